@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/nano"
@@ -68,6 +69,14 @@ type DeleteRequest struct {
 	Where     string   `super:"where"`
 }
 
+// UpdateRequest either applies the put operation in Set to the rows
+// matching Where or executes the MERGE INTO statement in Merge.
+type UpdateRequest struct {
+	Where string `super:"where"`
+	Set   string `super:"set"`
+	Merge string `super:"merge"`
+}
+
 type CommitMessage struct {
 	Author string `super:"author"`
 	Body   string `super:"body"`
@@ -86,6 +95,22 @@ type EventBranchCommit struct {
 	Parent   string      `super:"parent"`
 }
 
+type EventBranchCompact struct {
+	CommitID ksuid.KSUID `super:"commit_id"`
+	PoolID   ksuid.KSUID `super:"pool_id"`
+	Branch   string      `super:"branch"`
+	Objects  int         `super:"objects"`
+}
+
+type EventManageProgress struct {
+	PoolID           ksuid.KSUID `super:"pool_id"`
+	Branch           string      `super:"branch"`
+	Runs             int         `super:"runs"`
+	RunsCompacted    int         `super:"runs_compacted"`
+	ObjectsCompacted int         `super:"objects_compacted"`
+	BytesCompacted   int64       `super:"bytes_compacted"`
+}
+
 type EventPool struct {
 	PoolID ksuid.KSUID `super:"pool_id"`
 }
@@ -97,6 +122,8 @@ type EventBranch struct {
 
 type QueryRequest struct {
 	Query string `json:"query"`
+	// Params binds query parameters by name to values in SUP format.
+	Params map[string]string `json:"params,omitempty"`
 }
 
 type QueryChannelSet struct {
@@ -117,10 +144,46 @@ type QueryStats struct {
 	vio.Progress
 }
 
+// QueryInfo describes a query running in the database service.
+type QueryInfo struct {
+	RequestID string       `json:"request_id" super:"request_id"`
+	Query     string       `json:"query" super:"query"`
+	UserID    string       `json:"user_id" super:"user_id"`
+	StartTime nano.Ts      `json:"start_time" super:"start_time"`
+	Progress  vio.Progress `json:"progress" super:"progress"`
+}
+
 type QueryWarning struct {
 	Warning string `json:"warning" super:"warning"`
 }
 
+type RetentionPutRequest struct {
+	Age     nano.Duration `json:"age" super:"age"`
+	Commits int64         `json:"commits" super:"commits"`
+}
+
+type IndexRulesPostRequest struct {
+	Rules []index.Rule `super:"rules"`
+}
+
+type IndexRulesDeleteRequest struct {
+	Names []string `super:"names"`
+}
+
+type IndexRulesDeleteResponse struct {
+	Rules []index.Rule `super:"rules"`
+}
+
+type IndexUpdateResponse struct {
+	ObjectIDs []ksuid.KSUID `super:"object_ids"`
+}
+
+type RetainResponse struct {
+	Commit    ksuid.KSUID   `super:"commit"`
+	ObjectIDs []ksuid.KSUID `super:"object_ids"`
+	CommitIDs []ksuid.KSUID `super:"commit_ids"`
+}
+
 type VacateResponse struct {
 	CommitIDs []ksuid.KSUID `super:"commit_ids"`
 }
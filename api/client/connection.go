@@ -41,6 +41,8 @@ var (
 	ErrBranchNotFound = errors.New("branch not found")
 	// ErrBranchExists is returned when the specified the branch already exists.
 	ErrBranchExists = errors.New("branch exists")
+	// ErrQueryNotFound is returned when the specified query is not running.
+	ErrQueryNotFound = errors.New("query not found")
 )
 
 type Connection struct {
@@ -247,6 +249,40 @@ func (c *Connection) RenamePool(ctx context.Context, id ksuid.KSUID, put api.Poo
 	return nil
 }
 
+func (c *Connection) SetRetention(ctx context.Context, id ksuid.KSUID, put api.RetentionPutRequest) error {
+	req := c.NewRequest(ctx, http.MethodPut, path.Join("/pool", id.String(), "retention"), put)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) AddIndexRules(ctx context.Context, id ksuid.KSUID, post api.IndexRulesPostRequest) error {
+	req := c.NewRequest(ctx, http.MethodPost, path.Join("/pool", id.String(), "indexes"), post)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) DeleteIndexRules(ctx context.Context, id ksuid.KSUID, names []string) (api.IndexRulesDeleteResponse, error) {
+	req := c.NewRequest(ctx, http.MethodDelete, path.Join("/pool", id.String(), "indexes"), api.IndexRulesDeleteRequest{Names: names})
+	var res api.IndexRulesDeleteResponse
+	err := c.doAndUnmarshal(req, &res)
+	return res, err
+}
+
+func (c *Connection) UpdateIndexes(ctx context.Context, poolID ksuid.KSUID, branchName string) (api.IndexUpdateResponse, error) {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("pool", poolID.String(), "branch", branchName, "indexes", "update"), nil)
+	var res api.IndexUpdateResponse
+	err := c.doAndUnmarshal(req, &res)
+	return res, err
+}
+
 func (c *Connection) RemovePool(ctx context.Context, id ksuid.KSUID) error {
 	req := c.NewRequest(ctx, http.MethodDelete, path.Join("/pool", id.String()), nil)
 	res, err := c.Do(req)
@@ -292,16 +328,17 @@ func (c *Connection) Revert(ctx context.Context, poolID ksuid.KSUID, branchName
 	return commit, err
 }
 
-// Query assembles a query from src and filenames and runs it.
+// Query assembles a query from src and filenames and runs it with the query
+// parameters bound to the SUP values in params.
 //
 // As for Connection.Do, if the returned error is nil, the user is expected to
 // call Response.Body.Close.
-func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input) (*Response, error) {
+func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (*Response, error) {
 	files, err := srcfiles.Concat(inputs)
 	if err != nil {
 		return nil, err
 	}
-	body := api.QueryRequest{Query: string(files.Text)}
+	body := api.QueryRequest{Query: string(files.Text), Params: params}
 	req := c.NewRequest(ctx, http.MethodPost, "/query?ctrl=T", body)
 	res, err := c.Do(req)
 	if ae := (*api.Error)(nil); errors.As(err, &ae) && len(ae.CompilationErrors) > 0 {
@@ -311,6 +348,28 @@ func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input) (*Respo
 	return res, err
 }
 
+// QueryList returns the queries running in the service.
+func (c *Connection) QueryList(ctx context.Context) ([]api.QueryInfo, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/query", nil)
+	var infos []api.QueryInfo
+	err := c.doAndUnmarshal(req, &infos)
+	return infos, err
+}
+
+// QueryCancel cancels the running query with the given request ID.
+func (c *Connection) QueryCancel(ctx context.Context, requestID string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("query", requestID), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		if errIsStatus(err, http.StatusNotFound) {
+			return ErrQueryNotFound
+		}
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, writeVectors bool, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName, "compact")
 	if writeVectors {
@@ -374,6 +433,39 @@ func (c *Connection) delete(ctx context.Context, poolID ksuid.KSUID, branchName
 	return commit, err
 }
 
+func (c *Connection) UpdateWhere(ctx context.Context, poolID ksuid.KSUID, branchName, where, set string, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.update(ctx, poolID, branchName, api.UpdateRequest{Where: where, Set: set}, message)
+}
+
+func (c *Connection) MergeInto(ctx context.Context, poolID ksuid.KSUID, branchName, src string, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.update(ctx, poolID, branchName, api.UpdateRequest{Merge: src}, message)
+}
+
+func (c *Connection) update(ctx context.Context, poolID ksuid.KSUID, branchName string, payload api.UpdateRequest, message api.CommitMessage) (api.CommitResponse, error) {
+	path := urlPath("pool", poolID.String(), "branch", branchName, "update")
+	req := c.NewRequest(ctx, http.MethodPost, path, payload)
+	if err := encodeCommitMessage(req, message); err != nil {
+		return api.CommitResponse{}, err
+	}
+	var commit api.CommitResponse
+	err := c.doAndUnmarshal(req, &commit)
+	return commit, err
+}
+
+func (c *Connection) Retain(ctx context.Context, poolID ksuid.KSUID, branchName string, dryrun bool, message api.CommitMessage) (api.RetainResponse, error) {
+	path := urlPath("pool", poolID.String(), "branch", branchName, "retain")
+	if dryrun {
+		path += "?dryrun=true"
+	}
+	req := c.NewRequest(ctx, http.MethodPost, path, nil)
+	if err := encodeCommitMessage(req, message); err != nil {
+		return api.RetainResponse{}, err
+	}
+	var res api.RetainResponse
+	err := c.doAndUnmarshal(req, &res)
+	return res, err
+}
+
 func (c *Connection) Vacate(ctx context.Context, pool string, ts nano.Ts, dryrun bool) (api.VacateResponse, error) {
 	path := urlPath("pool", pool, "vacate")
 	vals := make(url.Values)
@@ -19,6 +19,8 @@ func newEventsClient(resp *Response) *EventsClient {
 		api.EventPool{},
 		api.EventBranch{},
 		api.EventBranchCommit{},
+		api.EventBranchCompact{},
+		api.EventManageProgress{},
 	)
 	return &EventsClient{
 		rc:          resp.Body,
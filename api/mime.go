@@ -10,12 +10,14 @@ import (
 const (
 	MediaTypeAny         = "*/*"
 	MediaTypeArrowStream = "application/vnd.apache.arrow.stream"
+	MediaTypeAvro        = "application/avro"
 	MediaTypeBSUP        = "application/x-bsup"
 	MediaTypeCSUP        = "application/x-csup"
 	MediaTypeCSV         = "text/csv"
 	MediaTypeJSON        = "application/json"
 	MediaTypeLine        = "application/x-line"
 	MediaTypeNDJSON      = "application/x-ndjson"
+	MediaTypeORC         = "application/x-orc"
 	MediaTypeParquet     = "application/x-parquet"
 	MediaTypeSUP         = "application/x-sup"
 	MediaTypeTSV         = "text/tab-separated-values"
@@ -45,6 +47,8 @@ func MediaTypeToFormat(s string, dflt string) (string, error) {
 		return dflt, nil
 	case MediaTypeArrowStream:
 		return "arrows", nil
+	case MediaTypeAvro:
+		return "avro", nil
 	case MediaTypeBSUP:
 		return "bsup", nil
 	case MediaTypeCSUP:
@@ -57,6 +61,8 @@ func MediaTypeToFormat(s string, dflt string) (string, error) {
 		return "line", nil
 	case MediaTypeNDJSON:
 		return "ndjson", nil
+	case MediaTypeORC:
+		return "orc", nil
 	case MediaTypeParquet:
 		return "parquet", nil
 	case MediaTypeSUP:
@@ -73,6 +79,8 @@ func FormatToMediaType(format string) (string, error) {
 	switch format {
 	case "arrows":
 		return MediaTypeArrowStream, nil
+	case "avro":
+		return MediaTypeAvro, nil
 	case "bsup":
 		return MediaTypeBSUP, nil
 	case "csup":
@@ -85,6 +93,8 @@ func FormatToMediaType(format string) (string, error) {
 		return MediaTypeLine, nil
 	case "ndjson":
 		return MediaTypeNDJSON, nil
+	case "orc":
+		return MediaTypeORC, nil
 	case "parquet":
 		return MediaTypeParquet, nil
 	case "sup":
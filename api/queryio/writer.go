@@ -52,13 +52,13 @@ func NewWriter(sctx *super.Context, w io.WriteCloser, format string, flusher htt
 }
 
 func (w *Writer) WriteBatch(channel string, batch sbuf.Batch) error {
+	defer batch.Unref()
 	if w.channel != channel {
 		w.channel = channel
 		if err := w.WriteControl(api.QueryChannelSet{Channel: channel}); err != nil {
 			return err
 		}
 	}
-	defer batch.Unref()
 	return w.writer.Push(sbuf.Dematerialize(w.sctx, batch))
 }
 
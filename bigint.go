@@ -0,0 +1,172 @@
+package super
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/super/scode"
+)
+
+// The 128- and 256-bit integer types use the same counted varint encodings
+// as the 64-bit integer types extended to arbitrary length so that values
+// that fit in 64 bits encode identically to int64 and uint64.
+
+// IsBigInt is true iff the type id is int128, int256, uint128, or uint256,
+// whose values are not generally representable by int64 or uint64.
+func IsBigInt(id int) bool {
+	switch id {
+	case IDInt128, IDInt256, IDUint128, IDUint256:
+		return true
+	}
+	return false
+}
+
+var (
+	minInt128  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxInt128  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minInt256  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	maxInt256  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	minInt64   = big.NewInt(-1 << 63)
+	maxInt64   = big.NewInt(1<<63 - 1)
+	maxUint64  = new(big.Int).SetUint64(1<<64 - 1)
+	bigZero    = new(big.Int)
+)
+
+// IntRange returns the smallest and largest values of the integer type with
+// the given ID.  The returned values must not be modified.
+func IntRange(id int) (*big.Int, *big.Int) {
+	switch id {
+	case IDInt8:
+		return big.NewInt(-1 << 7), big.NewInt(1<<7 - 1)
+	case IDInt16:
+		return big.NewInt(-1 << 15), big.NewInt(1<<15 - 1)
+	case IDInt32:
+		return big.NewInt(-1 << 31), big.NewInt(1<<31 - 1)
+	case IDInt64, IDDuration, IDTime:
+		return minInt64, maxInt64
+	case IDInt128:
+		return minInt128, maxInt128
+	case IDInt256:
+		return minInt256, maxInt256
+	case IDUint8:
+		return bigZero, big.NewInt(1<<8 - 1)
+	case IDUint16:
+		return bigZero, big.NewInt(1<<16 - 1)
+	case IDUint32:
+		return bigZero, big.NewInt(1<<32 - 1)
+	case IDUint64:
+		return bigZero, maxUint64
+	case IDUint128:
+		return bigZero, maxUint128
+	case IDUint256:
+		return bigZero, maxUint256
+	}
+	panic(id)
+}
+
+// FitsInt reports whether v is in the range of the integer type with the
+// given ID.
+func FitsInt(id int, v *big.Int) bool {
+	min, max := IntRange(id)
+	return v.Cmp(min) >= 0 && v.Cmp(max) <= 0
+}
+
+func EncodeBigInt(v *big.Int) scode.Bytes {
+	return AppendBigInt(nil, v)
+}
+
+// AppendBigInt appends the signed counted varint encoding of v.
+func AppendBigInt(bytes scode.Bytes, v *big.Int) scode.Bytes {
+	if v.IsInt64() && v.Int64() != math.MinInt64 {
+		return scode.AppendCountedVarint(bytes, v.Int64())
+	}
+	u := new(big.Int).Abs(v)
+	u.Lsh(u, 1)
+	if v.Sign() < 0 {
+		u.SetBit(u, 0, 1)
+	}
+	return appendLittleEndian(bytes, u)
+}
+
+// DecodeBigInt decodes a signed counted varint of any length.
+func DecodeBigInt(bytes scode.Bytes) *big.Int {
+	if len(bytes) < 8 || len(bytes) == 8 && bytes[7] < 0x80 {
+		// At most 63 bits of magnitude+sign so fits in an int64.
+		return big.NewInt(scode.DecodeCountedVarint(bytes))
+	}
+	u := decodeLittleEndian(bytes)
+	neg := u.Bit(0) == 1
+	u.Rsh(u, 1)
+	if neg {
+		u.Neg(u)
+	}
+	return u
+}
+
+func EncodeBigUint(v *big.Int) scode.Bytes {
+	return AppendBigUint(nil, v)
+}
+
+// AppendBigUint appends the unsigned counted varint encoding of v, which
+// must not be negative.
+func AppendBigUint(bytes scode.Bytes, v *big.Int) scode.Bytes {
+	if v.IsUint64() {
+		return scode.AppendCountedUvarint(bytes, v.Uint64())
+	}
+	return appendLittleEndian(bytes, v)
+}
+
+// DecodeBigUint decodes an unsigned counted varint of any length.
+func DecodeBigUint(bytes scode.Bytes) *big.Int {
+	if len(bytes) <= 8 {
+		return new(big.Int).SetUint64(scode.DecodeCountedUvarint(bytes))
+	}
+	return decodeLittleEndian(bytes)
+}
+
+func appendLittleEndian(bytes scode.Bytes, u *big.Int) scode.Bytes {
+	// big.Int.Bytes is big endian so reverse into little-endian order.
+	be := u.Bytes()
+	for k := len(be) - 1; k >= 0; k-- {
+		bytes = append(bytes, be[k])
+	}
+	return bytes
+}
+
+func decodeLittleEndian(bytes scode.Bytes) *big.Int {
+	be := make([]byte, len(bytes))
+	for k, b := range bytes {
+		be[len(bytes)-1-k] = b
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+// NewBigInt returns a value of the integer type typ, which must hold v.
+func NewBigInt(typ Type, v *big.Int) Value {
+	if IsSigned(typ.ID()) {
+		return NewValue(typ, EncodeBigInt(v))
+	}
+	return NewValue(typ, EncodeBigUint(v))
+}
+
+// BigInt returns v's underlying integer value.  It panics if v's underlying
+// type is not an integer type.
+func (v Value) BigInt() *big.Int {
+	id := v.Type().ID()
+	switch {
+	case IsUnsigned(id):
+		if IsBigInt(id) {
+			return DecodeBigUint(v.Bytes())
+		}
+		return new(big.Int).SetUint64(v.Uint())
+	case IsSigned(id):
+		if IsBigInt(id) {
+			return DecodeBigInt(v.Bytes())
+		}
+		return big.NewInt(v.Int())
+	}
+	panic(fmt.Sprintf("super.Value.BigInt called on %T", v.Type()))
+}
@@ -0,0 +1,47 @@
+package super_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigIntEncoding(t *testing.T) {
+	for _, s := range []string{
+		"0",
+		"1",
+		"-1",
+		"9223372036854775807",
+		"-9223372036854775808",
+		"18446744073709551616",
+		"-170141183460469231731687303715884105728",
+		"57896044618658097711785492504343953926634992332820282019728792003956564819967",
+	} {
+		v, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		assert.Equal(t, 0, v.Cmp(super.DecodeBigInt(super.EncodeBigInt(v))), s)
+		if v.IsInt64() && v.Int64() != math.MinInt64 {
+			// Values that fit in an int64 encode identically to int64.
+			assert.Equal(t, super.EncodeInt(v.Int64()), super.EncodeBigInt(v), s)
+		}
+		if v.Sign() >= 0 {
+			assert.Equal(t, 0, v.Cmp(super.DecodeBigUint(super.EncodeBigUint(v))), s)
+			if v.IsUint64() {
+				assert.Equal(t, super.EncodeUint(v.Uint64()), super.EncodeBigUint(v), s)
+			}
+		}
+	}
+}
+
+func TestFitsInt(t *testing.T) {
+	max128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	assert.True(t, super.FitsInt(super.IDInt128, max128))
+	assert.False(t, super.FitsInt(super.IDInt128, new(big.Int).Add(max128, big.NewInt(1))))
+	assert.True(t, super.FitsInt(super.IDUint128, new(big.Int).Add(max128, big.NewInt(1))))
+	assert.False(t, super.FitsInt(super.IDUint256, big.NewInt(-1)))
+	assert.False(t, super.FitsInt(super.IDInt64, new(big.Int).SetUint64(math.MaxUint64)))
+}
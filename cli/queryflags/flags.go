@@ -1,9 +1,11 @@
 package queryflags
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/sup"
@@ -11,7 +13,10 @@ import (
 )
 
 type QueryTextFlags struct {
-	Query     []srcfiles.Input
+	Query []srcfiles.Input
+	// Params holds the SUP text of the values bound to query parameters
+	// keyed by parameter name.
+	Params    map[string]string
 	includes  FileInput
 	dashCArgs PlainInput
 }
@@ -26,6 +31,20 @@ func (q *QueryTextFlags) SetFlags(fs *flag.FlagSet) {
 	q.dashCArgs.inputs = &q.Query
 	fs.Var(&q.dashCArgs, "c", "query text (may be used multiple times)")
 	fs.Var(&q.includes, "I", "source file containing query text (may be used multiple times)")
+	fs.Func("p", "bind query parameter to SUP value as name=value (may be used multiple times)", q.setParam)
+}
+
+func (q *QueryTextFlags) setParam(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "$"), ":")
+	if !ok || name == "" {
+		return errors.New("parameter must be of the form name=value")
+	}
+	if q.Params == nil {
+		q.Params = make(map[string]string)
+	}
+	q.Params[name] = value
+	return nil
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
@@ -5,8 +5,10 @@ import (
 	"flag"
 
 	"github.com/brimdata/super/cli/auto"
+	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/op/sort"
+	vamsort "github.com/brimdata/super/runtime/vam/op/sort"
 	"github.com/pbnjay/memory"
 )
 
@@ -30,6 +32,7 @@ func defaultMemMaxBytes() uint64 {
 type Flags struct {
 	// these memory limits should be based on a shared resource model
 	aggMemMax  auto.Bytes
+	hashMemMax auto.Bytes
 	sortMemMax auto.Bytes
 }
 
@@ -39,6 +42,8 @@ func (e *Flags) SetFlags(fs *flag.FlagSet) {
 	def := defaultMemMaxBytes()
 	e.sortMemMax = auto.NewBytes(def)
 	fs.Var(&e.sortMemMax, "sortmem", "maximum memory used by sort in MiB, MB, etc")
+	e.hashMemMax = auto.NewBytes(def)
+	fs.Var(&e.hashMemMax, "hashmem", "maximum memory used by hash aggregation and hash join before spilling in MiB, MB, etc")
 }
 
 func (e *Flags) Init() error {
@@ -50,5 +55,10 @@ func (e *Flags) Init() error {
 		return errors.New("sortmem value must be greater than zero")
 	}
 	sort.MemMaxBytes = int(e.sortMemMax.Bytes)
+	vamsort.MemMaxBytes = int(e.sortMemMax.Bytes)
+	if e.hashMemMax.Bytes <= 0 {
+		return errors.New("hashmem value must be greater than zero")
+	}
+	runtime.MemMaxBytes = int(e.hashMemMax.Bytes)
 	return nil
 }
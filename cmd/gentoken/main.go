@@ -24,6 +24,7 @@ type Command struct {
 	audience       string
 	domain         string
 	expiration     time.Duration
+	groups         []string
 	privateKeyFile string
 	keyID          string
 	tenantID       string
@@ -35,6 +36,10 @@ func New(_ charm.Command, fs *flag.FlagSet) (charm.Command, error) {
 	fs.StringVar(&c.audience, "audience", "", "audience claim in generated token")
 	fs.StringVar(&c.domain, "domain", "", "domain to use to generate token issuer")
 	fs.DurationVar(&c.expiration, "expiration", 4*time.Hour, "expiry duration for generated token")
+	fs.Func("group", "group claim in generated token (may be repeated)", func(s string) error {
+		c.groups = append(c.groups, s)
+		return nil
+	})
 	fs.StringVar(&c.privateKeyFile, "privatekeyfile", "", "path of file containing private key (required)")
 	fs.StringVar(&c.keyID, "keyid", "", "key identifier")
 	fs.StringVar(&c.tenantID, "tenantid", "", "tenant ID claim in generated token")
@@ -50,7 +55,7 @@ func (c *Command) Run(args []string) error {
 		return errors.New("must specify a keyfile")
 	}
 	token, err := auth.GenerateAccessToken(
-		c.keyID, c.privateKeyFile, c.expiration, c.audience, c.domain, auth.TenantID(c.tenantID), auth.UserID(c.userID))
+		c.keyID, c.privateKeyFile, c.expiration, c.audience, c.domain, auth.TenantID(c.tenantID), auth.UserID(c.userID), c.groups)
 	if err != nil {
 		return fmt.Errorf("GenerateAccessToken failed: %w", err)
 	}
@@ -68,6 +68,7 @@ func (s *Shared) Run(ctx context.Context, args []string, dbFlags *dbflags.Flags,
 	if err != nil {
 		return err
 	}
+	ast.SetParams(s.queryFlags.Params)
 	if s.parallel > 0 {
 		s.optimize = true
 	}
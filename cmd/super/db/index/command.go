@@ -0,0 +1,42 @@
+package index
+
+import (
+	"flag"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var spec = &charm.Spec{
+	Name:  "index",
+	Usage: "index [subcommand]",
+	Short: "create and manage secondary indexes of pools",
+	Long: `
+The index subcommands create, drop, list, and update the index rules
+of a pool.  Each rule indexes a field of the pool's data objects so that
+queries filtering on the field can skip objects.
+
+See https://superdb.org/command/db.html#super-db-index
+`,
+	New: New,
+}
+
+func init() {
+	spec.Add(create)
+	spec.Add(drop)
+	spec.Add(ls)
+	spec.Add(update)
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*db.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	return charm.NoRun(args)
+}
@@ -0,0 +1,71 @@
+package index
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/field"
+)
+
+var create = &charm.Spec{
+	Name:  "create",
+	Usage: "create [options] name kind field",
+	Short: "create an index rule for a pool",
+	Long: `
+The index create command adds a rule named by the name argument that indexes
+the field argument of the pool's data objects with an index of the kind
+argument, which is one of "bloom", "minmax", or "value".  Data objects loaded
+or compacted afterward are indexed by the rule; "index update" indexes existing
+data objects.
+`,
+	New: newCreate,
+}
+
+type createCommand struct {
+	*Command
+	poolFlags poolflags.Flags
+}
+
+func newCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &createCommand{Command: parent.(*Command)}
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *createCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 3 {
+		return errors.New("index create requires a name, kind, and field")
+	}
+	rule := index.Rule{Name: args[0], Kind: args[1], Field: field.Dotted(args[2])}
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	if err := db.AddIndexRules(ctx, poolID, []index.Rule{rule}); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("index rule %q created\n", rule.Name)
+	}
+	return nil
+}
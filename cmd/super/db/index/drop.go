@@ -0,0 +1,67 @@
+package index
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var drop = &charm.Spec{
+	Name:  "drop",
+	Usage: "drop [options] name [name ...]",
+	Short: "drop index rules from a pool",
+	Long: `
+The index drop command deletes the named index rules of a pool.  Queries stop
+using the dropped rules immediately.  The index files of existing data objects
+are left in place until they are rewritten by "index update" or removed with
+their data objects.
+`,
+	New: newDrop,
+}
+
+type dropCommand struct {
+	*Command
+	poolFlags poolflags.Flags
+}
+
+func newDrop(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &dropCommand{Command: parent.(*Command)}
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *dropCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		return errors.New("index drop requires at least one rule name")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	rules, err := db.DeleteIndexRules(ctx, poolID, args)
+	if err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		for _, rule := range rules {
+			fmt.Printf("index rule %q dropped\n", rule.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package index
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/db/api"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "ls [options]",
+	Short: "list the index rules of a pool",
+	Long: `
+The index ls command lists the index rules of a pool, one per line, as the
+rule's name, kind, and field.
+`,
+	New: newLs,
+}
+
+type lsCommand struct {
+	*Command
+	poolFlags poolflags.Flags
+}
+
+func newLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &lsCommand{Command: parent.(*Command)}
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *lsCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("too many arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	pool, err := api.LookupPoolByName(ctx, db, head.Pool)
+	if err != nil {
+		return err
+	}
+	for _, rule := range pool.Indexes {
+		fmt.Println(rule)
+	}
+	return nil
+}
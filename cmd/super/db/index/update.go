@@ -0,0 +1,66 @@
+package index
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/plural"
+)
+
+var update = &charm.Spec{
+	Name:  "update",
+	Usage: "update [options]",
+	Short: "index the existing data objects of a branch",
+	Long: `
+The index update command rewrites the index of each data object in a branch
+according to the pool's current index rules.  It is needed only for data
+objects written before a rule was created since data objects are indexed
+when they are loaded or compacted.
+`,
+	New: newUpdate,
+}
+
+type updateCommand struct {
+	*Command
+	poolFlags poolflags.Flags
+}
+
+func newUpdate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &updateCommand{Command: parent.(*Command)}
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *updateCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("too many arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	ids, err := db.UpdateIndexes(ctx, poolID, head.Branch)
+	if err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("%d data object%s indexed\n", len(ids), plural.Slice(ids, "s"))
+	}
+	return nil
+}
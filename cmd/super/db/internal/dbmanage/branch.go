@@ -3,24 +3,30 @@ package dbmanage
 import (
 	"context"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/api"
 	dbapi "github.com/brimdata/super/db/api"
 	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
 type branch struct {
-	config PoolConfig
-	db     dbapi.Interface
-	logger *zap.Logger
-	pool   *pools.Config
+	config   PoolConfig
+	db       dbapi.Interface
+	logger   *zap.Logger
+	pool     *pools.Config
+	publish  func(string, any)
+	strategy strategy
 }
 
-func newBranch(c Config, pool *pools.Config, db dbapi.Interface, logger *zap.Logger) *branch {
+func newBranch(c Config, pool *pools.Config, db dbapi.Interface, logger *zap.Logger) (*branch, error) {
 	config := c.poolConfig(pool)
+	strategy, err := newStrategy(config, pool)
+	if err != nil {
+		return nil, err
+	}
 	logger = logger.Named("pool").With(
 		zap.String("name", pool.Name),
 		zap.Stringer("id", pool.ID),
@@ -28,66 +34,152 @@ func newBranch(c Config, pool *pools.Config, db dbapi.Interface, logger *zap.Log
 		zap.Bool("vectors", config.Vectors),
 	)
 	return &branch{
-		config: config,
-		db:     db,
-		logger: logger,
-		pool:   pool,
+		config:   config,
+		db:       db,
+		logger:   logger,
+		pool:     pool,
+		publish:  c.Publish,
+		strategy: strategy,
+	}, nil
+}
+
+// Plan describes the compaction of a branch in one cycle.
+type Plan struct {
+	Pool   string
+	Branch string
+	// Runs are the runs of objects to be compacted in this cycle.
+	Runs []Run
+	// Deferred are the runs that exceed the budget and so are left
+	// for a later cycle.
+	Deferred []Run
+	// Vectors are the objects for which vectors are to be created.
+	Vectors []ksuid.KSUID
+}
+
+// Run is a run of objects that are compacted into new objects.
+type Run struct {
+	Objects []ksuid.KSUID
+	Size    int64
+	Min     super.Value
+	Max     super.Value
+}
+
+func newRun(objects []*object) Run {
+	run := newRunBuilder()
+	for _, o := range objects {
+		run.add(o)
+	}
+	return Run{
+		Objects: run.objectIDs(),
+		Size:    run.size,
+		Min:     run.span.First(),
+		Max:     run.span.Last(),
 	}
 }
 
-func (b *branch) run(ctx context.Context) error {
-	b.logger.Debug("compaction started")
+func (b *branch) plan(ctx context.Context, budgets ...*budget) (*Plan, error) {
 	head := dbid.Commitish{Pool: b.pool.Name, Branch: b.config.Branch}
 	it, err := newObjectIterator(ctx, b.db, &head)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	objects, err := readObjects(it)
+	if closeErr := it.close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{Pool: b.pool.Name, Branch: b.config.Branch}
+	compacted := make(map[ksuid.KSUID]struct{})
+	for _, objects := range b.strategy.plan(objects) {
+		run := newRun(objects)
+		if len(plan.Deferred) > 0 || !spend(run.Size, budgets) {
+			plan.Deferred = append(plan.Deferred, run)
+			continue
+		}
+		plan.Runs = append(plan.Runs, run)
+		for _, id := range run.Objects {
+			compacted[id] = struct{}{}
+		}
+	}
+	if b.config.Vectors {
+		for _, o := range objects {
+			if _, ok := compacted[o.ID]; !ok && !o.Vector {
+				plan.Vectors = append(plan.Vectors, o.ID)
+			}
+		}
+	}
+	return plan, nil
+}
+
+func (b *branch) run(ctx context.Context, plan *Plan) error {
+	b.logger.Debug("compaction started")
+	progress := api.EventManageProgress{
+		PoolID: b.pool.ID,
+		Branch: b.config.Branch,
+		Runs:   len(plan.Runs),
 	}
-	defer it.close()
-	runCh := make(chan []ksuid.KSUID)
-	vecCh := make(chan ksuid.KSUID)
-	group, ctx := errgroup.WithContext(ctx)
-	group.Go(func() error {
-		err := scan(ctx, it, b.pool, runCh, vecCh)
-		close(runCh)
-		close(vecCh)
-		return err
-	})
-	var found int
-	var compacted int
 	var vectors int
-	group.Go(func() error {
-		for run := range runCh {
-			commit, err := b.db.Compact(ctx, b.pool.ID, b.config.Branch, run, b.config.Vectors, api.CommitMessage{})
+	err := func() error {
+		for _, run := range plan.Runs {
+			commit, err := b.db.Compact(ctx, b.pool.ID, b.config.Branch, run.Objects, b.config.Vectors, api.CommitMessage{})
 			if err != nil {
 				return err
 			}
-			found++
-			compacted += len(run)
-			b.logger.Debug("compacted", zap.Stringer("commit", commit), zap.Int("objects_compacted", len(run)))
-		}
-		return nil
-	})
-	group.Go(func() error {
-		var oids []ksuid.KSUID
-		for oid := range vecCh {
-			if b.config.Vectors {
-				oids = append(oids, oid)
+			progress.RunsCompacted++
+			progress.ObjectsCompacted += len(run.Objects)
+			progress.BytesCompacted += run.Size
+			b.logger.Debug("compacted", zap.Stringer("commit", commit), zap.Int("objects_compacted", len(run.Objects)))
+			if b.publish != nil {
+				b.publish("manage-progress", progress)
 			}
 		}
-		if len(oids) == 0 {
+		if len(plan.Vectors) == 0 {
 			return nil
 		}
-		_, err := b.db.AddVectors(ctx, head.Pool, head.Branch, oids, api.CommitMessage{})
+		_, err := b.db.AddVectors(ctx, b.pool.Name, b.config.Branch, plan.Vectors, api.CommitMessage{})
 		if err == nil {
-			vectors += len(oids)
+			vectors += len(plan.Vectors)
 		}
 		return err
-	})
-	err = group.Wait()
+	}()
 	b.logger.Info("compaction completed",
-		zap.Int("runs_found", found),
-		zap.Int("objects_compacted", compacted),
+		zap.Int("runs_found", progress.RunsCompacted),
+		zap.Int("runs_deferred", len(plan.Deferred)),
+		zap.Int("objects_compacted", progress.ObjectsCompacted),
+		zap.Int64("bytes_compacted", progress.BytesCompacted),
 		zap.Int("vectors_created", vectors),
 	)
 	return err
 }
+
+// budget limits the number of bytes rewritten by compaction in a cycle.
+// A nil budget or one with a zero limit is unlimited.
+type budget struct {
+	limit int64
+	spent int64
+}
+
+func newBudget(limit int64) *budget {
+	return &budget{limit: limit}
+}
+
+func (b *budget) fits(size int64) bool {
+	return b == nil || b.limit == 0 || b.spent+size <= b.limit
+}
+
+// spend charges size to each of budgets if it fits in all of them.
+func spend(size int64, budgets []*budget) bool {
+	for _, b := range budgets {
+		if !b.fits(size) {
+			return false
+		}
+	}
+	for _, b := range budgets {
+		if b != nil {
+			b.spent += size
+		}
+	}
+	return true
+}
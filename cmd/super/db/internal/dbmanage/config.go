@@ -1,9 +1,15 @@
 package dbmanage
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/units"
+	"github.com/goccy/go-yaml"
 )
 
 const DefaultInterval = time.Minute
@@ -11,24 +17,59 @@ const DefaultInterval = time.Minute
 type Config struct {
 	Interval *time.Duration `yaml:"interval"`
 	Vectors  bool           `yaml:"vectors"`
-	Pools    []PoolConfig   `yaml:"pools"`
+	// Budget is the maximum number of bytes rewritten by compaction across
+	// all pools in each cycle.  Zero means no limit.
+	Budget units.Bytes `yaml:"budget"`
+	// QuietHours is a daily range of local time, e.g., "08:00-18:00", during
+	// which no compaction is done.
+	QuietHours string `yaml:"quiet_hours"`
+	// Strategy, TargetSize, and Window are the defaults for pools that
+	// do not set their own.
+	Strategy   string        `yaml:"strategy"`
+	TargetSize units.Bytes   `yaml:"target_size"`
+	Window     time.Duration `yaml:"window"`
+	Pools      []PoolConfig  `yaml:"pools"`
+	// Publish, if not nil, is called with a "manage-progress" event
+	// after each compaction.
+	Publish func(name string, event any) `yaml:"-"`
 }
 
-func (c *Config) poolConfig(p *pools.Config) PoolConfig {
-	for _, pconf := range c.Pools {
-		if p.Name != pconf.Pool && p.ID.String() != pconf.Pool {
-			continue
-		}
-		if pconf.Branch == "" {
-			pconf.Branch = "main"
-		}
-		return pconf
+// Load reads the YAML configuration file at path into c.
+func (c *Config) Load(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
 	}
-	return PoolConfig{
+	return yaml.UnmarshalWithOptions(b, c, yaml.DisallowUnknownField())
+}
+
+func (c *Config) poolConfig(p *pools.Config) PoolConfig {
+	pconf := PoolConfig{
 		Pool:    p.Name,
-		Branch:  "main",
 		Vectors: c.Vectors,
 	}
+	for _, conf := range c.Pools {
+		if p.Name == conf.Pool || p.ID.String() == conf.Pool {
+			pconf = conf
+			break
+		}
+	}
+	if pconf.Branch == "" {
+		pconf.Branch = "main"
+	}
+	if pconf.Strategy == "" {
+		pconf.Strategy = c.Strategy
+	}
+	if pconf.TargetSize == 0 {
+		pconf.TargetSize = c.TargetSize
+	}
+	if pconf.TargetSize == 0 {
+		pconf.TargetSize = units.Bytes(p.Threshold)
+	}
+	if pconf.Window == 0 {
+		pconf.Window = c.Window
+	}
+	return pconf
 }
 
 func (c *Config) interval() time.Duration {
@@ -38,8 +79,101 @@ func (c *Config) interval() time.Duration {
 	return *c.Interval
 }
 
+func (c *Config) validate() error {
+	if c.Budget < 0 {
+		return errors.New("budget cannot be negative")
+	}
+	if _, err := parseQuietHours(c.QuietHours); err != nil {
+		return err
+	}
+	if err := validateStrategy(c.Strategy, c.Window); err != nil {
+		return err
+	}
+	for _, p := range c.Pools {
+		if p.Budget < 0 {
+			return fmt.Errorf("pool %q: budget cannot be negative", p.Pool)
+		}
+		window := p.Window
+		if window == 0 {
+			window = c.Window
+		}
+		strategy := p.Strategy
+		if strategy == "" {
+			strategy = c.Strategy
+		}
+		if err := validateStrategy(strategy, window); err != nil {
+			return fmt.Errorf("pool %q: %w", p.Pool, err)
+		}
+	}
+	return nil
+}
+
 type PoolConfig struct {
 	Pool    string `yaml:"pool"`
 	Branch  string `yaml:"branch"`
 	Vectors bool   `yaml:"vectors"`
+	// Strategy is the name of the compaction strategy, either "size-tiered"
+	// (the default) or "time-window".
+	Strategy string `yaml:"strategy"`
+	// TargetSize is the size of the objects compaction aims to create.  It
+	// defaults to the pool's threshold.
+	TargetSize units.Bytes `yaml:"target_size"`
+	// Window is the width of the time partitions the time-window strategy
+	// never compacts across.
+	Window time.Duration `yaml:"window"`
+	// Budget is the maximum number of bytes rewritten by compaction of
+	// this pool in each cycle.  Zero means no limit.
+	Budget units.Bytes `yaml:"budget"`
+	// Pools with higher priority are compacted first.
+	Priority int `yaml:"priority"`
+}
+
+// quietHours is a daily range of local time given as offsets from midnight.
+// The range wraps past midnight if end is before start and is the whole day
+// if end equals start.
+type quietHours struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func parseQuietHours(s string) (*quietHours, error) {
+	if s == "" {
+		return nil, nil
+	}
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return nil, fmt.Errorf("quiet hours %q: must be of the form HH:MM-HH:MM", s)
+	}
+	var q quietHours
+	var err error
+	if q.start, err = parseTimeOfDay(start); err != nil {
+		return nil, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	if q.end, err = parseTimeOfDay(end); err != nil {
+		return nil, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	return &q, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("time of day %q must be HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (q *quietHours) contains(t time.Time) bool {
+	if q == nil {
+		return false
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+	if q.start == q.end {
+		return true
+	}
+	if q.start < q.end {
+		return offset >= q.start && offset < q.end
+	}
+	return offset >= q.start || offset < q.end
 }
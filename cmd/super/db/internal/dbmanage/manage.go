@@ -1,9 +1,11 @@
 package dbmanage
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"syscall"
 	"time"
 
@@ -13,25 +15,67 @@ import (
 	"github.com/brimdata/super/dbid"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
 func Update(ctx context.Context, db api.Interface, conf Config, logger *zap.Logger) error {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
+	quiet, err := parseQuietHours(conf.QuietHours)
+	if err != nil {
+		return err
+	}
+	if quiet.contains(time.Now()) {
+		logger.Info("quiet hours, skipping compaction")
+		return nil
+	}
+	return plan(ctx, db, conf, logger, func(branch *branch, plan *Plan) {
+		branch.logger.Info("updating pool")
+		if err := branch.run(ctx, plan); err != nil {
+			branch.logger.Error("update error", zap.Error(err))
+		}
+	})
+}
+
+// Plans returns the compaction plans for the next cycle without carrying
+// them out.
+func Plans(ctx context.Context, db api.Interface, conf Config, logger *zap.Logger) ([]*Plan, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	var plans []*Plan
+	err := plan(ctx, db, conf, logger, func(_ *branch, plan *Plan) {
+		plans = append(plans, plan)
+	})
+	return plans, err
+}
+
+// plan plans the compaction of each branch in priority order and calls fn
+// with each plan before planning the next branch.
+func plan(ctx context.Context, db api.Interface, conf Config, logger *zap.Logger, fn func(*branch, *Plan)) error {
+	if err := conf.validate(); err != nil {
+		return err
+	}
 	branches, err := getBranches(ctx, conf, db, logger)
 	if err != nil {
 		return err
 	}
-	group, ctx := errgroup.WithContext(ctx)
+	slices.SortStableFunc(branches, func(a, b *branch) int {
+		return cmp.Compare(b.config.Priority, a.config.Priority)
+	})
+	total := newBudget(int64(conf.Budget))
 	for _, branch := range branches {
-		branch.logger.Info("updating pool")
-		if err := branch.run(ctx); err != nil {
+		plan, err := branch.plan(ctx, total, newBudget(int64(branch.config.Budget)))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			branch.logger.Error("update error", zap.Error(err))
+			continue
 		}
+		fn(branch, plan)
 	}
-	return group.Wait()
+	return ctx.Err()
 }
 
 func Monitor(ctx context.Context, conn *client.Connection, conf Config, logger *zap.Logger) error {
@@ -40,8 +84,15 @@ func Monitor(ctx context.Context, conn *client.Connection, conf Config, logger *
 	}
 	logger.Info("monitoring")
 	db := api.NewRemoteDB(conn)
+	return retry(ctx, logger, func() error {
+		return monitor(ctx, db, conf, logger)
+	})
+}
+
+// retry runs fn until it returns an error other than a refused connection.
+func retry(ctx context.Context, logger *zap.Logger, fn func() error) error {
 	for {
-		err := monitor(ctx, db, conf, logger)
+		err := fn()
 		if errors.Is(err, syscall.ECONNREFUSED) {
 			logger.Info("cannot connect to database, retrying in 5 seconds")
 		} else if err != nil {
@@ -76,9 +127,11 @@ func getBranches(ctx context.Context, conf Config, db api.Interface, logger *zap
 	}
 	var branches []*branch
 	for _, pool := range pools {
-		if b := newBranch(conf, pool, db, logger); b != nil {
-			branches = append(branches, b)
+		b, err := newBranch(conf, pool, db, logger)
+		if err != nil {
+			return nil, err
 		}
+		branches = append(branches, b)
 	}
 	return branches, nil
 }
@@ -0,0 +1,68 @@
+package dbmanage
+
+import (
+	"context"
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/api/client"
+	dbapi "github.com/brimdata/super/db/api"
+	"go.uber.org/zap"
+)
+
+// Retain enforces the retention policy of each pool that has one on the
+// pool's main branch.
+func Retain(ctx context.Context, db dbapi.Interface, logger *zap.Logger) error {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	pools, err := dbapi.GetPools(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if pool.Retention.IsZero() {
+			continue
+		}
+		logger := logger.Named("pool").With(
+			zap.String("name", pool.Name),
+			zap.Stringer("id", pool.ID),
+			zap.Stringer("retention", pool.Retention),
+		)
+		res, err := db.Retain(ctx, pool.ID, "main", false, api.CommitMessage{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error("retention error", zap.Error(err))
+			continue
+		}
+		logger.Info("retention enforced",
+			zap.Int("objects_deleted", len(res.ObjectIDs)),
+			zap.Int("commits_vacated", len(res.CommitIDs)),
+		)
+	}
+	return nil
+}
+
+// MonitorRetention enforces the retention policies of the database's pools
+// at each interval.
+func MonitorRetention(ctx context.Context, conn *client.Connection, interval time.Duration, logger *zap.Logger) error {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	logger.Info("monitoring retention")
+	db := dbapi.NewRemoteDB(conn)
+	return retry(ctx, logger, func() error {
+		for {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if err := Retain(ctx, db, logger); err != nil {
+				return err
+			}
+		}
+	})
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db/api"
 	"github.com/brimdata/super/db/data"
-	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime/sam/expr"
@@ -19,45 +18,19 @@ import (
 	"github.com/segmentio/ksuid"
 )
 
-func scan(ctx context.Context, it *objectIterator, pool *pools.Config, runCh chan<- []ksuid.KSUID, vecCh chan<- ksuid.KSUID) error {
-	send := func(r *runBuilder) error {
-		switch len(r.objects) {
-		case 0: // do nothing
-		case 1:
-			if !r.objects[0].Vector {
-				select {
-				case vecCh <- r.objects[0].ID:
-				case <-ctx.Done():
-					return ctx.Err()
-				}
-			}
-		default:
-			select {
-			case runCh <- r.objectIDs():
-			case <-ctx.Done():
-				return ctx.Err()
-			}
-		}
-		return nil
-	}
-	run := newRunBuilder()
+// readObjects returns the objects of the branch sorted by their minimum
+// pool keys.
+func readObjects(it *objectIterator) ([]*object, error) {
+	var objects []*object
 	for {
 		o, err := it.next()
-		if o == nil {
-			return send(run)
-		}
 		if err != nil {
-			return err
-		}
-		if run.overlaps(o.Min, o.Max) || run.size+o.Size < pool.Threshold {
-			run.add(o)
-			continue
+			return nil, err
 		}
-		if err := send(run); err != nil {
-			return err
+		if o == nil {
+			return objects, nil
 		}
-		run.reset()
-		run.add(o)
+		objects = append(objects, o)
 	}
 }
 
@@ -77,7 +50,7 @@ type objectIterator struct {
 
 func newObjectIterator(ctx context.Context, db api.Interface, head *dbid.Commitish) (*objectIterator, error) {
 	query := fmt.Sprintf(iteratorQuery, head.Pool, head.Branch, head.Pool, head.Branch)
-	q, err := db.Query(ctx, srcfiles.Plain(query))
+	q, err := db.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -94,16 +67,34 @@ func (r *objectIterator) next() (*object, error) {
 	if val == nil || err != nil {
 		return nil, err
 	}
-	var o object
-	// XXX Embedded structs currently not supported in marshal so unmarshal
-	// embedded object struct separately.
-	if err := r.unmarshaler.Unmarshal(*val, &o.Object); err != nil {
-		return nil, err
+	var rec struct {
+		ID     ksuid.KSUID `super:"id"`
+		Count  uint64      `super:"count"`
+		Size   int64       `super:"size"`
+		Vector bool        `super:"vector"`
 	}
-	if err := r.unmarshaler.Unmarshal(*val, &o); err != nil {
+	if err := r.unmarshaler.Unmarshal(*val, &rec); err != nil {
 		return nil, err
 	}
-	return &o, nil
+	// The pool keys have been defused by the query so they are copied
+	// directly rather than unmarshaled into data.Object's super.Values.
+	return &object{
+		Object: data.Object{
+			ID:    rec.ID,
+			Min:   derefKey(val, "min"),
+			Max:   derefKey(val, "max"),
+			Count: rec.Count,
+			Size:  rec.Size,
+		},
+		Vector: rec.Vector,
+	}, nil
+}
+
+func derefKey(val *super.Value, field string) super.Value {
+	if v := val.Deref(field); v != nil {
+		return v.Copy()
+	}
+	return super.Null
 }
 
 func (r *objectIterator) close() error {
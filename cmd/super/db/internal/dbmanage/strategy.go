@@ -0,0 +1,124 @@
+package dbmanage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/nano"
+)
+
+// A strategy divides a pool's objects, sorted by their minimum pool keys,
+// into runs of objects that should each be compacted into new objects.
+type strategy interface {
+	plan(objects []*object) [][]*object
+}
+
+var strategies = map[string]func(PoolConfig, *pools.Config) strategy{
+	"size-tiered": newSizeTiered,
+	"time-window": newTimeWindow,
+}
+
+func validateStrategy(name string, window time.Duration) error {
+	if name == "" {
+		return nil
+	}
+	if _, ok := strategies[name]; !ok {
+		return fmt.Errorf("unknown compaction strategy %q", name)
+	}
+	if name == "time-window" && window <= 0 {
+		return errors.New("time-window strategy requires a positive window")
+	}
+	return nil
+}
+
+func newStrategy(conf PoolConfig, pool *pools.Config) (strategy, error) {
+	if err := validateStrategy(conf.Strategy, conf.Window); err != nil {
+		return nil, err
+	}
+	name := conf.Strategy
+	if name == "" {
+		name = "size-tiered"
+	}
+	return strategies[name](conf, pool), nil
+}
+
+// sizeTiered compacts consecutive objects while their combined size is under
+// the target size and always compacts overlapping objects.
+type sizeTiered struct {
+	target int64
+}
+
+func newSizeTiered(conf PoolConfig, _ *pools.Config) strategy {
+	return &sizeTiered{target: int64(conf.TargetSize)}
+}
+
+func (s *sizeTiered) plan(objects []*object) [][]*object {
+	var runs [][]*object
+	run := newRunBuilder()
+	for _, o := range objects {
+		if !run.overlaps(o.Min, o.Max) && run.size+o.Size >= s.target {
+			runs = appendRun(runs, run)
+			run.reset()
+		}
+		run.add(o)
+	}
+	return appendRun(runs, run)
+}
+
+// timeWindow is like sizeTiered but never compacts objects from different
+// windows of time.  Objects whose pool keys span windows or are not times are
+// left as they are.
+type timeWindow struct {
+	sizeTiered
+	window nano.Duration
+}
+
+func newTimeWindow(conf PoolConfig, _ *pools.Config) strategy {
+	return &timeWindow{
+		sizeTiered: sizeTiered{target: int64(conf.TargetSize)},
+		window:     nano.Duration(conf.Window),
+	}
+}
+
+func (t *timeWindow) plan(objects []*object) [][]*object {
+	var runs [][]*object
+	var partition []*object
+	var current nano.Ts
+	flush := func() {
+		runs = append(runs, t.sizeTiered.plan(partition)...)
+		partition = partition[:0]
+	}
+	for _, o := range objects {
+		min, ok1 := t.windowOf(o.Min)
+		max, ok2 := t.windowOf(o.Max)
+		if !ok1 || !ok2 || min != max {
+			continue
+		}
+		if len(partition) > 0 && min != current {
+			flush()
+		}
+		current = min
+		partition = append(partition, o)
+	}
+	flush()
+	return runs
+}
+
+func (t *timeWindow) windowOf(val super.Value) (nano.Ts, bool) {
+	val = val.Under()
+	if val.Type().ID() != super.IDTime || val.IsNull() {
+		return 0, false
+	}
+	return val.AsTime().Trunc(t.window), true
+}
+
+// appendRun appends the objects in run to runs if there is more than one.
+func appendRun(runs [][]*object, run *runBuilder) [][]*object {
+	if len(run.objects) > 1 {
+		runs = append(runs, append([]*object(nil), run.objects...))
+	}
+	return runs
+}
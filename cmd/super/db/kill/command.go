@@ -0,0 +1,56 @@
+package kill
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var spec = &charm.Spec{
+	Name:  "kill",
+	Usage: "kill request-id [request-id ...]",
+	Short: "cancel queries running in a database service",
+	Long: `
+See https://superdb.org/command/db.html#super-db-kill
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*db.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		return errors.New("at least one request ID must be specified")
+	}
+	conn, err := c.DBFlags.Connection()
+	if err != nil {
+		return err
+	}
+	for _, id := range args {
+		if err := conn.QueryCancel(ctx, id); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		if !c.DBFlags.Quiet {
+			fmt.Printf("query canceled: %s\n", id)
+		}
+	}
+	return nil
+}
@@ -83,7 +83,7 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
-	q, err := db.Query(ctx, srcfiles.Plain(query))
+	q, err := db.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		w.Close()
 		return err
@@ -3,14 +3,16 @@ package manage
 import (
 	"errors"
 	"flag"
-	"os"
+	"fmt"
 
 	"github.com/brimdata/super/cli/dbflags"
 	"github.com/brimdata/super/cli/logflags"
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/cmd/super/db/internal/dbmanage"
 	"github.com/brimdata/super/pkg/charm"
-	"github.com/goccy/go-yaml"
+	"github.com/brimdata/super/pkg/plural"
+	"github.com/brimdata/super/pkg/units"
+	"github.com/brimdata/super/sup"
 	"go.uber.org/zap"
 )
 
@@ -32,26 +34,25 @@ type Command struct {
 	*db.Command
 	logFlags logflags.Flags
 	config   dbmanage.Config
+	dryrun   bool
 	monitor  bool
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*db.Command)}
 	c.logFlags.SetFlags(f)
-	f.Func("config", "path of manage YAML config file", func(s string) error {
-		b, err := os.ReadFile(s)
-		if err != nil {
-			return err
-		}
-		return yaml.UnmarshalWithOptions(b, &c.config, yaml.DisallowUnknownField())
-	})
+	f.Func("config", "path of manage YAML config file", c.config.Load)
 	f.Func("pool", "pool to manage (all if unset, can be specified multiple times)", func(s string) error {
 		c.config.Pools = append(c.config.Pools, dbmanage.PoolConfig{Pool: s, Branch: "main"})
 		return nil
 	})
 	c.config.Interval = f.Duration("interval", dbmanage.DefaultInterval, "interval between updates (only applicable with -monitor")
+	f.Var(&c.config.Budget, "budget", "maximum bytes rewritten by compaction in each update (no limit if zero)")
+	f.BoolVar(&c.dryrun, "dryrun", false, "print the compaction plan without compacting")
 	f.BoolVar(&c.monitor, "monitor", false, "continuously monitor the database for updates")
+	f.StringVar(&c.config.Strategy, "strategy", "", "compaction strategy (size-tiered or time-window)")
 	f.BoolVar(&c.config.Vectors, "vectors", false, "create vectors for objects")
+	f.DurationVar(&c.config.Window, "window", 0, "time partition width for time-window strategy")
 	return c, nil
 }
 
@@ -69,6 +70,9 @@ func (c *Command) Run(args []string) error {
 		}
 		defer logger.Sync()
 	}
+	if c.dryrun && c.monitor {
+		return errors.New("-dryrun cannot be used with -monitor")
+	}
 	if c.monitor {
 		conn, err := c.DBFlags.Connection()
 		if err != nil {
@@ -83,5 +87,39 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	if c.dryrun {
+		plans, err := dbmanage.Plans(ctx, db, c.config, logger)
+		if err != nil {
+			return err
+		}
+		for _, p := range plans {
+			printPlan(p)
+		}
+		return nil
+	}
 	return dbmanage.Update(ctx, db, c.config, logger)
 }
+
+func printPlan(p *dbmanage.Plan) {
+	var size int64
+	var objects int
+	for _, r := range p.Runs {
+		size += r.Size
+		objects += len(r.Objects)
+	}
+	fmt.Printf("%s@%s: compact %d run%s (%d objects, %s), defer %d run%s, create %d vector%s\n",
+		p.Pool, p.Branch,
+		len(p.Runs), plural.Slice(p.Runs, "s"), objects, units.Bytes(size).Abbrev(),
+		len(p.Deferred), plural.Slice(p.Deferred, "s"),
+		len(p.Vectors), plural.Slice(p.Vectors, "s"))
+	for _, r := range p.Runs {
+		printRun("compact", r)
+	}
+	for _, r := range p.Deferred {
+		printRun("defer", r)
+	}
+}
+
+func printRun(action string, r dbmanage.Run) {
+	fmt.Printf("    %s %d objects (%s) from %s to %s\n", action, len(r.Objects), units.Bytes(r.Size).Abbrev(), sup.String(r.Min), sup.String(r.Max))
+}
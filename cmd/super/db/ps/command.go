@@ -0,0 +1,81 @@
+package ps
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sup"
+)
+
+var spec = &charm.Spec{
+	Name:  "ps",
+	Usage: "ps [options]",
+	Short: "list queries running in a database service",
+	Long: `
+See https://superdb.org/command/db.html#super-db-ps
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	outputFlags outputflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.outputFlags.DefaultFormat = "table"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("ps command takes no arguments")
+	}
+	conn, err := c.DBFlags.Connection()
+	if err != nil {
+		return err
+	}
+	infos, err := conn.QueryList(ctx)
+	if err != nil {
+		return err
+	}
+	sctx := super.NewContext()
+	marshaler := sup.NewBSUPMarshalerWithContext(sctx)
+	marshaler.Decorate(sup.StyleSimple)
+	var vals []super.Value
+	for _, info := range infos {
+		val, err := marshaler.Marshal(info)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, val)
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	if len(vals) > 0 {
+		err = w.Push(sbuf.Dematerialize(sctx, sbuf.NewArray(vals)))
+	}
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
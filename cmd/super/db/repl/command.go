@@ -0,0 +1,71 @@
+package repl
+
+import (
+	"errors"
+	"flag"
+	"syscall"
+
+	"github.com/brimdata/super/cli"
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cli/queryflags"
+	"github.com/brimdata/super/cli/runtimeflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/cmd/super/internal/shell"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/repl"
+)
+
+var spec = &charm.Spec{
+	Name:  "repl",
+	Usage: "repl [options]",
+	Short: "run SuperSQL queries interactively against a database",
+	Long: `
+See https://superdb.org/command/db.html#super-db-repl
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	outputFlags  outputflags.Flags
+	queryFlags   queryflags.QueryTextFlags
+	runtimeFlags runtimeflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.outputFlags.DefaultFormat = "sup"
+	c.outputFlags.SetFlags(f)
+	c.queryFlags.SetFlags(f)
+	c.runtimeFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	// SIGINT interrupts a running query rather than ending the session.
+	ctx, cleanup, err := c.InitWithSignals([]cli.Initializer{&c.outputFlags, &c.runtimeFlags}, syscall.SIGPIPE, syscall.SIGTERM)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("repl command takes no arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	sh := shell.New(ctx, db.Query, &c.outputFlags, c.queryFlags.Params)
+	for _, q := range c.queryFlags.Query {
+		text, err := q.Load()
+		if err != nil {
+			return err
+		}
+		sh.Exec(text)
+	}
+	return repl.Run(sh)
+}
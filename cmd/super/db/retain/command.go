@@ -0,0 +1,111 @@
+package retain
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/commitflags"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/plural"
+)
+
+var spec = &charm.Spec{
+	Name:  "retain",
+	Usage: "retain [options]",
+	Short: "set or enforce a pool's retention policy",
+	Long: `
+See https://superdb.org/command/db.html#super-db-retain
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	commitFlags commitflags.Flags
+	poolFlags   poolflags.Flags
+	age         string
+	commits     int64
+	dryrun      bool
+	set         bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.commitFlags.SetFlags(f)
+	c.poolFlags.SetFlags(f)
+	f.StringVar(&c.age, "age", "", "with -set, delete data objects whose pool key is older than this duration (e.g., 90d)")
+	f.Int64Var(&c.commits, "commits", 0, "with -set, keep this many of the most recent commits of the main branch")
+	f.BoolVar(&c.dryrun, "dryrun", false, "view the data objects and commits that would be removed")
+	f.BoolVar(&c.set, "set", false, "set the pool's retention policy instead of enforcing it")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("too many arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	if c.set {
+		if c.dryrun {
+			return errors.New("-dryrun cannot be used with -set")
+		}
+		var retention pools.Retention
+		if c.age != "" {
+			if retention.Age, err = nano.ParseDuration(c.age); err != nil {
+				return err
+			}
+		}
+		retention.Commits = c.commits
+		if err := db.SetRetention(ctx, poolID, retention); err != nil {
+			return err
+		}
+		if !c.DBFlags.Quiet {
+			fmt.Printf("pool %q retention policy set\n", head.Pool)
+		}
+		return nil
+	}
+	if c.age != "" || c.commits != 0 {
+		return errors.New("-age and -commits require -set")
+	}
+	res, err := db.Retain(ctx, poolID, head.Branch, c.dryrun, c.commitFlags.CommitMessage())
+	if err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		verb := "deleted"
+		vacated := "vacated"
+		if c.dryrun {
+			verb = "would delete"
+			vacated = "would vacate"
+		}
+		fmt.Printf("%s %d data object%s and %s %d commit%s\n",
+			verb, len(res.ObjectIDs), plural.Slice(res.ObjectIDs, "s"),
+			vacated, len(res.CommitIDs), plural.Slice(res.CommitIDs, "s"))
+	}
+	return nil
+}
@@ -50,13 +50,16 @@ type Command struct {
 	brimfd          int
 	listenAddr      string
 	manage          time.Duration
+	manageConfig    dbmanage.Config
 	portFile        string
+	retain          time.Duration
 	rootContentFile string
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*db.Command)}
 	c.conf.Auth.SetFlags(f)
+	c.conf.ResultCache.SetFlags(f)
 	c.conf.Version = cli.Version()
 	c.logflags.SetFlags(f)
 	f.IntVar(&c.brimfd, "brimfd", -1, "pipe read fd passed by Zui to signal Zui closure")
@@ -67,7 +70,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.conf.DefaultResponseFormat, "defaultfmt", service.DefaultFormat, "default response format")
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
 	f.DurationVar(&c.manage, "manage", 0, "when positive, run database maintenance tasks at this interval")
+	f.Func("manage.config", "path of manage YAML config file (with -manage)", c.manageConfig.Load)
 	f.StringVar(&c.portFile, "portfile", "", "write listen port to file")
+	f.DurationVar(&c.retain, "retain", 0, "when positive, enforce pool retention policies at this interval")
 	f.StringVar(&c.rootContentFile, "rootcontentfile", "", "file to serve for GET /")
 	return c, nil
 }
@@ -117,8 +122,17 @@ func (c *Command) Run(args []string) error {
 	group, ctx := errgroup.WithContext(ctx)
 	if c.manage > 0 {
 		conn := client.NewConnectionTo("http://" + srv.Addr())
+		conf := c.manageConfig
+		conf.Interval = &c.manage
+		conf.Publish = core.Publish
 		group.Go(func() error {
-			return dbmanage.Monitor(ctx, conn, dbmanage.Config{Interval: &c.manage}, logger.Named("manage"))
+			return dbmanage.Monitor(ctx, conn, conf, logger.Named("manage"))
+		})
+	}
+	if c.retain > 0 {
+		conn := client.NewConnectionTo("http://" + srv.Addr())
+		group.Go(func() error {
+			return dbmanage.MonitorRetention(ctx, conn, c.retain, logger.Named("retain"))
 		})
 	}
 	if c.portFile != "" {
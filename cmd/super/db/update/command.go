@@ -0,0 +1,107 @@
+package update
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/commitflags"
+	"github.com/brimdata/super/cli/dbflags"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/segmentio/ksuid"
+)
+
+var spec = &charm.Spec{
+	Name:  "update",
+	Usage: "update -where predicate assignment[,assignment...] | update merge-into-statement",
+	Short: "update rows in a pool branch",
+	Long: `
+See https://superdb.org/command/db.html#super-db-update
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	commitFlags commitflags.Flags
+	poolFlags   poolflags.Flags
+	where       string
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.commitFlags.SetFlags(f)
+	c.poolFlags.SetFlags(f)
+	f.StringVar(&c.where, "where", "", "update rows matching predicate")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		return errors.New("no update specified")
+	}
+	if len(args) > 1 {
+		return errors.New("too many arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	var commit ksuid.KSUID
+	if c.where != "" {
+		head, err := c.poolFlags.HEAD()
+		if err != nil {
+			return err
+		}
+		if head.Pool == "" {
+			return dbflags.ErrNoHEAD
+		}
+		poolID, err := db.PoolID(ctx, head.Pool)
+		if err != nil {
+			return err
+		}
+		commit, err = db.UpdateWhere(ctx, poolID, head.Branch, c.where, args[0], c.commitFlags.CommitMessage())
+		if err != nil {
+			return err
+		}
+	} else {
+		ast, err := parser.ParseText(args[0])
+		if err != nil {
+			return err
+		}
+		poolName, branchName, err := ast.MergeTarget()
+		if errors.Is(err, parser.ErrNotMergeInto) {
+			return errors.New("update must be a MERGE INTO statement when -where is not specified")
+		}
+		if err != nil {
+			return err
+		}
+		if branchName == "" {
+			branchName = "main"
+		}
+		poolID, err := db.PoolID(ctx, poolName)
+		if err != nil {
+			return err
+		}
+		commit, err = db.MergeInto(ctx, poolID, branchName, args[0], c.commitFlags.CommitMessage())
+		if err != nil {
+			return err
+		}
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("%s update committed\n", commit)
+	}
+	return nil
+}
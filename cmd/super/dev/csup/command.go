@@ -395,6 +395,27 @@ func decodeTypeDef(slot uint32, bytes []byte) ([]byte, any) {
 			Slot:  slot,
 			Names: names,
 		}
+	case super.TypeDefDecimal:
+		var precision, scale int
+		precision, bytes = super.DecodeLength(bytes)
+		if bytes == nil {
+			return nil, errInfo(slot, "TypeDefDecimal", "at precision field")
+		}
+		scale, bytes = super.DecodeLength(bytes)
+		if bytes == nil {
+			return nil, errInfo(slot, "TypeDefDecimal", "at scale field")
+		}
+		out = &struct {
+			Kind      string
+			Slot      uint32
+			Precision int
+			Scale     int
+		}{
+			Kind:      "TypeDefDecimal",
+			Slot:      slot,
+			Precision: precision,
+			Scale:     scale,
+		}
 	default:
 		out = &struct {
 			Kind string
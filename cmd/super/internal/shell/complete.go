@@ -0,0 +1,151 @@
+package shell
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+var keywords = []string{
+	// Pipe operators
+	"aggregate", "assert", "blend", "count", "cut", "debug", "drop", "fork",
+	"from", "fuse", "head", "infer", "join", "load", "pass", "put", "rename",
+	"search", "shapes", "skip", "sort", "switch", "tail", "top", "uniq",
+	"unnest", "values", "where",
+	// Declarations
+	"const", "fn", "let", "op", "pragma", "type",
+	// SQL
+	"as", "asc", "by", "cross", "desc", "distinct", "except", "full", "group",
+	"having", "inner", "intersect", "left", "limit", "offset", "on", "order",
+	"outer", "right", "select", "union", "using", "with",
+	// Expressions
+	"and", "case", "else", "end", "exists", "false", "in", "is", "like",
+	"not", "null", "or", "then", "this", "true", "when",
+}
+
+var functions = []string{
+	"abs", "at_time_zone", "base64", "bucket", "cast", "ceil", "cidr_match",
+	"coalesce", "compare", "concat", "date_part", "date_trunc", "defuse",
+	"downcast", "error", "fields", "flatten", "floor", "fusion", "greatest",
+	"grep", "grok", "has", "has_error", "hash", "hex", "hll_estimate",
+	"hmac_md5", "hmac_sha1", "hmac_sha256", "hmac_sha512", "is_error", "kind",
+	"ksuid", "least", "len", "length", "levenshtein", "log", "lower", "map",
+	"md5", "missing", "murmur3_32", "nameof", "nest_dotted", "network_of",
+	"now", "nullif", "parse_sup", "parse_uri", "position", "pow", "regexp",
+	"regexp_replace", "replace", "round", "sha1", "sha256", "sha512", "split",
+	"sqrt", "strftime", "strptime", "substring", "tdigest_quantile",
+	"theta_difference", "theta_estimate", "theta_intersect", "theta_union",
+	"time_bucket", "trim", "typename", "typeof", "unblend", "under",
+	"unflatten", "upcast", "upper", "xxhash64",
+	// Aggregate functions
+	"any", "approx_quantile", "array_agg", "avg", "collect", "collect_map",
+	"corr", "covar_pop", "covar_samp", "dcount", "hll_merge", "hll_sketch",
+	"max", "median", "min", "percentile_cont", "percentile_disc",
+	"regr_avgx", "regr_avgy", "regr_count", "regr_intercept", "regr_r2",
+	"regr_slope", "regr_sxx", "regr_sxy", "regr_syy", "stddev", "stddev_pop",
+	"stddev_samp", "sum", "tdigest_merge", "tdigest_sketch", "theta_merge",
+	"theta_sketch", "var_pop", "var_samp", "variance",
+}
+
+var commands = []string{".decls", ".exit", ".format", ".help", ".quit", ".reset", ".timing"}
+
+// Complete implements repl.Completer.  It completes the word before the
+// cursor with operator and function names, keywords, and the names of fields
+// seen in the input and in query results.
+func (s *Shell) Complete(line string, pos int) (string, []string, string) {
+	runes := []rune(line)
+	pos = min(max(pos, 0), len(runes))
+	start := pos
+	for start > 0 && isWordRune(runes[start-1]) {
+		start--
+	}
+	head, word, tail := string(runes[:start]), string(runes[start:pos]), string(runes[pos:])
+	if len(s.pending) == 0 && strings.TrimSpace(head) == "." {
+		return strings.TrimSuffix(head, "."), matches(commands, "."+word), tail
+	}
+	if word == "" {
+		return head, nil, tail
+	}
+	var candidates []string
+	candidates = append(candidates, matches(keywords, word)...)
+	candidates = append(candidates, matches(functions, word)...)
+	for name := range s.fields {
+		if strings.HasPrefix(name, word) {
+			candidates = append(candidates, name)
+		}
+	}
+	slices.Sort(candidates)
+	return head, slices.Compact(candidates), tail
+}
+
+func matches(words []string, prefix string) []string {
+	var out []string
+	for _, w := range words {
+		if strings.HasPrefix(w, prefix) {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
+
+// fieldSet holds the field names of the record types seen by a Shell.
+type fieldSet map[string]struct{}
+
+func (f fieldSet) addVector(vec vector.Any) {
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for _, vec := range d.Values {
+			if vec != nil {
+				f.addVector(vec)
+			}
+		}
+		return
+	}
+	f.addType(vec.Type())
+}
+
+func (f fieldSet) addType(typ super.Type) {
+	switch typ := super.TypeUnder(typ).(type) {
+	case *super.TypeRecord:
+		for _, field := range typ.Fields {
+			if sup.IsIdentifier(field.Name) && !strings.HasPrefix(field.Name, "$") {
+				f[field.Name] = struct{}{}
+			}
+			f.addType(field.Type)
+		}
+	case *super.TypeArray:
+		f.addType(typ.Type)
+	case *super.TypeSet:
+		f.addType(typ.Type)
+	case *super.TypeMap:
+		f.addType(typ.KeyType)
+		f.addType(typ.ValType)
+	case *super.TypeUnion:
+		for _, typ := range typ.Types {
+			f.addType(typ)
+		}
+	case *super.TypeError:
+		f.addType(typ.Type)
+	case *super.TypeFusion:
+		f.addType(typ.Type)
+	}
+}
+
+// fieldPusher adds the field names of the vectors it pushes to a fieldSet.
+type fieldPusher struct {
+	vio.Pusher
+	fields fieldSet
+}
+
+func (f *fieldPusher) Push(vec vector.Any) error {
+	f.fields.addVector(vec)
+	return f.Pusher.Push(vec)
+}
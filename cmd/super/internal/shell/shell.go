@@ -0,0 +1,326 @@
+// Package shell implements the interactive query sessions of super repl
+// and super db repl.
+package shell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/supio"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+// A Runner compiles and runs query text with the query parameters bound to
+// the SUP values in params.
+type Runner func(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (vio.Scanner, error)
+
+// Shell is a repl.Consumer that runs each statement it consumes as a query
+// and writes the results to standard output.  Declarations persist for the
+// life of the shell and are in scope for every subsequent query.
+type Shell struct {
+	ctx     context.Context
+	run     Runner
+	output  *outputflags.Flags
+	params  map[string]string
+	decls   []decl
+	fields  fieldSet
+	pending []string
+	timing  bool
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+type decl struct {
+	names []string
+	text  string
+}
+
+func New(ctx context.Context, run Runner, output *outputflags.Flags, params map[string]string) *Shell {
+	return &Shell{
+		ctx:    ctx,
+		run:    run,
+		output: output,
+		params: params,
+		fields: make(fieldSet),
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+}
+
+// AddFields makes the field names in the types of vec available for tab
+// completion.
+func (s *Shell) AddFields(vec vector.Any) {
+	s.fields.addVector(vec)
+}
+
+func (s *Shell) Prompt() string {
+	if len(s.pending) > 0 {
+		return "   ...> "
+	}
+	return "super> "
+}
+
+func (s *Shell) HistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".super_history")
+}
+
+// Consume accumulates lines until they form a complete statement, then runs
+// the statement.  A statement continues onto the next line while it has
+// unbalanced brackets or ends with a pipe symbol or backslash.  An empty line
+// completes a pending statement.  Consume returns true when the session
+// should end.
+func (s *Shell) Consume(line string) bool {
+	if len(s.pending) == 0 && strings.HasPrefix(strings.TrimSpace(line), ".") {
+		return s.command(strings.Fields(line))
+	}
+	line = strings.TrimRightFunc(line, isSpace)
+	line, cont := strings.CutSuffix(line, `\`)
+	s.pending = append(s.pending, line)
+	text := strings.Join(s.pending, "\n")
+	if cont || line != "" && !isComplete(text) {
+		return false
+	}
+	s.pending = nil
+	s.Exec(text)
+	return false
+}
+
+// Exec runs the statement in text, which may span multiple lines.
+func (s *Shell) Exec(text string) {
+	if text = strings.TrimSuffix(strings.TrimSpace(text), ";"); text != "" {
+		s.statement(text)
+	}
+}
+
+func (s *Shell) statement(text string) {
+	if decls, ok := parseDecls(text); ok {
+		s.declare(decls, text)
+		return
+	}
+	s.query(text)
+}
+
+// parseDecls returns the declarations in text if text consists entirely of
+// declarations.
+func parseDecls(text string) ([]ast.Decl, bool) {
+	p, err := parser.ParseText(text + "\npass")
+	if err != nil {
+		return nil, false
+	}
+	seq := p.Parsed()
+	if len(seq) != 1 {
+		return nil, false
+	}
+	scope, ok := seq[0].(*ast.ScopeOp)
+	if !ok || len(scope.Decls) == 0 || len(scope.Body) != 1 {
+		return nil, false
+	}
+	if _, ok := scope.Body[0].(*ast.PassOp); !ok {
+		return nil, false
+	}
+	return scope.Decls, true
+}
+
+// declare adds the declarations in text to the session, replacing any
+// previous statement that declared one of the same names.
+func (s *Shell) declare(decls []ast.Decl, text string) {
+	names := make([]string, 0, len(decls))
+	for _, d := range decls {
+		names = append(names, declName(d))
+	}
+	s.decls = slices.DeleteFunc(s.decls, func(d decl) bool {
+		return slices.ContainsFunc(d.names, func(name string) bool {
+			return slices.Contains(names, name)
+		})
+	})
+	s.decls = append(s.decls, decl{names: names, text: text})
+}
+
+func declName(d ast.Decl) string {
+	switch d := d.(type) {
+	case *ast.ConstDecl:
+		return d.Name.Name
+	case *ast.FuncDecl:
+		return d.Name.Name
+	case *ast.OpDecl:
+		return d.Name.Name
+	case *ast.PragmaDecl:
+		return d.Name.Name
+	case *ast.QueryDecl:
+		return d.Name.Name
+	case *ast.TypeDecl:
+		return d.Name.Name
+	}
+	return ""
+}
+
+func (s *Shell) query(text string) {
+	// An interrupt cancels the running query but not the session.
+	ctx, cancel := signal.NotifyContext(s.ctx, os.Interrupt)
+	defer cancel()
+	var inputs []srcfiles.Input
+	for _, d := range s.decls {
+		inputs = append(inputs, &srcfiles.PlainInput{Text: d.text})
+	}
+	inputs = append(inputs, &srcfiles.PlainInput{Text: text})
+	start := time.Now()
+	q, err := s.run(ctx, inputs, s.params)
+	if err != nil {
+		s.error(err)
+		return
+	}
+	defer q.Pull(true)
+	w, err := s.output.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		s.error(err)
+		return
+	}
+	out := map[string]vio.Pusher{
+		"main":  &fieldPusher{w, s.fields},
+		"debug": supio.NewWriter(sio.NopCloser(s.stderr), supio.WriterOpts{}),
+	}
+	err = vio.CopyMux(out, q)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if ctx.Err() != nil && s.ctx.Err() == nil {
+			err = errors.New("query interrupted")
+		}
+		s.error(err)
+		return
+	}
+	if s.timing {
+		fmt.Fprintf(s.stderr, "time: %s\n", time.Since(start).Round(time.Microsecond))
+	}
+}
+
+func (s *Shell) error(err error) {
+	fmt.Fprintln(s.stderr, strings.TrimRight(err.Error(), "\n"))
+}
+
+const help = `Enter a query to run it or a declaration (const, fn, op, type, ...) to
+define it for the rest of the session.  A query continues onto the next line
+while it has unbalanced brackets or ends with | or \.
+
+Commands:
+  .decls             list the session's declarations
+  .format [format]   show or set the output format
+  .help              show this message
+  .quit              end the session (also .exit or end of input)
+  .reset             forget the session's declarations
+  .timing [on|off]   show or set display of query run time
+`
+
+func (s *Shell) command(args []string) bool {
+	switch cmd, args := args[0], args[1:]; cmd {
+	case ".decls":
+		for _, d := range s.decls {
+			fmt.Fprintln(s.stdout, d.text)
+		}
+	case ".exit", ".quit":
+		return true
+	case ".format":
+		if len(args) == 0 {
+			fmt.Fprintln(s.stdout, s.output.Format)
+			break
+		}
+		s.output.Format = args[0]
+	case ".help":
+		fmt.Fprint(s.stdout, help)
+	case ".reset":
+		s.decls = nil
+	case ".timing":
+		if len(args) == 0 {
+			fmt.Fprintln(s.stdout, onOff(s.timing))
+			break
+		}
+		switch args[0] {
+		case "on":
+			s.timing = true
+		case "off":
+			s.timing = false
+		default:
+			s.error(fmt.Errorf(".timing: argument must be on or off: %q", args[0]))
+		}
+	default:
+		s.error(fmt.Errorf("unknown command %s (enter .help for a list of commands)", cmd))
+	}
+	return false
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+// isComplete returns false if text has unbalanced brackets outside of string
+// literals and comments or ends with a pipe symbol.
+func isComplete(text string) bool {
+	var depth int
+	var quote rune
+	var escaped, lineComment, blockComment bool
+	runes := []rune(text)
+	for k := 0; k < len(runes); k++ {
+		c := runes[k]
+		switch {
+		case lineComment:
+			lineComment = c != '\n'
+		case blockComment:
+			if c == '*' && k+1 < len(runes) && runes[k+1] == '/' {
+				blockComment = false
+				k++
+			}
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == quote:
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '-' && k+1 < len(runes) && runes[k+1] == '-':
+			lineComment = true
+		case c == '/' && k+1 < len(runes) && runes[k+1] == '*':
+			blockComment = true
+			k++
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		}
+	}
+	if depth > 0 || quote != 0 || blockComment {
+		return false
+	}
+	text = strings.TrimSpace(text)
+	return !strings.HasSuffix(text, "|") && !strings.HasSuffix(text, "|>")
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r'
+}
@@ -12,15 +12,21 @@ import (
 	_ "github.com/brimdata/super/cmd/super/db/create"
 	_ "github.com/brimdata/super/cmd/super/db/delete"
 	_ "github.com/brimdata/super/cmd/super/db/drop"
+	_ "github.com/brimdata/super/cmd/super/db/index"
 	_ "github.com/brimdata/super/cmd/super/db/init"
+	_ "github.com/brimdata/super/cmd/super/db/kill"
 	_ "github.com/brimdata/super/cmd/super/db/load"
 	_ "github.com/brimdata/super/cmd/super/db/log"
 	_ "github.com/brimdata/super/cmd/super/db/ls"
 	_ "github.com/brimdata/super/cmd/super/db/manage"
 	_ "github.com/brimdata/super/cmd/super/db/merge"
+	_ "github.com/brimdata/super/cmd/super/db/ps"
 	_ "github.com/brimdata/super/cmd/super/db/rename"
+	_ "github.com/brimdata/super/cmd/super/db/repl"
+	_ "github.com/brimdata/super/cmd/super/db/retain"
 	_ "github.com/brimdata/super/cmd/super/db/revert"
 	_ "github.com/brimdata/super/cmd/super/db/serve"
+	_ "github.com/brimdata/super/cmd/super/db/update"
 	_ "github.com/brimdata/super/cmd/super/db/use"
 	_ "github.com/brimdata/super/cmd/super/db/vacate"
 	_ "github.com/brimdata/super/cmd/super/db/vacuum"
@@ -33,6 +39,7 @@ import (
 	_ "github.com/brimdata/super/cmd/super/dev/vector/copy"
 	_ "github.com/brimdata/super/cmd/super/dev/vector/project"
 	_ "github.com/brimdata/super/cmd/super/dev/vector/search"
+	_ "github.com/brimdata/super/cmd/super/repl"
 	"github.com/brimdata/super/cmd/super/root"
 )
 
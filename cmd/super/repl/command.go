@@ -0,0 +1,133 @@
+package repl
+
+import (
+	"context"
+	"flag"
+	"syscall"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/cli"
+	"github.com/brimdata/super/cli/inputflags"
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cli/queryflags"
+	"github.com/brimdata/super/cli/runtimeflags"
+	"github.com/brimdata/super/cmd/super/internal/shell"
+	"github.com/brimdata/super/cmd/super/root"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/repl"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+var spec = &charm.Spec{
+	Name:  "repl",
+	Usage: "repl [ options ] [ file ... ]",
+	Short: "run SuperSQL queries interactively",
+	Long: `
+See https://superdb.org/command/repl.html
+`,
+	New: New,
+}
+
+func init() {
+	root.Super.Add(spec)
+}
+
+type Command struct {
+	*root.Command
+	stopErr      bool
+	inputFlags   inputflags.Flags
+	outputFlags  outputflags.Flags
+	queryFlags   queryflags.QueryTextFlags
+	runtimeFlags runtimeflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	c.outputFlags.DefaultFormat = "sup"
+	c.outputFlags.SetFlags(f)
+	c.inputFlags.SetFlags(f, false)
+	c.queryFlags.SetFlags(f)
+	c.runtimeFlags.SetFlags(f)
+	f.BoolVar(&c.stopErr, "e", true, "stop upon input errors")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	// SIGINT interrupts a running query rather than ending the session.
+	ctx, cleanup, err := c.InitWithSignals([]cli.Initializer{&c.inputFlags, &c.outputFlags, &c.runtimeFlags}, syscall.SIGPIPE, syscall.SIGTERM)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	env := exec.NewEnvironment(storage.NewLocalEngine(), nil)
+	env.Dynamic = c.inputFlags.Dynamic
+	env.IgnoreOpenErrors = !c.stopErr
+	env.ReaderOpts = c.inputFlags.ReaderOpts
+	env.SampleSize = c.inputFlags.SampleSize
+	comp := compiler.NewCompilerWithEnv(env)
+	// All queries share a type context so they can read the input, which
+	// is read once into memory when the session starts.
+	sctx := super.NewContext()
+	input, err := load(ctx, sctx, comp, args)
+	if err != nil {
+		return err
+	}
+	run := func(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (vio.Scanner, error) {
+		ast, err := parser.ParseFiles(inputs)
+		if err != nil {
+			return nil, err
+		}
+		ast.SetParams(params)
+		var readers []vio.Puller
+		if len(args) > 0 {
+			readers = []vio.Puller{vio.NewPuller(input...)}
+		}
+		return runtime.CompileQuery(ctx, sctx, comp, ast, readers)
+	}
+	sh := shell.New(ctx, run, &c.outputFlags, c.queryFlags.Params)
+	for _, vec := range input {
+		sh.AddFields(vec)
+	}
+	for _, q := range c.queryFlags.Query {
+		text, err := q.Load()
+		if err != nil {
+			return err
+		}
+		sh.Exec(text)
+	}
+	return repl.Run(sh)
+}
+
+// load reads the files in paths into memory.
+func load(ctx context.Context, sctx *super.Context, comp runtime.Compiler, paths []string) ([]vector.Any, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	ast, err := parser.ParseText("pass")
+	if err != nil {
+		return nil, err
+	}
+	ast.PrependFileScan(paths)
+	q, err := runtime.CompileQuery(ctx, sctx, comp, ast, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Pull(true)
+	var vecs []vector.Any
+	for {
+		vec, err := q.Pull(false)
+		if vec == nil || err != nil {
+			return vecs, err
+		}
+		if vec, _ = vector.Unlabel(vec); vec != nil {
+			vecs = append(vecs, vec)
+		}
+	}
+}
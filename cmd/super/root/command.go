@@ -79,6 +79,7 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	ast.SetParams(c.queryFlags.Params)
 	if c.canon {
 		fmt.Println(sfmt.AST(ast.Parsed()))
 		return nil
@@ -133,6 +133,13 @@ type (
 		Entries []MapEntry `json:"entries"`
 		Loc     `json:"loc"`
 	}
+	// A ParamExpr is a reference to a query parameter, which is bound to a
+	// value when the query is compiled.
+	ParamExpr struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+		Loc  `json:"loc"`
+	}
 	RecordExpr struct {
 		Kind  string       `json:"kind" unpack:""`
 		Elems []RecordElem `json:"elems"`
@@ -196,6 +203,14 @@ type (
 		Operand Expr   `json:"operand"`
 		Loc     `json:"loc"`
 	}
+	// A WindowExpr is a SQL window function call, i.e., a function call
+	// (a *CallExpr or *AggFuncExpr) followed by an OVER clause.
+	WindowExpr struct {
+		Kind string      `json:"kind" unpack:""`
+		Func Expr        `json:"func"`
+		Over *WindowSpec `json:"over"`
+		Loc  `json:"loc"`
+	}
 )
 
 // Support structures embedded in Expr nodes
@@ -301,6 +316,7 @@ func (*IndexExpr) exprNode()       {}
 func (*IsNullExpr) exprNode()      {}
 func (*LambdaExpr) exprNode()      {}
 func (*MapExpr) exprNode()         {}
+func (*ParamExpr) exprNode()       {}
 func (*Primitive) exprNode()       {}
 func (*RecordExpr) exprNode()      {}
 func (*RegexpExpr) exprNode()      {}
@@ -314,3 +330,4 @@ func (*TypeValue) exprNode()       {}
 func (*UnaryExpr) exprNode()       {}
 func (*SubqueryExpr) exprNode()    {}
 func (*SubstringExpr) exprNode()   {}
+func (*WindowExpr) exprNode()      {}
@@ -127,6 +127,7 @@ type (
 		RightInput Seq        `json:"right_input"`
 		Alias      *JoinAlias `json:"alias"`
 		Cond       JoinCond   `json:"cond"`
+		Tolerance  Expr       `json:"tolerance"`
 		Loc        `json:"loc"`
 	}
 	LoadOp struct {
@@ -17,13 +17,15 @@ type (
 		Loc     `json:"loc"`
 	}
 	SQLSelect struct {
-		Kind      string       `json:"kind" unpack:""`
-		Distinct  bool         `json:"distinct"`
-		Selection SQLSelection `json:"selection"`
-		From      SQLTableExpr `json:"from"`
-		Where     Expr         `json:"where"`
-		GroupBy   []Expr       `json:"group_by"`
-		Having    Expr         `json:"having"`
+		Kind      string         `json:"kind" unpack:""`
+		Distinct  bool           `json:"distinct"`
+		Selection SQLSelection   `json:"selection"`
+		From      SQLTableExpr   `json:"from"`
+		Where     Expr           `json:"where"`
+		GroupBy   []Expr         `json:"group_by"`
+		Having    Expr           `json:"having"`
+		Qualify   Expr           `json:"qualify"`
+		Windows   []SQLWindowDef `json:"windows"`
 		Loc       `json:"loc"`
 	}
 	SQLUnion struct {
@@ -33,6 +35,15 @@ type (
 		Right    SQLQueryBody `json:"right"`
 		Loc      `json:"loc"`
 	}
+	// SQLSetOp is an INTERSECT or EXCEPT set operation.
+	SQLSetOp struct {
+		Kind     string       `json:"kind" unpack:""`
+		Op       string       `json:"op"` // "intersect" or "except"
+		Distinct bool         `json:"distinct"`
+		Left     SQLQueryBody `json:"left"`
+		Right    SQLQueryBody `json:"right"`
+		Loc      `json:"loc"`
+	}
 	SQLValues struct {
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
@@ -40,9 +51,37 @@ type (
 	}
 )
 
+// SQLMerge is a MERGE INTO statement, which updates, deletes, and inserts
+// rows of a database pool according to how they match the rows of a source
+// table.  It is not a query but is executed by "super db update".
+type SQLMerge struct {
+	Kind    string            `json:"kind" unpack:""`
+	Target  *FromItem         `json:"target"`
+	Alias   *TableAlias       `json:"alias"`
+	Source  SQLTableExpr      `json:"source"`
+	Cond    Expr              `json:"cond"`
+	Clauses []*SQLMergeClause `json:"clauses"`
+	Loc     `json:"loc"`
+}
+
+// SQLMergeClause is a WHEN [NOT] MATCHED clause of a MERGE INTO statement.
+// Action is "update", "delete", or "insert".  Columns and Values hold the
+// SET assignments of an update and the column list and row of an insert.
+type SQLMergeClause struct {
+	Matched bool   `json:"matched"`
+	Cond    Expr   `json:"cond"`
+	Action  string `json:"action"`
+	Columns []*ID  `json:"columns"`
+	Values  []Expr `json:"values"`
+	Loc     `json:"loc"`
+}
+
+func (*SQLMerge) opNode() {}
+
 func (*SQLQuery) sqlQueryBodyNode()  {}
 func (*SQLSelect) sqlQueryBodyNode() {}
 func (*SQLUnion) sqlQueryBodyNode()  {}
+func (*SQLSetOp) sqlQueryBodyNode()  {}
 func (*SQLValues) sqlQueryBodyNode() {}
 
 // Structure used by instances of SQLQueryBody
@@ -67,6 +106,11 @@ type (
 		Args []SQLAsExpr `json:"args"`
 		Loc  `json:"loc"`
 	}
+	SQLWindowDef struct {
+		Name *ID         `json:"name"`
+		Spec *WindowSpec `json:"spec"`
+		Loc  `json:"loc"`
+	}
 	SQLWith struct {
 		Recursive bool     `json:"recursive"`
 		CTEs      []SQLCTE `json:"ctes"`
@@ -74,6 +118,36 @@ type (
 	}
 )
 
+// Window specifications used by window functions and the WINDOW clause
+
+type (
+	// A WindowSpec describes the partitioning, ordering, and framing of a
+	// window.  Name, when present, refers to a window defined in the
+	// WINDOW clause whose specification is extended by this one.
+	WindowSpec struct {
+		Name        *ID          `json:"name"`
+		PartitionBy []Expr       `json:"partition_by"`
+		OrderBy     []SortExpr   `json:"order_by"`
+		Frame       *WindowFrame `json:"frame"`
+		Loc         `json:"loc"`
+	}
+	// Unit is "rows" or "range".
+	WindowFrame struct {
+		Unit string      `json:"unit"`
+		From WindowBound `json:"from"`
+		To   WindowBound `json:"to"`
+		Loc  `json:"loc"`
+	}
+	// Type is one of "unbounded_preceding", "preceding", "current_row",
+	// "following", or "unbounded_following".  Offset is set for
+	// "preceding" and "following".
+	WindowBound struct {
+		Type   string `json:"type"`
+		Offset Expr   `json:"offset"`
+		Loc    `json:"loc"`
+	}
+)
+
 // SQLTableInput is a table expression that can be aliased with an AS clause.
 type SQLTableInput interface {
 	Node
@@ -109,12 +183,13 @@ type (
 	// expression.  This differs from a pipeline Join where the left input data comes
 	// from the parent.
 	SQLJoin struct {
-		Kind  string       `json:"kind" unpack:""`
-		Style string       `json:"style"`
-		Left  SQLTableExpr `json:"left"`
-		Right SQLTableExpr `json:"right"`
-		Cond  JoinCond     `json:"cond"`
-		Loc   `json:"loc"`
+		Kind      string       `json:"kind" unpack:""`
+		Style     string       `json:"style"`
+		Left      SQLTableExpr `json:"left"`
+		Right     SQLTableExpr `json:"right"`
+		Cond      JoinCond     `json:"cond"`
+		Tolerance Expr         `json:"tolerance"`
+		Loc       `json:"loc"`
 	}
 	// SQLPipe turns a Seq into an SQLQueryBody.  This allows us to put pipe queries inside
 	// of SQL.  The parser also uses this structure to embed a single SQLOp inside a SQLPipe
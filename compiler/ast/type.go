@@ -42,6 +42,12 @@ type (
 		Symbols []*Text `json:"symbols"`
 		Loc     `json:"loc"`
 	}
+	TypeDecimal struct {
+		Kind      string `json:"kind" unpack:""`
+		Precision int    `json:"precision"`
+		Scale     int    `json:"scale"`
+		Loc       `json:"loc"`
+	}
 	TypeMap struct {
 		Kind    string `json:"kind" unpack:""`
 		KeyType Type   `json:"key_type"`
@@ -71,6 +77,7 @@ func (*TypeArray) typeNode()     {}
 func (*TypeSet) typeNode()       {}
 func (*TypeUnion) typeNode()     {}
 func (*TypeEnum) typeNode()      {}
+func (*TypeDecimal) typeNode()   {}
 func (*TypeMap) typeNode()       {}
 func (*TypeError) typeNode()     {}
 func (*TypeFusion) typeNode()    {}
@@ -16,6 +16,7 @@ var unpacker = unpack.New(
 	ArrayExpr{},
 	AssertOp{},
 	AssignmentOp{},
+	BetweenExpr{},
 	BinaryExpr{},
 	CallExpr{},
 	CallOp{},
@@ -30,11 +31,13 @@ var unpacker = unpack.New(
 	DeclsValue{},
 	Decorated{},
 	Delete{},
+	DistinctOp{},
 	DoubleQuoteExpr{},
 	DropOp{},
 	ExprElem{},
 	ExtractExpr{},
 	Error{},
+	ExistsExpr{},
 	ExprOp{},
 	FieldElem{},
 	FileScan{},
@@ -59,7 +62,10 @@ var unpacker = unpack.New(
 	LoadOp{},
 	Map{},
 	MapExpr{},
+	ParamExpr{},
 	MergeOp{},
+	None{},
+	NoneElem{},
 	OpDecl{},
 	OutputOp{},
 	PassOp{},
@@ -80,15 +86,20 @@ var unpacker = unpack.New(
 	SliceExpr{},
 	SortOp{},
 	SpreadElem{},
+	StarExpr{},
+	SubqueryExpr{},
 	SubstringExpr{},
 	SwitchOp{},
 	TailOp{},
 	Text{},
 	TopOp{},
+	TupleExpr{},
 	TypeArray{},
 	TypeDecl{},
 	TypeEnum{},
+	TypeDecimal{},
 	TypeError{},
+	TypeFusion{},
 	TypeMap{},
 	TypePrimitive{},
 	TypeRecord{},
@@ -101,15 +112,21 @@ var unpacker = unpack.New(
 	UnnestOp{},
 	ValuesOp{},
 	WhereOp{},
+	WindowExpr{},
 	DBMeta{},
 	// SuperSQL
 	SQLFromItem{},
+	SQLOp{},
 	SQLPipe{},
+	SQLQuery{},
 	SQLSelect{},
 	SQLCrossJoin{},
 	SQLJoin{},
 	SQLTimeExpr{},
 	SQLUnion{},
+	SQLSetOp{},
+	SQLValues{},
+	SQLMerge{},
 	JoinOnCond{},
 	JoinUsingCond{},
 )
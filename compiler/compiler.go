@@ -1,6 +1,8 @@
 package compiler
 
 import (
+	"errors"
+	"fmt"
 	goruntime "runtime"
 
 	"github.com/brimdata/super/compiler/dag"
@@ -16,6 +18,11 @@ import (
 
 var Parallelism = goruntime.GOMAXPROCS(0) //XXX
 
+// ErrRestrictedPool is returned for a query that would modify a pool whose
+// values the environment restricts.  Such a query could neither see nor
+// should it alter the values hidden by the restriction.
+var ErrRestrictedPool = errors.New("pool is restricted")
+
 type compiler struct {
 	env *exec.Environment
 }
@@ -50,6 +57,9 @@ func (l *compiler) NewDeleteQuery(rctx *runtime.Context, ast *parser.AST, head *
 	if len(seq) != 2 {
 		return nil, &InvalidDeleteWhereQuery{}
 	}
+	if err := l.checkUnrestricted(rctx, head.Pool); err != nil {
+		return nil, err
+	}
 	main, err := Analyze(rctx, ast, l.env, false)
 	if err != nil {
 		return nil, err
@@ -67,8 +77,96 @@ func (l *compiler) NewDeleteQuery(rctx *runtime.Context, ast *parser.AST, head *
 	return exec.NewDeleteQuery(rctx, bundleOutputs(rctx, outputs, debugs), b.Deletes()), nil
 }
 
+func (c *compiler) NewUpdateQuery(rctx *runtime.Context, where, set *parser.AST, head *dbid.Commitish) (runtime.Query, error) {
+	if len(where.Parsed()) != 1 || len(set.Parsed()) != 1 {
+		return nil, &InvalidUpdateQuery{}
+	}
+	if err := c.checkUnrestricted(rctx, head.Pool); err != nil {
+		return nil, err
+	}
+	main, err := Analyze(rctx, set.UpdateWhereQuery(where, head.Pool, head.Branch), c.env, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(main.Body) != 4 {
+		return nil, &InvalidUpdateQuery{}
+	}
+	if _, ok := main.Body[1].(*dag.FilterOp); !ok {
+		return nil, &InvalidUpdateQuery{}
+	}
+	if _, ok := main.Body[2].(*dag.PutOp); !ok {
+		return nil, &InvalidUpdateQuery{}
+	}
+	if err := Optimize(rctx, main, c.env, Parallelism); err != nil {
+		return nil, err
+	}
+	outputs, debugs, meter, err := Build(rctx, main, c.env)
+	if err != nil {
+		return nil, err
+	}
+	return exec.NewQuery(rctx, bundleOutputs(rctx, outputs, debugs), meter), nil
+}
+
+func (c *compiler) NewMergeQuery(rctx *runtime.Context, ast *parser.AST, head *dbid.Commitish) (runtime.Query, error) {
+	target, _, err := ast.MergeTarget()
+	if err != nil {
+		if errors.Is(err, parser.ErrNotMergeInto) {
+			return nil, &InvalidMergeQuery{}
+		}
+		return nil, err
+	}
+	targetID, err := c.env.PoolID(rctx, target)
+	if err != nil {
+		return nil, err
+	}
+	poolID, err := c.env.PoolID(rctx, head.Pool)
+	if err != nil {
+		return nil, err
+	}
+	if targetID != poolID {
+		return nil, fmt.Errorf("MERGE INTO target %q does not match pool %q", target, head.Pool)
+	}
+	if err := c.checkUnrestricted(rctx, head.Pool); err != nil {
+		return nil, err
+	}
+	query, err := ast.MergeIntoQuery(head.Pool, head.Branch)
+	if err != nil {
+		return nil, err
+	}
+	return CompileWithAST(rctx, query, c.env, true, Parallelism, nil)
+}
+
+// checkUnrestricted returns an error wrapping ErrRestrictedPool if the
+// environment restricts the values of pool.
+func (c *compiler) checkUnrestricted(rctx *runtime.Context, pool string) error {
+	id, err := c.env.PoolID(rctx, pool)
+	if err != nil {
+		return err
+	}
+	r, err := c.env.PoolRestriction(rctx, id)
+	if err != nil {
+		return err
+	}
+	if r != nil {
+		return fmt.Errorf("%w: cannot modify pool %q", ErrRestrictedPool, pool)
+	}
+	return nil
+}
+
 type InvalidDeleteWhereQuery struct{}
 
 func (InvalidDeleteWhereQuery) Error() string {
 	return "invalid delete where query: must be a single filter operation"
 }
+
+type InvalidUpdateQuery struct{}
+
+func (InvalidUpdateQuery) Error() string {
+	return "invalid update query: must be a single filter operation followed by a single put operation"
+}
+
+type InvalidMergeQuery struct{}
+
+func (InvalidMergeQuery) Error() string {
+	return "invalid merge query: must be a single MERGE INTO statement"
+}
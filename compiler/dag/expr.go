@@ -143,6 +143,16 @@ type (
 		Op      string `json:"op"`
 		Operand Expr   `json:"operand"`
 	}
+	// WindowExpr is a function evaluated over the window frame of each
+	// row of a WindowOp.  Name is either a ranking/offset function
+	// (e.g., row_number, lag) or an aggregate function.
+	WindowExpr struct {
+		Kind   string       `json:"kind" unpack:""`
+		Name   string       `json:"name"`
+		Args   []Expr       `json:"args"`
+		Filter Expr         `json:"filter"`
+		Frame  *WindowFrame `json:"frame"`
+	}
 )
 
 func (*AggExpr) exprNode()          {}
@@ -167,6 +177,7 @@ func (*SubqueryExpr) exprNode()     {}
 func (*ThisExpr) exprNode()         {}
 func (*TypeExpr) exprNode()         {}
 func (*UnaryExpr) exprNode()        {}
+func (*WindowExpr) exprNode()       {}
 
 // Various Expr fields.
 
@@ -194,6 +205,20 @@ type (
 		Kind string `json:"kind" unpack:""`
 		Expr Expr   `json:"expr"`
 	}
+	// WindowFrame bounds the rows of a partition over which a WindowExpr
+	// is computed.  Unit is "rows" or "range".
+	WindowFrame struct {
+		Unit  string      `json:"unit"`
+		Start WindowBound `json:"start"`
+		End   WindowBound `json:"end"`
+	}
+	// WindowBound Type is one of "unbounded_preceding", "preceding",
+	// "current_row", "following", or "unbounded_following".  Offset is
+	// set only for "preceding" and "following".
+	WindowBound struct {
+		Type   string `json:"type"`
+		Offset Expr   `json:"offset"`
+	}
 )
 
 func (*Field) recordElemNode()       {}
@@ -59,6 +59,23 @@ type (
 		PartialsIn  bool         `json:"partials_in,omitempty"`
 		PartialsOut bool         `json:"partials_out,omitempty"`
 	}
+	// AsofJoinOp matches each value from its left input with the nearest
+	// value from its right input, in the order given by Op, that has equal
+	// keys.  LeftKey and RightKey are nil if there are no keys.  If Merge is
+	// true, both inputs are sorted in ascending order of LeftOn and RightOn.
+	AsofJoinOp struct {
+		Kind       string `json:"kind" unpack:""`
+		Style      string `json:"style"`
+		LeftAlias  string `json:"left_alias"`
+		RightAlias string `json:"right_alias"`
+		LeftKey    Expr   `json:"left_key"`
+		RightKey   Expr   `json:"right_key"`
+		LeftOn     Expr   `json:"left_on"`
+		RightOn    Expr   `json:"right_on"`
+		Op         string `json:"op"`
+		Tolerance  Expr   `json:"tolerance"`
+		Merge      bool   `json:"merge"`
+	}
 	CombineOp struct {
 		Kind string `json:"kind" unpack:""`
 	}
@@ -97,12 +114,15 @@ type (
 		Complete bool   `json:"complete"`
 	}
 	HashJoinOp struct {
-		Kind       string `json:"kind" unpack:""`
-		Style      string `json:"style"`
-		LeftAlias  string `json:"left_alias"`
-		RightAlias string `json:"right_alias"`
-		LeftKey    Expr   `json:"left_key"`
-		RightKey   Expr   `json:"right_key"`
+		Kind          string `json:"kind" unpack:""`
+		Style         string `json:"style"`
+		LeftAlias     string `json:"left_alias"`
+		RightAlias    string `json:"right_alias"`
+		LeftKey       Expr   `json:"left_key"`
+		RightKey      Expr   `json:"right_key"`
+		Build         string `json:"build"`
+		Partitioned   bool   `json:"partitioned"`
+		RuntimeFilter bool   `json:"runtime_filter"`
 	}
 	HeadOp struct {
 		Kind  string `json:"kind" unpack:""`
@@ -150,6 +170,12 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Paths []Seq  `json:"paths"`
 	}
+	// SetOp implements SQL INTERSECT and EXCEPT on its two parents.
+	SetOp struct {
+		Kind     string `json:"kind" unpack:""`
+		Op       string `json:"op"`
+		Distinct bool   `json:"distinct"`
+	}
 	SkipOp struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
@@ -185,6 +211,14 @@ type (
 		Kind string `json:"kind" unpack:""`
 		Expr Expr   `json:"exprs"`
 	}
+	// WindowOp sorts its input by PartitionBy and OrderBy and appends
+	// the result of each function in Funcs to each record.
+	WindowOp struct {
+		Kind        string       `json:"kind" unpack:""`
+		PartitionBy []Expr       `json:"partition_by"`
+		OrderBy     []SortExpr   `json:"order_by"`
+		Funcs       []Assignment `json:"funcs"`
+	}
 	ValuesOp struct {
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
@@ -206,6 +240,7 @@ type (
 )
 
 func (*AggregateOp) opNode() {}
+func (*AsofJoinOp) opNode()  {}
 func (*CombineOp) opNode()   {}
 func (*CountOp) opNode()     {}
 func (*CutOp) opNode()       {}
@@ -226,6 +261,7 @@ func (*PassOp) opNode()      {}
 func (*PutOp) opNode()       {}
 func (*RenameOp) opNode()    {}
 func (*ScatterOp) opNode()   {}
+func (*SetOp) opNode()       {}
 func (*SkipOp) opNode()      {}
 func (*SlicerOp) opNode()    {}
 func (*SortOp) opNode()      {}
@@ -235,6 +271,7 @@ func (*TopOp) opNode()       {}
 func (*UniqOp) opNode()      {}
 func (*UnnestOp) opNode()    {}
 func (*ValuesOp) opNode()    {}
+func (*WindowOp) opNode()    {}
 
 // Scanner sources also implement Op and all have suffix "Scan".
 type (
@@ -268,10 +305,11 @@ type (
 		Pushdown Pushdown `json:"pushdown"`
 	}
 	ListerScan struct {
-		Kind      string      `json:"kind" unpack:""`
-		Pool      ksuid.KSUID `json:"pool"`
-		Commit    ksuid.KSUID `json:"commit"`
-		KeyPruner Expr        `json:"key_pruner"`
+		Kind        string      `json:"kind" unpack:""`
+		Pool        ksuid.KSUID `json:"pool"`
+		Commit      ksuid.KSUID `json:"commit"`
+		KeyPruner   Expr        `json:"key_pruner"`
+		IndexFilter Expr        `json:"index_filter"`
 	}
 	HTTPScan struct {
 		Kind    string              `json:"kind" unpack:""`
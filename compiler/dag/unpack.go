@@ -11,6 +11,7 @@ var unpacker = unpack.New(
 	AggregateOp{},
 	ArrayExpr{},
 	Assignment{},
+	AsofJoinOp{},
 	BadExpr{},
 	BinaryExpr{},
 	CallExpr{},
@@ -59,6 +60,7 @@ var unpacker = unpack.New(
 	SearchExpr{},
 	SeqScan{},
 	SetExpr{},
+	SetOp{},
 	SkipOp{},
 	SliceExpr{},
 	SlicerOp{},
@@ -75,6 +77,8 @@ var unpacker = unpack.New(
 	UnnestOp{},
 	ValuesOp{},
 	VectorValue{},
+	WindowExpr{},
+	WindowOp{},
 )
 
 // UnmarshalOp transforms a JSON representation of an operator into an Op.
@@ -0,0 +1,64 @@
+package optimizer
+
+import (
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/order"
+)
+
+// planAsofJoins chooses a strategy for each ASOF join in seq.  A join whose
+// inputs are data sources that are both sorted in ascending order of the
+// join's inequality merges its inputs as they stream.  Otherwise, the join
+// builds a table from its right input.
+func (o *Optimizer) planAsofJoins(seq dag.Seq) error {
+	var err error
+	Walk(seq, func(seq dag.Seq) dag.Seq {
+		for i := range seq {
+			fork, ok := seq[i].(*dag.ForkOp)
+			if !ok || len(fork.Paths) != 2 || i+1 == len(seq) || err != nil {
+				continue
+			}
+			join, ok := seq[i+1].(*dag.AsofJoinOp)
+			if !ok {
+				continue
+			}
+			var left, right bool
+			if left, err = o.isSortedSource(fork.Paths[0], join.LeftOn); err != nil {
+				continue
+			}
+			if right, err = o.isSortedSource(fork.Paths[1], join.RightOn); err != nil {
+				continue
+			}
+			join.Merge = left && right
+		}
+		return seq
+	})
+	return err
+}
+
+// isSortedSource returns true if seq begins with a data source and its output
+// is sorted in ascending order of e.  Inputs that share a parent are never
+// merged since merging pulls from one input at a time.
+func (o *Optimizer) isSortedSource(seq dag.Seq, e dag.Expr) (bool, error) {
+	if len(seq) == 0 || !isSource(seq[0]) {
+		return false, nil
+	}
+	this, ok := e.(*dag.ThisExpr)
+	if !ok {
+		return false, nil
+	}
+	sortKeys, err := o.SortKeys(seq)
+	if err != nil || len(sortKeys) != 1 || sortKeys[0].IsNil() {
+		return false, err
+	}
+	key := sortKeys[0].Primary()
+	return key.Order == order.Asc && key.Key.Equal(this.Path), nil
+}
+
+func isSource(op dag.Op) bool {
+	switch op.(type) {
+	case *dag.CommitMetaScan, *dag.DBMetaScan, *dag.FileScan, *dag.HTTPScan, *dag.ListerScan,
+		*dag.NullScan, *dag.PoolMetaScan, *dag.PoolScan:
+		return true
+	}
+	return false
+}
@@ -21,6 +21,13 @@ func demandForOp(op dag.Op, downstreams []demand.Demand) []demand.Demand {
 			out = append(out, demand.Union(DemandForSeq(p, downstreams[i])...))
 		}
 		return out
+	case *dag.AsofJoinOp:
+		downstream := downstreams[0]
+		left := demand.GetKey(downstream, op.LeftAlias)
+		left = demand.Union(left, demandForExpr(op.LeftKey), demandForExpr(op.LeftOn))
+		right := demand.GetKey(downstream, op.RightAlias)
+		right = demand.Union(right, demandForExpr(op.RightKey), demandForExpr(op.RightOn))
+		return []demand.Demand{left, right}
 	case *dag.HashJoinOp:
 		downstream := downstreams[0]
 		left := demand.GetKey(downstream, op.LeftAlias)
@@ -33,6 +40,9 @@ func demandForOp(op dag.Op, downstreams []demand.Demand) []demand.Demand {
 		left := demand.GetKey(d, op.LeftAlias)
 		right := demand.GetKey(d, op.RightAlias)
 		return []demand.Demand{left, right}
+	case *dag.SetOp:
+		// Entire values are compared.
+		return []demand.Demand{demand.All(), demand.All()}
 	case *dag.ScatterOp:
 		d := demand.None()
 		for i, p := range op.Paths {
@@ -113,6 +123,15 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 			d = demand.Union(d, demandForExpr(e))
 		}
 		return d
+	case *dag.WindowOp:
+		d := demandForAssignments(op.Funcs, downstream)
+		for _, e := range op.PartitionBy {
+			d = demand.Union(d, demandForExpr(e))
+		}
+		for _, s := range op.OrderBy {
+			d = demand.Union(d, demandForExpr(s.Key))
+		}
+		return d
 
 	case *dag.CommitMetaScan, *dag.DeleterScan, *dag.DeleteScan, *dag.DBMetaScan:
 		return demand.None()
@@ -212,6 +231,12 @@ func demandForExpr(expr dag.Expr) demand.Demand {
 		return d
 	case *dag.UnaryExpr:
 		return demandForExpr(expr.Operand)
+	case *dag.WindowExpr:
+		d := demandForExpr(expr.Filter)
+		for _, a := range expr.Args {
+			d = demand.Union(d, demandForExpr(a))
+		}
+		return d
 	}
 	panic(expr)
 }
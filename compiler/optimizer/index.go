@@ -0,0 +1,91 @@
+package optimizer
+
+import (
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+)
+
+// newIndexFilter returns the part of pred that can be decided from the
+// indexes of the fields in fields or nil if there is no such part.  The
+// result is an "and" or "or" of comparisons of a field with a literal, with
+// the field on the left, and "in" expressions of a field and an array of
+// literals.  Like a range pruner, the index filter may match objects with no
+// value satisfying pred but never rules out an object with such a value.
+func newIndexFilter(pred dag.Expr, fields []field.Path) dag.Expr {
+	e, ok := pred.(*dag.BinaryExpr)
+	if !ok || len(fields) == 0 {
+		return nil
+	}
+	switch e.Op {
+	case "and":
+		lhs := newIndexFilter(e.LHS, fields)
+		rhs := newIndexFilter(e.RHS, fields)
+		if lhs == nil {
+			return rhs
+		}
+		if rhs == nil {
+			return lhs
+		}
+		return dag.NewBinaryExpr("and", lhs, rhs)
+	case "or":
+		lhs := newIndexFilter(e.LHS, fields)
+		rhs := newIndexFilter(e.RHS, fields)
+		if lhs == nil || rhs == nil {
+			return nil
+		}
+		return dag.NewBinaryExpr("or", lhs, rhs)
+	case "==", "<", "<=", ">", ">=":
+		this, literal, op := literalComparison(e)
+		if this == nil || !field.Path(this.Path).In(fields) {
+			return nil
+		}
+		return dag.NewBinaryExpr(op, this, literal)
+	case "in":
+		this, ok := e.LHS.(*dag.ThisExpr)
+		if !ok || !field.Path(this.Path).In(fields) {
+			return nil
+		}
+		if literals := literalElems(e.RHS); literals != nil {
+			return dag.NewBinaryExpr("in", this, literals)
+		}
+	}
+	return nil
+}
+
+// literalElems returns an array of the elements of e if e is an array or
+// record expression whose elements are all literals.  Otherwise, it returns
+// nil since "in" searches complex elements for matching values.
+func literalElems(e dag.Expr) *dag.ArrayExpr {
+	var elems []dag.Expr
+	switch e := e.(type) {
+	case *dag.ArrayExpr:
+		for _, elem := range e.Elems {
+			v, ok := elem.(*dag.VectorValue)
+			if !ok {
+				return nil
+			}
+			elems = append(elems, v.Expr)
+		}
+	case *dag.RecordExpr:
+		for _, elem := range e.Elems {
+			f, ok := elem.(*dag.Field)
+			if !ok {
+				return nil
+			}
+			elems = append(elems, f.Value)
+		}
+	default:
+		return nil
+	}
+	array := &dag.ArrayExpr{Kind: "ArrayExpr"}
+	for _, elem := range elems {
+		if _, ok := elem.(*dag.PrimitiveExpr); !ok {
+			return nil
+		}
+		array.Elems = append(array.Elems, &dag.VectorValue{Kind: "VectorValue", Expr: elem})
+	}
+	if len(array.Elems) == 0 {
+		return nil
+	}
+	return array
+}
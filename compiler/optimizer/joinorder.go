@@ -0,0 +1,557 @@
+package optimizer
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+	"slices"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+)
+
+// maxJoinLeaves is the largest number of inputs to a tree of inner joins
+// that orderJoins will reorder.  The search for an order takes time
+// exponential in this number.
+const maxJoinLeaves = 12
+
+// hashTableOverhead approximates the memory used by the table of a hash join
+// for each value in addition to the value itself.
+const hashTableOverhead = 64
+
+// orderJoins reorders each tree of inner hash joins in seq whose inputs can
+// all be estimated from the metadata of their data sources.  A tree is
+// rewritten as a left-deep tree that minimizes the estimated number of
+// intermediate values, followed by a values operator that restores the
+// shape of the original output.
+func (o *Optimizer) orderJoins(seq dag.Seq) dag.Seq {
+	for i := 0; i < len(seq); i++ {
+		switch op := seq[i].(type) {
+		case *dag.ForkOp:
+			if join, ok := matchInnerHashJoin(seq[i:]); ok {
+				t := newJoinTree(op, join)
+				for _, l := range t.leaves {
+					l.fork.Paths[l.index] = o.orderJoins(l.seq())
+				}
+				if ops := o.reorder(t); ops != nil {
+					seq = slices.Replace(seq, i, i+2, ops...)
+					if j := i + len(ops); j < len(seq) {
+						// Fold the values operator that restores the
+						// output into the next operator if possible.
+						seq = slices.Replace(seq, j-1, j+1, mergeValuesOps(dag.Seq{seq[j-1], seq[j]})...)
+					}
+				}
+				// Skip the join.
+				i++
+				continue
+			}
+			for k := range op.Paths {
+				op.Paths[k] = o.orderJoins(op.Paths[k])
+			}
+		case *dag.ScatterOp:
+			for k := range op.Paths {
+				op.Paths[k] = o.orderJoins(op.Paths[k])
+			}
+		case *dag.SwitchOp:
+			for k := range op.Cases {
+				op.Cases[k].Path = o.orderJoins(op.Cases[k].Path)
+			}
+		}
+	}
+	return seq
+}
+
+// matchInnerHashJoin returns the join if seq begins with a two-way fork
+// followed by an inner hash join.
+func matchInnerHashJoin(seq dag.Seq) (*dag.HashJoinOp, bool) {
+	if len(seq) < 2 {
+		return nil, false
+	}
+	fork, ok := seq[0].(*dag.ForkOp)
+	if !ok || len(fork.Paths) != 2 {
+		return nil, false
+	}
+	join, ok := seq[1].(*dag.HashJoinOp)
+	if !ok || join.Style != "inner" {
+		return nil, false
+	}
+	return join, true
+}
+
+// joinTree is a tree of inner hash joins.  Its leaves are the paths of the
+// forks of the joins that are not themselves inner hash joins.
+type joinTree struct {
+	root   *joinNode
+	leaves []*joinLeaf
+}
+
+type joinNode struct {
+	// path is the location of the output of the node in the output of the
+	// tree.
+	path        field.Path
+	join        *dag.HashJoinOp // nil for a leaf
+	left, right *joinNode
+	leaf        int
+}
+
+type joinLeaf struct {
+	fork  *dag.ForkOp
+	index int
+	est   *estimate
+}
+
+func (l *joinLeaf) seq() dag.Seq {
+	return l.fork.Paths[l.index]
+}
+
+// joinEdge is an equality of a key of one leaf with a key of another.  Each
+// key is relative to the values of its leaf.
+type joinEdge struct {
+	leaves [2]int
+	keys   [2]dag.Expr
+}
+
+func newJoinTree(fork *dag.ForkOp, join *dag.HashJoinOp) *joinTree {
+	t := &joinTree{}
+	t.root = t.node(nil, fork, join)
+	return t
+}
+
+func (t *joinTree) node(path field.Path, fork *dag.ForkOp, join *dag.HashJoinOp) *joinNode {
+	return &joinNode{
+		path:  path,
+		join:  join,
+		left:  t.child(slices.Concat(path, field.Path{join.LeftAlias}), fork, 0),
+		right: t.child(slices.Concat(path, field.Path{join.RightAlias}), fork, 1),
+	}
+}
+
+func (t *joinTree) child(path field.Path, fork *dag.ForkOp, index int) *joinNode {
+	seq := fork.Paths[index]
+	if join, ok := matchInnerHashJoin(seq); ok && len(seq) == 2 {
+		return t.node(path, seq[0].(*dag.ForkOp), join)
+	}
+	t.leaves = append(t.leaves, &joinLeaf{fork: fork, index: index})
+	return &joinNode{path: path, leaf: len(t.leaves) - 1}
+}
+
+// edges returns the edges of the joins at and below n or false if a key
+// does not refer to the values of exactly one leaf.
+func (t *joinTree) edges(n *joinNode) ([]joinEdge, bool) {
+	if n.join == nil {
+		return nil, true
+	}
+	left, ok := t.edges(n.left)
+	if !ok {
+		return nil, false
+	}
+	right, ok := t.edges(n.right)
+	if !ok {
+		return nil, false
+	}
+	edges := append(left, right...)
+	for _, keys := range keyPairs(n.join) {
+		var e joinEdge
+		if e.leaves[0], e.keys[0], ok = t.resolve(n.left, keys[0]); !ok {
+			return nil, false
+		}
+		if e.leaves[1], e.keys[1], ok = t.resolve(n.right, keys[1]); !ok {
+			return nil, false
+		}
+		edges = append(edges, e)
+	}
+	return edges, true
+}
+
+// keyPairs returns the pairs of key expressions compared by join.
+func keyPairs(join *dag.HashJoinOp) [][2]dag.Expr {
+	lefts, lok := tupleElems(join.LeftKey)
+	rights, rok := tupleElems(join.RightKey)
+	if !lok || !rok || len(lefts) != len(rights) {
+		return [][2]dag.Expr{{join.LeftKey, join.RightKey}}
+	}
+	var pairs [][2]dag.Expr
+	for k := range lefts {
+		pairs = append(pairs, [2]dag.Expr{lefts[k], rights[k]})
+	}
+	return pairs
+}
+
+// tupleElems returns the elements of a tuple built by buildTuple.
+func tupleElems(e dag.Expr) ([]dag.Expr, bool) {
+	r, ok := e.(*dag.RecordExpr)
+	if !ok {
+		return nil, false
+	}
+	var exprs []dag.Expr
+	for k, elem := range r.Elems {
+		f, ok := elem.(*dag.Field)
+		if !ok || f.Name != fmt.Sprintf("c%d", k) {
+			return nil, false
+		}
+		exprs = append(exprs, f.Value)
+	}
+	return exprs, true
+}
+
+// resolve returns the leaf of the values referred to by e, which is relative
+// to the output of n, along with a copy of e relative to the values of the
+// leaf.
+func (t *joinTree) resolve(n *joinNode, e dag.Expr) (int, dag.Expr, bool) {
+	leaves := t.leavesOf(n)
+	leaf := -1
+	ok := true
+	e = dag.CopyExpr(e)
+	walkT(reflect.ValueOf(e), func(this dag.ThisExpr) dag.ThisExpr {
+		path := slices.Concat(n.path, this.Path)
+		for _, l := range leaves {
+			if lpath := l.path; len(lpath) <= len(path) && slices.Equal(lpath, path[:len(lpath)]) {
+				if leaf >= 0 && leaf != l.leaf {
+					ok = false
+				}
+				leaf = l.leaf
+				this.Path = path[len(lpath):]
+				return this
+			}
+		}
+		ok = false
+		return this
+	})
+	return leaf, e, ok && leaf >= 0
+}
+
+// leavesOf returns the leaf nodes at and below n.
+func (t *joinTree) leavesOf(n *joinNode) []*joinNode {
+	if n.join == nil {
+		return []*joinNode{n}
+	}
+	return append(t.leavesOf(n.left), t.leavesOf(n.right)...)
+}
+
+// reorder returns the operators for a reordering of t or nil if t should be
+// left as is.
+func (o *Optimizer) reorder(t *joinTree) dag.Seq {
+	if len(t.leaves) < 3 || len(t.leaves) > maxJoinLeaves {
+		return nil
+	}
+	for _, l := range t.leaves {
+		if l.est = o.estimateSeq(l.seq()); l.est == nil {
+			return nil
+		}
+	}
+	edges, ok := t.edges(t.root)
+	if !ok {
+		return nil
+	}
+	m := newJoinModel(t.leaves, edges)
+	order, cost := m.bestOrder()
+	// Leave the tree as written unless the new order is clearly better
+	// since estimates are rough.
+	if origCost, _ := m.treeCost(t.root); order == nil || cost >= 0.9*origCost {
+		return nil
+	}
+	return t.rebuild(order, edges)
+}
+
+// rebuild returns a left-deep tree of joins of the leaves of t in the given
+// order followed by a values operator that restores the output of t.
+func (t *joinTree) rebuild(order []int, edges []joinEdge) dag.Seq {
+	// paths holds the location of the values of each leaf in the output
+	// of seq.
+	paths := make([]field.Path, len(t.leaves))
+	placed := uint(1) << order[0]
+	seq := t.leaves[order[0]].seq()
+	for _, j := range order[1:] {
+		var lefts, rights []dag.Expr
+		for _, e := range edges {
+			for side := range 2 {
+				if e.leaves[1-side] == j && placed&(1<<e.leaves[side]) != 0 {
+					lefts = append(lefts, addPathToThis(e.keys[side], paths[e.leaves[side]]))
+					rights = append(rights, dag.CopyExpr(e.keys[1-side]))
+				}
+			}
+		}
+		for k := range paths {
+			if placed&(1<<k) != 0 {
+				paths[k] = slices.Concat(field.Path{"left"}, paths[k])
+			}
+		}
+		paths[j] = field.Path{"right"}
+		placed |= 1 << j
+		left, right := lefts[0], rights[0]
+		if len(lefts) > 1 {
+			left, right = buildTuple(lefts), buildTuple(rights)
+		}
+		seq = dag.Seq{
+			&dag.ForkOp{Kind: "ForkOp", Paths: []dag.Seq{seq, t.leaves[j].seq()}},
+			&dag.HashJoinOp{
+				Kind:       "HashJoinOp",
+				Style:      "inner",
+				LeftAlias:  "left",
+				RightAlias: "right",
+				LeftKey:    left,
+				RightKey:   right,
+			},
+		}
+	}
+	return append(seq, dag.NewValuesOp(t.shape(t.root, paths)))
+}
+
+// shape returns a record expression for the output of n given the location
+// of the values of each leaf.
+func (t *joinTree) shape(n *joinNode, paths []field.Path) dag.Expr {
+	if n.join == nil {
+		return dag.NewThis(slices.Clone(paths[n.leaf]))
+	}
+	return &dag.RecordExpr{
+		Kind: "RecordExpr",
+		Elems: []dag.RecordElem{
+			&dag.Field{Kind: "Field", Name: n.join.LeftAlias, Value: t.shape(n.left, paths)},
+			&dag.Field{Kind: "Field", Name: n.join.RightAlias, Value: t.shape(n.right, paths)},
+		},
+	}
+}
+
+// addPathToThis returns a copy of e with path prepended to the path of
+// every dag.ThisExpr.
+func addPathToThis(e dag.Expr, path field.Path) dag.Expr {
+	e = dag.CopyExpr(e)
+	walkT(reflect.ValueOf(e), func(this dag.ThisExpr) dag.ThisExpr {
+		this.Path = slices.Concat(path, this.Path)
+		return this
+	})
+	return e
+}
+
+// joinModel estimates the number of values output by joining any subset of
+// the leaves of a joinTree.  Subsets are represented as bit sets.
+type joinModel struct {
+	rows []float64
+	// adj[i] is the set of leaves with an edge to leaf i.
+	adj []uint
+}
+
+func newJoinModel(leaves []*joinLeaf, edges []joinEdge) *joinModel {
+	n := len(leaves)
+	sel := make([][]float64, n)
+	for i := range sel {
+		sel[i] = make([]float64, n)
+		for j := range sel[i] {
+			sel[i][j] = 1
+		}
+	}
+	m := &joinModel{rows: make([]float64, 1<<n), adj: make([]uint, n)}
+	for _, e := range edges {
+		a, b := e.leaves[0], e.leaves[1]
+		ndv := max(keyNDV(leaves[a].est, e.keys[0]), keyNDV(leaves[b].est, e.keys[1]))
+		sel[a][b] /= ndv
+		sel[b][a] = sel[a][b]
+		m.adj[a] |= 1 << b
+		m.adj[b] |= 1 << a
+	}
+	m.rows[0] = 1
+	for set := 1; set < len(m.rows); set++ {
+		i := bits.TrailingZeros(uint(set))
+		rest := set &^ (1 << i)
+		rows := m.rows[rest] * leaves[i].est.rows
+		for j := range n {
+			if rest&(1<<j) != 0 {
+				rows *= sel[i][j]
+			}
+		}
+		m.rows[set] = rows
+	}
+	return m
+}
+
+// cost returns the cost of joining the sets a and b, which is the number of
+// values in the output plus the number of values in the hash table.
+func (m *joinModel) cost(a, b uint) float64 {
+	return m.rows[a|b] + min(m.rows[a], m.rows[b])
+}
+
+// treeCost returns the cost of the joins at and below n and the set of
+// leaves below n.
+func (m *joinModel) treeCost(n *joinNode) (float64, uint) {
+	if n.join == nil {
+		return 0, 1 << n.leaf
+	}
+	lcost, left := m.treeCost(n.left)
+	rcost, right := m.treeCost(n.right)
+	return lcost + rcost + m.cost(left, right), left | right
+}
+
+// bestOrder returns the order of the leaves for the left-deep tree of
+// joins of least cost along with its cost.  Only orders in which each leaf
+// shares an edge with a preceding leaf are considered so that no join is a
+// cross product.
+func (m *joinModel) bestOrder() ([]int, float64) {
+	type plan struct {
+		cost float64
+		last int
+		ok   bool
+	}
+	n := len(m.adj)
+	plans := make([]plan, 1<<n)
+	for i := range n {
+		plans[1<<i] = plan{last: i, ok: true}
+	}
+	for set := uint(1); set < uint(len(plans)); set++ {
+		if bits.OnesCount(set) < 2 {
+			continue
+		}
+		for i := range n {
+			rest := set &^ (1 << i)
+			if rest == set || !plans[rest].ok || m.adj[i]&rest == 0 {
+				continue
+			}
+			cost := plans[rest].cost + m.cost(rest, 1<<i)
+			if !plans[set].ok || cost < plans[set].cost {
+				plans[set] = plan{cost: cost, last: i, ok: true}
+			}
+		}
+	}
+	all := uint(len(plans) - 1)
+	if !plans[all].ok {
+		return nil, math.Inf(1)
+	}
+	order := make([]int, n)
+	for set, k := all, n-1; k >= 0; k-- {
+		order[k] = plans[set].last
+		set &^= 1 << order[k]
+	}
+	return order, plans[all].cost
+}
+
+// keyNDV returns an estimate of the number of distinct values of the key
+// expression e over the values estimated by est.
+func keyNDV(est *estimate, e dag.Expr) float64 {
+	if this, ok := e.(*dag.ThisExpr); ok {
+		return est.ndv(this.Path)
+	}
+	return max(est.rows, 1)
+}
+
+// estimateSeq returns an estimate of the output of seq or nil if none can be
+// made.
+func (o *Optimizer) estimateSeq(seq dag.Seq) *estimate {
+	if len(seq) == 0 {
+		return nil
+	}
+	var est *estimate
+	switch op := seq[0].(type) {
+	case *dag.FileScan, *dag.PoolScan:
+		est = o.sourceEstimate(op)
+		seq = seq[1:]
+	case *dag.ForkOp:
+		if len(seq) < 2 || len(op.Paths) != 2 {
+			return nil
+		}
+		join, ok := seq[1].(*dag.HashJoinOp)
+		if !ok {
+			return nil
+		}
+		left, right := o.estimateSeq(op.Paths[0]), o.estimateSeq(op.Paths[1])
+		if left == nil || right == nil {
+			return nil
+		}
+		est = estimateJoin(join, left, right)
+		seq = seq[2:]
+	default:
+		return nil
+	}
+	for _, op := range seq {
+		if est == nil {
+			return nil
+		}
+		switch op := op.(type) {
+		case *dag.FilterOp:
+			est = est.filter(op.Expr)
+		case *dag.PassOp:
+		case *dag.ValuesOp:
+			// The number of values is unchanged but their fields are not.
+			est = &estimate{rows: est.rows, bytes: est.bytes}
+		default:
+			return nil
+		}
+	}
+	return est
+}
+
+// estimateJoin returns an estimate of the output of join for inputs
+// estimated by left and right.
+func estimateJoin(join *dag.HashJoinOp, left, right *estimate) *estimate {
+	rows := left.rows * right.rows / max(joinKeyNDV(left, join.LeftKey), joinKeyNDV(right, join.RightKey))
+	switch join.Style {
+	case "left":
+		rows = max(rows, left.rows)
+	case "right":
+		rows = max(rows, right.rows)
+	case "anti":
+		rows = left.rows
+	}
+	est := &estimate{rows: rows, bytes: rows * (left.width() + right.width())}
+	for alias, in := range map[string]*estimate{join.LeftAlias: left, join.RightAlias: right} {
+		for key, c := range in.cols {
+			if est.cols == nil {
+				est.cols = map[string]colRange{}
+			}
+			if key != "" {
+				key = colKey([]string{alias, key})
+			} else {
+				key = alias
+			}
+			est.cols[key] = c
+		}
+	}
+	return est
+}
+
+// joinKeyNDV is like keyNDV but also handles the tuples built by buildTuple
+// for joins on more than one key.
+func joinKeyNDV(est *estimate, e dag.Expr) float64 {
+	elems, ok := tupleElems(e)
+	if !ok {
+		return keyNDV(est, e)
+	}
+	ndv := 1.0
+	for _, elem := range elems {
+		ndv *= keyNDV(est, elem)
+	}
+	return min(ndv, max(est.rows, 1))
+}
+
+// planHashJoins chooses a build side and strategy for each hash join in seq
+// whose inputs can be estimated from the metadata of their data sources.
+// The hash table is built from the smaller input.  If the table is not
+// expected to fit in the memory budget for hash tables, both inputs are
+// partitioned by key at the outset rather than building a single table
+// that every value of the probe input is broadcast against.
+func (o *Optimizer) planHashJoins(seq dag.Seq) {
+	Walk(seq, func(seq dag.Seq) dag.Seq {
+		for i := range seq {
+			fork, ok := seq[i].(*dag.ForkOp)
+			if !ok || len(fork.Paths) != 2 || i+1 == len(seq) {
+				continue
+			}
+			join, ok := seq[i+1].(*dag.HashJoinOp)
+			if !ok {
+				continue
+			}
+			left, right := o.estimateSeq(fork.Paths[0]), o.estimateSeq(fork.Paths[1])
+			if left == nil || right == nil || left.bytes == right.bytes {
+				continue
+			}
+			build := left
+			join.Build = "left"
+			if right.bytes < left.bytes {
+				build = right
+				join.Build = "right"
+			}
+			join.Partitioned = build.bytes+build.rows*hashTableOverhead > float64(runtime.MemMaxBytes)
+		}
+		return seq
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/optimizer/demand"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/segmentio/ksuid"
@@ -20,6 +21,9 @@ type Optimizer struct {
 	env  *exec.Environment
 	db   *db.Root
 	nent int
+	// estimates caches the estimates computed from the metadata of
+	// data sources, keyed by source.
+	estimates map[string]*estimate
 }
 
 func New(ctx context.Context, env *exec.Environment) *Optimizer {
@@ -128,6 +132,8 @@ func (o *Optimizer) Optimize(main *dag.Main) error {
 	seq = liftFiltersIntoJoins(seq)
 	replaceJoinWithHashJoin(seq)
 	seq = joinFilterPullup(seq)
+	seq = o.orderJoins(seq)
+	o.planHashJoins(seq)
 	seq = removePassOps(seq)
 	seq = replaceSortAndHeadOrTailWithTop(seq)
 	o.optimizeParallels(seq)
@@ -136,9 +142,13 @@ func (o *Optimizer) Optimize(main *dag.Main) error {
 	if err != nil {
 		return err
 	}
+	if err := o.planAsofJoins(seq); err != nil {
+		return err
+	}
 	seq = removePassOps(seq)
 	DemandForSeq(seq, demand.All())
 	setPushdownUnordered(seq, false)
+	planRuntimeFilters(seq)
 	main.Body = seq
 	return nil
 }
@@ -176,6 +186,9 @@ func (o *Optimizer) OptimizeDeleter(main *dag.Main, replicas int) error {
 		//XXX KeyPruner?
 	}
 	lister.KeyPruner = maybeNewRangePruner(filter.Expr, sortKeys)
+	if lister.IndexFilter, err = o.indexFilter(scan.ID, filter.Expr); err != nil {
+		return err
+	}
 	scatter := &dag.ScatterOp{Kind: "ScatterOp"}
 	for range replicas {
 		scatter.Paths = append(scatter.Paths, dag.CopySeq(dag.Seq{deleter}))
@@ -231,6 +244,9 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 				return nil, err
 			}
 			lister.KeyPruner = maybeNewRangePruner(filter, sortKeys)
+			if lister.IndexFilter, err = o.indexFilter(op.ID, filter); err != nil {
+				return nil, err
+			}
 			seq = dag.Seq{lister}
 			_, _, orderRequired, err := o.concurrentPath(chain, sortKeys)
 			if err != nil {
@@ -302,7 +318,7 @@ func (o *Optimizer) propagateSortKey(seq dag.Seq, parents []order.SortKeys) ([]o
 
 func (o *Optimizer) propagateSortKeyOp(op dag.Op, parents []order.SortKeys) ([]order.SortKeys, error) {
 	switch op.(type) {
-	case *dag.HashJoinOp, *dag.JoinOp:
+	case *dag.AsofJoinOp, *dag.HashJoinOp, *dag.JoinOp, *dag.SetOp:
 		return []order.SortKeys{nil}, nil
 	}
 	// If the op is not a join then condense sort order into a single parent,
@@ -400,6 +416,19 @@ func (o *Optimizer) lookupPool(id ksuid.KSUID) (*db.Pool, error) {
 	return o.db.OpenPool(o.ctx, id)
 }
 
+// indexFilter returns the part of filter that can be decided from the
+// indexes of the pool with the given ID.
+func (o *Optimizer) indexFilter(id ksuid.KSUID, filter dag.Expr) (dag.Expr, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	pool, err := o.lookupPool(id)
+	if err != nil {
+		return nil, err
+	}
+	return newIndexFilter(filter, index.Fields(pool.Indexes)), nil
+}
+
 // matchFilter attempts to find a filter from the front seq
 // and returns the filter's expression (and the modified seq) so
 // we can lift the filter predicate into the scanner.
@@ -829,7 +858,7 @@ func walkT[T any](v reflect.Value, post func(T) T) {
 func setPushdownUnordered(seq dag.Seq, unordered bool) bool {
 	for i := len(seq) - 1; i >= 0; i-- {
 		switch op := seq[i].(type) {
-		case *dag.AggregateOp, *dag.CombineOp, *dag.DistinctOp, *dag.HashJoinOp, *dag.JoinOp, *dag.SortOp, *dag.TopOp,
+		case *dag.AggregateOp, *dag.AsofJoinOp, *dag.CombineOp, *dag.DistinctOp, *dag.HashJoinOp, *dag.JoinOp, *dag.SetOp, *dag.SortOp, *dag.TopOp, *dag.WindowOp,
 			*dag.HTTPScan, *dag.PoolScan,
 			*dag.CommitMetaScan, *dag.DBMetaScan, *dag.PoolMetaScan:
 			unordered = true
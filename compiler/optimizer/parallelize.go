@@ -303,7 +303,7 @@ func (o *Optimizer) concurrentPath(seq dag.Seq, sortKeys order.SortKeys) (length
 			}
 			return k, sortExprsForSortKeys(sortKeys), true, nil
 		case *dag.ForkOp, *dag.HeadOp, *dag.ScatterOp, *dag.TailOp, *dag.UniqOp, *dag.FuseOp,
-			*dag.HashJoinOp, *dag.InferOp, *dag.JoinOp, *dag.OutputOp:
+			*dag.AsofJoinOp, *dag.HashJoinOp, *dag.InferOp, *dag.JoinOp, *dag.OutputOp, *dag.SetOp, *dag.WindowOp:
 			return k, sortExprsForSortKeys(sortKeys), true, nil
 		default:
 			next, err := o.analyzeSortKeys(op, sortKeys)
@@ -0,0 +1,68 @@
+package optimizer
+
+import "github.com/brimdata/super/compiler/dag"
+
+// planRuntimeFilters marks the hash joins that publish their keys to the
+// scans on their probe sides once their tables are built so the scans can
+// skip objects and row groups whose keys cannot match.  This requires that
+// the build side of a join be known in advance, that the probe key be a
+// field of the scanned values, and that the join drop the probe values
+// without a match.
+func planRuntimeFilters(seq dag.Seq) {
+	Walk(seq, func(seq dag.Seq) dag.Seq {
+		for i := range seq {
+			fork, ok := seq[i].(*dag.ForkOp)
+			if !ok || len(fork.Paths) != 2 || i+1 == len(seq) {
+				continue
+			}
+			if join, ok := seq[i+1].(*dag.HashJoinOp); ok {
+				join.RuntimeFilter = canFilterProbe(fork, join)
+			}
+		}
+		return seq
+	})
+}
+
+func canFilterProbe(fork *dag.ForkOp, join *dag.HashJoinOp) bool {
+	var probe dag.Seq
+	var key dag.Expr
+	switch {
+	case join.Build == "left" && (join.Style == "inner" || join.Style == "left" || join.Style == "anti"):
+		probe, key = fork.Paths[1], join.RightKey
+	case join.Build == "right" && (join.Style == "inner" || join.Style == "right"):
+		probe, key = fork.Paths[0], join.LeftKey
+	default:
+		return false
+	}
+	if this, ok := key.(*dag.ThisExpr); !ok || len(this.Path) == 0 {
+		return false
+	}
+	return isFilterableScan(probe)
+}
+
+// isFilterableScan returns true if seq is a scan that can skip data using a
+// runtime filter followed only by operators that don't change the values.
+func isFilterableScan(seq dag.Seq) bool {
+	if len(seq) == 0 {
+		return false
+	}
+	switch op := seq[0].(type) {
+	case *dag.FileScan:
+		switch op.Format {
+		case "csup", "orc", "parquet":
+		default:
+			return false
+		}
+	case *dag.ListerScan, *dag.PoolScan:
+	default:
+		return false
+	}
+	for _, op := range seq[1:] {
+		switch op.(type) {
+		case *dag.FilterOp, *dag.PassOp, *dag.SeqScan, *dag.SlicerOp:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,326 @@
+package optimizer
+
+import (
+	"math"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/brimdata/super/sio/parquetio"
+	"github.com/brimdata/super/sup"
+)
+
+const (
+	// defaultRowBytes approximates the size of a value in a file whose
+	// format does not record the number of values it contains.
+	defaultRowBytes = 100
+	// defaultSelectivity is the fraction of values assumed to pass a
+	// predicate whose selectivity cannot otherwise be estimated.
+	defaultSelectivity = 1.0 / 3
+	// defaultEqualSelectivity is the fraction of values assumed to pass an
+	// equality comparison with a constant when the number of distinct
+	// values being compared is unknown.
+	defaultEqualSelectivity = 0.1
+)
+
+// estimate is an estimate of the values output by a sequence of operators.
+type estimate struct {
+	rows  float64
+	bytes float64
+	// cols holds the ranges of the numeric leaf fields of the values,
+	// keyed by colKey.
+	cols map[string]colRange
+}
+
+// colRange is the range of the values of a numeric field.
+type colRange struct {
+	min, max float64
+	integer  bool
+}
+
+func colKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+func (e *estimate) width() float64 {
+	if e.rows < 1 {
+		return 0
+	}
+	return e.bytes / e.rows
+}
+
+// ndv returns an estimate of the number of distinct values of the field at
+// path.
+func (e *estimate) ndv(path field.Path) float64 {
+	if c, ok := e.cols[colKey(path)]; ok && c.integer {
+		return max(min(c.max-c.min+1, e.rows), 1)
+	}
+	return max(e.rows, 1)
+}
+
+func (e *estimate) addRange(path field.Path, minVal, maxVal super.Value) {
+	lo, loInt, ok1 := numericValue(minVal)
+	hi, hiInt, ok2 := numericValue(maxVal)
+	if !ok1 || !ok2 {
+		return
+	}
+	if e.cols == nil {
+		e.cols = map[string]colRange{}
+	}
+	key := colKey(path)
+	c, ok := e.cols[key]
+	if !ok {
+		e.cols[key] = colRange{lo, hi, loInt && hiInt}
+		return
+	}
+	c.min = math.Min(c.min, lo)
+	c.max = math.Max(c.max, hi)
+	c.integer = c.integer && loInt && hiInt
+	e.cols[key] = c
+}
+
+// addMetadata adds the ranges in val, a metadata value as computed for CSUP
+// objects and Parquet row groups, to e.cols.
+func (e *estimate) addMetadata(path field.Path, val super.Value) {
+	typ, ok := super.TypeUnder(val.Type()).(*super.TypeRecord)
+	if !ok || val.IsNull() {
+		return
+	}
+	if len(typ.Fields) == 2 && typ.Fields[0].Name == "min" && typ.Fields[1].Name == "max" {
+		e.addRange(path, *val.Deref("min"), *val.Deref("max"))
+		return
+	}
+	for _, f := range typ.Fields {
+		e.addMetadata(append(path, f.Name), *val.Deref(f.Name))
+	}
+}
+
+// merge adds the values estimated by e2 to e.
+func (e *estimate) merge(e2 *estimate) {
+	e.rows += e2.rows
+	e.bytes += e2.bytes
+	for key, c2 := range e2.cols {
+		if e.cols == nil {
+			e.cols = map[string]colRange{}
+		}
+		if c, ok := e.cols[key]; ok {
+			c2.min = math.Min(c.min, c2.min)
+			c2.max = math.Max(c.max, c2.max)
+			c2.integer = c.integer && c2.integer
+		}
+		e.cols[key] = c2
+	}
+}
+
+// filter returns an estimate of the values of e that pass the predicate
+// expr.
+func (e *estimate) filter(expr dag.Expr) *estimate {
+	sel := e.selectivity(expr)
+	return &estimate{rows: e.rows * sel, bytes: e.bytes * sel, cols: e.cols}
+}
+
+// selectivity returns an estimate of the fraction of the values of e that
+// pass the predicate expr.
+func (e *estimate) selectivity(expr dag.Expr) float64 {
+	b, ok := expr.(*dag.BinaryExpr)
+	if !ok {
+		return defaultSelectivity
+	}
+	switch b.Op {
+	case "and":
+		return e.selectivity(b.LHS) * e.selectivity(b.RHS)
+	case "or":
+		lhs, rhs := e.selectivity(b.LHS), e.selectivity(b.RHS)
+		return lhs + rhs - lhs*rhs
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return defaultSelectivity
+	}
+	op := b.Op
+	this, ok := b.LHS.(*dag.ThisExpr)
+	val, isConst := constValue(b.RHS)
+	if !ok || !isConst {
+		this, ok = b.RHS.(*dag.ThisExpr)
+		val, isConst = constValue(b.LHS)
+		if !ok || !isConst {
+			if op == "==" {
+				return defaultEqualSelectivity
+			}
+			return defaultSelectivity
+		}
+		// Flip the comparison so the field is on the left.
+		switch op {
+		case "<":
+			op = ">"
+		case "<=":
+			op = ">="
+		case ">":
+			op = "<"
+		case ">=":
+			op = "<="
+		}
+	}
+	c, hasRange := e.cols[colKey(this.Path)]
+	switch op {
+	case "==":
+		if hasRange && c.integer {
+			return 1 / e.ndv(this.Path)
+		}
+		return defaultEqualSelectivity
+	case "!=":
+		if hasRange && c.integer {
+			return 1 - 1/e.ndv(this.Path)
+		}
+		return 1 - defaultEqualSelectivity
+	}
+	v, _, ok := numericValue(val)
+	if !hasRange || !ok || c.max <= c.min {
+		return defaultSelectivity
+	}
+	frac := (v - c.min) / (c.max - c.min)
+	if op == ">" || op == ">=" {
+		frac = 1 - frac
+	}
+	return math.Max(math.Min(frac, 1), 0)
+}
+
+func constValue(e dag.Expr) (super.Value, bool) {
+	p, ok := e.(*dag.PrimitiveExpr)
+	if !ok {
+		return super.Value{}, false
+	}
+	val, err := sup.ParseValue(super.NewContext(), p.Value)
+	return val, err == nil
+}
+
+// numericValue returns val as a float64 and whether val is an integer.
+func numericValue(val super.Value) (float64, bool, bool) {
+	val = val.Under()
+	if val.IsNull() {
+		return 0, false, false
+	}
+	switch val.Type().ID() {
+	case super.IDUint8, super.IDUint16, super.IDUint32, super.IDUint64:
+		return float64(val.Uint()), true, true
+	case super.IDInt8, super.IDInt16, super.IDInt32, super.IDInt64, super.IDDuration, super.IDTime:
+		return float64(val.Int()), true, true
+	case super.IDFloat16, super.IDFloat32, super.IDFloat64:
+		return val.Float(), false, true
+	}
+	return 0, false, false
+}
+
+// sourceEstimate returns an estimate of the values read by a pool or file
+// scan or nil if none can be made.  Estimates are computed from the object
+// counts of pools, the metadata of CSUP files, and the footers of Parquet
+// files.  For files in other formats, the number of values is guessed from
+// the size of the file.
+func (o *Optimizer) sourceEstimate(op dag.Op) *estimate {
+	switch op := op.(type) {
+	case *dag.PoolScan:
+		key := "pool:" + op.ID.String() + ":" + op.Commit.String()
+		return o.cachedEstimate(key, func() *estimate { return o.poolEstimate(op) })
+	case *dag.FileScan:
+		out := &estimate{}
+		for _, path := range op.Paths {
+			key := "file:" + op.Format + ":" + path
+			e := o.cachedEstimate(key, func() *estimate { return o.fileEstimate(path, op.Format) })
+			if e == nil {
+				return nil
+			}
+			out.merge(e)
+		}
+		return out
+	}
+	return nil
+}
+
+func (o *Optimizer) cachedEstimate(key string, compute func() *estimate) *estimate {
+	if e, ok := o.estimates[key]; ok {
+		return e
+	}
+	if o.estimates == nil {
+		o.estimates = map[string]*estimate{}
+	}
+	e := compute()
+	o.estimates[key] = e
+	return e
+}
+
+func (o *Optimizer) poolEstimate(op *dag.PoolScan) *estimate {
+	if o.db == nil {
+		return nil
+	}
+	pool, err := o.lookupPool(op.ID)
+	if err != nil {
+		return nil
+	}
+	snap, err := pool.Snapshot(o.ctx, op.Commit)
+	if err != nil {
+		return nil
+	}
+	var key field.Path
+	if !pool.SortKeys.IsNil() {
+		key = pool.SortKeys.Primary().Key
+	}
+	e := &estimate{}
+	for _, object := range snap.SelectAll() {
+		e.rows += float64(object.Count)
+		e.bytes += float64(object.Size)
+		if key != nil {
+			e.addRange(key, object.Min, object.Max)
+		}
+	}
+	return e
+}
+
+func (o *Optimizer) fileEstimate(path, format string) *estimate {
+	if o.env == nil || o.env.Engine() == nil {
+		return nil
+	}
+	uri, err := storage.ParseURI(path)
+	if err != nil || !uri.HasScheme(storage.FileScheme) && !uri.HasScheme(storage.S3Scheme) {
+		// Don't read from standard input or make HTTP requests.
+		return nil
+	}
+	r, err := o.env.Engine().Get(o.ctx, uri)
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	size, err := storage.Size(r)
+	if err != nil {
+		return nil
+	}
+	e := &estimate{bytes: float64(size)}
+	var vals []super.Value
+	sctx := super.NewContext()
+	switch format {
+	case "csup":
+		var count uint64
+		if count, vals, err = csupio.ReadMetadata(sctx, r); err != nil {
+			return nil
+		}
+		e.rows = float64(count)
+	case "parquet":
+		ras, ok := r.(parquet.ReaderAtSeeker)
+		if !ok {
+			return nil
+		}
+		var count int64
+		if count, vals, err = parquetio.ReadMetadata(sctx, ras); err != nil {
+			return nil
+		}
+		e.rows = float64(count)
+	default:
+		e.rows = math.Ceil(e.bytes / defaultRowBytes)
+	}
+	for _, val := range vals {
+		e.addMetadata(nil, val)
+	}
+	return e
+}
@@ -75,6 +75,12 @@ func CompileWithAST(rctx *runtime.Context, ast *parser.AST, env *exec.Environmen
 			return nil, err
 		}
 	}
+	return BuildQuery(rctx, main, env)
+}
+
+// BuildQuery returns a query that runs main, which has already been analyzed
+// and optionally optimized.
+func BuildQuery(rctx *runtime.Context, main *dag.Main, env *exec.Environment) (*exec.Query, error) {
 	outputs, debugs, meter, err := Build(rctx, main, env)
 	if err != nil {
 		return nil, err
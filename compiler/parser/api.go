@@ -8,8 +8,9 @@ import (
 )
 
 type AST struct {
-	seq   ast.Seq
-	files *srcfiles.List
+	seq    ast.Seq
+	files  *srcfiles.List
+	params map[string]string
 }
 
 func (a *AST) Parsed() ast.Seq {
@@ -20,10 +21,27 @@ func (a *AST) Copy() ast.Seq {
 	return ast.CopySeq(a.seq)
 }
 
+// Clone returns a deep copy of a.
+func (a *AST) Clone() *AST {
+	return &AST{seq: a.Copy(), files: a.files, params: a.params}
+}
+
 func (a *AST) Files() *srcfiles.List {
 	return a.files
 }
 
+// Params returns the values bound to the query's parameters as SUP text
+// keyed by parameter name.
+func (a *AST) Params() map[string]string {
+	return a.params
+}
+
+// SetParams binds the query's parameters, e.g., $n or :n, to values given as
+// SUP text keyed by parameter name.
+func (a *AST) SetParams(params map[string]string) {
+	a.params = params
+}
+
 func (a *AST) ConvertToDeleteWhere(pool, branch string) error {
 	if len(a.seq) == 0 {
 		return errors.New("internal error: AST seq cannot be empty")
@@ -65,7 +83,7 @@ func ParseFiles(inputs []srcfiles.Input) (*AST, error) {
 		}
 		return nil, files.Error()
 	}
-	return &AST{sliceOf[ast.Op](p), files}, nil
+	return &AST{seq: sliceOf[ast.Op](p), files: files}, nil
 }
 
 func convertParseErrs(err error, files *srcfiles.List) error {
@@ -2486,19 +2486,68 @@ var g = &grammar{
 							exprs: []any{
 								&labeledExpr{
 									pos:   position{line: 300, col: 5, offset: 7845},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 300, col: 10, offset: 7850},
+										name: "AggName",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 300, col: 18, offset: 7858},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 21, offset: 7861},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 300, col: 25, offset: 7865},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 300, col: 28, offset: 7868},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 300, col: 33, offset: 7873},
+										name: "Exprs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 300, col: 39, offset: 7879},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 42, offset: 7882},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 308, col: 5, offset: 8122},
+						run: (*parser).callonAggFunc47,
+						expr: &seqExpr{
+							pos: position{line: 308, col: 5, offset: 8122},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 308, col: 5, offset: 8122},
 									label: "cs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 300, col: 8, offset: 7848},
+										pos:  position{line: 308, col: 8, offset: 8125},
 										name: "CountStar",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 300, col: 18, offset: 7858},
+									pos:   position{line: 308, col: 18, offset: 8135},
 									label: "filter",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 300, col: 25, offset: 7865},
+										pos: position{line: 308, col: 25, offset: 8142},
 										expr: &ruleRefExpr{
-											pos:  position{line: 300, col: 25, offset: 7865},
+											pos:  position{line: 308, col: 25, offset: 8142},
 											name: "FilterClause",
 										},
 									},
@@ -2513,20 +2562,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggName",
-			pos:  position{line: 312, col: 1, offset: 8100},
+			pos:  position{line: 320, col: 1, offset: 8377},
 			expr: &choiceExpr{
-				pos: position{line: 313, col: 5, offset: 8112},
+				pos: position{line: 321, col: 5, offset: 8389},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 313, col: 5, offset: 8112},
+						pos:  position{line: 321, col: 5, offset: 8389},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 314, col: 5, offset: 8131},
+						pos:  position{line: 322, col: 5, offset: 8408},
 						name: "AND",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 315, col: 5, offset: 8139},
+						pos:  position{line: 323, col: 5, offset: 8416},
 						name: "OR",
 					},
 				},
@@ -2536,65 +2585,65 @@ var g = &grammar{
 		},
 		{
 			name: "FilterClause",
-			pos:  position{line: 317, col: 1, offset: 8143},
+			pos:  position{line: 325, col: 1, offset: 8420},
 			expr: &actionExpr{
-				pos: position{line: 317, col: 16, offset: 8158},
+				pos: position{line: 325, col: 16, offset: 8435},
 				run: (*parser).callonFilterClause1,
 				expr: &seqExpr{
-					pos: position{line: 317, col: 16, offset: 8158},
+					pos: position{line: 325, col: 16, offset: 8435},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 16, offset: 8158},
+							pos:  position{line: 325, col: 16, offset: 8435},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 18, offset: 8160},
+							pos:  position{line: 325, col: 18, offset: 8437},
 							name: "FILTER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 25, offset: 8167},
+							pos:  position{line: 325, col: 25, offset: 8444},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 317, col: 28, offset: 8170},
+							pos:        position{line: 325, col: 28, offset: 8447},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 32, offset: 8174},
+							pos:  position{line: 325, col: 32, offset: 8451},
 							name: "__",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 317, col: 35, offset: 8177},
+							pos: position{line: 325, col: 35, offset: 8454},
 							expr: &seqExpr{
-								pos: position{line: 317, col: 36, offset: 8178},
+								pos: position{line: 325, col: 36, offset: 8455},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 317, col: 36, offset: 8178},
+										pos:  position{line: 325, col: 36, offset: 8455},
 										name: "WHERE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 317, col: 42, offset: 8184},
+										pos:  position{line: 325, col: 42, offset: 8461},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 317, col: 46, offset: 8188},
+							pos:   position{line: 325, col: 46, offset: 8465},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 51, offset: 8193},
+								pos:  position{line: 325, col: 51, offset: 8470},
 								name: "LogicalOrExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 65, offset: 8207},
+							pos:  position{line: 325, col: 65, offset: 8484},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 317, col: 68, offset: 8210},
+							pos:        position{line: 325, col: 68, offset: 8487},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2607,45 +2656,45 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 319, col: 1, offset: 8236},
+			pos:  position{line: 327, col: 1, offset: 8513},
 			expr: &actionExpr{
-				pos: position{line: 320, col: 5, offset: 8255},
+				pos: position{line: 328, col: 5, offset: 8532},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 320, col: 5, offset: 8255},
+					pos: position{line: 328, col: 5, offset: 8532},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 320, col: 5, offset: 8255},
+							pos:   position{line: 328, col: 5, offset: 8532},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 320, col: 11, offset: 8261},
+								pos:  position{line: 328, col: 11, offset: 8538},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 320, col: 25, offset: 8275},
+							pos:   position{line: 328, col: 25, offset: 8552},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 320, col: 30, offset: 8280},
+								pos: position{line: 328, col: 30, offset: 8557},
 								expr: &seqExpr{
-									pos: position{line: 320, col: 31, offset: 8281},
+									pos: position{line: 328, col: 31, offset: 8558},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 31, offset: 8281},
+											pos:  position{line: 328, col: 31, offset: 8558},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 320, col: 34, offset: 8284},
+											pos:        position{line: 328, col: 34, offset: 8561},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 38, offset: 8288},
+											pos:  position{line: 328, col: 38, offset: 8565},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 41, offset: 8291},
+											pos:  position{line: 328, col: 41, offset: 8568},
 											name: "AggAssignment",
 										},
 									},
@@ -2660,43 +2709,43 @@ var g = &grammar{
 		},
 		{
 			name: "CountStar",
-			pos:  position{line: 328, col: 1, offset: 8465},
+			pos:  position{line: 336, col: 1, offset: 8742},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 13, offset: 8477},
+				pos: position{line: 336, col: 13, offset: 8754},
 				run: (*parser).callonCountStar1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 13, offset: 8477},
+					pos: position{line: 336, col: 13, offset: 8754},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 13, offset: 8477},
+							pos:  position{line: 336, col: 13, offset: 8754},
 							name: "COUNT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 19, offset: 8483},
+							pos:  position{line: 336, col: 19, offset: 8760},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 22, offset: 8486},
+							pos:        position{line: 336, col: 22, offset: 8763},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 26, offset: 8490},
+							pos:  position{line: 336, col: 26, offset: 8767},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 29, offset: 8493},
+							pos:        position{line: 336, col: 29, offset: 8770},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 33, offset: 8497},
+							pos:  position{line: 336, col: 33, offset: 8774},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 36, offset: 8500},
+							pos:        position{line: 336, col: 36, offset: 8777},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2709,28 +2758,28 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 343, col: 1, offset: 8740},
+			pos:  position{line: 351, col: 1, offset: 9017},
 			expr: &choiceExpr{
-				pos: position{line: 344, col: 5, offset: 8753},
+				pos: position{line: 352, col: 5, offset: 9030},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 344, col: 5, offset: 8753},
+						pos: position{line: 352, col: 5, offset: 9030},
 						run: (*parser).callonOperator2,
 						expr: &seqExpr{
-							pos: position{line: 344, col: 5, offset: 8753},
+							pos: position{line: 352, col: 5, offset: 9030},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 344, col: 5, offset: 8753},
+									pos:   position{line: 352, col: 5, offset: 9030},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 344, col: 8, offset: 8756},
+										pos:  position{line: 352, col: 8, offset: 9033},
 										name: "SQLOp",
 									},
 								},
 								&andExpr{
-									pos: position{line: 344, col: 14, offset: 8762},
+									pos: position{line: 352, col: 14, offset: 9039},
 									expr: &ruleRefExpr{
-										pos:  position{line: 344, col: 15, offset: 8763},
+										pos:  position{line: 352, col: 15, offset: 9040},
 										name: "EndOfOp",
 									},
 								},
@@ -2738,123 +2787,127 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 345, col: 5, offset: 8794},
+						pos:  position{line: 353, col: 5, offset: 9071},
 						name: "ForkOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 346, col: 5, offset: 8805},
+						pos:  position{line: 354, col: 5, offset: 9082},
 						name: "SwitchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 347, col: 5, offset: 8818},
+						pos:  position{line: 355, col: 5, offset: 9095},
 						name: "SearchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 348, col: 5, offset: 8831},
+						pos:  position{line: 356, col: 5, offset: 9108},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 349, col: 5, offset: 8844},
+						pos:  position{line: 357, col: 5, offset: 9121},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 350, col: 5, offset: 8855},
+						pos:  position{line: 358, col: 5, offset: 9132},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 351, col: 5, offset: 8865},
+						pos:  position{line: 359, col: 5, offset: 9142},
 						name: "CallOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 352, col: 5, offset: 8876},
+						pos:  position{line: 360, col: 5, offset: 9153},
 						name: "CountOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 353, col: 5, offset: 8888},
+						pos:  position{line: 361, col: 5, offset: 9165},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 354, col: 5, offset: 8898},
+						pos:  position{line: 362, col: 5, offset: 9175},
 						name: "DistinctOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 355, col: 5, offset: 8913},
+						pos:  position{line: 363, col: 5, offset: 9190},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 356, col: 5, offset: 8924},
+						pos:  position{line: 364, col: 5, offset: 9201},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 357, col: 5, offset: 8935},
+						pos:  position{line: 365, col: 5, offset: 9212},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 358, col: 5, offset: 8946},
+						pos:  position{line: 366, col: 5, offset: 9223},
 						name: "SkipOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 359, col: 5, offset: 8957},
+						pos:  position{line: 367, col: 5, offset: 9234},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 360, col: 5, offset: 8969},
+						pos:  position{line: 368, col: 5, offset: 9246},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 361, col: 5, offset: 8980},
+						pos:  position{line: 369, col: 5, offset: 9257},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 362, col: 5, offset: 8990},
+						pos:  position{line: 370, col: 5, offset: 9267},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 363, col: 5, offset: 9003},
+						pos:  position{line: 371, col: 5, offset: 9280},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 364, col: 5, offset: 9014},
+						pos:  position{line: 372, col: 5, offset: 9291},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 365, col: 5, offset: 9025},
+						pos:  position{line: 373, col: 5, offset: 9302},
 						name: "ShapesOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 366, col: 5, offset: 9038},
+						pos:  position{line: 374, col: 5, offset: 9315},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 367, col: 5, offset: 9049},
+						pos:  position{line: 375, col: 5, offset: 9326},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 368, col: 5, offset: 9060},
+						pos:  position{line: 376, col: 5, offset: 9337},
+						name: "MergeIntoOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 377, col: 5, offset: 9353},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 369, col: 5, offset: 9072},
+						pos:  position{line: 378, col: 5, offset: 9365},
 						name: "UnnestOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 370, col: 5, offset: 9085},
+						pos:  position{line: 379, col: 5, offset: 9378},
 						name: "ValuesOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 371, col: 5, offset: 9098},
+						pos:  position{line: 380, col: 5, offset: 9391},
 						name: "LoadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 5, offset: 9109},
+						pos:  position{line: 381, col: 5, offset: 9402},
 						name: "OutputOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 373, col: 5, offset: 9122},
+						pos:  position{line: 382, col: 5, offset: 9415},
 						name: "DebugOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 374, col: 5, offset: 9134},
+						pos:  position{line: 383, col: 5, offset: 9427},
 						name: "InferOp",
 					},
 				},
@@ -2864,37 +2917,37 @@ var g = &grammar{
 		},
 		{
 			name: "ForkOp",
-			pos:  position{line: 376, col: 2, offset: 9144},
+			pos:  position{line: 385, col: 2, offset: 9437},
 			expr: &actionExpr{
-				pos: position{line: 377, col: 4, offset: 9156},
+				pos: position{line: 386, col: 4, offset: 9449},
 				run: (*parser).callonForkOp1,
 				expr: &seqExpr{
-					pos: position{line: 377, col: 4, offset: 9156},
+					pos: position{line: 386, col: 4, offset: 9449},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 377, col: 4, offset: 9156},
+							pos:  position{line: 386, col: 4, offset: 9449},
 							name: "FORK",
 						},
 						&labeledExpr{
-							pos:   position{line: 377, col: 9, offset: 9161},
+							pos:   position{line: 386, col: 9, offset: 9454},
 							label: "paths",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 377, col: 15, offset: 9167},
+								pos: position{line: 386, col: 15, offset: 9460},
 								expr: &actionExpr{
-									pos: position{line: 377, col: 17, offset: 9169},
+									pos: position{line: 386, col: 17, offset: 9462},
 									run: (*parser).callonForkOp6,
 									expr: &seqExpr{
-										pos: position{line: 377, col: 17, offset: 9169},
+										pos: position{line: 386, col: 17, offset: 9462},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 377, col: 17, offset: 9169},
+												pos:  position{line: 386, col: 17, offset: 9462},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 377, col: 20, offset: 9172},
+												pos:   position{line: 386, col: 20, offset: 9465},
 												label: "path",
 												expr: &ruleRefExpr{
-													pos:  position{line: 377, col: 25, offset: 9177},
+													pos:  position{line: 386, col: 25, offset: 9470},
 													name: "ScopeBody",
 												},
 											},
@@ -2911,31 +2964,31 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchOp",
-			pos:  position{line: 389, col: 1, offset: 9451},
+			pos:  position{line: 398, col: 1, offset: 9744},
 			expr: &choiceExpr{
-				pos: position{line: 390, col: 5, offset: 9464},
+				pos: position{line: 399, col: 5, offset: 9757},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 390, col: 5, offset: 9464},
+						pos: position{line: 399, col: 5, offset: 9757},
 						run: (*parser).callonSwitchOp2,
 						expr: &seqExpr{
-							pos: position{line: 390, col: 5, offset: 9464},
+							pos: position{line: 399, col: 5, offset: 9757},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 390, col: 5, offset: 9464},
+									pos:  position{line: 399, col: 5, offset: 9757},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 390, col: 12, offset: 9471},
+									pos:  position{line: 399, col: 12, offset: 9764},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 390, col: 14, offset: 9473},
+									pos:   position{line: 399, col: 14, offset: 9766},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 390, col: 20, offset: 9479},
+										pos: position{line: 399, col: 20, offset: 9772},
 										expr: &ruleRefExpr{
-											pos:  position{line: 390, col: 20, offset: 9479},
+											pos:  position{line: 399, col: 20, offset: 9772},
 											name: "SwitchPath",
 										},
 									},
@@ -2944,38 +2997,38 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 9638},
+						pos: position{line: 406, col: 5, offset: 9931},
 						run: (*parser).callonSwitchOp9,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 9638},
+							pos: position{line: 406, col: 5, offset: 9931},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 5, offset: 9638},
+									pos:  position{line: 406, col: 5, offset: 9931},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 12, offset: 9645},
+									pos:  position{line: 406, col: 12, offset: 9938},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 14, offset: 9647},
+									pos:   position{line: 406, col: 14, offset: 9940},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 397, col: 19, offset: 9652},
+										pos:  position{line: 406, col: 19, offset: 9945},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 24, offset: 9657},
+									pos:  position{line: 406, col: 24, offset: 9950},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 26, offset: 9659},
+									pos:   position{line: 406, col: 26, offset: 9952},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 397, col: 32, offset: 9665},
+										pos: position{line: 406, col: 32, offset: 9958},
 										expr: &ruleRefExpr{
-											pos:  position{line: 397, col: 32, offset: 9665},
+											pos:  position{line: 406, col: 32, offset: 9958},
 											name: "SwitchPath",
 										},
 									},
@@ -2990,34 +3043,34 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchPath",
-			pos:  position{line: 406, col: 1, offset: 9854},
+			pos:  position{line: 415, col: 1, offset: 10147},
 			expr: &actionExpr{
-				pos: position{line: 407, col: 5, offset: 9869},
+				pos: position{line: 416, col: 5, offset: 10162},
 				run: (*parser).callonSwitchPath1,
 				expr: &seqExpr{
-					pos: position{line: 407, col: 5, offset: 9869},
+					pos: position{line: 416, col: 5, offset: 10162},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 407, col: 5, offset: 9869},
+							pos:  position{line: 416, col: 5, offset: 10162},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 407, col: 8, offset: 9872},
+							pos:   position{line: 416, col: 8, offset: 10165},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 407, col: 13, offset: 9877},
+								pos:  position{line: 416, col: 13, offset: 10170},
 								name: "Case",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 407, col: 18, offset: 9882},
+							pos:  position{line: 416, col: 18, offset: 10175},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 407, col: 21, offset: 9885},
+							pos:   position{line: 416, col: 21, offset: 10178},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 407, col: 26, offset: 9890},
+								pos:  position{line: 416, col: 26, offset: 10183},
 								name: "ScopeBody",
 							},
 						},
@@ -3029,29 +3082,29 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 415, col: 1, offset: 10042},
+			pos:  position{line: 424, col: 1, offset: 10335},
 			expr: &choiceExpr{
-				pos: position{line: 416, col: 5, offset: 10051},
+				pos: position{line: 425, col: 5, offset: 10344},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 416, col: 5, offset: 10051},
+						pos: position{line: 425, col: 5, offset: 10344},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 416, col: 5, offset: 10051},
+							pos: position{line: 425, col: 5, offset: 10344},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 416, col: 5, offset: 10051},
+									pos:  position{line: 425, col: 5, offset: 10344},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 416, col: 10, offset: 10056},
+									pos:  position{line: 425, col: 10, offset: 10349},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 416, col: 12, offset: 10058},
+									pos:   position{line: 425, col: 12, offset: 10351},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 416, col: 17, offset: 10063},
+										pos:  position{line: 425, col: 17, offset: 10356},
 										name: "Expr",
 									},
 								},
@@ -3059,10 +3112,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 417, col: 5, offset: 10093},
+						pos: position{line: 426, col: 5, offset: 10386},
 						run: (*parser).callonCase8,
 						expr: &ruleRefExpr{
-							pos:  position{line: 417, col: 5, offset: 10093},
+							pos:  position{line: 426, col: 5, offset: 10386},
 							name: "DEFAULT",
 						},
 					},
@@ -3073,40 +3126,40 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOp",
-			pos:  position{line: 419, col: 1, offset: 10122},
+			pos:  position{line: 428, col: 1, offset: 10415},
 			expr: &actionExpr{
-				pos: position{line: 420, col: 5, offset: 10135},
+				pos: position{line: 429, col: 5, offset: 10428},
 				run: (*parser).callonSearchOp1,
 				expr: &seqExpr{
-					pos: position{line: 420, col: 5, offset: 10135},
+					pos: position{line: 429, col: 5, offset: 10428},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 420, col: 6, offset: 10136},
+							pos: position{line: 429, col: 6, offset: 10429},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 420, col: 6, offset: 10136},
+									pos: position{line: 429, col: 6, offset: 10429},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 6, offset: 10136},
+											pos:  position{line: 429, col: 6, offset: 10429},
 											name: "SEARCH",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 13, offset: 10143},
+											pos:  position{line: 429, col: 13, offset: 10436},
 											name: "_",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 420, col: 17, offset: 10147},
+									pos: position{line: 429, col: 17, offset: 10440},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 420, col: 17, offset: 10147},
+											pos:        position{line: 429, col: 17, offset: 10440},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 420, col: 21, offset: 10151},
+											pos:  position{line: 429, col: 21, offset: 10444},
 											name: "__",
 										},
 									},
@@ -3114,10 +3167,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 420, col: 25, offset: 10155},
+							pos:   position{line: 429, col: 25, offset: 10448},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 30, offset: 10160},
+								pos:  position{line: 429, col: 30, offset: 10453},
 								name: "SearchBoolean",
 							},
 						},
@@ -3129,32 +3182,32 @@ var g = &grammar{
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 424, col: 1, offset: 10264},
+			pos:  position{line: 433, col: 1, offset: 10557},
 			expr: &actionExpr{
-				pos: position{line: 425, col: 5, offset: 10277},
+				pos: position{line: 434, col: 5, offset: 10570},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 425, col: 5, offset: 10277},
+					pos: position{line: 434, col: 5, offset: 10570},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 425, col: 5, offset: 10277},
+							pos:  position{line: 434, col: 5, offset: 10570},
 							name: "ASSERT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 425, col: 12, offset: 10284},
+							pos:  position{line: 434, col: 12, offset: 10577},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 425, col: 14, offset: 10286},
+							pos:   position{line: 434, col: 14, offset: 10579},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 425, col: 20, offset: 10292},
+								pos: position{line: 434, col: 20, offset: 10585},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 425, col: 20, offset: 10292},
+									pos:   position{line: 434, col: 20, offset: 10585},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 425, col: 22, offset: 10294},
+										pos:  position{line: 434, col: 22, offset: 10587},
 										name: "Expr",
 									},
 								},
@@ -3168,33 +3221,33 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 434, col: 1, offset: 10528},
+			pos:  position{line: 443, col: 1, offset: 10821},
 			expr: &actionExpr{
-				pos: position{line: 435, col: 5, offset: 10539},
+				pos: position{line: 444, col: 5, offset: 10832},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 435, col: 5, offset: 10539},
+					pos: position{line: 444, col: 5, offset: 10832},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 435, col: 6, offset: 10540},
+							pos: position{line: 444, col: 6, offset: 10833},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 435, col: 6, offset: 10540},
+									pos:  position{line: 444, col: 6, offset: 10833},
 									name: "SORT",
 								},
 								&seqExpr{
-									pos: position{line: 435, col: 13, offset: 10547},
+									pos: position{line: 444, col: 13, offset: 10840},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 435, col: 13, offset: 10547},
+											pos:  position{line: 444, col: 13, offset: 10840},
 											name: "ORDER",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 435, col: 19, offset: 10553},
+											pos:  position{line: 444, col: 19, offset: 10846},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 435, col: 21, offset: 10555},
+											pos:  position{line: 444, col: 21, offset: 10848},
 											name: "BY",
 										},
 									},
@@ -3202,33 +3255,33 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 435, col: 25, offset: 10559},
+							pos:   position{line: 444, col: 25, offset: 10852},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 435, col: 30, offset: 10564},
+								pos:  position{line: 444, col: 30, offset: 10857},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 435, col: 39, offset: 10573},
+							pos:   position{line: 444, col: 39, offset: 10866},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 435, col: 45, offset: 10579},
+								pos: position{line: 444, col: 45, offset: 10872},
 								expr: &actionExpr{
-									pos: position{line: 435, col: 46, offset: 10580},
+									pos: position{line: 444, col: 46, offset: 10873},
 									run: (*parser).callonSortOp13,
 									expr: &seqExpr{
-										pos: position{line: 435, col: 46, offset: 10580},
+										pos: position{line: 444, col: 46, offset: 10873},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 435, col: 46, offset: 10580},
+												pos:  position{line: 444, col: 46, offset: 10873},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 435, col: 49, offset: 10583},
+												pos:   position{line: 444, col: 49, offset: 10876},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 435, col: 51, offset: 10585},
+													pos:  position{line: 444, col: 51, offset: 10878},
 													name: "OrderByList",
 												},
 											},
@@ -3245,30 +3298,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 450, col: 1, offset: 10899},
+			pos:  position{line: 459, col: 1, offset: 11192},
 			expr: &actionExpr{
-				pos: position{line: 450, col: 12, offset: 10910},
+				pos: position{line: 459, col: 12, offset: 11203},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 450, col: 12, offset: 10910},
+					pos:   position{line: 459, col: 12, offset: 11203},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 450, col: 17, offset: 10915},
+						pos: position{line: 459, col: 17, offset: 11208},
 						expr: &actionExpr{
-							pos: position{line: 450, col: 18, offset: 10916},
+							pos: position{line: 459, col: 18, offset: 11209},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 450, col: 18, offset: 10916},
+								pos: position{line: 459, col: 18, offset: 11209},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 450, col: 18, offset: 10916},
+										pos:  position{line: 459, col: 18, offset: 11209},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 450, col: 20, offset: 10918},
+										pos:   position{line: 459, col: 20, offset: 11211},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 450, col: 22, offset: 10920},
+											pos:  position{line: 459, col: 22, offset: 11213},
 											name: "SortArg",
 										},
 									},
@@ -3283,12 +3336,12 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 452, col: 1, offset: 10977},
+			pos:  position{line: 461, col: 1, offset: 11270},
 			expr: &actionExpr{
-				pos: position{line: 453, col: 5, offset: 10989},
+				pos: position{line: 462, col: 5, offset: 11282},
 				run: (*parser).callonSortArg1,
 				expr: &litMatcher{
-					pos:        position{line: 453, col: 5, offset: 10989},
+					pos:        position{line: 462, col: 5, offset: 11282},
 					val:        "-r",
 					ignoreCase: false,
 					want:       "\"-r\"",
@@ -3299,45 +3352,45 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 455, col: 1, offset: 11053},
+			pos:  position{line: 464, col: 1, offset: 11346},
 			expr: &actionExpr{
-				pos: position{line: 456, col: 5, offset: 11063},
+				pos: position{line: 465, col: 5, offset: 11356},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 456, col: 5, offset: 11063},
+					pos: position{line: 465, col: 5, offset: 11356},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 456, col: 5, offset: 11063},
+							pos:  position{line: 465, col: 5, offset: 11356},
 							name: "TOP",
 						},
 						&labeledExpr{
-							pos:   position{line: 456, col: 9, offset: 11067},
+							pos:   position{line: 465, col: 9, offset: 11360},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 456, col: 14, offset: 11072},
+								pos:  position{line: 465, col: 14, offset: 11365},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 456, col: 23, offset: 11081},
+							pos:   position{line: 465, col: 23, offset: 11374},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 456, col: 29, offset: 11087},
+								pos: position{line: 465, col: 29, offset: 11380},
 								expr: &actionExpr{
-									pos: position{line: 456, col: 30, offset: 11088},
+									pos: position{line: 465, col: 30, offset: 11381},
 									run: (*parser).callonTopOp8,
 									expr: &seqExpr{
-										pos: position{line: 456, col: 30, offset: 11088},
+										pos: position{line: 465, col: 30, offset: 11381},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 456, col: 30, offset: 11088},
+												pos:  position{line: 465, col: 30, offset: 11381},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 456, col: 32, offset: 11090},
+												pos:   position{line: 465, col: 32, offset: 11383},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 456, col: 34, offset: 11092},
+													pos:  position{line: 465, col: 34, offset: 11385},
 													name: "Expr",
 												},
 											},
@@ -3347,25 +3400,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 456, col: 59, offset: 11117},
+							pos:   position{line: 465, col: 59, offset: 11410},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 456, col: 65, offset: 11123},
+								pos: position{line: 465, col: 65, offset: 11416},
 								expr: &actionExpr{
-									pos: position{line: 456, col: 66, offset: 11124},
+									pos: position{line: 465, col: 66, offset: 11417},
 									run: (*parser).callonTopOp15,
 									expr: &seqExpr{
-										pos: position{line: 456, col: 66, offset: 11124},
+										pos: position{line: 465, col: 66, offset: 11417},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 456, col: 66, offset: 11124},
+												pos:  position{line: 465, col: 66, offset: 11417},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 456, col: 68, offset: 11126},
+												pos:   position{line: 465, col: 68, offset: 11419},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 456, col: 70, offset: 11128},
+													pos:  position{line: 465, col: 70, offset: 11421},
 													name: "OrderByList",
 												},
 											},
@@ -3382,49 +3435,49 @@ var g = &grammar{
 		},
 		{
 			name: "CallOp",
-			pos:  position{line: 474, col: 1, offset: 11512},
+			pos:  position{line: 483, col: 1, offset: 11805},
 			expr: &actionExpr{
-				pos: position{line: 475, col: 5, offset: 11523},
+				pos: position{line: 484, col: 5, offset: 11816},
 				run: (*parser).callonCallOp1,
 				expr: &seqExpr{
-					pos: position{line: 475, col: 5, offset: 11523},
+					pos: position{line: 484, col: 5, offset: 11816},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 475, col: 5, offset: 11523},
+							pos:  position{line: 484, col: 5, offset: 11816},
 							name: "CALL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 475, col: 10, offset: 11528},
+							pos:  position{line: 484, col: 10, offset: 11821},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 475, col: 12, offset: 11530},
+							pos:   position{line: 484, col: 12, offset: 11823},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 475, col: 17, offset: 11535},
+								pos:  position{line: 484, col: 17, offset: 11828},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 475, col: 28, offset: 11546},
+							pos:   position{line: 484, col: 28, offset: 11839},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 475, col: 33, offset: 11551},
+								pos: position{line: 484, col: 33, offset: 11844},
 								expr: &actionExpr{
-									pos: position{line: 475, col: 34, offset: 11552},
+									pos: position{line: 484, col: 34, offset: 11845},
 									run: (*parser).callonCallOp9,
 									expr: &seqExpr{
-										pos: position{line: 475, col: 35, offset: 11553},
+										pos: position{line: 484, col: 35, offset: 11846},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 475, col: 35, offset: 11553},
+												pos:  position{line: 484, col: 35, offset: 11846},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 475, col: 37, offset: 11555},
+												pos:   position{line: 484, col: 37, offset: 11848},
 												label: "args",
 												expr: &ruleRefExpr{
-													pos:  position{line: 475, col: 42, offset: 11560},
+													pos:  position{line: 484, col: 42, offset: 11853},
 													name: "FuncOrExprs",
 												},
 											},
@@ -3441,29 +3494,29 @@ var g = &grammar{
 		},
 		{
 			name: "CountOp",
-			pos:  position{line: 484, col: 1, offset: 11758},
+			pos:  position{line: 493, col: 1, offset: 12051},
 			expr: &choiceExpr{
-				pos: position{line: 485, col: 5, offset: 11770},
+				pos: position{line: 494, col: 5, offset: 12063},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 485, col: 5, offset: 11770},
+						pos: position{line: 494, col: 5, offset: 12063},
 						run: (*parser).callonCountOp2,
 						expr: &seqExpr{
-							pos: position{line: 485, col: 5, offset: 11770},
+							pos: position{line: 494, col: 5, offset: 12063},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 485, col: 5, offset: 11770},
+									pos:  position{line: 494, col: 5, offset: 12063},
 									name: "COUNT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 485, col: 11, offset: 11776},
+									pos:  position{line: 494, col: 11, offset: 12069},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 485, col: 13, offset: 11778},
+									pos:   position{line: 494, col: 13, offset: 12071},
 									label: "rec",
 									expr: &ruleRefExpr{
-										pos:  position{line: 485, col: 17, offset: 11782},
+										pos:  position{line: 494, col: 17, offset: 12075},
 										name: "Record",
 									},
 								},
@@ -3471,19 +3524,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 492, col: 5, offset: 11924},
+						pos: position{line: 501, col: 5, offset: 12217},
 						run: (*parser).callonCountOp8,
 						expr: &seqExpr{
-							pos: position{line: 492, col: 5, offset: 11924},
+							pos: position{line: 501, col: 5, offset: 12217},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 492, col: 5, offset: 11924},
+									pos:  position{line: 501, col: 5, offset: 12217},
 									name: "COUNT",
 								},
 								&andExpr{
-									pos: position{line: 492, col: 11, offset: 11930},
+									pos: position{line: 501, col: 11, offset: 12223},
 									expr: &ruleRefExpr{
-										pos:  position{line: 492, col: 12, offset: 11931},
+										pos:  position{line: 501, col: 12, offset: 12224},
 										name: "EndOfOp",
 									},
 								},
@@ -3497,26 +3550,26 @@ var g = &grammar{
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 499, col: 1, offset: 12034},
+			pos:  position{line: 508, col: 1, offset: 12327},
 			expr: &actionExpr{
-				pos: position{line: 500, col: 5, offset: 12044},
+				pos: position{line: 509, col: 5, offset: 12337},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 500, col: 5, offset: 12044},
+					pos: position{line: 509, col: 5, offset: 12337},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 5, offset: 12044},
+							pos:  position{line: 509, col: 5, offset: 12337},
 							name: "CUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 9, offset: 12048},
+							pos:  position{line: 509, col: 9, offset: 12341},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 500, col: 11, offset: 12050},
+							pos:   position{line: 509, col: 11, offset: 12343},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 500, col: 16, offset: 12055},
+								pos:  position{line: 509, col: 16, offset: 12348},
 								name: "Assignments",
 							},
 						},
@@ -3528,26 +3581,26 @@ var g = &grammar{
 		},
 		{
 			name: "DistinctOp",
-			pos:  position{line: 508, col: 1, offset: 12203},
+			pos:  position{line: 517, col: 1, offset: 12496},
 			expr: &actionExpr{
-				pos: position{line: 509, col: 5, offset: 12218},
+				pos: position{line: 518, col: 5, offset: 12511},
 				run: (*parser).callonDistinctOp1,
 				expr: &seqExpr{
-					pos: position{line: 509, col: 5, offset: 12218},
+					pos: position{line: 518, col: 5, offset: 12511},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 509, col: 5, offset: 12218},
+							pos:  position{line: 518, col: 5, offset: 12511},
 							name: "DISTINCT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 509, col: 14, offset: 12227},
+							pos:  position{line: 518, col: 14, offset: 12520},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 509, col: 16, offset: 12229},
+							pos:   position{line: 518, col: 16, offset: 12522},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 509, col: 18, offset: 12231},
+								pos:  position{line: 518, col: 18, offset: 12524},
 								name: "Expr",
 							},
 						},
@@ -3559,26 +3612,26 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 517, col: 1, offset: 12371},
+			pos:  position{line: 526, col: 1, offset: 12664},
 			expr: &actionExpr{
-				pos: position{line: 518, col: 5, offset: 12382},
+				pos: position{line: 527, col: 5, offset: 12675},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 518, col: 5, offset: 12382},
+					pos: position{line: 527, col: 5, offset: 12675},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 518, col: 5, offset: 12382},
+							pos:  position{line: 527, col: 5, offset: 12675},
 							name: "DROP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 518, col: 10, offset: 12387},
+							pos:  position{line: 527, col: 10, offset: 12680},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 518, col: 12, offset: 12389},
+							pos:   position{line: 527, col: 12, offset: 12682},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 518, col: 17, offset: 12394},
+								pos:  position{line: 527, col: 17, offset: 12687},
 								name: "Lvals",
 							},
 						},
@@ -3590,38 +3643,38 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 526, col: 1, offset: 12538},
+			pos:  position{line: 535, col: 1, offset: 12831},
 			expr: &choiceExpr{
-				pos: position{line: 527, col: 5, offset: 12549},
+				pos: position{line: 536, col: 5, offset: 12842},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 527, col: 5, offset: 12549},
+						pos: position{line: 536, col: 5, offset: 12842},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 527, col: 5, offset: 12549},
+							pos: position{line: 536, col: 5, offset: 12842},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 527, col: 6, offset: 12550},
+									pos: position{line: 536, col: 6, offset: 12843},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 527, col: 6, offset: 12550},
+											pos:  position{line: 536, col: 6, offset: 12843},
 											name: "HEAD",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 527, col: 13, offset: 12557},
+											pos:  position{line: 536, col: 13, offset: 12850},
 											name: "LIMIT",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 527, col: 20, offset: 12564},
+									pos:  position{line: 536, col: 20, offset: 12857},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 527, col: 22, offset: 12566},
+									pos:   position{line: 536, col: 22, offset: 12859},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 527, col: 28, offset: 12572},
+										pos:  position{line: 536, col: 28, offset: 12865},
 										name: "Expr",
 									},
 								},
@@ -3629,19 +3682,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 534, col: 5, offset: 12706},
+						pos: position{line: 543, col: 5, offset: 12999},
 						run: (*parser).callonHeadOp10,
 						expr: &seqExpr{
-							pos: position{line: 534, col: 5, offset: 12706},
+							pos: position{line: 543, col: 5, offset: 12999},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 534, col: 5, offset: 12706},
+									pos:  position{line: 543, col: 5, offset: 12999},
 									name: "HEAD",
 								},
 								&andExpr{
-									pos: position{line: 534, col: 10, offset: 12711},
+									pos: position{line: 543, col: 10, offset: 13004},
 									expr: &ruleRefExpr{
-										pos:  position{line: 534, col: 11, offset: 12712},
+										pos:  position{line: 543, col: 11, offset: 13005},
 										name: "EndOfOp",
 									},
 								},
@@ -3655,29 +3708,29 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 541, col: 1, offset: 12813},
+			pos:  position{line: 550, col: 1, offset: 13106},
 			expr: &choiceExpr{
-				pos: position{line: 542, col: 5, offset: 12824},
+				pos: position{line: 551, col: 5, offset: 13117},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 542, col: 5, offset: 12824},
+						pos: position{line: 551, col: 5, offset: 13117},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 542, col: 5, offset: 12824},
+							pos: position{line: 551, col: 5, offset: 13117},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 542, col: 5, offset: 12824},
+									pos:  position{line: 551, col: 5, offset: 13117},
 									name: "TAIL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 542, col: 10, offset: 12829},
+									pos:  position{line: 551, col: 10, offset: 13122},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 542, col: 12, offset: 12831},
+									pos:   position{line: 551, col: 12, offset: 13124},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 542, col: 18, offset: 12837},
+										pos:  position{line: 551, col: 18, offset: 13130},
 										name: "Expr",
 									},
 								},
@@ -3685,19 +3738,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 549, col: 5, offset: 12971},
+						pos: position{line: 558, col: 5, offset: 13264},
 						run: (*parser).callonTailOp8,
 						expr: &seqExpr{
-							pos: position{line: 549, col: 5, offset: 12971},
+							pos: position{line: 558, col: 5, offset: 13264},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 549, col: 5, offset: 12971},
+									pos:  position{line: 558, col: 5, offset: 13264},
 									name: "TAIL",
 								},
 								&andExpr{
-									pos: position{line: 549, col: 10, offset: 12976},
+									pos: position{line: 558, col: 10, offset: 13269},
 									expr: &ruleRefExpr{
-										pos:  position{line: 549, col: 11, offset: 12977},
+										pos:  position{line: 558, col: 11, offset: 13270},
 										name: "EndOfOp",
 									},
 								},
@@ -3711,26 +3764,26 @@ var g = &grammar{
 		},
 		{
 			name: "SkipOp",
-			pos:  position{line: 556, col: 1, offset: 13078},
+			pos:  position{line: 565, col: 1, offset: 13371},
 			expr: &actionExpr{
-				pos: position{line: 557, col: 5, offset: 13089},
+				pos: position{line: 566, col: 5, offset: 13382},
 				run: (*parser).callonSkipOp1,
 				expr: &seqExpr{
-					pos: position{line: 557, col: 5, offset: 13089},
+					pos: position{line: 566, col: 5, offset: 13382},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 557, col: 5, offset: 13089},
+							pos:  position{line: 566, col: 5, offset: 13382},
 							name: "SKIP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 557, col: 10, offset: 13094},
+							pos:  position{line: 566, col: 10, offset: 13387},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 557, col: 12, offset: 13096},
+							pos:   position{line: 566, col: 12, offset: 13389},
 							label: "count",
 							expr: &ruleRefExpr{
-								pos:  position{line: 557, col: 18, offset: 13102},
+								pos:  position{line: 566, col: 18, offset: 13395},
 								name: "Expr",
 							},
 						},
@@ -3742,26 +3795,26 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 565, col: 1, offset: 13233},
+			pos:  position{line: 574, col: 1, offset: 13526},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 5, offset: 13245},
+				pos: position{line: 575, col: 5, offset: 13538},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 5, offset: 13245},
+					pos: position{line: 575, col: 5, offset: 13538},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 5, offset: 13245},
+							pos:  position{line: 575, col: 5, offset: 13538},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 11, offset: 13251},
+							pos:  position{line: 575, col: 11, offset: 13544},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 566, col: 13, offset: 13253},
+							pos:   position{line: 575, col: 13, offset: 13546},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 566, col: 18, offset: 13258},
+								pos:  position{line: 575, col: 18, offset: 13551},
 								name: "Expr",
 							},
 						},
@@ -3773,26 +3826,26 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 574, col: 1, offset: 13389},
+			pos:  position{line: 583, col: 1, offset: 13682},
 			expr: &choiceExpr{
-				pos: position{line: 575, col: 5, offset: 13400},
+				pos: position{line: 584, col: 5, offset: 13693},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 575, col: 5, offset: 13400},
+						pos: position{line: 584, col: 5, offset: 13693},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 575, col: 5, offset: 13400},
+							pos: position{line: 584, col: 5, offset: 13693},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 575, col: 5, offset: 13400},
+									pos:  position{line: 584, col: 5, offset: 13693},
 									name: "UNIQ",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 575, col: 10, offset: 13405},
+									pos:  position{line: 584, col: 10, offset: 13698},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 575, col: 12, offset: 13407},
+									pos:        position{line: 584, col: 12, offset: 13700},
 									val:        "-c",
 									ignoreCase: false,
 									want:       "\"-c\"",
@@ -3801,19 +3854,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 578, col: 5, offset: 13496},
+						pos: position{line: 587, col: 5, offset: 13789},
 						run: (*parser).callonUniqOp7,
 						expr: &seqExpr{
-							pos: position{line: 578, col: 5, offset: 13496},
+							pos: position{line: 587, col: 5, offset: 13789},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 578, col: 5, offset: 13496},
+									pos:  position{line: 587, col: 5, offset: 13789},
 									name: "UNIQ",
 								},
 								&andExpr{
-									pos: position{line: 578, col: 10, offset: 13501},
+									pos: position{line: 587, col: 10, offset: 13794},
 									expr: &ruleRefExpr{
-										pos:  position{line: 578, col: 11, offset: 13502},
+										pos:  position{line: 587, col: 11, offset: 13795},
 										name: "EndOfOp",
 									},
 								},
@@ -3827,26 +3880,26 @@ var g = &grammar{
 		},
 		{
 			name: "PutOp",
-			pos:  position{line: 582, col: 1, offset: 13578},
+			pos:  position{line: 591, col: 1, offset: 13871},
 			expr: &actionExpr{
-				pos: position{line: 583, col: 5, offset: 13588},
+				pos: position{line: 592, col: 5, offset: 13881},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 583, col: 5, offset: 13588},
+					pos: position{line: 592, col: 5, offset: 13881},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 583, col: 5, offset: 13588},
+							pos:  position{line: 592, col: 5, offset: 13881},
 							name: "PUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 583, col: 9, offset: 13592},
+							pos:  position{line: 592, col: 9, offset: 13885},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 583, col: 11, offset: 13594},
+							pos:   position{line: 592, col: 11, offset: 13887},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 583, col: 16, offset: 13599},
+								pos:  position{line: 592, col: 16, offset: 13892},
 								name: "Assignments",
 							},
 						},
@@ -3858,59 +3911,59 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 591, col: 1, offset: 13753},
+			pos:  position{line: 600, col: 1, offset: 14046},
 			expr: &actionExpr{
-				pos: position{line: 592, col: 5, offset: 13766},
+				pos: position{line: 601, col: 5, offset: 14059},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 592, col: 5, offset: 13766},
+					pos: position{line: 601, col: 5, offset: 14059},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 5, offset: 13766},
+							pos:  position{line: 601, col: 5, offset: 14059},
 							name: "RENAME",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 12, offset: 13773},
+							pos:  position{line: 601, col: 12, offset: 14066},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 592, col: 14, offset: 13775},
+							pos:   position{line: 601, col: 14, offset: 14068},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 592, col: 20, offset: 13781},
+								pos:  position{line: 601, col: 20, offset: 14074},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 592, col: 31, offset: 13792},
+							pos:   position{line: 601, col: 31, offset: 14085},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 592, col: 36, offset: 13797},
+								pos: position{line: 601, col: 36, offset: 14090},
 								expr: &actionExpr{
-									pos: position{line: 592, col: 37, offset: 13798},
+									pos: position{line: 601, col: 37, offset: 14091},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 592, col: 37, offset: 13798},
+										pos: position{line: 601, col: 37, offset: 14091},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 592, col: 37, offset: 13798},
+												pos:  position{line: 601, col: 37, offset: 14091},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 592, col: 40, offset: 13801},
+												pos:        position{line: 601, col: 40, offset: 14094},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 592, col: 44, offset: 13805},
+												pos:  position{line: 601, col: 44, offset: 14098},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 592, col: 47, offset: 13808},
+												pos:   position{line: 601, col: 47, offset: 14101},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 592, col: 50, offset: 13811},
+													pos:  position{line: 601, col: 50, offset: 14104},
 													name: "Assignment",
 												},
 											},
@@ -3927,24 +3980,24 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 601, col: 1, offset: 14037},
+			pos:  position{line: 610, col: 1, offset: 14330},
 			expr: &choiceExpr{
-				pos: position{line: 602, col: 5, offset: 14048},
+				pos: position{line: 611, col: 5, offset: 14341},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 602, col: 5, offset: 14048},
+						pos: position{line: 611, col: 5, offset: 14341},
 						run: (*parser).callonFuseOp2,
 						expr: &seqExpr{
-							pos: position{line: 602, col: 5, offset: 14048},
+							pos: position{line: 611, col: 5, offset: 14341},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 602, col: 5, offset: 14048},
+									pos:  position{line: 611, col: 5, offset: 14341},
 									name: "FUSE",
 								},
 								&andExpr{
-									pos: position{line: 602, col: 10, offset: 14053},
+									pos: position{line: 611, col: 10, offset: 14346},
 									expr: &ruleRefExpr{
-										pos:  position{line: 602, col: 11, offset: 14054},
+										pos:  position{line: 611, col: 11, offset: 14347},
 										name: "EndOfOp",
 									},
 								},
@@ -3952,19 +4005,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 605, col: 5, offset: 14149},
+						pos: position{line: 614, col: 5, offset: 14442},
 						run: (*parser).callonFuseOp7,
 						expr: &seqExpr{
-							pos: position{line: 605, col: 5, offset: 14149},
+							pos: position{line: 614, col: 5, offset: 14442},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 605, col: 5, offset: 14149},
+									pos:  position{line: 614, col: 5, offset: 14442},
 									name: "BLEND",
 								},
 								&andExpr{
-									pos: position{line: 605, col: 11, offset: 14155},
+									pos: position{line: 614, col: 11, offset: 14448},
 									expr: &ruleRefExpr{
-										pos:  position{line: 605, col: 12, offset: 14156},
+										pos:  position{line: 614, col: 12, offset: 14449},
 										name: "EndOfOp",
 									},
 								},
@@ -3978,41 +4031,41 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 609, col: 1, offset: 14232},
+			pos:  position{line: 618, col: 1, offset: 14525},
 			expr: &choiceExpr{
-				pos: position{line: 610, col: 5, offset: 14243},
+				pos: position{line: 619, col: 5, offset: 14536},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 610, col: 5, offset: 14243},
+						pos: position{line: 619, col: 5, offset: 14536},
 						run: (*parser).callonJoinOp2,
 						expr: &seqExpr{
-							pos: position{line: 610, col: 5, offset: 14243},
+							pos: position{line: 619, col: 5, offset: 14536},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 610, col: 5, offset: 14243},
+									pos:  position{line: 619, col: 5, offset: 14536},
 									name: "CROSS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 610, col: 11, offset: 14249},
+									pos:  position{line: 619, col: 11, offset: 14542},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 610, col: 13, offset: 14251},
+									pos:  position{line: 619, col: 13, offset: 14544},
 									name: "JOIN",
 								},
 								&labeledExpr{
-									pos:   position{line: 610, col: 18, offset: 14256},
+									pos:   position{line: 619, col: 18, offset: 14549},
 									label: "rightInput",
 									expr: &ruleRefExpr{
-										pos:  position{line: 610, col: 29, offset: 14267},
+										pos:  position{line: 619, col: 29, offset: 14560},
 										name: "JoinRightInput",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 610, col: 44, offset: 14282},
+									pos:   position{line: 619, col: 44, offset: 14575},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 610, col: 50, offset: 14288},
+										pos:  position{line: 619, col: 50, offset: 14581},
 										name: "OptJoinAlias",
 									},
 								},
@@ -4020,51 +4073,59 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 624, col: 5, offset: 14595},
+						pos: position{line: 633, col: 5, offset: 14888},
 						run: (*parser).callonJoinOp11,
 						expr: &seqExpr{
-							pos: position{line: 624, col: 5, offset: 14595},
+							pos: position{line: 633, col: 5, offset: 14888},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 624, col: 5, offset: 14595},
+									pos:   position{line: 633, col: 5, offset: 14888},
 									label: "style",
 									expr: &ruleRefExpr{
-										pos:  position{line: 624, col: 11, offset: 14601},
+										pos:  position{line: 633, col: 11, offset: 14894},
 										name: "JoinStyle",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 624, col: 21, offset: 14611},
+									pos:  position{line: 633, col: 21, offset: 14904},
 									name: "JOIN",
 								},
 								&labeledExpr{
-									pos:   position{line: 624, col: 26, offset: 14616},
+									pos:   position{line: 633, col: 26, offset: 14909},
 									label: "rightInput",
 									expr: &ruleRefExpr{
-										pos:  position{line: 624, col: 37, offset: 14627},
+										pos:  position{line: 633, col: 37, offset: 14920},
 										name: "JoinRightInput",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 624, col: 52, offset: 14642},
+									pos:   position{line: 633, col: 52, offset: 14935},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 624, col: 58, offset: 14648},
+										pos:  position{line: 633, col: 58, offset: 14941},
 										name: "OptJoinAlias",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 624, col: 71, offset: 14661},
+									pos:  position{line: 633, col: 71, offset: 14954},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 624, col: 73, offset: 14663},
+									pos:   position{line: 633, col: 73, offset: 14956},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 624, col: 75, offset: 14665},
+										pos:  position{line: 633, col: 75, offset: 14958},
 										name: "JoinCond",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 633, col: 84, offset: 14967},
+									label: "tol",
+									expr: &ruleRefExpr{
+										pos:  position{line: 633, col: 88, offset: 14971},
+										name: "OptTolerance",
+									},
+								},
 							},
 						},
 					},
@@ -4075,83 +4136,125 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 640, col: 1, offset: 15004},
+			pos:  position{line: 652, col: 1, offset: 15381},
 			expr: &choiceExpr{
-				pos: position{line: 641, col: 5, offset: 15018},
+				pos: position{line: 653, col: 5, offset: 15395},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 641, col: 5, offset: 15018},
+						pos: position{line: 653, col: 5, offset: 15395},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 641, col: 5, offset: 15018},
+							pos: position{line: 653, col: 5, offset: 15395},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 653, col: 5, offset: 15395},
+									name: "ASOF",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 653, col: 10, offset: 15400},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 653, col: 12, offset: 15402},
+									name: "LEFT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 653, col: 17, offset: 15407},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 654, col: 5, offset: 15441},
+						run: (*parser).callonJoinStyle8,
+						expr: &seqExpr{
+							pos: position{line: 654, col: 5, offset: 15441},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 654, col: 5, offset: 15441},
+									name: "ASOF",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 654, col: 10, offset: 15446},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 655, col: 5, offset: 15476},
+						run: (*parser).callonJoinStyle12,
+						expr: &seqExpr{
+							pos: position{line: 655, col: 5, offset: 15476},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 641, col: 5, offset: 15018},
+									pos:  position{line: 655, col: 5, offset: 15476},
 									name: "ANTI",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 641, col: 10, offset: 15023},
+									pos:  position{line: 655, col: 10, offset: 15481},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 642, col: 5, offset: 15053},
-						run: (*parser).callonJoinStyle6,
+						pos: position{line: 656, col: 5, offset: 15511},
+						run: (*parser).callonJoinStyle16,
 						expr: &seqExpr{
-							pos: position{line: 642, col: 5, offset: 15053},
+							pos: position{line: 656, col: 5, offset: 15511},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 642, col: 5, offset: 15053},
+									pos:  position{line: 656, col: 5, offset: 15511},
 									name: "INNER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 642, col: 11, offset: 15059},
+									pos:  position{line: 656, col: 11, offset: 15517},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 643, col: 5, offset: 15089},
-						run: (*parser).callonJoinStyle10,
+						pos: position{line: 657, col: 5, offset: 15547},
+						run: (*parser).callonJoinStyle20,
 						expr: &seqExpr{
-							pos: position{line: 643, col: 5, offset: 15089},
+							pos: position{line: 657, col: 5, offset: 15547},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 643, col: 5, offset: 15089},
+									pos:  position{line: 657, col: 5, offset: 15547},
 									name: "LEFT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 643, col: 11, offset: 15095},
+									pos:  position{line: 657, col: 11, offset: 15553},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 644, col: 5, offset: 15124},
-						run: (*parser).callonJoinStyle14,
+						pos: position{line: 658, col: 5, offset: 15582},
+						run: (*parser).callonJoinStyle24,
 						expr: &seqExpr{
-							pos: position{line: 644, col: 5, offset: 15124},
+							pos: position{line: 658, col: 5, offset: 15582},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 644, col: 5, offset: 15124},
+									pos:  position{line: 658, col: 5, offset: 15582},
 									name: "RIGHT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 644, col: 11, offset: 15130},
+									pos:  position{line: 658, col: 11, offset: 15588},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 645, col: 5, offset: 15160},
-						run: (*parser).callonJoinStyle18,
+						pos: position{line: 659, col: 5, offset: 15618},
+						run: (*parser).callonJoinStyle28,
 						expr: &litMatcher{
-							pos:        position{line: 645, col: 5, offset: 15160},
+							pos:        position{line: 659, col: 5, offset: 15618},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4164,33 +4267,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptJoinAlias",
-			pos:  position{line: 647, col: 1, offset: 15188},
+			pos:  position{line: 661, col: 1, offset: 15646},
 			expr: &choiceExpr{
-				pos: position{line: 648, col: 5, offset: 15205},
+				pos: position{line: 662, col: 5, offset: 15663},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 648, col: 5, offset: 15205},
+						pos: position{line: 662, col: 5, offset: 15663},
 						run: (*parser).callonOptJoinAlias2,
 						expr: &seqExpr{
-							pos: position{line: 648, col: 5, offset: 15205},
+							pos: position{line: 662, col: 5, offset: 15663},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 648, col: 5, offset: 15205},
+									pos:  position{line: 662, col: 5, offset: 15663},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 648, col: 7, offset: 15207},
+									pos:  position{line: 662, col: 7, offset: 15665},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 648, col: 10, offset: 15210},
+									pos:  position{line: 662, col: 10, offset: 15668},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 648, col: 12, offset: 15212},
+									pos:   position{line: 662, col: 12, offset: 15670},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 648, col: 14, offset: 15214},
+										pos:  position{line: 662, col: 14, offset: 15672},
 										name: "JoinAlias",
 									},
 								},
@@ -4198,10 +4301,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 649, col: 5, offset: 15246},
+						pos: position{line: 663, col: 5, offset: 15704},
 						run: (*parser).callonOptJoinAlias9,
 						expr: &litMatcher{
-							pos:        position{line: 649, col: 5, offset: 15246},
+							pos:        position{line: 663, col: 5, offset: 15704},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4214,59 +4317,59 @@ var g = &grammar{
 		},
 		{
 			name: "JoinAlias",
-			pos:  position{line: 651, col: 1, offset: 15270},
+			pos:  position{line: 665, col: 1, offset: 15728},
 			expr: &actionExpr{
-				pos: position{line: 652, col: 5, offset: 15284},
+				pos: position{line: 666, col: 5, offset: 15742},
 				run: (*parser).callonJoinAlias1,
 				expr: &seqExpr{
-					pos: position{line: 652, col: 5, offset: 15284},
+					pos: position{line: 666, col: 5, offset: 15742},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 652, col: 5, offset: 15284},
+							pos:        position{line: 666, col: 5, offset: 15742},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 9, offset: 15288},
+							pos:  position{line: 666, col: 9, offset: 15746},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 652, col: 12, offset: 15291},
+							pos:   position{line: 666, col: 12, offset: 15749},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 17, offset: 15296},
+								pos:  position{line: 666, col: 17, offset: 15754},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 28, offset: 15307},
+							pos:  position{line: 666, col: 28, offset: 15765},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 652, col: 31, offset: 15310},
+							pos:        position{line: 666, col: 31, offset: 15768},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 35, offset: 15314},
+							pos:  position{line: 666, col: 35, offset: 15772},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 652, col: 38, offset: 15317},
+							pos:   position{line: 666, col: 38, offset: 15775},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 44, offset: 15323},
+								pos:  position{line: 666, col: 44, offset: 15781},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 55, offset: 15334},
+							pos:  position{line: 666, col: 55, offset: 15792},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 652, col: 58, offset: 15337},
+							pos:        position{line: 666, col: 58, offset: 15795},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -4279,44 +4382,44 @@ var g = &grammar{
 		},
 		{
 			name: "JoinRightInput",
-			pos:  position{line: 660, col: 1, offset: 15475},
+			pos:  position{line: 674, col: 1, offset: 15933},
 			expr: &choiceExpr{
-				pos: position{line: 661, col: 5, offset: 15494},
+				pos: position{line: 675, col: 5, offset: 15952},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 661, col: 5, offset: 15494},
+						pos: position{line: 675, col: 5, offset: 15952},
 						run: (*parser).callonJoinRightInput2,
 						expr: &seqExpr{
-							pos: position{line: 661, col: 5, offset: 15494},
+							pos: position{line: 675, col: 5, offset: 15952},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 661, col: 5, offset: 15494},
+									pos:  position{line: 675, col: 5, offset: 15952},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 661, col: 8, offset: 15497},
+									pos:        position{line: 675, col: 8, offset: 15955},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 661, col: 12, offset: 15501},
+									pos:  position{line: 675, col: 12, offset: 15959},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 661, col: 15, offset: 15504},
+									pos:   position{line: 675, col: 15, offset: 15962},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 661, col: 17, offset: 15506},
+										pos:  position{line: 675, col: 17, offset: 15964},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 661, col: 21, offset: 15510},
+									pos:  position{line: 675, col: 21, offset: 15968},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 661, col: 24, offset: 15513},
+									pos:        position{line: 675, col: 24, offset: 15971},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4325,10 +4428,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 662, col: 5, offset: 15539},
+						pos: position{line: 676, col: 5, offset: 15997},
 						run: (*parser).callonJoinRightInput11,
 						expr: &litMatcher{
-							pos:        position{line: 662, col: 5, offset: 15539},
+							pos:        position{line: 676, col: 5, offset: 15997},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4341,37 +4444,37 @@ var g = &grammar{
 		},
 		{
 			name: "ShapesOp",
-			pos:  position{line: 664, col: 1, offset: 15563},
+			pos:  position{line: 678, col: 1, offset: 16021},
 			expr: &actionExpr{
-				pos: position{line: 665, col: 5, offset: 15576},
+				pos: position{line: 679, col: 5, offset: 16034},
 				run: (*parser).callonShapesOp1,
 				expr: &seqExpr{
-					pos: position{line: 665, col: 5, offset: 15576},
+					pos: position{line: 679, col: 5, offset: 16034},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 5, offset: 15576},
+							pos:  position{line: 679, col: 5, offset: 16034},
 							name: "SHAPES",
 						},
 						&labeledExpr{
-							pos:   position{line: 665, col: 12, offset: 15583},
+							pos:   position{line: 679, col: 12, offset: 16041},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 665, col: 17, offset: 15588},
+								pos: position{line: 679, col: 17, offset: 16046},
 								expr: &actionExpr{
-									pos: position{line: 665, col: 18, offset: 15589},
+									pos: position{line: 679, col: 18, offset: 16047},
 									run: (*parser).callonShapesOp6,
 									expr: &seqExpr{
-										pos: position{line: 665, col: 18, offset: 15589},
+										pos: position{line: 679, col: 18, offset: 16047},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 665, col: 18, offset: 15589},
+												pos:  position{line: 679, col: 18, offset: 16047},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 665, col: 20, offset: 15591},
+												pos:   position{line: 679, col: 20, offset: 16049},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 665, col: 22, offset: 15593},
+													pos:  position{line: 679, col: 22, offset: 16051},
 													name: "Lval",
 												},
 											},
@@ -4388,28 +4491,28 @@ var g = &grammar{
 		},
 		{
 			name: "AssignmentOp",
-			pos:  position{line: 678, col: 1, offset: 16036},
+			pos:  position{line: 692, col: 1, offset: 16494},
 			expr: &actionExpr{
-				pos: position{line: 679, col: 5, offset: 16053},
+				pos: position{line: 693, col: 5, offset: 16511},
 				run: (*parser).callonAssignmentOp1,
 				expr: &seqExpr{
-					pos: position{line: 679, col: 5, offset: 16053},
+					pos: position{line: 693, col: 5, offset: 16511},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 679, col: 5, offset: 16053},
+							pos: position{line: 693, col: 5, offset: 16511},
 							expr: &seqExpr{
-								pos: position{line: 679, col: 7, offset: 16055},
+								pos: position{line: 693, col: 7, offset: 16513},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 679, col: 7, offset: 16055},
+										pos:  position{line: 693, col: 7, offset: 16513},
 										name: "Lval",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 679, col: 12, offset: 16060},
+										pos:  position{line: 693, col: 12, offset: 16518},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 679, col: 15, offset: 16063},
+										pos:        position{line: 693, col: 15, offset: 16521},
 										val:        ":=",
 										ignoreCase: false,
 										want:       "\":=\"",
@@ -4418,10 +4521,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 679, col: 21, offset: 16069},
+							pos:   position{line: 693, col: 21, offset: 16527},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 679, col: 23, offset: 16071},
+								pos:  position{line: 693, col: 23, offset: 16529},
 								name: "Assignments",
 							},
 						},
@@ -4433,36 +4536,36 @@ var g = &grammar{
 		},
 		{
 			name: "LoadOp",
-			pos:  position{line: 687, col: 1, offset: 16243},
+			pos:  position{line: 701, col: 1, offset: 16701},
 			expr: &actionExpr{
-				pos: position{line: 688, col: 5, offset: 16254},
+				pos: position{line: 702, col: 5, offset: 16712},
 				run: (*parser).callonLoadOp1,
 				expr: &seqExpr{
-					pos: position{line: 688, col: 5, offset: 16254},
+					pos: position{line: 702, col: 5, offset: 16712},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 688, col: 5, offset: 16254},
+							pos:  position{line: 702, col: 5, offset: 16712},
 							name: "LOAD",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 688, col: 10, offset: 16259},
+							pos:  position{line: 702, col: 10, offset: 16717},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 688, col: 12, offset: 16261},
+							pos:   position{line: 702, col: 12, offset: 16719},
 							label: "pool",
 							expr: &ruleRefExpr{
-								pos:  position{line: 688, col: 17, offset: 16266},
+								pos:  position{line: 702, col: 17, offset: 16724},
 								name: "Text",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 688, col: 22, offset: 16271},
+							pos:   position{line: 702, col: 22, offset: 16729},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 688, col: 27, offset: 16276},
+								pos: position{line: 702, col: 27, offset: 16734},
 								expr: &ruleRefExpr{
-									pos:  position{line: 688, col: 27, offset: 16276},
+									pos:  position{line: 702, col: 27, offset: 16734},
 									name: "CommitishOpArgs",
 								},
 							},
@@ -4475,26 +4578,26 @@ var g = &grammar{
 		},
 		{
 			name: "OutputOp",
-			pos:  position{line: 697, col: 1, offset: 16458},
+			pos:  position{line: 711, col: 1, offset: 16916},
 			expr: &actionExpr{
-				pos: position{line: 698, col: 5, offset: 16471},
+				pos: position{line: 712, col: 5, offset: 16929},
 				run: (*parser).callonOutputOp1,
 				expr: &seqExpr{
-					pos: position{line: 698, col: 5, offset: 16471},
+					pos: position{line: 712, col: 5, offset: 16929},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 698, col: 5, offset: 16471},
+							pos:  position{line: 712, col: 5, offset: 16929},
 							name: "OUTPUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 698, col: 12, offset: 16478},
+							pos:  position{line: 712, col: 12, offset: 16936},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 698, col: 14, offset: 16480},
+							pos:   position{line: 712, col: 14, offset: 16938},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 698, col: 19, offset: 16485},
+								pos:  position{line: 712, col: 19, offset: 16943},
 								name: "Identifier",
 							},
 						},
@@ -4506,44 +4609,44 @@ var g = &grammar{
 		},
 		{
 			name: "DebugOp",
-			pos:  position{line: 706, col: 1, offset: 16623},
+			pos:  position{line: 720, col: 1, offset: 17081},
 			expr: &actionExpr{
-				pos: position{line: 707, col: 5, offset: 16635},
+				pos: position{line: 721, col: 5, offset: 17093},
 				run: (*parser).callonDebugOp1,
 				expr: &seqExpr{
-					pos: position{line: 707, col: 5, offset: 16635},
+					pos: position{line: 721, col: 5, offset: 17093},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 707, col: 5, offset: 16635},
+							pos:  position{line: 721, col: 5, offset: 17093},
 							name: "DEBUG",
 						},
 						&labeledExpr{
-							pos:   position{line: 707, col: 11, offset: 16641},
+							pos:   position{line: 721, col: 11, offset: 17099},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 707, col: 16, offset: 16646},
+								pos: position{line: 721, col: 16, offset: 17104},
 								expr: &actionExpr{
-									pos: position{line: 707, col: 17, offset: 16647},
+									pos: position{line: 721, col: 17, offset: 17105},
 									run: (*parser).callonDebugOp6,
 									expr: &seqExpr{
-										pos: position{line: 707, col: 17, offset: 16647},
+										pos: position{line: 721, col: 17, offset: 17105},
 										exprs: []any{
 											&notExpr{
-												pos: position{line: 707, col: 17, offset: 16647},
+												pos: position{line: 721, col: 17, offset: 17105},
 												expr: &ruleRefExpr{
-													pos:  position{line: 707, col: 18, offset: 16648},
+													pos:  position{line: 721, col: 18, offset: 17106},
 													name: "FilterClause",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 707, col: 31, offset: 16661},
+												pos:  position{line: 721, col: 31, offset: 17119},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 707, col: 33, offset: 16663},
+												pos:   position{line: 721, col: 33, offset: 17121},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 707, col: 35, offset: 16665},
+													pos:  position{line: 721, col: 35, offset: 17123},
 													name: "Expr",
 												},
 											},
@@ -4553,12 +4656,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 707, col: 60, offset: 16690},
+							pos:   position{line: 721, col: 60, offset: 17148},
 							label: "filter",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 707, col: 67, offset: 16697},
+								pos: position{line: 721, col: 67, offset: 17155},
 								expr: &ruleRefExpr{
-									pos:  position{line: 707, col: 67, offset: 16697},
+									pos:  position{line: 721, col: 67, offset: 17155},
 									name: "FilterClause",
 								},
 							},
@@ -4571,37 +4674,37 @@ var g = &grammar{
 		},
 		{
 			name: "InferOp",
-			pos:  position{line: 721, col: 1, offset: 16953},
+			pos:  position{line: 735, col: 1, offset: 17411},
 			expr: &actionExpr{
-				pos: position{line: 722, col: 5, offset: 16965},
+				pos: position{line: 736, col: 5, offset: 17423},
 				run: (*parser).callonInferOp1,
 				expr: &seqExpr{
-					pos: position{line: 722, col: 5, offset: 16965},
+					pos: position{line: 736, col: 5, offset: 17423},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 722, col: 5, offset: 16965},
+							pos:  position{line: 736, col: 5, offset: 17423},
 							name: "INFER",
 						},
 						&labeledExpr{
-							pos:   position{line: 722, col: 11, offset: 16971},
+							pos:   position{line: 736, col: 11, offset: 17429},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 722, col: 17, offset: 16977},
+								pos: position{line: 736, col: 17, offset: 17435},
 								expr: &actionExpr{
-									pos: position{line: 722, col: 18, offset: 16978},
+									pos: position{line: 736, col: 18, offset: 17436},
 									run: (*parser).callonInferOp6,
 									expr: &seqExpr{
-										pos: position{line: 722, col: 18, offset: 16978},
+										pos: position{line: 736, col: 18, offset: 17436},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 722, col: 18, offset: 16978},
+												pos:  position{line: 736, col: 18, offset: 17436},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 722, col: 20, offset: 16980},
+												pos:   position{line: 736, col: 20, offset: 17438},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 722, col: 22, offset: 16982},
+													pos:  position{line: 736, col: 22, offset: 17440},
 													name: "Expr",
 												},
 											},
@@ -4618,26 +4721,26 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 733, col: 1, offset: 17185},
+			pos:  position{line: 747, col: 1, offset: 17643},
 			expr: &actionExpr{
-				pos: position{line: 734, col: 5, offset: 17196},
+				pos: position{line: 748, col: 5, offset: 17654},
 				run: (*parser).callonFromOp1,
 				expr: &seqExpr{
-					pos: position{line: 734, col: 5, offset: 17196},
+					pos: position{line: 748, col: 5, offset: 17654},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 734, col: 5, offset: 17196},
+							pos:  position{line: 748, col: 5, offset: 17654},
 							name: "FROM",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 734, col: 10, offset: 17201},
+							pos:  position{line: 748, col: 10, offset: 17659},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 734, col: 12, offset: 17203},
+							pos:   position{line: 748, col: 12, offset: 17661},
 							label: "item",
 							expr: &ruleRefExpr{
-								pos:  position{line: 734, col: 17, offset: 17208},
+								pos:  position{line: 748, col: 17, offset: 17666},
 								name: "FromItem",
 							},
 						},
@@ -4649,28 +4752,28 @@ var g = &grammar{
 		},
 		{
 			name: "JoinedTable",
-			pos:  position{line: 742, col: 1, offset: 17344},
+			pos:  position{line: 756, col: 1, offset: 17802},
 			expr: &actionExpr{
-				pos: position{line: 743, col: 5, offset: 17360},
+				pos: position{line: 757, col: 5, offset: 17818},
 				run: (*parser).callonJoinedTable1,
 				expr: &seqExpr{
-					pos: position{line: 743, col: 5, offset: 17360},
+					pos: position{line: 757, col: 5, offset: 17818},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 743, col: 5, offset: 17360},
+							pos:   position{line: 757, col: 5, offset: 17818},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 743, col: 11, offset: 17366},
+								pos:  position{line: 757, col: 11, offset: 17824},
 								name: "SQLTableExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 743, col: 24, offset: 17379},
+							pos:   position{line: 757, col: 24, offset: 17837},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 743, col: 29, offset: 17384},
+								pos: position{line: 757, col: 29, offset: 17842},
 								expr: &ruleRefExpr{
-									pos:  position{line: 743, col: 30, offset: 17385},
+									pos:  position{line: 757, col: 30, offset: 17843},
 									name: "JoinOperation",
 								},
 							},
@@ -4683,40 +4786,40 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTableExpr",
-			pos:  position{line: 761, col: 1, offset: 17829},
+			pos:  position{line: 775, col: 1, offset: 18287},
 			expr: &choiceExpr{
-				pos: position{line: 762, col: 5, offset: 17846},
+				pos: position{line: 776, col: 5, offset: 18304},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 762, col: 5, offset: 17846},
+						pos: position{line: 776, col: 5, offset: 18304},
 						run: (*parser).callonSQLTableExpr2,
 						expr: &seqExpr{
-							pos: position{line: 762, col: 5, offset: 17846},
+							pos: position{line: 776, col: 5, offset: 18304},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 762, col: 5, offset: 17846},
+									pos:        position{line: 776, col: 5, offset: 18304},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 762, col: 9, offset: 17850},
+									pos:  position{line: 776, col: 9, offset: 18308},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 762, col: 12, offset: 17853},
+									pos:   position{line: 776, col: 12, offset: 18311},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 762, col: 18, offset: 17859},
+										pos:  position{line: 776, col: 18, offset: 18317},
 										name: "JoinedTable",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 762, col: 30, offset: 17871},
+									pos:  position{line: 776, col: 30, offset: 18329},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 762, col: 33, offset: 17874},
+									pos:        position{line: 776, col: 33, offset: 18332},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4725,52 +4828,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 763, col: 5, offset: 17904},
+						pos: position{line: 777, col: 5, offset: 18362},
 						run: (*parser).callonSQLTableExpr10,
 						expr: &seqExpr{
-							pos: position{line: 763, col: 5, offset: 17904},
+							pos: position{line: 777, col: 5, offset: 18362},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 763, col: 5, offset: 17904},
+									pos:        position{line: 777, col: 5, offset: 18362},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 763, col: 9, offset: 17908},
+									pos:  position{line: 777, col: 9, offset: 18366},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 763, col: 12, offset: 17911},
+									pos:   position{line: 777, col: 12, offset: 18369},
 									label: "pipe",
 									expr: &ruleRefExpr{
-										pos:  position{line: 763, col: 17, offset: 17916},
+										pos:  position{line: 777, col: 17, offset: 18374},
 										name: "SQLPipe",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 763, col: 25, offset: 17924},
+									pos:  position{line: 777, col: 25, offset: 18382},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 763, col: 28, offset: 17927},
+									pos:        position{line: 777, col: 28, offset: 18385},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 763, col: 32, offset: 17931},
+									pos:   position{line: 777, col: 32, offset: 18389},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 763, col: 34, offset: 17933},
+										pos:  position{line: 777, col: 34, offset: 18391},
 										name: "OptOrdinality",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 763, col: 48, offset: 17947},
+									pos:   position{line: 777, col: 48, offset: 18405},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 763, col: 54, offset: 17953},
+										pos:  position{line: 777, col: 54, offset: 18411},
 										name: "OptAlias",
 									},
 								},
@@ -4778,32 +4881,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 777, col: 5, offset: 18274},
+						pos: position{line: 791, col: 5, offset: 18732},
 						run: (*parser).callonSQLTableExpr22,
 						expr: &seqExpr{
-							pos: position{line: 777, col: 5, offset: 18274},
+							pos: position{line: 791, col: 5, offset: 18732},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 777, col: 5, offset: 18274},
+									pos:   position{line: 791, col: 5, offset: 18732},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 777, col: 7, offset: 18276},
+										pos:  position{line: 791, col: 7, offset: 18734},
 										name: "FromItem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 777, col: 16, offset: 18285},
+									pos:   position{line: 791, col: 16, offset: 18743},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 777, col: 18, offset: 18287},
+										pos:  position{line: 791, col: 18, offset: 18745},
 										name: "OptOrdinality",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 777, col: 32, offset: 18301},
+									pos:   position{line: 791, col: 32, offset: 18759},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 777, col: 38, offset: 18307},
+										pos:  position{line: 791, col: 38, offset: 18765},
 										name: "OptAlias",
 									},
 								},
@@ -4817,28 +4920,28 @@ var g = &grammar{
 		},
 		{
 			name: "FromItem",
-			pos:  position{line: 792, col: 1, offset: 18623},
+			pos:  position{line: 806, col: 1, offset: 19081},
 			expr: &actionExpr{
-				pos: position{line: 793, col: 5, offset: 18636},
+				pos: position{line: 807, col: 5, offset: 19094},
 				run: (*parser).callonFromItem1,
 				expr: &seqExpr{
-					pos: position{line: 793, col: 5, offset: 18636},
+					pos: position{line: 807, col: 5, offset: 19094},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 793, col: 5, offset: 18636},
+							pos:   position{line: 807, col: 5, offset: 19094},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 793, col: 12, offset: 18643},
+								pos:  position{line: 807, col: 12, offset: 19101},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 793, col: 23, offset: 18654},
+							pos:   position{line: 807, col: 23, offset: 19112},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 793, col: 28, offset: 18659},
+								pos: position{line: 807, col: 28, offset: 19117},
 								expr: &ruleRefExpr{
-									pos:  position{line: 793, col: 28, offset: 18659},
+									pos:  position{line: 807, col: 28, offset: 19117},
 									name: "CommitishOpArgs",
 								},
 							},
@@ -4851,34 +4954,34 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 801, col: 1, offset: 18828},
+			pos:  position{line: 815, col: 1, offset: 19286},
 			expr: &choiceExpr{
-				pos: position{line: 802, col: 5, offset: 18843},
+				pos: position{line: 816, col: 5, offset: 19301},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 802, col: 5, offset: 18843},
+						pos:  position{line: 816, col: 5, offset: 19301},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 803, col: 5, offset: 18854},
+						pos:  position{line: 817, col: 5, offset: 19312},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 804, col: 5, offset: 18863},
+						pos: position{line: 818, col: 5, offset: 19321},
 						run: (*parser).callonFromSource4,
 						expr: &seqExpr{
-							pos: position{line: 804, col: 5, offset: 18863},
+							pos: position{line: 818, col: 5, offset: 19321},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 804, col: 5, offset: 18863},
+									pos:        position{line: 818, col: 5, offset: 19321},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&notExpr{
-									pos: position{line: 804, col: 9, offset: 18867},
+									pos: position{line: 818, col: 9, offset: 19325},
 									expr: &ruleRefExpr{
-										pos:  position{line: 804, col: 10, offset: 18868},
+										pos:  position{line: 818, col: 10, offset: 19326},
 										name: "ExprGuard",
 									},
 								},
@@ -4886,31 +4989,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 805, col: 5, offset: 18957},
+						pos: position{line: 819, col: 5, offset: 19415},
 						run: (*parser).callonFromSource9,
 						expr: &labeledExpr{
-							pos:   position{line: 805, col: 5, offset: 18957},
+							pos:   position{line: 819, col: 5, offset: 19415},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 805, col: 7, offset: 18959},
+								pos:  position{line: 819, col: 7, offset: 19417},
 								name: "FString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 812, col: 5, offset: 19103},
+						pos: position{line: 826, col: 5, offset: 19561},
 						run: (*parser).callonFromSource12,
 						expr: &labeledExpr{
-							pos:   position{line: 812, col: 5, offset: 19103},
+							pos:   position{line: 826, col: 5, offset: 19561},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 812, col: 10, offset: 19108},
+								pos:  position{line: 826, col: 10, offset: 19566},
 								name: "ColonName",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 819, col: 5, offset: 19246},
+						pos:  position{line: 833, col: 5, offset: 19704},
 						name: "Text",
 					},
 				},
@@ -4920,34 +5023,34 @@ var g = &grammar{
 		},
 		{
 			name: "Text",
-			pos:  position{line: 821, col: 1, offset: 19252},
+			pos:  position{line: 835, col: 1, offset: 19710},
 			expr: &actionExpr{
-				pos: position{line: 822, col: 4, offset: 19260},
+				pos: position{line: 836, col: 4, offset: 19718},
 				run: (*parser).callonText1,
 				expr: &labeledExpr{
-					pos:   position{line: 822, col: 4, offset: 19260},
+					pos:   position{line: 836, col: 4, offset: 19718},
 					label: "s",
 					expr: &choiceExpr{
-						pos: position{line: 822, col: 7, offset: 19263},
+						pos: position{line: 836, col: 7, offset: 19721},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 7, offset: 19263},
+								pos:  position{line: 836, col: 7, offset: 19721},
 								name: "SimpleURL",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 19, offset: 19275},
+								pos:  position{line: 836, col: 19, offset: 19733},
 								name: "TextChars",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 31, offset: 19287},
+								pos:  position{line: 836, col: 31, offset: 19745},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 52, offset: 19308},
+								pos:  position{line: 836, col: 52, offset: 19766},
 								name: "SingleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 73, offset: 19329},
+								pos:  position{line: 836, col: 73, offset: 19787},
 								name: "RString",
 							},
 						},
@@ -4959,29 +5062,29 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleURL",
-			pos:  position{line: 826, col: 1, offset: 19418},
+			pos:  position{line: 840, col: 1, offset: 19876},
 			expr: &actionExpr{
-				pos: position{line: 827, col: 3, offset: 19432},
+				pos: position{line: 841, col: 3, offset: 19890},
 				run: (*parser).callonSimpleURL1,
 				expr: &seqExpr{
-					pos: position{line: 827, col: 3, offset: 19432},
+					pos: position{line: 841, col: 3, offset: 19890},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 827, col: 4, offset: 19433},
+							pos: position{line: 841, col: 4, offset: 19891},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 827, col: 4, offset: 19433},
+									pos: position{line: 841, col: 4, offset: 19891},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 827, col: 4, offset: 19433},
+											pos:        position{line: 841, col: 4, offset: 19891},
 											val:        "http",
 											ignoreCase: false,
 											want:       "\"http\"",
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 827, col: 11, offset: 19440},
+											pos: position{line: 841, col: 11, offset: 19898},
 											expr: &litMatcher{
-												pos:        position{line: 827, col: 11, offset: 19440},
+												pos:        position{line: 841, col: 11, offset: 19898},
 												val:        "s",
 												ignoreCase: false,
 												want:       "\"s\"",
@@ -4990,7 +5093,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 827, col: 18, offset: 19447},
+									pos:        position{line: 841, col: 18, offset: 19905},
 									val:        "s3",
 									ignoreCase: false,
 									want:       "\"s3\"",
@@ -4998,15 +5101,15 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 827, col: 24, offset: 19453},
+							pos:        position{line: 841, col: 24, offset: 19911},
 							val:        "://",
 							ignoreCase: false,
 							want:       "\"://\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 828, col: 4, offset: 19462},
+							pos: position{line: 842, col: 4, offset: 19920},
 							expr: &charClassMatcher{
-								pos:        position{line: 828, col: 4, offset: 19462},
+								pos:        position{line: 842, col: 4, offset: 19920},
 								val:        "[a-zA-Z0-9_-]",
 								chars:      []rune{'_', '-'},
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -5015,20 +5118,20 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 828, col: 20, offset: 19478},
+							pos: position{line: 842, col: 20, offset: 19936},
 							expr: &seqExpr{
-								pos: position{line: 828, col: 22, offset: 19480},
+								pos: position{line: 842, col: 22, offset: 19938},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 828, col: 22, offset: 19480},
+										pos:        position{line: 842, col: 22, offset: 19938},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 828, col: 26, offset: 19484},
+										pos: position{line: 842, col: 26, offset: 19942},
 										expr: &charClassMatcher{
-											pos:        position{line: 828, col: 26, offset: 19484},
+											pos:        position{line: 842, col: 26, offset: 19942},
 											val:        "[a-zA-Z0-9_-]",
 											chars:      []rune{'_', '-'},
 											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -5040,20 +5143,20 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 829, col: 3, offset: 19503},
+							pos: position{line: 843, col: 3, offset: 19961},
 							expr: &seqExpr{
-								pos: position{line: 829, col: 4, offset: 19504},
+								pos: position{line: 843, col: 4, offset: 19962},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 829, col: 4, offset: 19504},
+										pos:        position{line: 843, col: 4, offset: 19962},
 										val:        "/",
 										ignoreCase: false,
 										want:       "\"/\"",
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 829, col: 8, offset: 19508},
+										pos: position{line: 843, col: 8, offset: 19966},
 										expr: &ruleRefExpr{
-											pos:  position{line: 829, col: 8, offset: 19508},
+											pos:  position{line: 843, col: 8, offset: 19966},
 											name: "TextChars",
 										},
 									},
@@ -5068,27 +5171,27 @@ var g = &grammar{
 		},
 		{
 			name: "TextChars",
-			pos:  position{line: 831, col: 1, offset: 19553},
+			pos:  position{line: 845, col: 1, offset: 20011},
 			expr: &actionExpr{
-				pos: position{line: 832, col: 5, offset: 19567},
+				pos: position{line: 846, col: 5, offset: 20025},
 				run: (*parser).callonTextChars1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 832, col: 5, offset: 19567},
+					pos: position{line: 846, col: 5, offset: 20025},
 					expr: &choiceExpr{
-						pos: position{line: 832, col: 6, offset: 19568},
+						pos: position{line: 846, col: 6, offset: 20026},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 832, col: 6, offset: 19568},
+								pos:  position{line: 846, col: 6, offset: 20026},
 								name: "IdentifierRest",
 							},
 							&litMatcher{
-								pos:        position{line: 832, col: 23, offset: 19585},
+								pos:        position{line: 846, col: 23, offset: 20043},
 								val:        ".",
 								ignoreCase: false,
 								want:       "\".\"",
 							},
 							&litMatcher{
-								pos:        position{line: 832, col: 29, offset: 19591},
+								pos:        position{line: 846, col: 29, offset: 20049},
 								val:        "/",
 								ignoreCase: false,
 								want:       "\"/\"",
@@ -5102,40 +5205,40 @@ var g = &grammar{
 		},
 		{
 			name: "CommitishOpArgs",
-			pos:  position{line: 834, col: 1, offset: 19629},
+			pos:  position{line: 848, col: 1, offset: 20087},
 			expr: &choiceExpr{
-				pos: position{line: 835, col: 5, offset: 19649},
+				pos: position{line: 849, col: 5, offset: 20107},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 835, col: 5, offset: 19649},
+						pos: position{line: 849, col: 5, offset: 20107},
 						run: (*parser).callonCommitishOpArgs2,
 						expr: &seqExpr{
-							pos: position{line: 835, col: 5, offset: 19649},
+							pos: position{line: 849, col: 5, offset: 20107},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 835, col: 5, offset: 19649},
+									pos:  position{line: 849, col: 5, offset: 20107},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 835, col: 8, offset: 19652},
+									pos:   position{line: 849, col: 8, offset: 20110},
 									label: "commit",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 835, col: 15, offset: 19659},
+										pos: position{line: 849, col: 15, offset: 20117},
 										expr: &ruleRefExpr{
-											pos:  position{line: 835, col: 15, offset: 19659},
+											pos:  position{line: 849, col: 15, offset: 20117},
 											name: "MetaCommitish",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 835, col: 30, offset: 19674},
+									pos:  position{line: 849, col: 30, offset: 20132},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 835, col: 33, offset: 19677},
+									pos:   position{line: 849, col: 33, offset: 20135},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 835, col: 38, offset: 19682},
+										pos:  position{line: 849, col: 38, offset: 20140},
 										name: "OpArgs",
 									},
 								},
@@ -5143,20 +5246,20 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 841, col: 5, offset: 19812},
+						pos: position{line: 855, col: 5, offset: 20270},
 						run: (*parser).callonCommitishOpArgs11,
 						expr: &seqExpr{
-							pos: position{line: 841, col: 5, offset: 19812},
+							pos: position{line: 855, col: 5, offset: 20270},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 841, col: 5, offset: 19812},
+									pos:  position{line: 855, col: 5, offset: 20270},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 841, col: 8, offset: 19815},
+									pos:   position{line: 855, col: 8, offset: 20273},
 									label: "commit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 841, col: 15, offset: 19822},
+										pos:  position{line: 855, col: 15, offset: 20280},
 										name: "MetaCommitish",
 									},
 								},
@@ -5170,31 +5273,31 @@ var g = &grammar{
 		},
 		{
 			name: "MetaCommitish",
-			pos:  position{line: 843, col: 1, offset: 19860},
+			pos:  position{line: 857, col: 1, offset: 20318},
 			expr: &choiceExpr{
-				pos: position{line: 844, col: 5, offset: 19878},
+				pos: position{line: 858, col: 5, offset: 20336},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 844, col: 5, offset: 19878},
+						pos: position{line: 858, col: 5, offset: 20336},
 						run: (*parser).callonMetaCommitish2,
 						expr: &seqExpr{
-							pos: position{line: 844, col: 5, offset: 19878},
+							pos: position{line: 858, col: 5, offset: 20336},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 844, col: 5, offset: 19878},
+									pos:   position{line: 858, col: 5, offset: 20336},
 									label: "commit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 844, col: 12, offset: 19885},
+										pos:  position{line: 858, col: 12, offset: 20343},
 										name: "Commitish",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 844, col: 22, offset: 19895},
+									pos:   position{line: 858, col: 22, offset: 20353},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 844, col: 27, offset: 19900},
+										pos: position{line: 858, col: 27, offset: 20358},
 										expr: &ruleRefExpr{
-											pos:  position{line: 844, col: 27, offset: 19900},
+											pos:  position{line: 858, col: 27, offset: 20358},
 											name: "ColonName",
 										},
 									},
@@ -5203,13 +5306,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 851, col: 5, offset: 20124},
+						pos: position{line: 865, col: 5, offset: 20582},
 						run: (*parser).callonMetaCommitish9,
 						expr: &labeledExpr{
-							pos:   position{line: 851, col: 5, offset: 20124},
+							pos:   position{line: 865, col: 5, offset: 20582},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 851, col: 10, offset: 20129},
+								pos:  position{line: 865, col: 10, offset: 20587},
 								name: "ColonName",
 							},
 						},
@@ -5221,24 +5324,24 @@ var g = &grammar{
 		},
 		{
 			name: "Commitish",
-			pos:  position{line: 855, col: 1, offset: 20253},
+			pos:  position{line: 869, col: 1, offset: 20711},
 			expr: &actionExpr{
-				pos: position{line: 856, col: 5, offset: 20267},
+				pos: position{line: 870, col: 5, offset: 20725},
 				run: (*parser).callonCommitish1,
 				expr: &seqExpr{
-					pos: position{line: 856, col: 5, offset: 20267},
+					pos: position{line: 870, col: 5, offset: 20725},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 856, col: 5, offset: 20267},
+							pos:        position{line: 870, col: 5, offset: 20725},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 856, col: 9, offset: 20271},
+							pos:   position{line: 870, col: 9, offset: 20729},
 							label: "text",
 							expr: &ruleRefExpr{
-								pos:  position{line: 856, col: 14, offset: 20276},
+								pos:  position{line: 870, col: 14, offset: 20734},
 								name: "CommitText",
 							},
 						},
@@ -5250,19 +5353,19 @@ var g = &grammar{
 		},
 		{
 			name: "CommitText",
-			pos:  position{line: 860, col: 1, offset: 20411},
+			pos:  position{line: 874, col: 1, offset: 20869},
 			expr: &choiceExpr{
-				pos: position{line: 861, col: 5, offset: 20426},
+				pos: position{line: 875, col: 5, offset: 20884},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 861, col: 5, offset: 20426},
+						pos:  position{line: 875, col: 5, offset: 20884},
 						name: "Name",
 					},
 					&actionExpr{
-						pos: position{line: 862, col: 5, offset: 20435},
+						pos: position{line: 876, col: 5, offset: 20893},
 						run: (*parser).callonCommitText3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 862, col: 5, offset: 20435},
+							pos:  position{line: 876, col: 5, offset: 20893},
 							name: "KSUID",
 						},
 					},
@@ -5273,11 +5376,11 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 864, col: 1, offset: 20513},
+			pos:  position{line: 878, col: 1, offset: 20971},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 864, col: 9, offset: 20521},
+				pos: position{line: 878, col: 9, offset: 20979},
 				expr: &charClassMatcher{
-					pos:        position{line: 864, col: 9, offset: 20521},
+					pos:        position{line: 878, col: 9, offset: 20979},
 					val:        "[0-9a-zA-Z]",
 					ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 					ignoreCase: false,
@@ -5289,40 +5392,40 @@ var g = &grammar{
 		},
 		{
 			name: "OpArg",
-			pos:  position{line: 866, col: 1, offset: 20535},
+			pos:  position{line: 880, col: 1, offset: 20993},
 			expr: &choiceExpr{
-				pos: position{line: 867, col: 5, offset: 20545},
+				pos: position{line: 881, col: 5, offset: 21003},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 867, col: 5, offset: 20545},
+						pos: position{line: 881, col: 5, offset: 21003},
 						run: (*parser).callonOpArg2,
 						expr: &seqExpr{
-							pos: position{line: 867, col: 5, offset: 20545},
+							pos: position{line: 881, col: 5, offset: 21003},
 							exprs: []any{
 								&andExpr{
-									pos: position{line: 867, col: 5, offset: 20545},
+									pos: position{line: 881, col: 5, offset: 21003},
 									expr: &ruleRefExpr{
-										pos:  position{line: 867, col: 6, offset: 20546},
+										pos:  position{line: 881, col: 6, offset: 21004},
 										name: "ArgNameExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 867, col: 18, offset: 20558},
+									pos:   position{line: 881, col: 18, offset: 21016},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 867, col: 22, offset: 20562},
+										pos:  position{line: 881, col: 22, offset: 21020},
 										name: "ArgName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 867, col: 30, offset: 20570},
+									pos:  position{line: 881, col: 30, offset: 21028},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 867, col: 32, offset: 20572},
+									pos:   position{line: 881, col: 32, offset: 21030},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 867, col: 34, offset: 20574},
+										pos:  position{line: 881, col: 34, offset: 21032},
 										name: "Expr",
 									},
 								},
@@ -5330,28 +5433,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 868, col: 5, offset: 20681},
+						pos: position{line: 882, col: 5, offset: 21139},
 						run: (*parser).callonOpArg11,
 						expr: &seqExpr{
-							pos: position{line: 868, col: 5, offset: 20681},
+							pos: position{line: 882, col: 5, offset: 21139},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 868, col: 5, offset: 20681},
+									pos:   position{line: 882, col: 5, offset: 21139},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 868, col: 9, offset: 20685},
+										pos:  position{line: 882, col: 9, offset: 21143},
 										name: "ArgName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 868, col: 17, offset: 20693},
+									pos:  position{line: 882, col: 17, offset: 21151},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 868, col: 19, offset: 20695},
+									pos:   position{line: 882, col: 19, offset: 21153},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 868, col: 21, offset: 20697},
+										pos:  position{line: 882, col: 21, offset: 21155},
 										name: "Text",
 									},
 								},
@@ -5365,51 +5468,51 @@ var g = &grammar{
 		},
 		{
 			name: "OpArgs",
-			pos:  position{line: 870, col: 1, offset: 20802},
+			pos:  position{line: 884, col: 1, offset: 21260},
 			expr: &actionExpr{
-				pos: position{line: 871, col: 5, offset: 20813},
+				pos: position{line: 885, col: 5, offset: 21271},
 				run: (*parser).callonOpArgs1,
 				expr: &seqExpr{
-					pos: position{line: 871, col: 5, offset: 20813},
+					pos: position{line: 885, col: 5, offset: 21271},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 871, col: 5, offset: 20813},
+							pos:        position{line: 885, col: 5, offset: 21271},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 871, col: 9, offset: 20817},
+							pos:  position{line: 885, col: 9, offset: 21275},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 871, col: 12, offset: 20820},
+							pos:   position{line: 885, col: 12, offset: 21278},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 871, col: 18, offset: 20826},
+								pos:  position{line: 885, col: 18, offset: 21284},
 								name: "OpArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 871, col: 24, offset: 20832},
+							pos:   position{line: 885, col: 24, offset: 21290},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 871, col: 29, offset: 20837},
+								pos: position{line: 885, col: 29, offset: 21295},
 								expr: &actionExpr{
-									pos: position{line: 871, col: 30, offset: 20838},
+									pos: position{line: 885, col: 30, offset: 21296},
 									run: (*parser).callonOpArgs9,
 									expr: &seqExpr{
-										pos: position{line: 871, col: 30, offset: 20838},
+										pos: position{line: 885, col: 30, offset: 21296},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 871, col: 30, offset: 20838},
+												pos:  position{line: 885, col: 30, offset: 21296},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 871, col: 32, offset: 20840},
+												pos:   position{line: 885, col: 32, offset: 21298},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 871, col: 34, offset: 20842},
+													pos:  position{line: 885, col: 34, offset: 21300},
 													name: "OpArg",
 												},
 											},
@@ -5419,11 +5522,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 871, col: 60, offset: 20868},
+							pos:  position{line: 885, col: 60, offset: 21326},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 871, col: 63, offset: 20871},
+							pos:        position{line: 885, col: 63, offset: 21329},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5436,14 +5539,14 @@ var g = &grammar{
 		},
 		{
 			name: "ArgName",
-			pos:  position{line: 875, col: 1, offset: 20923},
+			pos:  position{line: 889, col: 1, offset: 21381},
 			expr: &actionExpr{
-				pos: position{line: 875, col: 11, offset: 20933},
+				pos: position{line: 889, col: 11, offset: 21391},
 				run: (*parser).callonArgName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 875, col: 11, offset: 20933},
+					pos: position{line: 889, col: 11, offset: 21391},
 					expr: &ruleRefExpr{
-						pos:  position{line: 875, col: 11, offset: 20933},
+						pos:  position{line: 889, col: 11, offset: 21391},
 						name: "UnicodeLetter",
 					},
 				},
@@ -5453,20 +5556,20 @@ var g = &grammar{
 		},
 		{
 			name: "ArgNameExpr",
-			pos:  position{line: 877, col: 1, offset: 20980},
+			pos:  position{line: 891, col: 1, offset: 21438},
 			expr: &seqExpr{
-				pos: position{line: 878, col: 5, offset: 20996},
+				pos: position{line: 892, col: 5, offset: 21454},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 878, col: 5, offset: 20996},
+						pos:        position{line: 892, col: 5, offset: 21454},
 						val:        "headers",
 						ignoreCase: true,
 						want:       "\"headers\"i",
 					},
 					&notExpr{
-						pos: position{line: 878, col: 16, offset: 21007},
+						pos: position{line: 892, col: 16, offset: 21465},
 						expr: &ruleRefExpr{
-							pos:  position{line: 878, col: 17, offset: 21008},
+							pos:  position{line: 892, col: 17, offset: 21466},
 							name: "UnicodeLetter",
 						},
 					},
@@ -5477,24 +5580,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonName",
-			pos:  position{line: 880, col: 1, offset: 21023},
+			pos:  position{line: 894, col: 1, offset: 21481},
 			expr: &actionExpr{
-				pos: position{line: 881, col: 5, offset: 21037},
+				pos: position{line: 895, col: 5, offset: 21495},
 				run: (*parser).callonColonName1,
 				expr: &seqExpr{
-					pos: position{line: 881, col: 5, offset: 21037},
+					pos: position{line: 895, col: 5, offset: 21495},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 881, col: 5, offset: 21037},
+							pos:        position{line: 895, col: 5, offset: 21495},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 881, col: 9, offset: 21041},
+							pos:   position{line: 895, col: 9, offset: 21499},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 881, col: 11, offset: 21043},
+								pos:  position{line: 895, col: 11, offset: 21501},
 								name: "Name",
 							},
 						},
@@ -5506,21 +5609,21 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 883, col: 1, offset: 21067},
+			pos:  position{line: 897, col: 1, offset: 21525},
 			expr: &actionExpr{
-				pos: position{line: 884, col: 5, offset: 21078},
+				pos: position{line: 898, col: 5, offset: 21536},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 884, col: 5, offset: 21078},
+					pos: position{line: 898, col: 5, offset: 21536},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 884, col: 5, offset: 21078},
+							pos:  position{line: 898, col: 5, offset: 21536},
 							name: "PASS",
 						},
 						&andExpr{
-							pos: position{line: 884, col: 10, offset: 21083},
+							pos: position{line: 898, col: 10, offset: 21541},
 							expr: &ruleRefExpr{
-								pos:  position{line: 884, col: 11, offset: 21084},
+								pos:  position{line: 898, col: 11, offset: 21542},
 								name: "EndOfOp",
 							},
 						},
@@ -5532,26 +5635,26 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 888, col: 1, offset: 21160},
+			pos:  position{line: 902, col: 1, offset: 21618},
 			expr: &actionExpr{
-				pos: position{line: 889, col: 5, offset: 21172},
+				pos: position{line: 903, col: 5, offset: 21630},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 889, col: 5, offset: 21172},
+					pos: position{line: 903, col: 5, offset: 21630},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 889, col: 5, offset: 21172},
+							pos:  position{line: 903, col: 5, offset: 21630},
 							name: "MERGE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 889, col: 11, offset: 21178},
+							pos:  position{line: 903, col: 11, offset: 21636},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 889, col: 13, offset: 21180},
+							pos:   position{line: 903, col: 13, offset: 21638},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 889, col: 19, offset: 21186},
+								pos:  position{line: 903, col: 19, offset: 21644},
 								name: "OrderByList",
 							},
 						},
@@ -5563,59 +5666,59 @@ var g = &grammar{
 		},
 		{
 			name: "UnnestOp",
-			pos:  position{line: 897, col: 1, offset: 21332},
+			pos:  position{line: 911, col: 1, offset: 21790},
 			expr: &actionExpr{
-				pos: position{line: 898, col: 6, offset: 21346},
+				pos: position{line: 912, col: 6, offset: 21804},
 				run: (*parser).callonUnnestOp1,
 				expr: &seqExpr{
-					pos: position{line: 898, col: 6, offset: 21346},
+					pos: position{line: 912, col: 6, offset: 21804},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 898, col: 6, offset: 21346},
+							pos:  position{line: 912, col: 6, offset: 21804},
 							name: "UNNEST",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 898, col: 13, offset: 21353},
+							pos:  position{line: 912, col: 13, offset: 21811},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 898, col: 15, offset: 21355},
+							pos:   position{line: 912, col: 15, offset: 21813},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 898, col: 17, offset: 21357},
+								pos:  position{line: 912, col: 17, offset: 21815},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 898, col: 22, offset: 21362},
+							pos:   position{line: 912, col: 22, offset: 21820},
 							label: "body",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 898, col: 27, offset: 21367},
+								pos: position{line: 912, col: 27, offset: 21825},
 								expr: &actionExpr{
-									pos: position{line: 898, col: 28, offset: 21368},
+									pos: position{line: 912, col: 28, offset: 21826},
 									run: (*parser).callonUnnestOp9,
 									expr: &seqExpr{
-										pos: position{line: 898, col: 28, offset: 21368},
+										pos: position{line: 912, col: 28, offset: 21826},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 898, col: 28, offset: 21368},
+												pos:  position{line: 912, col: 28, offset: 21826},
 												name: "_",
 											},
 											&litMatcher{
-												pos:        position{line: 898, col: 30, offset: 21370},
+												pos:        position{line: 912, col: 30, offset: 21828},
 												val:        "into",
 												ignoreCase: true,
 												want:       "\"into\"i",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 898, col: 38, offset: 21378},
+												pos:  position{line: 912, col: 38, offset: 21836},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 898, col: 40, offset: 21380},
+												pos:   position{line: 912, col: 40, offset: 21838},
 												label: "body",
 												expr: &ruleRefExpr{
-													pos:  position{line: 898, col: 45, offset: 21385},
+													pos:  position{line: 912, col: 45, offset: 21843},
 													name: "ScopeBody",
 												},
 											},
@@ -5632,30 +5735,30 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 910, col: 1, offset: 21626},
+			pos:  position{line: 924, col: 1, offset: 22084},
 			expr: &actionExpr{
-				pos: position{line: 911, col: 5, offset: 21636},
+				pos: position{line: 925, col: 5, offset: 22094},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 911, col: 5, offset: 21636},
+					pos: position{line: 925, col: 5, offset: 22094},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 911, col: 5, offset: 21636},
+							pos:  position{line: 925, col: 5, offset: 22094},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 911, col: 7, offset: 21638},
+							pos:  position{line: 925, col: 7, offset: 22096},
 							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 911, col: 10, offset: 21641},
+							pos:  position{line: 925, col: 10, offset: 22099},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 911, col: 12, offset: 21643},
+							pos:   position{line: 925, col: 12, offset: 22101},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 911, col: 16, offset: 21647},
+								pos:  position{line: 925, col: 16, offset: 22105},
 								name: "Lval",
 							},
 						},
@@ -5667,9 +5770,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 915, col: 1, offset: 21698},
+			pos:  position{line: 929, col: 1, offset: 22156},
 			expr: &ruleRefExpr{
-				pos:  position{line: 915, col: 8, offset: 21705},
+				pos:  position{line: 929, col: 8, offset: 22163},
 				name: "DerefExpr",
 			},
 			leader:        false,
@@ -5677,51 +5780,51 @@ var g = &grammar{
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 917, col: 1, offset: 21716},
+			pos:  position{line: 931, col: 1, offset: 22174},
 			expr: &actionExpr{
-				pos: position{line: 918, col: 5, offset: 21726},
+				pos: position{line: 932, col: 5, offset: 22184},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 918, col: 5, offset: 21726},
+					pos: position{line: 932, col: 5, offset: 22184},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 918, col: 5, offset: 21726},
+							pos:   position{line: 932, col: 5, offset: 22184},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 918, col: 11, offset: 21732},
+								pos:  position{line: 932, col: 11, offset: 22190},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 918, col: 16, offset: 21737},
+							pos:   position{line: 932, col: 16, offset: 22195},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 918, col: 21, offset: 21742},
+								pos: position{line: 932, col: 21, offset: 22200},
 								expr: &actionExpr{
-									pos: position{line: 918, col: 22, offset: 21743},
+									pos: position{line: 932, col: 22, offset: 22201},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 918, col: 22, offset: 21743},
+										pos: position{line: 932, col: 22, offset: 22201},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 918, col: 22, offset: 21743},
+												pos:  position{line: 932, col: 22, offset: 22201},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 918, col: 25, offset: 21746},
+												pos:        position{line: 932, col: 25, offset: 22204},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 918, col: 29, offset: 21750},
+												pos:  position{line: 932, col: 29, offset: 22208},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 918, col: 32, offset: 21753},
+												pos:   position{line: 932, col: 32, offset: 22211},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 918, col: 37, offset: 21758},
+													pos:  position{line: 932, col: 37, offset: 22216},
 													name: "Lval",
 												},
 											},
@@ -5738,51 +5841,51 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 922, col: 1, offset: 21834},
+			pos:  position{line: 936, col: 1, offset: 22292},
 			expr: &actionExpr{
-				pos: position{line: 923, col: 5, offset: 21850},
+				pos: position{line: 937, col: 5, offset: 22308},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 923, col: 5, offset: 21850},
+					pos: position{line: 937, col: 5, offset: 22308},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 923, col: 5, offset: 21850},
+							pos:   position{line: 937, col: 5, offset: 22308},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 923, col: 11, offset: 21856},
+								pos:  position{line: 937, col: 11, offset: 22314},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 923, col: 22, offset: 21867},
+							pos:   position{line: 937, col: 22, offset: 22325},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 923, col: 27, offset: 21872},
+								pos: position{line: 937, col: 27, offset: 22330},
 								expr: &actionExpr{
-									pos: position{line: 923, col: 28, offset: 21873},
+									pos: position{line: 937, col: 28, offset: 22331},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 923, col: 28, offset: 21873},
+										pos: position{line: 937, col: 28, offset: 22331},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 923, col: 28, offset: 21873},
+												pos:  position{line: 937, col: 28, offset: 22331},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 923, col: 31, offset: 21876},
+												pos:        position{line: 937, col: 31, offset: 22334},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 923, col: 35, offset: 21880},
+												pos:  position{line: 937, col: 35, offset: 22338},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 923, col: 38, offset: 21883},
+												pos:   position{line: 937, col: 38, offset: 22341},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 923, col: 40, offset: 21885},
+													pos:  position{line: 937, col: 40, offset: 22343},
 													name: "Assignment",
 												},
 											},
@@ -5799,38 +5902,38 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 927, col: 1, offset: 21960},
+			pos:  position{line: 941, col: 1, offset: 22418},
 			expr: &actionExpr{
-				pos: position{line: 928, col: 5, offset: 21975},
+				pos: position{line: 942, col: 5, offset: 22433},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 928, col: 5, offset: 21975},
+					pos: position{line: 942, col: 5, offset: 22433},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 928, col: 5, offset: 21975},
+							pos:   position{line: 942, col: 5, offset: 22433},
 							label: "lhs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 928, col: 9, offset: 21979},
+								pos: position{line: 942, col: 9, offset: 22437},
 								expr: &actionExpr{
-									pos: position{line: 928, col: 10, offset: 21980},
+									pos: position{line: 942, col: 10, offset: 22438},
 									run: (*parser).callonAssignment5,
 									expr: &seqExpr{
-										pos: position{line: 928, col: 10, offset: 21980},
+										pos: position{line: 942, col: 10, offset: 22438},
 										exprs: []any{
 											&labeledExpr{
-												pos:   position{line: 928, col: 10, offset: 21980},
+												pos:   position{line: 942, col: 10, offset: 22438},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 928, col: 15, offset: 21985},
+													pos:  position{line: 942, col: 15, offset: 22443},
 													name: "Lval",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 928, col: 20, offset: 21990},
+												pos:  position{line: 942, col: 20, offset: 22448},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 928, col: 23, offset: 21993},
+												pos:        position{line: 942, col: 23, offset: 22451},
 												val:        ":=",
 												ignoreCase: false,
 												want:       "\":=\"",
@@ -5841,14 +5944,14 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 928, col: 51, offset: 22021},
+							pos:  position{line: 942, col: 51, offset: 22479},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 928, col: 54, offset: 22024},
+							pos:   position{line: 942, col: 54, offset: 22482},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 928, col: 58, offset: 22028},
+								pos:  position{line: 942, col: 58, offset: 22486},
 								name: "Expr",
 							},
 						},
@@ -5860,9 +5963,9 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 939, col: 1, offset: 22212},
+			pos:  position{line: 953, col: 1, offset: 22670},
 			expr: &ruleRefExpr{
-				pos:  position{line: 939, col: 8, offset: 22219},
+				pos:  position{line: 953, col: 8, offset: 22677},
 				name: "CondExpr",
 			},
 			leader:        false,
@@ -5870,63 +5973,63 @@ var g = &grammar{
 		},
 		{
 			name: "CondExpr",
-			pos:  position{line: 941, col: 1, offset: 22229},
+			pos:  position{line: 955, col: 1, offset: 22687},
 			expr: &actionExpr{
-				pos: position{line: 942, col: 5, offset: 22242},
+				pos: position{line: 956, col: 5, offset: 22700},
 				run: (*parser).callonCondExpr1,
 				expr: &seqExpr{
-					pos: position{line: 942, col: 5, offset: 22242},
+					pos: position{line: 956, col: 5, offset: 22700},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 942, col: 5, offset: 22242},
+							pos:   position{line: 956, col: 5, offset: 22700},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 942, col: 10, offset: 22247},
+								pos:  position{line: 956, col: 10, offset: 22705},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 942, col: 24, offset: 22261},
+							pos:   position{line: 956, col: 24, offset: 22719},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 942, col: 28, offset: 22265},
+								pos: position{line: 956, col: 28, offset: 22723},
 								expr: &seqExpr{
-									pos: position{line: 942, col: 29, offset: 22266},
+									pos: position{line: 956, col: 29, offset: 22724},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 29, offset: 22266},
+											pos:  position{line: 956, col: 29, offset: 22724},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 942, col: 32, offset: 22269},
+											pos:        position{line: 956, col: 32, offset: 22727},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 36, offset: 22273},
+											pos:  position{line: 956, col: 36, offset: 22731},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 39, offset: 22276},
+											pos:  position{line: 956, col: 39, offset: 22734},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 44, offset: 22281},
+											pos:  position{line: 956, col: 44, offset: 22739},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 942, col: 47, offset: 22284},
+											pos:        position{line: 956, col: 47, offset: 22742},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 51, offset: 22288},
+											pos:  position{line: 956, col: 51, offset: 22746},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 942, col: 54, offset: 22291},
+											pos:  position{line: 956, col: 54, offset: 22749},
 											name: "Expr",
 										},
 									},
@@ -5941,53 +6044,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 956, col: 1, offset: 22606},
+			pos:  position{line: 970, col: 1, offset: 23064},
 			expr: &actionExpr{
-				pos: position{line: 957, col: 5, offset: 22624},
+				pos: position{line: 971, col: 5, offset: 23082},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 957, col: 5, offset: 22624},
+					pos: position{line: 971, col: 5, offset: 23082},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 957, col: 5, offset: 22624},
+							pos:   position{line: 971, col: 5, offset: 23082},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 957, col: 11, offset: 22630},
+								pos:  position{line: 971, col: 11, offset: 23088},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 958, col: 5, offset: 22649},
+							pos:   position{line: 972, col: 5, offset: 23107},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 958, col: 10, offset: 22654},
+								pos: position{line: 972, col: 10, offset: 23112},
 								expr: &actionExpr{
-									pos: position{line: 958, col: 11, offset: 22655},
+									pos: position{line: 972, col: 11, offset: 23113},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 958, col: 11, offset: 22655},
+										pos: position{line: 972, col: 11, offset: 23113},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 958, col: 11, offset: 22655},
+												pos:  position{line: 972, col: 11, offset: 23113},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 958, col: 14, offset: 22658},
+												pos:   position{line: 972, col: 14, offset: 23116},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 958, col: 17, offset: 22661},
+													pos:  position{line: 972, col: 17, offset: 23119},
 													name: "OR",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 958, col: 20, offset: 22664},
+												pos:  position{line: 972, col: 20, offset: 23122},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 958, col: 23, offset: 22667},
+												pos:   position{line: 972, col: 23, offset: 23125},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 958, col: 28, offset: 22672},
+													pos:  position{line: 972, col: 28, offset: 23130},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -6004,53 +6107,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 962, col: 1, offset: 22786},
+			pos:  position{line: 976, col: 1, offset: 23244},
 			expr: &actionExpr{
-				pos: position{line: 963, col: 5, offset: 22805},
+				pos: position{line: 977, col: 5, offset: 23263},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 963, col: 5, offset: 22805},
+					pos: position{line: 977, col: 5, offset: 23263},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 963, col: 5, offset: 22805},
+							pos:   position{line: 977, col: 5, offset: 23263},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 963, col: 11, offset: 22811},
+								pos:  position{line: 977, col: 11, offset: 23269},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 964, col: 5, offset: 22823},
+							pos:   position{line: 978, col: 5, offset: 23281},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 964, col: 10, offset: 22828},
+								pos: position{line: 978, col: 10, offset: 23286},
 								expr: &actionExpr{
-									pos: position{line: 964, col: 11, offset: 22829},
+									pos: position{line: 978, col: 11, offset: 23287},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 964, col: 11, offset: 22829},
+										pos: position{line: 978, col: 11, offset: 23287},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 964, col: 11, offset: 22829},
+												pos:  position{line: 978, col: 11, offset: 23287},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 964, col: 14, offset: 22832},
+												pos:   position{line: 978, col: 14, offset: 23290},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 964, col: 17, offset: 22835},
+													pos:  position{line: 978, col: 17, offset: 23293},
 													name: "AND",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 964, col: 21, offset: 22839},
+												pos:  position{line: 978, col: 21, offset: 23297},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 964, col: 24, offset: 22842},
+												pos:   position{line: 978, col: 24, offset: 23300},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 964, col: 29, offset: 22847},
+													pos:  position{line: 978, col: 29, offset: 23305},
 													name: "NotExpr",
 												},
 											},
@@ -6067,43 +6170,43 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 968, col: 1, offset: 22954},
+			pos:  position{line: 982, col: 1, offset: 23412},
 			expr: &choiceExpr{
-				pos: position{line: 969, col: 5, offset: 22966},
+				pos: position{line: 983, col: 5, offset: 23424},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 969, col: 5, offset: 22966},
+						pos: position{line: 983, col: 5, offset: 23424},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 969, col: 5, offset: 22966},
+							pos: position{line: 983, col: 5, offset: 23424},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 969, col: 6, offset: 22967},
+									pos: position{line: 983, col: 6, offset: 23425},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 969, col: 6, offset: 22967},
+											pos: position{line: 983, col: 6, offset: 23425},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 969, col: 6, offset: 22967},
+													pos:  position{line: 983, col: 6, offset: 23425},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 969, col: 10, offset: 22971},
+													pos:  position{line: 983, col: 10, offset: 23429},
 													name: "__",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 969, col: 15, offset: 22976},
+											pos: position{line: 983, col: 15, offset: 23434},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 969, col: 15, offset: 22976},
+													pos:        position{line: 983, col: 15, offset: 23434},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 969, col: 19, offset: 22980},
+													pos:  position{line: 983, col: 19, offset: 23438},
 													name: "__",
 												},
 											},
@@ -6111,10 +6214,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 969, col: 23, offset: 22984},
+									pos:   position{line: 983, col: 23, offset: 23442},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 969, col: 25, offset: 22986},
+										pos:  position{line: 983, col: 25, offset: 23444},
 										name: "NotExpr",
 									},
 								},
@@ -6122,7 +6225,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 977, col: 5, offset: 23152},
+						pos:  position{line: 991, col: 5, offset: 23610},
 						name: "BetweenExpr",
 					},
 				},
@@ -6132,42 +6235,42 @@ var g = &grammar{
 		},
 		{
 			name: "BetweenExpr",
-			pos:  position{line: 979, col: 1, offset: 23165},
+			pos:  position{line: 993, col: 1, offset: 23623},
 			expr: &choiceExpr{
-				pos: position{line: 980, col: 5, offset: 23181},
+				pos: position{line: 994, col: 5, offset: 23639},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 980, col: 5, offset: 23181},
+						pos: position{line: 994, col: 5, offset: 23639},
 						run: (*parser).callonBetweenExpr2,
 						expr: &seqExpr{
-							pos: position{line: 980, col: 5, offset: 23181},
+							pos: position{line: 994, col: 5, offset: 23639},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 980, col: 5, offset: 23181},
+									pos:   position{line: 994, col: 5, offset: 23639},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 980, col: 10, offset: 23186},
+										pos:  position{line: 994, col: 10, offset: 23644},
 										name: "ComparisonExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 25, offset: 23201},
+									pos:  position{line: 994, col: 25, offset: 23659},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 980, col: 27, offset: 23203},
+									pos:   position{line: 994, col: 27, offset: 23661},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 980, col: 31, offset: 23207},
+										pos: position{line: 994, col: 31, offset: 23665},
 										expr: &seqExpr{
-											pos: position{line: 980, col: 32, offset: 23208},
+											pos: position{line: 994, col: 32, offset: 23666},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 980, col: 32, offset: 23208},
+													pos:  position{line: 994, col: 32, offset: 23666},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 980, col: 36, offset: 23212},
+													pos:  position{line: 994, col: 36, offset: 23670},
 													name: "_",
 												},
 											},
@@ -6175,38 +6278,38 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 40, offset: 23216},
+									pos:  position{line: 994, col: 40, offset: 23674},
 									name: "BETWEEN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 48, offset: 23224},
+									pos:  position{line: 994, col: 48, offset: 23682},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 980, col: 50, offset: 23226},
+									pos:   position{line: 994, col: 50, offset: 23684},
 									label: "lower",
 									expr: &ruleRefExpr{
-										pos:  position{line: 980, col: 56, offset: 23232},
+										pos:  position{line: 994, col: 56, offset: 23690},
 										name: "BetweenExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 68, offset: 23244},
+									pos:  position{line: 994, col: 68, offset: 23702},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 70, offset: 23246},
+									pos:  position{line: 994, col: 70, offset: 23704},
 									name: "AND",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 980, col: 74, offset: 23250},
+									pos:  position{line: 994, col: 74, offset: 23708},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 980, col: 76, offset: 23252},
+									pos:   position{line: 994, col: 76, offset: 23710},
 									label: "upper",
 									expr: &ruleRefExpr{
-										pos:  position{line: 980, col: 82, offset: 23258},
+										pos:  position{line: 994, col: 82, offset: 23716},
 										name: "BetweenExpr",
 									},
 								},
@@ -6214,7 +6317,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 990, col: 5, offset: 23498},
+						pos:  position{line: 1004, col: 5, offset: 23956},
 						name: "ComparisonExpr",
 					},
 				},
@@ -6224,46 +6327,46 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 992, col: 1, offset: 23514},
+			pos:  position{line: 1006, col: 1, offset: 23972},
 			expr: &choiceExpr{
-				pos: position{line: 993, col: 5, offset: 23533},
+				pos: position{line: 1007, col: 5, offset: 23991},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 993, col: 5, offset: 23533},
+						pos: position{line: 1007, col: 5, offset: 23991},
 						run: (*parser).callonComparisonExpr2,
 						expr: &seqExpr{
-							pos: position{line: 993, col: 5, offset: 23533},
+							pos: position{line: 1007, col: 5, offset: 23991},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 993, col: 5, offset: 23533},
+									pos:   position{line: 1007, col: 5, offset: 23991},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 993, col: 10, offset: 23538},
+										pos:  position{line: 1007, col: 10, offset: 23996},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 993, col: 23, offset: 23551},
+									pos:  position{line: 1007, col: 23, offset: 24009},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 993, col: 25, offset: 23553},
+									pos:  position{line: 1007, col: 25, offset: 24011},
 									name: "IS",
 								},
 								&labeledExpr{
-									pos:   position{line: 993, col: 28, offset: 23556},
+									pos:   position{line: 1007, col: 28, offset: 24014},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 993, col: 32, offset: 23560},
+										pos: position{line: 1007, col: 32, offset: 24018},
 										expr: &seqExpr{
-											pos: position{line: 993, col: 33, offset: 23561},
+											pos: position{line: 1007, col: 33, offset: 24019},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 993, col: 33, offset: 23561},
+													pos:  position{line: 1007, col: 33, offset: 24019},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 993, col: 35, offset: 23563},
+													pos:  position{line: 1007, col: 35, offset: 24021},
 													name: "NOT",
 												},
 											},
@@ -6271,82 +6374,82 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 993, col: 41, offset: 23569},
+									pos:  position{line: 1007, col: 41, offset: 24027},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 993, col: 43, offset: 23571},
+									pos:  position{line: 1007, col: 43, offset: 24029},
 									name: "NULL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1001, col: 5, offset: 23736},
+						pos: position{line: 1015, col: 5, offset: 24194},
 						run: (*parser).callonComparisonExpr15,
 						expr: &seqExpr{
-							pos: position{line: 1001, col: 5, offset: 23736},
+							pos: position{line: 1015, col: 5, offset: 24194},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1001, col: 5, offset: 23736},
+									pos:   position{line: 1015, col: 5, offset: 24194},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1001, col: 9, offset: 23740},
+										pos:  position{line: 1015, col: 9, offset: 24198},
 										name: "AdditiveExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1001, col: 22, offset: 23753},
+									pos:   position{line: 1015, col: 22, offset: 24211},
 									label: "opAndRHS",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1001, col: 31, offset: 23762},
+										pos: position{line: 1015, col: 31, offset: 24220},
 										expr: &choiceExpr{
-											pos: position{line: 1001, col: 32, offset: 23763},
+											pos: position{line: 1015, col: 32, offset: 24221},
 											alternatives: []any{
 												&seqExpr{
-													pos: position{line: 1001, col: 32, offset: 23763},
+													pos: position{line: 1015, col: 32, offset: 24221},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 32, offset: 23763},
+															pos:  position{line: 1015, col: 32, offset: 24221},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 35, offset: 23766},
+															pos:  position{line: 1015, col: 35, offset: 24224},
 															name: "Comparator",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 46, offset: 23777},
+															pos:  position{line: 1015, col: 46, offset: 24235},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 49, offset: 23780},
+															pos:  position{line: 1015, col: 49, offset: 24238},
 															name: "AdditiveExpr",
 														},
 													},
 												},
 												&seqExpr{
-													pos: position{line: 1001, col: 64, offset: 23795},
+													pos: position{line: 1015, col: 64, offset: 24253},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 64, offset: 23795},
+															pos:  position{line: 1015, col: 64, offset: 24253},
 															name: "__",
 														},
 														&actionExpr{
-															pos: position{line: 1001, col: 68, offset: 23799},
+															pos: position{line: 1015, col: 68, offset: 24257},
 															run: (*parser).callonComparisonExpr29,
 															expr: &litMatcher{
-																pos:        position{line: 1001, col: 68, offset: 23799},
+																pos:        position{line: 1015, col: 68, offset: 24257},
 																val:        "~",
 																ignoreCase: false,
 																want:       "\"~\"",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 104, offset: 23835},
+															pos:  position{line: 1015, col: 104, offset: 24293},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1001, col: 107, offset: 23838},
+															pos:  position{line: 1015, col: 107, offset: 24296},
 															name: "AdditiveExpr",
 														},
 													},
@@ -6365,53 +6468,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 1014, col: 1, offset: 24129},
+			pos:  position{line: 1028, col: 1, offset: 24587},
 			expr: &actionExpr{
-				pos: position{line: 1015, col: 5, offset: 24146},
+				pos: position{line: 1029, col: 5, offset: 24604},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1015, col: 5, offset: 24146},
+					pos: position{line: 1029, col: 5, offset: 24604},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1015, col: 5, offset: 24146},
+							pos:   position{line: 1029, col: 5, offset: 24604},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1015, col: 11, offset: 24152},
+								pos:  position{line: 1029, col: 11, offset: 24610},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1016, col: 5, offset: 24175},
+							pos:   position{line: 1030, col: 5, offset: 24633},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1016, col: 10, offset: 24180},
+								pos: position{line: 1030, col: 10, offset: 24638},
 								expr: &actionExpr{
-									pos: position{line: 1016, col: 11, offset: 24181},
+									pos: position{line: 1030, col: 11, offset: 24639},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1016, col: 11, offset: 24181},
+										pos: position{line: 1030, col: 11, offset: 24639},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1016, col: 11, offset: 24181},
+												pos:  position{line: 1030, col: 11, offset: 24639},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1016, col: 14, offset: 24184},
+												pos:   position{line: 1030, col: 14, offset: 24642},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1016, col: 17, offset: 24187},
+													pos:  position{line: 1030, col: 17, offset: 24645},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1016, col: 34, offset: 24204},
+												pos:  position{line: 1030, col: 34, offset: 24662},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1016, col: 37, offset: 24207},
+												pos:   position{line: 1030, col: 37, offset: 24665},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1016, col: 42, offset: 24212},
+													pos:  position{line: 1030, col: 42, offset: 24670},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -6428,21 +6531,21 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 1020, col: 1, offset: 24330},
+			pos:  position{line: 1034, col: 1, offset: 24788},
 			expr: &actionExpr{
-				pos: position{line: 1020, col: 20, offset: 24349},
+				pos: position{line: 1034, col: 20, offset: 24807},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1020, col: 21, offset: 24350},
+					pos: position{line: 1034, col: 21, offset: 24808},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1020, col: 21, offset: 24350},
+							pos:        position{line: 1034, col: 21, offset: 24808},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1020, col: 27, offset: 24356},
+							pos:        position{line: 1034, col: 27, offset: 24814},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6455,53 +6558,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 1022, col: 1, offset: 24393},
+			pos:  position{line: 1036, col: 1, offset: 24851},
 			expr: &actionExpr{
-				pos: position{line: 1023, col: 5, offset: 24416},
+				pos: position{line: 1037, col: 5, offset: 24874},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1023, col: 5, offset: 24416},
+					pos: position{line: 1037, col: 5, offset: 24874},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1023, col: 5, offset: 24416},
+							pos:   position{line: 1037, col: 5, offset: 24874},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1023, col: 11, offset: 24422},
+								pos:  position{line: 1037, col: 11, offset: 24880},
 								name: "ConcatExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1024, col: 5, offset: 24437},
+							pos:   position{line: 1038, col: 5, offset: 24895},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1024, col: 10, offset: 24442},
+								pos: position{line: 1038, col: 10, offset: 24900},
 								expr: &actionExpr{
-									pos: position{line: 1024, col: 11, offset: 24443},
+									pos: position{line: 1038, col: 11, offset: 24901},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1024, col: 11, offset: 24443},
+										pos: position{line: 1038, col: 11, offset: 24901},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1024, col: 11, offset: 24443},
+												pos:  position{line: 1038, col: 11, offset: 24901},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1024, col: 14, offset: 24446},
+												pos:   position{line: 1038, col: 14, offset: 24904},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1024, col: 17, offset: 24449},
+													pos:  position{line: 1038, col: 17, offset: 24907},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1024, col: 40, offset: 24472},
+												pos:  position{line: 1038, col: 40, offset: 24930},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1024, col: 43, offset: 24475},
+												pos:   position{line: 1038, col: 43, offset: 24933},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1024, col: 48, offset: 24480},
+													pos:  position{line: 1038, col: 48, offset: 24938},
 													name: "ConcatExpr",
 												},
 											},
@@ -6518,27 +6621,27 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 1028, col: 1, offset: 24590},
+			pos:  position{line: 1042, col: 1, offset: 25048},
 			expr: &actionExpr{
-				pos: position{line: 1028, col: 26, offset: 24615},
+				pos: position{line: 1042, col: 26, offset: 25073},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1028, col: 27, offset: 24616},
+					pos: position{line: 1042, col: 27, offset: 25074},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1028, col: 27, offset: 24616},
+							pos:        position{line: 1042, col: 27, offset: 25074},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1028, col: 33, offset: 24622},
+							pos:        position{line: 1042, col: 33, offset: 25080},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1028, col: 39, offset: 24628},
+							pos:        position{line: 1042, col: 39, offset: 25086},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
@@ -6551,51 +6654,51 @@ var g = &grammar{
 		},
 		{
 			name: "ConcatExpr",
-			pos:  position{line: 1030, col: 1, offset: 24665},
+			pos:  position{line: 1044, col: 1, offset: 25123},
 			expr: &actionExpr{
-				pos: position{line: 1031, col: 5, offset: 24680},
+				pos: position{line: 1045, col: 5, offset: 25138},
 				run: (*parser).callonConcatExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1031, col: 5, offset: 24680},
+					pos: position{line: 1045, col: 5, offset: 25138},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1031, col: 5, offset: 24680},
+							pos:   position{line: 1045, col: 5, offset: 25138},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1031, col: 11, offset: 24686},
+								pos:  position{line: 1045, col: 11, offset: 25144},
 								name: "UnaryPlusOrMinus",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1032, col: 5, offset: 24707},
+							pos:   position{line: 1046, col: 5, offset: 25165},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1032, col: 10, offset: 24712},
+								pos: position{line: 1046, col: 10, offset: 25170},
 								expr: &actionExpr{
-									pos: position{line: 1032, col: 11, offset: 24713},
+									pos: position{line: 1046, col: 11, offset: 25171},
 									run: (*parser).callonConcatExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1032, col: 11, offset: 24713},
+										pos: position{line: 1046, col: 11, offset: 25171},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1032, col: 11, offset: 24713},
+												pos:  position{line: 1046, col: 11, offset: 25171},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1032, col: 14, offset: 24716},
+												pos:        position{line: 1046, col: 14, offset: 25174},
 												val:        "||",
 												ignoreCase: false,
 												want:       "\"||\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1032, col: 19, offset: 24721},
+												pos:  position{line: 1046, col: 19, offset: 25179},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1032, col: 22, offset: 24724},
+												pos:   position{line: 1046, col: 22, offset: 25182},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1032, col: 27, offset: 24729},
+													pos:  position{line: 1046, col: 27, offset: 25187},
 													name: "UnaryPlusOrMinus",
 												},
 											},
@@ -6612,40 +6715,40 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryPlusOrMinus",
-			pos:  position{line: 1036, col: 1, offset: 24847},
+			pos:  position{line: 1050, col: 1, offset: 25305},
 			expr: &choiceExpr{
-				pos: position{line: 1037, col: 5, offset: 24868},
+				pos: position{line: 1051, col: 5, offset: 25326},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1037, col: 5, offset: 24868},
+						pos: position{line: 1051, col: 5, offset: 25326},
 						run: (*parser).callonUnaryPlusOrMinus2,
 						expr: &seqExpr{
-							pos: position{line: 1037, col: 5, offset: 24868},
+							pos: position{line: 1051, col: 5, offset: 25326},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1037, col: 5, offset: 24868},
+									pos: position{line: 1051, col: 5, offset: 25326},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1037, col: 6, offset: 24869},
+										pos:  position{line: 1051, col: 6, offset: 25327},
 										name: "Literal",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1037, col: 14, offset: 24877},
+									pos:   position{line: 1051, col: 14, offset: 25335},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1037, col: 17, offset: 24880},
+										pos:  position{line: 1051, col: 17, offset: 25338},
 										name: "PlusOrMinusOp",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1037, col: 31, offset: 24894},
+									pos:  position{line: 1051, col: 31, offset: 25352},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1037, col: 34, offset: 24897},
+									pos:   position{line: 1051, col: 34, offset: 25355},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1037, col: 36, offset: 24899},
+										pos:  position{line: 1051, col: 36, offset: 25357},
 										name: "UnaryPlusOrMinus",
 									},
 								},
@@ -6653,7 +6756,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1046, col: 5, offset: 25083},
+						pos:  position{line: 1060, col: 5, offset: 25541},
 						name: "ColonCast",
 					},
 				},
@@ -6663,21 +6766,21 @@ var g = &grammar{
 		},
 		{
 			name: "PlusOrMinusOp",
-			pos:  position{line: 1048, col: 1, offset: 25094},
+			pos:  position{line: 1062, col: 1, offset: 25552},
 			expr: &actionExpr{
-				pos: position{line: 1048, col: 17, offset: 25110},
+				pos: position{line: 1062, col: 17, offset: 25568},
 				run: (*parser).callonPlusOrMinusOp1,
 				expr: &choiceExpr{
-					pos: position{line: 1048, col: 18, offset: 25111},
+					pos: position{line: 1062, col: 18, offset: 25569},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1048, col: 18, offset: 25111},
+							pos:        position{line: 1062, col: 18, offset: 25569},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1048, col: 24, offset: 25117},
+							pos:        position{line: 1062, col: 24, offset: 25575},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6690,58 +6793,58 @@ var g = &grammar{
 		},
 		{
 			name: "ColonCast",
-			pos:  position{line: 1050, col: 1, offset: 25154},
+			pos:  position{line: 1064, col: 1, offset: 25612},
 			expr: &actionExpr{
-				pos: position{line: 1051, col: 5, offset: 25168},
+				pos: position{line: 1065, col: 5, offset: 25626},
 				run: (*parser).callonColonCast1,
 				expr: &seqExpr{
-					pos: position{line: 1051, col: 5, offset: 25168},
+					pos: position{line: 1065, col: 5, offset: 25626},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1051, col: 5, offset: 25168},
+							pos:   position{line: 1065, col: 5, offset: 25626},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1051, col: 11, offset: 25174},
+								pos:  position{line: 1065, col: 11, offset: 25632},
 								name: "DerefExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1052, col: 5, offset: 25188},
+							pos:   position{line: 1066, col: 5, offset: 25646},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1052, col: 10, offset: 25193},
+								pos: position{line: 1066, col: 10, offset: 25651},
 								expr: &actionExpr{
-									pos: position{line: 1052, col: 11, offset: 25194},
+									pos: position{line: 1066, col: 11, offset: 25652},
 									run: (*parser).callonColonCast7,
 									expr: &seqExpr{
-										pos: position{line: 1052, col: 11, offset: 25194},
+										pos: position{line: 1066, col: 11, offset: 25652},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1052, col: 11, offset: 25194},
+												pos:  position{line: 1066, col: 11, offset: 25652},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1052, col: 14, offset: 25197},
+												pos:        position{line: 1066, col: 14, offset: 25655},
 												val:        "::",
 												ignoreCase: false,
 												want:       "\"::\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1052, col: 19, offset: 25202},
+												pos:  position{line: 1066, col: 19, offset: 25660},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1052, col: 22, offset: 25205},
+												pos:   position{line: 1066, col: 22, offset: 25663},
 												label: "expr",
 												expr: &choiceExpr{
-													pos: position{line: 1052, col: 28, offset: 25211},
+													pos: position{line: 1066, col: 28, offset: 25669},
 													alternatives: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1052, col: 28, offset: 25211},
+															pos:  position{line: 1066, col: 28, offset: 25669},
 															name: "TypeAsValue",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1052, col: 42, offset: 25225},
+															pos:  position{line: 1066, col: 42, offset: 25683},
 															name: "IDExpr",
 														},
 													},
@@ -6760,15 +6863,15 @@ var g = &grammar{
 		},
 		{
 			name: "IDExpr",
-			pos:  position{line: 1056, col: 1, offset: 25332},
+			pos:  position{line: 1070, col: 1, offset: 25790},
 			expr: &actionExpr{
-				pos: position{line: 1056, col: 10, offset: 25341},
+				pos: position{line: 1070, col: 10, offset: 25799},
 				run: (*parser).callonIDExpr1,
 				expr: &labeledExpr{
-					pos:   position{line: 1056, col: 10, offset: 25341},
+					pos:   position{line: 1070, col: 10, offset: 25799},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1056, col: 13, offset: 25344},
+						pos:  position{line: 1070, col: 13, offset: 25802},
 						name: "Identifier",
 					},
 				},
@@ -6778,73 +6881,73 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 1058, col: 1, offset: 25421},
+			pos:  position{line: 1072, col: 1, offset: 25879},
 			expr: &choiceExpr{
-				pos: position{line: 1059, col: 5, offset: 25435},
+				pos: position{line: 1073, col: 5, offset: 25893},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1059, col: 5, offset: 25435},
+						pos: position{line: 1073, col: 5, offset: 25893},
 						run: (*parser).callonDerefExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1059, col: 5, offset: 25435},
+							pos: position{line: 1073, col: 5, offset: 25893},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1059, col: 5, offset: 25435},
+									pos:   position{line: 1073, col: 5, offset: 25893},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 10, offset: 25440},
+										pos:  position{line: 1073, col: 10, offset: 25898},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 20, offset: 25450},
+									pos:        position{line: 1073, col: 20, offset: 25908},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 24, offset: 25454},
+									pos:  position{line: 1073, col: 24, offset: 25912},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 27, offset: 25457},
+									pos:   position{line: 1073, col: 27, offset: 25915},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 32, offset: 25462},
+										pos:  position{line: 1073, col: 32, offset: 25920},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 45, offset: 25475},
+									pos:  position{line: 1073, col: 45, offset: 25933},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 48, offset: 25478},
+									pos:        position{line: 1073, col: 48, offset: 25936},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 52, offset: 25482},
+									pos:  position{line: 1073, col: 52, offset: 25940},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 55, offset: 25485},
+									pos:   position{line: 1073, col: 55, offset: 25943},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1059, col: 58, offset: 25488},
+										pos: position{line: 1073, col: 58, offset: 25946},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1059, col: 58, offset: 25488},
+											pos:  position{line: 1073, col: 58, offset: 25946},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 72, offset: 25502},
+									pos:  position{line: 1073, col: 72, offset: 25960},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 75, offset: 25505},
+									pos:        position{line: 1073, col: 75, offset: 25963},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6853,49 +6956,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1071, col: 5, offset: 25744},
+						pos: position{line: 1085, col: 5, offset: 26202},
 						run: (*parser).callonDerefExpr18,
 						expr: &seqExpr{
-							pos: position{line: 1071, col: 5, offset: 25744},
+							pos: position{line: 1085, col: 5, offset: 26202},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1071, col: 5, offset: 25744},
+									pos:   position{line: 1085, col: 5, offset: 26202},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1071, col: 10, offset: 25749},
+										pos:  position{line: 1085, col: 10, offset: 26207},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1071, col: 20, offset: 25759},
+									pos:        position{line: 1085, col: 20, offset: 26217},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1071, col: 24, offset: 25763},
+									pos:  position{line: 1085, col: 24, offset: 26221},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1071, col: 27, offset: 25766},
+									pos:        position{line: 1085, col: 27, offset: 26224},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1071, col: 31, offset: 25770},
+									pos:  position{line: 1085, col: 31, offset: 26228},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1071, col: 34, offset: 25773},
+									pos:   position{line: 1085, col: 34, offset: 26231},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1071, col: 37, offset: 25776},
+										pos:  position{line: 1085, col: 37, offset: 26234},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1071, col: 50, offset: 25789},
+									pos:        position{line: 1085, col: 50, offset: 26247},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6904,35 +7007,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1079, col: 5, offset: 25953},
+						pos: position{line: 1093, col: 5, offset: 26411},
 						run: (*parser).callonDerefExpr29,
 						expr: &seqExpr{
-							pos: position{line: 1079, col: 5, offset: 25953},
+							pos: position{line: 1093, col: 5, offset: 26411},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1079, col: 5, offset: 25953},
+									pos:   position{line: 1093, col: 5, offset: 26411},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1079, col: 10, offset: 25958},
+										pos:  position{line: 1093, col: 10, offset: 26416},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1079, col: 20, offset: 25968},
+									pos:        position{line: 1093, col: 20, offset: 26426},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1079, col: 24, offset: 25972},
+									pos:   position{line: 1093, col: 24, offset: 26430},
 									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1079, col: 30, offset: 25978},
+										pos:  position{line: 1093, col: 30, offset: 26436},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1079, col: 35, offset: 25983},
+									pos:        position{line: 1093, col: 35, offset: 26441},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6941,30 +7044,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1087, col: 5, offset: 26153},
+						pos: position{line: 1101, col: 5, offset: 26611},
 						run: (*parser).callonDerefExpr37,
 						expr: &seqExpr{
-							pos: position{line: 1087, col: 5, offset: 26153},
+							pos: position{line: 1101, col: 5, offset: 26611},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1087, col: 5, offset: 26153},
+									pos:   position{line: 1101, col: 5, offset: 26611},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1087, col: 10, offset: 26158},
+										pos:  position{line: 1101, col: 10, offset: 26616},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1087, col: 20, offset: 26168},
+									pos:        position{line: 1101, col: 20, offset: 26626},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1087, col: 24, offset: 26172},
+									pos:   position{line: 1101, col: 24, offset: 26630},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1087, col: 27, offset: 26175},
+										pos:  position{line: 1101, col: 27, offset: 26633},
 										name: "DerefKey",
 									},
 								},
@@ -6972,15 +7075,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1096, col: 5, offset: 26363},
+						pos:  position{line: 1110, col: 5, offset: 26821},
 						name: "CaseExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1097, col: 5, offset: 26376},
+						pos:  position{line: 1111, col: 5, offset: 26834},
 						name: "Function",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1098, col: 5, offset: 26389},
+						pos:  position{line: 1112, col: 5, offset: 26847},
 						name: "Primary",
 					},
 				},
@@ -6990,42 +7093,42 @@ var g = &grammar{
 		},
 		{
 			name: "DerefKey",
-			pos:  position{line: 1100, col: 1, offset: 26398},
+			pos:  position{line: 1114, col: 1, offset: 26856},
 			expr: &choiceExpr{
-				pos: position{line: 1101, col: 5, offset: 26411},
+				pos: position{line: 1115, col: 5, offset: 26869},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1101, col: 5, offset: 26411},
+						pos: position{line: 1115, col: 5, offset: 26869},
 						run: (*parser).callonDerefKey2,
 						expr: &labeledExpr{
-							pos:   position{line: 1101, col: 5, offset: 26411},
+							pos:   position{line: 1115, col: 5, offset: 26869},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1101, col: 8, offset: 26414},
+								pos:  position{line: 1115, col: 8, offset: 26872},
 								name: "Identifier",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1102, col: 5, offset: 26505},
+						pos: position{line: 1116, col: 5, offset: 26963},
 						run: (*parser).callonDerefKey5,
 						expr: &labeledExpr{
-							pos:   position{line: 1102, col: 5, offset: 26505},
+							pos:   position{line: 1116, col: 5, offset: 26963},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1102, col: 7, offset: 26507},
+								pos:  position{line: 1116, col: 7, offset: 26965},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1103, col: 5, offset: 26619},
+						pos: position{line: 1117, col: 5, offset: 27077},
 						run: (*parser).callonDerefKey8,
 						expr: &labeledExpr{
-							pos:   position{line: 1103, col: 5, offset: 26619},
+							pos:   position{line: 1117, col: 5, offset: 27077},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1103, col: 7, offset: 26621},
+								pos:  position{line: 1117, col: 7, offset: 27079},
 								name: "BacktickString",
 							},
 						},
@@ -7037,68 +7140,68 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 1105, col: 1, offset: 26730},
+			pos:  position{line: 1119, col: 1, offset: 27188},
 			expr: &choiceExpr{
-				pos: position{line: 1106, col: 5, offset: 26743},
+				pos: position{line: 1120, col: 5, offset: 27201},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1106, col: 5, offset: 26743},
+						pos: position{line: 1120, col: 5, offset: 27201},
 						run: (*parser).callonFunction2,
 						expr: &seqExpr{
-							pos: position{line: 1106, col: 5, offset: 26743},
+							pos: position{line: 1120, col: 5, offset: 27201},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 5, offset: 26743},
+									pos:  position{line: 1120, col: 5, offset: 27201},
 									name: "EXTRACT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 13, offset: 26751},
+									pos:  position{line: 1120, col: 13, offset: 27209},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 16, offset: 26754},
+									pos:        position{line: 1120, col: 16, offset: 27212},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 20, offset: 26758},
+									pos:  position{line: 1120, col: 20, offset: 27216},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1106, col: 23, offset: 26761},
+									pos:   position{line: 1120, col: 23, offset: 27219},
 									label: "part",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 28, offset: 26766},
+										pos:  position{line: 1120, col: 28, offset: 27224},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 33, offset: 26771},
+									pos:  position{line: 1120, col: 33, offset: 27229},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 35, offset: 26773},
+									pos:  position{line: 1120, col: 35, offset: 27231},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 40, offset: 26778},
+									pos:  position{line: 1120, col: 40, offset: 27236},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1106, col: 42, offset: 26780},
+									pos:   position{line: 1120, col: 42, offset: 27238},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 44, offset: 26782},
+										pos:  position{line: 1120, col: 44, offset: 27240},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 49, offset: 26787},
+									pos:  position{line: 1120, col: 49, offset: 27245},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 52, offset: 26790},
+									pos:        position{line: 1120, col: 52, offset: 27248},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7107,43 +7210,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1114, col: 5, offset: 26959},
+						pos: position{line: 1128, col: 5, offset: 27417},
 						run: (*parser).callonFunction17,
 						expr: &seqExpr{
-							pos: position{line: 1114, col: 5, offset: 26959},
+							pos: position{line: 1128, col: 5, offset: 27417},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 5, offset: 26959},
+									pos:  position{line: 1128, col: 5, offset: 27417},
 									name: "EXISTS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 12, offset: 26966},
+									pos:  position{line: 1128, col: 12, offset: 27424},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 15, offset: 26969},
+									pos:        position{line: 1128, col: 15, offset: 27427},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 19, offset: 26973},
+									pos:  position{line: 1128, col: 19, offset: 27431},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1114, col: 22, offset: 26976},
+									pos:   position{line: 1128, col: 22, offset: 27434},
 									label: "body",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1114, col: 27, offset: 26981},
+										pos:  position{line: 1128, col: 27, offset: 27439},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 31, offset: 26985},
+									pos:  position{line: 1128, col: 31, offset: 27443},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 34, offset: 26988},
+									pos:        position{line: 1128, col: 34, offset: 27446},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7152,72 +7255,72 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1121, col: 5, offset: 27133},
+						pos: position{line: 1135, col: 5, offset: 27591},
 						run: (*parser).callonFunction27,
 						expr: &seqExpr{
-							pos: position{line: 1121, col: 5, offset: 27133},
+							pos: position{line: 1135, col: 5, offset: 27591},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 5, offset: 27133},
+									pos:  position{line: 1135, col: 5, offset: 27591},
 									name: "CAST",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 10, offset: 27138},
+									pos:  position{line: 1135, col: 10, offset: 27596},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1121, col: 13, offset: 27141},
+									pos:        position{line: 1135, col: 13, offset: 27599},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 17, offset: 27145},
+									pos:  position{line: 1135, col: 17, offset: 27603},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1121, col: 20, offset: 27148},
+									pos:   position{line: 1135, col: 20, offset: 27606},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1121, col: 22, offset: 27150},
+										pos:  position{line: 1135, col: 22, offset: 27608},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 27, offset: 27155},
+									pos:  position{line: 1135, col: 27, offset: 27613},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 29, offset: 27157},
+									pos:  position{line: 1135, col: 29, offset: 27615},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 32, offset: 27160},
+									pos:  position{line: 1135, col: 32, offset: 27618},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1121, col: 34, offset: 27162},
+									pos:   position{line: 1135, col: 34, offset: 27620},
 									label: "typ",
 									expr: &choiceExpr{
-										pos: position{line: 1121, col: 39, offset: 27167},
+										pos: position{line: 1135, col: 39, offset: 27625},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1121, col: 39, offset: 27167},
+												pos:  position{line: 1135, col: 39, offset: 27625},
 												name: "DateTypeHack",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1121, col: 54, offset: 27182},
+												pos:  position{line: 1135, col: 54, offset: 27640},
 												name: "Type",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1121, col: 60, offset: 27188},
+									pos:  position{line: 1135, col: 60, offset: 27646},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1121, col: 63, offset: 27191},
+									pos:        position{line: 1135, col: 63, offset: 27649},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7226,65 +7329,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1129, col: 5, offset: 27353},
+						pos: position{line: 1143, col: 5, offset: 27811},
 						run: (*parser).callonFunction44,
 						expr: &seqExpr{
-							pos: position{line: 1129, col: 5, offset: 27353},
+							pos: position{line: 1143, col: 5, offset: 27811},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1129, col: 5, offset: 27353},
+									pos:  position{line: 1143, col: 5, offset: 27811},
 									name: "SUBSTRING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1129, col: 15, offset: 27363},
+									pos:  position{line: 1143, col: 15, offset: 27821},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1129, col: 18, offset: 27366},
+									pos:        position{line: 1143, col: 18, offset: 27824},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1129, col: 22, offset: 27370},
+									pos:  position{line: 1143, col: 22, offset: 27828},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1129, col: 25, offset: 27373},
+									pos:   position{line: 1143, col: 25, offset: 27831},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1129, col: 30, offset: 27378},
+										pos:  position{line: 1143, col: 30, offset: 27836},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1129, col: 35, offset: 27383},
+									pos:   position{line: 1143, col: 35, offset: 27841},
 									label: "from",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1129, col: 40, offset: 27388},
+										pos: position{line: 1143, col: 40, offset: 27846},
 										expr: &actionExpr{
-											pos: position{line: 1129, col: 41, offset: 27389},
+											pos: position{line: 1143, col: 41, offset: 27847},
 											run: (*parser).callonFunction54,
 											expr: &seqExpr{
-												pos: position{line: 1129, col: 41, offset: 27389},
+												pos: position{line: 1143, col: 41, offset: 27847},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 41, offset: 27389},
+														pos:  position{line: 1143, col: 41, offset: 27847},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 43, offset: 27391},
+														pos:  position{line: 1143, col: 43, offset: 27849},
 														name: "FROM",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 48, offset: 27396},
+														pos:  position{line: 1143, col: 48, offset: 27854},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1129, col: 50, offset: 27398},
+														pos:   position{line: 1143, col: 50, offset: 27856},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1129, col: 52, offset: 27400},
+															pos:  position{line: 1143, col: 52, offset: 27858},
 															name: "Expr",
 														},
 													},
@@ -7294,33 +7397,33 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1129, col: 77, offset: 27425},
+									pos:   position{line: 1143, col: 77, offset: 27883},
 									label: "for_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1129, col: 82, offset: 27430},
+										pos: position{line: 1143, col: 82, offset: 27888},
 										expr: &actionExpr{
-											pos: position{line: 1129, col: 83, offset: 27431},
+											pos: position{line: 1143, col: 83, offset: 27889},
 											run: (*parser).callonFunction63,
 											expr: &seqExpr{
-												pos: position{line: 1129, col: 83, offset: 27431},
+												pos: position{line: 1143, col: 83, offset: 27889},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 83, offset: 27431},
+														pos:  position{line: 1143, col: 83, offset: 27889},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 85, offset: 27433},
+														pos:  position{line: 1143, col: 85, offset: 27891},
 														name: "FOR",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1129, col: 89, offset: 27437},
+														pos:  position{line: 1143, col: 89, offset: 27895},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1129, col: 91, offset: 27439},
+														pos:   position{line: 1143, col: 91, offset: 27897},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1129, col: 93, offset: 27441},
+															pos:  position{line: 1143, col: 93, offset: 27899},
 															name: "Expr",
 														},
 													},
@@ -7330,7 +7433,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1129, col: 118, offset: 27466},
+									pos:        position{line: 1143, col: 118, offset: 27924},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7339,62 +7442,177 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1143, col: 5, offset: 27751},
+						pos: position{line: 1157, col: 5, offset: 28209},
 						run: (*parser).callonFunction71,
 						expr: &seqExpr{
-							pos: position{line: 1143, col: 5, offset: 27751},
+							pos: position{line: 1157, col: 5, offset: 28209},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1157, col: 5, offset: 28209},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1157, col: 7, offset: 28211},
+										name: "WindowCall",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1157, col: 18, offset: 28222},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1157, col: 20, offset: 28224},
+									name: "OVER",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1157, col: 25, offset: 28229},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 1157, col: 28, offset: 28232},
+									label: "over",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1157, col: 33, offset: 28237},
+										name: "OverClause",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1165, col: 5, offset: 28418},
+						run: (*parser).callonFunction80,
+						expr: &seqExpr{
+							pos: position{line: 1165, col: 5, offset: 28418},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1165, col: 5, offset: 28418},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1165, col: 7, offset: 28420},
+										name: "Callable",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1165, col: 16, offset: 28429},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 1165, col: 19, offset: 28432},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&notExpr{
+									pos: position{line: 1165, col: 23, offset: 28436},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1165, col: 24, offset: 28437},
+										name: "AggArgGuard",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1165, col: 36, offset: 28449},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 1165, col: 39, offset: 28452},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1165, col: 44, offset: 28457},
+										name: "FunctionArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1165, col: 57, offset: 28470},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 1165, col: 60, offset: 28473},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+								&notExpr{
+									pos: position{line: 1165, col: 64, offset: 28477},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1165, col: 65, offset: 28478},
+										name: "FilterClause",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1168, col: 5, offset: 28541},
+						name: "AggFunc",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowCall",
+			pos:  position{line: 1170, col: 1, offset: 28550},
+			expr: &choiceExpr{
+				pos: position{line: 1171, col: 5, offset: 28565},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1171, col: 5, offset: 28565},
+						run: (*parser).callonWindowCall2,
+						expr: &seqExpr{
+							pos: position{line: 1171, col: 5, offset: 28565},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1143, col: 5, offset: 27751},
+									pos:   position{line: 1171, col: 5, offset: 28565},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1143, col: 7, offset: 27753},
+										pos:  position{line: 1171, col: 7, offset: 28567},
 										name: "Callable",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1143, col: 16, offset: 27762},
+									pos:  position{line: 1171, col: 16, offset: 28576},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1143, col: 19, offset: 27765},
+									pos:        position{line: 1171, col: 19, offset: 28579},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&notExpr{
-									pos: position{line: 1143, col: 23, offset: 27769},
+									pos: position{line: 1171, col: 23, offset: 28583},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1143, col: 24, offset: 27770},
+										pos:  position{line: 1171, col: 24, offset: 28584},
 										name: "AggArgGuard",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1143, col: 36, offset: 27782},
+									pos:  position{line: 1171, col: 36, offset: 28596},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1143, col: 39, offset: 27785},
+									pos:   position{line: 1171, col: 39, offset: 28599},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1143, col: 44, offset: 27790},
+										pos:  position{line: 1171, col: 44, offset: 28604},
 										name: "FunctionArgs",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1143, col: 57, offset: 27803},
+									pos:  position{line: 1171, col: 57, offset: 28617},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1143, col: 60, offset: 27806},
+									pos:        position{line: 1171, col: 60, offset: 28620},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&notExpr{
-									pos: position{line: 1143, col: 64, offset: 27810},
+									pos: position{line: 1171, col: 64, offset: 28624},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1143, col: 65, offset: 27811},
+										pos:  position{line: 1171, col: 65, offset: 28625},
 										name: "FilterClause",
 									},
 								},
@@ -7402,7 +7620,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1146, col: 5, offset: 27874},
+						pos:  position{line: 1174, col: 5, offset: 28688},
 						name: "AggFunc",
 					},
 				},
@@ -7412,33 +7630,33 @@ var g = &grammar{
 		},
 		{
 			name: "AggArgGuard",
-			pos:  position{line: 1148, col: 1, offset: 27883},
+			pos:  position{line: 1176, col: 1, offset: 28697},
 			expr: &seqExpr{
-				pos: position{line: 1148, col: 15, offset: 27897},
+				pos: position{line: 1176, col: 15, offset: 28711},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1148, col: 15, offset: 27897},
+						pos:  position{line: 1176, col: 15, offset: 28711},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 1148, col: 19, offset: 27901},
+						pos: position{line: 1176, col: 19, offset: 28715},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 1148, col: 19, offset: 27901},
+								pos:  position{line: 1176, col: 19, offset: 28715},
 								name: "ALL",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1148, col: 25, offset: 27907},
+								pos:  position{line: 1176, col: 25, offset: 28721},
 								name: "DISTINCT",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1148, col: 35, offset: 27917},
+						pos:  position{line: 1176, col: 35, offset: 28731},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1148, col: 37, offset: 27919},
+						pos:  position{line: 1176, col: 37, offset: 28733},
 						name: "Expr",
 					},
 				},
@@ -7448,22 +7666,22 @@ var g = &grammar{
 		},
 		{
 			name: "Callable",
-			pos:  position{line: 1150, col: 1, offset: 27925},
+			pos:  position{line: 1178, col: 1, offset: 28739},
 			expr: &choiceExpr{
-				pos: position{line: 1151, col: 5, offset: 27938},
+				pos: position{line: 1179, col: 5, offset: 28752},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1151, col: 5, offset: 27938},
+						pos:  position{line: 1179, col: 5, offset: 28752},
 						name: "LambdaExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1152, col: 5, offset: 27953},
+						pos: position{line: 1180, col: 5, offset: 28767},
 						run: (*parser).callonCallable3,
 						expr: &labeledExpr{
-							pos:   position{line: 1152, col: 5, offset: 27953},
+							pos:   position{line: 1180, col: 5, offset: 28767},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1152, col: 8, offset: 27956},
+								pos:  position{line: 1180, col: 8, offset: 28770},
 								name: "IdentifierName",
 							},
 						},
@@ -7475,27 +7693,27 @@ var g = &grammar{
 		},
 		{
 			name: "FuncValue",
-			pos:  position{line: 1160, col: 1, offset: 28103},
+			pos:  position{line: 1188, col: 1, offset: 28917},
 			expr: &choiceExpr{
-				pos: position{line: 1161, col: 5, offset: 28117},
+				pos: position{line: 1189, col: 5, offset: 28931},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1161, col: 5, offset: 28117},
+						pos: position{line: 1189, col: 5, offset: 28931},
 						run: (*parser).callonFuncValue2,
 						expr: &seqExpr{
-							pos: position{line: 1161, col: 5, offset: 28117},
+							pos: position{line: 1189, col: 5, offset: 28931},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1161, col: 5, offset: 28117},
+									pos:        position{line: 1189, col: 5, offset: 28931},
 									val:        "&",
 									ignoreCase: false,
 									want:       "\"&\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1161, col: 9, offset: 28121},
+									pos:   position{line: 1189, col: 9, offset: 28935},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1161, col: 12, offset: 28124},
+										pos:  position{line: 1189, col: 12, offset: 28938},
 										name: "IdentifierName",
 									},
 								},
@@ -7503,7 +7721,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1168, col: 5, offset: 28274},
+						pos:  position{line: 1196, col: 5, offset: 29088},
 						name: "LambdaExpr",
 					},
 				},
@@ -7513,12 +7731,12 @@ var g = &grammar{
 		},
 		{
 			name: "DateTypeHack",
-			pos:  position{line: 1170, col: 1, offset: 28286},
+			pos:  position{line: 1198, col: 1, offset: 29100},
 			expr: &actionExpr{
-				pos: position{line: 1171, col: 5, offset: 28303},
+				pos: position{line: 1199, col: 5, offset: 29117},
 				run: (*parser).callonDateTypeHack1,
 				expr: &litMatcher{
-					pos:        position{line: 1171, col: 5, offset: 28303},
+					pos:        position{line: 1199, col: 5, offset: 29117},
 					val:        "date",
 					ignoreCase: true,
 					want:       "\"date\"i",
@@ -7529,19 +7747,19 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionArgs",
-			pos:  position{line: 1178, col: 1, offset: 28415},
+			pos:  position{line: 1206, col: 1, offset: 29229},
 			expr: &choiceExpr{
-				pos: position{line: 1179, col: 5, offset: 28432},
+				pos: position{line: 1207, col: 5, offset: 29246},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1179, col: 5, offset: 28432},
+						pos:  position{line: 1207, col: 5, offset: 29246},
 						name: "FuncOrExprs",
 					},
 					&actionExpr{
-						pos: position{line: 1180, col: 5, offset: 28448},
+						pos: position{line: 1208, col: 5, offset: 29262},
 						run: (*parser).callonFunctionArgs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1180, col: 5, offset: 28448},
+							pos:  position{line: 1208, col: 5, offset: 29262},
 							name: "__",
 						},
 					},
@@ -7552,51 +7770,51 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 1182, col: 1, offset: 28476},
+			pos:  position{line: 1210, col: 1, offset: 29290},
 			expr: &actionExpr{
-				pos: position{line: 1183, col: 5, offset: 28486},
+				pos: position{line: 1211, col: 5, offset: 29300},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 1183, col: 5, offset: 28486},
+					pos: position{line: 1211, col: 5, offset: 29300},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1183, col: 5, offset: 28486},
+							pos:   position{line: 1211, col: 5, offset: 29300},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1183, col: 11, offset: 28492},
+								pos:  position{line: 1211, col: 11, offset: 29306},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1183, col: 16, offset: 28497},
+							pos:   position{line: 1211, col: 16, offset: 29311},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1183, col: 21, offset: 28502},
+								pos: position{line: 1211, col: 21, offset: 29316},
 								expr: &actionExpr{
-									pos: position{line: 1183, col: 22, offset: 28503},
+									pos: position{line: 1211, col: 22, offset: 29317},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 1183, col: 22, offset: 28503},
+										pos: position{line: 1211, col: 22, offset: 29317},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1183, col: 22, offset: 28503},
+												pos:  position{line: 1211, col: 22, offset: 29317},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1183, col: 25, offset: 28506},
+												pos:        position{line: 1211, col: 25, offset: 29320},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1183, col: 29, offset: 28510},
+												pos:  position{line: 1211, col: 29, offset: 29324},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1183, col: 32, offset: 28513},
+												pos:   position{line: 1211, col: 32, offset: 29327},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1183, col: 34, offset: 28515},
+													pos:  position{line: 1211, col: 34, offset: 29329},
 													name: "Expr",
 												},
 											},
@@ -7613,80 +7831,84 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 1187, col: 1, offset: 28588},
+			pos:  position{line: 1215, col: 1, offset: 29402},
 			expr: &choiceExpr{
-				pos: position{line: 1188, col: 5, offset: 28600},
+				pos: position{line: 1216, col: 5, offset: 29414},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1188, col: 5, offset: 28600},
+						pos:  position{line: 1216, col: 5, offset: 29414},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 5, offset: 28611},
+						pos:  position{line: 1217, col: 5, offset: 29425},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1190, col: 5, offset: 28621},
+						pos:  position{line: 1218, col: 5, offset: 29435},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1191, col: 5, offset: 28629},
+						pos:  position{line: 1219, col: 5, offset: 29443},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1192, col: 5, offset: 28637},
+						pos:  position{line: 1220, col: 5, offset: 29451},
 						name: "SQLTimeExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1193, col: 5, offset: 28653},
+						pos:  position{line: 1221, col: 5, offset: 29467},
 						name: "Literal",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1222, col: 5, offset: 29479},
+						name: "Param",
+					},
 					&actionExpr{
-						pos: position{line: 1194, col: 5, offset: 28665},
-						run: (*parser).callonPrimary8,
+						pos: position{line: 1223, col: 5, offset: 29489},
+						run: (*parser).callonPrimary9,
 						expr: &labeledExpr{
-							pos:   position{line: 1194, col: 5, offset: 28665},
+							pos:   position{line: 1223, col: 5, offset: 29489},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1194, col: 8, offset: 28668},
+								pos:  position{line: 1223, col: 8, offset: 29492},
 								name: "Identifier",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1195, col: 5, offset: 28761},
+						pos:  position{line: 1224, col: 5, offset: 29585},
 						name: "Tuple",
 					},
 					&actionExpr{
-						pos: position{line: 1196, col: 5, offset: 28771},
-						run: (*parser).callonPrimary12,
+						pos: position{line: 1225, col: 5, offset: 29595},
+						run: (*parser).callonPrimary13,
 						expr: &seqExpr{
-							pos: position{line: 1196, col: 5, offset: 28771},
+							pos: position{line: 1225, col: 5, offset: 29595},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1196, col: 5, offset: 28771},
+									pos:        position{line: 1225, col: 5, offset: 29595},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1196, col: 9, offset: 28775},
+									pos:  position{line: 1225, col: 9, offset: 29599},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1196, col: 12, offset: 28778},
+									pos:   position{line: 1225, col: 12, offset: 29602},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1196, col: 17, offset: 28783},
+										pos:  position{line: 1225, col: 17, offset: 29607},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1196, col: 22, offset: 28788},
+									pos:  position{line: 1225, col: 22, offset: 29612},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1196, col: 25, offset: 28791},
+									pos:        position{line: 1225, col: 25, offset: 29615},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7695,35 +7917,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1197, col: 5, offset: 28820},
-						run: (*parser).callonPrimary20,
+						pos: position{line: 1226, col: 5, offset: 29644},
+						run: (*parser).callonPrimary21,
 						expr: &seqExpr{
-							pos: position{line: 1197, col: 5, offset: 28820},
+							pos: position{line: 1226, col: 5, offset: 29644},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1197, col: 5, offset: 28820},
+									pos:        position{line: 1226, col: 5, offset: 29644},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1197, col: 9, offset: 28824},
+									pos:  position{line: 1226, col: 9, offset: 29648},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1197, col: 12, offset: 28827},
+									pos:   position{line: 1226, col: 12, offset: 29651},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1197, col: 17, offset: 28832},
+										pos:  position{line: 1226, col: 17, offset: 29656},
 										name: "SubqueryExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1197, col: 30, offset: 28845},
+									pos:  position{line: 1226, col: 30, offset: 29669},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1197, col: 33, offset: 28848},
+									pos:        position{line: 1226, col: 33, offset: 29672},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7732,35 +7954,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1198, col: 5, offset: 28877},
-						run: (*parser).callonPrimary28,
+						pos: position{line: 1227, col: 5, offset: 29701},
+						run: (*parser).callonPrimary29,
 						expr: &seqExpr{
-							pos: position{line: 1198, col: 5, offset: 28877},
+							pos: position{line: 1227, col: 5, offset: 29701},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1198, col: 5, offset: 28877},
+									pos:        position{line: 1227, col: 5, offset: 29701},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1198, col: 9, offset: 28881},
+									pos:  position{line: 1227, col: 9, offset: 29705},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1198, col: 12, offset: 28884},
+									pos:   position{line: 1227, col: 12, offset: 29708},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1198, col: 17, offset: 28889},
+										pos:  position{line: 1227, col: 17, offset: 29713},
 										name: "SubqueryExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1198, col: 30, offset: 28902},
+									pos:  position{line: 1227, col: 30, offset: 29726},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1198, col: 33, offset: 28905},
+									pos:        position{line: 1227, col: 33, offset: 29729},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -7773,55 +7995,95 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "Param",
+			pos:  position{line: 1232, col: 1, offset: 29811},
+			expr: &actionExpr{
+				pos: position{line: 1233, col: 5, offset: 29821},
+				run: (*parser).callonParam1,
+				expr: &seqExpr{
+					pos: position{line: 1233, col: 5, offset: 29821},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 1233, col: 6, offset: 29822},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 1233, col: 6, offset: 29822},
+									val:        "$",
+									ignoreCase: false,
+									want:       "\"$\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1233, col: 12, offset: 29828},
+									val:        ":",
+									ignoreCase: false,
+									want:       "\":\"",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1233, col: 17, offset: 29833},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1233, col: 22, offset: 29838},
+								name: "IdentifierName",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "CaseExpr",
-			pos:  position{line: 1203, col: 1, offset: 28987},
+			pos:  position{line: 1237, col: 1, offset: 29948},
 			expr: &choiceExpr{
-				pos: position{line: 1204, col: 5, offset: 29000},
+				pos: position{line: 1238, col: 5, offset: 29961},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1204, col: 5, offset: 29000},
+						pos: position{line: 1238, col: 5, offset: 29961},
 						run: (*parser).callonCaseExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1204, col: 5, offset: 29000},
+							pos: position{line: 1238, col: 5, offset: 29961},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1204, col: 5, offset: 29000},
+									pos:  position{line: 1238, col: 5, offset: 29961},
 									name: "CASE",
 								},
 								&labeledExpr{
-									pos:   position{line: 1204, col: 10, offset: 29005},
+									pos:   position{line: 1238, col: 10, offset: 29966},
 									label: "whens",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1204, col: 16, offset: 29011},
+										pos: position{line: 1238, col: 16, offset: 29972},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1204, col: 16, offset: 29011},
+											pos:  position{line: 1238, col: 16, offset: 29972},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1204, col: 22, offset: 29017},
+									pos:   position{line: 1238, col: 22, offset: 29978},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1204, col: 28, offset: 29023},
+										pos: position{line: 1238, col: 28, offset: 29984},
 										expr: &seqExpr{
-											pos: position{line: 1204, col: 29, offset: 29024},
+											pos: position{line: 1238, col: 29, offset: 29985},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1204, col: 29, offset: 29024},
+													pos:  position{line: 1238, col: 29, offset: 29985},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1204, col: 31, offset: 29026},
+													pos:  position{line: 1238, col: 31, offset: 29987},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1204, col: 36, offset: 29031},
+													pos:  position{line: 1238, col: 36, offset: 29992},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1204, col: 38, offset: 29033},
+													pos:  position{line: 1238, col: 38, offset: 29994},
 													name: "Expr",
 												},
 											},
@@ -7829,24 +8091,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1204, col: 45, offset: 29040},
+									pos:  position{line: 1238, col: 45, offset: 30001},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1204, col: 47, offset: 29042},
+									pos:  position{line: 1238, col: 47, offset: 30003},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1204, col: 51, offset: 29046},
+									pos: position{line: 1238, col: 51, offset: 30007},
 									expr: &seqExpr{
-										pos: position{line: 1204, col: 52, offset: 29047},
+										pos: position{line: 1238, col: 52, offset: 30008},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1204, col: 52, offset: 29047},
+												pos:  position{line: 1238, col: 52, offset: 30008},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1204, col: 54, offset: 29049},
+												pos:  position{line: 1238, col: 54, offset: 30010},
 												name: "CASE",
 											},
 										},
@@ -7856,60 +8118,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1215, col: 5, offset: 29322},
+						pos: position{line: 1249, col: 5, offset: 30283},
 						run: (*parser).callonCaseExpr21,
 						expr: &seqExpr{
-							pos: position{line: 1215, col: 5, offset: 29322},
+							pos: position{line: 1249, col: 5, offset: 30283},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1215, col: 5, offset: 29322},
+									pos:  position{line: 1249, col: 5, offset: 30283},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1215, col: 10, offset: 29327},
+									pos:  position{line: 1249, col: 10, offset: 30288},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1215, col: 12, offset: 29329},
+									pos:   position{line: 1249, col: 12, offset: 30290},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1215, col: 17, offset: 29334},
+										pos:  position{line: 1249, col: 17, offset: 30295},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1215, col: 22, offset: 29339},
+									pos:   position{line: 1249, col: 22, offset: 30300},
 									label: "whens",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1215, col: 28, offset: 29345},
+										pos: position{line: 1249, col: 28, offset: 30306},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1215, col: 28, offset: 29345},
+											pos:  position{line: 1249, col: 28, offset: 30306},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1215, col: 34, offset: 29351},
+									pos:   position{line: 1249, col: 34, offset: 30312},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1215, col: 40, offset: 29357},
+										pos: position{line: 1249, col: 40, offset: 30318},
 										expr: &seqExpr{
-											pos: position{line: 1215, col: 41, offset: 29358},
+											pos: position{line: 1249, col: 41, offset: 30319},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1215, col: 41, offset: 29358},
+													pos:  position{line: 1249, col: 41, offset: 30319},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1215, col: 43, offset: 29360},
+													pos:  position{line: 1249, col: 43, offset: 30321},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1215, col: 48, offset: 29365},
+													pos:  position{line: 1249, col: 48, offset: 30326},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1215, col: 50, offset: 29367},
+													pos:  position{line: 1249, col: 50, offset: 30328},
 													name: "Expr",
 												},
 											},
@@ -7917,24 +8179,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1215, col: 57, offset: 29374},
+									pos:  position{line: 1249, col: 57, offset: 30335},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1215, col: 59, offset: 29376},
+									pos:  position{line: 1249, col: 59, offset: 30337},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1215, col: 63, offset: 29380},
+									pos: position{line: 1249, col: 63, offset: 30341},
 									expr: &seqExpr{
-										pos: position{line: 1215, col: 64, offset: 29381},
+										pos: position{line: 1249, col: 64, offset: 30342},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1215, col: 64, offset: 29381},
+												pos:  position{line: 1249, col: 64, offset: 30342},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1215, col: 66, offset: 29383},
+												pos:  position{line: 1249, col: 66, offset: 30344},
 												name: "CASE",
 											},
 										},
@@ -7950,50 +8212,50 @@ var g = &grammar{
 		},
 		{
 			name: "When",
-			pos:  position{line: 1228, col: 1, offset: 29689},
+			pos:  position{line: 1262, col: 1, offset: 30650},
 			expr: &actionExpr{
-				pos: position{line: 1229, col: 5, offset: 29698},
+				pos: position{line: 1263, col: 5, offset: 30659},
 				run: (*parser).callonWhen1,
 				expr: &seqExpr{
-					pos: position{line: 1229, col: 5, offset: 29698},
+					pos: position{line: 1263, col: 5, offset: 30659},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 5, offset: 29698},
+							pos:  position{line: 1263, col: 5, offset: 30659},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 7, offset: 29700},
+							pos:  position{line: 1263, col: 7, offset: 30661},
 							name: "WHEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 12, offset: 29705},
+							pos:  position{line: 1263, col: 12, offset: 30666},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1229, col: 14, offset: 29707},
+							pos:   position{line: 1263, col: 14, offset: 30668},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1229, col: 19, offset: 29712},
+								pos:  position{line: 1263, col: 19, offset: 30673},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 24, offset: 29717},
+							pos:  position{line: 1263, col: 24, offset: 30678},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 26, offset: 29719},
+							pos:  position{line: 1263, col: 26, offset: 30680},
 							name: "THEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1229, col: 31, offset: 29724},
+							pos:  position{line: 1263, col: 31, offset: 30685},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1229, col: 33, offset: 29726},
+							pos:   position{line: 1263, col: 33, offset: 30687},
 							label: "then",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1229, col: 38, offset: 29731},
+								pos:  position{line: 1263, col: 38, offset: 30692},
 								name: "Expr",
 							},
 						},
@@ -8005,15 +8267,15 @@ var g = &grammar{
 		},
 		{
 			name: "SubqueryExpr",
-			pos:  position{line: 1237, col: 1, offset: 29864},
+			pos:  position{line: 1271, col: 1, offset: 30825},
 			expr: &actionExpr{
-				pos: position{line: 1238, col: 5, offset: 29881},
+				pos: position{line: 1272, col: 5, offset: 30842},
 				run: (*parser).callonSubqueryExpr1,
 				expr: &labeledExpr{
-					pos:   position{line: 1238, col: 5, offset: 29881},
+					pos:   position{line: 1272, col: 5, offset: 30842},
 					label: "body",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1238, col: 10, offset: 29886},
+						pos:  position{line: 1272, col: 10, offset: 30847},
 						name: "Query",
 					},
 				},
@@ -8023,37 +8285,37 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 1246, col: 1, offset: 30032},
+			pos:  position{line: 1280, col: 1, offset: 30993},
 			expr: &actionExpr{
-				pos: position{line: 1247, col: 5, offset: 30043},
+				pos: position{line: 1281, col: 5, offset: 31004},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 1247, col: 5, offset: 30043},
+					pos: position{line: 1281, col: 5, offset: 31004},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1247, col: 5, offset: 30043},
+							pos:        position{line: 1281, col: 5, offset: 31004},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1247, col: 9, offset: 30047},
+							pos:  position{line: 1281, col: 9, offset: 31008},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1247, col: 12, offset: 30050},
+							pos:   position{line: 1281, col: 12, offset: 31011},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1247, col: 18, offset: 30056},
+								pos:  position{line: 1281, col: 18, offset: 31017},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1247, col: 30, offset: 30068},
+							pos:  position{line: 1281, col: 30, offset: 31029},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1247, col: 33, offset: 30071},
+							pos:        position{line: 1281, col: 33, offset: 31032},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -8066,31 +8328,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 1255, col: 1, offset: 30229},
+			pos:  position{line: 1289, col: 1, offset: 31190},
 			expr: &choiceExpr{
-				pos: position{line: 1256, col: 5, offset: 30245},
+				pos: position{line: 1290, col: 5, offset: 31206},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1256, col: 5, offset: 30245},
+						pos: position{line: 1290, col: 5, offset: 31206},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 1256, col: 5, offset: 30245},
+							pos: position{line: 1290, col: 5, offset: 31206},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1256, col: 5, offset: 30245},
+									pos:   position{line: 1290, col: 5, offset: 31206},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1256, col: 11, offset: 30251},
+										pos:  position{line: 1290, col: 11, offset: 31212},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1256, col: 22, offset: 30262},
+									pos:   position{line: 1290, col: 22, offset: 31223},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1256, col: 27, offset: 30267},
+										pos: position{line: 1290, col: 27, offset: 31228},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1256, col: 27, offset: 30267},
+											pos:  position{line: 1290, col: 27, offset: 31228},
 											name: "RecordElemTail",
 										},
 									},
@@ -8099,10 +8361,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1259, col: 5, offset: 30330},
+						pos: position{line: 1293, col: 5, offset: 31291},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1259, col: 5, offset: 30330},
+							pos:  position{line: 1293, col: 5, offset: 31291},
 							name: "__",
 						},
 					},
@@ -8113,32 +8375,32 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 1261, col: 1, offset: 30354},
+			pos:  position{line: 1295, col: 1, offset: 31315},
 			expr: &actionExpr{
-				pos: position{line: 1261, col: 18, offset: 30371},
+				pos: position{line: 1295, col: 18, offset: 31332},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 1261, col: 18, offset: 30371},
+					pos: position{line: 1295, col: 18, offset: 31332},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 18, offset: 30371},
+							pos:  position{line: 1295, col: 18, offset: 31332},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1261, col: 21, offset: 30374},
+							pos:        position{line: 1295, col: 21, offset: 31335},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1261, col: 25, offset: 30378},
+							pos:  position{line: 1295, col: 25, offset: 31339},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1261, col: 28, offset: 30381},
+							pos:   position{line: 1295, col: 28, offset: 31342},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1261, col: 33, offset: 30386},
+								pos:  position{line: 1295, col: 33, offset: 31347},
 								name: "RecordElem",
 							},
 						},
@@ -8150,24 +8412,24 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 1263, col: 1, offset: 30419},
+			pos:  position{line: 1297, col: 1, offset: 31380},
 			expr: &choiceExpr{
-				pos: position{line: 1263, col: 14, offset: 30432},
+				pos: position{line: 1297, col: 14, offset: 31393},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 14, offset: 30432},
+						pos:  position{line: 1297, col: 14, offset: 31393},
 						name: "SpreadElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 27, offset: 30445},
+						pos:  position{line: 1297, col: 27, offset: 31406},
 						name: "NoneElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 38, offset: 30456},
+						pos:  position{line: 1297, col: 38, offset: 31417},
 						name: "FieldElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 50, offset: 30468},
+						pos:  position{line: 1297, col: 50, offset: 31429},
 						name: "ExprElem",
 					},
 				},
@@ -8177,28 +8439,28 @@ var g = &grammar{
 		},
 		{
 			name: "SpreadElem",
-			pos:  position{line: 1265, col: 1, offset: 30478},
+			pos:  position{line: 1299, col: 1, offset: 31439},
 			expr: &actionExpr{
-				pos: position{line: 1266, col: 5, offset: 30493},
+				pos: position{line: 1300, col: 5, offset: 31454},
 				run: (*parser).callonSpreadElem1,
 				expr: &seqExpr{
-					pos: position{line: 1266, col: 5, offset: 30493},
+					pos: position{line: 1300, col: 5, offset: 31454},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1266, col: 5, offset: 30493},
+							pos:        position{line: 1300, col: 5, offset: 31454},
 							val:        "...",
 							ignoreCase: false,
 							want:       "\"...\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1266, col: 11, offset: 30499},
+							pos:  position{line: 1300, col: 11, offset: 31460},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1266, col: 14, offset: 30502},
+							pos:   position{line: 1300, col: 14, offset: 31463},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1266, col: 19, offset: 30507},
+								pos:  position{line: 1300, col: 19, offset: 31468},
 								name: "Expr",
 							},
 						},
@@ -8210,52 +8472,52 @@ var g = &grammar{
 		},
 		{
 			name: "FieldElem",
-			pos:  position{line: 1270, col: 1, offset: 30611},
+			pos:  position{line: 1304, col: 1, offset: 31572},
 			expr: &actionExpr{
-				pos: position{line: 1271, col: 5, offset: 30625},
+				pos: position{line: 1305, col: 5, offset: 31586},
 				run: (*parser).callonFieldElem1,
 				expr: &seqExpr{
-					pos: position{line: 1271, col: 5, offset: 30625},
+					pos: position{line: 1305, col: 5, offset: 31586},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1271, col: 5, offset: 30625},
+							pos:   position{line: 1305, col: 5, offset: 31586},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1271, col: 10, offset: 30630},
+								pos:  position{line: 1305, col: 10, offset: 31591},
 								name: "Name",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1271, col: 15, offset: 30635},
+							pos:  position{line: 1305, col: 15, offset: 31596},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1271, col: 18, offset: 30638},
+							pos:   position{line: 1305, col: 18, offset: 31599},
 							label: "opt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1271, col: 22, offset: 30642},
+								pos:  position{line: 1305, col: 22, offset: 31603},
 								name: "OptToken",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1271, col: 31, offset: 30651},
+							pos:  position{line: 1305, col: 31, offset: 31612},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1271, col: 34, offset: 30654},
+							pos:        position{line: 1305, col: 34, offset: 31615},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1271, col: 38, offset: 30658},
+							pos:  position{line: 1305, col: 38, offset: 31619},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1271, col: 41, offset: 30661},
+							pos:   position{line: 1305, col: 41, offset: 31622},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1271, col: 47, offset: 30667},
+								pos:  position{line: 1305, col: 47, offset: 31628},
 								name: "Expr",
 							},
 						},
@@ -8267,70 +8529,70 @@ var g = &grammar{
 		},
 		{
 			name: "NoneElem",
-			pos:  position{line: 1281, col: 1, offset: 30861},
+			pos:  position{line: 1315, col: 1, offset: 31822},
 			expr: &actionExpr{
-				pos: position{line: 1282, col: 5, offset: 30874},
+				pos: position{line: 1316, col: 5, offset: 31835},
 				run: (*parser).callonNoneElem1,
 				expr: &seqExpr{
-					pos: position{line: 1282, col: 5, offset: 30874},
+					pos: position{line: 1316, col: 5, offset: 31835},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1282, col: 5, offset: 30874},
+							pos:   position{line: 1316, col: 5, offset: 31835},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1282, col: 10, offset: 30879},
+								pos:  position{line: 1316, col: 10, offset: 31840},
 								name: "Name",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 15, offset: 30884},
+							pos:  position{line: 1316, col: 15, offset: 31845},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1282, col: 18, offset: 30887},
+							pos:        position{line: 1316, col: 18, offset: 31848},
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 22, offset: 30891},
+							pos:  position{line: 1316, col: 22, offset: 31852},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1282, col: 25, offset: 30894},
+							pos:        position{line: 1316, col: 25, offset: 31855},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 29, offset: 30898},
+							pos:  position{line: 1316, col: 29, offset: 31859},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1282, col: 32, offset: 30901},
+							pos:        position{line: 1316, col: 32, offset: 31862},
 							val:        "_",
 							ignoreCase: false,
 							want:       "\"_\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 36, offset: 30905},
+							pos:  position{line: 1316, col: 36, offset: 31866},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1282, col: 39, offset: 30908},
+							pos:        position{line: 1316, col: 39, offset: 31869},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 44, offset: 30913},
+							pos:  position{line: 1316, col: 44, offset: 31874},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1282, col: 47, offset: 30916},
+							pos:   position{line: 1316, col: 47, offset: 31877},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1282, col: 51, offset: 30920},
+								pos:  position{line: 1316, col: 51, offset: 31881},
 								name: "ComponentType",
 							},
 						},
@@ -8342,30 +8604,30 @@ var g = &grammar{
 		},
 		{
 			name: "ExprElem",
-			pos:  position{line: 1291, col: 1, offset: 31093},
+			pos:  position{line: 1325, col: 1, offset: 32054},
 			expr: &actionExpr{
-				pos: position{line: 1292, col: 5, offset: 31106},
+				pos: position{line: 1326, col: 5, offset: 32067},
 				run: (*parser).callonExprElem1,
 				expr: &seqExpr{
-					pos: position{line: 1292, col: 5, offset: 31106},
+					pos: position{line: 1326, col: 5, offset: 32067},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1292, col: 5, offset: 31106},
+							pos:   position{line: 1326, col: 5, offset: 32067},
 							label: "opt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1292, col: 9, offset: 31110},
+								pos:  position{line: 1326, col: 9, offset: 32071},
 								name: "OptToken",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1292, col: 18, offset: 31119},
+							pos:  position{line: 1326, col: 18, offset: 32080},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1292, col: 21, offset: 31122},
+							pos:   position{line: 1326, col: 21, offset: 32083},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1292, col: 26, offset: 31127},
+								pos:  position{line: 1326, col: 26, offset: 32088},
 								name: "Expr",
 							},
 						},
@@ -8377,37 +8639,37 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 1296, col: 1, offset: 31244},
+			pos:  position{line: 1330, col: 1, offset: 32205},
 			expr: &actionExpr{
-				pos: position{line: 1297, col: 5, offset: 31254},
+				pos: position{line: 1331, col: 5, offset: 32215},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 1297, col: 5, offset: 31254},
+					pos: position{line: 1331, col: 5, offset: 32215},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1297, col: 5, offset: 31254},
+							pos:        position{line: 1331, col: 5, offset: 32215},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1297, col: 9, offset: 31258},
+							pos:  position{line: 1331, col: 9, offset: 32219},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1297, col: 12, offset: 31261},
+							pos:   position{line: 1331, col: 12, offset: 32222},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1297, col: 18, offset: 31267},
+								pos:  position{line: 1331, col: 18, offset: 32228},
 								name: "ArrayElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1297, col: 29, offset: 31278},
+							pos:  position{line: 1331, col: 29, offset: 32239},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1297, col: 32, offset: 31281},
+							pos:        position{line: 1331, col: 32, offset: 32242},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -8420,37 +8682,37 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 1305, col: 1, offset: 31436},
+			pos:  position{line: 1339, col: 1, offset: 32397},
 			expr: &actionExpr{
-				pos: position{line: 1306, col: 5, offset: 31444},
+				pos: position{line: 1340, col: 5, offset: 32405},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 1306, col: 5, offset: 31444},
+					pos: position{line: 1340, col: 5, offset: 32405},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1306, col: 5, offset: 31444},
+							pos:        position{line: 1340, col: 5, offset: 32405},
 							val:        "set[",
 							ignoreCase: false,
 							want:       "\"set[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1306, col: 12, offset: 31451},
+							pos:  position{line: 1340, col: 12, offset: 32412},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1306, col: 15, offset: 31454},
+							pos:   position{line: 1340, col: 15, offset: 32415},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1306, col: 21, offset: 31460},
+								pos:  position{line: 1340, col: 21, offset: 32421},
 								name: "ArrayElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1306, col: 32, offset: 31471},
+							pos:  position{line: 1340, col: 32, offset: 32432},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1306, col: 35, offset: 31474},
+							pos:        position{line: 1340, col: 35, offset: 32435},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -8463,54 +8725,54 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElems",
-			pos:  position{line: 1314, col: 1, offset: 31625},
+			pos:  position{line: 1348, col: 1, offset: 32586},
 			expr: &choiceExpr{
-				pos: position{line: 1315, col: 5, offset: 31640},
+				pos: position{line: 1349, col: 5, offset: 32601},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1315, col: 5, offset: 31640},
+						pos: position{line: 1349, col: 5, offset: 32601},
 						run: (*parser).callonArrayElems2,
 						expr: &seqExpr{
-							pos: position{line: 1315, col: 5, offset: 31640},
+							pos: position{line: 1349, col: 5, offset: 32601},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1315, col: 5, offset: 31640},
+									pos:   position{line: 1349, col: 5, offset: 32601},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1315, col: 11, offset: 31646},
+										pos:  position{line: 1349, col: 11, offset: 32607},
 										name: "ArrayElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1315, col: 21, offset: 31656},
+									pos:   position{line: 1349, col: 21, offset: 32617},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1315, col: 26, offset: 31661},
+										pos: position{line: 1349, col: 26, offset: 32622},
 										expr: &actionExpr{
-											pos: position{line: 1315, col: 27, offset: 31662},
+											pos: position{line: 1349, col: 27, offset: 32623},
 											run: (*parser).callonArrayElems8,
 											expr: &seqExpr{
-												pos: position{line: 1315, col: 27, offset: 31662},
+												pos: position{line: 1349, col: 27, offset: 32623},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1315, col: 27, offset: 31662},
+														pos:  position{line: 1349, col: 27, offset: 32623},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 1315, col: 30, offset: 31665},
+														pos:        position{line: 1349, col: 30, offset: 32626},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1315, col: 34, offset: 31669},
+														pos:  position{line: 1349, col: 34, offset: 32630},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 1315, col: 37, offset: 31672},
+														pos:   position{line: 1349, col: 37, offset: 32633},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1315, col: 39, offset: 31674},
+															pos:  position{line: 1349, col: 39, offset: 32635},
 															name: "ArrayElem",
 														},
 													},
@@ -8523,10 +8785,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1318, col: 5, offset: 31755},
+						pos: position{line: 1352, col: 5, offset: 32716},
 						run: (*parser).callonArrayElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1318, col: 5, offset: 31755},
+							pos:  position{line: 1352, col: 5, offset: 32716},
 							name: "__",
 						},
 					},
@@ -8537,16 +8799,16 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElem",
-			pos:  position{line: 1320, col: 1, offset: 31779},
+			pos:  position{line: 1354, col: 1, offset: 32740},
 			expr: &choiceExpr{
-				pos: position{line: 1320, col: 13, offset: 31791},
+				pos: position{line: 1354, col: 13, offset: 32752},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1320, col: 13, offset: 31791},
+						pos:  position{line: 1354, col: 13, offset: 32752},
 						name: "SpreadElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1320, col: 26, offset: 31804},
+						pos:  position{line: 1354, col: 26, offset: 32765},
 						name: "ExprElem",
 					},
 				},
@@ -8556,37 +8818,37 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 1322, col: 1, offset: 31814},
+			pos:  position{line: 1356, col: 1, offset: 32775},
 			expr: &actionExpr{
-				pos: position{line: 1323, col: 5, offset: 31822},
+				pos: position{line: 1357, col: 5, offset: 32783},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 1323, col: 5, offset: 31822},
+					pos: position{line: 1357, col: 5, offset: 32783},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1323, col: 5, offset: 31822},
+							pos:        position{line: 1357, col: 5, offset: 32783},
 							val:        "map{",
 							ignoreCase: false,
 							want:       "\"map{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1323, col: 12, offset: 31829},
+							pos:  position{line: 1357, col: 12, offset: 32790},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1323, col: 15, offset: 31832},
+							pos:   position{line: 1357, col: 15, offset: 32793},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1323, col: 21, offset: 31838},
+								pos:  position{line: 1357, col: 21, offset: 32799},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1323, col: 29, offset: 31846},
+							pos:  position{line: 1357, col: 29, offset: 32807},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1323, col: 32, offset: 31849},
+							pos:        position{line: 1357, col: 32, offset: 32810},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -8599,31 +8861,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 1331, col: 1, offset: 32001},
+			pos:  position{line: 1365, col: 1, offset: 32962},
 			expr: &choiceExpr{
-				pos: position{line: 1332, col: 5, offset: 32013},
+				pos: position{line: 1366, col: 5, offset: 32974},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1332, col: 5, offset: 32013},
+						pos: position{line: 1366, col: 5, offset: 32974},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 1332, col: 5, offset: 32013},
+							pos: position{line: 1366, col: 5, offset: 32974},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1332, col: 5, offset: 32013},
+									pos:   position{line: 1366, col: 5, offset: 32974},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1332, col: 11, offset: 32019},
+										pos:  position{line: 1366, col: 11, offset: 32980},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1332, col: 17, offset: 32025},
+									pos:   position{line: 1366, col: 17, offset: 32986},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1332, col: 22, offset: 32030},
+										pos: position{line: 1366, col: 22, offset: 32991},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1332, col: 22, offset: 32030},
+											pos:  position{line: 1366, col: 22, offset: 32991},
 											name: "EntryTail",
 										},
 									},
@@ -8632,10 +8894,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1335, col: 5, offset: 32088},
+						pos: position{line: 1369, col: 5, offset: 33049},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1335, col: 5, offset: 32088},
+							pos:  position{line: 1369, col: 5, offset: 33049},
 							name: "__",
 						},
 					},
@@ -8646,32 +8908,32 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 1338, col: 1, offset: 32113},
+			pos:  position{line: 1372, col: 1, offset: 33074},
 			expr: &actionExpr{
-				pos: position{line: 1338, col: 13, offset: 32125},
+				pos: position{line: 1372, col: 13, offset: 33086},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 1338, col: 13, offset: 32125},
+					pos: position{line: 1372, col: 13, offset: 33086},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1338, col: 13, offset: 32125},
+							pos:  position{line: 1372, col: 13, offset: 33086},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1338, col: 16, offset: 32128},
+							pos:        position{line: 1372, col: 16, offset: 33089},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1338, col: 20, offset: 32132},
+							pos:  position{line: 1372, col: 20, offset: 33093},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1338, col: 23, offset: 32135},
+							pos:   position{line: 1372, col: 23, offset: 33096},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1338, col: 25, offset: 32137},
+								pos:  position{line: 1372, col: 25, offset: 33098},
 								name: "Entry",
 							},
 						},
@@ -8683,40 +8945,40 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 1340, col: 1, offset: 32162},
+			pos:  position{line: 1374, col: 1, offset: 33123},
 			expr: &actionExpr{
-				pos: position{line: 1341, col: 5, offset: 32172},
+				pos: position{line: 1375, col: 5, offset: 33133},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 1341, col: 5, offset: 32172},
+					pos: position{line: 1375, col: 5, offset: 33133},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1341, col: 5, offset: 32172},
+							pos:   position{line: 1375, col: 5, offset: 33133},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1341, col: 9, offset: 32176},
+								pos:  position{line: 1375, col: 9, offset: 33137},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1341, col: 14, offset: 32181},
+							pos:  position{line: 1375, col: 14, offset: 33142},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1341, col: 17, offset: 32184},
+							pos:        position{line: 1375, col: 17, offset: 33145},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1341, col: 21, offset: 32188},
+							pos:  position{line: 1375, col: 21, offset: 33149},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1341, col: 24, offset: 32191},
+							pos:   position{line: 1375, col: 24, offset: 33152},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1341, col: 30, offset: 32197},
+								pos:  position{line: 1375, col: 30, offset: 33158},
 								name: "Expr",
 							},
 						},
@@ -8728,61 +8990,61 @@ var g = &grammar{
 		},
 		{
 			name: "Tuple",
-			pos:  position{line: 1345, col: 1, offset: 32299},
+			pos:  position{line: 1379, col: 1, offset: 33260},
 			expr: &actionExpr{
-				pos: position{line: 1346, col: 5, offset: 32309},
+				pos: position{line: 1380, col: 5, offset: 33270},
 				run: (*parser).callonTuple1,
 				expr: &seqExpr{
-					pos: position{line: 1346, col: 5, offset: 32309},
+					pos: position{line: 1380, col: 5, offset: 33270},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1346, col: 5, offset: 32309},
+							pos:        position{line: 1380, col: 5, offset: 33270},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1346, col: 9, offset: 32313},
+							pos:  position{line: 1380, col: 9, offset: 33274},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1346, col: 12, offset: 32316},
+							pos:   position{line: 1380, col: 12, offset: 33277},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1346, col: 18, offset: 32322},
+								pos:  position{line: 1380, col: 18, offset: 33283},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1346, col: 23, offset: 32327},
+							pos:   position{line: 1380, col: 23, offset: 33288},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1346, col: 28, offset: 32332},
+								pos: position{line: 1380, col: 28, offset: 33293},
 								expr: &actionExpr{
-									pos: position{line: 1346, col: 29, offset: 32333},
+									pos: position{line: 1380, col: 29, offset: 33294},
 									run: (*parser).callonTuple9,
 									expr: &seqExpr{
-										pos: position{line: 1346, col: 29, offset: 32333},
+										pos: position{line: 1380, col: 29, offset: 33294},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1346, col: 29, offset: 32333},
+												pos:  position{line: 1380, col: 29, offset: 33294},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1346, col: 32, offset: 32336},
+												pos:        position{line: 1380, col: 32, offset: 33297},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1346, col: 36, offset: 32340},
+												pos:  position{line: 1380, col: 36, offset: 33301},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1346, col: 39, offset: 32343},
+												pos:   position{line: 1380, col: 39, offset: 33304},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1346, col: 41, offset: 32345},
+													pos:  position{line: 1380, col: 41, offset: 33306},
 													name: "Expr",
 												},
 											},
@@ -8792,11 +9054,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1346, col: 66, offset: 32370},
+							pos:  position{line: 1380, col: 66, offset: 33331},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1346, col: 69, offset: 32373},
+							pos:        position{line: 1380, col: 69, offset: 33334},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8809,39 +9071,39 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTimeExpr",
-			pos:  position{line: 1354, col: 1, offset: 32532},
+			pos:  position{line: 1388, col: 1, offset: 33493},
 			expr: &actionExpr{
-				pos: position{line: 1355, col: 5, offset: 32548},
+				pos: position{line: 1389, col: 5, offset: 33509},
 				run: (*parser).callonSQLTimeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1355, col: 5, offset: 32548},
+					pos: position{line: 1389, col: 5, offset: 33509},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1355, col: 5, offset: 32548},
+							pos:   position{line: 1389, col: 5, offset: 33509},
 							label: "typ",
 							expr: &choiceExpr{
-								pos: position{line: 1355, col: 10, offset: 32553},
+								pos: position{line: 1389, col: 10, offset: 33514},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1355, col: 10, offset: 32553},
+										pos:  position{line: 1389, col: 10, offset: 33514},
 										name: "DATE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1355, col: 17, offset: 32560},
+										pos:  position{line: 1389, col: 17, offset: 33521},
 										name: "TIMESTAMP",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1355, col: 28, offset: 32571},
+							pos:  position{line: 1389, col: 28, offset: 33532},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1355, col: 30, offset: 32573},
+							pos:   position{line: 1389, col: 30, offset: 33534},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1355, col: 32, offset: 32575},
+								pos:  position{line: 1389, col: 32, offset: 33536},
 								name: "StringLiteral",
 							},
 						},
@@ -8853,56 +9115,56 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1366, col: 1, offset: 32790},
+			pos:  position{line: 1400, col: 1, offset: 33751},
 			expr: &choiceExpr{
-				pos: position{line: 1367, col: 5, offset: 32802},
+				pos: position{line: 1401, col: 5, offset: 33763},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1367, col: 5, offset: 32802},
+						pos:  position{line: 1401, col: 5, offset: 33763},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1368, col: 5, offset: 32818},
+						pos:  position{line: 1402, col: 5, offset: 33779},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1369, col: 5, offset: 32836},
+						pos:  position{line: 1403, col: 5, offset: 33797},
 						name: "FString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 5, offset: 32848},
+						pos:  position{line: 1404, col: 5, offset: 33809},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1371, col: 5, offset: 32866},
+						pos:  position{line: 1405, col: 5, offset: 33827},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1372, col: 5, offset: 32885},
+						pos:  position{line: 1406, col: 5, offset: 33846},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1373, col: 5, offset: 32902},
+						pos:  position{line: 1407, col: 5, offset: 33863},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1374, col: 5, offset: 32915},
+						pos:  position{line: 1408, col: 5, offset: 33876},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1375, col: 5, offset: 32924},
+						pos:  position{line: 1409, col: 5, offset: 33885},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1376, col: 5, offset: 32941},
+						pos:  position{line: 1410, col: 5, offset: 33902},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1377, col: 5, offset: 32960},
+						pos:  position{line: 1411, col: 5, offset: 33921},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1378, col: 5, offset: 32979},
+						pos:  position{line: 1412, col: 5, offset: 33940},
 						name: "NullLiteral",
 					},
 				},
@@ -8912,28 +9174,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1380, col: 1, offset: 32992},
+			pos:  position{line: 1414, col: 1, offset: 33953},
 			expr: &choiceExpr{
-				pos: position{line: 1381, col: 5, offset: 33010},
+				pos: position{line: 1415, col: 5, offset: 33971},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1381, col: 5, offset: 33010},
+						pos: position{line: 1415, col: 5, offset: 33971},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1381, col: 5, offset: 33010},
+							pos: position{line: 1415, col: 5, offset: 33971},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1381, col: 5, offset: 33010},
+									pos:   position{line: 1415, col: 5, offset: 33971},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1381, col: 7, offset: 33012},
+										pos:  position{line: 1415, col: 7, offset: 33973},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1381, col: 14, offset: 33019},
+									pos: position{line: 1415, col: 14, offset: 33980},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1381, col: 15, offset: 33020},
+										pos:  position{line: 1415, col: 15, offset: 33981},
 										name: "IdentifierRest",
 									},
 								},
@@ -8941,13 +9203,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1384, col: 5, offset: 33100},
+						pos: position{line: 1418, col: 5, offset: 34061},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1384, col: 5, offset: 33100},
+							pos:   position{line: 1418, col: 5, offset: 34061},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1384, col: 7, offset: 33102},
+								pos:  position{line: 1418, col: 7, offset: 34063},
 								name: "IP4Net",
 							},
 						},
@@ -8959,35 +9221,35 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1388, col: 1, offset: 33171},
+			pos:  position{line: 1422, col: 1, offset: 34132},
 			expr: &choiceExpr{
-				pos: position{line: 1389, col: 5, offset: 33190},
+				pos: position{line: 1423, col: 5, offset: 34151},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1389, col: 5, offset: 33190},
+						pos: position{line: 1423, col: 5, offset: 34151},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1389, col: 5, offset: 33190},
+							pos: position{line: 1423, col: 5, offset: 34151},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1389, col: 5, offset: 33190},
+									pos:   position{line: 1423, col: 5, offset: 34151},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1389, col: 7, offset: 33192},
+										pos:  position{line: 1423, col: 7, offset: 34153},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1389, col: 11, offset: 33196},
+									pos: position{line: 1423, col: 11, offset: 34157},
 									expr: &choiceExpr{
-										pos: position{line: 1389, col: 13, offset: 33198},
+										pos: position{line: 1423, col: 13, offset: 34159},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1389, col: 13, offset: 33198},
+												pos:  position{line: 1423, col: 13, offset: 34159},
 												name: "IdentifierRest",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1389, col: 30, offset: 33215},
+												pos:  position{line: 1423, col: 30, offset: 34176},
 												name: "TypeLiteral",
 											},
 										},
@@ -8997,13 +9259,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1392, col: 5, offset: 33292},
+						pos: position{line: 1426, col: 5, offset: 34253},
 						run: (*parser).callonAddressLiteral10,
 						expr: &labeledExpr{
-							pos:   position{line: 1392, col: 5, offset: 33292},
+							pos:   position{line: 1426, col: 5, offset: 34253},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1392, col: 7, offset: 33294},
+								pos:  position{line: 1426, col: 7, offset: 34255},
 								name: "IP",
 							},
 						},
@@ -9015,15 +9277,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1396, col: 1, offset: 33358},
+			pos:  position{line: 1430, col: 1, offset: 34319},
 			expr: &actionExpr{
-				pos: position{line: 1397, col: 5, offset: 33375},
+				pos: position{line: 1431, col: 5, offset: 34336},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1397, col: 5, offset: 33375},
+					pos:   position{line: 1431, col: 5, offset: 34336},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1397, col: 7, offset: 33377},
+						pos:  position{line: 1431, col: 7, offset: 34338},
 						name: "FloatString",
 					},
 				},
@@ -9033,15 +9295,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1401, col: 1, offset: 33455},
+			pos:  position{line: 1435, col: 1, offset: 34416},
 			expr: &actionExpr{
-				pos: position{line: 1402, col: 5, offset: 33474},
+				pos: position{line: 1436, col: 5, offset: 34435},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1402, col: 5, offset: 33474},
+					pos:   position{line: 1436, col: 5, offset: 34435},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1402, col: 7, offset: 33476},
+						pos:  position{line: 1436, col: 7, offset: 34437},
 						name: "IntString",
 					},
 				},
@@ -9051,23 +9313,23 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1406, col: 1, offset: 33550},
+			pos:  position{line: 1440, col: 1, offset: 34511},
 			expr: &choiceExpr{
-				pos: position{line: 1407, col: 5, offset: 33569},
+				pos: position{line: 1441, col: 5, offset: 34530},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1407, col: 5, offset: 33569},
+						pos: position{line: 1441, col: 5, offset: 34530},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1407, col: 5, offset: 33569},
+							pos:  position{line: 1441, col: 5, offset: 34530},
 							name: "TRUE",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1408, col: 5, offset: 33627},
+						pos: position{line: 1442, col: 5, offset: 34588},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1408, col: 5, offset: 33627},
+							pos:  position{line: 1442, col: 5, offset: 34588},
 							name: "FALSE",
 						},
 					},
@@ -9078,12 +9340,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1410, col: 1, offset: 33683},
+			pos:  position{line: 1444, col: 1, offset: 34644},
 			expr: &actionExpr{
-				pos: position{line: 1411, col: 5, offset: 33699},
+				pos: position{line: 1445, col: 5, offset: 34660},
 				run: (*parser).callonNullLiteral1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 1411, col: 5, offset: 33699},
+					pos:  position{line: 1445, col: 5, offset: 34660},
 					name: "NULL",
 				},
 			},
@@ -9092,23 +9354,23 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1413, col: 1, offset: 33749},
+			pos:  position{line: 1447, col: 1, offset: 34710},
 			expr: &actionExpr{
-				pos: position{line: 1414, col: 5, offset: 33766},
+				pos: position{line: 1448, col: 5, offset: 34727},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1414, col: 5, offset: 33766},
+					pos: position{line: 1448, col: 5, offset: 34727},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1414, col: 5, offset: 33766},
+							pos:        position{line: 1448, col: 5, offset: 34727},
 							val:        "0x",
 							ignoreCase: false,
 							want:       "\"0x\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1414, col: 10, offset: 33771},
+							pos: position{line: 1448, col: 10, offset: 34732},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1414, col: 10, offset: 33771},
+								pos:  position{line: 1448, col: 10, offset: 34732},
 								name: "HexDigit",
 							},
 						},
@@ -9120,29 +9382,29 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1418, col: 1, offset: 33845},
+			pos:  position{line: 1452, col: 1, offset: 34806},
 			expr: &actionExpr{
-				pos: position{line: 1419, col: 5, offset: 33861},
+				pos: position{line: 1453, col: 5, offset: 34822},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1419, col: 5, offset: 33861},
+					pos: position{line: 1453, col: 5, offset: 34822},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1419, col: 5, offset: 33861},
+							pos:        position{line: 1453, col: 5, offset: 34822},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1419, col: 9, offset: 33865},
+							pos:   position{line: 1453, col: 9, offset: 34826},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1419, col: 13, offset: 33869},
+								pos:  position{line: 1453, col: 13, offset: 34830},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1419, col: 18, offset: 33874},
+							pos:        position{line: 1453, col: 18, offset: 34835},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -9155,15 +9417,15 @@ var g = &grammar{
 		},
 		{
 			name: "TypeAsValue",
-			pos:  position{line: 1427, col: 1, offset: 34007},
+			pos:  position{line: 1461, col: 1, offset: 34968},
 			expr: &actionExpr{
-				pos: position{line: 1428, col: 5, offset: 34023},
+				pos: position{line: 1462, col: 5, offset: 34984},
 				run: (*parser).callonTypeAsValue1,
 				expr: &labeledExpr{
-					pos:   position{line: 1428, col: 5, offset: 34023},
+					pos:   position{line: 1462, col: 5, offset: 34984},
 					label: "t",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1428, col: 7, offset: 34025},
+						pos:  position{line: 1462, col: 7, offset: 34986},
 						name: "ComponentType",
 					},
 				},
@@ -9173,16 +9435,16 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1436, col: 1, offset: 34166},
+			pos:  position{line: 1470, col: 1, offset: 35127},
 			expr: &choiceExpr{
-				pos: position{line: 1437, col: 5, offset: 34175},
+				pos: position{line: 1471, col: 5, offset: 35136},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1437, col: 5, offset: 34175},
+						pos:  position{line: 1471, col: 5, offset: 35136},
 						name: "TypeUnion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1438, col: 5, offset: 34189},
+						pos:  position{line: 1472, col: 5, offset: 35150},
 						name: "ComponentType",
 					},
 				},
@@ -9192,22 +9454,22 @@ var g = &grammar{
 		},
 		{
 			name: "ComponentType",
-			pos:  position{line: 1440, col: 1, offset: 34204},
+			pos:  position{line: 1474, col: 1, offset: 35165},
 			expr: &choiceExpr{
-				pos: position{line: 1441, col: 5, offset: 34222},
+				pos: position{line: 1475, col: 5, offset: 35183},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1441, col: 5, offset: 34222},
+						pos:  position{line: 1475, col: 5, offset: 35183},
 						name: "EasyType",
 					},
 					&actionExpr{
-						pos: position{line: 1442, col: 5, offset: 34235},
+						pos: position{line: 1476, col: 5, offset: 35196},
 						run: (*parser).callonComponentType3,
 						expr: &labeledExpr{
-							pos:   position{line: 1442, col: 5, offset: 34235},
+							pos:   position{line: 1476, col: 5, offset: 35196},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1442, col: 10, offset: 34240},
+								pos:  position{line: 1476, col: 10, offset: 35201},
 								name: "Name",
 							},
 						},
@@ -9219,40 +9481,40 @@ var g = &grammar{
 		},
 		{
 			name: "EasyType",
-			pos:  position{line: 1446, col: 1, offset: 34344},
+			pos:  position{line: 1480, col: 1, offset: 35305},
 			expr: &choiceExpr{
-				pos: position{line: 1447, col: 5, offset: 34357},
+				pos: position{line: 1481, col: 5, offset: 35318},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1447, col: 5, offset: 34357},
+						pos: position{line: 1481, col: 5, offset: 35318},
 						run: (*parser).callonEasyType2,
 						expr: &seqExpr{
-							pos: position{line: 1447, col: 5, offset: 34357},
+							pos: position{line: 1481, col: 5, offset: 35318},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1447, col: 5, offset: 34357},
+									pos:        position{line: 1481, col: 5, offset: 35318},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1447, col: 9, offset: 34361},
+									pos:  position{line: 1481, col: 9, offset: 35322},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1447, col: 12, offset: 34364},
+									pos:   position{line: 1481, col: 12, offset: 35325},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1447, col: 16, offset: 34368},
+										pos:  position{line: 1481, col: 16, offset: 35329},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1447, col: 21, offset: 34373},
+									pos:  position{line: 1481, col: 21, offset: 35334},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1447, col: 24, offset: 34376},
+									pos:        position{line: 1481, col: 24, offset: 35337},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9261,23 +9523,23 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1448, col: 5, offset: 34403},
+						pos: position{line: 1482, col: 5, offset: 35364},
 						run: (*parser).callonEasyType10,
 						expr: &seqExpr{
-							pos: position{line: 1448, col: 5, offset: 34403},
+							pos: position{line: 1482, col: 5, offset: 35364},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1448, col: 5, offset: 34403},
+									pos:   position{line: 1482, col: 5, offset: 35364},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1448, col: 10, offset: 34408},
+										pos:  position{line: 1482, col: 10, offset: 35369},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1448, col: 24, offset: 34422},
+									pos: position{line: 1482, col: 24, offset: 35383},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1448, col: 25, offset: 34423},
+										pos:  position{line: 1482, col: 25, offset: 35384},
 										name: "IdentifierRest",
 									},
 								},
@@ -9285,43 +9547,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1449, col: 5, offset: 34463},
+						pos: position{line: 1483, col: 5, offset: 35424},
 						run: (*parser).callonEasyType16,
 						expr: &seqExpr{
-							pos: position{line: 1449, col: 5, offset: 34463},
+							pos: position{line: 1483, col: 5, offset: 35424},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1449, col: 5, offset: 34463},
+									pos:  position{line: 1483, col: 5, offset: 35424},
 									name: "ERROR",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1449, col: 11, offset: 34469},
+									pos:  position{line: 1483, col: 11, offset: 35430},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1449, col: 14, offset: 34472},
+									pos:        position{line: 1483, col: 14, offset: 35433},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1449, col: 18, offset: 34476},
+									pos:  position{line: 1483, col: 18, offset: 35437},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1449, col: 21, offset: 34479},
+									pos:   position{line: 1483, col: 21, offset: 35440},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1449, col: 23, offset: 34481},
+										pos:  position{line: 1483, col: 23, offset: 35442},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1449, col: 28, offset: 34486},
+									pos:  position{line: 1483, col: 28, offset: 35447},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1449, col: 31, offset: 34489},
+									pos:        position{line: 1483, col: 31, offset: 35450},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9330,43 +9592,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1456, col: 5, offset: 34629},
+						pos: position{line: 1490, col: 5, offset: 35590},
 						run: (*parser).callonEasyType26,
 						expr: &seqExpr{
-							pos: position{line: 1456, col: 5, offset: 34629},
+							pos: position{line: 1490, col: 5, offset: 35590},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1456, col: 5, offset: 34629},
+									pos:  position{line: 1490, col: 5, offset: 35590},
 									name: "ENUM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1456, col: 10, offset: 34634},
+									pos:  position{line: 1490, col: 10, offset: 35595},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1456, col: 13, offset: 34637},
+									pos:        position{line: 1490, col: 13, offset: 35598},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1456, col: 17, offset: 34641},
+									pos:  position{line: 1490, col: 17, offset: 35602},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1456, col: 20, offset: 34644},
+									pos:   position{line: 1490, col: 20, offset: 35605},
 									label: "names",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1456, col: 26, offset: 34650},
+										pos:  position{line: 1490, col: 26, offset: 35611},
 										name: "Names",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1456, col: 32, offset: 34656},
+									pos:  position{line: 1490, col: 32, offset: 35617},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1456, col: 35, offset: 34659},
+									pos:        position{line: 1490, col: 35, offset: 35620},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9374,52 +9636,56 @@ var g = &grammar{
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1497, col: 5, offset: 35774},
+						name: "DecimalType",
+					},
 					&actionExpr{
-						pos: position{line: 1463, col: 5, offset: 34813},
-						run: (*parser).callonEasyType36,
+						pos: position{line: 1498, col: 5, offset: 35790},
+						run: (*parser).callonEasyType37,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1463, col: 5, offset: 34813},
+							pos:  position{line: 1498, col: 5, offset: 35790},
 							name: "ANY",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1474, col: 5, offset: 35059},
-						run: (*parser).callonEasyType38,
+						pos: position{line: 1509, col: 5, offset: 36036},
+						run: (*parser).callonEasyType39,
 						expr: &seqExpr{
-							pos: position{line: 1474, col: 5, offset: 35059},
+							pos: position{line: 1509, col: 5, offset: 36036},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1474, col: 5, offset: 35059},
+									pos:  position{line: 1509, col: 5, offset: 36036},
 									name: "FUSION",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1474, col: 12, offset: 35066},
+									pos:  position{line: 1509, col: 12, offset: 36043},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1474, col: 15, offset: 35069},
+									pos:        position{line: 1509, col: 15, offset: 36046},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1474, col: 19, offset: 35073},
+									pos:  position{line: 1509, col: 19, offset: 36050},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1474, col: 22, offset: 35076},
+									pos:   position{line: 1509, col: 22, offset: 36053},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1474, col: 24, offset: 35078},
+										pos:  position{line: 1509, col: 24, offset: 36055},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1474, col: 29, offset: 35083},
+									pos:  position{line: 1509, col: 29, offset: 36060},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1474, col: 32, offset: 35086},
+									pos:        position{line: 1509, col: 32, offset: 36063},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9428,35 +9694,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1481, col: 5, offset: 35228},
-						run: (*parser).callonEasyType48,
+						pos: position{line: 1516, col: 5, offset: 36205},
+						run: (*parser).callonEasyType49,
 						expr: &seqExpr{
-							pos: position{line: 1481, col: 5, offset: 35228},
+							pos: position{line: 1516, col: 5, offset: 36205},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1481, col: 5, offset: 35228},
+									pos:        position{line: 1516, col: 5, offset: 36205},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1481, col: 9, offset: 35232},
+									pos:  position{line: 1516, col: 9, offset: 36209},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1481, col: 12, offset: 35235},
+									pos:   position{line: 1516, col: 12, offset: 36212},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1481, col: 19, offset: 35242},
+										pos:  position{line: 1516, col: 19, offset: 36219},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1481, col: 33, offset: 35256},
+									pos:  position{line: 1516, col: 33, offset: 36233},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1481, col: 36, offset: 35259},
+									pos:        position{line: 1516, col: 36, offset: 36236},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -9465,35 +9731,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1488, col: 5, offset: 35421},
-						run: (*parser).callonEasyType56,
+						pos: position{line: 1523, col: 5, offset: 36398},
+						run: (*parser).callonEasyType57,
 						expr: &seqExpr{
-							pos: position{line: 1488, col: 5, offset: 35421},
+							pos: position{line: 1523, col: 5, offset: 36398},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1488, col: 5, offset: 35421},
+									pos:        position{line: 1523, col: 5, offset: 36398},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1488, col: 9, offset: 35425},
+									pos:  position{line: 1523, col: 9, offset: 36402},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1488, col: 12, offset: 35428},
+									pos:   position{line: 1523, col: 12, offset: 36405},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1488, col: 16, offset: 35432},
+										pos:  position{line: 1523, col: 16, offset: 36409},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1488, col: 21, offset: 35437},
+									pos:  position{line: 1523, col: 21, offset: 36414},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1488, col: 24, offset: 35440},
+									pos:        position{line: 1523, col: 24, offset: 36417},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9502,35 +9768,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1495, col: 5, offset: 35582},
-						run: (*parser).callonEasyType64,
+						pos: position{line: 1530, col: 5, offset: 36559},
+						run: (*parser).callonEasyType65,
 						expr: &seqExpr{
-							pos: position{line: 1495, col: 5, offset: 35582},
+							pos: position{line: 1530, col: 5, offset: 36559},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1495, col: 5, offset: 35582},
+									pos:        position{line: 1530, col: 5, offset: 36559},
 									val:        "set[",
 									ignoreCase: false,
 									want:       "\"set[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1495, col: 12, offset: 35589},
+									pos:  position{line: 1530, col: 12, offset: 36566},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1495, col: 15, offset: 35592},
+									pos:   position{line: 1530, col: 15, offset: 36569},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1495, col: 19, offset: 35596},
+										pos:  position{line: 1530, col: 19, offset: 36573},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1495, col: 24, offset: 35601},
+									pos:  position{line: 1530, col: 24, offset: 36578},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1495, col: 27, offset: 35604},
+									pos:        position{line: 1530, col: 27, offset: 36581},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9539,57 +9805,57 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1502, col: 5, offset: 35742},
-						run: (*parser).callonEasyType72,
+						pos: position{line: 1537, col: 5, offset: 36719},
+						run: (*parser).callonEasyType73,
 						expr: &seqExpr{
-							pos: position{line: 1502, col: 5, offset: 35742},
+							pos: position{line: 1537, col: 5, offset: 36719},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1502, col: 5, offset: 35742},
+									pos:        position{line: 1537, col: 5, offset: 36719},
 									val:        "map{",
 									ignoreCase: false,
 									want:       "\"map{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 12, offset: 35749},
+									pos:  position{line: 1537, col: 12, offset: 36726},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1502, col: 15, offset: 35752},
+									pos:   position{line: 1537, col: 15, offset: 36729},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1502, col: 23, offset: 35760},
+										pos:  position{line: 1537, col: 23, offset: 36737},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 28, offset: 35765},
+									pos:  position{line: 1537, col: 28, offset: 36742},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1502, col: 31, offset: 35768},
+									pos:        position{line: 1537, col: 31, offset: 36745},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 35, offset: 35772},
+									pos:  position{line: 1537, col: 35, offset: 36749},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1502, col: 38, offset: 35775},
+									pos:   position{line: 1537, col: 38, offset: 36752},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1502, col: 46, offset: 35783},
+										pos:  position{line: 1537, col: 46, offset: 36760},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1502, col: 51, offset: 35788},
+									pos:  position{line: 1537, col: 51, offset: 36765},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1502, col: 54, offset: 35791},
+									pos:        position{line: 1537, col: 54, offset: 36768},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -9604,15 +9870,15 @@ var g = &grammar{
 		},
 		{
 			name: "TypeUnion",
-			pos:  position{line: 1511, col: 1, offset: 35964},
+			pos:  position{line: 1546, col: 1, offset: 36941},
 			expr: &actionExpr{
-				pos: position{line: 1512, col: 5, offset: 35978},
+				pos: position{line: 1547, col: 5, offset: 36955},
 				run: (*parser).callonTypeUnion1,
 				expr: &labeledExpr{
-					pos:   position{line: 1512, col: 5, offset: 35978},
+					pos:   position{line: 1547, col: 5, offset: 36955},
 					label: "types",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1512, col: 11, offset: 35984},
+						pos:  position{line: 1547, col: 11, offset: 36961},
 						name: "TypeList",
 					},
 				},
@@ -9622,28 +9888,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1520, col: 1, offset: 36121},
+			pos:  position{line: 1555, col: 1, offset: 37098},
 			expr: &actionExpr{
-				pos: position{line: 1521, col: 5, offset: 36134},
+				pos: position{line: 1556, col: 5, offset: 37111},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1521, col: 5, offset: 36134},
+					pos: position{line: 1556, col: 5, offset: 37111},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1521, col: 5, offset: 36134},
+							pos:   position{line: 1556, col: 5, offset: 37111},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1521, col: 11, offset: 36140},
+								pos:  position{line: 1556, col: 11, offset: 37117},
 								name: "ComponentType",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1521, col: 25, offset: 36154},
+							pos:   position{line: 1556, col: 25, offset: 37131},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1521, col: 30, offset: 36159},
+								pos: position{line: 1556, col: 30, offset: 37136},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1521, col: 30, offset: 36159},
+									pos:  position{line: 1556, col: 30, offset: 37136},
 									name: "TypeListTail",
 								},
 							},
@@ -9656,32 +9922,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1525, col: 1, offset: 36217},
+			pos:  position{line: 1560, col: 1, offset: 37194},
 			expr: &actionExpr{
-				pos: position{line: 1525, col: 16, offset: 36232},
+				pos: position{line: 1560, col: 16, offset: 37209},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1525, col: 16, offset: 36232},
+					pos: position{line: 1560, col: 16, offset: 37209},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1525, col: 16, offset: 36232},
+							pos:  position{line: 1560, col: 16, offset: 37209},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1525, col: 19, offset: 36235},
+							pos:        position{line: 1560, col: 19, offset: 37212},
 							val:        "|",
 							ignoreCase: false,
 							want:       "\"|\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1525, col: 23, offset: 36239},
+							pos:  position{line: 1560, col: 23, offset: 37216},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1525, col: 26, offset: 36242},
+							pos:   position{line: 1560, col: 26, offset: 37219},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1525, col: 30, offset: 36246},
+								pos:  position{line: 1560, col: 30, offset: 37223},
 								name: "ComponentType",
 							},
 						},
@@ -9693,42 +9959,42 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 1527, col: 1, offset: 36281},
+			pos:  position{line: 1562, col: 1, offset: 37258},
 			expr: &choiceExpr{
-				pos: position{line: 1528, col: 5, offset: 36299},
+				pos: position{line: 1563, col: 5, offset: 37276},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1528, col: 5, offset: 36299},
+						pos: position{line: 1563, col: 5, offset: 37276},
 						run: (*parser).callonStringLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 1528, col: 5, offset: 36299},
+							pos:   position{line: 1563, col: 5, offset: 37276},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1528, col: 7, offset: 36301},
+								pos:  position{line: 1563, col: 7, offset: 37278},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1529, col: 5, offset: 36416},
+						pos: position{line: 1564, col: 5, offset: 37393},
 						run: (*parser).callonStringLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 1529, col: 5, offset: 36416},
+							pos:   position{line: 1564, col: 5, offset: 37393},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1529, col: 7, offset: 36418},
+								pos:  position{line: 1564, col: 7, offset: 37395},
 								name: "SingleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1530, col: 5, offset: 36495},
+						pos: position{line: 1565, col: 5, offset: 37472},
 						run: (*parser).callonStringLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1530, col: 5, offset: 36495},
+							pos:   position{line: 1565, col: 5, offset: 37472},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1530, col: 7, offset: 36497},
+								pos:  position{line: 1565, col: 7, offset: 37474},
 								name: "RString",
 							},
 						},
@@ -9740,35 +10006,35 @@ var g = &grammar{
 		},
 		{
 			name: "FString",
-			pos:  position{line: 1532, col: 1, offset: 36560},
+			pos:  position{line: 1567, col: 1, offset: 37537},
 			expr: &choiceExpr{
-				pos: position{line: 1533, col: 5, offset: 36572},
+				pos: position{line: 1568, col: 5, offset: 37549},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1533, col: 5, offset: 36572},
+						pos: position{line: 1568, col: 5, offset: 37549},
 						run: (*parser).callonFString2,
 						expr: &seqExpr{
-							pos: position{line: 1533, col: 5, offset: 36572},
+							pos: position{line: 1568, col: 5, offset: 37549},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1533, col: 5, offset: 36572},
+									pos:        position{line: 1568, col: 5, offset: 37549},
 									val:        "f\"",
 									ignoreCase: false,
 									want:       "\"f\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1533, col: 11, offset: 36578},
+									pos:   position{line: 1568, col: 11, offset: 37555},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1533, col: 13, offset: 36580},
+										pos: position{line: 1568, col: 13, offset: 37557},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1533, col: 13, offset: 36580},
+											pos:  position{line: 1568, col: 13, offset: 37557},
 											name: "FStringDoubleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1533, col: 38, offset: 36605},
+									pos:        position{line: 1568, col: 38, offset: 37582},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -9777,30 +10043,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1540, col: 5, offset: 36759},
+						pos: position{line: 1575, col: 5, offset: 37736},
 						run: (*parser).callonFString9,
 						expr: &seqExpr{
-							pos: position{line: 1540, col: 5, offset: 36759},
+							pos: position{line: 1575, col: 5, offset: 37736},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1540, col: 5, offset: 36759},
+									pos:        position{line: 1575, col: 5, offset: 37736},
 									val:        "f'",
 									ignoreCase: false,
 									want:       "\"f'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1540, col: 10, offset: 36764},
+									pos:   position{line: 1575, col: 10, offset: 37741},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1540, col: 12, offset: 36766},
+										pos: position{line: 1575, col: 12, offset: 37743},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1540, col: 12, offset: 36766},
+											pos:  position{line: 1575, col: 12, offset: 37743},
 											name: "FStringSingleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1540, col: 37, offset: 36791},
+									pos:        position{line: 1575, col: 37, offset: 37768},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -9815,24 +10081,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedElem",
-			pos:  position{line: 1548, col: 1, offset: 36942},
+			pos:  position{line: 1583, col: 1, offset: 37919},
 			expr: &choiceExpr{
-				pos: position{line: 1549, col: 5, offset: 36970},
+				pos: position{line: 1584, col: 5, offset: 37947},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1549, col: 5, offset: 36970},
+						pos:  position{line: 1584, col: 5, offset: 37947},
 						name: "FStringExprElem",
 					},
 					&actionExpr{
-						pos: position{line: 1550, col: 5, offset: 36990},
+						pos: position{line: 1585, col: 5, offset: 37967},
 						run: (*parser).callonFStringDoubleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1550, col: 5, offset: 36990},
+							pos:   position{line: 1585, col: 5, offset: 37967},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1550, col: 7, offset: 36992},
+								pos: position{line: 1585, col: 7, offset: 37969},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1550, col: 7, offset: 36992},
+									pos:  position{line: 1585, col: 7, offset: 37969},
 									name: "FStringDoubleQuotedChar",
 								},
 							},
@@ -9845,27 +10111,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedChar",
-			pos:  position{line: 1554, col: 1, offset: 37123},
+			pos:  position{line: 1589, col: 1, offset: 38100},
 			expr: &choiceExpr{
-				pos: position{line: 1555, col: 5, offset: 37151},
+				pos: position{line: 1590, col: 5, offset: 38128},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1555, col: 5, offset: 37151},
+						pos: position{line: 1590, col: 5, offset: 38128},
 						run: (*parser).callonFStringDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1555, col: 5, offset: 37151},
+							pos: position{line: 1590, col: 5, offset: 38128},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1555, col: 5, offset: 37151},
+									pos:        position{line: 1590, col: 5, offset: 38128},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1555, col: 10, offset: 37156},
+									pos:   position{line: 1590, col: 10, offset: 38133},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1555, col: 12, offset: 37158},
+										pos:        position{line: 1590, col: 12, offset: 38135},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9875,25 +10141,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1556, col: 5, offset: 37184},
+						pos: position{line: 1591, col: 5, offset: 38161},
 						run: (*parser).callonFStringDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1556, col: 5, offset: 37184},
+							pos: position{line: 1591, col: 5, offset: 38161},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1556, col: 5, offset: 37184},
+									pos: position{line: 1591, col: 5, offset: 38161},
 									expr: &litMatcher{
-										pos:        position{line: 1556, col: 7, offset: 37186},
+										pos:        position{line: 1591, col: 7, offset: 38163},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1556, col: 12, offset: 37191},
+									pos:   position{line: 1591, col: 12, offset: 38168},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1556, col: 14, offset: 37193},
+										pos:  position{line: 1591, col: 14, offset: 38170},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -9907,24 +10173,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedElem",
-			pos:  position{line: 1558, col: 1, offset: 37229},
+			pos:  position{line: 1593, col: 1, offset: 38206},
 			expr: &choiceExpr{
-				pos: position{line: 1559, col: 5, offset: 37257},
+				pos: position{line: 1594, col: 5, offset: 38234},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1559, col: 5, offset: 37257},
+						pos:  position{line: 1594, col: 5, offset: 38234},
 						name: "FStringExprElem",
 					},
 					&actionExpr{
-						pos: position{line: 1560, col: 5, offset: 37277},
+						pos: position{line: 1595, col: 5, offset: 38254},
 						run: (*parser).callonFStringSingleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1560, col: 5, offset: 37277},
+							pos:   position{line: 1595, col: 5, offset: 38254},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1560, col: 7, offset: 37279},
+								pos: position{line: 1595, col: 7, offset: 38256},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1560, col: 7, offset: 37279},
+									pos:  position{line: 1595, col: 7, offset: 38256},
 									name: "FStringSingleQuotedChar",
 								},
 							},
@@ -9937,27 +10203,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedChar",
-			pos:  position{line: 1564, col: 1, offset: 37410},
+			pos:  position{line: 1599, col: 1, offset: 38387},
 			expr: &choiceExpr{
-				pos: position{line: 1565, col: 5, offset: 37438},
+				pos: position{line: 1600, col: 5, offset: 38415},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1565, col: 5, offset: 37438},
+						pos: position{line: 1600, col: 5, offset: 38415},
 						run: (*parser).callonFStringSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1565, col: 5, offset: 37438},
+							pos: position{line: 1600, col: 5, offset: 38415},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1565, col: 5, offset: 37438},
+									pos:        position{line: 1600, col: 5, offset: 38415},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1565, col: 10, offset: 37443},
+									pos:   position{line: 1600, col: 10, offset: 38420},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1565, col: 12, offset: 37445},
+										pos:        position{line: 1600, col: 12, offset: 38422},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9967,25 +10233,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1566, col: 5, offset: 37471},
+						pos: position{line: 1601, col: 5, offset: 38448},
 						run: (*parser).callonFStringSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1566, col: 5, offset: 37471},
+							pos: position{line: 1601, col: 5, offset: 38448},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1566, col: 5, offset: 37471},
+									pos: position{line: 1601, col: 5, offset: 38448},
 									expr: &litMatcher{
-										pos:        position{line: 1566, col: 7, offset: 37473},
+										pos:        position{line: 1601, col: 7, offset: 38450},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1566, col: 12, offset: 37478},
+									pos:   position{line: 1601, col: 12, offset: 38455},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1566, col: 14, offset: 37480},
+										pos:  position{line: 1601, col: 14, offset: 38457},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -9999,37 +10265,37 @@ var g = &grammar{
 		},
 		{
 			name: "FStringExprElem",
-			pos:  position{line: 1568, col: 1, offset: 37516},
+			pos:  position{line: 1603, col: 1, offset: 38493},
 			expr: &actionExpr{
-				pos: position{line: 1569, col: 5, offset: 37536},
+				pos: position{line: 1604, col: 5, offset: 38513},
 				run: (*parser).callonFStringExprElem1,
 				expr: &seqExpr{
-					pos: position{line: 1569, col: 5, offset: 37536},
+					pos: position{line: 1604, col: 5, offset: 38513},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1569, col: 5, offset: 37536},
+							pos:        position{line: 1604, col: 5, offset: 38513},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1569, col: 9, offset: 37540},
+							pos:  position{line: 1604, col: 9, offset: 38517},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1569, col: 12, offset: 37543},
+							pos:   position{line: 1604, col: 12, offset: 38520},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1569, col: 14, offset: 37545},
+								pos:  position{line: 1604, col: 14, offset: 38522},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1569, col: 19, offset: 37550},
+							pos:  position{line: 1604, col: 19, offset: 38527},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1569, col: 22, offset: 37553},
+							pos:        position{line: 1604, col: 22, offset: 38530},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -10042,156 +10308,180 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1577, col: 1, offset: 37696},
+			pos:  position{line: 1612, col: 1, offset: 38673},
 			expr: &choiceExpr{
-				pos: position{line: 1578, col: 5, offset: 37714},
+				pos: position{line: 1613, col: 5, offset: 38691},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1578, col: 5, offset: 37714},
+						pos: position{line: 1613, col: 5, offset: 38691},
 						run: (*parser).callonPrimitiveType2,
 						expr: &labeledExpr{
-							pos:   position{line: 1578, col: 5, offset: 37714},
+							pos:   position{line: 1613, col: 5, offset: 38691},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1578, col: 10, offset: 37719},
+								pos:  position{line: 1613, col: 10, offset: 38696},
 								name: "PostgreSQLPrimitiveType",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1585, col: 5, offset: 37894},
+						pos: position{line: 1620, col: 5, offset: 38871},
 						run: (*parser).callonPrimitiveType5,
 						expr: &choiceExpr{
-							pos: position{line: 1585, col: 9, offset: 37898},
+							pos: position{line: 1620, col: 9, offset: 38875},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1585, col: 9, offset: 37898},
+									pos:        position{line: 1620, col: 9, offset: 38875},
 									val:        "uint8",
 									ignoreCase: false,
 									want:       "\"uint8\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1585, col: 19, offset: 37908},
+									pos:        position{line: 1620, col: 19, offset: 38885},
 									val:        "uint16",
 									ignoreCase: false,
 									want:       "\"uint16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1585, col: 30, offset: 37919},
+									pos:        position{line: 1620, col: 30, offset: 38896},
 									val:        "uint32",
 									ignoreCase: false,
 									want:       "\"uint32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1585, col: 41, offset: 37930},
+									pos:        position{line: 1620, col: 41, offset: 38907},
 									val:        "uint64",
 									ignoreCase: false,
 									want:       "\"uint64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1586, col: 9, offset: 37947},
+									pos:        position{line: 1620, col: 52, offset: 38918},
+									val:        "uint128",
+									ignoreCase: false,
+									want:       "\"uint128\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1620, col: 64, offset: 38930},
+									val:        "uint256",
+									ignoreCase: false,
+									want:       "\"uint256\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1621, col: 9, offset: 38948},
 									val:        "int8",
 									ignoreCase: false,
 									want:       "\"int8\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1586, col: 18, offset: 37956},
+									pos:        position{line: 1621, col: 18, offset: 38957},
 									val:        "int16",
 									ignoreCase: false,
 									want:       "\"int16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1586, col: 28, offset: 37966},
+									pos:        position{line: 1621, col: 28, offset: 38967},
 									val:        "int32",
 									ignoreCase: false,
 									want:       "\"int32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1586, col: 38, offset: 37976},
+									pos:        position{line: 1621, col: 38, offset: 38977},
 									val:        "int64",
 									ignoreCase: false,
 									want:       "\"int64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1587, col: 9, offset: 37992},
+									pos:        position{line: 1621, col: 48, offset: 38987},
+									val:        "int128",
+									ignoreCase: false,
+									want:       "\"int128\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1621, col: 59, offset: 38998},
+									val:        "int256",
+									ignoreCase: false,
+									want:       "\"int256\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1622, col: 9, offset: 39015},
 									val:        "float16",
 									ignoreCase: false,
 									want:       "\"float16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1587, col: 21, offset: 38004},
+									pos:        position{line: 1622, col: 21, offset: 39027},
 									val:        "float32",
 									ignoreCase: false,
 									want:       "\"float32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1587, col: 33, offset: 38016},
+									pos:        position{line: 1622, col: 33, offset: 39039},
 									val:        "float64",
 									ignoreCase: false,
 									want:       "\"float64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1588, col: 9, offset: 38034},
+									pos:        position{line: 1623, col: 9, offset: 39057},
 									val:        "bool",
 									ignoreCase: false,
 									want:       "\"bool\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1588, col: 18, offset: 38043},
+									pos:        position{line: 1623, col: 18, offset: 39066},
 									val:        "string",
 									ignoreCase: false,
 									want:       "\"string\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1589, col: 9, offset: 38060},
+									pos:        position{line: 1624, col: 9, offset: 39083},
 									val:        "duration",
 									ignoreCase: false,
 									want:       "\"duration\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1589, col: 22, offset: 38073},
+									pos:        position{line: 1624, col: 22, offset: 39096},
 									val:        "time",
 									ignoreCase: false,
 									want:       "\"time\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1590, col: 9, offset: 38088},
+									pos:        position{line: 1625, col: 9, offset: 39111},
 									val:        "bytes",
 									ignoreCase: false,
 									want:       "\"bytes\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1591, col: 9, offset: 38104},
+									pos:        position{line: 1626, col: 9, offset: 39127},
 									val:        "ip",
 									ignoreCase: false,
 									want:       "\"ip\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1591, col: 16, offset: 38111},
+									pos:        position{line: 1626, col: 16, offset: 39134},
 									val:        "net",
 									ignoreCase: false,
 									want:       "\"net\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1592, col: 9, offset: 38125},
+									pos:        position{line: 1627, col: 9, offset: 39148},
 									val:        "type",
 									ignoreCase: false,
 									want:       "\"type\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1592, col: 18, offset: 38134},
+									pos:        position{line: 1627, col: 18, offset: 39157},
 									val:        "null",
 									ignoreCase: false,
 									want:       "\"null\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1592, col: 27, offset: 38143},
+									pos:        position{line: 1627, col: 27, offset: 39166},
 									val:        "none",
 									ignoreCase: false,
 									want:       "\"none\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1592, col: 36, offset: 38152},
+									pos:        position{line: 1627, col: 36, offset: 39175},
 									val:        "all",
 									ignoreCase: false,
 									want:       "\"all\"",
@@ -10205,121 +10495,233 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "PostgreSQLPrimitiveType",
-			pos:  position{line: 1601, col: 1, offset: 38409},
-			expr: &choiceExpr{
-				pos: position{line: 1602, col: 5, offset: 38437},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 1602, col: 5, offset: 38437},
-						run: (*parser).callonPostgreSQLPrimitiveType2,
-						expr: &litMatcher{
-							pos:        position{line: 1602, col: 5, offset: 38437},
-							val:        "bigint",
-							ignoreCase: true,
-							want:       "\"bigint\"i",
-						},
-					},
-					&actionExpr{
-						pos: position{line: 1603, col: 5, offset: 38486},
-						run: (*parser).callonPostgreSQLPrimitiveType4,
-						expr: &litMatcher{
-							pos:        position{line: 1603, col: 5, offset: 38486},
-							val:        "boolean",
-							ignoreCase: true,
-							want:       "\"boolean\"i",
-						},
-					},
-					&actionExpr{
-						pos: position{line: 1604, col: 5, offset: 38534},
-						run: (*parser).callonPostgreSQLPrimitiveType6,
-						expr: &litMatcher{
-							pos:        position{line: 1604, col: 5, offset: 38534},
-							val:        "bytea",
-							ignoreCase: true,
-							want:       "\"bytea\"i",
-						},
-					},
-					&actionExpr{
-						pos: position{line: 1605, col: 5, offset: 38583},
-						run: (*parser).callonPostgreSQLPrimitiveType8,
-						expr: &seqExpr{
-							pos: position{line: 1605, col: 5, offset: 38583},
-							exprs: []any{
+			name: "DecimalType",
+			pos:  position{line: 1635, col: 1, offset: 39360},
+			expr: &actionExpr{
+				pos: position{line: 1636, col: 5, offset: 39376},
+				run: (*parser).callonDecimalType1,
+				expr: &seqExpr{
+					pos: position{line: 1636, col: 5, offset: 39376},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 1636, col: 6, offset: 39377},
+							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1605, col: 5, offset: 38583},
-									val:        "char",
+									pos:        position{line: 1636, col: 6, offset: 39377},
+									val:        "decimal",
 									ignoreCase: true,
-									want:       "\"char\"i",
+									want:       "\"decimal\"i",
 								},
-								&notExpr{
-									pos: position{line: 1605, col: 13, offset: 38591},
-									expr: &litMatcher{
-										pos:        position{line: 1605, col: 14, offset: 38592},
-										val:        "a",
-										ignoreCase: true,
-										want:       "\"a\"i",
-									},
+								&litMatcher{
+									pos:        position{line: 1636, col: 19, offset: 39390},
+									val:        "numeric",
+									ignoreCase: true,
+									want:       "\"numeric\"i",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1606, col: 5, offset: 38633},
-						run: (*parser).callonPostgreSQLPrimitiveType13,
-						expr: &litMatcher{
-							pos:        position{line: 1606, col: 5, offset: 38633},
-							val:        "character varying",
-							ignoreCase: true,
-							want:       "\"character varying\"i",
+						&ruleRefExpr{
+							pos:  position{line: 1636, col: 31, offset: 39402},
+							name: "__",
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1607, col: 5, offset: 38683},
-						run: (*parser).callonPostgreSQLPrimitiveType15,
-						expr: &litMatcher{
-							pos:        position{line: 1607, col: 5, offset: 38683},
-							val:        "character",
-							ignoreCase: true,
+						&litMatcher{
+							pos:        position{line: 1636, col: 34, offset: 39405},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1636, col: 38, offset: 39409},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 1636, col: 41, offset: 39412},
+							label: "precision",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1636, col: 51, offset: 39422},
+								name: "UInt",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1636, col: 56, offset: 39427},
+							label: "scale",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1636, col: 62, offset: 39433},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1636, col: 62, offset: 39433},
+									name: "DecimalScale",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1636, col: 76, offset: 39447},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1636, col: 79, offset: 39450},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "DecimalScale",
+			pos:  position{line: 1648, col: 1, offset: 39668},
+			expr: &actionExpr{
+				pos: position{line: 1648, col: 16, offset: 39683},
+				run: (*parser).callonDecimalScale1,
+				expr: &seqExpr{
+					pos: position{line: 1648, col: 16, offset: 39683},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 1648, col: 16, offset: 39683},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 1648, col: 19, offset: 39686},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1648, col: 23, offset: 39690},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 1648, col: 26, offset: 39693},
+							label: "scale",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1648, col: 32, offset: 39699},
+								name: "UInt",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PostgreSQLPrimitiveType",
+			pos:  position{line: 1651, col: 1, offset: 39799},
+			expr: &choiceExpr{
+				pos: position{line: 1652, col: 5, offset: 39827},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1652, col: 5, offset: 39827},
+						run: (*parser).callonPostgreSQLPrimitiveType2,
+						expr: &litMatcher{
+							pos:        position{line: 1652, col: 5, offset: 39827},
+							val:        "bigint",
+							ignoreCase: true,
+							want:       "\"bigint\"i",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1653, col: 5, offset: 39876},
+						run: (*parser).callonPostgreSQLPrimitiveType4,
+						expr: &litMatcher{
+							pos:        position{line: 1653, col: 5, offset: 39876},
+							val:        "boolean",
+							ignoreCase: true,
+							want:       "\"boolean\"i",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1654, col: 5, offset: 39924},
+						run: (*parser).callonPostgreSQLPrimitiveType6,
+						expr: &litMatcher{
+							pos:        position{line: 1654, col: 5, offset: 39924},
+							val:        "bytea",
+							ignoreCase: true,
+							want:       "\"bytea\"i",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1655, col: 5, offset: 39973},
+						run: (*parser).callonPostgreSQLPrimitiveType8,
+						expr: &seqExpr{
+							pos: position{line: 1655, col: 5, offset: 39973},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 1655, col: 5, offset: 39973},
+									val:        "char",
+									ignoreCase: true,
+									want:       "\"char\"i",
+								},
+								&notExpr{
+									pos: position{line: 1655, col: 13, offset: 39981},
+									expr: &litMatcher{
+										pos:        position{line: 1655, col: 14, offset: 39982},
+										val:        "a",
+										ignoreCase: true,
+										want:       "\"a\"i",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1656, col: 5, offset: 40023},
+						run: (*parser).callonPostgreSQLPrimitiveType13,
+						expr: &litMatcher{
+							pos:        position{line: 1656, col: 5, offset: 40023},
+							val:        "character varying",
+							ignoreCase: true,
+							want:       "\"character varying\"i",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1657, col: 5, offset: 40073},
+						run: (*parser).callonPostgreSQLPrimitiveType15,
+						expr: &litMatcher{
+							pos:        position{line: 1657, col: 5, offset: 40073},
+							val:        "character",
+							ignoreCase: true,
 							want:       "\"character\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1608, col: 5, offset: 38733},
+						pos: position{line: 1658, col: 5, offset: 40123},
 						run: (*parser).callonPostgreSQLPrimitiveType17,
 						expr: &litMatcher{
-							pos:        position{line: 1608, col: 5, offset: 38733},
+							pos:        position{line: 1658, col: 5, offset: 40123},
 							val:        "cidr",
 							ignoreCase: true,
 							want:       "\"cidr\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1609, col: 5, offset: 38780},
+						pos: position{line: 1659, col: 5, offset: 40170},
 						run: (*parser).callonPostgreSQLPrimitiveType19,
 						expr: &litMatcher{
-							pos:        position{line: 1609, col: 5, offset: 38780},
+							pos:        position{line: 1659, col: 5, offset: 40170},
 							val:        "double precision",
 							ignoreCase: true,
 							want:       "\"double precision\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1610, col: 5, offset: 38831},
+						pos: position{line: 1660, col: 5, offset: 40221},
 						run: (*parser).callonPostgreSQLPrimitiveType21,
 						expr: &seqExpr{
-							pos: position{line: 1610, col: 5, offset: 38831},
+							pos: position{line: 1660, col: 5, offset: 40221},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1610, col: 5, offset: 38831},
+									pos:        position{line: 1660, col: 5, offset: 40221},
 									val:        "float",
 									ignoreCase: true,
 									want:       "\"float\"i",
 								},
 								&notExpr{
-									pos: position{line: 1610, col: 14, offset: 38840},
+									pos: position{line: 1660, col: 14, offset: 40230},
 									expr: &charClassMatcher{
-										pos:        position{line: 1610, col: 15, offset: 38841},
+										pos:        position{line: 1660, col: 15, offset: 40231},
 										val:        "[136]",
 										chars:      []rune{'1', '3', '6'},
 										ignoreCase: false,
@@ -10330,33 +10732,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1611, col: 5, offset: 38882},
+						pos: position{line: 1661, col: 5, offset: 40272},
 						run: (*parser).callonPostgreSQLPrimitiveType26,
 						expr: &litMatcher{
-							pos:        position{line: 1611, col: 5, offset: 38882},
+							pos:        position{line: 1661, col: 5, offset: 40272},
 							val:        "inet",
 							ignoreCase: true,
 							want:       "\"inet\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1612, col: 5, offset: 38928},
+						pos: position{line: 1662, col: 5, offset: 40318},
 						run: (*parser).callonPostgreSQLPrimitiveType28,
 						expr: &seqExpr{
-							pos: position{line: 1612, col: 5, offset: 38928},
+							pos: position{line: 1662, col: 5, offset: 40318},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1612, col: 5, offset: 38928},
+									pos:        position{line: 1662, col: 5, offset: 40318},
 									val:        "int",
 									ignoreCase: true,
 									want:       "\"int\"i",
 								},
 								&notExpr{
-									pos: position{line: 1612, col: 12, offset: 38935},
+									pos: position{line: 1662, col: 12, offset: 40325},
 									expr: &charClassMatcher{
-										pos:        position{line: 1612, col: 13, offset: 38936},
-										val:        "[1368e]i",
-										chars:      []rune{'1', '3', '6', '8', 'e'},
+										pos:        position{line: 1662, col: 13, offset: 40326},
+										val:        "[12368e]i",
+										chars:      []rune{'1', '2', '3', '6', '8', 'e'},
 										ignoreCase: true,
 										inverted:   false,
 									},
@@ -10365,60 +10767,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1613, col: 5, offset: 38977},
+						pos: position{line: 1663, col: 5, offset: 40367},
 						run: (*parser).callonPostgreSQLPrimitiveType33,
 						expr: &litMatcher{
-							pos:        position{line: 1613, col: 5, offset: 38977},
+							pos:        position{line: 1663, col: 5, offset: 40367},
 							val:        "integer",
 							ignoreCase: true,
 							want:       "\"integer\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1614, col: 5, offset: 39026},
+						pos: position{line: 1664, col: 5, offset: 40416},
 						run: (*parser).callonPostgreSQLPrimitiveType35,
 						expr: &litMatcher{
-							pos:        position{line: 1614, col: 5, offset: 39026},
+							pos:        position{line: 1664, col: 5, offset: 40416},
 							val:        "interval",
 							ignoreCase: true,
 							want:       "\"interval\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1615, col: 5, offset: 39078},
+						pos: position{line: 1665, col: 5, offset: 40468},
 						run: (*parser).callonPostgreSQLPrimitiveType37,
 						expr: &litMatcher{
-							pos:        position{line: 1615, col: 5, offset: 39078},
+							pos:        position{line: 1665, col: 5, offset: 40468},
 							val:        "real",
 							ignoreCase: true,
 							want:       "\"real\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1616, col: 5, offset: 39129},
+						pos: position{line: 1666, col: 5, offset: 40519},
 						run: (*parser).callonPostgreSQLPrimitiveType39,
 						expr: &litMatcher{
-							pos:        position{line: 1616, col: 5, offset: 39129},
+							pos:        position{line: 1666, col: 5, offset: 40519},
 							val:        "smallint",
 							ignoreCase: true,
 							want:       "\"smallint\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1617, col: 5, offset: 39178},
+						pos: position{line: 1667, col: 5, offset: 40568},
 						run: (*parser).callonPostgreSQLPrimitiveType41,
 						expr: &litMatcher{
-							pos:        position{line: 1617, col: 5, offset: 39178},
+							pos:        position{line: 1667, col: 5, offset: 40568},
 							val:        "text",
 							ignoreCase: true,
 							want:       "\"text\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1618, col: 5, offset: 39228},
+						pos: position{line: 1668, col: 5, offset: 40618},
 						run: (*parser).callonPostgreSQLPrimitiveType43,
 						expr: &litMatcher{
-							pos:        position{line: 1618, col: 5, offset: 39228},
+							pos:        position{line: 1668, col: 5, offset: 40618},
 							val:        "varchar",
 							ignoreCase: true,
 							want:       "\"varchar\"i",
@@ -10431,31 +10833,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1620, col: 1, offset: 39275},
+			pos:  position{line: 1670, col: 1, offset: 40665},
 			expr: &choiceExpr{
-				pos: position{line: 1621, col: 5, offset: 39293},
+				pos: position{line: 1671, col: 5, offset: 40683},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1621, col: 5, offset: 39293},
+						pos: position{line: 1671, col: 5, offset: 40683},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1621, col: 5, offset: 39293},
+							pos: position{line: 1671, col: 5, offset: 40683},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1621, col: 5, offset: 39293},
+									pos:   position{line: 1671, col: 5, offset: 40683},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1621, col: 11, offset: 39299},
+										pos:  position{line: 1671, col: 11, offset: 40689},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1621, col: 21, offset: 39309},
+									pos:   position{line: 1671, col: 21, offset: 40699},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1621, col: 26, offset: 39314},
+										pos: position{line: 1671, col: 26, offset: 40704},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1621, col: 26, offset: 39314},
+											pos:  position{line: 1671, col: 26, offset: 40704},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -10464,10 +10866,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1624, col: 5, offset: 39380},
+						pos: position{line: 1674, col: 5, offset: 40770},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1624, col: 5, offset: 39380},
+							pos:        position{line: 1674, col: 5, offset: 40770},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -10480,32 +10882,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1626, col: 1, offset: 39404},
+			pos:  position{line: 1676, col: 1, offset: 40794},
 			expr: &actionExpr{
-				pos: position{line: 1626, col: 21, offset: 39424},
+				pos: position{line: 1676, col: 21, offset: 40814},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1626, col: 21, offset: 39424},
+					pos: position{line: 1676, col: 21, offset: 40814},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1626, col: 21, offset: 39424},
+							pos:  position{line: 1676, col: 21, offset: 40814},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1626, col: 24, offset: 39427},
+							pos:        position{line: 1676, col: 24, offset: 40817},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1626, col: 28, offset: 39431},
+							pos:  position{line: 1676, col: 28, offset: 40821},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1626, col: 31, offset: 39434},
+							pos:   position{line: 1676, col: 31, offset: 40824},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1626, col: 35, offset: 39438},
+								pos:  position{line: 1676, col: 35, offset: 40828},
 								name: "TypeField",
 							},
 						},
@@ -10517,48 +10919,48 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1628, col: 1, offset: 39469},
+			pos:  position{line: 1678, col: 1, offset: 40859},
 			expr: &actionExpr{
-				pos: position{line: 1629, col: 5, offset: 39483},
+				pos: position{line: 1679, col: 5, offset: 40873},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1629, col: 5, offset: 39483},
+					pos: position{line: 1679, col: 5, offset: 40873},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1629, col: 5, offset: 39483},
+							pos:   position{line: 1679, col: 5, offset: 40873},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1629, col: 10, offset: 39488},
+								pos:  position{line: 1679, col: 10, offset: 40878},
 								name: "Name",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1629, col: 15, offset: 39493},
+							pos:   position{line: 1679, col: 15, offset: 40883},
 							label: "opt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1629, col: 19, offset: 39497},
+								pos:  position{line: 1679, col: 19, offset: 40887},
 								name: "OptToken",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1629, col: 28, offset: 39506},
+							pos:  position{line: 1679, col: 28, offset: 40896},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1629, col: 31, offset: 39509},
+							pos:        position{line: 1679, col: 31, offset: 40899},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1629, col: 35, offset: 39513},
+							pos:  position{line: 1679, col: 35, offset: 40903},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1629, col: 38, offset: 39516},
+							pos:   position{line: 1679, col: 38, offset: 40906},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1629, col: 42, offset: 39520},
+								pos:  position{line: 1679, col: 42, offset: 40910},
 								name: "Type",
 							},
 						},
@@ -10570,25 +10972,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptToken",
-			pos:  position{line: 1638, col: 1, offset: 39696},
+			pos:  position{line: 1688, col: 1, offset: 41086},
 			expr: &choiceExpr{
-				pos: position{line: 1639, col: 5, offset: 39709},
+				pos: position{line: 1689, col: 5, offset: 41099},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1639, col: 5, offset: 39709},
+						pos: position{line: 1689, col: 5, offset: 41099},
 						run: (*parser).callonOptToken2,
 						expr: &litMatcher{
-							pos:        position{line: 1639, col: 5, offset: 39709},
+							pos:        position{line: 1689, col: 5, offset: 41099},
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1640, col: 5, offset: 39738},
+						pos: position{line: 1690, col: 5, offset: 41128},
 						run: (*parser).callonOptToken4,
 						expr: &litMatcher{
-							pos:        position{line: 1640, col: 5, offset: 39738},
+							pos:        position{line: 1690, col: 5, offset: 41128},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -10601,26 +11003,26 @@ var g = &grammar{
 		},
 		{
 			name: "Name",
-			pos:  position{line: 1642, col: 1, offset: 39764},
+			pos:  position{line: 1692, col: 1, offset: 41154},
 			expr: &actionExpr{
-				pos: position{line: 1643, col: 4, offset: 39772},
+				pos: position{line: 1693, col: 4, offset: 41162},
 				run: (*parser).callonName1,
 				expr: &labeledExpr{
-					pos:   position{line: 1643, col: 4, offset: 39772},
+					pos:   position{line: 1693, col: 4, offset: 41162},
 					label: "s",
 					expr: &choiceExpr{
-						pos: position{line: 1643, col: 7, offset: 39775},
+						pos: position{line: 1693, col: 7, offset: 41165},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 1643, col: 7, offset: 39775},
+								pos:  position{line: 1693, col: 7, offset: 41165},
 								name: "IdentifierName",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1643, col: 24, offset: 39792},
+								pos:  position{line: 1693, col: 24, offset: 41182},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1643, col: 45, offset: 39813},
+								pos:  position{line: 1693, col: 45, offset: 41203},
 								name: "SingleQuotedString",
 							},
 						},
@@ -10632,51 +11034,51 @@ var g = &grammar{
 		},
 		{
 			name: "Names",
-			pos:  position{line: 1647, col: 1, offset: 39913},
+			pos:  position{line: 1697, col: 1, offset: 41303},
 			expr: &actionExpr{
-				pos: position{line: 1648, col: 5, offset: 39923},
+				pos: position{line: 1698, col: 5, offset: 41313},
 				run: (*parser).callonNames1,
 				expr: &seqExpr{
-					pos: position{line: 1648, col: 5, offset: 39923},
+					pos: position{line: 1698, col: 5, offset: 41313},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1648, col: 5, offset: 39923},
+							pos:   position{line: 1698, col: 5, offset: 41313},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1648, col: 11, offset: 39929},
+								pos:  position{line: 1698, col: 11, offset: 41319},
 								name: "Name",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1648, col: 16, offset: 39934},
+							pos:   position{line: 1698, col: 16, offset: 41324},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1648, col: 21, offset: 39939},
+								pos: position{line: 1698, col: 21, offset: 41329},
 								expr: &actionExpr{
-									pos: position{line: 1648, col: 22, offset: 39940},
+									pos: position{line: 1698, col: 22, offset: 41330},
 									run: (*parser).callonNames7,
 									expr: &seqExpr{
-										pos: position{line: 1648, col: 22, offset: 39940},
+										pos: position{line: 1698, col: 22, offset: 41330},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1648, col: 22, offset: 39940},
+												pos:  position{line: 1698, col: 22, offset: 41330},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1648, col: 25, offset: 39943},
+												pos:        position{line: 1698, col: 25, offset: 41333},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1648, col: 29, offset: 39947},
+												pos:  position{line: 1698, col: 29, offset: 41337},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1648, col: 32, offset: 39950},
+												pos:   position{line: 1698, col: 32, offset: 41340},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1648, col: 37, offset: 39955},
+													pos:  position{line: 1698, col: 37, offset: 41345},
 													name: "Name",
 												},
 											},
@@ -10693,15 +11095,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1652, col: 1, offset: 40027},
+			pos:  position{line: 1702, col: 1, offset: 41417},
 			expr: &actionExpr{
-				pos: position{line: 1653, col: 5, offset: 40042},
+				pos: position{line: 1703, col: 5, offset: 41432},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1653, col: 5, offset: 40042},
+					pos:   position{line: 1703, col: 5, offset: 41432},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1653, col: 8, offset: 40045},
+						pos:  position{line: 1703, col: 8, offset: 41435},
 						name: "IdentifierName",
 					},
 				},
@@ -10711,51 +11113,51 @@ var g = &grammar{
 		},
 		{
 			name: "Identifiers",
-			pos:  position{line: 1660, col: 1, offset: 40156},
+			pos:  position{line: 1710, col: 1, offset: 41546},
 			expr: &actionExpr{
-				pos: position{line: 1661, col: 5, offset: 40172},
+				pos: position{line: 1711, col: 5, offset: 41562},
 				run: (*parser).callonIdentifiers1,
 				expr: &seqExpr{
-					pos: position{line: 1661, col: 5, offset: 40172},
+					pos: position{line: 1711, col: 5, offset: 41562},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1661, col: 5, offset: 40172},
+							pos:   position{line: 1711, col: 5, offset: 41562},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1661, col: 11, offset: 40178},
+								pos:  position{line: 1711, col: 11, offset: 41568},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1661, col: 22, offset: 40189},
+							pos:   position{line: 1711, col: 22, offset: 41579},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1661, col: 27, offset: 40194},
+								pos: position{line: 1711, col: 27, offset: 41584},
 								expr: &actionExpr{
-									pos: position{line: 1661, col: 28, offset: 40195},
+									pos: position{line: 1711, col: 28, offset: 41585},
 									run: (*parser).callonIdentifiers7,
 									expr: &seqExpr{
-										pos: position{line: 1661, col: 28, offset: 40195},
+										pos: position{line: 1711, col: 28, offset: 41585},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1661, col: 28, offset: 40195},
+												pos:  position{line: 1711, col: 28, offset: 41585},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1661, col: 31, offset: 40198},
+												pos:        position{line: 1711, col: 31, offset: 41588},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1661, col: 35, offset: 40202},
+												pos:  position{line: 1711, col: 35, offset: 41592},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1661, col: 38, offset: 40205},
+												pos:   position{line: 1711, col: 38, offset: 41595},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1661, col: 43, offset: 40210},
+													pos:  position{line: 1711, col: 43, offset: 41600},
 													name: "Identifier",
 												},
 											},
@@ -10772,22 +11174,22 @@ var g = &grammar{
 		},
 		{
 			name: "SQLIdentifier",
-			pos:  position{line: 1665, col: 1, offset: 40288},
+			pos:  position{line: 1715, col: 1, offset: 41678},
 			expr: &choiceExpr{
-				pos: position{line: 1666, col: 5, offset: 40306},
+				pos: position{line: 1716, col: 5, offset: 41696},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1666, col: 5, offset: 40306},
+						pos:  position{line: 1716, col: 5, offset: 41696},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 1667, col: 5, offset: 40321},
+						pos: position{line: 1717, col: 5, offset: 41711},
 						run: (*parser).callonSQLIdentifier3,
 						expr: &labeledExpr{
-							pos:   position{line: 1667, col: 5, offset: 40321},
+							pos:   position{line: 1717, col: 5, offset: 41711},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1667, col: 7, offset: 40323},
+								pos:  position{line: 1717, col: 7, offset: 41713},
 								name: "DoubleQuotedString",
 							},
 						},
@@ -10799,29 +11201,29 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1669, col: 1, offset: 40397},
+			pos:  position{line: 1719, col: 1, offset: 41787},
 			expr: &choiceExpr{
-				pos: position{line: 1670, col: 5, offset: 40416},
+				pos: position{line: 1720, col: 5, offset: 41806},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1670, col: 5, offset: 40416},
+						pos: position{line: 1720, col: 5, offset: 41806},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1670, col: 5, offset: 40416},
+							pos: position{line: 1720, col: 5, offset: 41806},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1670, col: 5, offset: 40416},
+									pos: position{line: 1720, col: 5, offset: 41806},
 									expr: &seqExpr{
-										pos: position{line: 1670, col: 7, offset: 40418},
+										pos: position{line: 1720, col: 7, offset: 41808},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1670, col: 7, offset: 40418},
+												pos:  position{line: 1720, col: 7, offset: 41808},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1670, col: 15, offset: 40426},
+												pos: position{line: 1720, col: 15, offset: 41816},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1670, col: 16, offset: 40427},
+													pos:  position{line: 1720, col: 16, offset: 41817},
 													name: "IdentifierRest",
 												},
 											},
@@ -10829,13 +11231,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1670, col: 32, offset: 40443},
+									pos:  position{line: 1720, col: 32, offset: 41833},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1670, col: 48, offset: 40459},
+									pos: position{line: 1720, col: 48, offset: 41849},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1670, col: 48, offset: 40459},
+										pos:  position{line: 1720, col: 48, offset: 41849},
 										name: "IdentifierRest",
 									},
 								},
@@ -10843,7 +11245,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1671, col: 5, offset: 40510},
+						pos:  position{line: 1721, col: 5, offset: 41900},
 						name: "BacktickString",
 					},
 				},
@@ -10853,22 +11255,22 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1673, col: 1, offset: 40526},
+			pos:  position{line: 1723, col: 1, offset: 41916},
 			expr: &choiceExpr{
-				pos: position{line: 1674, col: 5, offset: 40546},
+				pos: position{line: 1724, col: 5, offset: 41936},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1674, col: 5, offset: 40546},
+						pos:  position{line: 1724, col: 5, offset: 41936},
 						name: "UnicodeLetter",
 					},
 					&litMatcher{
-						pos:        position{line: 1675, col: 5, offset: 40564},
+						pos:        position{line: 1725, col: 5, offset: 41954},
 						val:        "$",
 						ignoreCase: false,
 						want:       "\"$\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1676, col: 5, offset: 40572},
+						pos:        position{line: 1726, col: 5, offset: 41962},
 						val:        "_",
 						ignoreCase: false,
 						want:       "\"_\"",
@@ -10880,24 +11282,24 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1678, col: 1, offset: 40577},
+			pos:  position{line: 1728, col: 1, offset: 41967},
 			expr: &choiceExpr{
-				pos: position{line: 1679, col: 5, offset: 40596},
+				pos: position{line: 1729, col: 5, offset: 41986},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1679, col: 5, offset: 40596},
+						pos:  position{line: 1729, col: 5, offset: 41986},
 						name: "IdentifierStart",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1680, col: 5, offset: 40616},
+						pos:  position{line: 1730, col: 5, offset: 42006},
 						name: "UnicodeCombiningMark",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1681, col: 5, offset: 40641},
+						pos:  position{line: 1731, col: 5, offset: 42031},
 						name: "UnicodeDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1682, col: 5, offset: 40658},
+						pos:  position{line: 1732, col: 5, offset: 42048},
 						name: "UnicodeConnectorPunctuation",
 					},
 				},
@@ -10907,24 +11309,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1684, col: 1, offset: 40687},
+			pos:  position{line: 1734, col: 1, offset: 42077},
 			expr: &choiceExpr{
-				pos: position{line: 1685, col: 5, offset: 40699},
+				pos: position{line: 1735, col: 5, offset: 42089},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1685, col: 5, offset: 40699},
+						pos:  position{line: 1735, col: 5, offset: 42089},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1686, col: 5, offset: 40718},
+						pos:  position{line: 1736, col: 5, offset: 42108},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1687, col: 5, offset: 40734},
+						pos:  position{line: 1737, col: 5, offset: 42124},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1688, col: 5, offset: 40742},
+						pos:  position{line: 1738, col: 5, offset: 42132},
 						name: "Infinity",
 					},
 				},
@@ -10934,25 +11336,25 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1690, col: 1, offset: 40752},
+			pos:  position{line: 1740, col: 1, offset: 42142},
 			expr: &actionExpr{
-				pos: position{line: 1691, col: 5, offset: 40761},
+				pos: position{line: 1741, col: 5, offset: 42151},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1691, col: 5, offset: 40761},
+					pos: position{line: 1741, col: 5, offset: 42151},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 5, offset: 40761},
+							pos:  position{line: 1741, col: 5, offset: 42151},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1691, col: 14, offset: 40770},
+							pos:        position{line: 1741, col: 14, offset: 42160},
 							val:        "T",
 							ignoreCase: false,
 							want:       "\"T\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 18, offset: 40774},
+							pos:  position{line: 1741, col: 18, offset: 42164},
 							name: "FullTime",
 						},
 					},
@@ -10963,32 +11365,32 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1695, col: 1, offset: 40850},
+			pos:  position{line: 1745, col: 1, offset: 42240},
 			expr: &seqExpr{
-				pos: position{line: 1695, col: 12, offset: 40861},
+				pos: position{line: 1745, col: 12, offset: 42251},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1695, col: 12, offset: 40861},
+						pos:  position{line: 1745, col: 12, offset: 42251},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1695, col: 15, offset: 40864},
+						pos:        position{line: 1745, col: 15, offset: 42254},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1695, col: 19, offset: 40868},
+						pos:  position{line: 1745, col: 19, offset: 42258},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1695, col: 22, offset: 40871},
+						pos:        position{line: 1745, col: 22, offset: 42261},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1695, col: 26, offset: 40875},
+						pos:  position{line: 1745, col: 26, offset: 42265},
 						name: "D2",
 					},
 				},
@@ -10998,33 +11400,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1697, col: 1, offset: 40879},
+			pos:  position{line: 1747, col: 1, offset: 42269},
 			expr: &seqExpr{
-				pos: position{line: 1697, col: 6, offset: 40884},
+				pos: position{line: 1747, col: 6, offset: 42274},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1697, col: 6, offset: 40884},
+						pos:        position{line: 1747, col: 6, offset: 42274},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1697, col: 11, offset: 40889},
+						pos:        position{line: 1747, col: 11, offset: 42279},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1697, col: 16, offset: 40894},
+						pos:        position{line: 1747, col: 16, offset: 42284},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1697, col: 21, offset: 40899},
+						pos:        position{line: 1747, col: 21, offset: 42289},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11037,19 +11439,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1698, col: 1, offset: 40905},
+			pos:  position{line: 1748, col: 1, offset: 42295},
 			expr: &seqExpr{
-				pos: position{line: 1698, col: 6, offset: 40910},
+				pos: position{line: 1748, col: 6, offset: 42300},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1698, col: 6, offset: 40910},
+						pos:        position{line: 1748, col: 6, offset: 42300},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1698, col: 11, offset: 40915},
+						pos:        position{line: 1748, col: 11, offset: 42305},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11062,16 +11464,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1700, col: 1, offset: 40922},
+			pos:  position{line: 1750, col: 1, offset: 42312},
 			expr: &seqExpr{
-				pos: position{line: 1700, col: 12, offset: 40933},
+				pos: position{line: 1750, col: 12, offset: 42323},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1700, col: 12, offset: 40933},
+						pos:  position{line: 1750, col: 12, offset: 42323},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1700, col: 24, offset: 40945},
+						pos:  position{line: 1750, col: 24, offset: 42335},
 						name: "TimeOffset",
 					},
 				},
@@ -11081,49 +11483,49 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1702, col: 1, offset: 40957},
+			pos:  position{line: 1752, col: 1, offset: 42347},
 			expr: &seqExpr{
-				pos: position{line: 1702, col: 15, offset: 40971},
+				pos: position{line: 1752, col: 15, offset: 42361},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1702, col: 15, offset: 40971},
+						pos:  position{line: 1752, col: 15, offset: 42361},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1702, col: 18, offset: 40974},
+						pos:        position{line: 1752, col: 18, offset: 42364},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1702, col: 22, offset: 40978},
+						pos:  position{line: 1752, col: 22, offset: 42368},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1702, col: 25, offset: 40981},
+						pos:        position{line: 1752, col: 25, offset: 42371},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1702, col: 29, offset: 40985},
+						pos:  position{line: 1752, col: 29, offset: 42375},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1702, col: 32, offset: 40988},
+						pos: position{line: 1752, col: 32, offset: 42378},
 						expr: &seqExpr{
-							pos: position{line: 1702, col: 33, offset: 40989},
+							pos: position{line: 1752, col: 33, offset: 42379},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1702, col: 33, offset: 40989},
+									pos:        position{line: 1752, col: 33, offset: 42379},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1702, col: 37, offset: 40993},
+									pos: position{line: 1752, col: 37, offset: 42383},
 									expr: &charClassMatcher{
-										pos:        position{line: 1702, col: 37, offset: 40993},
+										pos:        position{line: 1752, col: 37, offset: 42383},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11140,30 +11542,30 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1704, col: 1, offset: 41003},
+			pos:  position{line: 1754, col: 1, offset: 42393},
 			expr: &choiceExpr{
-				pos: position{line: 1705, col: 5, offset: 41018},
+				pos: position{line: 1755, col: 5, offset: 42408},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1705, col: 5, offset: 41018},
+						pos:        position{line: 1755, col: 5, offset: 42408},
 						val:        "Z",
 						ignoreCase: false,
 						want:       "\"Z\"",
 					},
 					&seqExpr{
-						pos: position{line: 1706, col: 5, offset: 41026},
+						pos: position{line: 1756, col: 5, offset: 42416},
 						exprs: []any{
 							&choiceExpr{
-								pos: position{line: 1706, col: 6, offset: 41027},
+								pos: position{line: 1756, col: 6, offset: 42417},
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 1706, col: 6, offset: 41027},
+										pos:        position{line: 1756, col: 6, offset: 42417},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 1706, col: 12, offset: 41033},
+										pos:        position{line: 1756, col: 12, offset: 42423},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -11171,34 +11573,34 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1706, col: 17, offset: 41038},
+								pos:  position{line: 1756, col: 17, offset: 42428},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1706, col: 20, offset: 41041},
+								pos:        position{line: 1756, col: 20, offset: 42431},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1706, col: 24, offset: 41045},
+								pos:  position{line: 1756, col: 24, offset: 42435},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1706, col: 27, offset: 41048},
+								pos: position{line: 1756, col: 27, offset: 42438},
 								expr: &seqExpr{
-									pos: position{line: 1706, col: 28, offset: 41049},
+									pos: position{line: 1756, col: 28, offset: 42439},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 1706, col: 28, offset: 41049},
+											pos:        position{line: 1756, col: 28, offset: 42439},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1706, col: 32, offset: 41053},
+											pos: position{line: 1756, col: 32, offset: 42443},
 											expr: &charClassMatcher{
-												pos:        position{line: 1706, col: 32, offset: 41053},
+												pos:        position{line: 1756, col: 32, offset: 42443},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -11217,33 +11619,33 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1708, col: 1, offset: 41063},
+			pos:  position{line: 1758, col: 1, offset: 42453},
 			expr: &actionExpr{
-				pos: position{line: 1709, col: 5, offset: 41076},
+				pos: position{line: 1759, col: 5, offset: 42466},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1709, col: 5, offset: 41076},
+					pos: position{line: 1759, col: 5, offset: 42466},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 1709, col: 5, offset: 41076},
+							pos: position{line: 1759, col: 5, offset: 42466},
 							expr: &litMatcher{
-								pos:        position{line: 1709, col: 5, offset: 41076},
+								pos:        position{line: 1759, col: 5, offset: 42466},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1709, col: 10, offset: 41081},
+							pos: position{line: 1759, col: 10, offset: 42471},
 							expr: &seqExpr{
-								pos: position{line: 1709, col: 11, offset: 41082},
+								pos: position{line: 1759, col: 11, offset: 42472},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1709, col: 11, offset: 41082},
+										pos:  position{line: 1759, col: 11, offset: 42472},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1709, col: 19, offset: 41090},
+										pos:  position{line: 1759, col: 19, offset: 42480},
 										name: "TimeUnit",
 									},
 								},
@@ -11257,27 +11659,27 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1713, col: 1, offset: 41172},
+			pos:  position{line: 1763, col: 1, offset: 42562},
 			expr: &seqExpr{
-				pos: position{line: 1713, col: 11, offset: 41182},
+				pos: position{line: 1763, col: 11, offset: 42572},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1713, col: 11, offset: 41182},
+						pos:  position{line: 1763, col: 11, offset: 42572},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1713, col: 16, offset: 41187},
+						pos: position{line: 1763, col: 16, offset: 42577},
 						expr: &seqExpr{
-							pos: position{line: 1713, col: 17, offset: 41188},
+							pos: position{line: 1763, col: 17, offset: 42578},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1713, col: 17, offset: 41188},
+									pos:        position{line: 1763, col: 17, offset: 42578},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1713, col: 21, offset: 41192},
+									pos:  position{line: 1763, col: 21, offset: 42582},
 									name: "UInt",
 								},
 							},
@@ -11290,60 +11692,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1715, col: 1, offset: 41200},
+			pos:  position{line: 1765, col: 1, offset: 42590},
 			expr: &choiceExpr{
-				pos: position{line: 1716, col: 5, offset: 41213},
+				pos: position{line: 1766, col: 5, offset: 42603},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1716, col: 5, offset: 41213},
+						pos:        position{line: 1766, col: 5, offset: 42603},
 						val:        "ns",
 						ignoreCase: false,
 						want:       "\"ns\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1717, col: 5, offset: 41222},
+						pos:        position{line: 1767, col: 5, offset: 42612},
 						val:        "us",
 						ignoreCase: false,
 						want:       "\"us\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1718, col: 5, offset: 41231},
+						pos:        position{line: 1768, col: 5, offset: 42621},
 						val:        "ms",
 						ignoreCase: false,
 						want:       "\"ms\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1719, col: 5, offset: 41240},
+						pos:        position{line: 1769, col: 5, offset: 42630},
 						val:        "s",
 						ignoreCase: false,
 						want:       "\"s\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1720, col: 5, offset: 41248},
+						pos:        position{line: 1770, col: 5, offset: 42638},
 						val:        "m",
 						ignoreCase: false,
 						want:       "\"m\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1721, col: 5, offset: 41256},
+						pos:        position{line: 1771, col: 5, offset: 42646},
 						val:        "h",
 						ignoreCase: false,
 						want:       "\"h\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1722, col: 5, offset: 41264},
+						pos:        position{line: 1772, col: 5, offset: 42654},
 						val:        "d",
 						ignoreCase: false,
 						want:       "\"d\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1723, col: 5, offset: 41272},
+						pos:        position{line: 1773, col: 5, offset: 42662},
 						val:        "w",
 						ignoreCase: false,
 						want:       "\"w\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1724, col: 5, offset: 41280},
+						pos:        position{line: 1774, col: 5, offset: 42670},
 						val:        "y",
 						ignoreCase: false,
 						want:       "\"y\"",
@@ -11355,45 +11757,45 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1726, col: 1, offset: 41285},
+			pos:  position{line: 1776, col: 1, offset: 42675},
 			expr: &actionExpr{
-				pos: position{line: 1727, col: 5, offset: 41292},
+				pos: position{line: 1777, col: 5, offset: 42682},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1727, col: 5, offset: 41292},
+					pos: position{line: 1777, col: 5, offset: 42682},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1727, col: 5, offset: 41292},
+							pos:  position{line: 1777, col: 5, offset: 42682},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1727, col: 10, offset: 41297},
+							pos:        position{line: 1777, col: 10, offset: 42687},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1727, col: 14, offset: 41301},
+							pos:  position{line: 1777, col: 14, offset: 42691},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1727, col: 19, offset: 41306},
+							pos:        position{line: 1777, col: 19, offset: 42696},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1727, col: 23, offset: 41310},
+							pos:  position{line: 1777, col: 23, offset: 42700},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1727, col: 28, offset: 41315},
+							pos:        position{line: 1777, col: 28, offset: 42705},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1727, col: 32, offset: 41319},
+							pos:  position{line: 1777, col: 32, offset: 42709},
 							name: "UInt",
 						},
 					},
@@ -11404,43 +11806,43 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1729, col: 1, offset: 41356},
+			pos:  position{line: 1779, col: 1, offset: 42746},
 			expr: &actionExpr{
-				pos: position{line: 1730, col: 5, offset: 41364},
+				pos: position{line: 1780, col: 5, offset: 42754},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1730, col: 5, offset: 41364},
+					pos: position{line: 1780, col: 5, offset: 42754},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 1730, col: 5, offset: 41364},
+							pos: position{line: 1780, col: 5, offset: 42754},
 							expr: &seqExpr{
-								pos: position{line: 1730, col: 7, offset: 41366},
+								pos: position{line: 1780, col: 7, offset: 42756},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1730, col: 7, offset: 41366},
+										pos:  position{line: 1780, col: 7, offset: 42756},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1730, col: 11, offset: 41370},
+										pos:        position{line: 1780, col: 11, offset: 42760},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1730, col: 15, offset: 41374},
+										pos:  position{line: 1780, col: 15, offset: 42764},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1730, col: 19, offset: 41378},
+										pos: position{line: 1780, col: 19, offset: 42768},
 										expr: &choiceExpr{
-											pos: position{line: 1730, col: 21, offset: 41380},
+											pos: position{line: 1780, col: 21, offset: 42770},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1730, col: 21, offset: 41380},
+													pos:  position{line: 1780, col: 21, offset: 42770},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1730, col: 32, offset: 41391},
+													pos:        position{line: 1780, col: 32, offset: 42781},
 													val:        ":",
 													ignoreCase: false,
 													want:       "\":\"",
@@ -11452,10 +11854,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1730, col: 38, offset: 41397},
+							pos:   position{line: 1780, col: 38, offset: 42787},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1730, col: 40, offset: 41399},
+								pos:  position{line: 1780, col: 40, offset: 42789},
 								name: "IP6Variations",
 							},
 						},
@@ -11467,32 +11869,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1734, col: 1, offset: 41563},
+			pos:  position{line: 1784, col: 1, offset: 42953},
 			expr: &choiceExpr{
-				pos: position{line: 1735, col: 5, offset: 41581},
+				pos: position{line: 1785, col: 5, offset: 42971},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1735, col: 5, offset: 41581},
+						pos: position{line: 1785, col: 5, offset: 42971},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1735, col: 5, offset: 41581},
+							pos: position{line: 1785, col: 5, offset: 42971},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1735, col: 5, offset: 41581},
+									pos:   position{line: 1785, col: 5, offset: 42971},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1735, col: 7, offset: 41583},
+										pos: position{line: 1785, col: 7, offset: 42973},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1735, col: 7, offset: 41583},
+											pos:  position{line: 1785, col: 7, offset: 42973},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1735, col: 17, offset: 41593},
+									pos:   position{line: 1785, col: 17, offset: 42983},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1735, col: 19, offset: 41595},
+										pos:  position{line: 1785, col: 19, offset: 42985},
 										name: "IP6Tail",
 									},
 								},
@@ -11500,52 +11902,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1738, col: 5, offset: 41659},
+						pos: position{line: 1788, col: 5, offset: 43049},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1738, col: 5, offset: 41659},
+							pos: position{line: 1788, col: 5, offset: 43049},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1738, col: 5, offset: 41659},
+									pos:   position{line: 1788, col: 5, offset: 43049},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1738, col: 7, offset: 41661},
+										pos:  position{line: 1788, col: 7, offset: 43051},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1738, col: 11, offset: 41665},
+									pos:   position{line: 1788, col: 11, offset: 43055},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1738, col: 13, offset: 41667},
+										pos: position{line: 1788, col: 13, offset: 43057},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1738, col: 13, offset: 41667},
+											pos:  position{line: 1788, col: 13, offset: 43057},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1738, col: 23, offset: 41677},
+									pos:        position{line: 1788, col: 23, offset: 43067},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1738, col: 28, offset: 41682},
+									pos:   position{line: 1788, col: 28, offset: 43072},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1738, col: 30, offset: 41684},
+										pos: position{line: 1788, col: 30, offset: 43074},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1738, col: 30, offset: 41684},
+											pos:  position{line: 1788, col: 30, offset: 43074},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1738, col: 40, offset: 41694},
+									pos:   position{line: 1788, col: 40, offset: 43084},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1738, col: 42, offset: 41696},
+										pos:  position{line: 1788, col: 42, offset: 43086},
 										name: "IP6Tail",
 									},
 								},
@@ -11553,33 +11955,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1741, col: 5, offset: 41795},
+						pos: position{line: 1791, col: 5, offset: 43185},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1741, col: 5, offset: 41795},
+							pos: position{line: 1791, col: 5, offset: 43185},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1741, col: 5, offset: 41795},
+									pos:        position{line: 1791, col: 5, offset: 43185},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1741, col: 10, offset: 41800},
+									pos:   position{line: 1791, col: 10, offset: 43190},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1741, col: 12, offset: 41802},
+										pos: position{line: 1791, col: 12, offset: 43192},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1741, col: 12, offset: 41802},
+											pos:  position{line: 1791, col: 12, offset: 43192},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1741, col: 22, offset: 41812},
+									pos:   position{line: 1791, col: 22, offset: 43202},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1741, col: 24, offset: 41814},
+										pos:  position{line: 1791, col: 24, offset: 43204},
 										name: "IP6Tail",
 									},
 								},
@@ -11587,40 +11989,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1744, col: 5, offset: 41885},
+						pos: position{line: 1794, col: 5, offset: 43275},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1744, col: 5, offset: 41885},
+							pos: position{line: 1794, col: 5, offset: 43275},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1744, col: 5, offset: 41885},
+									pos:   position{line: 1794, col: 5, offset: 43275},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1744, col: 7, offset: 41887},
+										pos:  position{line: 1794, col: 7, offset: 43277},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1744, col: 11, offset: 41891},
+									pos:   position{line: 1794, col: 11, offset: 43281},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1744, col: 13, offset: 41893},
+										pos: position{line: 1794, col: 13, offset: 43283},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1744, col: 13, offset: 41893},
+											pos:  position{line: 1794, col: 13, offset: 43283},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1744, col: 23, offset: 41903},
+									pos:        position{line: 1794, col: 23, offset: 43293},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&notExpr{
-									pos: position{line: 1744, col: 28, offset: 41908},
+									pos: position{line: 1794, col: 28, offset: 43298},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1744, col: 29, offset: 41909},
+										pos:  position{line: 1794, col: 29, offset: 43299},
 										name: "TypeAsValue",
 									},
 								},
@@ -11628,10 +12030,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1747, col: 5, offset: 41984},
+						pos: position{line: 1797, col: 5, offset: 43374},
 						run: (*parser).callonIP6Variations40,
 						expr: &litMatcher{
-							pos:        position{line: 1747, col: 5, offset: 41984},
+							pos:        position{line: 1797, col: 5, offset: 43374},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
@@ -11644,16 +12046,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1751, col: 1, offset: 42021},
+			pos:  position{line: 1801, col: 1, offset: 43411},
 			expr: &choiceExpr{
-				pos: position{line: 1752, col: 5, offset: 42033},
+				pos: position{line: 1802, col: 5, offset: 43423},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1752, col: 5, offset: 42033},
+						pos:  position{line: 1802, col: 5, offset: 43423},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1753, col: 5, offset: 42040},
+						pos:  position{line: 1803, col: 5, offset: 43430},
 						name: "Hex",
 					},
 				},
@@ -11663,24 +12065,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1755, col: 1, offset: 42045},
+			pos:  position{line: 1805, col: 1, offset: 43435},
 			expr: &actionExpr{
-				pos: position{line: 1755, col: 12, offset: 42056},
+				pos: position{line: 1805, col: 12, offset: 43446},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1755, col: 12, offset: 42056},
+					pos: position{line: 1805, col: 12, offset: 43446},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1755, col: 12, offset: 42056},
+							pos:        position{line: 1805, col: 12, offset: 43446},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1755, col: 16, offset: 42060},
+							pos:   position{line: 1805, col: 16, offset: 43450},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1755, col: 18, offset: 42062},
+								pos:  position{line: 1805, col: 18, offset: 43452},
 								name: "Hex",
 							},
 						},
@@ -11692,23 +12094,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1757, col: 1, offset: 42100},
+			pos:  position{line: 1807, col: 1, offset: 43490},
 			expr: &actionExpr{
-				pos: position{line: 1757, col: 12, offset: 42111},
+				pos: position{line: 1807, col: 12, offset: 43501},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1757, col: 12, offset: 42111},
+					pos: position{line: 1807, col: 12, offset: 43501},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1757, col: 12, offset: 42111},
+							pos:   position{line: 1807, col: 12, offset: 43501},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1757, col: 14, offset: 42113},
+								pos:  position{line: 1807, col: 14, offset: 43503},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1757, col: 18, offset: 42117},
+							pos:        position{line: 1807, col: 18, offset: 43507},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
@@ -11721,32 +12123,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1759, col: 1, offset: 42155},
+			pos:  position{line: 1809, col: 1, offset: 43545},
 			expr: &actionExpr{
-				pos: position{line: 1760, col: 5, offset: 42166},
+				pos: position{line: 1810, col: 5, offset: 43556},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1760, col: 5, offset: 42166},
+					pos: position{line: 1810, col: 5, offset: 43556},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1760, col: 5, offset: 42166},
+							pos:   position{line: 1810, col: 5, offset: 43556},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1760, col: 7, offset: 42168},
+								pos:  position{line: 1810, col: 7, offset: 43558},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1760, col: 10, offset: 42171},
+							pos:        position{line: 1810, col: 10, offset: 43561},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1760, col: 14, offset: 42175},
+							pos:   position{line: 1810, col: 14, offset: 43565},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1760, col: 16, offset: 42177},
+								pos:  position{line: 1810, col: 16, offset: 43567},
 								name: "UIntString",
 							},
 						},
@@ -11758,32 +12160,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1764, col: 1, offset: 42245},
+			pos:  position{line: 1814, col: 1, offset: 43635},
 			expr: &actionExpr{
-				pos: position{line: 1765, col: 5, offset: 42256},
+				pos: position{line: 1815, col: 5, offset: 43646},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1765, col: 5, offset: 42256},
+					pos: position{line: 1815, col: 5, offset: 43646},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1765, col: 5, offset: 42256},
+							pos:   position{line: 1815, col: 5, offset: 43646},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1765, col: 7, offset: 42258},
+								pos:  position{line: 1815, col: 7, offset: 43648},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1765, col: 11, offset: 42262},
+							pos:        position{line: 1815, col: 11, offset: 43652},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1765, col: 15, offset: 42266},
+							pos:   position{line: 1815, col: 15, offset: 43656},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1765, col: 17, offset: 42268},
+								pos:  position{line: 1815, col: 17, offset: 43658},
 								name: "UIntString",
 							},
 						},
@@ -11795,15 +12197,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1769, col: 1, offset: 42336},
+			pos:  position{line: 1819, col: 1, offset: 43726},
 			expr: &actionExpr{
-				pos: position{line: 1770, col: 4, offset: 42344},
+				pos: position{line: 1820, col: 4, offset: 43734},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1770, col: 4, offset: 42344},
+					pos:   position{line: 1820, col: 4, offset: 43734},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1770, col: 6, offset: 42346},
+						pos:  position{line: 1820, col: 6, offset: 43736},
 						name: "UIntString",
 					},
 				},
@@ -11813,16 +12215,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1772, col: 1, offset: 42386},
+			pos:  position{line: 1822, col: 1, offset: 43776},
 			expr: &choiceExpr{
-				pos: position{line: 1773, col: 5, offset: 42400},
+				pos: position{line: 1823, col: 5, offset: 43790},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1773, col: 5, offset: 42400},
+						pos:  position{line: 1823, col: 5, offset: 43790},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1774, col: 5, offset: 42415},
+						pos:  position{line: 1824, col: 5, offset: 43805},
 						name: "MinusIntString",
 					},
 				},
@@ -11832,14 +12234,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1776, col: 1, offset: 42431},
+			pos:  position{line: 1826, col: 1, offset: 43821},
 			expr: &actionExpr{
-				pos: position{line: 1776, col: 14, offset: 42444},
+				pos: position{line: 1826, col: 14, offset: 43834},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1776, col: 14, offset: 42444},
+					pos: position{line: 1826, col: 14, offset: 43834},
 					expr: &charClassMatcher{
-						pos:        position{line: 1776, col: 14, offset: 42444},
+						pos:        position{line: 1826, col: 14, offset: 43834},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11852,21 +12254,21 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1778, col: 1, offset: 42483},
+			pos:  position{line: 1828, col: 1, offset: 43873},
 			expr: &actionExpr{
-				pos: position{line: 1779, col: 5, offset: 42502},
+				pos: position{line: 1829, col: 5, offset: 43892},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1779, col: 5, offset: 42502},
+					pos: position{line: 1829, col: 5, offset: 43892},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1779, col: 5, offset: 42502},
+							pos:        position{line: 1829, col: 5, offset: 43892},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1779, col: 9, offset: 42506},
+							pos:  position{line: 1829, col: 9, offset: 43896},
 							name: "UIntString",
 						},
 					},
@@ -11877,29 +12279,29 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1781, col: 1, offset: 42549},
+			pos:  position{line: 1831, col: 1, offset: 43939},
 			expr: &choiceExpr{
-				pos: position{line: 1782, col: 5, offset: 42565},
+				pos: position{line: 1832, col: 5, offset: 43955},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1782, col: 5, offset: 42565},
+						pos: position{line: 1832, col: 5, offset: 43955},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1782, col: 5, offset: 42565},
+							pos: position{line: 1832, col: 5, offset: 43955},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1782, col: 5, offset: 42565},
+									pos: position{line: 1832, col: 5, offset: 43955},
 									expr: &litMatcher{
-										pos:        position{line: 1782, col: 5, offset: 42565},
+										pos:        position{line: 1832, col: 5, offset: 43955},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1782, col: 10, offset: 42570},
+									pos: position{line: 1832, col: 10, offset: 43960},
 									expr: &charClassMatcher{
-										pos:        position{line: 1782, col: 10, offset: 42570},
+										pos:        position{line: 1832, col: 10, offset: 43960},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11907,15 +12309,15 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1782, col: 17, offset: 42577},
+									pos:        position{line: 1832, col: 17, offset: 43967},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1782, col: 21, offset: 42581},
+									pos: position{line: 1832, col: 21, offset: 43971},
 									expr: &charClassMatcher{
-										pos:        position{line: 1782, col: 21, offset: 42581},
+										pos:        position{line: 1832, col: 21, offset: 43971},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11923,9 +12325,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1782, col: 28, offset: 42588},
+									pos: position{line: 1832, col: 28, offset: 43978},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1782, col: 28, offset: 42588},
+										pos:  position{line: 1832, col: 28, offset: 43978},
 										name: "ExponentPart",
 									},
 								},
@@ -11933,30 +12335,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1783, col: 5, offset: 42637},
+						pos: position{line: 1833, col: 5, offset: 44027},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1783, col: 5, offset: 42637},
+							pos: position{line: 1833, col: 5, offset: 44027},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1783, col: 5, offset: 42637},
+									pos: position{line: 1833, col: 5, offset: 44027},
 									expr: &litMatcher{
-										pos:        position{line: 1783, col: 5, offset: 42637},
+										pos:        position{line: 1833, col: 5, offset: 44027},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1783, col: 10, offset: 42642},
+									pos:        position{line: 1833, col: 10, offset: 44032},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1783, col: 14, offset: 42646},
+									pos: position{line: 1833, col: 14, offset: 44036},
 									expr: &charClassMatcher{
-										pos:        position{line: 1783, col: 14, offset: 42646},
+										pos:        position{line: 1833, col: 14, offset: 44036},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11964,9 +12366,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1783, col: 21, offset: 42653},
+									pos: position{line: 1833, col: 21, offset: 44043},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1783, col: 21, offset: 42653},
+										pos:  position{line: 1833, col: 21, offset: 44043},
 										name: "ExponentPart",
 									},
 								},
@@ -11974,17 +12376,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1784, col: 5, offset: 42702},
+						pos: position{line: 1834, col: 5, offset: 44092},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1784, col: 6, offset: 42703},
+							pos: position{line: 1834, col: 6, offset: 44093},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1784, col: 6, offset: 42703},
+									pos:  position{line: 1834, col: 6, offset: 44093},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1784, col: 12, offset: 42709},
+									pos:  position{line: 1834, col: 12, offset: 44099},
 									name: "Infinity",
 								},
 							},
@@ -11997,20 +12399,20 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1787, col: 1, offset: 42752},
+			pos:  position{line: 1837, col: 1, offset: 44142},
 			expr: &seqExpr{
-				pos: position{line: 1787, col: 16, offset: 42767},
+				pos: position{line: 1837, col: 16, offset: 44157},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1787, col: 16, offset: 42767},
+						pos:        position{line: 1837, col: 16, offset: 44157},
 						val:        "e",
 						ignoreCase: true,
 						want:       "\"e\"i",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1787, col: 21, offset: 42772},
+						pos: position{line: 1837, col: 21, offset: 44162},
 						expr: &charClassMatcher{
-							pos:        position{line: 1787, col: 21, offset: 42772},
+							pos:        position{line: 1837, col: 21, offset: 44162},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -12018,7 +12420,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1787, col: 27, offset: 42778},
+						pos:  position{line: 1837, col: 27, offset: 44168},
 						name: "UIntString",
 					},
 				},
@@ -12028,9 +12430,9 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1789, col: 1, offset: 42790},
+			pos:  position{line: 1839, col: 1, offset: 44180},
 			expr: &litMatcher{
-				pos:        position{line: 1789, col: 7, offset: 42796},
+				pos:        position{line: 1839, col: 7, offset: 44186},
 				val:        "NaN",
 				ignoreCase: false,
 				want:       "\"NaN\"",
@@ -12040,23 +12442,23 @@ var g = &grammar{
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1791, col: 1, offset: 42803},
+			pos:  position{line: 1841, col: 1, offset: 44193},
 			expr: &seqExpr{
-				pos: position{line: 1791, col: 12, offset: 42814},
+				pos: position{line: 1841, col: 12, offset: 44204},
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 1791, col: 12, offset: 42814},
+						pos: position{line: 1841, col: 12, offset: 44204},
 						expr: &choiceExpr{
-							pos: position{line: 1791, col: 13, offset: 42815},
+							pos: position{line: 1841, col: 13, offset: 44205},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1791, col: 13, offset: 42815},
+									pos:        position{line: 1841, col: 13, offset: 44205},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1791, col: 19, offset: 42821},
+									pos:        position{line: 1841, col: 19, offset: 44211},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
@@ -12065,7 +12467,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1791, col: 25, offset: 42827},
+						pos:        position{line: 1841, col: 25, offset: 44217},
 						val:        "Inf",
 						ignoreCase: false,
 						want:       "\"Inf\"",
@@ -12077,14 +12479,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1793, col: 1, offset: 42834},
+			pos:  position{line: 1843, col: 1, offset: 44224},
 			expr: &actionExpr{
-				pos: position{line: 1793, col: 7, offset: 42840},
+				pos: position{line: 1843, col: 7, offset: 44230},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1793, col: 7, offset: 42840},
+					pos: position{line: 1843, col: 7, offset: 44230},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1793, col: 7, offset: 42840},
+						pos:  position{line: 1843, col: 7, offset: 44230},
 						name: "HexDigit",
 					},
 				},
@@ -12094,9 +12496,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1795, col: 1, offset: 42882},
+			pos:  position{line: 1845, col: 1, offset: 44272},
 			expr: &charClassMatcher{
-				pos:        position{line: 1795, col: 12, offset: 42893},
+				pos:        position{line: 1845, col: 12, offset: 44283},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -12107,32 +12509,32 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedString",
-			pos:  position{line: 1797, col: 1, offset: 42906},
+			pos:  position{line: 1847, col: 1, offset: 44296},
 			expr: &actionExpr{
-				pos: position{line: 1798, col: 5, offset: 42929},
+				pos: position{line: 1848, col: 5, offset: 44319},
 				run: (*parser).callonSingleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1798, col: 5, offset: 42929},
+					pos: position{line: 1848, col: 5, offset: 44319},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1798, col: 5, offset: 42929},
+							pos:        position{line: 1848, col: 5, offset: 44319},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1798, col: 9, offset: 42933},
+							pos:   position{line: 1848, col: 9, offset: 44323},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1798, col: 11, offset: 42935},
+								pos: position{line: 1848, col: 11, offset: 44325},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1798, col: 11, offset: 42935},
+									pos:  position{line: 1848, col: 11, offset: 44325},
 									name: "SingleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1798, col: 29, offset: 42953},
+							pos:        position{line: 1848, col: 29, offset: 44343},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
@@ -12145,32 +12547,32 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedString",
-			pos:  position{line: 1800, col: 1, offset: 42987},
+			pos:  position{line: 1850, col: 1, offset: 44377},
 			expr: &actionExpr{
-				pos: position{line: 1801, col: 5, offset: 43010},
+				pos: position{line: 1851, col: 5, offset: 44400},
 				run: (*parser).callonDoubleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1801, col: 5, offset: 43010},
+					pos: position{line: 1851, col: 5, offset: 44400},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1801, col: 5, offset: 43010},
+							pos:        position{line: 1851, col: 5, offset: 44400},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1801, col: 9, offset: 43014},
+							pos:   position{line: 1851, col: 9, offset: 44404},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1801, col: 11, offset: 43016},
+								pos: position{line: 1851, col: 11, offset: 44406},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1801, col: 11, offset: 43016},
+									pos:  position{line: 1851, col: 11, offset: 44406},
 									name: "DoubleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1801, col: 29, offset: 43034},
+							pos:        position{line: 1851, col: 29, offset: 44424},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -12183,57 +12585,57 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1803, col: 1, offset: 43068},
+			pos:  position{line: 1853, col: 1, offset: 44458},
 			expr: &choiceExpr{
-				pos: position{line: 1804, col: 5, offset: 43089},
+				pos: position{line: 1854, col: 5, offset: 44479},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1804, col: 5, offset: 43089},
+						pos: position{line: 1854, col: 5, offset: 44479},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1804, col: 5, offset: 43089},
+							pos: position{line: 1854, col: 5, offset: 44479},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1804, col: 5, offset: 43089},
+									pos: position{line: 1854, col: 5, offset: 44479},
 									expr: &choiceExpr{
-										pos: position{line: 1804, col: 7, offset: 43091},
+										pos: position{line: 1854, col: 7, offset: 44481},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1804, col: 7, offset: 43091},
+												pos:        position{line: 1854, col: 7, offset: 44481},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1804, col: 13, offset: 43097},
+												pos:  position{line: 1854, col: 13, offset: 44487},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1804, col: 26, offset: 43110,
+									line: 1854, col: 26, offset: 44500,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1805, col: 5, offset: 43147},
+						pos: position{line: 1855, col: 5, offset: 44537},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1805, col: 5, offset: 43147},
+							pos: position{line: 1855, col: 5, offset: 44537},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1805, col: 5, offset: 43147},
+									pos:        position{line: 1855, col: 5, offset: 44537},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1805, col: 10, offset: 43152},
+									pos:   position{line: 1855, col: 10, offset: 44542},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1805, col: 12, offset: 43154},
+										pos:  position{line: 1855, col: 12, offset: 44544},
 										name: "EscapeSequence",
 									},
 								},
@@ -12247,32 +12649,32 @@ var g = &grammar{
 		},
 		{
 			name: "RString",
-			pos:  position{line: 1807, col: 1, offset: 43188},
+			pos:  position{line: 1857, col: 1, offset: 44578},
 			expr: &choiceExpr{
-				pos: position{line: 1808, col: 5, offset: 43200},
+				pos: position{line: 1858, col: 5, offset: 44590},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1808, col: 5, offset: 43200},
+						pos: position{line: 1858, col: 5, offset: 44590},
 						run: (*parser).callonRString2,
 						expr: &seqExpr{
-							pos: position{line: 1808, col: 5, offset: 43200},
+							pos: position{line: 1858, col: 5, offset: 44590},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1808, col: 5, offset: 43200},
+									pos:        position{line: 1858, col: 5, offset: 44590},
 									val:        "r'",
 									ignoreCase: false,
 									want:       "\"r'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1808, col: 10, offset: 43205},
+									pos:   position{line: 1858, col: 10, offset: 44595},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1808, col: 12, offset: 43207},
+										pos:  position{line: 1858, col: 12, offset: 44597},
 										name: "NoSingleQuotes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1808, col: 27, offset: 43222},
+									pos:        position{line: 1858, col: 27, offset: 44612},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -12281,33 +12683,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1809, col: 5, offset: 43257},
+						pos: position{line: 1859, col: 5, offset: 44647},
 						run: (*parser).callonRString8,
 						expr: &seqExpr{
-							pos: position{line: 1809, col: 5, offset: 43257},
+							pos: position{line: 1859, col: 5, offset: 44647},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1809, col: 5, offset: 43257},
+									pos:        position{line: 1859, col: 5, offset: 44647},
 									val:        "r",
 									ignoreCase: false,
 									want:       "\"r\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1809, col: 9, offset: 43261},
+									pos:        position{line: 1859, col: 9, offset: 44651},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1809, col: 13, offset: 43265},
+									pos:   position{line: 1859, col: 13, offset: 44655},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1809, col: 15, offset: 43267},
+										pos:  position{line: 1859, col: 15, offset: 44657},
 										name: "NoDoubleQuotes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1809, col: 30, offset: 43282},
+									pos:        position{line: 1859, col: 30, offset: 44672},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -12322,26 +12724,26 @@ var g = &grammar{
 		},
 		{
 			name: "NoSingleQuotes",
-			pos:  position{line: 1811, col: 1, offset: 43314},
+			pos:  position{line: 1861, col: 1, offset: 44704},
 			expr: &actionExpr{
-				pos: position{line: 1812, col: 5, offset: 43333},
+				pos: position{line: 1862, col: 5, offset: 44723},
 				run: (*parser).callonNoSingleQuotes1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1812, col: 5, offset: 43333},
+					pos: position{line: 1862, col: 5, offset: 44723},
 					expr: &seqExpr{
-						pos: position{line: 1812, col: 6, offset: 43334},
+						pos: position{line: 1862, col: 6, offset: 44724},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 1812, col: 6, offset: 43334},
+								pos: position{line: 1862, col: 6, offset: 44724},
 								expr: &litMatcher{
-									pos:        position{line: 1812, col: 7, offset: 43335},
+									pos:        position{line: 1862, col: 7, offset: 44725},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
 								},
 							},
 							&anyMatcher{
-								line: 1812, col: 11, offset: 43339,
+								line: 1862, col: 11, offset: 44729,
 							},
 						},
 					},
@@ -12352,26 +12754,26 @@ var g = &grammar{
 		},
 		{
 			name: "NoDoubleQuotes",
-			pos:  position{line: 1814, col: 1, offset: 43375},
+			pos:  position{line: 1864, col: 1, offset: 44765},
 			expr: &actionExpr{
-				pos: position{line: 1815, col: 5, offset: 43394},
+				pos: position{line: 1865, col: 5, offset: 44784},
 				run: (*parser).callonNoDoubleQuotes1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1815, col: 5, offset: 43394},
+					pos: position{line: 1865, col: 5, offset: 44784},
 					expr: &seqExpr{
-						pos: position{line: 1815, col: 6, offset: 43395},
+						pos: position{line: 1865, col: 6, offset: 44785},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 1815, col: 6, offset: 43395},
+								pos: position{line: 1865, col: 6, offset: 44785},
 								expr: &litMatcher{
-									pos:        position{line: 1815, col: 7, offset: 43396},
+									pos:        position{line: 1865, col: 7, offset: 44786},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 							},
 							&anyMatcher{
-								line: 1815, col: 11, offset: 43400,
+								line: 1865, col: 11, offset: 44790,
 							},
 						},
 					},
@@ -12382,32 +12784,32 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickString",
-			pos:  position{line: 1817, col: 1, offset: 43436},
+			pos:  position{line: 1867, col: 1, offset: 44826},
 			expr: &actionExpr{
-				pos: position{line: 1818, col: 5, offset: 43455},
+				pos: position{line: 1868, col: 5, offset: 44845},
 				run: (*parser).callonBacktickString1,
 				expr: &seqExpr{
-					pos: position{line: 1818, col: 5, offset: 43455},
+					pos: position{line: 1868, col: 5, offset: 44845},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1818, col: 5, offset: 43455},
+							pos:        position{line: 1868, col: 5, offset: 44845},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1818, col: 9, offset: 43459},
+							pos:   position{line: 1868, col: 9, offset: 44849},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1818, col: 11, offset: 43461},
+								pos: position{line: 1868, col: 11, offset: 44851},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1818, col: 11, offset: 43461},
+									pos:  position{line: 1868, col: 11, offset: 44851},
 									name: "BacktickChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1818, col: 25, offset: 43475},
+							pos:        position{line: 1868, col: 25, offset: 44865},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
@@ -12420,57 +12822,57 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickChar",
-			pos:  position{line: 1820, col: 1, offset: 43509},
+			pos:  position{line: 1870, col: 1, offset: 44899},
 			expr: &choiceExpr{
-				pos: position{line: 1821, col: 5, offset: 43526},
+				pos: position{line: 1871, col: 5, offset: 44916},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1821, col: 5, offset: 43526},
+						pos: position{line: 1871, col: 5, offset: 44916},
 						run: (*parser).callonBacktickChar2,
 						expr: &seqExpr{
-							pos: position{line: 1821, col: 5, offset: 43526},
+							pos: position{line: 1871, col: 5, offset: 44916},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1821, col: 5, offset: 43526},
+									pos: position{line: 1871, col: 5, offset: 44916},
 									expr: &choiceExpr{
-										pos: position{line: 1821, col: 7, offset: 43528},
+										pos: position{line: 1871, col: 7, offset: 44918},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1821, col: 7, offset: 43528},
+												pos:        position{line: 1871, col: 7, offset: 44918},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1821, col: 13, offset: 43534},
+												pos:  position{line: 1871, col: 13, offset: 44924},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1821, col: 26, offset: 43547,
+									line: 1871, col: 26, offset: 44937,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1822, col: 5, offset: 43584},
+						pos: position{line: 1872, col: 5, offset: 44974},
 						run: (*parser).callonBacktickChar9,
 						expr: &seqExpr{
-							pos: position{line: 1822, col: 5, offset: 43584},
+							pos: position{line: 1872, col: 5, offset: 44974},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1822, col: 5, offset: 43584},
+									pos:        position{line: 1872, col: 5, offset: 44974},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1822, col: 10, offset: 43589},
+									pos:   position{line: 1872, col: 10, offset: 44979},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1822, col: 12, offset: 43591},
+										pos:  position{line: 1872, col: 12, offset: 44981},
 										name: "EscapeSequence",
 									},
 								},
@@ -12484,28 +12886,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1824, col: 1, offset: 43625},
+			pos:  position{line: 1874, col: 1, offset: 45015},
 			expr: &actionExpr{
-				pos: position{line: 1825, col: 5, offset: 43637},
+				pos: position{line: 1875, col: 5, offset: 45027},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1825, col: 5, offset: 43637},
+					pos: position{line: 1875, col: 5, offset: 45027},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1825, col: 5, offset: 43637},
+							pos:   position{line: 1875, col: 5, offset: 45027},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1825, col: 10, offset: 43642},
+								pos:  position{line: 1875, col: 10, offset: 45032},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1825, col: 23, offset: 43655},
+							pos:   position{line: 1875, col: 23, offset: 45045},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1825, col: 28, offset: 43660},
+								pos: position{line: 1875, col: 28, offset: 45050},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1825, col: 28, offset: 43660},
+									pos:  position{line: 1875, col: 28, offset: 45050},
 									name: "KeyWordRest",
 								},
 							},
@@ -12518,16 +12920,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1827, col: 1, offset: 43722},
+			pos:  position{line: 1877, col: 1, offset: 45112},
 			expr: &choiceExpr{
-				pos: position{line: 1828, col: 5, offset: 43739},
+				pos: position{line: 1878, col: 5, offset: 45129},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1828, col: 5, offset: 43739},
+						pos:  position{line: 1878, col: 5, offset: 45129},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1829, col: 5, offset: 43756},
+						pos:  position{line: 1879, col: 5, offset: 45146},
 						name: "KeyWordEsc",
 					},
 				},
@@ -12537,16 +12939,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1831, col: 1, offset: 43768},
+			pos:  position{line: 1881, col: 1, offset: 45158},
 			expr: &choiceExpr{
-				pos: position{line: 1832, col: 5, offset: 43784},
+				pos: position{line: 1882, col: 5, offset: 45174},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1832, col: 5, offset: 43784},
+						pos:  position{line: 1882, col: 5, offset: 45174},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1833, col: 5, offset: 43801},
+						pos:        position{line: 1883, col: 5, offset: 45191},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12559,19 +12961,19 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1835, col: 1, offset: 43808},
+			pos:  position{line: 1885, col: 1, offset: 45198},
 			expr: &actionExpr{
-				pos: position{line: 1835, col: 16, offset: 43823},
+				pos: position{line: 1885, col: 16, offset: 45213},
 				run: (*parser).callonKeyWordChars1,
 				expr: &choiceExpr{
-					pos: position{line: 1835, col: 17, offset: 43824},
+					pos: position{line: 1885, col: 17, offset: 45214},
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1835, col: 17, offset: 43824},
+							pos:  position{line: 1885, col: 17, offset: 45214},
 							name: "UnicodeLetter",
 						},
 						&charClassMatcher{
-							pos:        position{line: 1835, col: 33, offset: 43840},
+							pos:        position{line: 1885, col: 33, offset: 45230},
 							val:        "[_.:/%#@~]",
 							chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 							ignoreCase: false,
@@ -12585,31 +12987,31 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1837, col: 1, offset: 43884},
+			pos:  position{line: 1887, col: 1, offset: 45274},
 			expr: &actionExpr{
-				pos: position{line: 1837, col: 14, offset: 43897},
+				pos: position{line: 1887, col: 14, offset: 45287},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1837, col: 14, offset: 43897},
+					pos: position{line: 1887, col: 14, offset: 45287},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1837, col: 14, offset: 43897},
+							pos:        position{line: 1887, col: 14, offset: 45287},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1837, col: 19, offset: 43902},
+							pos:   position{line: 1887, col: 19, offset: 45292},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1837, col: 22, offset: 43905},
+								pos: position{line: 1887, col: 22, offset: 45295},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1837, col: 22, offset: 43905},
+										pos:  position{line: 1887, col: 22, offset: 45295},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1837, col: 38, offset: 43921},
+										pos:  position{line: 1887, col: 38, offset: 45311},
 										name: "EscapeSequence",
 									},
 								},
@@ -12623,42 +13025,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1839, col: 1, offset: 43956},
+			pos:  position{line: 1889, col: 1, offset: 45346},
 			expr: &actionExpr{
-				pos: position{line: 1840, col: 5, offset: 43972},
+				pos: position{line: 1890, col: 5, offset: 45362},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1840, col: 5, offset: 43972},
+					pos: position{line: 1890, col: 5, offset: 45362},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 1840, col: 5, offset: 43972},
+							pos: position{line: 1890, col: 5, offset: 45362},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1840, col: 6, offset: 43973},
+								pos:  position{line: 1890, col: 6, offset: 45363},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1840, col: 22, offset: 43989},
+							pos: position{line: 1890, col: 22, offset: 45379},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1840, col: 23, offset: 43990},
+								pos:  position{line: 1890, col: 23, offset: 45380},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1840, col: 35, offset: 44002},
+							pos:   position{line: 1890, col: 35, offset: 45392},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1840, col: 40, offset: 44007},
+								pos:  position{line: 1890, col: 40, offset: 45397},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1840, col: 50, offset: 44017},
+							pos:   position{line: 1890, col: 50, offset: 45407},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1840, col: 55, offset: 44022},
+								pos: position{line: 1890, col: 55, offset: 45412},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1840, col: 55, offset: 44022},
+									pos:  position{line: 1890, col: 55, offset: 45412},
 									name: "GlobRest",
 								},
 							},
@@ -12671,28 +13073,28 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1844, col: 1, offset: 44091},
+			pos:  position{line: 1894, col: 1, offset: 45481},
 			expr: &choiceExpr{
-				pos: position{line: 1844, col: 19, offset: 44109},
+				pos: position{line: 1894, col: 19, offset: 45499},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1844, col: 19, offset: 44109},
+						pos:  position{line: 1894, col: 19, offset: 45499},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1844, col: 34, offset: 44124},
+						pos: position{line: 1894, col: 34, offset: 45514},
 						exprs: []any{
 							&oneOrMoreExpr{
-								pos: position{line: 1844, col: 34, offset: 44124},
+								pos: position{line: 1894, col: 34, offset: 45514},
 								expr: &litMatcher{
-									pos:        position{line: 1844, col: 34, offset: 44124},
+									pos:        position{line: 1894, col: 34, offset: 45514},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1844, col: 39, offset: 44129},
+								pos:  position{line: 1894, col: 39, offset: 45519},
 								name: "KeyWordRest",
 							},
 						},
@@ -12704,19 +13106,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1845, col: 1, offset: 44141},
+			pos:  position{line: 1895, col: 1, offset: 45531},
 			expr: &seqExpr{
-				pos: position{line: 1845, col: 15, offset: 44155},
+				pos: position{line: 1895, col: 15, offset: 45545},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1845, col: 15, offset: 44155},
+						pos: position{line: 1895, col: 15, offset: 45545},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1845, col: 15, offset: 44155},
+							pos:  position{line: 1895, col: 15, offset: 45545},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1845, col: 28, offset: 44168},
+						pos:        position{line: 1895, col: 28, offset: 45558},
 						val:        "*",
 						ignoreCase: false,
 						want:       "\"*\"",
@@ -12728,23 +13130,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1847, col: 1, offset: 44173},
+			pos:  position{line: 1897, col: 1, offset: 45563},
 			expr: &choiceExpr{
-				pos: position{line: 1848, col: 5, offset: 44187},
+				pos: position{line: 1898, col: 5, offset: 45577},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1848, col: 5, offset: 44187},
+						pos:  position{line: 1898, col: 5, offset: 45577},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1849, col: 5, offset: 44204},
+						pos:  position{line: 1899, col: 5, offset: 45594},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1850, col: 5, offset: 44216},
+						pos: position{line: 1900, col: 5, offset: 45606},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1850, col: 5, offset: 44216},
+							pos:        position{line: 1900, col: 5, offset: 45606},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
@@ -12757,16 +13159,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1852, col: 1, offset: 44241},
+			pos:  position{line: 1902, col: 1, offset: 45631},
 			expr: &choiceExpr{
-				pos: position{line: 1853, col: 5, offset: 44254},
+				pos: position{line: 1903, col: 5, offset: 45644},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1853, col: 5, offset: 44254},
+						pos:  position{line: 1903, col: 5, offset: 45644},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1854, col: 5, offset: 44268},
+						pos:        position{line: 1904, col: 5, offset: 45658},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12779,31 +13181,31 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1856, col: 1, offset: 44275},
+			pos:  position{line: 1906, col: 1, offset: 45665},
 			expr: &actionExpr{
-				pos: position{line: 1856, col: 11, offset: 44285},
+				pos: position{line: 1906, col: 11, offset: 45675},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1856, col: 11, offset: 44285},
+					pos: position{line: 1906, col: 11, offset: 45675},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1856, col: 11, offset: 44285},
+							pos:        position{line: 1906, col: 11, offset: 45675},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1856, col: 16, offset: 44290},
+							pos:   position{line: 1906, col: 16, offset: 45680},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1856, col: 19, offset: 44293},
+								pos: position{line: 1906, col: 19, offset: 45683},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1856, col: 19, offset: 44293},
+										pos:  position{line: 1906, col: 19, offset: 45683},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1856, col: 32, offset: 44306},
+										pos:  position{line: 1906, col: 32, offset: 45696},
 										name: "EscapeSequence",
 									},
 								},
@@ -12817,32 +13219,32 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1858, col: 1, offset: 44341},
+			pos:  position{line: 1908, col: 1, offset: 45731},
 			expr: &choiceExpr{
-				pos: position{line: 1859, col: 5, offset: 44356},
+				pos: position{line: 1909, col: 5, offset: 45746},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1859, col: 5, offset: 44356},
+						pos: position{line: 1909, col: 5, offset: 45746},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1859, col: 5, offset: 44356},
+							pos:        position{line: 1909, col: 5, offset: 45746},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1860, col: 5, offset: 44384},
+						pos: position{line: 1910, col: 5, offset: 45774},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1860, col: 5, offset: 44384},
+							pos:        position{line: 1910, col: 5, offset: 45774},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1861, col: 5, offset: 44414},
+						pos:        position{line: 1911, col: 5, offset: 45804},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -12855,57 +13257,57 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1863, col: 1, offset: 44420},
+			pos:  position{line: 1913, col: 1, offset: 45810},
 			expr: &choiceExpr{
-				pos: position{line: 1864, col: 5, offset: 44441},
+				pos: position{line: 1914, col: 5, offset: 45831},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1864, col: 5, offset: 44441},
+						pos: position{line: 1914, col: 5, offset: 45831},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1864, col: 5, offset: 44441},
+							pos: position{line: 1914, col: 5, offset: 45831},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1864, col: 5, offset: 44441},
+									pos: position{line: 1914, col: 5, offset: 45831},
 									expr: &choiceExpr{
-										pos: position{line: 1864, col: 7, offset: 44443},
+										pos: position{line: 1914, col: 7, offset: 45833},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1864, col: 7, offset: 44443},
+												pos:        position{line: 1914, col: 7, offset: 45833},
 												val:        "'",
 												ignoreCase: false,
 												want:       "\"'\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1864, col: 13, offset: 44449},
+												pos:  position{line: 1914, col: 13, offset: 45839},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1864, col: 26, offset: 44462,
+									line: 1914, col: 26, offset: 45852,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1865, col: 5, offset: 44499},
+						pos: position{line: 1915, col: 5, offset: 45889},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1865, col: 5, offset: 44499},
+							pos: position{line: 1915, col: 5, offset: 45889},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1865, col: 5, offset: 44499},
+									pos:        position{line: 1915, col: 5, offset: 45889},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1865, col: 10, offset: 44504},
+									pos:   position{line: 1915, col: 10, offset: 45894},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1865, col: 12, offset: 44506},
+										pos:  position{line: 1915, col: 12, offset: 45896},
 										name: "EscapeSequence",
 									},
 								},
@@ -12919,16 +13321,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1867, col: 1, offset: 44540},
+			pos:  position{line: 1917, col: 1, offset: 45930},
 			expr: &choiceExpr{
-				pos: position{line: 1868, col: 5, offset: 44559},
+				pos: position{line: 1918, col: 5, offset: 45949},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1868, col: 5, offset: 44559},
+						pos:  position{line: 1918, col: 5, offset: 45949},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1869, col: 5, offset: 44580},
+						pos:  position{line: 1919, col: 5, offset: 45970},
 						name: "UnicodeEscape",
 					},
 				},
@@ -12938,87 +13340,87 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1871, col: 1, offset: 44595},
+			pos:  position{line: 1921, col: 1, offset: 45985},
 			expr: &choiceExpr{
-				pos: position{line: 1872, col: 5, offset: 44616},
+				pos: position{line: 1922, col: 5, offset: 46006},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1872, col: 5, offset: 44616},
+						pos:        position{line: 1922, col: 5, offset: 46006},
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&actionExpr{
-						pos: position{line: 1873, col: 5, offset: 44624},
+						pos: position{line: 1923, col: 5, offset: 46014},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1873, col: 5, offset: 44624},
+							pos:        position{line: 1923, col: 5, offset: 46014},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1874, col: 5, offset: 44664},
+						pos:        position{line: 1924, col: 5, offset: 46054},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 					&actionExpr{
-						pos: position{line: 1875, col: 5, offset: 44673},
+						pos: position{line: 1925, col: 5, offset: 46063},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1875, col: 5, offset: 44673},
+							pos:        position{line: 1925, col: 5, offset: 46063},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1876, col: 5, offset: 44702},
+						pos: position{line: 1926, col: 5, offset: 46092},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1876, col: 5, offset: 44702},
+							pos:        position{line: 1926, col: 5, offset: 46092},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1877, col: 5, offset: 44731},
+						pos: position{line: 1927, col: 5, offset: 46121},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1877, col: 5, offset: 44731},
+							pos:        position{line: 1927, col: 5, offset: 46121},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1878, col: 5, offset: 44760},
+						pos: position{line: 1928, col: 5, offset: 46150},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1878, col: 5, offset: 44760},
+							pos:        position{line: 1928, col: 5, offset: 46150},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1879, col: 5, offset: 44789},
+						pos: position{line: 1929, col: 5, offset: 46179},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1879, col: 5, offset: 44789},
+							pos:        position{line: 1929, col: 5, offset: 46179},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1880, col: 5, offset: 44818},
+						pos: position{line: 1930, col: 5, offset: 46208},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1880, col: 5, offset: 44818},
+							pos:        position{line: 1930, col: 5, offset: 46208},
 							val:        "v",
 							ignoreCase: false,
 							want:       "\"v\"",
@@ -13031,32 +13433,32 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1882, col: 1, offset: 44844},
+			pos:  position{line: 1932, col: 1, offset: 46234},
 			expr: &choiceExpr{
-				pos: position{line: 1883, col: 5, offset: 44862},
+				pos: position{line: 1933, col: 5, offset: 46252},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1883, col: 5, offset: 44862},
+						pos: position{line: 1933, col: 5, offset: 46252},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1883, col: 5, offset: 44862},
+							pos:        position{line: 1933, col: 5, offset: 46252},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1884, col: 5, offset: 44890},
+						pos: position{line: 1934, col: 5, offset: 46280},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1884, col: 5, offset: 44890},
+							pos:        position{line: 1934, col: 5, offset: 46280},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1885, col: 5, offset: 44918},
+						pos:        position{line: 1935, col: 5, offset: 46308},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -13069,42 +13471,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1887, col: 1, offset: 44924},
+			pos:  position{line: 1937, col: 1, offset: 46314},
 			expr: &choiceExpr{
-				pos: position{line: 1888, col: 5, offset: 44942},
+				pos: position{line: 1938, col: 5, offset: 46332},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1888, col: 5, offset: 44942},
+						pos: position{line: 1938, col: 5, offset: 46332},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1888, col: 5, offset: 44942},
+							pos: position{line: 1938, col: 5, offset: 46332},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1888, col: 5, offset: 44942},
+									pos:        position{line: 1938, col: 5, offset: 46332},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1888, col: 9, offset: 44946},
+									pos:   position{line: 1938, col: 9, offset: 46336},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1888, col: 16, offset: 44953},
+										pos: position{line: 1938, col: 16, offset: 46343},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1888, col: 16, offset: 44953},
+												pos:  position{line: 1938, col: 16, offset: 46343},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1888, col: 25, offset: 44962},
+												pos:  position{line: 1938, col: 25, offset: 46352},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1888, col: 34, offset: 44971},
+												pos:  position{line: 1938, col: 34, offset: 46361},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1888, col: 43, offset: 44980},
+												pos:  position{line: 1938, col: 43, offset: 46370},
 												name: "HexDigit",
 											},
 										},
@@ -13114,65 +13516,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1891, col: 5, offset: 45043},
+						pos: position{line: 1941, col: 5, offset: 46433},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1891, col: 5, offset: 45043},
+							pos: position{line: 1941, col: 5, offset: 46433},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1891, col: 5, offset: 45043},
+									pos:        position{line: 1941, col: 5, offset: 46433},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1891, col: 9, offset: 45047},
+									pos:        position{line: 1941, col: 9, offset: 46437},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1891, col: 13, offset: 45051},
+									pos:   position{line: 1941, col: 13, offset: 46441},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1891, col: 20, offset: 45058},
+										pos: position{line: 1941, col: 20, offset: 46448},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1891, col: 20, offset: 45058},
+												pos:  position{line: 1941, col: 20, offset: 46448},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1891, col: 29, offset: 45067},
+												pos: position{line: 1941, col: 29, offset: 46457},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1891, col: 29, offset: 45067},
+													pos:  position{line: 1941, col: 29, offset: 46457},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1891, col: 39, offset: 45077},
+												pos: position{line: 1941, col: 39, offset: 46467},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1891, col: 39, offset: 45077},
+													pos:  position{line: 1941, col: 39, offset: 46467},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1891, col: 49, offset: 45087},
+												pos: position{line: 1941, col: 49, offset: 46477},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1891, col: 49, offset: 45087},
+													pos:  position{line: 1941, col: 49, offset: 46477},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1891, col: 59, offset: 45097},
+												pos: position{line: 1941, col: 59, offset: 46487},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1891, col: 59, offset: 45097},
+													pos:  position{line: 1941, col: 59, offset: 46487},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1891, col: 69, offset: 45107},
+												pos: position{line: 1941, col: 69, offset: 46497},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1891, col: 69, offset: 45107},
+													pos:  position{line: 1941, col: 69, offset: 46497},
 													name: "HexDigit",
 												},
 											},
@@ -13180,7 +13582,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1891, col: 80, offset: 45118},
+									pos:        position{line: 1941, col: 80, offset: 46508},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -13195,9 +13597,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1896, col: 1, offset: 45173},
+			pos:  position{line: 1946, col: 1, offset: 46563},
 			expr: &charClassMatcher{
-				pos:        position{line: 1897, col: 5, offset: 45189},
+				pos:        position{line: 1947, col: 5, offset: 46579},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -13209,11 +13611,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1899, col: 1, offset: 45204},
+			pos:  position{line: 1949, col: 1, offset: 46594},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1899, col: 5, offset: 45208},
+				pos: position{line: 1949, col: 5, offset: 46598},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1899, col: 5, offset: 45208},
+					pos:  position{line: 1949, col: 5, offset: 46598},
 					name: "AnySpace",
 				},
 			},
@@ -13222,11 +13624,11 @@ var g = &grammar{
 		},
 		{
 			name: "__",
-			pos:  position{line: 1901, col: 1, offset: 45219},
+			pos:  position{line: 1951, col: 1, offset: 46609},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1901, col: 6, offset: 45224},
+				pos: position{line: 1951, col: 6, offset: 46614},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1901, col: 6, offset: 45224},
+					pos:  position{line: 1951, col: 6, offset: 46614},
 					name: "AnySpace",
 				},
 			},
@@ -13235,20 +13637,20 @@ var g = &grammar{
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1903, col: 1, offset: 45235},
+			pos:  position{line: 1953, col: 1, offset: 46625},
 			expr: &choiceExpr{
-				pos: position{line: 1904, col: 5, offset: 45248},
+				pos: position{line: 1954, col: 5, offset: 46638},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1904, col: 5, offset: 45248},
+						pos:  position{line: 1954, col: 5, offset: 46638},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1905, col: 5, offset: 45263},
+						pos:  position{line: 1955, col: 5, offset: 46653},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1906, col: 5, offset: 45282},
+						pos:  position{line: 1956, col: 5, offset: 46672},
 						name: "Comment",
 					},
 				},
@@ -13258,32 +13660,32 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeLetter",
-			pos:  position{line: 1908, col: 1, offset: 45291},
+			pos:  position{line: 1958, col: 1, offset: 46681},
 			expr: &choiceExpr{
-				pos: position{line: 1909, col: 5, offset: 45309},
+				pos: position{line: 1959, col: 5, offset: 46699},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1909, col: 5, offset: 45309},
+						pos:  position{line: 1959, col: 5, offset: 46699},
 						name: "Lu",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1910, col: 5, offset: 45316},
+						pos:  position{line: 1960, col: 5, offset: 46706},
 						name: "Ll",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1911, col: 5, offset: 45323},
+						pos:  position{line: 1961, col: 5, offset: 46713},
 						name: "Lt",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1912, col: 5, offset: 45330},
+						pos:  position{line: 1962, col: 5, offset: 46720},
 						name: "Lm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1913, col: 5, offset: 45337},
+						pos:  position{line: 1963, col: 5, offset: 46727},
 						name: "Lo",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1914, col: 5, offset: 45344},
+						pos:  position{line: 1964, col: 5, offset: 46734},
 						name: "Nl",
 					},
 				},
@@ -13293,16 +13695,16 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeCombiningMark",
-			pos:  position{line: 1916, col: 1, offset: 45348},
+			pos:  position{line: 1966, col: 1, offset: 46738},
 			expr: &choiceExpr{
-				pos: position{line: 1917, col: 5, offset: 45373},
+				pos: position{line: 1967, col: 5, offset: 46763},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1917, col: 5, offset: 45373},
+						pos:  position{line: 1967, col: 5, offset: 46763},
 						name: "Mn",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1918, col: 5, offset: 45380},
+						pos:  position{line: 1968, col: 5, offset: 46770},
 						name: "Mc",
 					},
 				},
@@ -13312,9 +13714,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeDigit",
-			pos:  position{line: 1920, col: 1, offset: 45384},
+			pos:  position{line: 1970, col: 1, offset: 46774},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1921, col: 5, offset: 45401},
+				pos:  position{line: 1971, col: 5, offset: 46791},
 				name: "Nd",
 			},
 			leader:        false,
@@ -13322,9 +13724,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeConnectorPunctuation",
-			pos:  position{line: 1923, col: 1, offset: 45405},
+			pos:  position{line: 1973, col: 1, offset: 46795},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1924, col: 5, offset: 45437},
+				pos:  position{line: 1974, col: 5, offset: 46827},
 				name: "Pc",
 			},
 			leader:        false,
@@ -13332,9 +13734,9 @@ var g = &grammar{
 		},
 		{
 			name: "Ll",
-			pos:  position{line: 1930, col: 1, offset: 45618},
+			pos:  position{line: 1980, col: 1, offset: 47008},
 			expr: &charClassMatcher{
-				pos:        position{line: 1930, col: 6, offset: 45623},
+				pos:        position{line: 1980, col: 6, offset: 47013},
 				val:        "[\\u0061-\\u007A\\u00B5\\u00DF-\\u00F6\\u00F8-\\u00FF\\u0101\\u0103\\u0105\\u0107\\u0109\\u010B\\u010D\\u010F\\u0111\\u0113\\u0115\\u0117\\u0119\\u011B\\u011D\\u011F\\u0121\\u0123\\u0125\\u0127\\u0129\\u012B\\u012D\\u012F\\u0131\\u0133\\u0135\\u0137-\\u0138\\u013A\\u013C\\u013E\\u0140\\u0142\\u0144\\u0146\\u0148-\\u0149\\u014B\\u014D\\u014F\\u0151\\u0153\\u0155\\u0157\\u0159\\u015B\\u015D\\u015F\\u0161\\u0163\\u0165\\u0167\\u0169\\u016B\\u016D\\u016F\\u0171\\u0173\\u0175\\u0177\\u017A\\u017C\\u017E-\\u0180\\u0183\\u0185\\u0188\\u018C-\\u018D\\u0192\\u0195\\u0199-\\u019B\\u019E\\u01A1\\u01A3\\u01A5\\u01A8\\u01AA-\\u01AB\\u01AD\\u01B0\\u01B4\\u01B6\\u01B9-\\u01BA\\u01BD-\\u01BF\\u01C6\\u01C9\\u01CC\\u01CE\\u01D0\\u01D2\\u01D4\\u01D6\\u01D8\\u01DA\\u01DC-\\u01DD\\u01DF\\u01E1\\u01E3\\u01E5\\u01E7\\u01E9\\u01EB\\u01ED\\u01EF-\\u01F0\\u01F3\\u01F5\\u01F9\\u01FB\\u01FD\\u01FF\\u0201\\u0203\\u0205\\u0207\\u0209\\u020B\\u020D\\u020F\\u0211\\u0213\\u0215\\u0217\\u0219\\u021B\\u021D\\u021F\\u0221\\u0223\\u0225\\u0227\\u0229\\u022B\\u022D\\u022F\\u0231\\u0233-\\u0239\\u023C\\u023F-\\u0240\\u0242\\u0247\\u0249\\u024B\\u024D\\u024F-\\u0293\\u0295-\\u02AF\\u0371\\u0373\\u0377\\u037B-\\u037D\\u0390\\u03AC-\\u03CE\\u03D0-\\u03D1\\u03D5-\\u03D7\\u03D9\\u03DB\\u03DD\\u03DF\\u03E1\\u03E3\\u03E5\\u03E7\\u03E9\\u03EB\\u03ED\\u03EF-\\u03F3\\u03F5\\u03F8\\u03FB-\\u03FC\\u0430-\\u045F\\u0461\\u0463\\u0465\\u0467\\u0469\\u046B\\u046D\\u046F\\u0471\\u0473\\u0475\\u0477\\u0479\\u047B\\u047D\\u047F\\u0481\\u048B\\u048D\\u048F\\u0491\\u0493\\u0495\\u0497\\u0499\\u049B\\u049D\\u049F\\u04A1\\u04A3\\u04A5\\u04A7\\u04A9\\u04AB\\u04AD\\u04AF\\u04B1\\u04B3\\u04B5\\u04B7\\u04B9\\u04BB\\u04BD\\u04BF\\u04C2\\u04C4\\u04C6\\u04C8\\u04CA\\u04CC\\u04CE-\\u04CF\\u04D1\\u04D3\\u04D5\\u04D7\\u04D9\\u04DB\\u04DD\\u04DF\\u04E1\\u04E3\\u04E5\\u04E7\\u04E9\\u04EB\\u04ED\\u04EF\\u04F1\\u04F3\\u04F5\\u04F7\\u04F9\\u04FB\\u04FD\\u04FF\\u0501\\u0503\\u0505\\u0507\\u0509\\u050B\\u050D\\u050F\\u0511\\u0513\\u0515\\u0517\\u0519\\u051B\\u051D\\u051F\\u0521\\u0523\\u0525\\u0527\\u0529\\u052B\\u052D\\u052F\\u0560-\\u0588\\u10D0-\\u10FA\\u10FD-\\u10FF\\u13F8-\\u13FD\\u1C80-\\u1C88\\u1D00-\\u1D2B\\u1D6B-\\u1D77\\u1D79-\\u1D9A\\u1E01\\u1E03\\u1E05\\u1E07\\u1E09\\u1E0B\\u1E0D\\u1E0F\\u1E11\\u1E13\\u1E15\\u1E17\\u1E19\\u1E1B\\u1E1D\\u1E1F\\u1E21\\u1E23\\u1E25\\u1E27\\u1E29\\u1E2B\\u1E2D\\u1E2F\\u1E31\\u1E33\\u1E35\\u1E37\\u1E39\\u1E3B\\u1E3D\\u1E3F\\u1E41\\u1E43\\u1E45\\u1E47\\u1E49\\u1E4B\\u1E4D\\u1E4F\\u1E51\\u1E53\\u1E55\\u1E57\\u1E59\\u1E5B\\u1E5D\\u1E5F\\u1E61\\u1E63\\u1E65\\u1E67\\u1E69\\u1E6B\\u1E6D\\u1E6F\\u1E71\\u1E73\\u1E75\\u1E77\\u1E79\\u1E7B\\u1E7D\\u1E7F\\u1E81\\u1E83\\u1E85\\u1E87\\u1E89\\u1E8B\\u1E8D\\u1E8F\\u1E91\\u1E93\\u1E95-\\u1E9D\\u1E9F\\u1EA1\\u1EA3\\u1EA5\\u1EA7\\u1EA9\\u1EAB\\u1EAD\\u1EAF\\u1EB1\\u1EB3\\u1EB5\\u1EB7\\u1EB9\\u1EBB\\u1EBD\\u1EBF\\u1EC1\\u1EC3\\u1EC5\\u1EC7\\u1EC9\\u1ECB\\u1ECD\\u1ECF\\u1ED1\\u1ED3\\u1ED5\\u1ED7\\u1ED9\\u1EDB\\u1EDD\\u1EDF\\u1EE1\\u1EE3\\u1EE5\\u1EE7\\u1EE9\\u1EEB\\u1EED\\u1EEF\\u1EF1\\u1EF3\\u1EF5\\u1EF7\\u1EF9\\u1EFB\\u1EFD\\u1EFF-\\u1F07\\u1F10-\\u1F15\\u1F20-\\u1F27\\u1F30-\\u1F37\\u1F40-\\u1F45\\u1F50-\\u1F57\\u1F60-\\u1F67\\u1F70-\\u1F7D\\u1F80-\\u1F87\\u1F90-\\u1F97\\u1FA0-\\u1FA7\\u1FB0-\\u1FB4\\u1FB6-\\u1FB7\\u1FBE\\u1FC2-\\u1FC4\\u1FC6-\\u1FC7\\u1FD0-\\u1FD3\\u1FD6-\\u1FD7\\u1FE0-\\u1FE7\\u1FF2-\\u1FF4\\u1FF6-\\u1FF7\\u210A\\u210E-\\u210F\\u2113\\u212F\\u2134\\u2139\\u213C-\\u213D\\u2146-\\u2149\\u214E\\u2184\\u2C30-\\u2C5E\\u2C61\\u2C65-\\u2C66\\u2C68\\u2C6A\\u2C6C\\u2C71\\u2C73-\\u2C74\\u2C76-\\u2C7B\\u2C81\\u2C83\\u2C85\\u2C87\\u2C89\\u2C8B\\u2C8D\\u2C8F\\u2C91\\u2C93\\u2C95\\u2C97\\u2C99\\u2C9B\\u2C9D\\u2C9F\\u2CA1\\u2CA3\\u2CA5\\u2CA7\\u2CA9\\u2CAB\\u2CAD\\u2CAF\\u2CB1\\u2CB3\\u2CB5\\u2CB7\\u2CB9\\u2CBB\\u2CBD\\u2CBF\\u2CC1\\u2CC3\\u2CC5\\u2CC7\\u2CC9\\u2CCB\\u2CCD\\u2CCF\\u2CD1\\u2CD3\\u2CD5\\u2CD7\\u2CD9\\u2CDB\\u2CDD\\u2CDF\\u2CE1\\u2CE3-\\u2CE4\\u2CEC\\u2CEE\\u2CF3\\u2D00-\\u2D25\\u2D27\\u2D2D\\uA641\\uA643\\uA645\\uA647\\uA649\\uA64B\\uA64D\\uA64F\\uA651\\uA653\\uA655\\uA657\\uA659\\uA65B\\uA65D\\uA65F\\uA661\\uA663\\uA665\\uA667\\uA669\\uA66B\\uA66D\\uA681\\uA683\\uA685\\uA687\\uA689\\uA68B\\uA68D\\uA68F\\uA691\\uA693\\uA695\\uA697\\uA699\\uA69B\\uA723\\uA725\\uA727\\uA729\\uA72B\\uA72D\\uA72F-\\uA731\\uA733\\uA735\\uA737\\uA739\\uA73B\\uA73D\\uA73F\\uA741\\uA743\\uA745\\uA747\\uA749\\uA74B\\uA74D\\uA74F\\uA751\\uA753\\uA755\\uA757\\uA759\\uA75B\\uA75D\\uA75F\\uA761\\uA763\\uA765\\uA767\\uA769\\uA76B\\uA76D\\uA76F\\uA771-\\uA778\\uA77A\\uA77C\\uA77F\\uA781\\uA783\\uA785\\uA787\\uA78C\\uA78E\\uA791\\uA793-\\uA795\\uA797\\uA799\\uA79B\\uA79D\\uA79F\\uA7A1\\uA7A3\\uA7A5\\uA7A7\\uA7A9\\uA7AF\\uA7B5\\uA7B7\\uA7B9\\uA7FA\\uAB30-\\uAB5A\\uAB60-\\uAB65\\uAB70-\\uABBF\\uFB00-\\uFB06\\uFB13-\\uFB17\\uFF41-\\uFF5A]",
 				chars:      []rune{'µ', 'ā', 'ă', 'ą', 'ć', 'ĉ', 'ċ', 'č', 'ď', 'đ', 'ē', 'ĕ', 'ė', 'ę', 'ě', 'ĝ', 'ğ', 'ġ', 'ģ', 'ĥ', 'ħ', 'ĩ', 'ī', 'ĭ', 'į', 'ı', 'ĳ', 'ĵ', 'ĺ', 'ļ', 'ľ', 'ŀ', 'ł', 'ń', 'ņ', 'ŋ', 'ō', 'ŏ', 'ő', 'œ', 'ŕ', 'ŗ', 'ř', 'ś', 'ŝ', 'ş', 'š', 'ţ', 'ť', 'ŧ', 'ũ', 'ū', 'ŭ', 'ů', 'ű', 'ų', 'ŵ', 'ŷ', 'ź', 'ż', 'ƃ', 'ƅ', 'ƈ', 'ƒ', 'ƕ', 'ƞ', 'ơ', 'ƣ', 'ƥ', 'ƨ', 'ƭ', 'ư', 'ƴ', 'ƶ', 'ǆ', 'ǉ', 'ǌ', 'ǎ', 'ǐ', 'ǒ', 'ǔ', 'ǖ', 'ǘ', 'ǚ', 'ǟ', 'ǡ', 'ǣ', 'ǥ', 'ǧ', 'ǩ', 'ǫ', 'ǭ', 'ǳ', 'ǵ', 'ǹ', 'ǻ', 'ǽ', 'ǿ', 'ȁ', 'ȃ', 'ȅ', 'ȇ', 'ȉ', 'ȋ', 'ȍ', 'ȏ', 'ȑ', 'ȓ', 'ȕ', 'ȗ', 'ș', 'ț', 'ȝ', 'ȟ', 'ȡ', 'ȣ', 'ȥ', 'ȧ', 'ȩ', 'ȫ', 'ȭ', 'ȯ', 'ȱ', 'ȼ', 'ɂ', 'ɇ', 'ɉ', 'ɋ', 'ɍ', 'ͱ', 'ͳ', 'ͷ', 'ΐ', 'ϙ', 'ϛ', 'ϝ', 'ϟ', 'ϡ', 'ϣ', 'ϥ', 'ϧ', 'ϩ', 'ϫ', 'ϭ', 'ϵ', 'ϸ', 'ѡ', 'ѣ', 'ѥ', 'ѧ', 'ѩ', 'ѫ', 'ѭ', 'ѯ', 'ѱ', 'ѳ', 'ѵ', 'ѷ', 'ѹ', 'ѻ', 'ѽ', 'ѿ', 'ҁ', 'ҋ', 'ҍ', 'ҏ', 'ґ', 'ғ', 'ҕ', 'җ', 'ҙ', 'қ', 'ҝ', 'ҟ', 'ҡ', 'ң', 'ҥ', 'ҧ', 'ҩ', 'ҫ', 'ҭ', 'ү', 'ұ', 'ҳ', 'ҵ', 'ҷ', 'ҹ', 'һ', 'ҽ', 'ҿ', 'ӂ', 'ӄ', 'ӆ', 'ӈ', 'ӊ', 'ӌ', 'ӑ', 'ӓ', 'ӕ', 'ӗ', 'ә', 'ӛ', 'ӝ', 'ӟ', 'ӡ', 'ӣ', 'ӥ', 'ӧ', 'ө', 'ӫ', 'ӭ', 'ӯ', 'ӱ', 'ӳ', 'ӵ', 'ӷ', 'ӹ', 'ӻ', 'ӽ', 'ӿ', 'ԁ', 'ԃ', 'ԅ', 'ԇ', 'ԉ', 'ԋ', 'ԍ', 'ԏ', 'ԑ', 'ԓ', 'ԕ', 'ԗ', 'ԙ', 'ԛ', 'ԝ', 'ԟ', 'ԡ', 'ԣ', 'ԥ', 'ԧ', 'ԩ', 'ԫ', 'ԭ', 'ԯ', 'ḁ', 'ḃ', 'ḅ', 'ḇ', 'ḉ', 'ḋ', 'ḍ', 'ḏ', 'ḑ', 'ḓ', 'ḕ', 'ḗ', 'ḙ', 'ḛ', 'ḝ', 'ḟ', 'ḡ', 'ḣ', 'ḥ', 'ḧ', 'ḩ', 'ḫ', 'ḭ', 'ḯ', 'ḱ', 'ḳ', 'ḵ', 'ḷ', 'ḹ', 'ḻ', 'ḽ', 'ḿ', 'ṁ', 'ṃ', 'ṅ', 'ṇ', 'ṉ', 'ṋ', 'ṍ', 'ṏ', 'ṑ', 'ṓ', 'ṕ', 'ṗ', 'ṙ', 'ṛ', 'ṝ', 'ṟ', 'ṡ', 'ṣ', 'ṥ', 'ṧ', 'ṩ', 'ṫ', 'ṭ', 'ṯ', 'ṱ', 'ṳ', 'ṵ', 'ṷ', 'ṹ', 'ṻ', 'ṽ', 'ṿ', 'ẁ', 'ẃ', 'ẅ', 'ẇ', 'ẉ', 'ẋ', 'ẍ', 'ẏ', 'ẑ', 'ẓ', 'ẟ', 'ạ', 'ả', 'ấ', 'ầ', 'ẩ', 'ẫ', 'ậ', 'ắ', 'ằ', 'ẳ', 'ẵ', 'ặ', 'ẹ', 'ẻ', 'ẽ', 'ế', 'ề', 'ể', 'ễ', 'ệ', 'ỉ', 'ị', 'ọ', 'ỏ', 'ố', 'ồ', 'ổ', 'ỗ', 'ộ', 'ớ', 'ờ', 'ở', 'ỡ', 'ợ', 'ụ', 'ủ', 'ứ', 'ừ', 'ử', 'ữ', 'ự', 'ỳ', 'ỵ', 'ỷ', 'ỹ', 'ỻ', 'ỽ', 'ι', 'ℊ', 'ℓ', 'ℯ', 'ℴ', 'ℹ', 'ⅎ', 'ↄ', 'ⱡ', 'ⱨ', 'ⱪ', 'ⱬ', 'ⱱ', 'ⲁ', 'ⲃ', 'ⲅ', 'ⲇ', 'ⲉ', 'ⲋ', 'ⲍ', 'ⲏ', 'ⲑ', 'ⲓ', 'ⲕ', 'ⲗ', 'ⲙ', 'ⲛ', 'ⲝ', 'ⲟ', 'ⲡ', 'ⲣ', 'ⲥ', 'ⲧ', 'ⲩ', 'ⲫ', 'ⲭ', 'ⲯ', 'ⲱ', 'ⲳ', 'ⲵ', 'ⲷ', 'ⲹ', 'ⲻ', 'ⲽ', 'ⲿ', 'ⳁ', 'ⳃ', 'ⳅ', 'ⳇ', 'ⳉ', 'ⳋ', 'ⳍ', 'ⳏ', 'ⳑ', 'ⳓ', 'ⳕ', 'ⳗ', 'ⳙ', 'ⳛ', 'ⳝ', 'ⳟ', 'ⳡ', 'ⳬ', 'ⳮ', 'ⳳ', 'ⴧ', 'ⴭ', 'ꙁ', 'ꙃ', 'ꙅ', 'ꙇ', 'ꙉ', 'ꙋ', 'ꙍ', 'ꙏ', 'ꙑ', 'ꙓ', 'ꙕ', 'ꙗ', 'ꙙ', 'ꙛ', 'ꙝ', 'ꙟ', 'ꙡ', 'ꙣ', 'ꙥ', 'ꙧ', 'ꙩ', 'ꙫ', 'ꙭ', 'ꚁ', 'ꚃ', 'ꚅ', 'ꚇ', 'ꚉ', 'ꚋ', 'ꚍ', 'ꚏ', 'ꚑ', 'ꚓ', 'ꚕ', 'ꚗ', 'ꚙ', 'ꚛ', 'ꜣ', 'ꜥ', 'ꜧ', 'ꜩ', 'ꜫ', 'ꜭ', 'ꜳ', 'ꜵ', 'ꜷ', 'ꜹ', 'ꜻ', 'ꜽ', 'ꜿ', 'ꝁ', 'ꝃ', 'ꝅ', 'ꝇ', 'ꝉ', 'ꝋ', 'ꝍ', 'ꝏ', 'ꝑ', 'ꝓ', 'ꝕ', 'ꝗ', 'ꝙ', 'ꝛ', 'ꝝ', 'ꝟ', 'ꝡ', 'ꝣ', 'ꝥ', 'ꝧ', 'ꝩ', 'ꝫ', 'ꝭ', 'ꝯ', 'ꝺ', 'ꝼ', 'ꝿ', 'ꞁ', 'ꞃ', 'ꞅ', 'ꞇ', 'ꞌ', 'ꞎ', 'ꞑ', 'ꞗ', 'ꞙ', 'ꞛ', 'ꞝ', 'ꞟ', 'ꞡ', 'ꞣ', 'ꞥ', 'ꞧ', 'ꞩ', 'ꞯ', 'ꞵ', 'ꞷ', 'ꞹ', 'ꟺ'},
 				ranges:     []rune{'a', 'z', 'ß', 'ö', 'ø', 'ÿ', 'ķ', 'ĸ', 'ň', 'ŉ', 'ž', 'ƀ', 'ƌ', 'ƍ', 'ƙ', 'ƛ', 'ƪ', 'ƫ', 'ƹ', 'ƺ', 'ƽ', 'ƿ', 'ǜ', 'ǝ', 'ǯ', 'ǰ', 'ȳ', 'ȹ', 'ȿ', 'ɀ', 'ɏ', 'ʓ', 'ʕ', 'ʯ', 'ͻ', 'ͽ', 'ά', 'ώ', 'ϐ', 'ϑ', 'ϕ', 'ϗ', 'ϯ', 'ϳ', 'ϻ', 'ϼ', 'а', 'џ', 'ӎ', 'ӏ', 'ՠ', 'ֈ', 'ა', 'ჺ', 'ჽ', 'ჿ', 'ᏸ', 'ᏽ', 'ᲀ', 'ᲈ', 'ᴀ', 'ᴫ', 'ᵫ', 'ᵷ', 'ᵹ', 'ᶚ', 'ẕ', 'ẝ', 'ỿ', 'ἇ', 'ἐ', 'ἕ', 'ἠ', 'ἧ', 'ἰ', 'ἷ', 'ὀ', 'ὅ', 'ὐ', 'ὗ', 'ὠ', 'ὧ', 'ὰ', 'ώ', 'ᾀ', 'ᾇ', 'ᾐ', 'ᾗ', 'ᾠ', 'ᾧ', 'ᾰ', 'ᾴ', 'ᾶ', 'ᾷ', 'ῂ', 'ῄ', 'ῆ', 'ῇ', 'ῐ', 'ΐ', 'ῖ', 'ῗ', 'ῠ', 'ῧ', 'ῲ', 'ῴ', 'ῶ', 'ῷ', 'ℎ', 'ℏ', 'ℼ', 'ℽ', 'ⅆ', 'ⅉ', 'ⰰ', 'ⱞ', 'ⱥ', 'ⱦ', 'ⱳ', 'ⱴ', 'ⱶ', 'ⱻ', 'ⳣ', 'ⳤ', 'ⴀ', 'ⴥ', 'ꜯ', 'ꜱ', 'ꝱ', 'ꝸ', 'ꞓ', 'ꞕ', 'ꬰ', 'ꭚ', 'ꭠ', 'ꭥ', 'ꭰ', 'ꮿ', 'ﬀ', 'ﬆ', 'ﬓ', 'ﬗ', 'ａ', 'ｚ'},
@@ -13346,9 +13748,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lm",
-			pos:  position{line: 1933, col: 1, offset: 49775},
+			pos:  position{line: 1983, col: 1, offset: 51165},
 			expr: &charClassMatcher{
-				pos:        position{line: 1933, col: 6, offset: 49780},
+				pos:        position{line: 1983, col: 6, offset: 51170},
 				val:        "[\\u02B0-\\u02C1\\u02C6-\\u02D1\\u02E0-\\u02E4\\u02EC\\u02EE\\u0374\\u037A\\u0559\\u0640\\u06E5-\\u06E6\\u07F4-\\u07F5\\u07FA\\u081A\\u0824\\u0828\\u0971\\u0E46\\u0EC6\\u10FC\\u17D7\\u1843\\u1AA7\\u1C78-\\u1C7D\\u1D2C-\\u1D6A\\u1D78\\u1D9B-\\u1DBF\\u2071\\u207F\\u2090-\\u209C\\u2C7C-\\u2C7D\\u2D6F\\u2E2F\\u3005\\u3031-\\u3035\\u303B\\u309D-\\u309E\\u30FC-\\u30FE\\uA015\\uA4F8-\\uA4FD\\uA60C\\uA67F\\uA69C-\\uA69D\\uA717-\\uA71F\\uA770\\uA788\\uA7F8-\\uA7F9\\uA9CF\\uA9E6\\uAA70\\uAADD\\uAAF3-\\uAAF4\\uAB5C-\\uAB5F\\uFF70\\uFF9E-\\uFF9F]",
 				chars:      []rune{'ˬ', 'ˮ', 'ʹ', 'ͺ', 'ՙ', 'ـ', 'ߺ', 'ࠚ', 'ࠤ', 'ࠨ', 'ॱ', 'ๆ', 'ໆ', 'ჼ', 'ៗ', 'ᡃ', 'ᪧ', 'ᵸ', 'ⁱ', 'ⁿ', 'ⵯ', 'ⸯ', '々', '〻', 'ꀕ', 'ꘌ', 'ꙿ', 'ꝰ', 'ꞈ', 'ꧏ', 'ꧦ', 'ꩰ', 'ꫝ', 'ｰ'},
 				ranges:     []rune{'ʰ', 'ˁ', 'ˆ', 'ˑ', 'ˠ', 'ˤ', 'ۥ', 'ۦ', 'ߴ', 'ߵ', 'ᱸ', 'ᱽ', 'ᴬ', 'ᵪ', 'ᶛ', 'ᶿ', 'ₐ', 'ₜ', 'ⱼ', 'ⱽ', '〱', '〵', 'ゝ', 'ゞ', 'ー', 'ヾ', 'ꓸ', 'ꓽ', 'ꚜ', 'ꚝ', 'ꜗ', 'ꜟ', 'ꟸ', 'ꟹ', 'ꫳ', 'ꫴ', 'ꭜ', 'ꭟ', 'ﾞ', 'ﾟ'},
@@ -13360,9 +13762,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lo",
-			pos:  position{line: 1936, col: 1, offset: 50265},
+			pos:  position{line: 1986, col: 1, offset: 51655},
 			expr: &charClassMatcher{
-				pos:        position{line: 1936, col: 6, offset: 50270},
+				pos:        position{line: 1986, col: 6, offset: 51660},
 				val:        "[\\u00AA\\u00BA\\u01BB\\u01C0-\\u01C3\\u0294\\u05D0-\\u05EA\\u05EF-\\u05F2\\u0620-\\u063F\\u0641-\\u064A\\u066E-\\u066F\\u0671-\\u06D3\\u06D5\\u06EE-\\u06EF\\u06FA-\\u06FC\\u06FF\\u0710\\u0712-\\u072F\\u074D-\\u07A5\\u07B1\\u07CA-\\u07EA\\u0800-\\u0815\\u0840-\\u0858\\u0860-\\u086A\\u08A0-\\u08B4\\u08B6-\\u08BD\\u0904-\\u0939\\u093D\\u0950\\u0958-\\u0961\\u0972-\\u0980\\u0985-\\u098C\\u098F-\\u0990\\u0993-\\u09A8\\u09AA-\\u09B0\\u09B2\\u09B6-\\u09B9\\u09BD\\u09CE\\u09DC-\\u09DD\\u09DF-\\u09E1\\u09F0-\\u09F1\\u09FC\\u0A05-\\u0A0A\\u0A0F-\\u0A10\\u0A13-\\u0A28\\u0A2A-\\u0A30\\u0A32-\\u0A33\\u0A35-\\u0A36\\u0A38-\\u0A39\\u0A59-\\u0A5C\\u0A5E\\u0A72-\\u0A74\\u0A85-\\u0A8D\\u0A8F-\\u0A91\\u0A93-\\u0AA8\\u0AAA-\\u0AB0\\u0AB2-\\u0AB3\\u0AB5-\\u0AB9\\u0ABD\\u0AD0\\u0AE0-\\u0AE1\\u0AF9\\u0B05-\\u0B0C\\u0B0F-\\u0B10\\u0B13-\\u0B28\\u0B2A-\\u0B30\\u0B32-\\u0B33\\u0B35-\\u0B39\\u0B3D\\u0B5C-\\u0B5D\\u0B5F-\\u0B61\\u0B71\\u0B83\\u0B85-\\u0B8A\\u0B8E-\\u0B90\\u0B92-\\u0B95\\u0B99-\\u0B9A\\u0B9C\\u0B9E-\\u0B9F\\u0BA3-\\u0BA4\\u0BA8-\\u0BAA\\u0BAE-\\u0BB9\\u0BD0\\u0C05-\\u0C0C\\u0C0E-\\u0C10\\u0C12-\\u0C28\\u0C2A-\\u0C39\\u0C3D\\u0C58-\\u0C5A\\u0C60-\\u0C61\\u0C80\\u0C85-\\u0C8C\\u0C8E-\\u0C90\\u0C92-\\u0CA8\\u0CAA-\\u0CB3\\u0CB5-\\u0CB9\\u0CBD\\u0CDE\\u0CE0-\\u0CE1\\u0CF1-\\u0CF2\\u0D05-\\u0D0C\\u0D0E-\\u0D10\\u0D12-\\u0D3A\\u0D3D\\u0D4E\\u0D54-\\u0D56\\u0D5F-\\u0D61\\u0D7A-\\u0D7F\\u0D85-\\u0D96\\u0D9A-\\u0DB1\\u0DB3-\\u0DBB\\u0DBD\\u0DC0-\\u0DC6\\u0E01-\\u0E30\\u0E32-\\u0E33\\u0E40-\\u0E45\\u0E81-\\u0E82\\u0E84\\u0E87-\\u0E88\\u0E8A\\u0E8D\\u0E94-\\u0E97\\u0E99-\\u0E9F\\u0EA1-\\u0EA3\\u0EA5\\u0EA7\\u0EAA-\\u0EAB\\u0EAD-\\u0EB0\\u0EB2-\\u0EB3\\u0EBD\\u0EC0-\\u0EC4\\u0EDC-\\u0EDF\\u0F00\\u0F40-\\u0F47\\u0F49-\\u0F6C\\u0F88-\\u0F8C\\u1000-\\u102A\\u103F\\u1050-\\u1055\\u105A-\\u105D\\u1061\\u1065-\\u1066\\u106E-\\u1070\\u1075-\\u1081\\u108E\\u1100-\\u1248\\u124A-\\u124D\\u1250-\\u1256\\u1258\\u125A-\\u125D\\u1260-\\u1288\\u128A-\\u128D\\u1290-\\u12B0\\u12B2-\\u12B5\\u12B8-\\u12BE\\u12C0\\u12C2-\\u12C5\\u12C8-\\u12D6\\u12D8-\\u1310\\u1312-\\u1315\\u1318-\\u135A\\u1380-\\u138F\\u1401-\\u166C\\u166F-\\u167F\\u1681-\\u169A\\u16A0-\\u16EA\\u16F1-\\u16F8\\u1700-\\u170C\\u170E-\\u1711\\u1720-\\u1731\\u1740-\\u1751\\u1760-\\u176C\\u176E-\\u1770\\u1780-\\u17B3\\u17DC\\u1820-\\u1842\\u1844-\\u1878\\u1880-\\u1884\\u1887-\\u18A8\\u18AA\\u18B0-\\u18F5\\u1900-\\u191E\\u1950-\\u196D\\u1970-\\u1974\\u1980-\\u19AB\\u19B0-\\u19C9\\u1A00-\\u1A16\\u1A20-\\u1A54\\u1B05-\\u1B33\\u1B45-\\u1B4B\\u1B83-\\u1BA0\\u1BAE-\\u1BAF\\u1BBA-\\u1BE5\\u1C00-\\u1C23\\u1C4D-\\u1C4F\\u1C5A-\\u1C77\\u1CE9-\\u1CEC\\u1CEE-\\u1CF1\\u1CF5-\\u1CF6\\u2135-\\u2138\\u2D30-\\u2D67\\u2D80-\\u2D96\\u2DA0-\\u2DA6\\u2DA8-\\u2DAE\\u2DB0-\\u2DB6\\u2DB8-\\u2DBE\\u2DC0-\\u2DC6\\u2DC8-\\u2DCE\\u2DD0-\\u2DD6\\u2DD8-\\u2DDE\\u3006\\u303C\\u3041-\\u3096\\u309F\\u30A1-\\u30FA\\u30FF\\u3105-\\u312F\\u3131-\\u318E\\u31A0-\\u31BA\\u31F0-\\u31FF\\u3400-\\u4DB5\\u4E00-\\u9FEF\\uA000-\\uA014\\uA016-\\uA48C\\uA4D0-\\uA4F7\\uA500-\\uA60B\\uA610-\\uA61F\\uA62A-\\uA62B\\uA66E\\uA6A0-\\uA6E5\\uA78F\\uA7F7\\uA7FB-\\uA801\\uA803-\\uA805\\uA807-\\uA80A\\uA80C-\\uA822\\uA840-\\uA873\\uA882-\\uA8B3\\uA8F2-\\uA8F7\\uA8FB\\uA8FD-\\uA8FE\\uA90A-\\uA925\\uA930-\\uA946\\uA960-\\uA97C\\uA984-\\uA9B2\\uA9E0-\\uA9E4\\uA9E7-\\uA9EF\\uA9FA-\\uA9FE\\uAA00-\\uAA28\\uAA40-\\uAA42\\uAA44-\\uAA4B\\uAA60-\\uAA6F\\uAA71-\\uAA76\\uAA7A\\uAA7E-\\uAAAF\\uAAB1\\uAAB5-\\uAAB6\\uAAB9-\\uAABD\\uAAC0\\uAAC2\\uAADB-\\uAADC\\uAAE0-\\uAAEA\\uAAF2\\uAB01-\\uAB06\\uAB09-\\uAB0E\\uAB11-\\uAB16\\uAB20-\\uAB26\\uAB28-\\uAB2E\\uABC0-\\uABE2\\uAC00-\\uD7A3\\uD7B0-\\uD7C6\\uD7CB-\\uD7FB\\uF900-\\uFA6D\\uFA70-\\uFAD9\\uFB1D\\uFB1F-\\uFB28\\uFB2A-\\uFB36\\uFB38-\\uFB3C\\uFB3E\\uFB40-\\uFB41\\uFB43-\\uFB44\\uFB46-\\uFBB1\\uFBD3-\\uFD3D\\uFD50-\\uFD8F\\uFD92-\\uFDC7\\uFDF0-\\uFDFB\\uFE70-\\uFE74\\uFE76-\\uFEFC\\uFF66-\\uFF6F\\uFF71-\\uFF9D\\uFFA0-\\uFFBE\\uFFC2-\\uFFC7\\uFFCA-\\uFFCF\\uFFD2-\\uFFD7\\uFFDA-\\uFFDC]",
 				chars:      []rune{'ª', 'º', 'ƻ', 'ʔ', 'ە', 'ۿ', 'ܐ', 'ޱ', 'ऽ', 'ॐ', 'ল', 'ঽ', 'ৎ', 'ৼ', 'ਫ਼', 'ઽ', 'ૐ', 'ૹ', 'ଽ', 'ୱ', 'ஃ', 'ஜ', 'ௐ', 'ఽ', 'ಀ', 'ಽ', 'ೞ', 'ഽ', 'ൎ', 'ල', 'ຄ', 'ຊ', 'ຍ', 'ລ', 'ວ', 'ຽ', 'ༀ', 'ဿ', 'ၡ', 'ႎ', 'ቘ', 'ዀ', 'ៜ', 'ᢪ', '〆', '〼', 'ゟ', 'ヿ', 'ꙮ', 'ꞏ', 'ꟷ', 'ꣻ', 'ꩺ', 'ꪱ', 'ꫀ', 'ꫂ', 'ꫲ', 'יִ', 'מּ'},
 				ranges:     []rune{'ǀ', 'ǃ', 'א', 'ת', 'ׯ', 'ײ', 'ؠ', 'ؿ', 'ف', 'ي', 'ٮ', 'ٯ', 'ٱ', 'ۓ', 'ۮ', 'ۯ', 'ۺ', 'ۼ', 'ܒ', 'ܯ', 'ݍ', 'ޥ', 'ߊ', 'ߪ', 'ࠀ', 'ࠕ', 'ࡀ', 'ࡘ', 'ࡠ', 'ࡪ', 'ࢠ', 'ࢴ', 'ࢶ', 'ࢽ', 'ऄ', 'ह', 'क़', 'ॡ', 'ॲ', 'ঀ', 'অ', 'ঌ', 'এ', 'ঐ', 'ও', 'ন', 'প', 'র', 'শ', 'হ', 'ড়', 'ঢ়', 'য়', 'ৡ', 'ৰ', 'ৱ', 'ਅ', 'ਊ', 'ਏ', 'ਐ', 'ਓ', 'ਨ', 'ਪ', 'ਰ', 'ਲ', 'ਲ਼', 'ਵ', 'ਸ਼', 'ਸ', 'ਹ', 'ਖ਼', 'ੜ', 'ੲ', 'ੴ', 'અ', 'ઍ', 'એ', 'ઑ', 'ઓ', 'ન', 'પ', 'ર', 'લ', 'ળ', 'વ', 'હ', 'ૠ', 'ૡ', 'ଅ', 'ଌ', 'ଏ', 'ଐ', 'ଓ', 'ନ', 'ପ', 'ର', 'ଲ', 'ଳ', 'ଵ', 'ହ', 'ଡ଼', 'ଢ଼', 'ୟ', 'ୡ', 'அ', 'ஊ', 'எ', 'ஐ', 'ஒ', 'க', 'ங', 'ச', 'ஞ', 'ட', 'ண', 'த', 'ந', 'ப', 'ம', 'ஹ', 'అ', 'ఌ', 'ఎ', 'ఐ', 'ఒ', 'న', 'ప', 'హ', 'ౘ', 'ౚ', 'ౠ', 'ౡ', 'ಅ', 'ಌ', 'ಎ', 'ಐ', 'ಒ', 'ನ', 'ಪ', 'ಳ', 'ವ', 'ಹ', 'ೠ', 'ೡ', 'ೱ', 'ೲ', 'അ', 'ഌ', 'എ', 'ഐ', 'ഒ', 'ഺ', 'ൔ', 'ൖ', 'ൟ', 'ൡ', 'ൺ', 'ൿ', 'අ', 'ඖ', 'ක', 'න', 'ඳ', 'ර', 'ව', 'ෆ', 'ก', 'ะ', 'า', 'ำ', 'เ', 'ๅ', 'ກ', 'ຂ', 'ງ', 'ຈ', 'ດ', 'ທ', 'ນ', 'ຟ', 'ມ', 'ຣ', 'ສ', 'ຫ', 'ອ', 'ະ', 'າ', 'ຳ', 'ເ', 'ໄ', 'ໜ', 'ໟ', 'ཀ', 'ཇ', 'ཉ', 'ཬ', 'ྈ', 'ྌ', 'က', 'ဪ', 'ၐ', 'ၕ', 'ၚ', 'ၝ', 'ၥ', 'ၦ', 'ၮ', 'ၰ', 'ၵ', 'ႁ', 'ᄀ', 'ቈ', 'ቊ', 'ቍ', 'ቐ', 'ቖ', 'ቚ', 'ቝ', 'በ', 'ኈ', 'ኊ', 'ኍ', 'ነ', 'ኰ', 'ኲ', 'ኵ', 'ኸ', 'ኾ', 'ዂ', 'ዅ', 'ወ', 'ዖ', 'ዘ', 'ጐ', 'ጒ', 'ጕ', 'ጘ', 'ፚ', 'ᎀ', 'ᎏ', 'ᐁ', 'ᙬ', 'ᙯ', 'ᙿ', 'ᚁ', 'ᚚ', 'ᚠ', 'ᛪ', 'ᛱ', 'ᛸ', 'ᜀ', 'ᜌ', 'ᜎ', 'ᜑ', 'ᜠ', 'ᜱ', 'ᝀ', 'ᝑ', 'ᝠ', 'ᝬ', 'ᝮ', 'ᝰ', 'ក', 'ឳ', 'ᠠ', 'ᡂ', 'ᡄ', 'ᡸ', 'ᢀ', 'ᢄ', 'ᢇ', 'ᢨ', 'ᢰ', 'ᣵ', 'ᤀ', 'ᤞ', 'ᥐ', 'ᥭ', 'ᥰ', 'ᥴ', 'ᦀ', 'ᦫ', 'ᦰ', 'ᧉ', 'ᨀ', 'ᨖ', 'ᨠ', 'ᩔ', 'ᬅ', 'ᬳ', 'ᭅ', 'ᭋ', 'ᮃ', 'ᮠ', 'ᮮ', 'ᮯ', 'ᮺ', 'ᯥ', 'ᰀ', 'ᰣ', 'ᱍ', 'ᱏ', 'ᱚ', 'ᱷ', 'ᳩ', 'ᳬ', 'ᳮ', 'ᳱ', 'ᳵ', 'ᳶ', 'ℵ', 'ℸ', 'ⴰ', 'ⵧ', 'ⶀ', 'ⶖ', 'ⶠ', 'ⶦ', 'ⶨ', 'ⶮ', 'ⶰ', 'ⶶ', 'ⶸ', 'ⶾ', 'ⷀ', 'ⷆ', 'ⷈ', 'ⷎ', 'ⷐ', 'ⷖ', 'ⷘ', 'ⷞ', 'ぁ', 'ゖ', 'ァ', 'ヺ', 'ㄅ', 'ㄯ', 'ㄱ', 'ㆎ', 'ㆠ', 'ㆺ', 'ㇰ', 'ㇿ', '㐀', '䶵', '一', '鿯', 'ꀀ', 'ꀔ', 'ꀖ', 'ꒌ', 'ꓐ', 'ꓷ', 'ꔀ', 'ꘋ', 'ꘐ', 'ꘟ', 'ꘪ', 'ꘫ', 'ꚠ', 'ꛥ', 'ꟻ', 'ꠁ', 'ꠃ', 'ꠅ', 'ꠇ', 'ꠊ', 'ꠌ', 'ꠢ', 'ꡀ', 'ꡳ', 'ꢂ', 'ꢳ', 'ꣲ', 'ꣷ', 'ꣽ', 'ꣾ', 'ꤊ', 'ꤥ', 'ꤰ', 'ꥆ', 'ꥠ', 'ꥼ', 'ꦄ', 'ꦲ', 'ꧠ', 'ꧤ', 'ꧧ', 'ꧯ', 'ꧺ', 'ꧾ', 'ꨀ', 'ꨨ', 'ꩀ', 'ꩂ', 'ꩄ', 'ꩋ', 'ꩠ', 'ꩯ', 'ꩱ', 'ꩶ', 'ꩾ', 'ꪯ', 'ꪵ', 'ꪶ', 'ꪹ', 'ꪽ', 'ꫛ', 'ꫜ', 'ꫠ', 'ꫪ', 'ꬁ', 'ꬆ', 'ꬉ', 'ꬎ', 'ꬑ', 'ꬖ', 'ꬠ', 'ꬦ', 'ꬨ', 'ꬮ', 'ꯀ', 'ꯢ', '가', '힣', 'ힰ', 'ퟆ', 'ퟋ', 'ퟻ', '豈', '舘', '並', '龎', 'ײַ', 'ﬨ', 'שׁ', 'זּ', 'טּ', 'לּ', 'נּ', 'סּ', 'ףּ', 'פּ', 'צּ', 'ﮱ', 'ﯓ', 'ﴽ', 'ﵐ', 'ﶏ', 'ﶒ', 'ﷇ', 'ﷰ', 'ﷻ', 'ﹰ', 'ﹴ', 'ﹶ', 'ﻼ', 'ｦ', 'ｯ', 'ｱ', 'ﾝ', 'ﾠ', 'ﾾ', 'ￂ', 'ￇ', 'ￊ', 'ￏ', 'ￒ', 'ￗ', 'ￚ', 'ￜ'},
@@ -13374,9 +13776,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lt",
-			pos:  position{line: 1939, col: 1, offset: 53717},
+			pos:  position{line: 1989, col: 1, offset: 55107},
 			expr: &charClassMatcher{
-				pos:        position{line: 1939, col: 6, offset: 53722},
+				pos:        position{line: 1989, col: 6, offset: 55112},
 				val:        "[\\u01C5\\u01C8\\u01CB\\u01F2\\u1F88-\\u1F8F\\u1F98-\\u1F9F\\u1FA8-\\u1FAF\\u1FBC\\u1FCC\\u1FFC]",
 				chars:      []rune{'ǅ', 'ǈ', 'ǋ', 'ǲ', 'ᾼ', 'ῌ', 'ῼ'},
 				ranges:     []rune{'ᾈ', 'ᾏ', 'ᾘ', 'ᾟ', 'ᾨ', 'ᾯ'},
@@ -13388,9 +13790,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lu",
-			pos:  position{line: 1942, col: 1, offset: 53828},
+			pos:  position{line: 1992, col: 1, offset: 55218},
 			expr: &charClassMatcher{
-				pos:        position{line: 1942, col: 6, offset: 53833},
+				pos:        position{line: 1992, col: 6, offset: 55223},
 				val:        "[\\u0041-\\u005A\\u00C0-\\u00D6\\u00D8-\\u00DE\\u0100\\u0102\\u0104\\u0106\\u0108\\u010A\\u010C\\u010E\\u0110\\u0112\\u0114\\u0116\\u0118\\u011A\\u011C\\u011E\\u0120\\u0122\\u0124\\u0126\\u0128\\u012A\\u012C\\u012E\\u0130\\u0132\\u0134\\u0136\\u0139\\u013B\\u013D\\u013F\\u0141\\u0143\\u0145\\u0147\\u014A\\u014C\\u014E\\u0150\\u0152\\u0154\\u0156\\u0158\\u015A\\u015C\\u015E\\u0160\\u0162\\u0164\\u0166\\u0168\\u016A\\u016C\\u016E\\u0170\\u0172\\u0174\\u0176\\u0178-\\u0179\\u017B\\u017D\\u0181-\\u0182\\u0184\\u0186-\\u0187\\u0189-\\u018B\\u018E-\\u0191\\u0193-\\u0194\\u0196-\\u0198\\u019C-\\u019D\\u019F-\\u01A0\\u01A2\\u01A4\\u01A6-\\u01A7\\u01A9\\u01AC\\u01AE-\\u01AF\\u01B1-\\u01B3\\u01B5\\u01B7-\\u01B8\\u01BC\\u01C4\\u01C7\\u01CA\\u01CD\\u01CF\\u01D1\\u01D3\\u01D5\\u01D7\\u01D9\\u01DB\\u01DE\\u01E0\\u01E2\\u01E4\\u01E6\\u01E8\\u01EA\\u01EC\\u01EE\\u01F1\\u01F4\\u01F6-\\u01F8\\u01FA\\u01FC\\u01FE\\u0200\\u0202\\u0204\\u0206\\u0208\\u020A\\u020C\\u020E\\u0210\\u0212\\u0214\\u0216\\u0218\\u021A\\u021C\\u021E\\u0220\\u0222\\u0224\\u0226\\u0228\\u022A\\u022C\\u022E\\u0230\\u0232\\u023A-\\u023B\\u023D-\\u023E\\u0241\\u0243-\\u0246\\u0248\\u024A\\u024C\\u024E\\u0370\\u0372\\u0376\\u037F\\u0386\\u0388-\\u038A\\u038C\\u038E-\\u038F\\u0391-\\u03A1\\u03A3-\\u03AB\\u03CF\\u03D2-\\u03D4\\u03D8\\u03DA\\u03DC\\u03DE\\u03E0\\u03E2\\u03E4\\u03E6\\u03E8\\u03EA\\u03EC\\u03EE\\u03F4\\u03F7\\u03F9-\\u03FA\\u03FD-\\u042F\\u0460\\u0462\\u0464\\u0466\\u0468\\u046A\\u046C\\u046E\\u0470\\u0472\\u0474\\u0476\\u0478\\u047A\\u047C\\u047E\\u0480\\u048A\\u048C\\u048E\\u0490\\u0492\\u0494\\u0496\\u0498\\u049A\\u049C\\u049E\\u04A0\\u04A2\\u04A4\\u04A6\\u04A8\\u04AA\\u04AC\\u04AE\\u04B0\\u04B2\\u04B4\\u04B6\\u04B8\\u04BA\\u04BC\\u04BE\\u04C0-\\u04C1\\u04C3\\u04C5\\u04C7\\u04C9\\u04CB\\u04CD\\u04D0\\u04D2\\u04D4\\u04D6\\u04D8\\u04DA\\u04DC\\u04DE\\u04E0\\u04E2\\u04E4\\u04E6\\u04E8\\u04EA\\u04EC\\u04EE\\u04F0\\u04F2\\u04F4\\u04F6\\u04F8\\u04FA\\u04FC\\u04FE\\u0500\\u0502\\u0504\\u0506\\u0508\\u050A\\u050C\\u050E\\u0510\\u0512\\u0514\\u0516\\u0518\\u051A\\u051C\\u051E\\u0520\\u0522\\u0524\\u0526\\u0528\\u052A\\u052C\\u052E\\u0531-\\u0556\\u10A0-\\u10C5\\u10C7\\u10CD\\u13A0-\\u13F5\\u1C90-\\u1CBA\\u1CBD-\\u1CBF\\u1E00\\u1E02\\u1E04\\u1E06\\u1E08\\u1E0A\\u1E0C\\u1E0E\\u1E10\\u1E12\\u1E14\\u1E16\\u1E18\\u1E1A\\u1E1C\\u1E1E\\u1E20\\u1E22\\u1E24\\u1E26\\u1E28\\u1E2A\\u1E2C\\u1E2E\\u1E30\\u1E32\\u1E34\\u1E36\\u1E38\\u1E3A\\u1E3C\\u1E3E\\u1E40\\u1E42\\u1E44\\u1E46\\u1E48\\u1E4A\\u1E4C\\u1E4E\\u1E50\\u1E52\\u1E54\\u1E56\\u1E58\\u1E5A\\u1E5C\\u1E5E\\u1E60\\u1E62\\u1E64\\u1E66\\u1E68\\u1E6A\\u1E6C\\u1E6E\\u1E70\\u1E72\\u1E74\\u1E76\\u1E78\\u1E7A\\u1E7C\\u1E7E\\u1E80\\u1E82\\u1E84\\u1E86\\u1E88\\u1E8A\\u1E8C\\u1E8E\\u1E90\\u1E92\\u1E94\\u1E9E\\u1EA0\\u1EA2\\u1EA4\\u1EA6\\u1EA8\\u1EAA\\u1EAC\\u1EAE\\u1EB0\\u1EB2\\u1EB4\\u1EB6\\u1EB8\\u1EBA\\u1EBC\\u1EBE\\u1EC0\\u1EC2\\u1EC4\\u1EC6\\u1EC8\\u1ECA\\u1ECC\\u1ECE\\u1ED0\\u1ED2\\u1ED4\\u1ED6\\u1ED8\\u1EDA\\u1EDC\\u1EDE\\u1EE0\\u1EE2\\u1EE4\\u1EE6\\u1EE8\\u1EEA\\u1EEC\\u1EEE\\u1EF0\\u1EF2\\u1EF4\\u1EF6\\u1EF8\\u1EFA\\u1EFC\\u1EFE\\u1F08-\\u1F0F\\u1F18-\\u1F1D\\u1F28-\\u1F2F\\u1F38-\\u1F3F\\u1F48-\\u1F4D\\u1F59\\u1F5B\\u1F5D\\u1F5F\\u1F68-\\u1F6F\\u1FB8-\\u1FBB\\u1FC8-\\u1FCB\\u1FD8-\\u1FDB\\u1FE8-\\u1FEC\\u1FF8-\\u1FFB\\u2102\\u2107\\u210B-\\u210D\\u2110-\\u2112\\u2115\\u2119-\\u211D\\u2124\\u2126\\u2128\\u212A-\\u212D\\u2130-\\u2133\\u213E-\\u213F\\u2145\\u2183\\u2C00-\\u2C2E\\u2C60\\u2C62-\\u2C64\\u2C67\\u2C69\\u2C6B\\u2C6D-\\u2C70\\u2C72\\u2C75\\u2C7E-\\u2C80\\u2C82\\u2C84\\u2C86\\u2C88\\u2C8A\\u2C8C\\u2C8E\\u2C90\\u2C92\\u2C94\\u2C96\\u2C98\\u2C9A\\u2C9C\\u2C9E\\u2CA0\\u2CA2\\u2CA4\\u2CA6\\u2CA8\\u2CAA\\u2CAC\\u2CAE\\u2CB0\\u2CB2\\u2CB4\\u2CB6\\u2CB8\\u2CBA\\u2CBC\\u2CBE\\u2CC0\\u2CC2\\u2CC4\\u2CC6\\u2CC8\\u2CCA\\u2CCC\\u2CCE\\u2CD0\\u2CD2\\u2CD4\\u2CD6\\u2CD8\\u2CDA\\u2CDC\\u2CDE\\u2CE0\\u2CE2\\u2CEB\\u2CED\\u2CF2\\uA640\\uA642\\uA644\\uA646\\uA648\\uA64A\\uA64C\\uA64E\\uA650\\uA652\\uA654\\uA656\\uA658\\uA65A\\uA65C\\uA65E\\uA660\\uA662\\uA664\\uA666\\uA668\\uA66A\\uA66C\\uA680\\uA682\\uA684\\uA686\\uA688\\uA68A\\uA68C\\uA68E\\uA690\\uA692\\uA694\\uA696\\uA698\\uA69A\\uA722\\uA724\\uA726\\uA728\\uA72A\\uA72C\\uA72E\\uA732\\uA734\\uA736\\uA738\\uA73A\\uA73C\\uA73E\\uA740\\uA742\\uA744\\uA746\\uA748\\uA74A\\uA74C\\uA74E\\uA750\\uA752\\uA754\\uA756\\uA758\\uA75A\\uA75C\\uA75E\\uA760\\uA762\\uA764\\uA766\\uA768\\uA76A\\uA76C\\uA76E\\uA779\\uA77B\\uA77D-\\uA77E\\uA780\\uA782\\uA784\\uA786\\uA78B\\uA78D\\uA790\\uA792\\uA796\\uA798\\uA79A\\uA79C\\uA79E\\uA7A0\\uA7A2\\uA7A4\\uA7A6\\uA7A8\\uA7AA-\\uA7AE\\uA7B0-\\uA7B4\\uA7B6\\uA7B8\\uFF21-\\uFF3A]",
 				chars:      []rune{'Ā', 'Ă', 'Ą', 'Ć', 'Ĉ', 'Ċ', 'Č', 'Ď', 'Đ', 'Ē', 'Ĕ', 'Ė', 'Ę', 'Ě', 'Ĝ', 'Ğ', 'Ġ', 'Ģ', 'Ĥ', 'Ħ', 'Ĩ', 'Ī', 'Ĭ', 'Į', 'İ', 'Ĳ', 'Ĵ', 'Ķ', 'Ĺ', 'Ļ', 'Ľ', 'Ŀ', 'Ł', 'Ń', 'Ņ', 'Ň', 'Ŋ', 'Ō', 'Ŏ', 'Ő', 'Œ', 'Ŕ', 'Ŗ', 'Ř', 'Ś', 'Ŝ', 'Ş', 'Š', 'Ţ', 'Ť', 'Ŧ', 'Ũ', 'Ū', 'Ŭ', 'Ů', 'Ű', 'Ų', 'Ŵ', 'Ŷ', 'Ż', 'Ž', 'Ƅ', 'Ƣ', 'Ƥ', 'Ʃ', 'Ƭ', 'Ƶ', 'Ƽ', 'Ǆ', 'Ǉ', 'Ǌ', 'Ǎ', 'Ǐ', 'Ǒ', 'Ǔ', 'Ǖ', 'Ǘ', 'Ǚ', 'Ǜ', 'Ǟ', 'Ǡ', 'Ǣ', 'Ǥ', 'Ǧ', 'Ǩ', 'Ǫ', 'Ǭ', 'Ǯ', 'Ǳ', 'Ǵ', 'Ǻ', 'Ǽ', 'Ǿ', 'Ȁ', 'Ȃ', 'Ȅ', 'Ȇ', 'Ȉ', 'Ȋ', 'Ȍ', 'Ȏ', 'Ȑ', 'Ȓ', 'Ȕ', 'Ȗ', 'Ș', 'Ț', 'Ȝ', 'Ȟ', 'Ƞ', 'Ȣ', 'Ȥ', 'Ȧ', 'Ȩ', 'Ȫ', 'Ȭ', 'Ȯ', 'Ȱ', 'Ȳ', 'Ɂ', 'Ɉ', 'Ɋ', 'Ɍ', 'Ɏ', 'Ͱ', 'Ͳ', 'Ͷ', 'Ϳ', 'Ά', 'Ό', 'Ϗ', 'Ϙ', 'Ϛ', 'Ϝ', 'Ϟ', 'Ϡ', 'Ϣ', 'Ϥ', 'Ϧ', 'Ϩ', 'Ϫ', 'Ϭ', 'Ϯ', 'ϴ', 'Ϸ', 'Ѡ', 'Ѣ', 'Ѥ', 'Ѧ', 'Ѩ', 'Ѫ', 'Ѭ', 'Ѯ', 'Ѱ', 'Ѳ', 'Ѵ', 'Ѷ', 'Ѹ', 'Ѻ', 'Ѽ', 'Ѿ', 'Ҁ', 'Ҋ', 'Ҍ', 'Ҏ', 'Ґ', 'Ғ', 'Ҕ', 'Җ', 'Ҙ', 'Қ', 'Ҝ', 'Ҟ', 'Ҡ', 'Ң', 'Ҥ', 'Ҧ', 'Ҩ', 'Ҫ', 'Ҭ', 'Ү', 'Ұ', 'Ҳ', 'Ҵ', 'Ҷ', 'Ҹ', 'Һ', 'Ҽ', 'Ҿ', 'Ӄ', 'Ӆ', 'Ӈ', 'Ӊ', 'Ӌ', 'Ӎ', 'Ӑ', 'Ӓ', 'Ӕ', 'Ӗ', 'Ә', 'Ӛ', 'Ӝ', 'Ӟ', 'Ӡ', 'Ӣ', 'Ӥ', 'Ӧ', 'Ө', 'Ӫ', 'Ӭ', 'Ӯ', 'Ӱ', 'Ӳ', 'Ӵ', 'Ӷ', 'Ӹ', 'Ӻ', 'Ӽ', 'Ӿ', 'Ԁ', 'Ԃ', 'Ԅ', 'Ԇ', 'Ԉ', 'Ԋ', 'Ԍ', 'Ԏ', 'Ԑ', 'Ԓ', 'Ԕ', 'Ԗ', 'Ԙ', 'Ԛ', 'Ԝ', 'Ԟ', 'Ԡ', 'Ԣ', 'Ԥ', 'Ԧ', 'Ԩ', 'Ԫ', 'Ԭ', 'Ԯ', 'Ⴧ', 'Ⴭ', 'Ḁ', 'Ḃ', 'Ḅ', 'Ḇ', 'Ḉ', 'Ḋ', 'Ḍ', 'Ḏ', 'Ḑ', 'Ḓ', 'Ḕ', 'Ḗ', 'Ḙ', 'Ḛ', 'Ḝ', 'Ḟ', 'Ḡ', 'Ḣ', 'Ḥ', 'Ḧ', 'Ḩ', 'Ḫ', 'Ḭ', 'Ḯ', 'Ḱ', 'Ḳ', 'Ḵ', 'Ḷ', 'Ḹ', 'Ḻ', 'Ḽ', 'Ḿ', 'Ṁ', 'Ṃ', 'Ṅ', 'Ṇ', 'Ṉ', 'Ṋ', 'Ṍ', 'Ṏ', 'Ṑ', 'Ṓ', 'Ṕ', 'Ṗ', 'Ṙ', 'Ṛ', 'Ṝ', 'Ṟ', 'Ṡ', 'Ṣ', 'Ṥ', 'Ṧ', 'Ṩ', 'Ṫ', 'Ṭ', 'Ṯ', 'Ṱ', 'Ṳ', 'Ṵ', 'Ṷ', 'Ṹ', 'Ṻ', 'Ṽ', 'Ṿ', 'Ẁ', 'Ẃ', 'Ẅ', 'Ẇ', 'Ẉ', 'Ẋ', 'Ẍ', 'Ẏ', 'Ẑ', 'Ẓ', 'Ẕ', 'ẞ', 'Ạ', 'Ả', 'Ấ', 'Ầ', 'Ẩ', 'Ẫ', 'Ậ', 'Ắ', 'Ằ', 'Ẳ', 'Ẵ', 'Ặ', 'Ẹ', 'Ẻ', 'Ẽ', 'Ế', 'Ề', 'Ể', 'Ễ', 'Ệ', 'Ỉ', 'Ị', 'Ọ', 'Ỏ', 'Ố', 'Ồ', 'Ổ', 'Ỗ', 'Ộ', 'Ớ', 'Ờ', 'Ở', 'Ỡ', 'Ợ', 'Ụ', 'Ủ', 'Ứ', 'Ừ', 'Ử', 'Ữ', 'Ự', 'Ỳ', 'Ỵ', 'Ỷ', 'Ỹ', 'Ỻ', 'Ỽ', 'Ỿ', 'Ὑ', 'Ὓ', 'Ὕ', 'Ὗ', 'ℂ', 'ℇ', 'ℕ', 'ℤ', 'Ω', 'ℨ', 'ⅅ', 'Ↄ', 'Ⱡ', 'Ⱨ', 'Ⱪ', 'Ⱬ', 'Ⱳ', 'Ⱶ', 'Ⲃ', 'Ⲅ', 'Ⲇ', 'Ⲉ', 'Ⲋ', 'Ⲍ', 'Ⲏ', 'Ⲑ', 'Ⲓ', 'Ⲕ', 'Ⲗ', 'Ⲙ', 'Ⲛ', 'Ⲝ', 'Ⲟ', 'Ⲡ', 'Ⲣ', 'Ⲥ', 'Ⲧ', 'Ⲩ', 'Ⲫ', 'Ⲭ', 'Ⲯ', 'Ⲱ', 'Ⲳ', 'Ⲵ', 'Ⲷ', 'Ⲹ', 'Ⲻ', 'Ⲽ', 'Ⲿ', 'Ⳁ', 'Ⳃ', 'Ⳅ', 'Ⳇ', 'Ⳉ', 'Ⳋ', 'Ⳍ', 'Ⳏ', 'Ⳑ', 'Ⳓ', 'Ⳕ', 'Ⳗ', 'Ⳙ', 'Ⳛ', 'Ⳝ', 'Ⳟ', 'Ⳡ', 'Ⳣ', 'Ⳬ', 'Ⳮ', 'Ⳳ', 'Ꙁ', 'Ꙃ', 'Ꙅ', 'Ꙇ', 'Ꙉ', 'Ꙋ', 'Ꙍ', 'Ꙏ', 'Ꙑ', 'Ꙓ', 'Ꙕ', 'Ꙗ', 'Ꙙ', 'Ꙛ', 'Ꙝ', 'Ꙟ', 'Ꙡ', 'Ꙣ', 'Ꙥ', 'Ꙧ', 'Ꙩ', 'Ꙫ', 'Ꙭ', 'Ꚁ', 'Ꚃ', 'Ꚅ', 'Ꚇ', 'Ꚉ', 'Ꚋ', 'Ꚍ', 'Ꚏ', 'Ꚑ', 'Ꚓ', 'Ꚕ', 'Ꚗ', 'Ꚙ', 'Ꚛ', 'Ꜣ', 'Ꜥ', 'Ꜧ', 'Ꜩ', 'Ꜫ', 'Ꜭ', 'Ꜯ', 'Ꜳ', 'Ꜵ', 'Ꜷ', 'Ꜹ', 'Ꜻ', 'Ꜽ', 'Ꜿ', 'Ꝁ', 'Ꝃ', 'Ꝅ', 'Ꝇ', 'Ꝉ', 'Ꝋ', 'Ꝍ', 'Ꝏ', 'Ꝑ', 'Ꝓ', 'Ꝕ', 'Ꝗ', 'Ꝙ', 'Ꝛ', 'Ꝝ', 'Ꝟ', 'Ꝡ', 'Ꝣ', 'Ꝥ', 'Ꝧ', 'Ꝩ', 'Ꝫ', 'Ꝭ', 'Ꝯ', 'Ꝺ', 'Ꝼ', 'Ꞁ', 'Ꞃ', 'Ꞅ', 'Ꞇ', 'Ꞌ', 'Ɥ', 'Ꞑ', 'Ꞓ', 'Ꞗ', 'Ꞙ', 'Ꞛ', 'Ꞝ', 'Ꞟ', 'Ꞡ', 'Ꞣ', 'Ꞥ', 'Ꞧ', 'Ꞩ', 'Ꞷ', 'Ꞹ'},
 				ranges:     []rune{'A', 'Z', 'À', 'Ö', 'Ø', 'Þ', 'Ÿ', 'Ź', 'Ɓ', 'Ƃ', 'Ɔ', 'Ƈ', 'Ɖ', 'Ƌ', 'Ǝ', 'Ƒ', 'Ɠ', 'Ɣ', 'Ɩ', 'Ƙ', 'Ɯ', 'Ɲ', 'Ɵ', 'Ơ', 'Ʀ', 'Ƨ', 'Ʈ', 'Ư', 'Ʊ', 'Ƴ', 'Ʒ', 'Ƹ', 'Ƕ', 'Ǹ', 'Ⱥ', 'Ȼ', 'Ƚ', 'Ⱦ', 'Ƀ', 'Ɇ', 'Έ', 'Ί', 'Ύ', 'Ώ', 'Α', 'Ρ', 'Σ', 'Ϋ', 'ϒ', 'ϔ', 'Ϲ', 'Ϻ', 'Ͻ', 'Я', 'Ӏ', 'Ӂ', 'Ա', 'Ֆ', 'Ⴀ', 'Ⴥ', 'Ꭰ', 'Ᏽ', 'Ა', 'Ჺ', 'Ჽ', 'Ჿ', 'Ἀ', 'Ἇ', 'Ἐ', 'Ἕ', 'Ἠ', 'Ἧ', 'Ἰ', 'Ἷ', 'Ὀ', 'Ὅ', 'Ὠ', 'Ὧ', 'Ᾰ', 'Ά', 'Ὲ', 'Ή', 'Ῐ', 'Ί', 'Ῠ', 'Ῥ', 'Ὸ', 'Ώ', 'ℋ', 'ℍ', 'ℐ', 'ℒ', 'ℙ', 'ℝ', 'K', 'ℭ', 'ℰ', 'ℳ', 'ℾ', 'ℿ', 'Ⰰ', 'Ⱞ', 'Ɫ', 'Ɽ', 'Ɑ', 'Ɒ', 'Ȿ', 'Ⲁ', 'Ᵹ', 'Ꝿ', 'Ɦ', 'Ɪ', 'Ʞ', 'Ꞵ', 'Ａ', 'Ｚ'},
@@ -13402,9 +13804,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mc",
-			pos:  position{line: 1945, col: 1, offset: 57834},
+			pos:  position{line: 1995, col: 1, offset: 59224},
 			expr: &charClassMatcher{
-				pos:        position{line: 1945, col: 6, offset: 57839},
+				pos:        position{line: 1995, col: 6, offset: 59229},
 				val:        "[\\u0903\\u093B\\u093E-\\u0940\\u0949-\\u094C\\u094E-\\u094F\\u0982-\\u0983\\u09BE-\\u09C0\\u09C7-\\u09C8\\u09CB-\\u09CC\\u09D7\\u0A03\\u0A3E-\\u0A40\\u0A83\\u0ABE-\\u0AC0\\u0AC9\\u0ACB-\\u0ACC\\u0B02-\\u0B03\\u0B3E\\u0B40\\u0B47-\\u0B48\\u0B4B-\\u0B4C\\u0B57\\u0BBE-\\u0BBF\\u0BC1-\\u0BC2\\u0BC6-\\u0BC8\\u0BCA-\\u0BCC\\u0BD7\\u0C01-\\u0C03\\u0C41-\\u0C44\\u0C82-\\u0C83\\u0CBE\\u0CC0-\\u0CC4\\u0CC7-\\u0CC8\\u0CCA-\\u0CCB\\u0CD5-\\u0CD6\\u0D02-\\u0D03\\u0D3E-\\u0D40\\u0D46-\\u0D48\\u0D4A-\\u0D4C\\u0D57\\u0D82-\\u0D83\\u0DCF-\\u0DD1\\u0DD8-\\u0DDF\\u0DF2-\\u0DF3\\u0F3E-\\u0F3F\\u0F7F\\u102B-\\u102C\\u1031\\u1038\\u103B-\\u103C\\u1056-\\u1057\\u1062-\\u1064\\u1067-\\u106D\\u1083-\\u1084\\u1087-\\u108C\\u108F\\u109A-\\u109C\\u17B6\\u17BE-\\u17C5\\u17C7-\\u17C8\\u1923-\\u1926\\u1929-\\u192B\\u1930-\\u1931\\u1933-\\u1938\\u1A19-\\u1A1A\\u1A55\\u1A57\\u1A61\\u1A63-\\u1A64\\u1A6D-\\u1A72\\u1B04\\u1B35\\u1B3B\\u1B3D-\\u1B41\\u1B43-\\u1B44\\u1B82\\u1BA1\\u1BA6-\\u1BA7\\u1BAA\\u1BE7\\u1BEA-\\u1BEC\\u1BEE\\u1BF2-\\u1BF3\\u1C24-\\u1C2B\\u1C34-\\u1C35\\u1CE1\\u1CF2-\\u1CF3\\u1CF7\\u302E-\\u302F\\uA823-\\uA824\\uA827\\uA880-\\uA881\\uA8B4-\\uA8C3\\uA952-\\uA953\\uA983\\uA9B4-\\uA9B5\\uA9BA-\\uA9BB\\uA9BD-\\uA9C0\\uAA2F-\\uAA30\\uAA33-\\uAA34\\uAA4D\\uAA7B\\uAA7D\\uAAEB\\uAAEE-\\uAAEF\\uAAF5\\uABE3-\\uABE4\\uABE6-\\uABE7\\uABE9-\\uABEA\\uABEC]",
 				chars:      []rune{'ः', 'ऻ', 'ৗ', 'ਃ', 'ઃ', 'ૉ', 'ା', 'ୀ', 'ୗ', 'ௗ', 'ಾ', 'ൗ', 'ཿ', 'ေ', 'း', 'ႏ', 'ា', 'ᩕ', 'ᩗ', 'ᩡ', 'ᬄ', 'ᬵ', 'ᬻ', 'ᮂ', 'ᮡ', '᮪', 'ᯧ', 'ᯮ', '᳡', '᳷', 'ꠧ', 'ꦃ', 'ꩍ', 'ꩻ', 'ꩽ', 'ꫫ', 'ꫵ', '꯬'},
 				ranges:     []rune{'ा', 'ी', 'ॉ', 'ौ', 'ॎ', 'ॏ', 'ং', 'ঃ', 'া', 'ী', 'ে', 'ৈ', 'ো', 'ৌ', 'ਾ', 'ੀ', 'ા', 'ી', 'ો', 'ૌ', 'ଂ', 'ଃ', 'େ', 'ୈ', 'ୋ', 'ୌ', 'ா', 'ி', 'ு', 'ூ', 'ெ', 'ை', 'ொ', 'ௌ', 'ఁ', 'ః', 'ు', 'ౄ', 'ಂ', 'ಃ', 'ೀ', 'ೄ', 'ೇ', 'ೈ', 'ೊ', 'ೋ', 'ೕ', 'ೖ', 'ം', 'ഃ', 'ാ', 'ീ', 'െ', 'ൈ', 'ൊ', 'ൌ', 'ං', 'ඃ', 'ා', 'ෑ', 'ෘ', 'ෟ', 'ෲ', 'ෳ', '༾', '༿', 'ါ', 'ာ', 'ျ', 'ြ', 'ၖ', 'ၗ', 'ၢ', 'ၤ', 'ၧ', 'ၭ', 'ႃ', 'ႄ', 'ႇ', 'ႌ', 'ႚ', 'ႜ', 'ើ', 'ៅ', 'ះ', 'ៈ', 'ᤣ', 'ᤦ', 'ᤩ', 'ᤫ', 'ᤰ', 'ᤱ', 'ᤳ', 'ᤸ', 'ᨙ', 'ᨚ', 'ᩣ', 'ᩤ', 'ᩭ', 'ᩲ', 'ᬽ', 'ᭁ', 'ᭃ', '᭄', 'ᮦ', 'ᮧ', 'ᯪ', 'ᯬ', '᯲', '᯳', 'ᰤ', 'ᰫ', 'ᰴ', 'ᰵ', 'ᳲ', 'ᳳ', '〮', '〯', 'ꠣ', 'ꠤ', 'ꢀ', 'ꢁ', 'ꢴ', 'ꣃ', 'ꥒ', '꥓', 'ꦴ', 'ꦵ', 'ꦺ', 'ꦻ', 'ꦽ', '꧀', 'ꨯ', 'ꨰ', 'ꨳ', 'ꨴ', 'ꫮ', 'ꫯ', 'ꯣ', 'ꯤ', 'ꯦ', 'ꯧ', 'ꯩ', 'ꯪ'},
@@ -13416,9 +13818,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mn",
-			pos:  position{line: 1948, col: 1, offset: 59027},
+			pos:  position{line: 1998, col: 1, offset: 60417},
 			expr: &charClassMatcher{
-				pos:        position{line: 1948, col: 6, offset: 59032},
+				pos:        position{line: 1998, col: 6, offset: 60422},
 				val:        "[\\u0300-\\u036F\\u0483-\\u0487\\u0591-\\u05BD\\u05BF\\u05C1-\\u05C2\\u05C4-\\u05C5\\u05C7\\u0610-\\u061A\\u064B-\\u065F\\u0670\\u06D6-\\u06DC\\u06DF-\\u06E4\\u06E7-\\u06E8\\u06EA-\\u06ED\\u0711\\u0730-\\u074A\\u07A6-\\u07B0\\u07EB-\\u07F3\\u07FD\\u0816-\\u0819\\u081B-\\u0823\\u0825-\\u0827\\u0829-\\u082D\\u0859-\\u085B\\u08D3-\\u08E1\\u08E3-\\u0902\\u093A\\u093C\\u0941-\\u0948\\u094D\\u0951-\\u0957\\u0962-\\u0963\\u0981\\u09BC\\u09C1-\\u09C4\\u09CD\\u09E2-\\u09E3\\u09FE\\u0A01-\\u0A02\\u0A3C\\u0A41-\\u0A42\\u0A47-\\u0A48\\u0A4B-\\u0A4D\\u0A51\\u0A70-\\u0A71\\u0A75\\u0A81-\\u0A82\\u0ABC\\u0AC1-\\u0AC5\\u0AC7-\\u0AC8\\u0ACD\\u0AE2-\\u0AE3\\u0AFA-\\u0AFF\\u0B01\\u0B3C\\u0B3F\\u0B41-\\u0B44\\u0B4D\\u0B56\\u0B62-\\u0B63\\u0B82\\u0BC0\\u0BCD\\u0C00\\u0C04\\u0C3E-\\u0C40\\u0C46-\\u0C48\\u0C4A-\\u0C4D\\u0C55-\\u0C56\\u0C62-\\u0C63\\u0C81\\u0CBC\\u0CBF\\u0CC6\\u0CCC-\\u0CCD\\u0CE2-\\u0CE3\\u0D00-\\u0D01\\u0D3B-\\u0D3C\\u0D41-\\u0D44\\u0D4D\\u0D62-\\u0D63\\u0DCA\\u0DD2-\\u0DD4\\u0DD6\\u0E31\\u0E34-\\u0E3A\\u0E47-\\u0E4E\\u0EB1\\u0EB4-\\u0EB9\\u0EBB-\\u0EBC\\u0EC8-\\u0ECD\\u0F18-\\u0F19\\u0F35\\u0F37\\u0F39\\u0F71-\\u0F7E\\u0F80-\\u0F84\\u0F86-\\u0F87\\u0F8D-\\u0F97\\u0F99-\\u0FBC\\u0FC6\\u102D-\\u1030\\u1032-\\u1037\\u1039-\\u103A\\u103D-\\u103E\\u1058-\\u1059\\u105E-\\u1060\\u1071-\\u1074\\u1082\\u1085-\\u1086\\u108D\\u109D\\u135D-\\u135F\\u1712-\\u1714\\u1732-\\u1734\\u1752-\\u1753\\u1772-\\u1773\\u17B4-\\u17B5\\u17B7-\\u17BD\\u17C6\\u17C9-\\u17D3\\u17DD\\u180B-\\u180D\\u1885-\\u1886\\u18A9\\u1920-\\u1922\\u1927-\\u1928\\u1932\\u1939-\\u193B\\u1A17-\\u1A18\\u1A1B\\u1A56\\u1A58-\\u1A5E\\u1A60\\u1A62\\u1A65-\\u1A6C\\u1A73-\\u1A7C\\u1A7F\\u1AB0-\\u1ABD\\u1B00-\\u1B03\\u1B34\\u1B36-\\u1B3A\\u1B3C\\u1B42\\u1B6B-\\u1B73\\u1B80-\\u1B81\\u1BA2-\\u1BA5\\u1BA8-\\u1BA9\\u1BAB-\\u1BAD\\u1BE6\\u1BE8-\\u1BE9\\u1BED\\u1BEF-\\u1BF1\\u1C2C-\\u1C33\\u1C36-\\u1C37\\u1CD0-\\u1CD2\\u1CD4-\\u1CE0\\u1CE2-\\u1CE8\\u1CED\\u1CF4\\u1CF8-\\u1CF9\\u1DC0-\\u1DF9\\u1DFB-\\u1DFF\\u20D0-\\u20DC\\u20E1\\u20E5-\\u20F0\\u2CEF-\\u2CF1\\u2D7F\\u2DE0-\\u2DFF\\u302A-\\u302D\\u3099-\\u309A\\uA66F\\uA674-\\uA67D\\uA69E-\\uA69F\\uA6F0-\\uA6F1\\uA802\\uA806\\uA80B\\uA825-\\uA826\\uA8C4-\\uA8C5\\uA8E0-\\uA8F1\\uA8FF\\uA926-\\uA92D\\uA947-\\uA951\\uA980-\\uA982\\uA9B3\\uA9B6-\\uA9B9\\uA9BC\\uA9E5\\uAA29-\\uAA2E\\uAA31-\\uAA32\\uAA35-\\uAA36\\uAA43\\uAA4C\\uAA7C\\uAAB0\\uAAB2-\\uAAB4\\uAAB7-\\uAAB8\\uAABE-\\uAABF\\uAAC1\\uAAEC-\\uAAED\\uAAF6\\uABE5\\uABE8\\uABED\\uFB1E\\uFE00-\\uFE0F\\uFE20-\\uFE2F]",
 				chars:      []rune{'ֿ', 'ׇ', 'ٰ', 'ܑ', '߽', 'ऺ', '़', '्', 'ঁ', '়', '্', '৾', '਼', 'ੑ', 'ੵ', '઼', '્', 'ଁ', '଼', 'ି', '୍', 'ୖ', 'ஂ', 'ீ', '்', 'ఀ', 'ఄ', 'ಁ', '಼', 'ಿ', 'ೆ', '്', '්', 'ූ', 'ั', 'ັ', '༵', '༷', '༹', '࿆', 'ႂ', 'ႍ', 'ႝ', 'ំ', '៝', 'ᢩ', 'ᤲ', 'ᨛ', 'ᩖ', '᩠', 'ᩢ', '᩿', '᬴', 'ᬼ', 'ᭂ', '᯦', 'ᯭ', '᳭', '᳴', '⃡', '⵿', '꙯', 'ꠂ', '꠆', 'ꠋ', 'ꣿ', '꦳', 'ꦼ', 'ꧥ', 'ꩃ', 'ꩌ', 'ꩼ', 'ꪰ', '꫁', '꫶', 'ꯥ', 'ꯨ', '꯭', 'ﬞ'},
 				ranges:     []rune{'̀', 'ͯ', '҃', '҇', '֑', 'ֽ', 'ׁ', 'ׂ', 'ׄ', 'ׅ', 'ؐ', 'ؚ', 'ً', 'ٟ', 'ۖ', 'ۜ', '۟', 'ۤ', 'ۧ', 'ۨ', '۪', 'ۭ', 'ܰ', '݊', 'ަ', 'ް', '߫', '߳', 'ࠖ', '࠙', 'ࠛ', 'ࠣ', 'ࠥ', 'ࠧ', 'ࠩ', '࠭', '࡙', '࡛', '࣓', '࣡', 'ࣣ', 'ं', 'ु', 'ै', '॑', 'ॗ', 'ॢ', 'ॣ', 'ু', 'ৄ', 'ৢ', 'ৣ', 'ਁ', 'ਂ', 'ੁ', 'ੂ', 'ੇ', 'ੈ', 'ੋ', '੍', 'ੰ', 'ੱ', 'ઁ', 'ં', 'ુ', 'ૅ', 'ે', 'ૈ', 'ૢ', 'ૣ', 'ૺ', '૿', 'ୁ', 'ୄ', 'ୢ', 'ୣ', 'ా', 'ీ', 'ె', 'ై', 'ొ', '్', 'ౕ', 'ౖ', 'ౢ', 'ౣ', 'ೌ', '್', 'ೢ', 'ೣ', 'ഀ', 'ഁ', '഻', '഼', 'ു', 'ൄ', 'ൢ', 'ൣ', 'ි', 'ු', 'ิ', 'ฺ', '็', '๎', 'ິ', 'ູ', 'ົ', 'ຼ', '່', 'ໍ', '༘', '༙', 'ཱ', 'ཾ', 'ྀ', '྄', '྆', '྇', 'ྍ', 'ྗ', 'ྙ', 'ྼ', 'ိ', 'ူ', 'ဲ', '့', '္', '်', 'ွ', 'ှ', 'ၘ', 'ၙ', 'ၞ', 'ၠ', 'ၱ', 'ၴ', 'ႅ', 'ႆ', '፝', '፟', 'ᜒ', '᜔', 'ᜲ', '᜴', 'ᝒ', 'ᝓ', 'ᝲ', 'ᝳ', '឴', '឵', 'ិ', 'ួ', '៉', '៓', '᠋', '᠍', 'ᢅ', 'ᢆ', 'ᤠ', 'ᤢ', 'ᤧ', 'ᤨ', '᤹', '᤻', 'ᨗ', 'ᨘ', 'ᩘ', 'ᩞ', 'ᩥ', 'ᩬ', 'ᩳ', '᩼', '᪰', '᪽', 'ᬀ', 'ᬃ', 'ᬶ', 'ᬺ', '᭫', '᭳', 'ᮀ', 'ᮁ', 'ᮢ', 'ᮥ', 'ᮨ', 'ᮩ', '᮫', 'ᮭ', 'ᯨ', 'ᯩ', 'ᯯ', 'ᯱ', 'ᰬ', 'ᰳ', 'ᰶ', '᰷', '᳐', '᳒', '᳔', '᳠', '᳢', '᳨', '᳸', '᳹', '᷀', '᷹', '᷻', '᷿', '⃐', '⃜', '⃥', '⃰', '⳯', '⳱', 'ⷠ', 'ⷿ', '〪', '〭', '゙', '゚', 'ꙴ', '꙽', 'ꚞ', 'ꚟ', '꛰', '꛱', 'ꠥ', 'ꠦ', '꣄', 'ꣅ', '꣠', '꣱', 'ꤦ', '꤭', 'ꥇ', 'ꥑ', 'ꦀ', 'ꦂ', 'ꦶ', 'ꦹ', 'ꨩ', 'ꨮ', 'ꨱ', 'ꨲ', 'ꨵ', 'ꨶ', 'ꪲ', 'ꪴ', 'ꪷ', 'ꪸ', 'ꪾ', '꪿', 'ꫬ', 'ꫭ', '︀', '️', '︠', '︯'},
@@ -13430,9 +13832,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nd",
-			pos:  position{line: 1951, col: 1, offset: 61212},
+			pos:  position{line: 2001, col: 1, offset: 62602},
 			expr: &charClassMatcher{
-				pos:        position{line: 1951, col: 6, offset: 61217},
+				pos:        position{line: 2001, col: 6, offset: 62607},
 				val:        "[\\u0030-\\u0039\\u0660-\\u0669\\u06F0-\\u06F9\\u07C0-\\u07C9\\u0966-\\u096F\\u09E6-\\u09EF\\u0A66-\\u0A6F\\u0AE6-\\u0AEF\\u0B66-\\u0B6F\\u0BE6-\\u0BEF\\u0C66-\\u0C6F\\u0CE6-\\u0CEF\\u0D66-\\u0D6F\\u0DE6-\\u0DEF\\u0E50-\\u0E59\\u0ED0-\\u0ED9\\u0F20-\\u0F29\\u1040-\\u1049\\u1090-\\u1099\\u17E0-\\u17E9\\u1810-\\u1819\\u1946-\\u194F\\u19D0-\\u19D9\\u1A80-\\u1A89\\u1A90-\\u1A99\\u1B50-\\u1B59\\u1BB0-\\u1BB9\\u1C40-\\u1C49\\u1C50-\\u1C59\\uA620-\\uA629\\uA8D0-\\uA8D9\\uA900-\\uA909\\uA9D0-\\uA9D9\\uA9F0-\\uA9F9\\uAA50-\\uAA59\\uABF0-\\uABF9\\uFF10-\\uFF19]",
 				ranges:     []rune{'0', '9', '٠', '٩', '۰', '۹', '߀', '߉', '०', '९', '০', '৯', '੦', '੯', '૦', '૯', '୦', '୯', '௦', '௯', '౦', '౯', '೦', '೯', '൦', '൯', '෦', '෯', '๐', '๙', '໐', '໙', '༠', '༩', '၀', '၉', '႐', '႙', '០', '៩', '᠐', '᠙', '᥆', '᥏', '᧐', '᧙', '᪀', '᪉', '᪐', '᪙', '᭐', '᭙', '᮰', '᮹', '᱀', '᱉', '᱐', '᱙', '꘠', '꘩', '꣐', '꣙', '꤀', '꤉', '꧐', '꧙', '꧰', '꧹', '꩐', '꩙', '꯰', '꯹', '０', '９'},
 				ignoreCase: false,
@@ -13443,9 +13845,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nl",
-			pos:  position{line: 1954, col: 1, offset: 61720},
+			pos:  position{line: 2004, col: 1, offset: 63110},
 			expr: &charClassMatcher{
-				pos:        position{line: 1954, col: 6, offset: 61725},
+				pos:        position{line: 2004, col: 6, offset: 63115},
 				val:        "[\\u16EE-\\u16F0\\u2160-\\u2182\\u2185-\\u2188\\u3007\\u3021-\\u3029\\u3038-\\u303A\\uA6E6-\\uA6EF]",
 				chars:      []rune{'〇'},
 				ranges:     []rune{'ᛮ', 'ᛰ', 'Ⅰ', 'ↂ', 'ↅ', 'ↈ', '〡', '〩', '〸', '〺', 'ꛦ', 'ꛯ'},
@@ -13457,9 +13859,9 @@ var g = &grammar{
 		},
 		{
 			name: "Pc",
-			pos:  position{line: 1957, col: 1, offset: 61839},
+			pos:  position{line: 2007, col: 1, offset: 63229},
 			expr: &charClassMatcher{
-				pos:        position{line: 1957, col: 6, offset: 61844},
+				pos:        position{line: 2007, col: 6, offset: 63234},
 				val:        "[\\u005F\\u203F-\\u2040\\u2054\\uFE33-\\uFE34\\uFE4D-\\uFE4F\\uFF3F]",
 				chars:      []rune{'_', '⁔', '＿'},
 				ranges:     []rune{'‿', '⁀', '︳', '︴', '﹍', '﹏'},
@@ -13471,9 +13873,9 @@ var g = &grammar{
 		},
 		{
 			name: "Zs",
-			pos:  position{line: 1960, col: 1, offset: 61925},
+			pos:  position{line: 2010, col: 1, offset: 63315},
 			expr: &charClassMatcher{
-				pos:        position{line: 1960, col: 6, offset: 61930},
+				pos:        position{line: 2010, col: 6, offset: 63320},
 				val:        "[\\u0020\\u00A0\\u1680\\u2000-\\u200A\\u202F\\u205F\\u3000]",
 				chars:      []rune{' ', '\u00a0', '\u1680', '\u202f', '\u205f', '\u3000'},
 				ranges:     []rune{'\u2000', '\u200a'},
@@ -13485,9 +13887,9 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1962, col: 1, offset: 61983},
+			pos:  position{line: 2012, col: 1, offset: 63373},
 			expr: &anyMatcher{
-				line: 1963, col: 5, offset: 62003,
+				line: 2013, col: 5, offset: 63393,
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -13495,48 +13897,48 @@ var g = &grammar{
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1965, col: 1, offset: 62006},
+			pos:         position{line: 2015, col: 1, offset: 63396},
 			expr: &choiceExpr{
-				pos: position{line: 1966, col: 5, offset: 62034},
+				pos: position{line: 2016, col: 5, offset: 63424},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1966, col: 5, offset: 62034},
+						pos:        position{line: 2016, col: 5, offset: 63424},
 						val:        "\t",
 						ignoreCase: false,
 						want:       "\"\\t\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1967, col: 5, offset: 62043},
+						pos:        position{line: 2017, col: 5, offset: 63433},
 						val:        "\v",
 						ignoreCase: false,
 						want:       "\"\\v\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1968, col: 5, offset: 62052},
+						pos:        position{line: 2018, col: 5, offset: 63442},
 						val:        "\f",
 						ignoreCase: false,
 						want:       "\"\\f\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1969, col: 5, offset: 62061},
+						pos:        position{line: 2019, col: 5, offset: 63451},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&litMatcher{
-						pos:        position{line: 1970, col: 5, offset: 62069},
+						pos:        position{line: 2020, col: 5, offset: 63459},
 						val:        "\u00a0",
 						ignoreCase: false,
 						want:       "\"\\u00a0\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1971, col: 5, offset: 62082},
+						pos:        position{line: 2021, col: 5, offset: 63472},
 						val:        "\ufeff",
 						ignoreCase: false,
 						want:       "\"\\ufeff\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1972, col: 5, offset: 62095},
+						pos:  position{line: 2022, col: 5, offset: 63485},
 						name: "Zs",
 					},
 				},
@@ -13546,9 +13948,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1974, col: 1, offset: 62099},
+			pos:  position{line: 2024, col: 1, offset: 63489},
 			expr: &charClassMatcher{
-				pos:        position{line: 1975, col: 5, offset: 62118},
+				pos:        position{line: 2025, col: 5, offset: 63508},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -13560,16 +13962,16 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1977, col: 1, offset: 62138},
+			pos:         position{line: 2027, col: 1, offset: 63528},
 			expr: &choiceExpr{
-				pos: position{line: 1978, col: 5, offset: 62160},
+				pos: position{line: 2028, col: 5, offset: 63550},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1978, col: 5, offset: 62160},
+						pos:  position{line: 2028, col: 5, offset: 63550},
 						name: "MultiLineComment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1979, col: 5, offset: 62181},
+						pos:  position{line: 2029, col: 5, offset: 63571},
 						name: "SingleLineComment",
 					},
 				},
@@ -13579,39 +13981,39 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1981, col: 1, offset: 62200},
+			pos:  position{line: 2031, col: 1, offset: 63590},
 			expr: &seqExpr{
-				pos: position{line: 1982, col: 5, offset: 62221},
+				pos: position{line: 2032, col: 5, offset: 63611},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1982, col: 5, offset: 62221},
+						pos:        position{line: 2032, col: 5, offset: 63611},
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1982, col: 10, offset: 62226},
+						pos: position{line: 2032, col: 10, offset: 63616},
 						expr: &seqExpr{
-							pos: position{line: 1982, col: 11, offset: 62227},
+							pos: position{line: 2032, col: 11, offset: 63617},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1982, col: 11, offset: 62227},
+									pos: position{line: 2032, col: 11, offset: 63617},
 									expr: &litMatcher{
-										pos:        position{line: 1982, col: 12, offset: 62228},
+										pos:        position{line: 2032, col: 12, offset: 63618},
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1982, col: 17, offset: 62233},
+									pos:  position{line: 2032, col: 17, offset: 63623},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1982, col: 35, offset: 62251},
+						pos:        position{line: 2032, col: 35, offset: 63641},
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -13623,30 +14025,30 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1984, col: 1, offset: 62257},
+			pos:  position{line: 2034, col: 1, offset: 63647},
 			expr: &seqExpr{
-				pos: position{line: 1985, col: 5, offset: 62279},
+				pos: position{line: 2035, col: 5, offset: 63669},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1985, col: 5, offset: 62279},
+						pos:        position{line: 2035, col: 5, offset: 63669},
 						val:        "--",
 						ignoreCase: false,
 						want:       "\"--\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1985, col: 10, offset: 62284},
+						pos: position{line: 2035, col: 10, offset: 63674},
 						expr: &seqExpr{
-							pos: position{line: 1985, col: 11, offset: 62285},
+							pos: position{line: 2035, col: 11, offset: 63675},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1985, col: 11, offset: 62285},
+									pos: position{line: 2035, col: 11, offset: 63675},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1985, col: 12, offset: 62286},
+										pos:  position{line: 2035, col: 12, offset: 63676},
 										name: "LineTerminator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1985, col: 27, offset: 62301},
+									pos:  position{line: 2035, col: 27, offset: 63691},
 									name: "SourceCharacter",
 								},
 							},
@@ -13659,19 +14061,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1987, col: 1, offset: 62320},
+			pos:  position{line: 2037, col: 1, offset: 63710},
 			expr: &seqExpr{
-				pos: position{line: 1987, col: 7, offset: 62326},
+				pos: position{line: 2037, col: 7, offset: 63716},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1987, col: 7, offset: 62326},
+						pos: position{line: 2037, col: 7, offset: 63716},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1987, col: 7, offset: 62326},
+							pos:  position{line: 2037, col: 7, offset: 63716},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1987, col: 19, offset: 62338},
+						pos:  position{line: 2037, col: 19, offset: 63728},
 						name: "LineTerminator",
 					},
 				},
@@ -13681,16 +14083,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1989, col: 1, offset: 62354},
+			pos:  position{line: 2039, col: 1, offset: 63744},
 			expr: &choiceExpr{
-				pos: position{line: 1989, col: 7, offset: 62360},
+				pos: position{line: 2039, col: 7, offset: 63750},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1989, col: 7, offset: 62360},
+						pos:  position{line: 2039, col: 7, offset: 63750},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1989, col: 11, offset: 62364},
+						pos:  position{line: 2039, col: 11, offset: 63754},
 						name: "EOF",
 					},
 				},
@@ -13700,11 +14102,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1991, col: 1, offset: 62369},
+			pos:  position{line: 2041, col: 1, offset: 63759},
 			expr: &notExpr{
-				pos: position{line: 1991, col: 7, offset: 62375},
+				pos: position{line: 2041, col: 7, offset: 63765},
 				expr: &anyMatcher{
-					line: 1991, col: 8, offset: 62376,
+					line: 2041, col: 8, offset: 63766,
 				},
 			},
 			leader:        false,
@@ -13712,15 +14114,15 @@ var g = &grammar{
 		},
 		{
 			name: "SQLPipe",
-			pos:  position{line: 1995, col: 1, offset: 62401},
+			pos:  position{line: 2045, col: 1, offset: 63791},
 			expr: &actionExpr{
-				pos: position{line: 1996, col: 5, offset: 62413},
+				pos: position{line: 2046, col: 5, offset: 63803},
 				run: (*parser).callonSQLPipe1,
 				expr: &labeledExpr{
-					pos:   position{line: 1996, col: 5, offset: 62413},
+					pos:   position{line: 2046, col: 5, offset: 63803},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1996, col: 7, offset: 62415},
+						pos:  position{line: 2046, col: 7, offset: 63805},
 						name: "Seq",
 					},
 				},
@@ -13730,15 +14132,15 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOp",
-			pos:  position{line: 2004, col: 1, offset: 62562},
+			pos:  position{line: 2054, col: 1, offset: 63952},
 			expr: &actionExpr{
-				pos: position{line: 2005, col: 5, offset: 62572},
+				pos: position{line: 2055, col: 5, offset: 63962},
 				run: (*parser).callonSQLOp1,
 				expr: &labeledExpr{
-					pos:   position{line: 2005, col: 5, offset: 62572},
+					pos:   position{line: 2055, col: 5, offset: 63962},
 					label: "query",
 					expr: &ruleRefExpr{
-						pos:  position{line: 2005, col: 11, offset: 62578},
+						pos:  position{line: 2055, col: 11, offset: 63968},
 						name: "SQLQuery",
 					},
 				},
@@ -13747,88 +14149,109 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "SQLQuery",
-			pos:  position{line: 2013, col: 1, offset: 62715},
+			name: "MergeIntoOp",
+			pos:  position{line: 2063, col: 1, offset: 64105},
 			expr: &actionExpr{
-				pos: position{line: 2014, col: 5, offset: 62728},
-				run: (*parser).callonSQLQuery1,
+				pos: position{line: 2064, col: 5, offset: 64121},
+				run: (*parser).callonMergeIntoOp1,
 				expr: &seqExpr{
-					pos: position{line: 2014, col: 5, offset: 62728},
+					pos: position{line: 2064, col: 5, offset: 64121},
 					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 2014, col: 5, offset: 62728},
-							label: "with",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2014, col: 10, offset: 62733},
-								name: "OptWithClause",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 5, offset: 64121},
+							name: "MERGE",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 11, offset: 64127},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 13, offset: 64129},
+							name: "INTO",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 18, offset: 64134},
+							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2015, col: 5, offset: 62751},
-							label: "body",
+							pos:   position{line: 2064, col: 20, offset: 64136},
+							label: "target",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2015, col: 10, offset: 62756},
-								name: "SQLBodySetOp",
+								pos:  position{line: 2064, col: 27, offset: 64143},
+								name: "FromItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2016, col: 5, offset: 62773},
-							label: "orderby",
+							pos:   position{line: 2064, col: 36, offset: 64152},
+							label: "alias",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2016, col: 13, offset: 62781},
-								name: "OptOrderByClause",
+								pos:  position{line: 2064, col: 42, offset: 64158},
+								name: "OptAlias",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 51, offset: 64167},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 53, offset: 64169},
+							name: "USING",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 59, offset: 64175},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 2017, col: 5, offset: 62802},
-							label: "loff",
+							pos:   position{line: 2064, col: 61, offset: 64177},
+							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2017, col: 10, offset: 62807},
-								name: "OptSQLLimitOffset",
+								pos:  position{line: 2064, col: 68, offset: 64184},
+								name: "SQLTableExpr",
 							},
 						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "SQLBodySetOp",
-			pos:  position{line: 2035, col: 1, offset: 63224},
-			expr: &actionExpr{
-				pos: position{line: 2036, col: 5, offset: 63241},
-				run: (*parser).callonSQLBodySetOp1,
-				expr: &seqExpr{
-					pos: position{line: 2036, col: 5, offset: 63241},
-					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 81, offset: 64197},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 83, offset: 64199},
+							name: "ON",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2064, col: 86, offset: 64202},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 2036, col: 5, offset: 63241},
-							label: "first",
+							pos:   position{line: 2064, col: 88, offset: 64204},
+							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2036, col: 11, offset: 63247},
-								name: "SQLQueryBody",
+								pos:  position{line: 2064, col: 93, offset: 64209},
+								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2036, col: 24, offset: 63260},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 2036, col: 29, offset: 63265},
-								expr: &seqExpr{
-									pos: position{line: 2036, col: 30, offset: 63266},
-									exprs: []any{
-										&ruleRefExpr{
-											pos:  position{line: 2036, col: 30, offset: 63266},
-											name: "SetOp",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 2036, col: 36, offset: 63272},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 2036, col: 38, offset: 63274},
-											name: "SQLQueryBody",
+							pos:   position{line: 2065, col: 5, offset: 64218},
+							label: "clauses",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2065, col: 13, offset: 64226},
+								expr: &actionExpr{
+									pos: position{line: 2065, col: 14, offset: 64227},
+									run: (*parser).callonMergeIntoOp23,
+									expr: &seqExpr{
+										pos: position{line: 2065, col: 14, offset: 64227},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2065, col: 14, offset: 64227},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 2065, col: 16, offset: 64229},
+												label: "clause",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2065, col: 23, offset: 64236},
+													name: "MergeWhen",
+												},
+											},
 										},
 									},
 								},
@@ -13841,150 +14264,1201 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "SQLQueryBody",
-			pos:  position{line: 2052, col: 1, offset: 63672},
+			name: "MergeWhen",
+			pos:  position{line: 2080, col: 1, offset: 64625},
 			expr: &choiceExpr{
-				pos: position{line: 2053, col: 5, offset: 63689},
+				pos: position{line: 2081, col: 5, offset: 64639},
 				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 2053, col: 5, offset: 63689},
-						name: "Select",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2054, col: 5, offset: 63700},
-						name: "FromSelect",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2055, col: 5, offset: 63715},
-						name: "SQLValues",
-					},
 					&actionExpr{
-						pos: position{line: 2056, col: 5, offset: 63729},
-						run: (*parser).callonSQLQueryBody5,
+						pos: position{line: 2081, col: 5, offset: 64639},
+						run: (*parser).callonMergeWhen2,
 						expr: &seqExpr{
-							pos: position{line: 2056, col: 5, offset: 63729},
+							pos: position{line: 2081, col: 5, offset: 64639},
 							exprs: []any{
-								&litMatcher{
-									pos:        position{line: 2056, col: 5, offset: 63729},
-									val:        "(",
-									ignoreCase: false,
-									want:       "\"(\"",
+								&ruleRefExpr{
+									pos:  position{line: 2081, col: 5, offset: 64639},
+									name: "WHEN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2056, col: 9, offset: 63733},
-									name: "__",
+									pos:  position{line: 2081, col: 10, offset: 64644},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2081, col: 12, offset: 64646},
+									name: "MATCHED",
 								},
 								&labeledExpr{
-									pos:   position{line: 2056, col: 12, offset: 63736},
-									label: "s",
+									pos:   position{line: 2081, col: 20, offset: 64654},
+									label: "cond",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2056, col: 14, offset: 63738},
-										name: "SQLQueryOrSetExpr",
+										pos:  position{line: 2081, col: 25, offset: 64659},
+										name: "OptMergeCond",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2056, col: 32, offset: 63756},
-									name: "__",
+									pos:  position{line: 2081, col: 38, offset: 64672},
+									name: "_",
 								},
-								&litMatcher{
-									pos:        position{line: 2056, col: 34, offset: 63758},
-									val:        ")",
-									ignoreCase: false,
-									want:       "\")\"",
+								&ruleRefExpr{
+									pos:  position{line: 2081, col: 40, offset: 64674},
+									name: "THEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2081, col: 45, offset: 64679},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2081, col: 47, offset: 64681},
+									label: "action",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2081, col: 54, offset: 64688},
+										name: "MergeMatchedAction",
+									},
 								},
 							},
 						},
 					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "SQLQueryOrSetExpr",
-			pos:  position{line: 2058, col: 1, offset: 63781},
-			expr: &choiceExpr{
-				pos: position{line: 2058, col: 21, offset: 63801},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 2058, col: 21, offset: 63801},
-						name: "SQLQuery",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2058, col: 32, offset: 63812},
-						name: "SQLBodySetOp",
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
+					&actionExpr{
+						pos: position{line: 2090, col: 5, offset: 64912},
+						run: (*parser).callonMergeWhen14,
+						expr: &seqExpr{
+							pos: position{line: 2090, col: 5, offset: 64912},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 5, offset: 64912},
+									name: "WHEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 10, offset: 64917},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 12, offset: 64919},
+									name: "NOT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 16, offset: 64923},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 18, offset: 64925},
+									name: "MATCHED",
+								},
+								&labeledExpr{
+									pos:   position{line: 2090, col: 26, offset: 64933},
+									label: "cond",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2090, col: 31, offset: 64938},
+										name: "OptMergeCond",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 44, offset: 64951},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 46, offset: 64953},
+									name: "THEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2090, col: 51, offset: 64958},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2090, col: 53, offset: 64960},
+									label: "action",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2090, col: 60, offset: 64967},
+										name: "MergeInsertAction",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptMergeCond",
+			pos:  position{line: 2099, col: 1, offset: 65159},
+			expr: &choiceExpr{
+				pos: position{line: 2100, col: 5, offset: 65176},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2100, col: 5, offset: 65176},
+						run: (*parser).callonOptMergeCond2,
+						expr: &seqExpr{
+							pos: position{line: 2100, col: 5, offset: 65176},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2100, col: 5, offset: 65176},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2100, col: 7, offset: 65178},
+									name: "AND",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2100, col: 11, offset: 65182},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2100, col: 13, offset: 65184},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2100, col: 15, offset: 65186},
+										name: "Expr",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2101, col: 5, offset: 65213},
+						run: (*parser).callonOptMergeCond9,
+						expr: &litMatcher{
+							pos:        position{line: 2101, col: 5, offset: 65213},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "MergeMatchedAction",
+			pos:  position{line: 2103, col: 1, offset: 65237},
+			expr: &choiceExpr{
+				pos: position{line: 2104, col: 5, offset: 65260},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2104, col: 5, offset: 65260},
+						run: (*parser).callonMergeMatchedAction2,
+						expr: &seqExpr{
+							pos: position{line: 2104, col: 5, offset: 65260},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2104, col: 5, offset: 65260},
+									name: "UPDATE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2104, col: 12, offset: 65267},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2104, col: 14, offset: 65269},
+									name: "SET",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2104, col: 18, offset: 65273},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2104, col: 20, offset: 65275},
+									label: "first",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2104, col: 26, offset: 65281},
+										name: "MergeSet",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2104, col: 35, offset: 65290},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 2104, col: 40, offset: 65295},
+										expr: &actionExpr{
+											pos: position{line: 2104, col: 41, offset: 65296},
+											run: (*parser).callonMergeMatchedAction12,
+											expr: &seqExpr{
+												pos: position{line: 2104, col: 41, offset: 65296},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 2104, col: 41, offset: 65296},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 2104, col: 44, offset: 65299},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 2104, col: 48, offset: 65303},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 2104, col: 51, offset: 65306},
+														label: "s",
+														expr: &ruleRefExpr{
+															pos:  position{line: 2104, col: 53, offset: 65308},
+															name: "MergeSet",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2113, col: 5, offset: 65642},
+						run: (*parser).callonMergeMatchedAction19,
+						expr: &ruleRefExpr{
+							pos:  position{line: 2113, col: 5, offset: 65642},
+							name: "DELETE",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "MergeSet",
+			pos:  position{line: 2115, col: 1, offset: 65704},
+			expr: &actionExpr{
+				pos: position{line: 2116, col: 5, offset: 65717},
+				run: (*parser).callonMergeSet1,
+				expr: &seqExpr{
+					pos: position{line: 2116, col: 5, offset: 65717},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2116, col: 5, offset: 65717},
+							label: "col",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2116, col: 9, offset: 65721},
+								name: "SQLIdentifier",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2116, col: 23, offset: 65735},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2116, col: 26, offset: 65738},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2116, col: 30, offset: 65742},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 2116, col: 33, offset: 65745},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2116, col: 35, offset: 65747},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "MergeInsertAction",
+			pos:  position{line: 2118, col: 1, offset: 65783},
+			expr: &actionExpr{
+				pos: position{line: 2119, col: 5, offset: 65805},
+				run: (*parser).callonMergeInsertAction1,
+				expr: &seqExpr{
+					pos: position{line: 2119, col: 5, offset: 65805},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 5, offset: 65805},
+							name: "INSERT",
+						},
+						&labeledExpr{
+							pos:   position{line: 2119, col: 12, offset: 65812},
+							label: "cols",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2119, col: 17, offset: 65817},
+								name: "Columns",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 25, offset: 65825},
+							name: "__",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 28, offset: 65828},
+							name: "VALUES",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 35, offset: 65835},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2119, col: 38, offset: 65838},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 42, offset: 65842},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 2119, col: 45, offset: 65845},
+							label: "exprs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2119, col: 51, offset: 65851},
+								name: "Exprs",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2119, col: 57, offset: 65857},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2119, col: 60, offset: 65860},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLQuery",
+			pos:  position{line: 2127, col: 1, offset: 66023},
+			expr: &actionExpr{
+				pos: position{line: 2128, col: 5, offset: 66036},
+				run: (*parser).callonSQLQuery1,
+				expr: &seqExpr{
+					pos: position{line: 2128, col: 5, offset: 66036},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2128, col: 5, offset: 66036},
+							label: "with",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2128, col: 10, offset: 66041},
+								name: "OptWithClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2129, col: 5, offset: 66059},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2129, col: 10, offset: 66064},
+								name: "SQLBodySetOp",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2130, col: 5, offset: 66081},
+							label: "orderby",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2130, col: 13, offset: 66089},
+								name: "OptOrderByClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2131, col: 5, offset: 66110},
+							label: "loff",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2131, col: 10, offset: 66115},
+								name: "OptSQLLimitOffset",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLBodySetOp",
+			pos:  position{line: 2150, col: 1, offset: 66587},
+			expr: &actionExpr{
+				pos: position{line: 2151, col: 5, offset: 66604},
+				run: (*parser).callonSQLBodySetOp1,
+				expr: &seqExpr{
+					pos: position{line: 2151, col: 5, offset: 66604},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2151, col: 5, offset: 66604},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2151, col: 11, offset: 66610},
+								name: "SQLIntersect",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2151, col: 24, offset: 66623},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2151, col: 29, offset: 66628},
+								expr: &seqExpr{
+									pos: position{line: 2151, col: 30, offset: 66629},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 2151, col: 30, offset: 66629},
+											name: "SetOp",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2151, col: 36, offset: 66635},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2151, col: 38, offset: 66637},
+											name: "SQLIntersect",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLIntersect",
+			pos:  position{line: 2155, col: 1, offset: 66707},
+			expr: &actionExpr{
+				pos: position{line: 2156, col: 5, offset: 66724},
+				run: (*parser).callonSQLIntersect1,
+				expr: &seqExpr{
+					pos: position{line: 2156, col: 5, offset: 66724},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2156, col: 5, offset: 66724},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2156, col: 11, offset: 66730},
+								name: "SQLQueryBody",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2156, col: 24, offset: 66743},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2156, col: 29, offset: 66748},
+								expr: &seqExpr{
+									pos: position{line: 2156, col: 30, offset: 66749},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 2156, col: 30, offset: 66749},
+											name: "IntersectOp",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2156, col: 42, offset: 66761},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2156, col: 44, offset: 66763},
+											name: "SQLQueryBody",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLQueryBody",
+			pos:  position{line: 2160, col: 1, offset: 66833},
+			expr: &choiceExpr{
+				pos: position{line: 2161, col: 5, offset: 66850},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 2161, col: 5, offset: 66850},
+						name: "Select",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2162, col: 5, offset: 66861},
+						name: "FromSelect",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2163, col: 5, offset: 66876},
+						name: "SQLValues",
+					},
+					&actionExpr{
+						pos: position{line: 2164, col: 5, offset: 66890},
+						run: (*parser).callonSQLQueryBody5,
+						expr: &seqExpr{
+							pos: position{line: 2164, col: 5, offset: 66890},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 2164, col: 5, offset: 66890},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2164, col: 9, offset: 66894},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 2164, col: 12, offset: 66897},
+									label: "s",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2164, col: 14, offset: 66899},
+										name: "SQLQueryOrSetExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2164, col: 32, offset: 66917},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2164, col: 34, offset: 66919},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLQueryOrSetExpr",
+			pos:  position{line: 2166, col: 1, offset: 66942},
+			expr: &choiceExpr{
+				pos: position{line: 2166, col: 21, offset: 66962},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 2166, col: 21, offset: 66962},
+						name: "SQLQuery",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2166, col: 32, offset: 66973},
+						name: "SQLBodySetOp",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
 			name: "Select",
-			pos:  position{line: 2060, col: 1, offset: 63826},
+			pos:  position{line: 2168, col: 1, offset: 66987},
+			expr: &actionExpr{
+				pos: position{line: 2169, col: 5, offset: 66998},
+				run: (*parser).callonSelect1,
+				expr: &seqExpr{
+					pos: position{line: 2169, col: 5, offset: 66998},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2169, col: 5, offset: 66998},
+							name: "SELECT",
+						},
+						&labeledExpr{
+							pos:   position{line: 2170, col: 5, offset: 67009},
+							label: "distinct",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2170, col: 14, offset: 67018},
+								name: "OptDistinct",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2173, col: 5, offset: 67154},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2173, col: 7, offset: 67156},
+							label: "selection",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2173, col: 17, offset: 67166},
+								name: "Selection",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2174, col: 5, offset: 67180},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2174, col: 10, offset: 67185},
+								name: "OptFromClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2175, col: 5, offset: 67203},
+							label: "where",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2175, col: 11, offset: 67209},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2175, col: 11, offset: 67209},
+									name: "WhereClause",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2176, col: 5, offset: 67226},
+							label: "group",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2176, col: 11, offset: 67232},
+								name: "OptGroupClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2177, col: 5, offset: 67251},
+							label: "having",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2177, col: 12, offset: 67258},
+								name: "OptHavingClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2178, col: 5, offset: 67278},
+							label: "window",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2178, col: 12, offset: 67285},
+								name: "OptWindowClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2179, col: 5, offset: 67305},
+							label: "qualify",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2179, col: 13, offset: 67313},
+								name: "OptQualifyClause",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "FromSelect",
+			pos:  position{line: 2208, col: 1, offset: 68000},
+			expr: &actionExpr{
+				pos: position{line: 2209, col: 5, offset: 68015},
+				run: (*parser).callonFromSelect1,
+				expr: &seqExpr{
+					pos: position{line: 2209, col: 5, offset: 68015},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2209, col: 5, offset: 68015},
+							name: "FROM",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2209, col: 10, offset: 68020},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2209, col: 12, offset: 68022},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2209, col: 17, offset: 68027},
+								name: "JoinedTable",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2209, col: 29, offset: 68039},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2209, col: 31, offset: 68041},
+							name: "SELECT",
+						},
+						&labeledExpr{
+							pos:   position{line: 2210, col: 5, offset: 68052},
+							label: "distinct",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2210, col: 14, offset: 68061},
+								name: "OptDistinct",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2213, col: 5, offset: 68197},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2213, col: 7, offset: 68199},
+							label: "selection",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2213, col: 17, offset: 68209},
+								name: "Selection",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2214, col: 5, offset: 68223},
+							label: "where",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2214, col: 11, offset: 68229},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2214, col: 11, offset: 68229},
+									name: "WhereClause",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2215, col: 5, offset: 68246},
+							label: "group",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2215, col: 11, offset: 68252},
+								name: "OptGroupClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2216, col: 5, offset: 68271},
+							label: "having",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2216, col: 12, offset: 68278},
+								name: "OptHavingClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2217, col: 5, offset: 68298},
+							label: "window",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2217, col: 12, offset: 68305},
+								name: "OptWindowClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2218, col: 5, offset: 68325},
+							label: "qualify",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2218, col: 13, offset: 68333},
+								name: "OptQualifyClause",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WhereClause",
+			pos:  position{line: 2247, col: 1, offset: 69020},
+			expr: &actionExpr{
+				pos: position{line: 2247, col: 15, offset: 69034},
+				run: (*parser).callonWhereClause1,
+				expr: &seqExpr{
+					pos: position{line: 2247, col: 15, offset: 69034},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2247, col: 15, offset: 69034},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2247, col: 17, offset: 69036},
+							name: "WHERE",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2247, col: 23, offset: 69042},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2247, col: 25, offset: 69044},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2247, col: 30, offset: 69049},
+								name: "LogicalOrExpr",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLValues",
+			pos:  position{line: 2249, col: 1, offset: 69085},
+			expr: &actionExpr{
+				pos: position{line: 2250, col: 5, offset: 69099},
+				run: (*parser).callonSQLValues1,
+				expr: &seqExpr{
+					pos: position{line: 2250, col: 5, offset: 69099},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2250, col: 5, offset: 69099},
+							name: "VALUES",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2250, col: 12, offset: 69106},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 2250, col: 15, offset: 69109},
+							label: "tuples",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2250, col: 22, offset: 69116},
+								name: "SQLTuples",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ValuesOp",
+			pos:  position{line: 2258, col: 1, offset: 69273},
+			expr: &actionExpr{
+				pos: position{line: 2259, col: 5, offset: 69286},
+				run: (*parser).callonValuesOp1,
+				expr: &seqExpr{
+					pos: position{line: 2259, col: 5, offset: 69286},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2259, col: 5, offset: 69286},
+							name: "VALUES",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2259, col: 12, offset: 69293},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2259, col: 14, offset: 69295},
+							label: "exprs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2259, col: 20, offset: 69301},
+								name: "Exprs",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLTuples",
+			pos:  position{line: 2268, col: 1, offset: 69452},
+			expr: &actionExpr{
+				pos: position{line: 2269, col: 5, offset: 69466},
+				run: (*parser).callonSQLTuples1,
+				expr: &seqExpr{
+					pos: position{line: 2269, col: 5, offset: 69466},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2269, col: 5, offset: 69466},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2269, col: 11, offset: 69472},
+								name: "SQLTuple",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2269, col: 20, offset: 69481},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2269, col: 25, offset: 69486},
+								expr: &actionExpr{
+									pos: position{line: 2269, col: 26, offset: 69487},
+									run: (*parser).callonSQLTuples7,
+									expr: &seqExpr{
+										pos: position{line: 2269, col: 26, offset: 69487},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2269, col: 26, offset: 69487},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 2269, col: 29, offset: 69490},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 2269, col: 33, offset: 69494},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 2269, col: 36, offset: 69497},
+												label: "t",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2269, col: 38, offset: 69499},
+													name: "SQLTuple",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SQLTuple",
+			pos:  position{line: 2273, col: 1, offset: 69576},
 			expr: &actionExpr{
-				pos: position{line: 2061, col: 5, offset: 63837},
-				run: (*parser).callonSelect1,
+				pos: position{line: 2274, col: 5, offset: 69589},
+				run: (*parser).callonSQLTuple1,
 				expr: &seqExpr{
-					pos: position{line: 2061, col: 5, offset: 63837},
+					pos: position{line: 2274, col: 5, offset: 69589},
 					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 2274, col: 5, offset: 69589},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
 						&ruleRefExpr{
-							pos:  position{line: 2061, col: 5, offset: 63837},
-							name: "SELECT",
+							pos:  position{line: 2274, col: 9, offset: 69593},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2062, col: 5, offset: 63848},
-							label: "distinct",
+							pos:   position{line: 2274, col: 12, offset: 69596},
+							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2062, col: 14, offset: 63857},
-								name: "OptDistinct",
+								pos:  position{line: 2274, col: 18, offset: 69602},
+								name: "Exprs",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2065, col: 5, offset: 63993},
-							name: "_",
+							pos:  position{line: 2274, col: 24, offset: 69608},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2274, col: 27, offset: 69611},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptDistinct",
+			pos:  position{line: 2282, col: 1, offset: 69755},
+			expr: &choiceExpr{
+				pos: position{line: 2283, col: 5, offset: 69771},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2283, col: 5, offset: 69771},
+						run: (*parser).callonOptDistinct2,
+						expr: &seqExpr{
+							pos: position{line: 2283, col: 5, offset: 69771},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2283, col: 5, offset: 69771},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2283, col: 7, offset: 69773},
+									name: "ALL",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2284, col: 5, offset: 69810},
+						run: (*parser).callonOptDistinct6,
+						expr: &seqExpr{
+							pos: position{line: 2284, col: 5, offset: 69810},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2284, col: 5, offset: 69810},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2284, col: 7, offset: 69812},
+									name: "DISTINCT",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2285, col: 5, offset: 69848},
+						run: (*parser).callonOptDistinct10,
+						expr: &litMatcher{
+							pos:        position{line: 2285, col: 5, offset: 69848},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptWithClause",
+			pos:  position{line: 2287, col: 1, offset: 69887},
+			expr: &choiceExpr{
+				pos: position{line: 2288, col: 5, offset: 69905},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 2288, col: 5, offset: 69905},
+						name: "WithClause",
+					},
+					&actionExpr{
+						pos: position{line: 2289, col: 5, offset: 69920},
+						run: (*parser).callonOptWithClause3,
+						expr: &litMatcher{
+							pos:        position{line: 2289, col: 5, offset: 69920},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WithClause",
+			pos:  position{line: 2291, col: 1, offset: 69953},
+			expr: &actionExpr{
+				pos: position{line: 2292, col: 5, offset: 69968},
+				run: (*parser).callonWithClause1,
+				expr: &seqExpr{
+					pos: position{line: 2292, col: 5, offset: 69968},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2292, col: 5, offset: 69968},
+							name: "WITH",
 						},
 						&labeledExpr{
-							pos:   position{line: 2065, col: 7, offset: 63995},
-							label: "selection",
+							pos:   position{line: 2292, col: 10, offset: 69973},
+							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2065, col: 17, offset: 64005},
-								name: "Selection",
+								pos:  position{line: 2292, col: 12, offset: 69975},
+								name: "OptRecursive",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2292, col: 25, offset: 69988},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 2066, col: 5, offset: 64019},
-							label: "from",
+							pos:   position{line: 2292, col: 27, offset: 69990},
+							label: "ctes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2066, col: 10, offset: 64024},
-								name: "OptFromClause",
+								pos:  position{line: 2292, col: 32, offset: 69995},
+								name: "CteList",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 2067, col: 5, offset: 64042},
-							label: "where",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2067, col: 11, offset: 64048},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2067, col: 11, offset: 64048},
-									name: "WhereClause",
+						&ruleRefExpr{
+							pos:  position{line: 2292, col: 40, offset: 70003},
+							name: "__",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptRecursive",
+			pos:  position{line: 2300, col: 1, offset: 70162},
+			expr: &choiceExpr{
+				pos: position{line: 2301, col: 5, offset: 70179},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2301, col: 5, offset: 70179},
+						run: (*parser).callonOptRecursive2,
+						expr: &seqExpr{
+							pos: position{line: 2301, col: 5, offset: 70179},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2301, col: 5, offset: 70179},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2301, col: 7, offset: 70181},
+									name: "RECURSIVE",
 								},
 							},
 						},
+					},
+					&actionExpr{
+						pos: position{line: 2302, col: 5, offset: 70217},
+						run: (*parser).callonOptRecursive6,
+						expr: &litMatcher{
+							pos:        position{line: 2302, col: 5, offset: 70217},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CteList",
+			pos:  position{line: 2304, col: 1, offset: 70256},
+			expr: &actionExpr{
+				pos: position{line: 2304, col: 11, offset: 70266},
+				run: (*parser).callonCteList1,
+				expr: &seqExpr{
+					pos: position{line: 2304, col: 11, offset: 70266},
+					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2068, col: 5, offset: 64065},
-							label: "group",
+							pos:   position{line: 2304, col: 11, offset: 70266},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2068, col: 11, offset: 64071},
-								name: "OptGroupClause",
+								pos:  position{line: 2304, col: 17, offset: 70272},
+								name: "Cte",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2069, col: 5, offset: 64090},
-							label: "having",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2069, col: 12, offset: 64097},
-								name: "OptHavingClause",
+							pos:   position{line: 2304, col: 21, offset: 70276},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2304, col: 26, offset: 70281},
+								expr: &actionExpr{
+									pos: position{line: 2304, col: 28, offset: 70283},
+									run: (*parser).callonCteList7,
+									expr: &seqExpr{
+										pos: position{line: 2304, col: 28, offset: 70283},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2304, col: 28, offset: 70283},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 2304, col: 31, offset: 70286},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 2304, col: 35, offset: 70290},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 2304, col: 38, offset: 70293},
+												label: "cte",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2304, col: 42, offset: 70297},
+													name: "Cte",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -13994,84 +15468,140 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "FromSelect",
-			pos:  position{line: 2094, col: 1, offset: 64688},
+			name: "Cte",
+			pos:  position{line: 2308, col: 1, offset: 70365},
 			expr: &actionExpr{
-				pos: position{line: 2095, col: 5, offset: 64703},
-				run: (*parser).callonFromSelect1,
+				pos: position{line: 2309, col: 5, offset: 70373},
+				run: (*parser).callonCte1,
 				expr: &seqExpr{
-					pos: position{line: 2095, col: 5, offset: 64703},
+					pos: position{line: 2309, col: 5, offset: 70373},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2095, col: 5, offset: 64703},
-							name: "FROM",
+						&labeledExpr{
+							pos:   position{line: 2309, col: 5, offset: 70373},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2309, col: 10, offset: 70378},
+								name: "TableAlias",
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2095, col: 10, offset: 64708},
+							pos:  position{line: 2309, col: 21, offset: 70389},
 							name: "_",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2309, col: 23, offset: 70391},
+							name: "AS",
+						},
 						&labeledExpr{
-							pos:   position{line: 2095, col: 12, offset: 64710},
-							label: "from",
+							pos:   position{line: 2309, col: 26, offset: 70394},
+							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2095, col: 17, offset: 64715},
-								name: "JoinedTable",
+								pos:  position{line: 2309, col: 28, offset: 70396},
+								name: "OptMaterialized",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2095, col: 29, offset: 64727},
-							name: "_",
+						&ruleRefExpr{
+							pos:  position{line: 2309, col: 44, offset: 70412},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2309, col: 47, offset: 70415},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2095, col: 31, offset: 64729},
-							name: "SELECT",
+							pos:  position{line: 2309, col: 51, offset: 70419},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2096, col: 5, offset: 64740},
-							label: "distinct",
+							pos:   position{line: 2309, col: 54, offset: 70422},
+							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2096, col: 14, offset: 64749},
-								name: "OptDistinct",
+								pos:  position{line: 2309, col: 56, offset: 70424},
+								name: "SQLQueryOrSetExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2099, col: 5, offset: 64885},
-							name: "_",
+							pos:  position{line: 2309, col: 74, offset: 70442},
+							name: "__",
 						},
-						&labeledExpr{
-							pos:   position{line: 2099, col: 7, offset: 64887},
-							label: "selection",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2099, col: 17, offset: 64897},
-								name: "Selection",
-							},
+						&litMatcher{
+							pos:        position{line: 2309, col: 77, offset: 70445},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 2100, col: 5, offset: 64911},
-							label: "where",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2100, col: 11, offset: 64917},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2100, col: 11, offset: 64917},
-									name: "WhereClause",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptMaterialized",
+			pos:  position{line: 2318, col: 1, offset: 70643},
+			expr: &choiceExpr{
+				pos: position{line: 2319, col: 5, offset: 70663},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2319, col: 5, offset: 70663},
+						run: (*parser).callonOptMaterialized2,
+						expr: &seqExpr{
+							pos: position{line: 2319, col: 5, offset: 70663},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2319, col: 5, offset: 70663},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2319, col: 7, offset: 70665},
+									name: "MATERIALIZED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2319, col: 20, offset: 70678},
+									name: "_",
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 2101, col: 5, offset: 64934},
-							label: "group",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2101, col: 11, offset: 64940},
-								name: "OptGroupClause",
+					},
+					&actionExpr{
+						pos: position{line: 2320, col: 5, offset: 70717},
+						run: (*parser).callonOptMaterialized7,
+						expr: &seqExpr{
+							pos: position{line: 2320, col: 5, offset: 70717},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2320, col: 5, offset: 70717},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2320, col: 7, offset: 70719},
+									name: "NOT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2320, col: 11, offset: 70723},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2320, col: 13, offset: 70725},
+									name: "MATERIALIZED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2320, col: 26, offset: 70738},
+									name: "_",
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 2102, col: 5, offset: 64959},
-							label: "having",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2102, col: 12, offset: 64966},
-								name: "OptHavingClause",
-							},
+					},
+					&actionExpr{
+						pos: position{line: 2321, col: 5, offset: 70769},
+						run: (*parser).callonOptMaterialized14,
+						expr: &litMatcher{
+							pos:        position{line: 2321, col: 5, offset: 70769},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
 						},
 					},
 				},
@@ -14080,94 +15610,158 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "WhereClause",
-			pos:  position{line: 2127, col: 1, offset: 65557},
-			expr: &actionExpr{
-				pos: position{line: 2127, col: 15, offset: 65571},
-				run: (*parser).callonWhereClause1,
-				expr: &seqExpr{
-					pos: position{line: 2127, col: 15, offset: 65571},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2127, col: 15, offset: 65571},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2127, col: 17, offset: 65573},
-							name: "WHERE",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2127, col: 23, offset: 65579},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 2127, col: 25, offset: 65581},
-							label: "expr",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2127, col: 30, offset: 65586},
-								name: "LogicalOrExpr",
+			name: "OptAllClause",
+			pos:  position{line: 2323, col: 1, offset: 70824},
+			expr: &choiceExpr{
+				pos: position{line: 2324, col: 5, offset: 70841},
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 2324, col: 5, offset: 70841},
+						exprs: []any{
+							&ruleRefExpr{
+								pos:  position{line: 2324, col: 5, offset: 70841},
+								name: "_",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 2324, col: 7, offset: 70843},
+								name: "ALL",
 							},
 						},
 					},
+					&litMatcher{
+						pos:        position{line: 2325, col: 5, offset: 70851},
+						val:        "",
+						ignoreCase: false,
+						want:       "\"\"",
+					},
 				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
-			name: "SQLValues",
-			pos:  position{line: 2129, col: 1, offset: 65622},
-			expr: &actionExpr{
-				pos: position{line: 2130, col: 5, offset: 65636},
-				run: (*parser).callonSQLValues1,
-				expr: &seqExpr{
-					pos: position{line: 2130, col: 5, offset: 65636},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2130, col: 5, offset: 65636},
-							name: "VALUES",
+			name: "OptFromClause",
+			pos:  position{line: 2327, col: 1, offset: 70855},
+			expr: &choiceExpr{
+				pos: position{line: 2328, col: 5, offset: 70873},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2328, col: 5, offset: 70873},
+						run: (*parser).callonOptFromClause2,
+						expr: &seqExpr{
+							pos: position{line: 2328, col: 5, offset: 70873},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 5, offset: 70873},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 7, offset: 70875},
+									name: "FROM",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 12, offset: 70880},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2328, col: 14, offset: 70882},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2328, col: 19, offset: 70887},
+										name: "JoinedTable",
+									},
+								},
+							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2130, col: 12, offset: 65643},
-							name: "__",
+					},
+					&actionExpr{
+						pos: position{line: 2331, col: 5, offset: 70934},
+						run: (*parser).callonOptFromClause9,
+						expr: &litMatcher{
+							pos:        position{line: 2331, col: 5, offset: 70934},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 2130, col: 15, offset: 65646},
-							label: "tuples",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2130, col: 22, offset: 65653},
-								name: "SQLTuples",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptGroupClause",
+			pos:  position{line: 2333, col: 1, offset: 70975},
+			expr: &choiceExpr{
+				pos: position{line: 2334, col: 5, offset: 70994},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2334, col: 5, offset: 70994},
+						run: (*parser).callonOptGroupClause2,
+						expr: &seqExpr{
+							pos: position{line: 2334, col: 5, offset: 70994},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2334, col: 5, offset: 70994},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2334, col: 7, offset: 70996},
+									label: "group",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2334, col: 13, offset: 71002},
+										name: "GroupClause",
+									},
+								},
 							},
 						},
 					},
+					&actionExpr{
+						pos: position{line: 2335, col: 5, offset: 71040},
+						run: (*parser).callonOptGroupClause7,
+						expr: &litMatcher{
+							pos:        position{line: 2335, col: 5, offset: 71040},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
 				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
-			name: "ValuesOp",
-			pos:  position{line: 2138, col: 1, offset: 65810},
+			name: "GroupClause",
+			pos:  position{line: 2337, col: 1, offset: 71081},
 			expr: &actionExpr{
-				pos: position{line: 2139, col: 5, offset: 65823},
-				run: (*parser).callonValuesOp1,
+				pos: position{line: 2338, col: 5, offset: 71097},
+				run: (*parser).callonGroupClause1,
 				expr: &seqExpr{
-					pos: position{line: 2139, col: 5, offset: 65823},
+					pos: position{line: 2338, col: 5, offset: 71097},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2139, col: 5, offset: 65823},
-							name: "VALUES",
+							pos:  position{line: 2338, col: 5, offset: 71097},
+							name: "GROUP",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2338, col: 11, offset: 71103},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2338, col: 13, offset: 71105},
+							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2139, col: 12, offset: 65830},
+							pos:  position{line: 2338, col: 16, offset: 71108},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2139, col: 14, offset: 65832},
-							label: "exprs",
+							pos:   position{line: 2338, col: 18, offset: 71110},
+							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2139, col: 20, offset: 65838},
-								name: "Exprs",
+								pos:  position{line: 2338, col: 23, offset: 71115},
+								name: "GroupByList",
 							},
 						},
 					},
@@ -14177,53 +15771,53 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "SQLTuples",
-			pos:  position{line: 2148, col: 1, offset: 65989},
+			name: "GroupByList",
+			pos:  position{line: 2340, col: 1, offset: 71149},
 			expr: &actionExpr{
-				pos: position{line: 2149, col: 5, offset: 66003},
-				run: (*parser).callonSQLTuples1,
+				pos: position{line: 2341, col: 5, offset: 71165},
+				run: (*parser).callonGroupByList1,
 				expr: &seqExpr{
-					pos: position{line: 2149, col: 5, offset: 66003},
+					pos: position{line: 2341, col: 5, offset: 71165},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2149, col: 5, offset: 66003},
+							pos:   position{line: 2341, col: 5, offset: 71165},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2149, col: 11, offset: 66009},
-								name: "SQLTuple",
+								pos:  position{line: 2341, col: 11, offset: 71171},
+								name: "GroupByItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2149, col: 20, offset: 66018},
+							pos:   position{line: 2341, col: 23, offset: 71183},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2149, col: 25, offset: 66023},
+								pos: position{line: 2341, col: 28, offset: 71188},
 								expr: &actionExpr{
-									pos: position{line: 2149, col: 26, offset: 66024},
-									run: (*parser).callonSQLTuples7,
+									pos: position{line: 2341, col: 30, offset: 71190},
+									run: (*parser).callonGroupByList7,
 									expr: &seqExpr{
-										pos: position{line: 2149, col: 26, offset: 66024},
+										pos: position{line: 2341, col: 30, offset: 71190},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2149, col: 26, offset: 66024},
+												pos:  position{line: 2341, col: 30, offset: 71190},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2149, col: 29, offset: 66027},
+												pos:        position{line: 2341, col: 33, offset: 71193},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2149, col: 33, offset: 66031},
+												pos:  position{line: 2341, col: 37, offset: 71197},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2149, col: 36, offset: 66034},
-												label: "t",
+												pos:   position{line: 2341, col: 40, offset: 71200},
+												label: "g",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2149, col: 38, offset: 66036},
-													name: "SQLTuple",
+													pos:  position{line: 2341, col: 42, offset: 71202},
+													name: "GroupByItem",
 												},
 											},
 										},
@@ -14238,42 +15832,82 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "SQLTuple",
-			pos:  position{line: 2153, col: 1, offset: 66113},
+			name: "GroupByItem",
+			pos:  position{line: 2345, col: 1, offset: 71283},
+			expr: &ruleRefExpr{
+				pos:  position{line: 2345, col: 15, offset: 71297},
+				name: "Expr",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptHavingClause",
+			pos:  position{line: 2347, col: 1, offset: 71303},
+			expr: &choiceExpr{
+				pos: position{line: 2348, col: 5, offset: 71323},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2348, col: 5, offset: 71323},
+						run: (*parser).callonOptHavingClause2,
+						expr: &seqExpr{
+							pos: position{line: 2348, col: 5, offset: 71323},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2348, col: 5, offset: 71323},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2348, col: 7, offset: 71325},
+									label: "h",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2348, col: 9, offset: 71327},
+										name: "HavingClause",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2349, col: 5, offset: 71362},
+						run: (*parser).callonOptHavingClause7,
+						expr: &litMatcher{
+							pos:        position{line: 2349, col: 5, offset: 71362},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "HavingClause",
+			pos:  position{line: 2351, col: 1, offset: 71386},
 			expr: &actionExpr{
-				pos: position{line: 2154, col: 5, offset: 66126},
-				run: (*parser).callonSQLTuple1,
+				pos: position{line: 2352, col: 5, offset: 71403},
+				run: (*parser).callonHavingClause1,
 				expr: &seqExpr{
-					pos: position{line: 2154, col: 5, offset: 66126},
+					pos: position{line: 2352, col: 5, offset: 71403},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 2154, col: 5, offset: 66126},
-							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
+						&ruleRefExpr{
+							pos:  position{line: 2352, col: 5, offset: 71403},
+							name: "HAVING",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2154, col: 9, offset: 66130},
-							name: "__",
+							pos:  position{line: 2352, col: 12, offset: 71410},
+							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2154, col: 12, offset: 66133},
-							label: "exprs",
+							pos:   position{line: 2352, col: 14, offset: 71412},
+							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2154, col: 18, offset: 66139},
-								name: "Exprs",
+								pos:  position{line: 2352, col: 16, offset: 71414},
+								name: "Expr",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2154, col: 24, offset: 66145},
-							name: "__",
-						},
-						&litMatcher{
-							pos:        position{line: 2154, col: 27, offset: 66148},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
 					},
 				},
 			},
@@ -14281,50 +15915,45 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptDistinct",
-			pos:  position{line: 2162, col: 1, offset: 66292},
+			name: "OptQualifyClause",
+			pos:  position{line: 2354, col: 1, offset: 71438},
 			expr: &choiceExpr{
-				pos: position{line: 2163, col: 5, offset: 66308},
+				pos: position{line: 2355, col: 5, offset: 71459},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2163, col: 5, offset: 66308},
-						run: (*parser).callonOptDistinct2,
+						pos: position{line: 2355, col: 5, offset: 71459},
+						run: (*parser).callonOptQualifyClause2,
 						expr: &seqExpr{
-							pos: position{line: 2163, col: 5, offset: 66308},
+							pos: position{line: 2355, col: 5, offset: 71459},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2163, col: 5, offset: 66308},
+									pos:  position{line: 2355, col: 5, offset: 71459},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2163, col: 7, offset: 66310},
-									name: "ALL",
+									pos:  position{line: 2355, col: 7, offset: 71461},
+									name: "QUALIFY",
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 2164, col: 5, offset: 66347},
-						run: (*parser).callonOptDistinct6,
-						expr: &seqExpr{
-							pos: position{line: 2164, col: 5, offset: 66347},
-							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 5, offset: 66347},
+									pos:  position{line: 2355, col: 15, offset: 71469},
 									name: "_",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 2164, col: 7, offset: 66349},
-									name: "DISTINCT",
+								&labeledExpr{
+									pos:   position{line: 2355, col: 17, offset: 71471},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2355, col: 19, offset: 71473},
+										name: "Expr",
+									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2165, col: 5, offset: 66385},
-						run: (*parser).callonOptDistinct10,
+						pos: position{line: 2356, col: 5, offset: 71500},
+						run: (*parser).callonOptQualifyClause9,
 						expr: &litMatcher{
-							pos:        position{line: 2165, col: 5, offset: 66385},
+							pos:        position{line: 2356, col: 5, offset: 71500},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14336,20 +15965,83 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptWithClause",
-			pos:  position{line: 2167, col: 1, offset: 66424},
+			name: "OptWindowClause",
+			pos:  position{line: 2358, col: 1, offset: 71524},
 			expr: &choiceExpr{
-				pos: position{line: 2168, col: 5, offset: 66442},
+				pos: position{line: 2359, col: 5, offset: 71544},
 				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 2168, col: 5, offset: 66442},
-						name: "WithClause",
+					&actionExpr{
+						pos: position{line: 2359, col: 5, offset: 71544},
+						run: (*parser).callonOptWindowClause2,
+						expr: &seqExpr{
+							pos: position{line: 2359, col: 5, offset: 71544},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2359, col: 5, offset: 71544},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2359, col: 7, offset: 71546},
+									name: "WINDOW",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2359, col: 14, offset: 71553},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2359, col: 16, offset: 71555},
+									label: "first",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2359, col: 22, offset: 71561},
+										name: "WindowDef",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2359, col: 32, offset: 71571},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 2359, col: 37, offset: 71576},
+										expr: &actionExpr{
+											pos: position{line: 2359, col: 39, offset: 71578},
+											run: (*parser).callonOptWindowClause11,
+											expr: &seqExpr{
+												pos: position{line: 2359, col: 39, offset: 71578},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 2359, col: 39, offset: 71578},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 2359, col: 42, offset: 71581},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 2359, col: 46, offset: 71585},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 2359, col: 49, offset: 71588},
+														label: "w",
+														expr: &ruleRefExpr{
+															pos:  position{line: 2359, col: 51, offset: 71590},
+															name: "WindowDef",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 					&actionExpr{
-						pos: position{line: 2169, col: 5, offset: 66457},
-						run: (*parser).callonOptWithClause3,
+						pos: position{line: 2362, col: 5, offset: 71672},
+						run: (*parser).callonOptWindowClause18,
 						expr: &litMatcher{
-							pos:        position{line: 2169, col: 5, offset: 66457},
+							pos:        position{line: 2362, col: 5, offset: 71672},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14361,42 +16053,62 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "WithClause",
-			pos:  position{line: 2171, col: 1, offset: 66490},
+			name: "WindowDef",
+			pos:  position{line: 2364, col: 1, offset: 71696},
 			expr: &actionExpr{
-				pos: position{line: 2172, col: 5, offset: 66505},
-				run: (*parser).callonWithClause1,
+				pos: position{line: 2365, col: 5, offset: 71710},
+				run: (*parser).callonWindowDef1,
 				expr: &seqExpr{
-					pos: position{line: 2172, col: 5, offset: 66505},
+					pos: position{line: 2365, col: 5, offset: 71710},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2172, col: 5, offset: 66505},
-							name: "WITH",
-						},
 						&labeledExpr{
-							pos:   position{line: 2172, col: 10, offset: 66510},
-							label: "r",
+							pos:   position{line: 2365, col: 5, offset: 71710},
+							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2172, col: 12, offset: 66512},
-								name: "OptRecursive",
+								pos:  position{line: 2365, col: 10, offset: 71715},
+								name: "SQLIdentifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2172, col: 25, offset: 66525},
+							pos:  position{line: 2365, col: 24, offset: 71729},
 							name: "_",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2365, col: 26, offset: 71731},
+							name: "AS",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2365, col: 29, offset: 71734},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 2365, col: 32, offset: 71737},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2365, col: 36, offset: 71741},
+							name: "__",
+						},
 						&labeledExpr{
-							pos:   position{line: 2172, col: 27, offset: 66527},
-							label: "ctes",
+							pos:   position{line: 2365, col: 39, offset: 71744},
+							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2172, col: 32, offset: 66532},
-								name: "CteList",
+								pos:  position{line: 2365, col: 44, offset: 71749},
+								name: "WindowSpec",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2172, col: 40, offset: 66540},
+							pos:  position{line: 2365, col: 55, offset: 71760},
 							name: "__",
 						},
+						&litMatcher{
+							pos:        position{line: 2365, col: 58, offset: 71763},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
@@ -14404,36 +16116,58 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptRecursive",
-			pos:  position{line: 2180, col: 1, offset: 66699},
+			name: "OverClause",
+			pos:  position{line: 2373, col: 1, offset: 71909},
 			expr: &choiceExpr{
-				pos: position{line: 2181, col: 5, offset: 66716},
+				pos: position{line: 2374, col: 5, offset: 71924},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2181, col: 5, offset: 66716},
-						run: (*parser).callonOptRecursive2,
+						pos: position{line: 2374, col: 5, offset: 71924},
+						run: (*parser).callonOverClause2,
 						expr: &seqExpr{
-							pos: position{line: 2181, col: 5, offset: 66716},
+							pos: position{line: 2374, col: 5, offset: 71924},
 							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 2374, col: 5, offset: 71924},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
 								&ruleRefExpr{
-									pos:  position{line: 2181, col: 5, offset: 66716},
-									name: "_",
+									pos:  position{line: 2374, col: 9, offset: 71928},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 2374, col: 12, offset: 71931},
+									label: "spec",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2374, col: 17, offset: 71936},
+										name: "WindowSpec",
+									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2181, col: 7, offset: 66718},
-									name: "RECURSIVE",
+									pos:  position{line: 2374, col: 28, offset: 71947},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2374, col: 31, offset: 71950},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2182, col: 5, offset: 66754},
-						run: (*parser).callonOptRecursive6,
-						expr: &litMatcher{
-							pos:        position{line: 2182, col: 5, offset: 66754},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
+						pos: position{line: 2375, col: 5, offset: 71979},
+						run: (*parser).callonOverClause10,
+						expr: &labeledExpr{
+							pos:   position{line: 2375, col: 5, offset: 71979},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2375, col: 10, offset: 71984},
+								name: "SQLIdentifier",
+							},
 						},
 					},
 				},
@@ -14442,53 +16176,122 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "CteList",
-			pos:  position{line: 2184, col: 1, offset: 66793},
+			name: "WindowSpec",
+			pos:  position{line: 2379, col: 1, offset: 72076},
 			expr: &actionExpr{
-				pos: position{line: 2184, col: 11, offset: 66803},
-				run: (*parser).callonCteList1,
+				pos: position{line: 2380, col: 5, offset: 72091},
+				run: (*parser).callonWindowSpec1,
 				expr: &seqExpr{
-					pos: position{line: 2184, col: 11, offset: 66803},
+					pos: position{line: 2380, col: 5, offset: 72091},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2184, col: 11, offset: 66803},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2184, col: 17, offset: 66809},
-								name: "Cte",
+							pos:   position{line: 2380, col: 5, offset: 72091},
+							label: "name",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2380, col: 10, offset: 72096},
+								expr: &actionExpr{
+									pos: position{line: 2380, col: 11, offset: 72097},
+									run: (*parser).callonWindowSpec5,
+									expr: &seqExpr{
+										pos: position{line: 2380, col: 11, offset: 72097},
+										exprs: []any{
+											&notExpr{
+												pos: position{line: 2380, col: 11, offset: 72097},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2380, col: 12, offset: 72098},
+													name: "WindowSpecGuard",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 2380, col: 28, offset: 72114},
+												label: "id",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2380, col: 31, offset: 72117},
+													name: "SQLIdentifier",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2184, col: 21, offset: 66813},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 2184, col: 26, offset: 66818},
+							pos:   position{line: 2381, col: 5, offset: 72156},
+							label: "partition",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2381, col: 15, offset: 72166},
 								expr: &actionExpr{
-									pos: position{line: 2184, col: 28, offset: 66820},
-									run: (*parser).callonCteList7,
+									pos: position{line: 2381, col: 16, offset: 72167},
+									run: (*parser).callonWindowSpec13,
 									expr: &seqExpr{
-										pos: position{line: 2184, col: 28, offset: 66820},
+										pos: position{line: 2381, col: 16, offset: 72167},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2184, col: 28, offset: 66820},
+												pos:  position{line: 2381, col: 16, offset: 72167},
 												name: "__",
 											},
-											&litMatcher{
-												pos:        position{line: 2184, col: 31, offset: 66823},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
+											&labeledExpr{
+												pos:   position{line: 2381, col: 19, offset: 72170},
+												label: "p",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2381, col: 21, offset: 72172},
+													name: "PartitionByClause",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2382, col: 5, offset: 72214},
+							label: "orderby",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2382, col: 13, offset: 72222},
+								expr: &actionExpr{
+									pos: position{line: 2382, col: 14, offset: 72223},
+									run: (*parser).callonWindowSpec20,
+									expr: &seqExpr{
+										pos: position{line: 2382, col: 14, offset: 72223},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2382, col: 14, offset: 72223},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 2382, col: 17, offset: 72226},
+												label: "o",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2382, col: 19, offset: 72228},
+													name: "WindowOrderByClause",
+												},
 											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2383, col: 5, offset: 72272},
+							label: "frame",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2383, col: 11, offset: 72278},
+								expr: &actionExpr{
+									pos: position{line: 2383, col: 12, offset: 72279},
+									run: (*parser).callonWindowSpec27,
+									expr: &seqExpr{
+										pos: position{line: 2383, col: 12, offset: 72279},
+										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2184, col: 35, offset: 66827},
+												pos:  position{line: 2383, col: 12, offset: 72279},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2184, col: 38, offset: 66830},
-												label: "cte",
+												pos:   position{line: 2383, col: 15, offset: 72282},
+												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2184, col: 42, offset: 66834},
-													name: "Cte",
+													pos:  position{line: 2383, col: 17, offset: 72284},
+													name: "WindowFrame",
 												},
 											},
 										},
@@ -14503,70 +16306,104 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "Cte",
-			pos:  position{line: 2188, col: 1, offset: 66902},
+			name: "WindowSpecGuard",
+			pos:  position{line: 2400, col: 1, offset: 72709},
+			expr: &choiceExpr{
+				pos: position{line: 2400, col: 19, offset: 72727},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 2400, col: 19, offset: 72727},
+						name: "PARTITION",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2400, col: 31, offset: 72739},
+						name: "ORDER",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2400, col: 39, offset: 72747},
+						name: "ROWS",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2400, col: 46, offset: 72754},
+						name: "RANGE",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PartitionByClause",
+			pos:  position{line: 2402, col: 1, offset: 72761},
+			expr: &actionExpr{
+				pos: position{line: 2403, col: 5, offset: 72783},
+				run: (*parser).callonPartitionByClause1,
+				expr: &seqExpr{
+					pos: position{line: 2403, col: 5, offset: 72783},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2403, col: 5, offset: 72783},
+							name: "PARTITION",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2403, col: 15, offset: 72793},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2403, col: 17, offset: 72795},
+							name: "BY",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2403, col: 20, offset: 72798},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2403, col: 22, offset: 72800},
+							label: "list",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2403, col: 27, offset: 72805},
+								name: "Exprs",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowOrderByClause",
+			pos:  position{line: 2405, col: 1, offset: 72833},
 			expr: &actionExpr{
-				pos: position{line: 2189, col: 5, offset: 66910},
-				run: (*parser).callonCte1,
+				pos: position{line: 2406, col: 5, offset: 72857},
+				run: (*parser).callonWindowOrderByClause1,
 				expr: &seqExpr{
-					pos: position{line: 2189, col: 5, offset: 66910},
+					pos: position{line: 2406, col: 5, offset: 72857},
 					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 2189, col: 5, offset: 66910},
-							label: "name",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2189, col: 10, offset: 66915},
-								name: "TableAlias",
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 2189, col: 21, offset: 66926},
-							name: "_",
+							pos:  position{line: 2406, col: 5, offset: 72857},
+							name: "ORDER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2189, col: 23, offset: 66928},
-							name: "AS",
-						},
-						&labeledExpr{
-							pos:   position{line: 2189, col: 26, offset: 66931},
-							label: "m",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2189, col: 28, offset: 66933},
-								name: "OptMaterialized",
-							},
+							pos:  position{line: 2406, col: 11, offset: 72863},
+							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2189, col: 44, offset: 66949},
-							name: "__",
-						},
-						&litMatcher{
-							pos:        position{line: 2189, col: 47, offset: 66952},
-							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
+							pos:  position{line: 2406, col: 13, offset: 72865},
+							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2189, col: 51, offset: 66956},
-							name: "__",
+							pos:  position{line: 2406, col: 16, offset: 72868},
+							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2189, col: 54, offset: 66959},
-							label: "s",
+							pos:   position{line: 2406, col: 18, offset: 72870},
+							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2189, col: 56, offset: 66961},
-								name: "SQLQueryOrSetExpr",
+								pos:  position{line: 2406, col: 23, offset: 72875},
+								name: "OrderByList",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2189, col: 74, offset: 66979},
-							name: "__",
-						},
-						&litMatcher{
-							pos:        position{line: 2189, col: 77, offset: 66982},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
 					},
 				},
 			},
@@ -14574,69 +16411,95 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptMaterialized",
-			pos:  position{line: 2198, col: 1, offset: 67180},
+			name: "WindowFrame",
+			pos:  position{line: 2408, col: 1, offset: 72909},
 			expr: &choiceExpr{
-				pos: position{line: 2199, col: 5, offset: 67200},
+				pos: position{line: 2409, col: 5, offset: 72925},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2199, col: 5, offset: 67200},
-						run: (*parser).callonOptMaterialized2,
+						pos: position{line: 2409, col: 5, offset: 72925},
+						run: (*parser).callonWindowFrame2,
 						expr: &seqExpr{
-							pos: position{line: 2199, col: 5, offset: 67200},
+							pos: position{line: 2409, col: 5, offset: 72925},
 							exprs: []any{
-								&ruleRefExpr{
-									pos:  position{line: 2199, col: 5, offset: 67200},
-									name: "_",
+								&labeledExpr{
+									pos:   position{line: 2409, col: 5, offset: 72925},
+									label: "unit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2409, col: 10, offset: 72930},
+										name: "FrameUnit",
+									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2199, col: 7, offset: 67202},
-									name: "MATERIALIZED",
+									pos:  position{line: 2409, col: 20, offset: 72940},
+									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2199, col: 20, offset: 67215},
-									name: "_",
+									pos:  position{line: 2409, col: 22, offset: 72942},
+									name: "BETWEEN",
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 2200, col: 5, offset: 67254},
-						run: (*parser).callonOptMaterialized7,
-						expr: &seqExpr{
-							pos: position{line: 2200, col: 5, offset: 67254},
-							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2200, col: 5, offset: 67254},
+									pos:  position{line: 2409, col: 30, offset: 72950},
 									name: "_",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 2200, col: 7, offset: 67256},
-									name: "NOT",
+								&labeledExpr{
+									pos:   position{line: 2409, col: 32, offset: 72952},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2409, col: 38, offset: 72958},
+										name: "FrameBound",
+									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2200, col: 11, offset: 67260},
+									pos:  position{line: 2409, col: 49, offset: 72969},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2200, col: 13, offset: 67262},
-									name: "MATERIALIZED",
+									pos:  position{line: 2409, col: 51, offset: 72971},
+									name: "AND",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2200, col: 26, offset: 67275},
+									pos:  position{line: 2409, col: 55, offset: 72975},
 									name: "_",
 								},
+								&labeledExpr{
+									pos:   position{line: 2409, col: 57, offset: 72977},
+									label: "end",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2409, col: 61, offset: 72981},
+										name: "FrameBound",
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2201, col: 5, offset: 67306},
-						run: (*parser).callonOptMaterialized14,
-						expr: &litMatcher{
-							pos:        position{line: 2201, col: 5, offset: 67306},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
+						pos: position{line: 2417, col: 5, offset: 73172},
+						run: (*parser).callonWindowFrame16,
+						expr: &seqExpr{
+							pos: position{line: 2417, col: 5, offset: 73172},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 2417, col: 5, offset: 73172},
+									label: "unit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2417, col: 10, offset: 73177},
+										name: "FrameUnit",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2417, col: 20, offset: 73187},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2417, col: 22, offset: 73189},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2417, col: 28, offset: 73195},
+										name: "FrameBound",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -14645,29 +16508,26 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptAllClause",
-			pos:  position{line: 2203, col: 1, offset: 67361},
+			name: "FrameUnit",
+			pos:  position{line: 2426, col: 1, offset: 73411},
 			expr: &choiceExpr{
-				pos: position{line: 2204, col: 5, offset: 67378},
+				pos: position{line: 2427, col: 5, offset: 73425},
 				alternatives: []any{
-					&seqExpr{
-						pos: position{line: 2204, col: 5, offset: 67378},
-						exprs: []any{
-							&ruleRefExpr{
-								pos:  position{line: 2204, col: 5, offset: 67378},
-								name: "_",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 2204, col: 7, offset: 67380},
-								name: "ALL",
-							},
+					&actionExpr{
+						pos: position{line: 2427, col: 5, offset: 73425},
+						run: (*parser).callonFrameUnit2,
+						expr: &ruleRefExpr{
+							pos:  position{line: 2427, col: 5, offset: 73425},
+							name: "ROWS",
 						},
 					},
-					&litMatcher{
-						pos:        position{line: 2205, col: 5, offset: 67388},
-						val:        "",
-						ignoreCase: false,
-						want:       "\"\"",
+					&actionExpr{
+						pos: position{line: 2428, col: 5, offset: 73458},
+						run: (*parser).callonFrameUnit4,
+						expr: &ruleRefExpr{
+							pos:  position{line: 2428, col: 5, offset: 73458},
+							name: "RANGE",
+						},
 					},
 				},
 			},
@@ -14675,272 +16535,121 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OptFromClause",
-			pos:  position{line: 2207, col: 1, offset: 67392},
+			name: "FrameBound",
+			pos:  position{line: 2430, col: 1, offset: 73489},
 			expr: &choiceExpr{
-				pos: position{line: 2208, col: 5, offset: 67410},
+				pos: position{line: 2431, col: 5, offset: 73504},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2208, col: 5, offset: 67410},
-						run: (*parser).callonOptFromClause2,
+						pos: position{line: 2431, col: 5, offset: 73504},
+						run: (*parser).callonFrameBound2,
 						expr: &seqExpr{
-							pos: position{line: 2208, col: 5, offset: 67410},
+							pos: position{line: 2431, col: 5, offset: 73504},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2208, col: 5, offset: 67410},
-									name: "_",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2208, col: 7, offset: 67412},
-									name: "FROM",
+									pos:  position{line: 2431, col: 5, offset: 73504},
+									name: "UNBOUNDED",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2208, col: 12, offset: 67417},
+									pos:  position{line: 2431, col: 15, offset: 73514},
 									name: "_",
 								},
-								&labeledExpr{
-									pos:   position{line: 2208, col: 14, offset: 67419},
-									label: "expr",
-									expr: &ruleRefExpr{
-										pos:  position{line: 2208, col: 19, offset: 67424},
-										name: "JoinedTable",
-									},
+								&ruleRefExpr{
+									pos:  position{line: 2431, col: 17, offset: 73516},
+									name: "PRECEDING",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2211, col: 5, offset: 67471},
-						run: (*parser).callonOptFromClause9,
-						expr: &litMatcher{
-							pos:        position{line: 2211, col: 5, offset: 67471},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "OptGroupClause",
-			pos:  position{line: 2213, col: 1, offset: 67512},
-			expr: &choiceExpr{
-				pos: position{line: 2214, col: 5, offset: 67531},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 2214, col: 5, offset: 67531},
-						run: (*parser).callonOptGroupClause2,
+						pos: position{line: 2432, col: 5, offset: 73604},
+						run: (*parser).callonFrameBound7,
 						expr: &seqExpr{
-							pos: position{line: 2214, col: 5, offset: 67531},
+							pos: position{line: 2432, col: 5, offset: 73604},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2214, col: 5, offset: 67531},
+									pos:  position{line: 2432, col: 5, offset: 73604},
+									name: "UNBOUNDED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2432, col: 15, offset: 73614},
 									name: "_",
 								},
-								&labeledExpr{
-									pos:   position{line: 2214, col: 7, offset: 67533},
-									label: "group",
-									expr: &ruleRefExpr{
-										pos:  position{line: 2214, col: 13, offset: 67539},
-										name: "GroupClause",
-									},
+								&ruleRefExpr{
+									pos:  position{line: 2432, col: 17, offset: 73616},
+									name: "FOLLOWING",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2215, col: 5, offset: 67577},
-						run: (*parser).callonOptGroupClause7,
-						expr: &litMatcher{
-							pos:        position{line: 2215, col: 5, offset: 67577},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "GroupClause",
-			pos:  position{line: 2217, col: 1, offset: 67618},
-			expr: &actionExpr{
-				pos: position{line: 2218, col: 5, offset: 67634},
-				run: (*parser).callonGroupClause1,
-				expr: &seqExpr{
-					pos: position{line: 2218, col: 5, offset: 67634},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2218, col: 5, offset: 67634},
-							name: "GROUP",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2218, col: 11, offset: 67640},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2218, col: 13, offset: 67642},
-							name: "BY",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2218, col: 16, offset: 67645},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 2218, col: 18, offset: 67647},
-							label: "list",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2218, col: 23, offset: 67652},
-								name: "GroupByList",
-							},
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "GroupByList",
-			pos:  position{line: 2220, col: 1, offset: 67686},
-			expr: &actionExpr{
-				pos: position{line: 2221, col: 5, offset: 67702},
-				run: (*parser).callonGroupByList1,
-				expr: &seqExpr{
-					pos: position{line: 2221, col: 5, offset: 67702},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 2221, col: 5, offset: 67702},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2221, col: 11, offset: 67708},
-								name: "GroupByItem",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 2221, col: 23, offset: 67720},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 2221, col: 28, offset: 67725},
-								expr: &actionExpr{
-									pos: position{line: 2221, col: 30, offset: 67727},
-									run: (*parser).callonGroupByList7,
-									expr: &seqExpr{
-										pos: position{line: 2221, col: 30, offset: 67727},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 2221, col: 30, offset: 67727},
-												name: "__",
-											},
-											&litMatcher{
-												pos:        position{line: 2221, col: 33, offset: 67730},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 2221, col: 37, offset: 67734},
-												name: "__",
-											},
-											&labeledExpr{
-												pos:   position{line: 2221, col: 40, offset: 67737},
-												label: "g",
-												expr: &ruleRefExpr{
-													pos:  position{line: 2221, col: 42, offset: 67739},
-													name: "GroupByItem",
-												},
-											},
-										},
-									},
+						pos: position{line: 2433, col: 5, offset: 73704},
+						run: (*parser).callonFrameBound12,
+						expr: &seqExpr{
+							pos: position{line: 2433, col: 5, offset: 73704},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2433, col: 5, offset: 73704},
+									name: "CURRENT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2433, col: 13, offset: 73712},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2433, col: 15, offset: 73714},
+									name: "ROW",
 								},
 							},
 						},
 					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "GroupByItem",
-			pos:  position{line: 2225, col: 1, offset: 67820},
-			expr: &ruleRefExpr{
-				pos:  position{line: 2225, col: 15, offset: 67834},
-				name: "Expr",
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "OptHavingClause",
-			pos:  position{line: 2227, col: 1, offset: 67840},
-			expr: &choiceExpr{
-				pos: position{line: 2228, col: 5, offset: 67860},
-				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2228, col: 5, offset: 67860},
-						run: (*parser).callonOptHavingClause2,
+						pos: position{line: 2434, col: 5, offset: 73796},
+						run: (*parser).callonFrameBound17,
 						expr: &seqExpr{
-							pos: position{line: 2228, col: 5, offset: 67860},
+							pos: position{line: 2434, col: 5, offset: 73796},
 							exprs: []any{
-								&ruleRefExpr{
-									pos:  position{line: 2228, col: 5, offset: 67860},
-									name: "_",
-								},
 								&labeledExpr{
-									pos:   position{line: 2228, col: 7, offset: 67862},
-									label: "h",
+									pos:   position{line: 2434, col: 5, offset: 73796},
+									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2228, col: 9, offset: 67864},
-										name: "HavingClause",
+										pos:  position{line: 2434, col: 7, offset: 73798},
+										name: "AdditiveExpr",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 2434, col: 20, offset: 73811},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2434, col: 22, offset: 73813},
+									name: "PRECEDING",
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2229, col: 5, offset: 67899},
-						run: (*parser).callonOptHavingClause7,
-						expr: &litMatcher{
-							pos:        position{line: 2229, col: 5, offset: 67899},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "HavingClause",
-			pos:  position{line: 2231, col: 1, offset: 67923},
-			expr: &actionExpr{
-				pos: position{line: 2232, col: 5, offset: 67940},
-				run: (*parser).callonHavingClause1,
-				expr: &seqExpr{
-					pos: position{line: 2232, col: 5, offset: 67940},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2232, col: 5, offset: 67940},
-							name: "HAVING",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2232, col: 12, offset: 67947},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 2232, col: 14, offset: 67949},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2232, col: 16, offset: 67951},
-								name: "Expr",
+						pos: position{line: 2437, col: 5, offset: 73923},
+						run: (*parser).callonFrameBound23,
+						expr: &seqExpr{
+							pos: position{line: 2437, col: 5, offset: 73923},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 2437, col: 5, offset: 73923},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2437, col: 7, offset: 73925},
+										name: "AdditiveExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2437, col: 20, offset: 73938},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2437, col: 22, offset: 73940},
+									name: "FOLLOWING",
+								},
 							},
 						},
 					},
@@ -14951,16 +16660,16 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOperation",
-			pos:  position{line: 2234, col: 1, offset: 67975},
+			pos:  position{line: 2441, col: 1, offset: 74047},
 			expr: &choiceExpr{
-				pos: position{line: 2235, col: 5, offset: 67993},
+				pos: position{line: 2442, col: 5, offset: 74065},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2235, col: 5, offset: 67993},
+						pos:  position{line: 2442, col: 5, offset: 74065},
 						name: "CrossJoin",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2236, col: 5, offset: 68007},
+						pos:  position{line: 2443, col: 5, offset: 74079},
 						name: "ConditionJoin",
 					},
 				},
@@ -14970,56 +16679,56 @@ var g = &grammar{
 		},
 		{
 			name: "CrossJoin",
-			pos:  position{line: 2238, col: 1, offset: 68022},
+			pos:  position{line: 2445, col: 1, offset: 74094},
 			expr: &actionExpr{
-				pos: position{line: 2239, col: 5, offset: 68036},
+				pos: position{line: 2446, col: 5, offset: 74108},
 				run: (*parser).callonCrossJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2239, col: 5, offset: 68036},
+					pos: position{line: 2446, col: 5, offset: 74108},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 2239, col: 6, offset: 68037},
+							pos: position{line: 2446, col: 6, offset: 74109},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 2239, col: 6, offset: 68037},
+									pos: position{line: 2446, col: 6, offset: 74109},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 6, offset: 68037},
+											pos:  position{line: 2446, col: 6, offset: 74109},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 8, offset: 68039},
+											pos:  position{line: 2446, col: 8, offset: 74111},
 											name: "CROSS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 14, offset: 68045},
+											pos:  position{line: 2446, col: 14, offset: 74117},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 16, offset: 68047},
+											pos:  position{line: 2446, col: 16, offset: 74119},
 											name: "JOIN",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 21, offset: 68052},
+											pos:  position{line: 2446, col: 21, offset: 74124},
 											name: "_",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 2239, col: 25, offset: 68056},
+									pos: position{line: 2446, col: 25, offset: 74128},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 25, offset: 68056},
+											pos:  position{line: 2446, col: 25, offset: 74128},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 2239, col: 28, offset: 68059},
+											pos:        position{line: 2446, col: 28, offset: 74131},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2239, col: 32, offset: 68063},
+											pos:  position{line: 2446, col: 32, offset: 74135},
 											name: "__",
 										},
 									},
@@ -15027,10 +16736,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2239, col: 36, offset: 68067},
+							pos:   position{line: 2446, col: 36, offset: 74139},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2239, col: 42, offset: 68073},
+								pos:  position{line: 2446, col: 42, offset: 74145},
 								name: "SQLTableExpr",
 							},
 						},
@@ -15042,45 +16751,53 @@ var g = &grammar{
 		},
 		{
 			name: "ConditionJoin",
-			pos:  position{line: 2247, col: 1, offset: 68248},
+			pos:  position{line: 2454, col: 1, offset: 74320},
 			expr: &actionExpr{
-				pos: position{line: 2248, col: 5, offset: 68266},
+				pos: position{line: 2455, col: 5, offset: 74338},
 				run: (*parser).callonConditionJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2248, col: 5, offset: 68266},
+					pos: position{line: 2455, col: 5, offset: 74338},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2248, col: 5, offset: 68266},
+							pos:   position{line: 2455, col: 5, offset: 74338},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2248, col: 11, offset: 68272},
+								pos:  position{line: 2455, col: 11, offset: 74344},
 								name: "SQLJoinStyle",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2248, col: 24, offset: 68285},
+							pos:  position{line: 2455, col: 24, offset: 74357},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2248, col: 26, offset: 68287},
+							pos:   position{line: 2455, col: 26, offset: 74359},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2248, col: 32, offset: 68293},
+								pos:  position{line: 2455, col: 32, offset: 74365},
 								name: "SQLTableExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2248, col: 45, offset: 68306},
+							pos:  position{line: 2455, col: 45, offset: 74378},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2248, col: 47, offset: 68308},
+							pos:   position{line: 2455, col: 47, offset: 74380},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2248, col: 49, offset: 68310},
+								pos:  position{line: 2455, col: 49, offset: 74382},
 								name: "JoinCond",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 2455, col: 58, offset: 74391},
+							label: "tol",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2455, col: 62, offset: 74395},
+								name: "OptTolerance",
+							},
+						},
 					},
 				},
 			},
@@ -15089,186 +16806,260 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoinStyle",
-			pos:  position{line: 2258, col: 1, offset: 68542},
+			pos:  position{line: 2469, col: 1, offset: 74721},
 			expr: &choiceExpr{
-				pos: position{line: 2259, col: 5, offset: 68559},
+				pos: position{line: 2470, col: 5, offset: 74738},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2259, col: 5, offset: 68559},
+						pos: position{line: 2470, col: 5, offset: 74738},
 						run: (*parser).callonSQLJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 2259, col: 5, offset: 68559},
+							pos: position{line: 2470, col: 5, offset: 74738},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 2259, col: 5, offset: 68559},
+									pos: position{line: 2470, col: 5, offset: 74738},
 									expr: &seqExpr{
-										pos: position{line: 2259, col: 6, offset: 68560},
+										pos: position{line: 2470, col: 6, offset: 74739},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2259, col: 6, offset: 68560},
+												pos:  position{line: 2470, col: 6, offset: 74739},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2259, col: 8, offset: 68562},
+												pos:  position{line: 2470, col: 8, offset: 74741},
 												name: "INNER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2259, col: 16, offset: 68570},
+									pos:  position{line: 2470, col: 16, offset: 74749},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2259, col: 18, offset: 68572},
+									pos:  position{line: 2470, col: 18, offset: 74751},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2260, col: 5, offset: 68617},
+						pos: position{line: 2471, col: 5, offset: 74796},
 						run: (*parser).callonSQLJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 2260, col: 5, offset: 68617},
+							pos: position{line: 2471, col: 5, offset: 74796},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 5, offset: 74796},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 7, offset: 74798},
+									name: "ASOF",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 12, offset: 74803},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 14, offset: 74805},
+									name: "LEFT",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2471, col: 19, offset: 74810},
+									expr: &seqExpr{
+										pos: position{line: 2471, col: 20, offset: 74811},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2471, col: 20, offset: 74811},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 2471, col: 22, offset: 74813},
+												name: "OUTER",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 30, offset: 74821},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2471, col: 32, offset: 74823},
+									name: "JOIN",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2472, col: 5, offset: 74860},
+						run: (*parser).callonSQLJoinStyle22,
+						expr: &seqExpr{
+							pos: position{line: 2472, col: 5, offset: 74860},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2472, col: 5, offset: 74860},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2472, col: 7, offset: 74862},
+									name: "ASOF",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2472, col: 12, offset: 74867},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2472, col: 14, offset: 74869},
+									name: "JOIN",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2473, col: 5, offset: 74901},
+						run: (*parser).callonSQLJoinStyle28,
+						expr: &seqExpr{
+							pos: position{line: 2473, col: 5, offset: 74901},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2260, col: 5, offset: 68617},
+									pos:  position{line: 2473, col: 5, offset: 74901},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2260, col: 7, offset: 68619},
+									pos:  position{line: 2473, col: 7, offset: 74903},
 									name: "ANTI",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2260, col: 12, offset: 68624},
+									pos:  position{line: 2473, col: 12, offset: 74908},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2260, col: 14, offset: 68626},
+									pos:  position{line: 2473, col: 14, offset: 74910},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2261, col: 5, offset: 68658},
-						run: (*parser).callonSQLJoinStyle16,
+						pos: position{line: 2474, col: 5, offset: 74942},
+						run: (*parser).callonSQLJoinStyle34,
 						expr: &seqExpr{
-							pos: position{line: 2261, col: 5, offset: 68658},
+							pos: position{line: 2474, col: 5, offset: 74942},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2261, col: 5, offset: 68658},
+									pos:  position{line: 2474, col: 5, offset: 74942},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2261, col: 7, offset: 68660},
+									pos:  position{line: 2474, col: 7, offset: 74944},
 									name: "FULL",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2261, col: 12, offset: 68665},
+									pos: position{line: 2474, col: 12, offset: 74949},
 									expr: &seqExpr{
-										pos: position{line: 2261, col: 13, offset: 68666},
+										pos: position{line: 2474, col: 13, offset: 74950},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2261, col: 13, offset: 68666},
+												pos:  position{line: 2474, col: 13, offset: 74950},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2261, col: 15, offset: 68668},
+												pos:  position{line: 2474, col: 15, offset: 74952},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2261, col: 23, offset: 68676},
+									pos:  position{line: 2474, col: 23, offset: 74960},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2261, col: 25, offset: 68678},
+									pos:  position{line: 2474, col: 25, offset: 74962},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2262, col: 5, offset: 68712},
-						run: (*parser).callonSQLJoinStyle26,
+						pos: position{line: 2475, col: 5, offset: 74996},
+						run: (*parser).callonSQLJoinStyle44,
 						expr: &seqExpr{
-							pos: position{line: 2262, col: 5, offset: 68712},
+							pos: position{line: 2475, col: 5, offset: 74996},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2262, col: 5, offset: 68712},
+									pos:  position{line: 2475, col: 5, offset: 74996},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2262, col: 7, offset: 68714},
+									pos:  position{line: 2475, col: 7, offset: 74998},
 									name: "LEFT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2262, col: 12, offset: 68719},
+									pos: position{line: 2475, col: 12, offset: 75003},
 									expr: &seqExpr{
-										pos: position{line: 2262, col: 13, offset: 68720},
+										pos: position{line: 2475, col: 13, offset: 75004},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2262, col: 13, offset: 68720},
+												pos:  position{line: 2475, col: 13, offset: 75004},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2262, col: 15, offset: 68722},
+												pos:  position{line: 2475, col: 15, offset: 75006},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2262, col: 23, offset: 68730},
+									pos:  position{line: 2475, col: 23, offset: 75014},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2262, col: 25, offset: 68732},
+									pos:  position{line: 2475, col: 25, offset: 75016},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2263, col: 5, offset: 68766},
-						run: (*parser).callonSQLJoinStyle36,
+						pos: position{line: 2476, col: 5, offset: 75050},
+						run: (*parser).callonSQLJoinStyle54,
 						expr: &seqExpr{
-							pos: position{line: 2263, col: 5, offset: 68766},
+							pos: position{line: 2476, col: 5, offset: 75050},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2263, col: 5, offset: 68766},
+									pos:  position{line: 2476, col: 5, offset: 75050},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2263, col: 7, offset: 68768},
+									pos:  position{line: 2476, col: 7, offset: 75052},
 									name: "RIGHT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2263, col: 13, offset: 68774},
+									pos: position{line: 2476, col: 13, offset: 75058},
 									expr: &seqExpr{
-										pos: position{line: 2263, col: 14, offset: 68775},
+										pos: position{line: 2476, col: 14, offset: 75059},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2263, col: 14, offset: 68775},
+												pos:  position{line: 2476, col: 14, offset: 75059},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2263, col: 16, offset: 68777},
+												pos:  position{line: 2476, col: 16, offset: 75061},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2263, col: 24, offset: 68785},
+									pos:  position{line: 2476, col: 24, offset: 75069},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2263, col: 26, offset: 68787},
+									pos:  position{line: 2476, col: 26, offset: 75071},
 									name: "JOIN",
 								},
 							},
@@ -15281,29 +17072,29 @@ var g = &grammar{
 		},
 		{
 			name: "JoinCond",
-			pos:  position{line: 2265, col: 1, offset: 68819},
+			pos:  position{line: 2478, col: 1, offset: 75103},
 			expr: &choiceExpr{
-				pos: position{line: 2266, col: 5, offset: 68832},
+				pos: position{line: 2479, col: 5, offset: 75116},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2266, col: 5, offset: 68832},
+						pos: position{line: 2479, col: 5, offset: 75116},
 						run: (*parser).callonJoinCond2,
 						expr: &seqExpr{
-							pos: position{line: 2266, col: 5, offset: 68832},
+							pos: position{line: 2479, col: 5, offset: 75116},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2266, col: 5, offset: 68832},
+									pos:  position{line: 2479, col: 5, offset: 75116},
 									name: "ON",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2266, col: 8, offset: 68835},
+									pos:  position{line: 2479, col: 8, offset: 75119},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2266, col: 10, offset: 68837},
+									pos:   position{line: 2479, col: 10, offset: 75121},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2266, col: 12, offset: 68839},
+										pos:  position{line: 2479, col: 12, offset: 75123},
 										name: "Expr",
 									},
 								},
@@ -15311,43 +17102,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2273, col: 5, offset: 68992},
+						pos: position{line: 2486, col: 5, offset: 75276},
 						run: (*parser).callonJoinCond8,
 						expr: &seqExpr{
-							pos: position{line: 2273, col: 5, offset: 68992},
+							pos: position{line: 2486, col: 5, offset: 75276},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2273, col: 5, offset: 68992},
+									pos:  position{line: 2486, col: 5, offset: 75276},
 									name: "USING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2273, col: 11, offset: 68998},
+									pos:  position{line: 2486, col: 11, offset: 75282},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2273, col: 14, offset: 69001},
+									pos:        position{line: 2486, col: 14, offset: 75285},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2273, col: 18, offset: 69005},
+									pos:  position{line: 2486, col: 18, offset: 75289},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 2273, col: 21, offset: 69008},
+									pos:   position{line: 2486, col: 21, offset: 75292},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2273, col: 28, offset: 69015},
+										pos:  position{line: 2486, col: 28, offset: 75299},
 										name: "Identifiers",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2273, col: 40, offset: 69027},
+									pos:  position{line: 2486, col: 40, offset: 75311},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2273, col: 43, offset: 69030},
+									pos:        position{line: 2486, col: 43, offset: 75314},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -15360,42 +17151,92 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "OptTolerance",
+			pos:  position{line: 2494, col: 1, offset: 75483},
+			expr: &choiceExpr{
+				pos: position{line: 2495, col: 5, offset: 75500},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2495, col: 5, offset: 75500},
+						run: (*parser).callonOptTolerance2,
+						expr: &seqExpr{
+							pos: position{line: 2495, col: 5, offset: 75500},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2495, col: 5, offset: 75500},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2495, col: 7, offset: 75502},
+									name: "TOLERANCE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2495, col: 17, offset: 75512},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2495, col: 19, offset: 75514},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2495, col: 21, offset: 75516},
+										name: "Expr",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2496, col: 5, offset: 75543},
+						run: (*parser).callonOptTolerance9,
+						expr: &litMatcher{
+							pos:        position{line: 2496, col: 5, offset: 75543},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "OptOrdinality",
-			pos:  position{line: 2281, col: 1, offset: 69199},
+			pos:  position{line: 2498, col: 1, offset: 75585},
 			expr: &choiceExpr{
-				pos: position{line: 2282, col: 5, offset: 69217},
+				pos: position{line: 2499, col: 5, offset: 75603},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2282, col: 5, offset: 69217},
+						pos: position{line: 2499, col: 5, offset: 75603},
 						run: (*parser).callonOptOrdinality2,
 						expr: &seqExpr{
-							pos: position{line: 2282, col: 5, offset: 69217},
+							pos: position{line: 2499, col: 5, offset: 75603},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2282, col: 5, offset: 69217},
+									pos:  position{line: 2499, col: 5, offset: 75603},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2282, col: 7, offset: 69219},
+									pos:  position{line: 2499, col: 7, offset: 75605},
 									name: "WITH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2282, col: 12, offset: 69224},
+									pos:  position{line: 2499, col: 12, offset: 75610},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2282, col: 14, offset: 69226},
+									pos:  position{line: 2499, col: 14, offset: 75612},
 									name: "ORDINALITY",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2287, col: 5, offset: 69323},
+						pos: position{line: 2504, col: 5, offset: 75709},
 						run: (*parser).callonOptOrdinality8,
 						expr: &litMatcher{
-							pos:        position{line: 2287, col: 5, offset: 69323},
+							pos:        position{line: 2504, col: 5, offset: 75709},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15408,25 +17249,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptAlias",
-			pos:  position{line: 2289, col: 1, offset: 69372},
+			pos:  position{line: 2506, col: 1, offset: 75758},
 			expr: &choiceExpr{
-				pos: position{line: 2290, col: 5, offset: 69385},
+				pos: position{line: 2507, col: 5, offset: 75771},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2290, col: 5, offset: 69385},
+						pos: position{line: 2507, col: 5, offset: 75771},
 						run: (*parser).callonOptAlias2,
 						expr: &seqExpr{
-							pos: position{line: 2290, col: 5, offset: 69385},
+							pos: position{line: 2507, col: 5, offset: 75771},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2290, col: 5, offset: 69385},
+									pos:  position{line: 2507, col: 5, offset: 75771},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2290, col: 7, offset: 69387},
+									pos:   position{line: 2507, col: 7, offset: 75773},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2290, col: 9, offset: 69389},
+										pos:  position{line: 2507, col: 9, offset: 75775},
 										name: "AliasClause",
 									},
 								},
@@ -15434,10 +17275,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2291, col: 5, offset: 69423},
+						pos: position{line: 2508, col: 5, offset: 75809},
 						run: (*parser).callonOptAlias7,
 						expr: &litMatcher{
-							pos:        position{line: 2291, col: 5, offset: 69423},
+							pos:        position{line: 2508, col: 5, offset: 75809},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15450,41 +17291,41 @@ var g = &grammar{
 		},
 		{
 			name: "AliasClause",
-			pos:  position{line: 2293, col: 1, offset: 69460},
+			pos:  position{line: 2510, col: 1, offset: 75846},
 			expr: &actionExpr{
-				pos: position{line: 2294, col: 4, offset: 69475},
+				pos: position{line: 2511, col: 4, offset: 75861},
 				run: (*parser).callonAliasClause1,
 				expr: &seqExpr{
-					pos: position{line: 2294, col: 4, offset: 69475},
+					pos: position{line: 2511, col: 4, offset: 75861},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 2294, col: 4, offset: 69475},
+							pos: position{line: 2511, col: 4, offset: 75861},
 							expr: &seqExpr{
-								pos: position{line: 2294, col: 5, offset: 69476},
+								pos: position{line: 2511, col: 5, offset: 75862},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 2294, col: 5, offset: 69476},
+										pos:  position{line: 2511, col: 5, offset: 75862},
 										name: "AS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 2294, col: 8, offset: 69479},
+										pos:  position{line: 2511, col: 8, offset: 75865},
 										name: "_",
 									},
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 2294, col: 12, offset: 69483},
+							pos: position{line: 2511, col: 12, offset: 75869},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2294, col: 13, offset: 69484},
+								pos:  position{line: 2511, col: 13, offset: 75870},
 								name: "SQLGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2294, col: 22, offset: 69493},
+							pos:   position{line: 2511, col: 22, offset: 75879},
 							label: "alias",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2294, col: 28, offset: 69499},
+								pos:  position{line: 2511, col: 28, offset: 75885},
 								name: "TableAlias",
 							},
 						},
@@ -15496,28 +17337,28 @@ var g = &grammar{
 		},
 		{
 			name: "TableAlias",
-			pos:  position{line: 2296, col: 1, offset: 69533},
+			pos:  position{line: 2513, col: 1, offset: 75919},
 			expr: &actionExpr{
-				pos: position{line: 2297, col: 4, offset: 69547},
+				pos: position{line: 2514, col: 4, offset: 75933},
 				run: (*parser).callonTableAlias1,
 				expr: &seqExpr{
-					pos: position{line: 2297, col: 4, offset: 69547},
+					pos: position{line: 2514, col: 4, offset: 75933},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2297, col: 4, offset: 69547},
+							pos:   position{line: 2514, col: 4, offset: 75933},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2297, col: 9, offset: 69552},
+								pos:  position{line: 2514, col: 9, offset: 75938},
 								name: "SQLIdentifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2297, col: 23, offset: 69566},
+							pos:   position{line: 2514, col: 23, offset: 75952},
 							label: "cols",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2297, col: 28, offset: 69571},
+								pos: position{line: 2514, col: 28, offset: 75957},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2297, col: 28, offset: 69571},
+									pos:  position{line: 2514, col: 28, offset: 75957},
 									name: "Columns",
 								},
 							},
@@ -15530,65 +17371,65 @@ var g = &grammar{
 		},
 		{
 			name: "Columns",
-			pos:  position{line: 2305, col: 1, offset: 69756},
+			pos:  position{line: 2522, col: 1, offset: 76142},
 			expr: &actionExpr{
-				pos: position{line: 2306, col: 5, offset: 69768},
+				pos: position{line: 2523, col: 5, offset: 76154},
 				run: (*parser).callonColumns1,
 				expr: &seqExpr{
-					pos: position{line: 2306, col: 5, offset: 69768},
+					pos: position{line: 2523, col: 5, offset: 76154},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2306, col: 5, offset: 69768},
+							pos:  position{line: 2523, col: 5, offset: 76154},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2306, col: 8, offset: 69771},
+							pos:        position{line: 2523, col: 8, offset: 76157},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2306, col: 12, offset: 69775},
+							pos:  position{line: 2523, col: 12, offset: 76161},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2306, col: 15, offset: 69778},
+							pos:   position{line: 2523, col: 15, offset: 76164},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2306, col: 21, offset: 69784},
+								pos:  position{line: 2523, col: 21, offset: 76170},
 								name: "SQLIdentifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2306, col: 35, offset: 69798},
+							pos:   position{line: 2523, col: 35, offset: 76184},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2306, col: 40, offset: 69803},
+								pos: position{line: 2523, col: 40, offset: 76189},
 								expr: &actionExpr{
-									pos: position{line: 2306, col: 42, offset: 69805},
+									pos: position{line: 2523, col: 42, offset: 76191},
 									run: (*parser).callonColumns10,
 									expr: &seqExpr{
-										pos: position{line: 2306, col: 42, offset: 69805},
+										pos: position{line: 2523, col: 42, offset: 76191},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2306, col: 42, offset: 69805},
+												pos:  position{line: 2523, col: 42, offset: 76191},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2306, col: 45, offset: 69808},
+												pos:        position{line: 2523, col: 45, offset: 76194},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2306, col: 49, offset: 69812},
+												pos:  position{line: 2523, col: 49, offset: 76198},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2306, col: 52, offset: 69815},
+												pos:   position{line: 2523, col: 52, offset: 76201},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2306, col: 54, offset: 69817},
+													pos:  position{line: 2523, col: 54, offset: 76203},
 													name: "SQLIdentifier",
 												},
 											},
@@ -15598,11 +17439,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2306, col: 87, offset: 69850},
+							pos:  position{line: 2523, col: 87, offset: 76236},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2306, col: 90, offset: 69853},
+							pos:        position{line: 2523, col: 90, offset: 76239},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -15615,51 +17456,51 @@ var g = &grammar{
 		},
 		{
 			name: "Selection",
-			pos:  position{line: 2310, col: 1, offset: 69924},
+			pos:  position{line: 2527, col: 1, offset: 76310},
 			expr: &actionExpr{
-				pos: position{line: 2311, col: 5, offset: 69938},
+				pos: position{line: 2528, col: 5, offset: 76324},
 				run: (*parser).callonSelection1,
 				expr: &seqExpr{
-					pos: position{line: 2311, col: 5, offset: 69938},
+					pos: position{line: 2528, col: 5, offset: 76324},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2311, col: 5, offset: 69938},
+							pos:   position{line: 2528, col: 5, offset: 76324},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2311, col: 11, offset: 69944},
+								pos:  position{line: 2528, col: 11, offset: 76330},
 								name: "SelectElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2311, col: 22, offset: 69955},
+							pos:   position{line: 2528, col: 22, offset: 76341},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2311, col: 27, offset: 69960},
+								pos: position{line: 2528, col: 27, offset: 76346},
 								expr: &actionExpr{
-									pos: position{line: 2311, col: 29, offset: 69962},
+									pos: position{line: 2528, col: 29, offset: 76348},
 									run: (*parser).callonSelection7,
 									expr: &seqExpr{
-										pos: position{line: 2311, col: 29, offset: 69962},
+										pos: position{line: 2528, col: 29, offset: 76348},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2311, col: 29, offset: 69962},
+												pos:  position{line: 2528, col: 29, offset: 76348},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2311, col: 32, offset: 69965},
+												pos:        position{line: 2528, col: 32, offset: 76351},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2311, col: 36, offset: 69969},
+												pos:  position{line: 2528, col: 36, offset: 76355},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2311, col: 39, offset: 69972},
+												pos:   position{line: 2528, col: 39, offset: 76358},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2311, col: 41, offset: 69974},
+													pos:  position{line: 2528, col: 41, offset: 76360},
 													name: "SelectElem",
 												},
 											},
@@ -15676,26 +17517,26 @@ var g = &grammar{
 		},
 		{
 			name: "SelectElem",
-			pos:  position{line: 2318, col: 1, offset: 70136},
+			pos:  position{line: 2535, col: 1, offset: 76522},
 			expr: &actionExpr{
-				pos: position{line: 2319, col: 5, offset: 70151},
+				pos: position{line: 2536, col: 5, offset: 76537},
 				run: (*parser).callonSelectElem1,
 				expr: &seqExpr{
-					pos: position{line: 2319, col: 5, offset: 70151},
+					pos: position{line: 2536, col: 5, offset: 76537},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2319, col: 5, offset: 70151},
+							pos:   position{line: 2536, col: 5, offset: 76537},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2319, col: 10, offset: 70156},
+								pos:  position{line: 2536, col: 10, offset: 76542},
 								name: "ColumnExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2319, col: 21, offset: 70167},
+							pos:   position{line: 2536, col: 21, offset: 76553},
 							label: "as",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2319, col: 24, offset: 70170},
+								pos:  position{line: 2536, col: 24, offset: 76556},
 								name: "OptAsClause",
 							},
 						},
@@ -15707,40 +17548,40 @@ var g = &grammar{
 		},
 		{
 			name: "ColumnExpr",
-			pos:  position{line: 2332, col: 1, offset: 70444},
+			pos:  position{line: 2549, col: 1, offset: 76830},
 			expr: &choiceExpr{
-				pos: position{line: 2333, col: 5, offset: 70459},
+				pos: position{line: 2550, col: 5, offset: 76845},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2333, col: 5, offset: 70459},
+						pos: position{line: 2550, col: 5, offset: 76845},
 						run: (*parser).callonColumnExpr2,
 						expr: &seqExpr{
-							pos: position{line: 2333, col: 5, offset: 70459},
+							pos: position{line: 2550, col: 5, offset: 76845},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2333, col: 5, offset: 70459},
+									pos:   position{line: 2550, col: 5, offset: 76845},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2333, col: 11, offset: 70465},
+										pos:  position{line: 2550, col: 11, offset: 76851},
 										name: "SQLIdentifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2333, col: 25, offset: 70479},
+									pos:  position{line: 2550, col: 25, offset: 76865},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2333, col: 28, offset: 70482},
+									pos:        position{line: 2550, col: 28, offset: 76868},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2333, col: 32, offset: 70486},
+									pos:  position{line: 2550, col: 32, offset: 76872},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2333, col: 35, offset: 70489},
+									pos:        position{line: 2550, col: 35, offset: 76875},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -15749,17 +17590,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2340, col: 5, offset: 70630},
+						pos: position{line: 2557, col: 5, offset: 77016},
 						run: (*parser).callonColumnExpr10,
 						expr: &litMatcher{
-							pos:        position{line: 2340, col: 5, offset: 70630},
+							pos:        position{line: 2557, col: 5, offset: 77016},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2343, col: 5, offset: 70709},
+						pos:  position{line: 2560, col: 5, offset: 77095},
 						name: "Expr",
 					},
 				},
@@ -15769,33 +17610,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptAsClause",
-			pos:  position{line: 2345, col: 1, offset: 70715},
+			pos:  position{line: 2562, col: 1, offset: 77101},
 			expr: &choiceExpr{
-				pos: position{line: 2346, col: 5, offset: 70731},
+				pos: position{line: 2563, col: 5, offset: 77117},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2346, col: 5, offset: 70731},
+						pos: position{line: 2563, col: 5, offset: 77117},
 						run: (*parser).callonOptAsClause2,
 						expr: &seqExpr{
-							pos: position{line: 2346, col: 5, offset: 70731},
+							pos: position{line: 2563, col: 5, offset: 77117},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 5, offset: 70731},
+									pos:  position{line: 2563, col: 5, offset: 77117},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 7, offset: 70733},
+									pos:  position{line: 2563, col: 7, offset: 77119},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 10, offset: 70736},
+									pos:  position{line: 2563, col: 10, offset: 77122},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2346, col: 12, offset: 70738},
+									pos:   position{line: 2563, col: 12, offset: 77124},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2346, col: 15, offset: 70741},
+										pos:  position{line: 2563, col: 15, offset: 77127},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15803,27 +17644,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2347, col: 5, offset: 70778},
+						pos: position{line: 2564, col: 5, offset: 77164},
 						run: (*parser).callonOptAsClause9,
 						expr: &seqExpr{
-							pos: position{line: 2347, col: 5, offset: 70778},
+							pos: position{line: 2564, col: 5, offset: 77164},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2347, col: 5, offset: 70778},
+									pos:  position{line: 2564, col: 5, offset: 77164},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 2347, col: 7, offset: 70780},
+									pos: position{line: 2564, col: 7, offset: 77166},
 									expr: &ruleRefExpr{
-										pos:  position{line: 2347, col: 8, offset: 70781},
+										pos:  position{line: 2564, col: 8, offset: 77167},
 										name: "SQLGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2347, col: 17, offset: 70790},
+									pos:   position{line: 2564, col: 17, offset: 77176},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2347, col: 20, offset: 70793},
+										pos:  position{line: 2564, col: 20, offset: 77179},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15831,10 +17672,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2348, col: 5, offset: 70830},
+						pos: position{line: 2565, col: 5, offset: 77216},
 						run: (*parser).callonOptAsClause16,
 						expr: &litMatcher{
-							pos:        position{line: 2348, col: 5, offset: 70830},
+							pos:        position{line: 2565, col: 5, offset: 77216},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15847,41 +17688,41 @@ var g = &grammar{
 		},
 		{
 			name: "OptOrderByClause",
-			pos:  position{line: 2350, col: 1, offset: 70855},
+			pos:  position{line: 2567, col: 1, offset: 77241},
 			expr: &choiceExpr{
-				pos: position{line: 2351, col: 5, offset: 70876},
+				pos: position{line: 2568, col: 5, offset: 77262},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2351, col: 5, offset: 70876},
+						pos: position{line: 2568, col: 5, offset: 77262},
 						run: (*parser).callonOptOrderByClause2,
 						expr: &seqExpr{
-							pos: position{line: 2351, col: 5, offset: 70876},
+							pos: position{line: 2568, col: 5, offset: 77262},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 5, offset: 70876},
+									pos:  position{line: 2568, col: 5, offset: 77262},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 7, offset: 70878},
+									pos:  position{line: 2568, col: 7, offset: 77264},
 									name: "ORDER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 13, offset: 70884},
+									pos:  position{line: 2568, col: 13, offset: 77270},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 15, offset: 70886},
+									pos:  position{line: 2568, col: 15, offset: 77272},
 									name: "BY",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 18, offset: 70889},
+									pos:  position{line: 2568, col: 18, offset: 77275},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2351, col: 20, offset: 70891},
+									pos:   position{line: 2568, col: 20, offset: 77277},
 									label: "list",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2351, col: 25, offset: 70896},
+										pos:  position{line: 2568, col: 25, offset: 77282},
 										name: "OrderByList",
 									},
 								},
@@ -15889,10 +17730,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2357, col: 5, offset: 71030},
+						pos: position{line: 2574, col: 5, offset: 77416},
 						run: (*parser).callonOptOrderByClause11,
 						expr: &litMatcher{
-							pos:        position{line: 2357, col: 5, offset: 71030},
+							pos:        position{line: 2574, col: 5, offset: 77416},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15905,51 +17746,51 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByList",
-			pos:  position{line: 2359, col: 1, offset: 71063},
+			pos:  position{line: 2576, col: 1, offset: 77449},
 			expr: &actionExpr{
-				pos: position{line: 2360, col: 5, offset: 71079},
+				pos: position{line: 2577, col: 5, offset: 77465},
 				run: (*parser).callonOrderByList1,
 				expr: &seqExpr{
-					pos: position{line: 2360, col: 5, offset: 71079},
+					pos: position{line: 2577, col: 5, offset: 77465},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2360, col: 5, offset: 71079},
+							pos:   position{line: 2577, col: 5, offset: 77465},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2360, col: 11, offset: 71085},
+								pos:  position{line: 2577, col: 11, offset: 77471},
 								name: "OrderByItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2360, col: 23, offset: 71097},
+							pos:   position{line: 2577, col: 23, offset: 77483},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2360, col: 28, offset: 71102},
+								pos: position{line: 2577, col: 28, offset: 77488},
 								expr: &actionExpr{
-									pos: position{line: 2360, col: 30, offset: 71104},
+									pos: position{line: 2577, col: 30, offset: 77490},
 									run: (*parser).callonOrderByList7,
 									expr: &seqExpr{
-										pos: position{line: 2360, col: 30, offset: 71104},
+										pos: position{line: 2577, col: 30, offset: 77490},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2360, col: 30, offset: 71104},
+												pos:  position{line: 2577, col: 30, offset: 77490},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2360, col: 33, offset: 71107},
+												pos:        position{line: 2577, col: 33, offset: 77493},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2360, col: 37, offset: 71111},
+												pos:  position{line: 2577, col: 37, offset: 77497},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2360, col: 40, offset: 71114},
+												pos:   position{line: 2577, col: 40, offset: 77500},
 												label: "o",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2360, col: 42, offset: 71116},
+													pos:  position{line: 2577, col: 42, offset: 77502},
 													name: "OrderByItem",
 												},
 											},
@@ -15966,34 +17807,34 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByItem",
-			pos:  position{line: 2364, col: 1, offset: 71217},
+			pos:  position{line: 2581, col: 1, offset: 77603},
 			expr: &actionExpr{
-				pos: position{line: 2365, col: 5, offset: 71233},
+				pos: position{line: 2582, col: 5, offset: 77619},
 				run: (*parser).callonOrderByItem1,
 				expr: &seqExpr{
-					pos: position{line: 2365, col: 5, offset: 71233},
+					pos: position{line: 2582, col: 5, offset: 77619},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2365, col: 5, offset: 71233},
+							pos:   position{line: 2582, col: 5, offset: 77619},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2365, col: 7, offset: 71235},
+								pos:  position{line: 2582, col: 7, offset: 77621},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2365, col: 12, offset: 71240},
+							pos:   position{line: 2582, col: 12, offset: 77626},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2365, col: 18, offset: 71246},
+								pos:  position{line: 2582, col: 18, offset: 77632},
 								name: "OptAscDesc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2365, col: 29, offset: 71257},
+							pos:   position{line: 2582, col: 29, offset: 77643},
 							label: "nulls",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2365, col: 35, offset: 71263},
+								pos:  position{line: 2582, col: 35, offset: 77649},
 								name: "OptNullsOrder",
 							},
 						},
@@ -16005,49 +17846,49 @@ var g = &grammar{
 		},
 		{
 			name: "OptAscDesc",
-			pos:  position{line: 2376, col: 1, offset: 71495},
+			pos:  position{line: 2593, col: 1, offset: 77881},
 			expr: &choiceExpr{
-				pos: position{line: 2377, col: 5, offset: 71510},
+				pos: position{line: 2594, col: 5, offset: 77896},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2377, col: 5, offset: 71510},
+						pos: position{line: 2594, col: 5, offset: 77896},
 						run: (*parser).callonOptAscDesc2,
 						expr: &seqExpr{
-							pos: position{line: 2377, col: 5, offset: 71510},
+							pos: position{line: 2594, col: 5, offset: 77896},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2377, col: 5, offset: 71510},
+									pos:  position{line: 2594, col: 5, offset: 77896},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2377, col: 7, offset: 71512},
+									pos:  position{line: 2594, col: 7, offset: 77898},
 									name: "ASC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2378, col: 5, offset: 71572},
+						pos: position{line: 2595, col: 5, offset: 77958},
 						run: (*parser).callonOptAscDesc6,
 						expr: &seqExpr{
-							pos: position{line: 2378, col: 5, offset: 71572},
+							pos: position{line: 2595, col: 5, offset: 77958},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2378, col: 5, offset: 71572},
+									pos:  position{line: 2595, col: 5, offset: 77958},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2378, col: 7, offset: 71574},
+									pos:  position{line: 2595, col: 7, offset: 77960},
 									name: "DESC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2379, col: 5, offset: 71634},
+						pos: position{line: 2596, col: 5, offset: 78020},
 						run: (*parser).callonOptAscDesc10,
 						expr: &litMatcher{
-							pos:        position{line: 2379, col: 5, offset: 71634},
+							pos:        position{line: 2596, col: 5, offset: 78020},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16060,65 +17901,65 @@ var g = &grammar{
 		},
 		{
 			name: "OptNullsOrder",
-			pos:  position{line: 2381, col: 1, offset: 71666},
+			pos:  position{line: 2598, col: 1, offset: 78052},
 			expr: &choiceExpr{
-				pos: position{line: 2382, col: 5, offset: 71684},
+				pos: position{line: 2599, col: 5, offset: 78070},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2382, col: 5, offset: 71684},
+						pos: position{line: 2599, col: 5, offset: 78070},
 						run: (*parser).callonOptNullsOrder2,
 						expr: &seqExpr{
-							pos: position{line: 2382, col: 5, offset: 71684},
+							pos: position{line: 2599, col: 5, offset: 78070},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2382, col: 5, offset: 71684},
+									pos:  position{line: 2599, col: 5, offset: 78070},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2382, col: 7, offset: 71686},
+									pos:  position{line: 2599, col: 7, offset: 78072},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2382, col: 13, offset: 71692},
+									pos:  position{line: 2599, col: 13, offset: 78078},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2382, col: 15, offset: 71694},
+									pos:  position{line: 2599, col: 15, offset: 78080},
 									name: "FIRST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2383, col: 5, offset: 71758},
+						pos: position{line: 2600, col: 5, offset: 78144},
 						run: (*parser).callonOptNullsOrder8,
 						expr: &seqExpr{
-							pos: position{line: 2383, col: 5, offset: 71758},
+							pos: position{line: 2600, col: 5, offset: 78144},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 5, offset: 71758},
+									pos:  position{line: 2600, col: 5, offset: 78144},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 7, offset: 71760},
+									pos:  position{line: 2600, col: 7, offset: 78146},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 13, offset: 71766},
+									pos:  position{line: 2600, col: 13, offset: 78152},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 15, offset: 71768},
+									pos:  position{line: 2600, col: 15, offset: 78154},
 									name: "LAST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2384, col: 5, offset: 71831},
+						pos: position{line: 2601, col: 5, offset: 78217},
 						run: (*parser).callonOptNullsOrder14,
 						expr: &litMatcher{
-							pos:        position{line: 2384, col: 5, offset: 71831},
+							pos:        position{line: 2601, col: 5, offset: 78217},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16131,25 +17972,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptSQLLimitOffset",
-			pos:  position{line: 2386, col: 1, offset: 71876},
+			pos:  position{line: 2603, col: 1, offset: 78262},
 			expr: &choiceExpr{
-				pos: position{line: 2387, col: 5, offset: 71898},
+				pos: position{line: 2604, col: 5, offset: 78284},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2387, col: 5, offset: 71898},
+						pos: position{line: 2604, col: 5, offset: 78284},
 						run: (*parser).callonOptSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2387, col: 5, offset: 71898},
+							pos: position{line: 2604, col: 5, offset: 78284},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2387, col: 5, offset: 71898},
+									pos:  position{line: 2604, col: 5, offset: 78284},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2387, col: 7, offset: 71900},
+									pos:   position{line: 2604, col: 7, offset: 78286},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2387, col: 10, offset: 71903},
+										pos:  position{line: 2604, col: 10, offset: 78289},
 										name: "SQLLimitOffset",
 									},
 								},
@@ -16157,10 +17998,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2388, col: 5, offset: 71941},
+						pos: position{line: 2605, col: 5, offset: 78327},
 						run: (*parser).callonOptSQLLimitOffset7,
 						expr: &litMatcher{
-							pos:        position{line: 2388, col: 5, offset: 71941},
+							pos:        position{line: 2605, col: 5, offset: 78327},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16173,29 +18014,29 @@ var g = &grammar{
 		},
 		{
 			name: "SQLLimitOffset",
-			pos:  position{line: 2390, col: 1, offset: 71982},
+			pos:  position{line: 2607, col: 1, offset: 78368},
 			expr: &choiceExpr{
-				pos: position{line: 2391, col: 5, offset: 72001},
+				pos: position{line: 2608, col: 5, offset: 78387},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2391, col: 5, offset: 72001},
+						pos: position{line: 2608, col: 5, offset: 78387},
 						run: (*parser).callonSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2391, col: 5, offset: 72001},
+							pos: position{line: 2608, col: 5, offset: 78387},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2391, col: 5, offset: 72001},
+									pos:   position{line: 2608, col: 5, offset: 78387},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2391, col: 7, offset: 72003},
+										pos:  position{line: 2608, col: 7, offset: 78389},
 										name: "LimitClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2391, col: 19, offset: 72015},
+									pos:   position{line: 2608, col: 19, offset: 78401},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2391, col: 21, offset: 72017},
+										pos:  position{line: 2608, col: 21, offset: 78403},
 										name: "OptOffsetClause",
 									},
 								},
@@ -16203,24 +18044,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2403, col: 5, offset: 72249},
+						pos: position{line: 2620, col: 5, offset: 78635},
 						run: (*parser).callonSQLLimitOffset8,
 						expr: &seqExpr{
-							pos: position{line: 2403, col: 5, offset: 72249},
+							pos: position{line: 2620, col: 5, offset: 78635},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2403, col: 5, offset: 72249},
+									pos:   position{line: 2620, col: 5, offset: 78635},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2403, col: 7, offset: 72251},
+										pos:  position{line: 2620, col: 7, offset: 78637},
 										name: "OffsetClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2403, col: 20, offset: 72264},
+									pos:   position{line: 2620, col: 20, offset: 78650},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2403, col: 22, offset: 72266},
+										pos:  position{line: 2620, col: 22, offset: 78652},
 										name: "OptLimitClause",
 									},
 								},
@@ -16234,25 +18075,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptLimitClause",
-			pos:  position{line: 2414, col: 1, offset: 72463},
+			pos:  position{line: 2631, col: 1, offset: 78849},
 			expr: &choiceExpr{
-				pos: position{line: 2415, col: 5, offset: 72482},
+				pos: position{line: 2632, col: 5, offset: 78868},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2415, col: 5, offset: 72482},
+						pos: position{line: 2632, col: 5, offset: 78868},
 						run: (*parser).callonOptLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2415, col: 5, offset: 72482},
+							pos: position{line: 2632, col: 5, offset: 78868},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2415, col: 5, offset: 72482},
+									pos:  position{line: 2632, col: 5, offset: 78868},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2415, col: 7, offset: 72484},
+									pos:   position{line: 2632, col: 7, offset: 78870},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2415, col: 9, offset: 72486},
+										pos:  position{line: 2632, col: 9, offset: 78872},
 										name: "LimitClause",
 									},
 								},
@@ -16260,10 +18101,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2416, col: 5, offset: 72520},
+						pos: position{line: 2633, col: 5, offset: 78906},
 						run: (*parser).callonOptLimitClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2416, col: 5, offset: 72520},
+							pos:        position{line: 2633, col: 5, offset: 78906},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16276,50 +18117,50 @@ var g = &grammar{
 		},
 		{
 			name: "LimitClause",
-			pos:  position{line: 2418, col: 1, offset: 72557},
+			pos:  position{line: 2635, col: 1, offset: 78943},
 			expr: &choiceExpr{
-				pos: position{line: 2419, col: 5, offset: 72573},
+				pos: position{line: 2636, col: 5, offset: 78959},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2419, col: 5, offset: 72573},
+						pos: position{line: 2636, col: 5, offset: 78959},
 						run: (*parser).callonLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2419, col: 5, offset: 72573},
+							pos: position{line: 2636, col: 5, offset: 78959},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2419, col: 5, offset: 72573},
+									pos:  position{line: 2636, col: 5, offset: 78959},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2419, col: 11, offset: 72579},
+									pos:  position{line: 2636, col: 11, offset: 78965},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2419, col: 13, offset: 72581},
+									pos:  position{line: 2636, col: 13, offset: 78967},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2420, col: 5, offset: 72609},
+						pos: position{line: 2637, col: 5, offset: 78995},
 						run: (*parser).callonLimitClause7,
 						expr: &seqExpr{
-							pos: position{line: 2420, col: 5, offset: 72609},
+							pos: position{line: 2637, col: 5, offset: 78995},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2420, col: 5, offset: 72609},
+									pos:  position{line: 2637, col: 5, offset: 78995},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2420, col: 11, offset: 72615},
+									pos:  position{line: 2637, col: 11, offset: 79001},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2420, col: 13, offset: 72617},
+									pos:   position{line: 2637, col: 13, offset: 79003},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2420, col: 15, offset: 72619},
+										pos:  position{line: 2637, col: 15, offset: 79005},
 										name: "Expr",
 									},
 								},
@@ -16333,25 +18174,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptOffsetClause",
-			pos:  position{line: 2422, col: 1, offset: 72643},
+			pos:  position{line: 2639, col: 1, offset: 79029},
 			expr: &choiceExpr{
-				pos: position{line: 2423, col: 5, offset: 72663},
+				pos: position{line: 2640, col: 5, offset: 79049},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2423, col: 5, offset: 72663},
+						pos: position{line: 2640, col: 5, offset: 79049},
 						run: (*parser).callonOptOffsetClause2,
 						expr: &seqExpr{
-							pos: position{line: 2423, col: 5, offset: 72663},
+							pos: position{line: 2640, col: 5, offset: 79049},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2423, col: 5, offset: 72663},
+									pos:  position{line: 2640, col: 5, offset: 79049},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2423, col: 7, offset: 72665},
+									pos:   position{line: 2640, col: 7, offset: 79051},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2423, col: 9, offset: 72667},
+										pos:  position{line: 2640, col: 9, offset: 79053},
 										name: "OffsetClause",
 									},
 								},
@@ -16359,10 +18200,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2424, col: 5, offset: 72703},
+						pos: position{line: 2641, col: 5, offset: 79089},
 						run: (*parser).callonOptOffsetClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2424, col: 5, offset: 72703},
+							pos:        position{line: 2641, col: 5, offset: 79089},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16375,26 +18216,26 @@ var g = &grammar{
 		},
 		{
 			name: "OffsetClause",
-			pos:  position{line: 2426, col: 1, offset: 72728},
+			pos:  position{line: 2643, col: 1, offset: 79114},
 			expr: &actionExpr{
-				pos: position{line: 2427, col: 5, offset: 72745},
+				pos: position{line: 2644, col: 5, offset: 79131},
 				run: (*parser).callonOffsetClause1,
 				expr: &seqExpr{
-					pos: position{line: 2427, col: 5, offset: 72745},
+					pos: position{line: 2644, col: 5, offset: 79131},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2427, col: 5, offset: 72745},
+							pos:  position{line: 2644, col: 5, offset: 79131},
 							name: "OFFSET",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2427, col: 12, offset: 72752},
+							pos:  position{line: 2644, col: 12, offset: 79138},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2427, col: 14, offset: 72754},
+							pos:   position{line: 2644, col: 14, offset: 79140},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2427, col: 16, offset: 72756},
+								pos:  position{line: 2644, col: 16, offset: 79142},
 								name: "Expr",
 							},
 						},
@@ -16406,63 +18247,134 @@ var g = &grammar{
 		},
 		{
 			name: "SetOp",
-			pos:  position{line: 2429, col: 1, offset: 72781},
+			pos:  position{line: 2646, col: 1, offset: 79167},
 			expr: &choiceExpr{
-				pos: position{line: 2430, col: 5, offset: 72791},
+				pos: position{line: 2647, col: 5, offset: 79177},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2430, col: 5, offset: 72791},
+						pos: position{line: 2647, col: 5, offset: 79177},
 						run: (*parser).callonSetOp2,
 						expr: &seqExpr{
-							pos: position{line: 2430, col: 5, offset: 72791},
+							pos: position{line: 2647, col: 5, offset: 79177},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2430, col: 5, offset: 72791},
+									pos:  position{line: 2647, col: 5, offset: 79177},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2430, col: 7, offset: 72793},
+									pos:  position{line: 2647, col: 7, offset: 79179},
 									name: "UNION",
 								},
+								&labeledExpr{
+									pos:   position{line: 2647, col: 13, offset: 79185},
+									label: "distinct",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2647, col: 22, offset: 79194},
+										name: "SetQuantifier",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2648, col: 5, offset: 79254},
+						run: (*parser).callonSetOp8,
+						expr: &seqExpr{
+							pos: position{line: 2648, col: 5, offset: 79254},
+							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2430, col: 13, offset: 72799},
+									pos:  position{line: 2648, col: 5, offset: 79254},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2430, col: 15, offset: 72801},
-									name: "ALL",
+									pos:  position{line: 2648, col: 7, offset: 79256},
+									name: "EXCEPT",
+								},
+								&labeledExpr{
+									pos:   position{line: 2648, col: 14, offset: 79263},
+									label: "distinct",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2648, col: 23, offset: 79272},
+										name: "SetQuantifier",
+									},
 								},
 							},
 						},
 					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "IntersectOp",
+			pos:  position{line: 2650, col: 1, offset: 79329},
+			expr: &actionExpr{
+				pos: position{line: 2651, col: 5, offset: 79345},
+				run: (*parser).callonIntersectOp1,
+				expr: &seqExpr{
+					pos: position{line: 2651, col: 5, offset: 79345},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2651, col: 5, offset: 79345},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2651, col: 7, offset: 79347},
+							name: "INTERSECT",
+						},
+						&labeledExpr{
+							pos:   position{line: 2651, col: 17, offset: 79357},
+							label: "distinct",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2651, col: 26, offset: 79366},
+								name: "SetQuantifier",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SetQuantifier",
+			pos:  position{line: 2653, col: 1, offset: 79426},
+			expr: &choiceExpr{
+				pos: position{line: 2654, col: 5, offset: 79444},
+				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2431, col: 5, offset: 72837},
-						run: (*parser).callonSetOp8,
+						pos: position{line: 2654, col: 5, offset: 79444},
+						run: (*parser).callonSetQuantifier2,
 						expr: &seqExpr{
-							pos: position{line: 2431, col: 5, offset: 72837},
+							pos: position{line: 2654, col: 5, offset: 79444},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2431, col: 5, offset: 72837},
+									pos:  position{line: 2654, col: 5, offset: 79444},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2431, col: 7, offset: 72839},
-									name: "UNION",
+									pos:  position{line: 2654, col: 7, offset: 79446},
+									name: "ALL",
 								},
-								&zeroOrOneExpr{
-									pos: position{line: 2431, col: 13, offset: 72845},
-									expr: &seqExpr{
-										pos: position{line: 2431, col: 14, offset: 72846},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 2431, col: 14, offset: 72846},
-												name: "_",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 2431, col: 16, offset: 72848},
-												name: "DISTINCT",
-											},
-										},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2655, col: 5, offset: 79487},
+						run: (*parser).callonSetQuantifier6,
+						expr: &zeroOrOneExpr{
+							pos: position{line: 2655, col: 5, offset: 79487},
+							expr: &seqExpr{
+								pos: position{line: 2655, col: 6, offset: 79488},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 2655, col: 6, offset: 79488},
+										name: "_",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 2655, col: 8, offset: 79490},
+										name: "DISTINCT",
 									},
 								},
 							},
@@ -16475,90 +18387,110 @@ var g = &grammar{
 		},
 		{
 			name: "SQLGuard",
-			pos:  position{line: 2434, col: 1, offset: 72900},
+			pos:  position{line: 2658, col: 1, offset: 79544},
 			expr: &choiceExpr{
-				pos: position{line: 2435, col: 5, offset: 72915},
+				pos: position{line: 2659, col: 5, offset: 79559},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2435, col: 5, offset: 72915},
+						pos:  position{line: 2659, col: 5, offset: 79559},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2435, col: 12, offset: 72922},
+						pos:  position{line: 2659, col: 12, offset: 79566},
 						name: "GROUP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2435, col: 20, offset: 72930},
+						pos:  position{line: 2659, col: 20, offset: 79574},
 						name: "HAVING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2435, col: 29, offset: 72939},
+						pos:  position{line: 2659, col: 29, offset: 79583},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2435, col: 38, offset: 72948},
+						pos:  position{line: 2659, col: 38, offset: 79592},
 						name: "RECURSIVE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 5, offset: 72962},
+						pos:  position{line: 2660, col: 5, offset: 79606},
 						name: "ANTI",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 12, offset: 72969},
+						pos:  position{line: 2660, col: 12, offset: 79613},
+						name: "ASOF",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2660, col: 19, offset: 79620},
 						name: "INNER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 20, offset: 72977},
+						pos:  position{line: 2660, col: 27, offset: 79628},
 						name: "LEFT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 27, offset: 72984},
+						pos:  position{line: 2660, col: 34, offset: 79635},
 						name: "RIGHT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 35, offset: 72992},
+						pos:  position{line: 2660, col: 42, offset: 79643},
 						name: "OUTER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 43, offset: 73000},
+						pos:  position{line: 2660, col: 50, offset: 79651},
 						name: "CROSS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2436, col: 51, offset: 73008},
+						pos:  position{line: 2660, col: 58, offset: 79659},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2437, col: 5, offset: 73017},
+						pos:  position{line: 2661, col: 5, offset: 79668},
 						name: "UNION",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2438, col: 5, offset: 73027},
+						pos:  position{line: 2661, col: 13, offset: 79676},
+						name: "INTERSECT",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2661, col: 25, offset: 79688},
+						name: "EXCEPT",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2662, col: 5, offset: 79699},
 						name: "ORDER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2439, col: 5, offset: 73037},
+						pos:  position{line: 2663, col: 5, offset: 79709},
 						name: "OFFSET",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2440, col: 5, offset: 73048},
+						pos:  position{line: 2664, col: 5, offset: 79720},
 						name: "LIMIT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2441, col: 5, offset: 73058},
+						pos:  position{line: 2665, col: 5, offset: 79730},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2442, col: 5, offset: 73068},
+						pos:  position{line: 2666, col: 5, offset: 79740},
 						name: "WITH",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2443, col: 5, offset: 73077},
+						pos:  position{line: 2667, col: 5, offset: 79749},
 						name: "USING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2444, col: 5, offset: 73087},
+						pos:  position{line: 2668, col: 5, offset: 79759},
 						name: "ON",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 2669, col: 5, offset: 79766},
+						name: "QUALIFY",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2670, col: 5, offset: 79778},
+						name: "WINDOW",
+					},
 				},
 			},
 			leader:        false,
@@ -16566,20 +18498,20 @@ var g = &grammar{
 		},
 		{
 			name: "AGGREGATE",
-			pos:  position{line: 2446, col: 1, offset: 73091},
+			pos:  position{line: 2672, col: 1, offset: 79786},
 			expr: &seqExpr{
-				pos: position{line: 2446, col: 14, offset: 73104},
+				pos: position{line: 2672, col: 14, offset: 79799},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2446, col: 14, offset: 73104},
+						pos:        position{line: 2672, col: 14, offset: 79799},
 						val:        "aggregate",
 						ignoreCase: true,
 						want:       "\"AGGREGATE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2446, col: 33, offset: 73123},
+						pos: position{line: 2672, col: 33, offset: 79818},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2446, col: 34, offset: 73124},
+							pos:  position{line: 2672, col: 34, offset: 79819},
 							name: "IdentifierRest",
 						},
 					},
@@ -16590,20 +18522,20 @@ var g = &grammar{
 		},
 		{
 			name: "ALL",
-			pos:  position{line: 2447, col: 1, offset: 73139},
+			pos:  position{line: 2673, col: 1, offset: 79834},
 			expr: &seqExpr{
-				pos: position{line: 2447, col: 14, offset: 73152},
+				pos: position{line: 2673, col: 14, offset: 79847},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2447, col: 14, offset: 73152},
+						pos:        position{line: 2673, col: 14, offset: 79847},
 						val:        "all",
 						ignoreCase: true,
 						want:       "\"ALL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2447, col: 33, offset: 73171},
+						pos: position{line: 2673, col: 33, offset: 79866},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2447, col: 34, offset: 73172},
+							pos:  position{line: 2673, col: 34, offset: 79867},
 							name: "IdentifierRest",
 						},
 					},
@@ -16614,23 +18546,23 @@ var g = &grammar{
 		},
 		{
 			name: "AND",
-			pos:  position{line: 2448, col: 1, offset: 73187},
+			pos:  position{line: 2674, col: 1, offset: 79882},
 			expr: &actionExpr{
-				pos: position{line: 2448, col: 14, offset: 73200},
+				pos: position{line: 2674, col: 14, offset: 79895},
 				run: (*parser).callonAND1,
 				expr: &seqExpr{
-					pos: position{line: 2448, col: 14, offset: 73200},
+					pos: position{line: 2674, col: 14, offset: 79895},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2448, col: 14, offset: 73200},
+							pos:        position{line: 2674, col: 14, offset: 79895},
 							val:        "and",
 							ignoreCase: true,
 							want:       "\"AND\"i",
 						},
 						&notExpr{
-							pos: position{line: 2448, col: 33, offset: 73219},
+							pos: position{line: 2674, col: 33, offset: 79914},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2448, col: 34, offset: 73220},
+								pos:  position{line: 2674, col: 34, offset: 79915},
 								name: "IdentifierRest",
 							},
 						},
@@ -16642,20 +18574,20 @@ var g = &grammar{
 		},
 		{
 			name: "ANTI",
-			pos:  position{line: 2449, col: 1, offset: 73257},
+			pos:  position{line: 2675, col: 1, offset: 79952},
 			expr: &seqExpr{
-				pos: position{line: 2449, col: 14, offset: 73270},
+				pos: position{line: 2675, col: 14, offset: 79965},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2449, col: 14, offset: 73270},
+						pos:        position{line: 2675, col: 14, offset: 79965},
 						val:        "anti",
 						ignoreCase: true,
 						want:       "\"ANTI\"i",
 					},
 					&notExpr{
-						pos: position{line: 2449, col: 33, offset: 73289},
+						pos: position{line: 2675, col: 33, offset: 79984},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2449, col: 34, offset: 73290},
+							pos:  position{line: 2675, col: 34, offset: 79985},
 							name: "IdentifierRest",
 						},
 					},
@@ -16666,20 +18598,20 @@ var g = &grammar{
 		},
 		{
 			name: "ANY",
-			pos:  position{line: 2450, col: 1, offset: 73305},
+			pos:  position{line: 2676, col: 1, offset: 80000},
 			expr: &seqExpr{
-				pos: position{line: 2450, col: 14, offset: 73318},
+				pos: position{line: 2676, col: 14, offset: 80013},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2450, col: 14, offset: 73318},
+						pos:        position{line: 2676, col: 14, offset: 80013},
 						val:        "any",
 						ignoreCase: true,
 						want:       "\"ANY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2450, col: 33, offset: 73337},
+						pos: position{line: 2676, col: 33, offset: 80032},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2450, col: 34, offset: 73338},
+							pos:  position{line: 2676, col: 34, offset: 80033},
 							name: "IdentifierRest",
 						},
 					},
@@ -16690,20 +18622,20 @@ var g = &grammar{
 		},
 		{
 			name: "AS",
-			pos:  position{line: 2451, col: 1, offset: 73353},
+			pos:  position{line: 2677, col: 1, offset: 80048},
 			expr: &seqExpr{
-				pos: position{line: 2451, col: 14, offset: 73366},
+				pos: position{line: 2677, col: 14, offset: 80061},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2451, col: 14, offset: 73366},
+						pos:        position{line: 2677, col: 14, offset: 80061},
 						val:        "as",
 						ignoreCase: true,
 						want:       "\"AS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2451, col: 33, offset: 73385},
+						pos: position{line: 2677, col: 33, offset: 80080},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2451, col: 34, offset: 73386},
+							pos:  position{line: 2677, col: 34, offset: 80081},
 							name: "IdentifierRest",
 						},
 					},
@@ -16714,23 +18646,23 @@ var g = &grammar{
 		},
 		{
 			name: "ASC",
-			pos:  position{line: 2452, col: 1, offset: 73401},
+			pos:  position{line: 2678, col: 1, offset: 80096},
 			expr: &actionExpr{
-				pos: position{line: 2452, col: 14, offset: 73414},
+				pos: position{line: 2678, col: 14, offset: 80109},
 				run: (*parser).callonASC1,
 				expr: &seqExpr{
-					pos: position{line: 2452, col: 14, offset: 73414},
+					pos: position{line: 2678, col: 14, offset: 80109},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2452, col: 14, offset: 73414},
+							pos:        position{line: 2678, col: 14, offset: 80109},
 							val:        "asc",
 							ignoreCase: true,
 							want:       "\"ASC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2452, col: 33, offset: 73433},
+							pos: position{line: 2678, col: 33, offset: 80128},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2452, col: 34, offset: 73434},
+								pos:  position{line: 2678, col: 34, offset: 80129},
 								name: "IdentifierRest",
 							},
 						},
@@ -16740,22 +18672,46 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "ASOF",
+			pos:  position{line: 2679, col: 1, offset: 80166},
+			expr: &seqExpr{
+				pos: position{line: 2679, col: 14, offset: 80179},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2679, col: 14, offset: 80179},
+						val:        "asof",
+						ignoreCase: true,
+						want:       "\"ASOF\"i",
+					},
+					&notExpr{
+						pos: position{line: 2679, col: 33, offset: 80198},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2679, col: 34, offset: 80199},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "ASSERT",
-			pos:  position{line: 2453, col: 1, offset: 73471},
+			pos:  position{line: 2680, col: 1, offset: 80214},
 			expr: &seqExpr{
-				pos: position{line: 2453, col: 14, offset: 73484},
+				pos: position{line: 2680, col: 14, offset: 80227},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2453, col: 14, offset: 73484},
+						pos:        position{line: 2680, col: 14, offset: 80227},
 						val:        "assert",
 						ignoreCase: true,
 						want:       "\"ASSERT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2453, col: 33, offset: 73503},
+						pos: position{line: 2680, col: 33, offset: 80246},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2453, col: 34, offset: 73504},
+							pos:  position{line: 2680, col: 34, offset: 80247},
 							name: "IdentifierRest",
 						},
 					},
@@ -16766,20 +18722,20 @@ var g = &grammar{
 		},
 		{
 			name: "AT",
-			pos:  position{line: 2454, col: 1, offset: 73519},
+			pos:  position{line: 2681, col: 1, offset: 80262},
 			expr: &seqExpr{
-				pos: position{line: 2454, col: 14, offset: 73532},
+				pos: position{line: 2681, col: 14, offset: 80275},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2454, col: 14, offset: 73532},
+						pos:        position{line: 2681, col: 14, offset: 80275},
 						val:        "at",
 						ignoreCase: true,
 						want:       "\"AT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2454, col: 33, offset: 73551},
+						pos: position{line: 2681, col: 33, offset: 80294},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2454, col: 34, offset: 73552},
+							pos:  position{line: 2681, col: 34, offset: 80295},
 							name: "IdentifierRest",
 						},
 					},
@@ -16790,20 +18746,20 @@ var g = &grammar{
 		},
 		{
 			name: "BETWEEN",
-			pos:  position{line: 2455, col: 1, offset: 73567},
+			pos:  position{line: 2682, col: 1, offset: 80310},
 			expr: &seqExpr{
-				pos: position{line: 2455, col: 14, offset: 73580},
+				pos: position{line: 2682, col: 14, offset: 80323},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2455, col: 14, offset: 73580},
+						pos:        position{line: 2682, col: 14, offset: 80323},
 						val:        "between",
 						ignoreCase: true,
 						want:       "\"BETWEEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2455, col: 33, offset: 73599},
+						pos: position{line: 2682, col: 33, offset: 80342},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2455, col: 34, offset: 73600},
+							pos:  position{line: 2682, col: 34, offset: 80343},
 							name: "IdentifierRest",
 						},
 					},
@@ -16814,20 +18770,20 @@ var g = &grammar{
 		},
 		{
 			name: "BLEND",
-			pos:  position{line: 2456, col: 1, offset: 73615},
+			pos:  position{line: 2683, col: 1, offset: 80358},
 			expr: &seqExpr{
-				pos: position{line: 2456, col: 14, offset: 73628},
+				pos: position{line: 2683, col: 14, offset: 80371},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2456, col: 14, offset: 73628},
+						pos:        position{line: 2683, col: 14, offset: 80371},
 						val:        "blend",
 						ignoreCase: true,
 						want:       "\"BLEND\"i",
 					},
 					&notExpr{
-						pos: position{line: 2456, col: 33, offset: 73647},
+						pos: position{line: 2683, col: 33, offset: 80390},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2456, col: 34, offset: 73648},
+							pos:  position{line: 2683, col: 34, offset: 80391},
 							name: "IdentifierRest",
 						},
 					},
@@ -16838,20 +18794,20 @@ var g = &grammar{
 		},
 		{
 			name: "BY",
-			pos:  position{line: 2457, col: 1, offset: 73663},
+			pos:  position{line: 2684, col: 1, offset: 80406},
 			expr: &seqExpr{
-				pos: position{line: 2457, col: 14, offset: 73676},
+				pos: position{line: 2684, col: 14, offset: 80419},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2457, col: 14, offset: 73676},
+						pos:        position{line: 2684, col: 14, offset: 80419},
 						val:        "by",
 						ignoreCase: true,
 						want:       "\"BY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2457, col: 33, offset: 73695},
+						pos: position{line: 2684, col: 33, offset: 80438},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2457, col: 34, offset: 73696},
+							pos:  position{line: 2684, col: 34, offset: 80439},
 							name: "IdentifierRest",
 						},
 					},
@@ -16862,20 +18818,20 @@ var g = &grammar{
 		},
 		{
 			name: "CALL",
-			pos:  position{line: 2458, col: 1, offset: 73711},
+			pos:  position{line: 2685, col: 1, offset: 80454},
 			expr: &seqExpr{
-				pos: position{line: 2458, col: 14, offset: 73724},
+				pos: position{line: 2685, col: 14, offset: 80467},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2458, col: 14, offset: 73724},
+						pos:        position{line: 2685, col: 14, offset: 80467},
 						val:        "call",
 						ignoreCase: true,
 						want:       "\"CALL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2458, col: 33, offset: 73743},
+						pos: position{line: 2685, col: 33, offset: 80486},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2458, col: 34, offset: 73744},
+							pos:  position{line: 2685, col: 34, offset: 80487},
 							name: "IdentifierRest",
 						},
 					},
@@ -16886,20 +18842,20 @@ var g = &grammar{
 		},
 		{
 			name: "CASE",
-			pos:  position{line: 2459, col: 1, offset: 73759},
+			pos:  position{line: 2686, col: 1, offset: 80502},
 			expr: &seqExpr{
-				pos: position{line: 2459, col: 14, offset: 73772},
+				pos: position{line: 2686, col: 14, offset: 80515},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2459, col: 14, offset: 73772},
+						pos:        position{line: 2686, col: 14, offset: 80515},
 						val:        "case",
 						ignoreCase: true,
 						want:       "\"CASE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2459, col: 33, offset: 73791},
+						pos: position{line: 2686, col: 33, offset: 80534},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2459, col: 34, offset: 73792},
+							pos:  position{line: 2686, col: 34, offset: 80535},
 							name: "IdentifierRest",
 						},
 					},
@@ -16910,20 +18866,20 @@ var g = &grammar{
 		},
 		{
 			name: "CAST",
-			pos:  position{line: 2460, col: 1, offset: 73807},
+			pos:  position{line: 2687, col: 1, offset: 80550},
 			expr: &seqExpr{
-				pos: position{line: 2460, col: 14, offset: 73820},
+				pos: position{line: 2687, col: 14, offset: 80563},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2460, col: 14, offset: 73820},
+						pos:        position{line: 2687, col: 14, offset: 80563},
 						val:        "cast",
 						ignoreCase: true,
 						want:       "\"CAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2460, col: 33, offset: 73839},
+						pos: position{line: 2687, col: 33, offset: 80582},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2460, col: 34, offset: 73840},
+							pos:  position{line: 2687, col: 34, offset: 80583},
 							name: "IdentifierRest",
 						},
 					},
@@ -16934,20 +18890,20 @@ var g = &grammar{
 		},
 		{
 			name: "CONST",
-			pos:  position{line: 2461, col: 1, offset: 73855},
+			pos:  position{line: 2688, col: 1, offset: 80598},
 			expr: &seqExpr{
-				pos: position{line: 2461, col: 14, offset: 73868},
+				pos: position{line: 2688, col: 14, offset: 80611},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2461, col: 14, offset: 73868},
+						pos:        position{line: 2688, col: 14, offset: 80611},
 						val:        "const",
 						ignoreCase: true,
 						want:       "\"CONST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2461, col: 33, offset: 73887},
+						pos: position{line: 2688, col: 33, offset: 80630},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2461, col: 34, offset: 73888},
+							pos:  position{line: 2688, col: 34, offset: 80631},
 							name: "IdentifierRest",
 						},
 					},
@@ -16958,20 +18914,44 @@ var g = &grammar{
 		},
 		{
 			name: "COUNT",
-			pos:  position{line: 2462, col: 1, offset: 73903},
+			pos:  position{line: 2689, col: 1, offset: 80646},
+			expr: &seqExpr{
+				pos: position{line: 2689, col: 14, offset: 80659},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2689, col: 14, offset: 80659},
+						val:        "count",
+						ignoreCase: true,
+						want:       "\"COUNT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2689, col: 33, offset: 80678},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2689, col: 34, offset: 80679},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CROSS",
+			pos:  position{line: 2690, col: 1, offset: 80694},
 			expr: &seqExpr{
-				pos: position{line: 2462, col: 14, offset: 73916},
+				pos: position{line: 2690, col: 14, offset: 80707},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2462, col: 14, offset: 73916},
-						val:        "count",
+						pos:        position{line: 2690, col: 14, offset: 80707},
+						val:        "cross",
 						ignoreCase: true,
-						want:       "\"COUNT\"i",
+						want:       "\"CROSS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2462, col: 33, offset: 73935},
+						pos: position{line: 2690, col: 33, offset: 80726},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2462, col: 34, offset: 73936},
+							pos:  position{line: 2690, col: 34, offset: 80727},
 							name: "IdentifierRest",
 						},
 					},
@@ -16981,21 +18961,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "CROSS",
-			pos:  position{line: 2463, col: 1, offset: 73951},
+			name: "CURRENT",
+			pos:  position{line: 2691, col: 1, offset: 80742},
 			expr: &seqExpr{
-				pos: position{line: 2463, col: 14, offset: 73964},
+				pos: position{line: 2691, col: 14, offset: 80755},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2463, col: 14, offset: 73964},
-						val:        "cross",
+						pos:        position{line: 2691, col: 14, offset: 80755},
+						val:        "current",
 						ignoreCase: true,
-						want:       "\"CROSS\"i",
+						want:       "\"CURRENT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2463, col: 33, offset: 73983},
+						pos: position{line: 2691, col: 33, offset: 80774},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2463, col: 34, offset: 73984},
+							pos:  position{line: 2691, col: 34, offset: 80775},
 							name: "IdentifierRest",
 						},
 					},
@@ -17006,20 +18986,20 @@ var g = &grammar{
 		},
 		{
 			name: "CUT",
-			pos:  position{line: 2464, col: 1, offset: 73999},
+			pos:  position{line: 2692, col: 1, offset: 80790},
 			expr: &seqExpr{
-				pos: position{line: 2464, col: 14, offset: 74012},
+				pos: position{line: 2692, col: 14, offset: 80803},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2464, col: 14, offset: 74012},
+						pos:        position{line: 2692, col: 14, offset: 80803},
 						val:        "cut",
 						ignoreCase: true,
 						want:       "\"CUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2464, col: 33, offset: 74031},
+						pos: position{line: 2692, col: 33, offset: 80822},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2464, col: 34, offset: 74032},
+							pos:  position{line: 2692, col: 34, offset: 80823},
 							name: "IdentifierRest",
 						},
 					},
@@ -17030,23 +19010,23 @@ var g = &grammar{
 		},
 		{
 			name: "DATE",
-			pos:  position{line: 2465, col: 1, offset: 74047},
+			pos:  position{line: 2693, col: 1, offset: 80838},
 			expr: &actionExpr{
-				pos: position{line: 2465, col: 14, offset: 74060},
+				pos: position{line: 2693, col: 14, offset: 80851},
 				run: (*parser).callonDATE1,
 				expr: &seqExpr{
-					pos: position{line: 2465, col: 14, offset: 74060},
+					pos: position{line: 2693, col: 14, offset: 80851},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2465, col: 14, offset: 74060},
+							pos:        position{line: 2693, col: 14, offset: 80851},
 							val:        "date",
 							ignoreCase: true,
 							want:       "\"DATE\"i",
 						},
 						&notExpr{
-							pos: position{line: 2465, col: 33, offset: 74079},
+							pos: position{line: 2693, col: 33, offset: 80870},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2465, col: 34, offset: 74080},
+								pos:  position{line: 2693, col: 34, offset: 80871},
 								name: "IdentifierRest",
 							},
 						},
@@ -17058,20 +19038,20 @@ var g = &grammar{
 		},
 		{
 			name: "DEBUG",
-			pos:  position{line: 2466, col: 1, offset: 74118},
+			pos:  position{line: 2694, col: 1, offset: 80909},
 			expr: &seqExpr{
-				pos: position{line: 2466, col: 14, offset: 74131},
+				pos: position{line: 2694, col: 14, offset: 80922},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2466, col: 14, offset: 74131},
+						pos:        position{line: 2694, col: 14, offset: 80922},
 						val:        "debug",
 						ignoreCase: true,
 						want:       "\"DEBUG\"i",
 					},
 					&notExpr{
-						pos: position{line: 2466, col: 33, offset: 74150},
+						pos: position{line: 2694, col: 33, offset: 80941},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2466, col: 34, offset: 74151},
+							pos:  position{line: 2694, col: 34, offset: 80942},
 							name: "IdentifierRest",
 						},
 					},
@@ -17082,20 +19062,44 @@ var g = &grammar{
 		},
 		{
 			name: "DEFAULT",
-			pos:  position{line: 2467, col: 1, offset: 74166},
+			pos:  position{line: 2695, col: 1, offset: 80957},
 			expr: &seqExpr{
-				pos: position{line: 2467, col: 14, offset: 74179},
+				pos: position{line: 2695, col: 14, offset: 80970},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2467, col: 14, offset: 74179},
+						pos:        position{line: 2695, col: 14, offset: 80970},
 						val:        "default",
 						ignoreCase: true,
 						want:       "\"DEFAULT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2467, col: 33, offset: 74198},
+						pos: position{line: 2695, col: 33, offset: 80989},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2695, col: 34, offset: 80990},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "DELETE",
+			pos:  position{line: 2696, col: 1, offset: 81005},
+			expr: &seqExpr{
+				pos: position{line: 2696, col: 14, offset: 81018},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2696, col: 14, offset: 81018},
+						val:        "delete",
+						ignoreCase: true,
+						want:       "\"DELETE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2696, col: 33, offset: 81037},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2467, col: 34, offset: 74199},
+							pos:  position{line: 2696, col: 34, offset: 81038},
 							name: "IdentifierRest",
 						},
 					},
@@ -17106,23 +19110,23 @@ var g = &grammar{
 		},
 		{
 			name: "DESC",
-			pos:  position{line: 2468, col: 1, offset: 74214},
+			pos:  position{line: 2697, col: 1, offset: 81053},
 			expr: &actionExpr{
-				pos: position{line: 2468, col: 14, offset: 74227},
+				pos: position{line: 2697, col: 14, offset: 81066},
 				run: (*parser).callonDESC1,
 				expr: &seqExpr{
-					pos: position{line: 2468, col: 14, offset: 74227},
+					pos: position{line: 2697, col: 14, offset: 81066},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2468, col: 14, offset: 74227},
+							pos:        position{line: 2697, col: 14, offset: 81066},
 							val:        "desc",
 							ignoreCase: true,
 							want:       "\"DESC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2468, col: 33, offset: 74246},
+							pos: position{line: 2697, col: 33, offset: 81085},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2468, col: 34, offset: 74247},
+								pos:  position{line: 2697, col: 34, offset: 81086},
 								name: "IdentifierRest",
 							},
 						},
@@ -17134,20 +19138,20 @@ var g = &grammar{
 		},
 		{
 			name: "DISTINCT",
-			pos:  position{line: 2469, col: 1, offset: 74285},
+			pos:  position{line: 2698, col: 1, offset: 81124},
 			expr: &seqExpr{
-				pos: position{line: 2469, col: 14, offset: 74298},
+				pos: position{line: 2698, col: 14, offset: 81137},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2469, col: 14, offset: 74298},
+						pos:        position{line: 2698, col: 14, offset: 81137},
 						val:        "distinct",
 						ignoreCase: true,
 						want:       "\"DISTINCT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2469, col: 33, offset: 74317},
+						pos: position{line: 2698, col: 33, offset: 81156},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2469, col: 34, offset: 74318},
+							pos:  position{line: 2698, col: 34, offset: 81157},
 							name: "IdentifierRest",
 						},
 					},
@@ -17158,20 +19162,20 @@ var g = &grammar{
 		},
 		{
 			name: "DROP",
-			pos:  position{line: 2470, col: 1, offset: 74333},
+			pos:  position{line: 2699, col: 1, offset: 81172},
 			expr: &seqExpr{
-				pos: position{line: 2470, col: 14, offset: 74346},
+				pos: position{line: 2699, col: 14, offset: 81185},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2470, col: 14, offset: 74346},
+						pos:        position{line: 2699, col: 14, offset: 81185},
 						val:        "drop",
 						ignoreCase: true,
 						want:       "\"DROP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2470, col: 33, offset: 74365},
+						pos: position{line: 2699, col: 33, offset: 81204},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2470, col: 34, offset: 74366},
+							pos:  position{line: 2699, col: 34, offset: 81205},
 							name: "IdentifierRest",
 						},
 					},
@@ -17182,20 +19186,20 @@ var g = &grammar{
 		},
 		{
 			name: "ELSE",
-			pos:  position{line: 2471, col: 1, offset: 74381},
+			pos:  position{line: 2700, col: 1, offset: 81220},
 			expr: &seqExpr{
-				pos: position{line: 2471, col: 14, offset: 74394},
+				pos: position{line: 2700, col: 14, offset: 81233},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2471, col: 14, offset: 74394},
+						pos:        position{line: 2700, col: 14, offset: 81233},
 						val:        "else",
 						ignoreCase: true,
 						want:       "\"ELSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2471, col: 33, offset: 74413},
+						pos: position{line: 2700, col: 33, offset: 81252},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2471, col: 34, offset: 74414},
+							pos:  position{line: 2700, col: 34, offset: 81253},
 							name: "IdentifierRest",
 						},
 					},
@@ -17206,20 +19210,20 @@ var g = &grammar{
 		},
 		{
 			name: "END",
-			pos:  position{line: 2472, col: 1, offset: 74429},
+			pos:  position{line: 2701, col: 1, offset: 81268},
 			expr: &seqExpr{
-				pos: position{line: 2472, col: 14, offset: 74442},
+				pos: position{line: 2701, col: 14, offset: 81281},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2472, col: 14, offset: 74442},
+						pos:        position{line: 2701, col: 14, offset: 81281},
 						val:        "end",
 						ignoreCase: true,
 						want:       "\"END\"i",
 					},
 					&notExpr{
-						pos: position{line: 2472, col: 33, offset: 74461},
+						pos: position{line: 2701, col: 33, offset: 81300},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2472, col: 34, offset: 74462},
+							pos:  position{line: 2701, col: 34, offset: 81301},
 							name: "IdentifierRest",
 						},
 					},
@@ -17230,20 +19234,20 @@ var g = &grammar{
 		},
 		{
 			name: "ENUM",
-			pos:  position{line: 2473, col: 1, offset: 74477},
+			pos:  position{line: 2702, col: 1, offset: 81316},
 			expr: &seqExpr{
-				pos: position{line: 2473, col: 14, offset: 74490},
+				pos: position{line: 2702, col: 14, offset: 81329},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2473, col: 14, offset: 74490},
+						pos:        position{line: 2702, col: 14, offset: 81329},
 						val:        "enum",
 						ignoreCase: true,
 						want:       "\"ENUM\"i",
 					},
 					&notExpr{
-						pos: position{line: 2473, col: 33, offset: 74509},
+						pos: position{line: 2702, col: 33, offset: 81348},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2473, col: 34, offset: 74510},
+							pos:  position{line: 2702, col: 34, offset: 81349},
 							name: "IdentifierRest",
 						},
 					},
@@ -17254,20 +19258,44 @@ var g = &grammar{
 		},
 		{
 			name: "ERROR",
-			pos:  position{line: 2474, col: 1, offset: 74525},
+			pos:  position{line: 2703, col: 1, offset: 81364},
 			expr: &seqExpr{
-				pos: position{line: 2474, col: 14, offset: 74538},
+				pos: position{line: 2703, col: 14, offset: 81377},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2474, col: 14, offset: 74538},
+						pos:        position{line: 2703, col: 14, offset: 81377},
 						val:        "error",
 						ignoreCase: true,
 						want:       "\"ERROR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2474, col: 33, offset: 74557},
+						pos: position{line: 2703, col: 33, offset: 81396},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2703, col: 34, offset: 81397},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "EXCEPT",
+			pos:  position{line: 2704, col: 1, offset: 81412},
+			expr: &seqExpr{
+				pos: position{line: 2704, col: 14, offset: 81425},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2704, col: 14, offset: 81425},
+						val:        "except",
+						ignoreCase: true,
+						want:       "\"EXCEPT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2704, col: 33, offset: 81444},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2474, col: 34, offset: 74558},
+							pos:  position{line: 2704, col: 34, offset: 81445},
 							name: "IdentifierRest",
 						},
 					},
@@ -17278,20 +19306,20 @@ var g = &grammar{
 		},
 		{
 			name: "EXISTS",
-			pos:  position{line: 2475, col: 1, offset: 74573},
+			pos:  position{line: 2705, col: 1, offset: 81460},
 			expr: &seqExpr{
-				pos: position{line: 2475, col: 14, offset: 74586},
+				pos: position{line: 2705, col: 14, offset: 81473},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2475, col: 14, offset: 74586},
+						pos:        position{line: 2705, col: 14, offset: 81473},
 						val:        "exists",
 						ignoreCase: true,
 						want:       "\"EXISTS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2475, col: 33, offset: 74605},
+						pos: position{line: 2705, col: 33, offset: 81492},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2475, col: 34, offset: 74606},
+							pos:  position{line: 2705, col: 34, offset: 81493},
 							name: "IdentifierRest",
 						},
 					},
@@ -17302,20 +19330,20 @@ var g = &grammar{
 		},
 		{
 			name: "EXTRACT",
-			pos:  position{line: 2476, col: 1, offset: 74621},
+			pos:  position{line: 2706, col: 1, offset: 81508},
 			expr: &seqExpr{
-				pos: position{line: 2476, col: 14, offset: 74634},
+				pos: position{line: 2706, col: 14, offset: 81521},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2476, col: 14, offset: 74634},
+						pos:        position{line: 2706, col: 14, offset: 81521},
 						val:        "extract",
 						ignoreCase: true,
 						want:       "\"EXTRACT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2476, col: 33, offset: 74653},
+						pos: position{line: 2706, col: 33, offset: 81540},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2476, col: 34, offset: 74654},
+							pos:  position{line: 2706, col: 34, offset: 81541},
 							name: "IdentifierRest",
 						},
 					},
@@ -17326,20 +19354,20 @@ var g = &grammar{
 		},
 		{
 			name: "FALSE",
-			pos:  position{line: 2477, col: 1, offset: 74669},
+			pos:  position{line: 2707, col: 1, offset: 81556},
 			expr: &seqExpr{
-				pos: position{line: 2477, col: 14, offset: 74682},
+				pos: position{line: 2707, col: 14, offset: 81569},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2477, col: 14, offset: 74682},
+						pos:        position{line: 2707, col: 14, offset: 81569},
 						val:        "false",
 						ignoreCase: true,
 						want:       "\"FALSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2477, col: 33, offset: 74701},
+						pos: position{line: 2707, col: 33, offset: 81588},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2477, col: 34, offset: 74702},
+							pos:  position{line: 2707, col: 34, offset: 81589},
 							name: "IdentifierRest",
 						},
 					},
@@ -17350,20 +19378,20 @@ var g = &grammar{
 		},
 		{
 			name: "FILTER",
-			pos:  position{line: 2478, col: 1, offset: 74717},
+			pos:  position{line: 2708, col: 1, offset: 81604},
 			expr: &seqExpr{
-				pos: position{line: 2478, col: 14, offset: 74730},
+				pos: position{line: 2708, col: 14, offset: 81617},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2478, col: 14, offset: 74730},
+						pos:        position{line: 2708, col: 14, offset: 81617},
 						val:        "filter",
 						ignoreCase: true,
 						want:       "\"FILTER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2478, col: 33, offset: 74749},
+						pos: position{line: 2708, col: 33, offset: 81636},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2478, col: 34, offset: 74750},
+							pos:  position{line: 2708, col: 34, offset: 81637},
 							name: "IdentifierRest",
 						},
 					},
@@ -17374,20 +19402,20 @@ var g = &grammar{
 		},
 		{
 			name: "FIRST",
-			pos:  position{line: 2479, col: 1, offset: 74765},
+			pos:  position{line: 2709, col: 1, offset: 81652},
 			expr: &seqExpr{
-				pos: position{line: 2479, col: 14, offset: 74778},
+				pos: position{line: 2709, col: 14, offset: 81665},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2479, col: 14, offset: 74778},
+						pos:        position{line: 2709, col: 14, offset: 81665},
 						val:        "first",
 						ignoreCase: true,
 						want:       "\"FIRST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2479, col: 33, offset: 74797},
+						pos: position{line: 2709, col: 33, offset: 81684},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2479, col: 34, offset: 74798},
+							pos:  position{line: 2709, col: 34, offset: 81685},
 							name: "IdentifierRest",
 						},
 					},
@@ -17398,20 +19426,44 @@ var g = &grammar{
 		},
 		{
 			name: "FN",
-			pos:  position{line: 2480, col: 1, offset: 74813},
+			pos:  position{line: 2710, col: 1, offset: 81700},
 			expr: &seqExpr{
-				pos: position{line: 2480, col: 14, offset: 74826},
+				pos: position{line: 2710, col: 14, offset: 81713},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2480, col: 14, offset: 74826},
+						pos:        position{line: 2710, col: 14, offset: 81713},
 						val:        "fn",
 						ignoreCase: true,
 						want:       "\"FN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2480, col: 33, offset: 74845},
+						pos: position{line: 2710, col: 33, offset: 81732},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2710, col: 34, offset: 81733},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "FOLLOWING",
+			pos:  position{line: 2711, col: 1, offset: 81748},
+			expr: &seqExpr{
+				pos: position{line: 2711, col: 14, offset: 81761},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2711, col: 14, offset: 81761},
+						val:        "following",
+						ignoreCase: true,
+						want:       "\"FOLLOWING\"i",
+					},
+					&notExpr{
+						pos: position{line: 2711, col: 33, offset: 81780},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2480, col: 34, offset: 74846},
+							pos:  position{line: 2711, col: 34, offset: 81781},
 							name: "IdentifierRest",
 						},
 					},
@@ -17422,20 +19474,20 @@ var g = &grammar{
 		},
 		{
 			name: "FOR",
-			pos:  position{line: 2481, col: 1, offset: 74861},
+			pos:  position{line: 2712, col: 1, offset: 81796},
 			expr: &seqExpr{
-				pos: position{line: 2481, col: 14, offset: 74874},
+				pos: position{line: 2712, col: 14, offset: 81809},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2481, col: 14, offset: 74874},
+						pos:        position{line: 2712, col: 14, offset: 81809},
 						val:        "for",
 						ignoreCase: true,
 						want:       "\"FOR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2481, col: 33, offset: 74893},
+						pos: position{line: 2712, col: 33, offset: 81828},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2481, col: 34, offset: 74894},
+							pos:  position{line: 2712, col: 34, offset: 81829},
 							name: "IdentifierRest",
 						},
 					},
@@ -17446,20 +19498,20 @@ var g = &grammar{
 		},
 		{
 			name: "FORK",
-			pos:  position{line: 2482, col: 1, offset: 74909},
+			pos:  position{line: 2713, col: 1, offset: 81844},
 			expr: &seqExpr{
-				pos: position{line: 2482, col: 14, offset: 74922},
+				pos: position{line: 2713, col: 14, offset: 81857},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2482, col: 14, offset: 74922},
+						pos:        position{line: 2713, col: 14, offset: 81857},
 						val:        "fork",
 						ignoreCase: true,
 						want:       "\"FORK\"i",
 					},
 					&notExpr{
-						pos: position{line: 2482, col: 33, offset: 74941},
+						pos: position{line: 2713, col: 33, offset: 81876},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2482, col: 34, offset: 74942},
+							pos:  position{line: 2713, col: 34, offset: 81877},
 							name: "IdentifierRest",
 						},
 					},
@@ -17470,20 +19522,20 @@ var g = &grammar{
 		},
 		{
 			name: "FROM",
-			pos:  position{line: 2483, col: 1, offset: 74957},
+			pos:  position{line: 2714, col: 1, offset: 81892},
 			expr: &seqExpr{
-				pos: position{line: 2483, col: 14, offset: 74970},
+				pos: position{line: 2714, col: 14, offset: 81905},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2483, col: 14, offset: 74970},
+						pos:        position{line: 2714, col: 14, offset: 81905},
 						val:        "from",
 						ignoreCase: true,
 						want:       "\"FROM\"i",
 					},
 					&notExpr{
-						pos: position{line: 2483, col: 33, offset: 74989},
+						pos: position{line: 2714, col: 33, offset: 81924},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2483, col: 34, offset: 74990},
+							pos:  position{line: 2714, col: 34, offset: 81925},
 							name: "IdentifierRest",
 						},
 					},
@@ -17494,20 +19546,20 @@ var g = &grammar{
 		},
 		{
 			name: "FULL",
-			pos:  position{line: 2484, col: 1, offset: 75005},
+			pos:  position{line: 2715, col: 1, offset: 81940},
 			expr: &seqExpr{
-				pos: position{line: 2484, col: 14, offset: 75018},
+				pos: position{line: 2715, col: 14, offset: 81953},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2484, col: 14, offset: 75018},
+						pos:        position{line: 2715, col: 14, offset: 81953},
 						val:        "full",
 						ignoreCase: true,
 						want:       "\"FULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2484, col: 33, offset: 75037},
+						pos: position{line: 2715, col: 33, offset: 81972},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2484, col: 34, offset: 75038},
+							pos:  position{line: 2715, col: 34, offset: 81973},
 							name: "IdentifierRest",
 						},
 					},
@@ -17518,20 +19570,20 @@ var g = &grammar{
 		},
 		{
 			name: "FUSE",
-			pos:  position{line: 2485, col: 1, offset: 75053},
+			pos:  position{line: 2716, col: 1, offset: 81988},
 			expr: &seqExpr{
-				pos: position{line: 2485, col: 14, offset: 75066},
+				pos: position{line: 2716, col: 14, offset: 82001},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2485, col: 14, offset: 75066},
+						pos:        position{line: 2716, col: 14, offset: 82001},
 						val:        "fuse",
 						ignoreCase: true,
 						want:       "\"FUSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2485, col: 33, offset: 75085},
+						pos: position{line: 2716, col: 33, offset: 82020},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2485, col: 34, offset: 75086},
+							pos:  position{line: 2716, col: 34, offset: 82021},
 							name: "IdentifierRest",
 						},
 					},
@@ -17542,20 +19594,20 @@ var g = &grammar{
 		},
 		{
 			name: "FUSION",
-			pos:  position{line: 2486, col: 1, offset: 75101},
+			pos:  position{line: 2717, col: 1, offset: 82036},
 			expr: &seqExpr{
-				pos: position{line: 2486, col: 14, offset: 75114},
+				pos: position{line: 2717, col: 14, offset: 82049},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2486, col: 14, offset: 75114},
+						pos:        position{line: 2717, col: 14, offset: 82049},
 						val:        "fusion",
 						ignoreCase: true,
 						want:       "\"FUSION\"i",
 					},
 					&notExpr{
-						pos: position{line: 2486, col: 33, offset: 75133},
+						pos: position{line: 2717, col: 33, offset: 82068},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2486, col: 34, offset: 75134},
+							pos:  position{line: 2717, col: 34, offset: 82069},
 							name: "IdentifierRest",
 						},
 					},
@@ -17566,20 +19618,20 @@ var g = &grammar{
 		},
 		{
 			name: "GROUP",
-			pos:  position{line: 2487, col: 1, offset: 75149},
+			pos:  position{line: 2718, col: 1, offset: 82084},
 			expr: &seqExpr{
-				pos: position{line: 2487, col: 14, offset: 75162},
+				pos: position{line: 2718, col: 14, offset: 82097},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2487, col: 14, offset: 75162},
+						pos:        position{line: 2718, col: 14, offset: 82097},
 						val:        "group",
 						ignoreCase: true,
 						want:       "\"GROUP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2487, col: 33, offset: 75181},
+						pos: position{line: 2718, col: 33, offset: 82116},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2487, col: 34, offset: 75182},
+							pos:  position{line: 2718, col: 34, offset: 82117},
 							name: "IdentifierRest",
 						},
 					},
@@ -17590,20 +19642,20 @@ var g = &grammar{
 		},
 		{
 			name: "HAVING",
-			pos:  position{line: 2488, col: 1, offset: 75197},
+			pos:  position{line: 2719, col: 1, offset: 82132},
 			expr: &seqExpr{
-				pos: position{line: 2488, col: 14, offset: 75210},
+				pos: position{line: 2719, col: 14, offset: 82145},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2488, col: 14, offset: 75210},
+						pos:        position{line: 2719, col: 14, offset: 82145},
 						val:        "having",
 						ignoreCase: true,
 						want:       "\"HAVING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2488, col: 33, offset: 75229},
+						pos: position{line: 2719, col: 33, offset: 82164},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2488, col: 34, offset: 75230},
+							pos:  position{line: 2719, col: 34, offset: 82165},
 							name: "IdentifierRest",
 						},
 					},
@@ -17614,20 +19666,20 @@ var g = &grammar{
 		},
 		{
 			name: "HEAD",
-			pos:  position{line: 2489, col: 1, offset: 75245},
+			pos:  position{line: 2720, col: 1, offset: 82180},
 			expr: &seqExpr{
-				pos: position{line: 2489, col: 14, offset: 75258},
+				pos: position{line: 2720, col: 14, offset: 82193},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2489, col: 14, offset: 75258},
+						pos:        position{line: 2720, col: 14, offset: 82193},
 						val:        "head",
 						ignoreCase: true,
 						want:       "\"HEAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2489, col: 33, offset: 75277},
+						pos: position{line: 2720, col: 33, offset: 82212},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2489, col: 34, offset: 75278},
+							pos:  position{line: 2720, col: 34, offset: 82213},
 							name: "IdentifierRest",
 						},
 					},
@@ -17638,20 +19690,20 @@ var g = &grammar{
 		},
 		{
 			name: "IN",
-			pos:  position{line: 2490, col: 1, offset: 75293},
+			pos:  position{line: 2721, col: 1, offset: 82228},
 			expr: &seqExpr{
-				pos: position{line: 2490, col: 14, offset: 75306},
+				pos: position{line: 2721, col: 14, offset: 82241},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2490, col: 14, offset: 75306},
+						pos:        position{line: 2721, col: 14, offset: 82241},
 						val:        "in",
 						ignoreCase: true,
 						want:       "\"IN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2490, col: 33, offset: 75325},
+						pos: position{line: 2721, col: 33, offset: 82260},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2490, col: 34, offset: 75326},
+							pos:  position{line: 2721, col: 34, offset: 82261},
 							name: "IdentifierRest",
 						},
 					},
@@ -17662,20 +19714,20 @@ var g = &grammar{
 		},
 		{
 			name: "INFER",
-			pos:  position{line: 2491, col: 1, offset: 75341},
+			pos:  position{line: 2722, col: 1, offset: 82276},
 			expr: &seqExpr{
-				pos: position{line: 2491, col: 14, offset: 75354},
+				pos: position{line: 2722, col: 14, offset: 82289},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2491, col: 14, offset: 75354},
+						pos:        position{line: 2722, col: 14, offset: 82289},
 						val:        "infer",
 						ignoreCase: true,
 						want:       "\"INFER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2491, col: 33, offset: 75373},
+						pos: position{line: 2722, col: 33, offset: 82308},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2491, col: 34, offset: 75374},
+							pos:  position{line: 2722, col: 34, offset: 82309},
 							name: "IdentifierRest",
 						},
 					},
@@ -17686,20 +19738,92 @@ var g = &grammar{
 		},
 		{
 			name: "INNER",
-			pos:  position{line: 2492, col: 1, offset: 75389},
+			pos:  position{line: 2723, col: 1, offset: 82324},
 			expr: &seqExpr{
-				pos: position{line: 2492, col: 14, offset: 75402},
+				pos: position{line: 2723, col: 14, offset: 82337},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2492, col: 14, offset: 75402},
+						pos:        position{line: 2723, col: 14, offset: 82337},
 						val:        "inner",
 						ignoreCase: true,
 						want:       "\"INNER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2492, col: 33, offset: 75421},
+						pos: position{line: 2723, col: 33, offset: 82356},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2723, col: 34, offset: 82357},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "INSERT",
+			pos:  position{line: 2724, col: 1, offset: 82372},
+			expr: &seqExpr{
+				pos: position{line: 2724, col: 14, offset: 82385},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2724, col: 14, offset: 82385},
+						val:        "insert",
+						ignoreCase: true,
+						want:       "\"INSERT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2724, col: 33, offset: 82404},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2724, col: 34, offset: 82405},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "INTERSECT",
+			pos:  position{line: 2725, col: 1, offset: 82420},
+			expr: &seqExpr{
+				pos: position{line: 2725, col: 14, offset: 82433},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2725, col: 14, offset: 82433},
+						val:        "intersect",
+						ignoreCase: true,
+						want:       "\"INTERSECT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2725, col: 33, offset: 82452},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2725, col: 34, offset: 82453},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "INTO",
+			pos:  position{line: 2726, col: 1, offset: 82468},
+			expr: &seqExpr{
+				pos: position{line: 2726, col: 14, offset: 82481},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2726, col: 14, offset: 82481},
+						val:        "into",
+						ignoreCase: true,
+						want:       "\"INTO\"i",
+					},
+					&notExpr{
+						pos: position{line: 2726, col: 33, offset: 82500},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2492, col: 34, offset: 75422},
+							pos:  position{line: 2726, col: 34, offset: 82501},
 							name: "IdentifierRest",
 						},
 					},
@@ -17710,20 +19834,20 @@ var g = &grammar{
 		},
 		{
 			name: "IS",
-			pos:  position{line: 2493, col: 1, offset: 75437},
+			pos:  position{line: 2727, col: 1, offset: 82516},
 			expr: &seqExpr{
-				pos: position{line: 2493, col: 14, offset: 75450},
+				pos: position{line: 2727, col: 14, offset: 82529},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2493, col: 14, offset: 75450},
+						pos:        position{line: 2727, col: 14, offset: 82529},
 						val:        "is",
 						ignoreCase: true,
 						want:       "\"IS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2493, col: 33, offset: 75469},
+						pos: position{line: 2727, col: 33, offset: 82548},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2493, col: 34, offset: 75470},
+							pos:  position{line: 2727, col: 34, offset: 82549},
 							name: "IdentifierRest",
 						},
 					},
@@ -17734,20 +19858,20 @@ var g = &grammar{
 		},
 		{
 			name: "JOIN",
-			pos:  position{line: 2494, col: 1, offset: 75485},
+			pos:  position{line: 2728, col: 1, offset: 82564},
 			expr: &seqExpr{
-				pos: position{line: 2494, col: 14, offset: 75498},
+				pos: position{line: 2728, col: 14, offset: 82577},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2494, col: 14, offset: 75498},
+						pos:        position{line: 2728, col: 14, offset: 82577},
 						val:        "join",
 						ignoreCase: true,
 						want:       "\"JOIN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2494, col: 33, offset: 75517},
+						pos: position{line: 2728, col: 33, offset: 82596},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2494, col: 34, offset: 75518},
+							pos:  position{line: 2728, col: 34, offset: 82597},
 							name: "IdentifierRest",
 						},
 					},
@@ -17758,20 +19882,20 @@ var g = &grammar{
 		},
 		{
 			name: "LAMBDA",
-			pos:  position{line: 2495, col: 1, offset: 75533},
+			pos:  position{line: 2729, col: 1, offset: 82612},
 			expr: &seqExpr{
-				pos: position{line: 2495, col: 14, offset: 75546},
+				pos: position{line: 2729, col: 14, offset: 82625},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2495, col: 14, offset: 75546},
+						pos:        position{line: 2729, col: 14, offset: 82625},
 						val:        "lambda",
 						ignoreCase: true,
 						want:       "\"LAMBDA\"i",
 					},
 					&notExpr{
-						pos: position{line: 2495, col: 33, offset: 75565},
+						pos: position{line: 2729, col: 33, offset: 82644},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2495, col: 34, offset: 75566},
+							pos:  position{line: 2729, col: 34, offset: 82645},
 							name: "IdentifierRest",
 						},
 					},
@@ -17782,20 +19906,20 @@ var g = &grammar{
 		},
 		{
 			name: "LAST",
-			pos:  position{line: 2496, col: 1, offset: 75581},
+			pos:  position{line: 2730, col: 1, offset: 82660},
 			expr: &seqExpr{
-				pos: position{line: 2496, col: 14, offset: 75594},
+				pos: position{line: 2730, col: 14, offset: 82673},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2496, col: 14, offset: 75594},
+						pos:        position{line: 2730, col: 14, offset: 82673},
 						val:        "last",
 						ignoreCase: true,
 						want:       "\"LAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2496, col: 33, offset: 75613},
+						pos: position{line: 2730, col: 33, offset: 82692},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2496, col: 34, offset: 75614},
+							pos:  position{line: 2730, col: 34, offset: 82693},
 							name: "IdentifierRest",
 						},
 					},
@@ -17806,20 +19930,20 @@ var g = &grammar{
 		},
 		{
 			name: "LEFT",
-			pos:  position{line: 2497, col: 1, offset: 75629},
+			pos:  position{line: 2731, col: 1, offset: 82708},
 			expr: &seqExpr{
-				pos: position{line: 2497, col: 14, offset: 75642},
+				pos: position{line: 2731, col: 14, offset: 82721},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2497, col: 14, offset: 75642},
+						pos:        position{line: 2731, col: 14, offset: 82721},
 						val:        "left",
 						ignoreCase: true,
 						want:       "\"LEFT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2497, col: 33, offset: 75661},
+						pos: position{line: 2731, col: 33, offset: 82740},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2497, col: 34, offset: 75662},
+							pos:  position{line: 2731, col: 34, offset: 82741},
 							name: "IdentifierRest",
 						},
 					},
@@ -17830,20 +19954,20 @@ var g = &grammar{
 		},
 		{
 			name: "LET",
-			pos:  position{line: 2498, col: 1, offset: 75677},
+			pos:  position{line: 2732, col: 1, offset: 82756},
 			expr: &seqExpr{
-				pos: position{line: 2498, col: 14, offset: 75690},
+				pos: position{line: 2732, col: 14, offset: 82769},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2498, col: 14, offset: 75690},
+						pos:        position{line: 2732, col: 14, offset: 82769},
 						val:        "let",
 						ignoreCase: true,
 						want:       "\"LET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2498, col: 33, offset: 75709},
+						pos: position{line: 2732, col: 33, offset: 82788},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2498, col: 34, offset: 75710},
+							pos:  position{line: 2732, col: 34, offset: 82789},
 							name: "IdentifierRest",
 						},
 					},
@@ -17854,20 +19978,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIKE",
-			pos:  position{line: 2499, col: 1, offset: 75725},
+			pos:  position{line: 2733, col: 1, offset: 82804},
 			expr: &seqExpr{
-				pos: position{line: 2499, col: 14, offset: 75738},
+				pos: position{line: 2733, col: 14, offset: 82817},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2499, col: 14, offset: 75738},
+						pos:        position{line: 2733, col: 14, offset: 82817},
 						val:        "like",
 						ignoreCase: true,
 						want:       "\"LIKE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2499, col: 33, offset: 75757},
+						pos: position{line: 2733, col: 33, offset: 82836},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2499, col: 34, offset: 75758},
+							pos:  position{line: 2733, col: 34, offset: 82837},
 							name: "IdentifierRest",
 						},
 					},
@@ -17878,20 +20002,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIMIT",
-			pos:  position{line: 2500, col: 1, offset: 75773},
+			pos:  position{line: 2734, col: 1, offset: 82852},
 			expr: &seqExpr{
-				pos: position{line: 2500, col: 14, offset: 75786},
+				pos: position{line: 2734, col: 14, offset: 82865},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2500, col: 14, offset: 75786},
+						pos:        position{line: 2734, col: 14, offset: 82865},
 						val:        "limit",
 						ignoreCase: true,
 						want:       "\"LIMIT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2500, col: 33, offset: 75805},
+						pos: position{line: 2734, col: 33, offset: 82884},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2500, col: 34, offset: 75806},
+							pos:  position{line: 2734, col: 34, offset: 82885},
 							name: "IdentifierRest",
 						},
 					},
@@ -17902,20 +20026,44 @@ var g = &grammar{
 		},
 		{
 			name: "LOAD",
-			pos:  position{line: 2501, col: 1, offset: 75821},
+			pos:  position{line: 2735, col: 1, offset: 82900},
 			expr: &seqExpr{
-				pos: position{line: 2501, col: 14, offset: 75834},
+				pos: position{line: 2735, col: 14, offset: 82913},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2501, col: 14, offset: 75834},
+						pos:        position{line: 2735, col: 14, offset: 82913},
 						val:        "load",
 						ignoreCase: true,
 						want:       "\"LOAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2501, col: 33, offset: 75853},
+						pos: position{line: 2735, col: 33, offset: 82932},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2735, col: 34, offset: 82933},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "MATCHED",
+			pos:  position{line: 2736, col: 1, offset: 82948},
+			expr: &seqExpr{
+				pos: position{line: 2736, col: 14, offset: 82961},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2736, col: 14, offset: 82961},
+						val:        "matched",
+						ignoreCase: true,
+						want:       "\"MATCHED\"i",
+					},
+					&notExpr{
+						pos: position{line: 2736, col: 33, offset: 82980},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2501, col: 34, offset: 75854},
+							pos:  position{line: 2736, col: 34, offset: 82981},
 							name: "IdentifierRest",
 						},
 					},
@@ -17926,20 +20074,20 @@ var g = &grammar{
 		},
 		{
 			name: "MATERIALIZED",
-			pos:  position{line: 2502, col: 1, offset: 75869},
+			pos:  position{line: 2737, col: 1, offset: 82996},
 			expr: &seqExpr{
-				pos: position{line: 2502, col: 16, offset: 75884},
+				pos: position{line: 2737, col: 16, offset: 83011},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2502, col: 16, offset: 75884},
+						pos:        position{line: 2737, col: 16, offset: 83011},
 						val:        "materialized",
 						ignoreCase: true,
 						want:       "\"MATERIALIZED\"i",
 					},
 					&notExpr{
-						pos: position{line: 2502, col: 33, offset: 75901},
+						pos: position{line: 2737, col: 33, offset: 83028},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2502, col: 34, offset: 75902},
+							pos:  position{line: 2737, col: 34, offset: 83029},
 							name: "IdentifierRest",
 						},
 					},
@@ -17950,20 +20098,20 @@ var g = &grammar{
 		},
 		{
 			name: "MAP",
-			pos:  position{line: 2503, col: 1, offset: 75917},
+			pos:  position{line: 2738, col: 1, offset: 83044},
 			expr: &seqExpr{
-				pos: position{line: 2503, col: 14, offset: 75930},
+				pos: position{line: 2738, col: 14, offset: 83057},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2503, col: 14, offset: 75930},
+						pos:        position{line: 2738, col: 14, offset: 83057},
 						val:        "map",
 						ignoreCase: true,
 						want:       "\"MAP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2503, col: 33, offset: 75949},
+						pos: position{line: 2738, col: 33, offset: 83076},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2503, col: 34, offset: 75950},
+							pos:  position{line: 2738, col: 34, offset: 83077},
 							name: "IdentifierRest",
 						},
 					},
@@ -17974,20 +20122,20 @@ var g = &grammar{
 		},
 		{
 			name: "MERGE",
-			pos:  position{line: 2504, col: 1, offset: 75965},
+			pos:  position{line: 2739, col: 1, offset: 83092},
 			expr: &seqExpr{
-				pos: position{line: 2504, col: 14, offset: 75978},
+				pos: position{line: 2739, col: 14, offset: 83105},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2504, col: 14, offset: 75978},
+						pos:        position{line: 2739, col: 14, offset: 83105},
 						val:        "merge",
 						ignoreCase: true,
 						want:       "\"MERGE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2504, col: 33, offset: 75997},
+						pos: position{line: 2739, col: 33, offset: 83124},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2504, col: 34, offset: 75998},
+							pos:  position{line: 2739, col: 34, offset: 83125},
 							name: "IdentifierRest",
 						},
 					},
@@ -17998,20 +20146,20 @@ var g = &grammar{
 		},
 		{
 			name: "NOT",
-			pos:  position{line: 2505, col: 1, offset: 76013},
+			pos:  position{line: 2740, col: 1, offset: 83140},
 			expr: &seqExpr{
-				pos: position{line: 2505, col: 14, offset: 76026},
+				pos: position{line: 2740, col: 14, offset: 83153},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2505, col: 14, offset: 76026},
+						pos:        position{line: 2740, col: 14, offset: 83153},
 						val:        "not",
 						ignoreCase: true,
 						want:       "\"NOT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2505, col: 33, offset: 76045},
+						pos: position{line: 2740, col: 33, offset: 83172},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2505, col: 34, offset: 76046},
+							pos:  position{line: 2740, col: 34, offset: 83173},
 							name: "IdentifierRest",
 						},
 					},
@@ -18022,20 +20170,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULL",
-			pos:  position{line: 2506, col: 1, offset: 76061},
+			pos:  position{line: 2741, col: 1, offset: 83188},
 			expr: &seqExpr{
-				pos: position{line: 2506, col: 14, offset: 76074},
+				pos: position{line: 2741, col: 14, offset: 83201},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2506, col: 14, offset: 76074},
+						pos:        position{line: 2741, col: 14, offset: 83201},
 						val:        "null",
 						ignoreCase: true,
 						want:       "\"NULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2506, col: 33, offset: 76093},
+						pos: position{line: 2741, col: 33, offset: 83220},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2506, col: 34, offset: 76094},
+							pos:  position{line: 2741, col: 34, offset: 83221},
 							name: "IdentifierRest",
 						},
 					},
@@ -18046,20 +20194,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULLS",
-			pos:  position{line: 2507, col: 1, offset: 76109},
+			pos:  position{line: 2742, col: 1, offset: 83236},
 			expr: &seqExpr{
-				pos: position{line: 2507, col: 14, offset: 76122},
+				pos: position{line: 2742, col: 14, offset: 83249},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2507, col: 14, offset: 76122},
+						pos:        position{line: 2742, col: 14, offset: 83249},
 						val:        "nulls",
 						ignoreCase: true,
 						want:       "\"NULLS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2507, col: 33, offset: 76141},
+						pos: position{line: 2742, col: 33, offset: 83268},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2507, col: 34, offset: 76142},
+							pos:  position{line: 2742, col: 34, offset: 83269},
 							name: "IdentifierRest",
 						},
 					},
@@ -18070,20 +20218,20 @@ var g = &grammar{
 		},
 		{
 			name: "OFFSET",
-			pos:  position{line: 2508, col: 1, offset: 76157},
+			pos:  position{line: 2743, col: 1, offset: 83284},
 			expr: &seqExpr{
-				pos: position{line: 2508, col: 14, offset: 76170},
+				pos: position{line: 2743, col: 14, offset: 83297},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2508, col: 14, offset: 76170},
+						pos:        position{line: 2743, col: 14, offset: 83297},
 						val:        "offset",
 						ignoreCase: true,
 						want:       "\"OFFSET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2508, col: 33, offset: 76189},
+						pos: position{line: 2743, col: 33, offset: 83316},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2508, col: 34, offset: 76190},
+							pos:  position{line: 2743, col: 34, offset: 83317},
 							name: "IdentifierRest",
 						},
 					},
@@ -18094,20 +20242,20 @@ var g = &grammar{
 		},
 		{
 			name: "ON",
-			pos:  position{line: 2509, col: 1, offset: 76205},
+			pos:  position{line: 2744, col: 1, offset: 83332},
 			expr: &seqExpr{
-				pos: position{line: 2509, col: 14, offset: 76218},
+				pos: position{line: 2744, col: 14, offset: 83345},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2509, col: 14, offset: 76218},
+						pos:        position{line: 2744, col: 14, offset: 83345},
 						val:        "on",
 						ignoreCase: true,
 						want:       "\"ON\"i",
 					},
 					&notExpr{
-						pos: position{line: 2509, col: 33, offset: 76237},
+						pos: position{line: 2744, col: 33, offset: 83364},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2509, col: 34, offset: 76238},
+							pos:  position{line: 2744, col: 34, offset: 83365},
 							name: "IdentifierRest",
 						},
 					},
@@ -18118,20 +20266,20 @@ var g = &grammar{
 		},
 		{
 			name: "OP",
-			pos:  position{line: 2510, col: 1, offset: 76253},
+			pos:  position{line: 2745, col: 1, offset: 83380},
 			expr: &seqExpr{
-				pos: position{line: 2510, col: 14, offset: 76266},
+				pos: position{line: 2745, col: 14, offset: 83393},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2510, col: 14, offset: 76266},
+						pos:        position{line: 2745, col: 14, offset: 83393},
 						val:        "op",
 						ignoreCase: true,
 						want:       "\"OP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2510, col: 33, offset: 76285},
+						pos: position{line: 2745, col: 33, offset: 83412},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2510, col: 34, offset: 76286},
+							pos:  position{line: 2745, col: 34, offset: 83413},
 							name: "IdentifierRest",
 						},
 					},
@@ -18142,23 +20290,23 @@ var g = &grammar{
 		},
 		{
 			name: "OR",
-			pos:  position{line: 2511, col: 1, offset: 76301},
+			pos:  position{line: 2746, col: 1, offset: 83428},
 			expr: &actionExpr{
-				pos: position{line: 2511, col: 14, offset: 76314},
+				pos: position{line: 2746, col: 14, offset: 83441},
 				run: (*parser).callonOR1,
 				expr: &seqExpr{
-					pos: position{line: 2511, col: 14, offset: 76314},
+					pos: position{line: 2746, col: 14, offset: 83441},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2511, col: 14, offset: 76314},
+							pos:        position{line: 2746, col: 14, offset: 83441},
 							val:        "or",
 							ignoreCase: true,
 							want:       "\"OR\"i",
 						},
 						&notExpr{
-							pos: position{line: 2511, col: 33, offset: 76333},
+							pos: position{line: 2746, col: 33, offset: 83460},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2511, col: 34, offset: 76334},
+								pos:  position{line: 2746, col: 34, offset: 83461},
 								name: "IdentifierRest",
 							},
 						},
@@ -18170,20 +20318,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDER",
-			pos:  position{line: 2512, col: 1, offset: 76370},
+			pos:  position{line: 2747, col: 1, offset: 83497},
 			expr: &seqExpr{
-				pos: position{line: 2512, col: 14, offset: 76383},
+				pos: position{line: 2747, col: 14, offset: 83510},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2512, col: 14, offset: 76383},
+						pos:        position{line: 2747, col: 14, offset: 83510},
 						val:        "order",
 						ignoreCase: true,
 						want:       "\"ORDER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2512, col: 33, offset: 76402},
+						pos: position{line: 2747, col: 33, offset: 83529},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2512, col: 34, offset: 76403},
+							pos:  position{line: 2747, col: 34, offset: 83530},
 							name: "IdentifierRest",
 						},
 					},
@@ -18194,20 +20342,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDINALITY",
-			pos:  position{line: 2513, col: 1, offset: 76418},
+			pos:  position{line: 2748, col: 1, offset: 83545},
 			expr: &seqExpr{
-				pos: position{line: 2513, col: 14, offset: 76431},
+				pos: position{line: 2748, col: 14, offset: 83558},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2513, col: 14, offset: 76431},
+						pos:        position{line: 2748, col: 14, offset: 83558},
 						val:        "ordinality",
 						ignoreCase: true,
 						want:       "\"ORDINALITY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2513, col: 33, offset: 76450},
+						pos: position{line: 2748, col: 33, offset: 83577},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2513, col: 34, offset: 76451},
+							pos:  position{line: 2748, col: 34, offset: 83578},
 							name: "IdentifierRest",
 						},
 					},
@@ -18218,20 +20366,20 @@ var g = &grammar{
 		},
 		{
 			name: "OUTER",
-			pos:  position{line: 2514, col: 1, offset: 76466},
+			pos:  position{line: 2749, col: 1, offset: 83593},
 			expr: &seqExpr{
-				pos: position{line: 2514, col: 14, offset: 76479},
+				pos: position{line: 2749, col: 14, offset: 83606},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2514, col: 14, offset: 76479},
+						pos:        position{line: 2749, col: 14, offset: 83606},
 						val:        "outer",
 						ignoreCase: true,
 						want:       "\"OUTER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2514, col: 33, offset: 76498},
+						pos: position{line: 2749, col: 33, offset: 83625},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2514, col: 34, offset: 76499},
+							pos:  position{line: 2749, col: 34, offset: 83626},
 							name: "IdentifierRest",
 						},
 					},
@@ -18242,20 +20390,68 @@ var g = &grammar{
 		},
 		{
 			name: "OUTPUT",
-			pos:  position{line: 2515, col: 1, offset: 76514},
+			pos:  position{line: 2750, col: 1, offset: 83641},
 			expr: &seqExpr{
-				pos: position{line: 2515, col: 14, offset: 76527},
+				pos: position{line: 2750, col: 14, offset: 83654},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2515, col: 14, offset: 76527},
+						pos:        position{line: 2750, col: 14, offset: 83654},
 						val:        "output",
 						ignoreCase: true,
 						want:       "\"OUTPUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2515, col: 33, offset: 76546},
+						pos: position{line: 2750, col: 33, offset: 83673},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2750, col: 34, offset: 83674},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OVER",
+			pos:  position{line: 2751, col: 1, offset: 83689},
+			expr: &seqExpr{
+				pos: position{line: 2751, col: 14, offset: 83702},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2751, col: 14, offset: 83702},
+						val:        "over",
+						ignoreCase: true,
+						want:       "\"OVER\"i",
+					},
+					&notExpr{
+						pos: position{line: 2751, col: 33, offset: 83721},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2751, col: 34, offset: 83722},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PARTITION",
+			pos:  position{line: 2752, col: 1, offset: 83737},
+			expr: &seqExpr{
+				pos: position{line: 2752, col: 14, offset: 83750},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2752, col: 14, offset: 83750},
+						val:        "partition",
+						ignoreCase: true,
+						want:       "\"PARTITION\"i",
+					},
+					&notExpr{
+						pos: position{line: 2752, col: 33, offset: 83769},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2515, col: 34, offset: 76547},
+							pos:  position{line: 2752, col: 34, offset: 83770},
 							name: "IdentifierRest",
 						},
 					},
@@ -18266,20 +20462,164 @@ var g = &grammar{
 		},
 		{
 			name: "PASS",
-			pos:  position{line: 2516, col: 1, offset: 76562},
+			pos:  position{line: 2753, col: 1, offset: 83785},
 			expr: &seqExpr{
-				pos: position{line: 2516, col: 14, offset: 76575},
+				pos: position{line: 2753, col: 14, offset: 83798},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2516, col: 14, offset: 76575},
+						pos:        position{line: 2753, col: 14, offset: 83798},
 						val:        "pass",
 						ignoreCase: true,
 						want:       "\"PASS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2516, col: 33, offset: 76594},
+						pos: position{line: 2753, col: 33, offset: 83817},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2753, col: 34, offset: 83818},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PRECEDING",
+			pos:  position{line: 2754, col: 1, offset: 83833},
+			expr: &seqExpr{
+				pos: position{line: 2754, col: 14, offset: 83846},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2754, col: 14, offset: 83846},
+						val:        "preceding",
+						ignoreCase: true,
+						want:       "\"PRECEDING\"i",
+					},
+					&notExpr{
+						pos: position{line: 2754, col: 33, offset: 83865},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2754, col: 34, offset: 83866},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PUT",
+			pos:  position{line: 2755, col: 1, offset: 83881},
+			expr: &seqExpr{
+				pos: position{line: 2755, col: 14, offset: 83894},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2755, col: 14, offset: 83894},
+						val:        "put",
+						ignoreCase: true,
+						want:       "\"PUT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2755, col: 33, offset: 83913},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2755, col: 34, offset: 83914},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PRAGMA",
+			pos:  position{line: 2756, col: 1, offset: 83929},
+			expr: &seqExpr{
+				pos: position{line: 2756, col: 14, offset: 83942},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2756, col: 14, offset: 83942},
+						val:        "pragma",
+						ignoreCase: true,
+						want:       "\"PRAGMA\"i",
+					},
+					&notExpr{
+						pos: position{line: 2756, col: 33, offset: 83961},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2756, col: 34, offset: 83962},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "QUALIFY",
+			pos:  position{line: 2757, col: 1, offset: 83977},
+			expr: &seqExpr{
+				pos: position{line: 2757, col: 14, offset: 83990},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2757, col: 14, offset: 83990},
+						val:        "qualify",
+						ignoreCase: true,
+						want:       "\"QUALIFY\"i",
+					},
+					&notExpr{
+						pos: position{line: 2757, col: 33, offset: 84009},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2757, col: 34, offset: 84010},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "RANGE",
+			pos:  position{line: 2758, col: 1, offset: 84025},
+			expr: &seqExpr{
+				pos: position{line: 2758, col: 14, offset: 84038},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2758, col: 14, offset: 84038},
+						val:        "range",
+						ignoreCase: true,
+						want:       "\"RANGE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2758, col: 33, offset: 84057},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2758, col: 34, offset: 84058},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "RECURSIVE",
+			pos:  position{line: 2759, col: 1, offset: 84073},
+			expr: &seqExpr{
+				pos: position{line: 2759, col: 14, offset: 84086},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2759, col: 14, offset: 84086},
+						val:        "recursive",
+						ignoreCase: true,
+						want:       "\"RECURSIVE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2759, col: 33, offset: 84105},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2516, col: 34, offset: 76595},
+							pos:  position{line: 2759, col: 34, offset: 84106},
 							name: "IdentifierRest",
 						},
 					},
@@ -18289,21 +20629,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "PUT",
-			pos:  position{line: 2517, col: 1, offset: 76610},
+			name: "RENAME",
+			pos:  position{line: 2760, col: 1, offset: 84121},
 			expr: &seqExpr{
-				pos: position{line: 2517, col: 14, offset: 76623},
+				pos: position{line: 2760, col: 14, offset: 84134},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2517, col: 14, offset: 76623},
-						val:        "put",
+						pos:        position{line: 2760, col: 14, offset: 84134},
+						val:        "rename",
 						ignoreCase: true,
-						want:       "\"PUT\"i",
+						want:       "\"RENAME\"i",
 					},
 					&notExpr{
-						pos: position{line: 2517, col: 33, offset: 76642},
+						pos: position{line: 2760, col: 33, offset: 84153},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2517, col: 34, offset: 76643},
+							pos:  position{line: 2760, col: 34, offset: 84154},
 							name: "IdentifierRest",
 						},
 					},
@@ -18313,21 +20653,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "PRAGMA",
-			pos:  position{line: 2518, col: 1, offset: 76658},
+			name: "RIGHT",
+			pos:  position{line: 2761, col: 1, offset: 84169},
 			expr: &seqExpr{
-				pos: position{line: 2518, col: 14, offset: 76671},
+				pos: position{line: 2761, col: 14, offset: 84182},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2518, col: 14, offset: 76671},
-						val:        "pragma",
+						pos:        position{line: 2761, col: 14, offset: 84182},
+						val:        "right",
 						ignoreCase: true,
-						want:       "\"PRAGMA\"i",
+						want:       "\"RIGHT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2518, col: 33, offset: 76690},
+						pos: position{line: 2761, col: 33, offset: 84201},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2518, col: 34, offset: 76691},
+							pos:  position{line: 2761, col: 34, offset: 84202},
 							name: "IdentifierRest",
 						},
 					},
@@ -18337,21 +20677,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "RECURSIVE",
-			pos:  position{line: 2519, col: 1, offset: 76706},
+			name: "ROW",
+			pos:  position{line: 2762, col: 1, offset: 84217},
 			expr: &seqExpr{
-				pos: position{line: 2519, col: 14, offset: 76719},
+				pos: position{line: 2762, col: 14, offset: 84230},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2519, col: 14, offset: 76719},
-						val:        "recursive",
+						pos:        position{line: 2762, col: 14, offset: 84230},
+						val:        "row",
 						ignoreCase: true,
-						want:       "\"RECURSIVE\"i",
+						want:       "\"ROW\"i",
 					},
 					&notExpr{
-						pos: position{line: 2519, col: 33, offset: 76738},
+						pos: position{line: 2762, col: 33, offset: 84249},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2519, col: 34, offset: 76739},
+							pos:  position{line: 2762, col: 34, offset: 84250},
 							name: "IdentifierRest",
 						},
 					},
@@ -18361,21 +20701,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "RENAME",
-			pos:  position{line: 2520, col: 1, offset: 76754},
+			name: "ROWS",
+			pos:  position{line: 2763, col: 1, offset: 84265},
 			expr: &seqExpr{
-				pos: position{line: 2520, col: 14, offset: 76767},
+				pos: position{line: 2763, col: 14, offset: 84278},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2520, col: 14, offset: 76767},
-						val:        "rename",
+						pos:        position{line: 2763, col: 14, offset: 84278},
+						val:        "rows",
 						ignoreCase: true,
-						want:       "\"RENAME\"i",
+						want:       "\"ROWS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2520, col: 33, offset: 76786},
+						pos: position{line: 2763, col: 33, offset: 84297},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2520, col: 34, offset: 76787},
+							pos:  position{line: 2763, col: 34, offset: 84298},
 							name: "IdentifierRest",
 						},
 					},
@@ -18385,21 +20725,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "RIGHT",
-			pos:  position{line: 2521, col: 1, offset: 76802},
+			name: "SET",
+			pos:  position{line: 2764, col: 1, offset: 84313},
 			expr: &seqExpr{
-				pos: position{line: 2521, col: 14, offset: 76815},
+				pos: position{line: 2764, col: 14, offset: 84326},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2521, col: 14, offset: 76815},
-						val:        "right",
+						pos:        position{line: 2764, col: 14, offset: 84326},
+						val:        "set",
 						ignoreCase: true,
-						want:       "\"RIGHT\"i",
+						want:       "\"SET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2521, col: 33, offset: 76834},
+						pos: position{line: 2764, col: 33, offset: 84345},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2521, col: 34, offset: 76835},
+							pos:  position{line: 2764, col: 34, offset: 84346},
 							name: "IdentifierRest",
 						},
 					},
@@ -18410,20 +20750,20 @@ var g = &grammar{
 		},
 		{
 			name: "SHAPES",
-			pos:  position{line: 2522, col: 1, offset: 76850},
+			pos:  position{line: 2765, col: 1, offset: 84361},
 			expr: &seqExpr{
-				pos: position{line: 2522, col: 14, offset: 76863},
+				pos: position{line: 2765, col: 14, offset: 84374},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2522, col: 14, offset: 76863},
+						pos:        position{line: 2765, col: 14, offset: 84374},
 						val:        "shapes",
 						ignoreCase: true,
 						want:       "\"SHAPES\"i",
 					},
 					&notExpr{
-						pos: position{line: 2522, col: 33, offset: 76882},
+						pos: position{line: 2765, col: 33, offset: 84393},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2522, col: 34, offset: 76883},
+							pos:  position{line: 2765, col: 34, offset: 84394},
 							name: "IdentifierRest",
 						},
 					},
@@ -18434,20 +20774,20 @@ var g = &grammar{
 		},
 		{
 			name: "SEARCH",
-			pos:  position{line: 2523, col: 1, offset: 76898},
+			pos:  position{line: 2766, col: 1, offset: 84409},
 			expr: &seqExpr{
-				pos: position{line: 2523, col: 14, offset: 76911},
+				pos: position{line: 2766, col: 14, offset: 84422},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2523, col: 14, offset: 76911},
+						pos:        position{line: 2766, col: 14, offset: 84422},
 						val:        "search",
 						ignoreCase: true,
 						want:       "\"SEARCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2523, col: 33, offset: 76930},
+						pos: position{line: 2766, col: 33, offset: 84441},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2523, col: 34, offset: 76931},
+							pos:  position{line: 2766, col: 34, offset: 84442},
 							name: "IdentifierRest",
 						},
 					},
@@ -18458,20 +20798,20 @@ var g = &grammar{
 		},
 		{
 			name: "SELECT",
-			pos:  position{line: 2524, col: 1, offset: 76946},
+			pos:  position{line: 2767, col: 1, offset: 84457},
 			expr: &seqExpr{
-				pos: position{line: 2524, col: 14, offset: 76959},
+				pos: position{line: 2767, col: 14, offset: 84470},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2524, col: 14, offset: 76959},
+						pos:        position{line: 2767, col: 14, offset: 84470},
 						val:        "select",
 						ignoreCase: true,
 						want:       "\"SELECT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2524, col: 33, offset: 76978},
+						pos: position{line: 2767, col: 33, offset: 84489},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2524, col: 34, offset: 76979},
+							pos:  position{line: 2767, col: 34, offset: 84490},
 							name: "IdentifierRest",
 						},
 					},
@@ -18482,20 +20822,20 @@ var g = &grammar{
 		},
 		{
 			name: "SHAPE",
-			pos:  position{line: 2525, col: 1, offset: 76994},
+			pos:  position{line: 2768, col: 1, offset: 84505},
 			expr: &seqExpr{
-				pos: position{line: 2525, col: 14, offset: 77007},
+				pos: position{line: 2768, col: 14, offset: 84518},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2525, col: 14, offset: 77007},
+						pos:        position{line: 2768, col: 14, offset: 84518},
 						val:        "shape",
 						ignoreCase: true,
 						want:       "\"SHAPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2525, col: 33, offset: 77026},
+						pos: position{line: 2768, col: 33, offset: 84537},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2525, col: 34, offset: 77027},
+							pos:  position{line: 2768, col: 34, offset: 84538},
 							name: "IdentifierRest",
 						},
 					},
@@ -18506,20 +20846,20 @@ var g = &grammar{
 		},
 		{
 			name: "SKIP",
-			pos:  position{line: 2526, col: 1, offset: 77042},
+			pos:  position{line: 2769, col: 1, offset: 84553},
 			expr: &seqExpr{
-				pos: position{line: 2526, col: 14, offset: 77055},
+				pos: position{line: 2769, col: 14, offset: 84566},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2526, col: 14, offset: 77055},
+						pos:        position{line: 2769, col: 14, offset: 84566},
 						val:        "skip",
 						ignoreCase: true,
 						want:       "\"SKIP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2526, col: 33, offset: 77074},
+						pos: position{line: 2769, col: 33, offset: 84585},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2526, col: 34, offset: 77075},
+							pos:  position{line: 2769, col: 34, offset: 84586},
 							name: "IdentifierRest",
 						},
 					},
@@ -18530,20 +20870,20 @@ var g = &grammar{
 		},
 		{
 			name: "SORT",
-			pos:  position{line: 2527, col: 1, offset: 77090},
+			pos:  position{line: 2770, col: 1, offset: 84601},
 			expr: &seqExpr{
-				pos: position{line: 2527, col: 14, offset: 77103},
+				pos: position{line: 2770, col: 14, offset: 84614},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2527, col: 14, offset: 77103},
+						pos:        position{line: 2770, col: 14, offset: 84614},
 						val:        "sort",
 						ignoreCase: true,
 						want:       "\"SORT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2527, col: 33, offset: 77122},
+						pos: position{line: 2770, col: 33, offset: 84633},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2527, col: 34, offset: 77123},
+							pos:  position{line: 2770, col: 34, offset: 84634},
 							name: "IdentifierRest",
 						},
 					},
@@ -18554,20 +20894,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUBSTRING",
-			pos:  position{line: 2528, col: 1, offset: 77138},
+			pos:  position{line: 2771, col: 1, offset: 84649},
 			expr: &seqExpr{
-				pos: position{line: 2528, col: 14, offset: 77151},
+				pos: position{line: 2771, col: 14, offset: 84662},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2528, col: 14, offset: 77151},
+						pos:        position{line: 2771, col: 14, offset: 84662},
 						val:        "substring",
 						ignoreCase: true,
 						want:       "\"SUBSTRING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2528, col: 33, offset: 77170},
+						pos: position{line: 2771, col: 33, offset: 84681},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2528, col: 34, offset: 77171},
+							pos:  position{line: 2771, col: 34, offset: 84682},
 							name: "IdentifierRest",
 						},
 					},
@@ -18578,20 +20918,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUMMARIZE",
-			pos:  position{line: 2529, col: 1, offset: 77186},
+			pos:  position{line: 2772, col: 1, offset: 84697},
 			expr: &seqExpr{
-				pos: position{line: 2529, col: 14, offset: 77199},
+				pos: position{line: 2772, col: 14, offset: 84710},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2529, col: 14, offset: 77199},
+						pos:        position{line: 2772, col: 14, offset: 84710},
 						val:        "summarize",
 						ignoreCase: true,
 						want:       "\"SUMMARIZE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2529, col: 33, offset: 77218},
+						pos: position{line: 2772, col: 33, offset: 84729},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2529, col: 34, offset: 77219},
+							pos:  position{line: 2772, col: 34, offset: 84730},
 							name: "IdentifierRest",
 						},
 					},
@@ -18602,20 +20942,20 @@ var g = &grammar{
 		},
 		{
 			name: "SWITCH",
-			pos:  position{line: 2530, col: 1, offset: 77234},
+			pos:  position{line: 2773, col: 1, offset: 84745},
 			expr: &seqExpr{
-				pos: position{line: 2530, col: 14, offset: 77247},
+				pos: position{line: 2773, col: 14, offset: 84758},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2530, col: 14, offset: 77247},
+						pos:        position{line: 2773, col: 14, offset: 84758},
 						val:        "switch",
 						ignoreCase: true,
 						want:       "\"SWITCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2530, col: 33, offset: 77266},
+						pos: position{line: 2773, col: 33, offset: 84777},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2530, col: 34, offset: 77267},
+							pos:  position{line: 2773, col: 34, offset: 84778},
 							name: "IdentifierRest",
 						},
 					},
@@ -18626,20 +20966,20 @@ var g = &grammar{
 		},
 		{
 			name: "TAIL",
-			pos:  position{line: 2531, col: 1, offset: 77282},
+			pos:  position{line: 2774, col: 1, offset: 84793},
 			expr: &seqExpr{
-				pos: position{line: 2531, col: 14, offset: 77295},
+				pos: position{line: 2774, col: 14, offset: 84806},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2531, col: 14, offset: 77295},
+						pos:        position{line: 2774, col: 14, offset: 84806},
 						val:        "tail",
 						ignoreCase: true,
 						want:       "\"TAIL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2531, col: 33, offset: 77314},
+						pos: position{line: 2774, col: 33, offset: 84825},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2531, col: 34, offset: 77315},
+							pos:  position{line: 2774, col: 34, offset: 84826},
 							name: "IdentifierRest",
 						},
 					},
@@ -18650,20 +20990,20 @@ var g = &grammar{
 		},
 		{
 			name: "THEN",
-			pos:  position{line: 2532, col: 1, offset: 77330},
+			pos:  position{line: 2775, col: 1, offset: 84841},
 			expr: &seqExpr{
-				pos: position{line: 2532, col: 14, offset: 77343},
+				pos: position{line: 2775, col: 14, offset: 84854},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2532, col: 14, offset: 77343},
+						pos:        position{line: 2775, col: 14, offset: 84854},
 						val:        "then",
 						ignoreCase: true,
 						want:       "\"THEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2532, col: 33, offset: 77362},
+						pos: position{line: 2775, col: 33, offset: 84873},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2532, col: 34, offset: 77363},
+							pos:  position{line: 2775, col: 34, offset: 84874},
 							name: "IdentifierRest",
 						},
 					},
@@ -18674,23 +21014,23 @@ var g = &grammar{
 		},
 		{
 			name: "TIMESTAMP",
-			pos:  position{line: 2533, col: 1, offset: 77378},
+			pos:  position{line: 2776, col: 1, offset: 84889},
 			expr: &actionExpr{
-				pos: position{line: 2533, col: 14, offset: 77391},
+				pos: position{line: 2776, col: 14, offset: 84902},
 				run: (*parser).callonTIMESTAMP1,
 				expr: &seqExpr{
-					pos: position{line: 2533, col: 14, offset: 77391},
+					pos: position{line: 2776, col: 14, offset: 84902},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2533, col: 14, offset: 77391},
+							pos:        position{line: 2776, col: 14, offset: 84902},
 							val:        "timestamp",
 							ignoreCase: true,
 							want:       "\"TIMESTAMP\"i",
 						},
 						&notExpr{
-							pos: position{line: 2533, col: 33, offset: 77410},
+							pos: position{line: 2776, col: 33, offset: 84921},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2533, col: 34, offset: 77411},
+								pos:  position{line: 2776, col: 34, offset: 84922},
 								name: "IdentifierRest",
 							},
 						},
@@ -18700,22 +21040,46 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "TOLERANCE",
+			pos:  position{line: 2777, col: 1, offset: 84965},
+			expr: &seqExpr{
+				pos: position{line: 2777, col: 14, offset: 84978},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2777, col: 14, offset: 84978},
+						val:        "tolerance",
+						ignoreCase: true,
+						want:       "\"TOLERANCE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2777, col: 33, offset: 84997},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2777, col: 34, offset: 84998},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "TOP",
-			pos:  position{line: 2534, col: 1, offset: 77454},
+			pos:  position{line: 2778, col: 1, offset: 85013},
 			expr: &seqExpr{
-				pos: position{line: 2534, col: 14, offset: 77467},
+				pos: position{line: 2778, col: 14, offset: 85026},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2534, col: 14, offset: 77467},
+						pos:        position{line: 2778, col: 14, offset: 85026},
 						val:        "top",
 						ignoreCase: true,
 						want:       "\"TOP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2534, col: 33, offset: 77486},
+						pos: position{line: 2778, col: 33, offset: 85045},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2534, col: 34, offset: 77487},
+							pos:  position{line: 2778, col: 34, offset: 85046},
 							name: "IdentifierRest",
 						},
 					},
@@ -18726,20 +21090,20 @@ var g = &grammar{
 		},
 		{
 			name: "TRUE",
-			pos:  position{line: 2535, col: 1, offset: 77502},
+			pos:  position{line: 2779, col: 1, offset: 85061},
 			expr: &seqExpr{
-				pos: position{line: 2535, col: 14, offset: 77515},
+				pos: position{line: 2779, col: 14, offset: 85074},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2535, col: 14, offset: 77515},
+						pos:        position{line: 2779, col: 14, offset: 85074},
 						val:        "true",
 						ignoreCase: true,
 						want:       "\"TRUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2535, col: 33, offset: 77534},
+						pos: position{line: 2779, col: 33, offset: 85093},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2535, col: 34, offset: 77535},
+							pos:  position{line: 2779, col: 34, offset: 85094},
 							name: "IdentifierRest",
 						},
 					},
@@ -18750,20 +21114,44 @@ var g = &grammar{
 		},
 		{
 			name: "TYPE",
-			pos:  position{line: 2536, col: 1, offset: 77550},
+			pos:  position{line: 2780, col: 1, offset: 85109},
 			expr: &seqExpr{
-				pos: position{line: 2536, col: 14, offset: 77563},
+				pos: position{line: 2780, col: 14, offset: 85122},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2536, col: 14, offset: 77563},
+						pos:        position{line: 2780, col: 14, offset: 85122},
 						val:        "type",
 						ignoreCase: true,
 						want:       "\"TYPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2536, col: 33, offset: 77582},
+						pos: position{line: 2780, col: 33, offset: 85141},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2780, col: 34, offset: 85142},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "UNBOUNDED",
+			pos:  position{line: 2781, col: 1, offset: 85157},
+			expr: &seqExpr{
+				pos: position{line: 2781, col: 14, offset: 85170},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2781, col: 14, offset: 85170},
+						val:        "unbounded",
+						ignoreCase: true,
+						want:       "\"UNBOUNDED\"i",
+					},
+					&notExpr{
+						pos: position{line: 2781, col: 33, offset: 85189},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2536, col: 34, offset: 77583},
+							pos:  position{line: 2781, col: 34, offset: 85190},
 							name: "IdentifierRest",
 						},
 					},
@@ -18774,20 +21162,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNION",
-			pos:  position{line: 2537, col: 1, offset: 77598},
+			pos:  position{line: 2782, col: 1, offset: 85205},
 			expr: &seqExpr{
-				pos: position{line: 2537, col: 14, offset: 77611},
+				pos: position{line: 2782, col: 14, offset: 85218},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2537, col: 14, offset: 77611},
+						pos:        position{line: 2782, col: 14, offset: 85218},
 						val:        "union",
 						ignoreCase: true,
 						want:       "\"UNION\"i",
 					},
 					&notExpr{
-						pos: position{line: 2537, col: 33, offset: 77630},
+						pos: position{line: 2782, col: 33, offset: 85237},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2537, col: 34, offset: 77631},
+							pos:  position{line: 2782, col: 34, offset: 85238},
 							name: "IdentifierRest",
 						},
 					},
@@ -18798,20 +21186,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNIQ",
-			pos:  position{line: 2538, col: 1, offset: 77646},
+			pos:  position{line: 2783, col: 1, offset: 85253},
 			expr: &seqExpr{
-				pos: position{line: 2538, col: 14, offset: 77659},
+				pos: position{line: 2783, col: 14, offset: 85266},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2538, col: 14, offset: 77659},
+						pos:        position{line: 2783, col: 14, offset: 85266},
 						val:        "uniq",
 						ignoreCase: true,
 						want:       "\"UNIQ\"i",
 					},
 					&notExpr{
-						pos: position{line: 2538, col: 33, offset: 77678},
+						pos: position{line: 2783, col: 33, offset: 85285},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2538, col: 34, offset: 77679},
+							pos:  position{line: 2783, col: 34, offset: 85286},
 							name: "IdentifierRest",
 						},
 					},
@@ -18822,20 +21210,44 @@ var g = &grammar{
 		},
 		{
 			name: "UNNEST",
-			pos:  position{line: 2539, col: 1, offset: 77694},
+			pos:  position{line: 2784, col: 1, offset: 85301},
 			expr: &seqExpr{
-				pos: position{line: 2539, col: 14, offset: 77707},
+				pos: position{line: 2784, col: 14, offset: 85314},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2539, col: 14, offset: 77707},
+						pos:        position{line: 2784, col: 14, offset: 85314},
 						val:        "unnest",
 						ignoreCase: true,
 						want:       "\"UNNEST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2539, col: 33, offset: 77726},
+						pos: position{line: 2784, col: 33, offset: 85333},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2784, col: 34, offset: 85334},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "UPDATE",
+			pos:  position{line: 2785, col: 1, offset: 85349},
+			expr: &seqExpr{
+				pos: position{line: 2785, col: 14, offset: 85362},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2785, col: 14, offset: 85362},
+						val:        "update",
+						ignoreCase: true,
+						want:       "\"UPDATE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2785, col: 33, offset: 85381},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2539, col: 34, offset: 77727},
+							pos:  position{line: 2785, col: 34, offset: 85382},
 							name: "IdentifierRest",
 						},
 					},
@@ -18846,20 +21258,20 @@ var g = &grammar{
 		},
 		{
 			name: "USING",
-			pos:  position{line: 2540, col: 1, offset: 77742},
+			pos:  position{line: 2786, col: 1, offset: 85397},
 			expr: &seqExpr{
-				pos: position{line: 2540, col: 14, offset: 77755},
+				pos: position{line: 2786, col: 14, offset: 85410},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2540, col: 14, offset: 77755},
+						pos:        position{line: 2786, col: 14, offset: 85410},
 						val:        "using",
 						ignoreCase: true,
 						want:       "\"USING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2540, col: 33, offset: 77774},
+						pos: position{line: 2786, col: 33, offset: 85429},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2540, col: 34, offset: 77775},
+							pos:  position{line: 2786, col: 34, offset: 85430},
 							name: "IdentifierRest",
 						},
 					},
@@ -18870,20 +21282,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUE",
-			pos:  position{line: 2541, col: 1, offset: 77790},
+			pos:  position{line: 2787, col: 1, offset: 85445},
 			expr: &seqExpr{
-				pos: position{line: 2541, col: 14, offset: 77803},
+				pos: position{line: 2787, col: 14, offset: 85458},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2541, col: 14, offset: 77803},
+						pos:        position{line: 2787, col: 14, offset: 85458},
 						val:        "value",
 						ignoreCase: true,
 						want:       "\"VALUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2541, col: 33, offset: 77822},
+						pos: position{line: 2787, col: 33, offset: 85477},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2541, col: 34, offset: 77823},
+							pos:  position{line: 2787, col: 34, offset: 85478},
 							name: "IdentifierRest",
 						},
 					},
@@ -18894,20 +21306,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUES",
-			pos:  position{line: 2542, col: 1, offset: 77838},
+			pos:  position{line: 2788, col: 1, offset: 85493},
 			expr: &seqExpr{
-				pos: position{line: 2542, col: 14, offset: 77851},
+				pos: position{line: 2788, col: 14, offset: 85506},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2542, col: 14, offset: 77851},
+						pos:        position{line: 2788, col: 14, offset: 85506},
 						val:        "values",
 						ignoreCase: true,
 						want:       "\"VALUES\"i",
 					},
 					&notExpr{
-						pos: position{line: 2542, col: 33, offset: 77870},
+						pos: position{line: 2788, col: 33, offset: 85525},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2542, col: 34, offset: 77871},
+							pos:  position{line: 2788, col: 34, offset: 85526},
 							name: "IdentifierRest",
 						},
 					},
@@ -18918,20 +21330,20 @@ var g = &grammar{
 		},
 		{
 			name: "WHEN",
-			pos:  position{line: 2543, col: 1, offset: 77886},
+			pos:  position{line: 2789, col: 1, offset: 85541},
 			expr: &seqExpr{
-				pos: position{line: 2543, col: 14, offset: 77899},
+				pos: position{line: 2789, col: 14, offset: 85554},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2543, col: 14, offset: 77899},
+						pos:        position{line: 2789, col: 14, offset: 85554},
 						val:        "when",
 						ignoreCase: true,
 						want:       "\"WHEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2543, col: 33, offset: 77918},
+						pos: position{line: 2789, col: 33, offset: 85573},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2543, col: 34, offset: 77919},
+							pos:  position{line: 2789, col: 34, offset: 85574},
 							name: "IdentifierRest",
 						},
 					},
@@ -18942,20 +21354,44 @@ var g = &grammar{
 		},
 		{
 			name: "WHERE",
-			pos:  position{line: 2544, col: 1, offset: 77934},
+			pos:  position{line: 2790, col: 1, offset: 85589},
 			expr: &seqExpr{
-				pos: position{line: 2544, col: 14, offset: 77947},
+				pos: position{line: 2790, col: 14, offset: 85602},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2544, col: 14, offset: 77947},
+						pos:        position{line: 2790, col: 14, offset: 85602},
 						val:        "where",
 						ignoreCase: true,
 						want:       "\"WHERE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2544, col: 33, offset: 77966},
+						pos: position{line: 2790, col: 33, offset: 85621},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2790, col: 34, offset: 85622},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WINDOW",
+			pos:  position{line: 2791, col: 1, offset: 85637},
+			expr: &seqExpr{
+				pos: position{line: 2791, col: 14, offset: 85650},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2791, col: 14, offset: 85650},
+						val:        "window",
+						ignoreCase: true,
+						want:       "\"WINDOW\"i",
+					},
+					&notExpr{
+						pos: position{line: 2791, col: 33, offset: 85669},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2544, col: 34, offset: 77967},
+							pos:  position{line: 2791, col: 34, offset: 85670},
 							name: "IdentifierRest",
 						},
 					},
@@ -18966,20 +21402,20 @@ var g = &grammar{
 		},
 		{
 			name: "WITH",
-			pos:  position{line: 2545, col: 1, offset: 77982},
+			pos:  position{line: 2792, col: 1, offset: 85685},
 			expr: &seqExpr{
-				pos: position{line: 2545, col: 14, offset: 77995},
+				pos: position{line: 2792, col: 14, offset: 85698},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2545, col: 14, offset: 77995},
+						pos:        position{line: 2792, col: 14, offset: 85698},
 						val:        "with",
 						ignoreCase: true,
 						want:       "\"WITH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2545, col: 33, offset: 78014},
+						pos: position{line: 2792, col: 33, offset: 85717},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2545, col: 34, offset: 78015},
+							pos:  position{line: 2792, col: 34, offset: 85718},
 							name: "IdentifierRest",
 						},
 					},
@@ -19649,7 +22085,23 @@ func (p *parser) callonAggFunc21() (any, error) {
 	return p.cur.onAggFunc21(stack["name"], stack["expr"], stack["filter"])
 }
 
-func (c *current) onAggFunc36(cs, filter any) (any, error) {
+func (c *current) onAggFunc36(name, args any) (any, error) {
+	return &ast.CallExpr{
+		Kind: "CallExpr",
+		Func: &ast.FuncNameExpr{Kind: "FuncNameExpr", Name: name.(string), Loc: loc(c)},
+		Args: sliceOf[ast.Expr](args),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonAggFunc36() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggFunc36(stack["name"], stack["args"])
+}
+
+func (c *current) onAggFunc47(cs, filter any) (any, error) {
 	agg := &ast.AggFuncExpr{
 		Kind: "AggFuncExpr",
 		Name: "count",
@@ -19662,10 +22114,10 @@ func (c *current) onAggFunc36(cs, filter any) (any, error) {
 
 }
 
-func (p *parser) callonAggFunc36() (any, error) {
+func (p *parser) callonAggFunc47() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAggFunc36(stack["cs"], stack["filter"])
+	return p.cur.onAggFunc47(stack["cs"], stack["filter"])
 }
 
 func (c *current) onFilterClause1(expr any) (any, error) {
@@ -20257,7 +22709,7 @@ func (p *parser) callonJoinOp2() (any, error) {
 	return p.cur.onJoinOp2(stack["rightInput"], stack["alias"])
 }
 
-func (c *current) onJoinOp11(style, rightInput, alias, e any) (any, error) {
+func (c *current) onJoinOp11(style, rightInput, alias, e, tol any) (any, error) {
 	o := &ast.JoinOp{
 		Kind:  "JoinOp",
 		Style: style.(string),
@@ -20270,6 +22722,9 @@ func (c *current) onJoinOp11(style, rightInput, alias, e any) (any, error) {
 	if alias != nil {
 		o.Alias = alias.(*ast.JoinAlias)
 	}
+	if tol != nil {
+		o.Tolerance = tol.(ast.Expr)
+	}
 	return o, nil
 
 }
@@ -20277,11 +22732,11 @@ func (c *current) onJoinOp11(style, rightInput, alias, e any) (any, error) {
 func (p *parser) callonJoinOp11() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinOp11(stack["style"], stack["rightInput"], stack["alias"], stack["e"])
+	return p.cur.onJoinOp11(stack["style"], stack["rightInput"], stack["alias"], stack["e"], stack["tol"])
 }
 
 func (c *current) onJoinStyle2() (any, error) {
-	return "anti", nil
+	return "asof left", nil
 }
 
 func (p *parser) callonJoinStyle2() (any, error) {
@@ -20290,44 +22745,64 @@ func (p *parser) callonJoinStyle2() (any, error) {
 	return p.cur.onJoinStyle2()
 }
 
-func (c *current) onJoinStyle6() (any, error) {
+func (c *current) onJoinStyle8() (any, error) {
+	return "asof", nil
+}
+
+func (p *parser) callonJoinStyle8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle8()
+}
+
+func (c *current) onJoinStyle12() (any, error) {
+	return "anti", nil
+}
+
+func (p *parser) callonJoinStyle12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle12()
+}
+
+func (c *current) onJoinStyle16() (any, error) {
 	return "inner", nil
 }
 
-func (p *parser) callonJoinStyle6() (any, error) {
+func (p *parser) callonJoinStyle16() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinStyle6()
+	return p.cur.onJoinStyle16()
 }
 
-func (c *current) onJoinStyle10() (any, error) {
+func (c *current) onJoinStyle20() (any, error) {
 	return "left", nil
 }
 
-func (p *parser) callonJoinStyle10() (any, error) {
+func (p *parser) callonJoinStyle20() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinStyle10()
+	return p.cur.onJoinStyle20()
 }
 
-func (c *current) onJoinStyle14() (any, error) {
+func (c *current) onJoinStyle24() (any, error) {
 	return "right", nil
 }
 
-func (p *parser) callonJoinStyle14() (any, error) {
+func (p *parser) callonJoinStyle24() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinStyle14()
+	return p.cur.onJoinStyle24()
 }
 
-func (c *current) onJoinStyle18() (any, error) {
+func (c *current) onJoinStyle28() (any, error) {
 	return "", nil
 }
 
-func (p *parser) callonJoinStyle18() (any, error) {
+func (p *parser) callonJoinStyle28() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinStyle18()
+	return p.cur.onJoinStyle28()
 }
 
 func (c *current) onOptJoinAlias2(a any) (any, error) {
@@ -21433,15 +23908,42 @@ func (p *parser) callonFunction44() (any, error) {
 	return p.cur.onFunction44(stack["expr"], stack["from"], stack["for_"])
 }
 
-func (c *current) onFunction71(f, args any) (any, error) {
-	return newCall(c, f, args), nil
+func (c *current) onFunction71(f, over any) (any, error) {
+	return &ast.WindowExpr{
+		Kind: "WindowExpr",
+		Func: f.(ast.Expr),
+		Over: over.(*ast.WindowSpec),
+		Loc:  loc(c),
+	}, nil
 
 }
 
 func (p *parser) callonFunction71() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFunction71(stack["f"], stack["args"])
+	return p.cur.onFunction71(stack["f"], stack["over"])
+}
+
+func (c *current) onFunction80(f, args any) (any, error) {
+	return newCall(c, f, args), nil
+
+}
+
+func (p *parser) callonFunction80() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunction80(stack["f"], stack["args"])
+}
+
+func (c *current) onWindowCall2(f, args any) (any, error) {
+	return newCall(c, f, args), nil
+
+}
+
+func (p *parser) callonWindowCall2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowCall2(stack["f"], stack["args"])
 }
 
 func (c *current) onCallable3(id any) (any, error) {
@@ -21519,47 +24021,58 @@ func (p *parser) callonExprs1() (any, error) {
 	return p.cur.onExprs1(stack["first"], stack["rest"])
 }
 
-func (c *current) onPrimary8(id any) (any, error) {
+func (c *current) onPrimary9(id any) (any, error) {
 	return &ast.IDExpr{Kind: "IDExpr", ID: *(id.(*ast.ID))}, nil
 }
 
-func (p *parser) callonPrimary8() (any, error) {
+func (p *parser) callonPrimary9() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary8(stack["id"])
+	return p.cur.onPrimary9(stack["id"])
 }
 
-func (c *current) onPrimary12(expr any) (any, error) {
+func (c *current) onPrimary13(expr any) (any, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimary12() (any, error) {
+func (p *parser) callonPrimary13() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary12(stack["expr"])
+	return p.cur.onPrimary13(stack["expr"])
 }
 
-func (c *current) onPrimary20(expr any) (any, error) {
+func (c *current) onPrimary21(expr any) (any, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimary20() (any, error) {
+func (p *parser) callonPrimary21() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary20(stack["expr"])
+	return p.cur.onPrimary21(stack["expr"])
 }
 
-func (c *current) onPrimary28(expr any) (any, error) {
+func (c *current) onPrimary29(expr any) (any, error) {
 
 	expr.(*ast.SubqueryExpr).Array = true
 	return expr, nil
 
 }
 
-func (p *parser) callonPrimary28() (any, error) {
+func (p *parser) callonPrimary29() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary29(stack["expr"])
+}
+
+func (c *current) onParam1(name any) (any, error) {
+	return &ast.ParamExpr{Kind: "ParamExpr", Name: name.(string), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonParam1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary28(stack["expr"])
+	return p.cur.onParam1(stack["name"])
 }
 
 func (c *current) onCaseExpr2(whens, else_ any) (any, error) {
@@ -22089,7 +24602,7 @@ func (p *parser) callonEasyType26() (any, error) {
 	return p.cur.onEasyType26(stack["names"])
 }
 
-func (c *current) onEasyType36() (any, error) {
+func (c *current) onEasyType37() (any, error) {
 	return &ast.TypeFusion{
 		Kind: "TypeFusion",
 		Type: &ast.TypePrimitive{
@@ -22102,13 +24615,13 @@ func (c *current) onEasyType36() (any, error) {
 
 }
 
-func (p *parser) callonEasyType36() (any, error) {
+func (p *parser) callonEasyType37() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType36()
+	return p.cur.onEasyType37()
 }
 
-func (c *current) onEasyType38(t any) (any, error) {
+func (c *current) onEasyType39(t any) (any, error) {
 	return &ast.TypeFusion{
 		Kind: "TypeFusion",
 		Type: t.(ast.Type),
@@ -22117,13 +24630,13 @@ func (c *current) onEasyType38(t any) (any, error) {
 
 }
 
-func (p *parser) callonEasyType38() (any, error) {
+func (p *parser) callonEasyType39() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType38(stack["t"])
+	return p.cur.onEasyType39(stack["t"])
 }
 
-func (c *current) onEasyType48(fields any) (any, error) {
+func (c *current) onEasyType49(fields any) (any, error) {
 	return &ast.TypeRecord{
 		Kind:   "TypeRecord",
 		Fields: sliceOf[ast.TypeField](fields),
@@ -22132,13 +24645,13 @@ func (c *current) onEasyType48(fields any) (any, error) {
 
 }
 
-func (p *parser) callonEasyType48() (any, error) {
+func (p *parser) callonEasyType49() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType48(stack["fields"])
+	return p.cur.onEasyType49(stack["fields"])
 }
 
-func (c *current) onEasyType56(typ any) (any, error) {
+func (c *current) onEasyType57(typ any) (any, error) {
 	return &ast.TypeArray{
 		Kind: "TypeArray",
 		Type: typ.(ast.Type),
@@ -22147,13 +24660,13 @@ func (c *current) onEasyType56(typ any) (any, error) {
 
 }
 
-func (p *parser) callonEasyType56() (any, error) {
+func (p *parser) callonEasyType57() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType56(stack["typ"])
+	return p.cur.onEasyType57(stack["typ"])
 }
 
-func (c *current) onEasyType64(typ any) (any, error) {
+func (c *current) onEasyType65(typ any) (any, error) {
 	return &ast.TypeSet{
 		Kind: "TypeSet",
 		Type: typ.(ast.Type),
@@ -22162,13 +24675,13 @@ func (c *current) onEasyType64(typ any) (any, error) {
 
 }
 
-func (p *parser) callonEasyType64() (any, error) {
+func (p *parser) callonEasyType65() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType64(stack["typ"])
+	return p.cur.onEasyType65(stack["typ"])
 }
 
-func (c *current) onEasyType72(keyType, valType any) (any, error) {
+func (c *current) onEasyType73(keyType, valType any) (any, error) {
 	return &ast.TypeMap{
 		Kind:    "TypeMap",
 		KeyType: keyType.(ast.Type),
@@ -22178,10 +24691,10 @@ func (c *current) onEasyType72(keyType, valType any) (any, error) {
 
 }
 
-func (p *parser) callonEasyType72() (any, error) {
+func (p *parser) callonEasyType73() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEasyType72(stack["keyType"], stack["valType"])
+	return p.cur.onEasyType73(stack["keyType"], stack["valType"])
 }
 
 func (c *current) onTypeUnion1(types any) (any, error) {
@@ -22387,6 +24900,35 @@ func (p *parser) callonPrimitiveType5() (any, error) {
 	return p.cur.onPrimitiveType5()
 }
 
+func (c *current) onDecimalType1(precision, scale any) (any, error) {
+	t := &ast.TypeDecimal{
+		Kind:      "TypeDecimal",
+		Precision: precision.(int),
+		Loc:       loc(c),
+	}
+	if scale != nil {
+		t.Scale = scale.(int)
+	}
+	return t, nil
+
+}
+
+func (p *parser) callonDecimalType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDecimalType1(stack["precision"], stack["scale"])
+}
+
+func (c *current) onDecimalScale1(scale any) (any, error) {
+	return scale, nil
+}
+
+func (p *parser) callonDecimalScale1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDecimalScale1(stack["scale"])
+}
+
 func (c *current) onPostgreSQLPrimitiveType2() (any, error) {
 	return "int64", nil
 }
@@ -23243,34 +25785,181 @@ func (p *parser) callonUnicodeEscape11() (any, error) {
 	return p.cur.onUnicodeEscape11(stack["chars"])
 }
 
-func (c *current) onSQLPipe1(s any) (any, error) {
-	return &ast.SQLPipe{
-		Kind: "SQLPipe",
-		Body: sliceOf[ast.Op](s),
-		Loc:  loc(c),
-	}, nil
-
+func (c *current) onSQLPipe1(s any) (any, error) {
+	return &ast.SQLPipe{
+		Kind: "SQLPipe",
+		Body: sliceOf[ast.Op](s),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonSQLPipe1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLPipe1(stack["s"])
+}
+
+func (c *current) onSQLOp1(query any) (any, error) {
+	return &ast.SQLOp{
+		Kind: "SQLOp",
+		Body: query.(*ast.SQLQuery),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonSQLOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLOp1(stack["query"])
+}
+
+func (c *current) onMergeIntoOp23(clause any) (any, error) {
+	return clause, nil
+}
+
+func (p *parser) callonMergeIntoOp23() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeIntoOp23(stack["clause"])
+}
+
+func (c *current) onMergeIntoOp1(target, alias, source, cond, clauses any) (any, error) {
+	m := &ast.SQLMerge{
+		Kind:    "SQLMerge",
+		Target:  target.(*ast.FromItem),
+		Source:  source.(ast.SQLTableExpr),
+		Cond:    cond.(ast.Expr),
+		Clauses: sliceOf[*ast.SQLMergeClause](clauses),
+		Loc:     loc(c),
+	}
+	if alias != nil {
+		m.Alias = alias.(*ast.TableAlias)
+	}
+	return m, nil
+
+}
+
+func (p *parser) callonMergeIntoOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeIntoOp1(stack["target"], stack["alias"], stack["source"], stack["cond"], stack["clauses"])
+}
+
+func (c *current) onMergeWhen2(cond, action any) (any, error) {
+	clause := action.(*ast.SQLMergeClause)
+	clause.Matched = true
+	if cond != nil {
+		clause.Cond = cond.(ast.Expr)
+	}
+	clause.Loc = loc(c)
+	return clause, nil
+
+}
+
+func (p *parser) callonMergeWhen2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeWhen2(stack["cond"], stack["action"])
+}
+
+func (c *current) onMergeWhen14(cond, action any) (any, error) {
+	clause := action.(*ast.SQLMergeClause)
+	if cond != nil {
+		clause.Cond = cond.(ast.Expr)
+	}
+	clause.Loc = loc(c)
+	return clause, nil
+
+}
+
+func (p *parser) callonMergeWhen14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeWhen14(stack["cond"], stack["action"])
+}
+
+func (c *current) onOptMergeCond2(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonOptMergeCond2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptMergeCond2(stack["e"])
+}
+
+func (c *current) onOptMergeCond9() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptMergeCond9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptMergeCond9()
+}
+
+func (c *current) onMergeMatchedAction12(s any) (any, error) {
+	return s, nil
+}
+
+func (p *parser) callonMergeMatchedAction12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeMatchedAction12(stack["s"])
+}
+
+func (c *current) onMergeMatchedAction2(first, rest any) (any, error) {
+	clause := &ast.SQLMergeClause{Action: "update"}
+	for _, s := range prepend(first, rest) {
+		pair := s.([]any)
+		clause.Columns = append(clause.Columns, pair[0].(*ast.ID))
+		clause.Values = append(clause.Values, pair[1].(ast.Expr))
+	}
+	return clause, nil
+
+}
+
+func (p *parser) callonMergeMatchedAction2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeMatchedAction2(stack["first"], stack["rest"])
+}
+
+func (c *current) onMergeMatchedAction19() (any, error) {
+	return &ast.SQLMergeClause{Action: "delete"}, nil
+}
+
+func (p *parser) callonMergeMatchedAction19() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMergeMatchedAction19()
+}
+
+func (c *current) onMergeSet1(col, e any) (any, error) {
+	return []any{col, e}, nil
 }
 
-func (p *parser) callonSQLPipe1() (any, error) {
+func (p *parser) callonMergeSet1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLPipe1(stack["s"])
+	return p.cur.onMergeSet1(stack["col"], stack["e"])
 }
 
-func (c *current) onSQLOp1(query any) (any, error) {
-	return &ast.SQLOp{
-		Kind: "SQLOp",
-		Body: query.(*ast.SQLQuery),
-		Loc:  loc(c),
+func (c *current) onMergeInsertAction1(cols, exprs any) (any, error) {
+	return &ast.SQLMergeClause{
+		Action:  "insert",
+		Columns: cols.([]*ast.ID),
+		Values:  sliceOf[ast.Expr](exprs),
 	}, nil
 
 }
 
-func (p *parser) callonSQLOp1() (any, error) {
+func (p *parser) callonMergeInsertAction1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLOp1(stack["query"])
+	return p.cur.onMergeInsertAction1(stack["cols"], stack["exprs"])
 }
 
 func (c *current) onSQLQuery1(with, body, orderby, loff any) (any, error) {
@@ -23299,19 +25988,7 @@ func (p *parser) callonSQLQuery1() (any, error) {
 }
 
 func (c *current) onSQLBodySetOp1(first, rest any) (any, error) {
-	out := first.(ast.SQLQueryBody)
-	for _, o := range rest.([]any) {
-		o := o.([]any)
-		right := o[2].(ast.SQLQueryBody)
-		out = &ast.SQLUnion{
-			Kind:     "SQLUnion",
-			Distinct: o[0].(bool),
-			Left:     out,
-			Right:    right,
-			Loc:      ast.Loc{First: out.Pos(), Last: right.End()},
-		}
-	}
-	return out, nil
+	return makeSetOpChain(first, rest), nil
 
 }
 
@@ -23321,6 +25998,17 @@ func (p *parser) callonSQLBodySetOp1() (any, error) {
 	return p.cur.onSQLBodySetOp1(stack["first"], stack["rest"])
 }
 
+func (c *current) onSQLIntersect1(first, rest any) (any, error) {
+	return makeSetOpChain(first, rest), nil
+
+}
+
+func (p *parser) callonSQLIntersect1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLIntersect1(stack["first"], stack["rest"])
+}
+
 func (c *current) onSQLQueryBody5(s any) (any, error) {
 	return s, nil
 }
@@ -23331,7 +26019,7 @@ func (p *parser) callonSQLQueryBody5() (any, error) {
 	return p.cur.onSQLQueryBody5(stack["s"])
 }
 
-func (c *current) onSelect1(distinct, selection, from, where, group, having any) (any, error) {
+func (c *current) onSelect1(distinct, selection, from, where, group, having, window, qualify any) (any, error) {
 	sel := &ast.SQLSelect{
 		Kind:      "SQLSelect",
 		Distinct:  distinct.(bool),
@@ -23350,6 +26038,12 @@ func (c *current) onSelect1(distinct, selection, from, where, group, having any)
 	if having != nil {
 		sel.Having = having.(ast.Expr)
 	}
+	if qualify != nil {
+		sel.Qualify = qualify.(ast.Expr)
+	}
+	if window != nil {
+		sel.Windows = sliceOf[ast.SQLWindowDef](window)
+	}
 	return sel, nil
 
 }
@@ -23357,10 +26051,10 @@ func (c *current) onSelect1(distinct, selection, from, where, group, having any)
 func (p *parser) callonSelect1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelect1(stack["distinct"], stack["selection"], stack["from"], stack["where"], stack["group"], stack["having"])
+	return p.cur.onSelect1(stack["distinct"], stack["selection"], stack["from"], stack["where"], stack["group"], stack["having"], stack["window"], stack["qualify"])
 }
 
-func (c *current) onFromSelect1(from, distinct, selection, where, group, having any) (any, error) {
+func (c *current) onFromSelect1(from, distinct, selection, where, group, having, window, qualify any) (any, error) {
 	sel := &ast.SQLSelect{
 		Kind:      "SQLSelect",
 		Distinct:  distinct.(bool),
@@ -23379,6 +26073,12 @@ func (c *current) onFromSelect1(from, distinct, selection, where, group, having
 	if having != nil {
 		sel.Having = having.(ast.Expr)
 	}
+	if qualify != nil {
+		sel.Qualify = qualify.(ast.Expr)
+	}
+	if window != nil {
+		sel.Windows = sliceOf[ast.SQLWindowDef](window)
+	}
 	return sel, nil
 
 }
@@ -23386,7 +26086,7 @@ func (c *current) onFromSelect1(from, distinct, selection, where, group, having
 func (p *parser) callonFromSelect1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromSelect1(stack["from"], stack["distinct"], stack["selection"], stack["where"], stack["group"], stack["having"])
+	return p.cur.onFromSelect1(stack["from"], stack["distinct"], stack["selection"], stack["where"], stack["group"], stack["having"], stack["window"], stack["qualify"])
 }
 
 func (c *current) onWhereClause1(expr any) (any, error) {
@@ -23708,6 +26408,281 @@ func (p *parser) callonHavingClause1() (any, error) {
 	return p.cur.onHavingClause1(stack["e"])
 }
 
+func (c *current) onOptQualifyClause2(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonOptQualifyClause2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptQualifyClause2(stack["e"])
+}
+
+func (c *current) onOptQualifyClause9() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptQualifyClause9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptQualifyClause9()
+}
+
+func (c *current) onOptWindowClause11(w any) (any, error) {
+	return w, nil
+}
+
+func (p *parser) callonOptWindowClause11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause11(stack["w"])
+}
+
+func (c *current) onOptWindowClause2(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonOptWindowClause2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause2(stack["first"], stack["rest"])
+}
+
+func (c *current) onOptWindowClause18() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptWindowClause18() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause18()
+}
+
+func (c *current) onWindowDef1(name, spec any) (any, error) {
+	return ast.SQLWindowDef{
+		Name: name.(*ast.ID),
+		Spec: spec.(*ast.WindowSpec),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonWindowDef1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowDef1(stack["name"], stack["spec"])
+}
+
+func (c *current) onOverClause2(spec any) (any, error) {
+	return spec, nil
+}
+
+func (p *parser) callonOverClause2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOverClause2(stack["spec"])
+}
+
+func (c *current) onOverClause10(name any) (any, error) {
+	return &ast.WindowSpec{Name: name.(*ast.ID), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonOverClause10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOverClause10(stack["name"])
+}
+
+func (c *current) onWindowSpec5(id any) (any, error) {
+	return id, nil
+}
+
+func (p *parser) callonWindowSpec5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec5(stack["id"])
+}
+
+func (c *current) onWindowSpec13(p any) (any, error) {
+	return p, nil
+}
+
+func (p *parser) callonWindowSpec13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec13(stack["p"])
+}
+
+func (c *current) onWindowSpec20(o any) (any, error) {
+	return o, nil
+}
+
+func (p *parser) callonWindowSpec20() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec20(stack["o"])
+}
+
+func (c *current) onWindowSpec27(f any) (any, error) {
+	return f, nil
+}
+
+func (p *parser) callonWindowSpec27() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec27(stack["f"])
+}
+
+func (c *current) onWindowSpec1(name, partition, orderby, frame any) (any, error) {
+	spec := &ast.WindowSpec{Loc: loc(c)}
+	if name != nil {
+		spec.Name = name.(*ast.ID)
+	}
+	if partition != nil {
+		spec.PartitionBy = sliceOf[ast.Expr](partition)
+	}
+	if orderby != nil {
+		spec.OrderBy = orderby.([]ast.SortExpr)
+	}
+	if frame != nil {
+		spec.Frame = frame.(*ast.WindowFrame)
+	}
+	return spec, nil
+
+}
+
+func (p *parser) callonWindowSpec1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec1(stack["name"], stack["partition"], stack["orderby"], stack["frame"])
+}
+
+func (c *current) onPartitionByClause1(list any) (any, error) {
+	return list, nil
+}
+
+func (p *parser) callonPartitionByClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPartitionByClause1(stack["list"])
+}
+
+func (c *current) onWindowOrderByClause1(list any) (any, error) {
+	return list, nil
+}
+
+func (p *parser) callonWindowOrderByClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOrderByClause1(stack["list"])
+}
+
+func (c *current) onWindowFrame2(unit, start, end any) (any, error) {
+	return &ast.WindowFrame{
+		Unit: unit.(string),
+		From: start.(ast.WindowBound),
+		To:   end.(ast.WindowBound),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFrame2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrame2(stack["unit"], stack["start"], stack["end"])
+}
+
+func (c *current) onWindowFrame16(unit, start any) (any, error) {
+	return &ast.WindowFrame{
+		Unit: unit.(string),
+		From: start.(ast.WindowBound),
+		To:   ast.WindowBound{Type: "current_row", Loc: loc(c)},
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFrame16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrame16(stack["unit"], stack["start"])
+}
+
+func (c *current) onFrameUnit2() (any, error) {
+	return "rows", nil
+}
+
+func (p *parser) callonFrameUnit2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameUnit2()
+}
+
+func (c *current) onFrameUnit4() (any, error) {
+	return "range", nil
+}
+
+func (p *parser) callonFrameUnit4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameUnit4()
+}
+
+func (c *current) onFrameBound2() (any, error) {
+	return ast.WindowBound{Type: "unbounded_preceding", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonFrameBound2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameBound2()
+}
+
+func (c *current) onFrameBound7() (any, error) {
+	return ast.WindowBound{Type: "unbounded_following", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonFrameBound7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameBound7()
+}
+
+func (c *current) onFrameBound12() (any, error) {
+	return ast.WindowBound{Type: "current_row", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonFrameBound12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameBound12()
+}
+
+func (c *current) onFrameBound17(e any) (any, error) {
+	return ast.WindowBound{Type: "preceding", Offset: e.(ast.Expr), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonFrameBound17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameBound17(stack["e"])
+}
+
+func (c *current) onFrameBound23(e any) (any, error) {
+	return ast.WindowBound{Type: "following", Offset: e.(ast.Expr), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonFrameBound23() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFrameBound23(stack["e"])
+}
+
 func (c *current) onCrossJoin1(right any) (any, error) {
 	return &ast.SQLCrossJoin{
 		Kind:  "SQLCrossJoin",
@@ -23723,21 +26698,25 @@ func (p *parser) callonCrossJoin1() (any, error) {
 	return p.cur.onCrossJoin1(stack["right"])
 }
 
-func (c *current) onConditionJoin1(style, right, e any) (any, error) {
-	return &ast.SQLJoin{
+func (c *current) onConditionJoin1(style, right, e, tol any) (any, error) {
+	j := &ast.SQLJoin{
 		Kind:  "SQLJoin",
 		Style: style.(string),
 		Right: right.(ast.SQLTableExpr),
 		Cond:  e.(ast.JoinCond),
 		Loc:   loc(c),
-	}, nil
+	}
+	if tol != nil {
+		j.Tolerance = tol.(ast.Expr)
+	}
+	return j, nil
 
 }
 
 func (p *parser) callonConditionJoin1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onConditionJoin1(stack["style"], stack["right"], stack["e"])
+	return p.cur.onConditionJoin1(stack["style"], stack["right"], stack["e"], stack["tol"])
 }
 
 func (c *current) onSQLJoinStyle2() (any, error) {
@@ -23751,7 +26730,7 @@ func (p *parser) callonSQLJoinStyle2() (any, error) {
 }
 
 func (c *current) onSQLJoinStyle10() (any, error) {
-	return "anti", nil
+	return "asof left", nil
 }
 
 func (p *parser) callonSQLJoinStyle10() (any, error) {
@@ -23760,34 +26739,54 @@ func (p *parser) callonSQLJoinStyle10() (any, error) {
 	return p.cur.onSQLJoinStyle10()
 }
 
-func (c *current) onSQLJoinStyle16() (any, error) {
+func (c *current) onSQLJoinStyle22() (any, error) {
+	return "asof", nil
+}
+
+func (p *parser) callonSQLJoinStyle22() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoinStyle22()
+}
+
+func (c *current) onSQLJoinStyle28() (any, error) {
+	return "anti", nil
+}
+
+func (p *parser) callonSQLJoinStyle28() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLJoinStyle28()
+}
+
+func (c *current) onSQLJoinStyle34() (any, error) {
 	return "full", nil
 }
 
-func (p *parser) callonSQLJoinStyle16() (any, error) {
+func (p *parser) callonSQLJoinStyle34() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoinStyle16()
+	return p.cur.onSQLJoinStyle34()
 }
 
-func (c *current) onSQLJoinStyle26() (any, error) {
+func (c *current) onSQLJoinStyle44() (any, error) {
 	return "left", nil
 }
 
-func (p *parser) callonSQLJoinStyle26() (any, error) {
+func (p *parser) callonSQLJoinStyle44() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoinStyle26()
+	return p.cur.onSQLJoinStyle44()
 }
 
-func (c *current) onSQLJoinStyle36() (any, error) {
+func (c *current) onSQLJoinStyle54() (any, error) {
 	return "right", nil
 }
 
-func (p *parser) callonSQLJoinStyle36() (any, error) {
+func (p *parser) callonSQLJoinStyle54() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSQLJoinStyle36()
+	return p.cur.onSQLJoinStyle54()
 }
 
 func (c *current) onJoinCond2(e any) (any, error) {
@@ -23820,6 +26819,26 @@ func (p *parser) callonJoinCond8() (any, error) {
 	return p.cur.onJoinCond8(stack["fields"])
 }
 
+func (c *current) onOptTolerance2(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonOptTolerance2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptTolerance2(stack["e"])
+}
+
+func (c *current) onOptTolerance9() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptTolerance9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptTolerance9()
+}
+
 func (c *current) onOptOrdinality2() (any, error) {
 	return &ast.Ordinality{
 		Loc: loc(c),
@@ -24259,24 +27278,54 @@ func (p *parser) callonOffsetClause1() (any, error) {
 	return p.cur.onOffsetClause1(stack["e"])
 }
 
-func (c *current) onSetOp2() (any, error) {
-	return false, nil
+func (c *current) onSetOp2(distinct any) (any, error) {
+	return []any{"union", distinct}, nil
 }
 
 func (p *parser) callonSetOp2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSetOp2()
+	return p.cur.onSetOp2(stack["distinct"])
 }
 
-func (c *current) onSetOp8() (any, error) {
-	return true, nil
+func (c *current) onSetOp8(distinct any) (any, error) {
+	return []any{"except", distinct}, nil
 }
 
 func (p *parser) callonSetOp8() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSetOp8()
+	return p.cur.onSetOp8(stack["distinct"])
+}
+
+func (c *current) onIntersectOp1(distinct any) (any, error) {
+	return []any{"intersect", distinct}, nil
+}
+
+func (p *parser) callonIntersectOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntersectOp1(stack["distinct"])
+}
+
+func (c *current) onSetQuantifier2() (any, error) {
+	return false, nil
+}
+
+func (p *parser) callonSetQuantifier2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSetQuantifier2()
+}
+
+func (c *current) onSetQuantifier6() (any, error) {
+	return true, nil
+}
+
+func (p *parser) callonSetQuantifier6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSetQuantifier6()
 }
 
 func (c *current) onAND1() (any, error) {
@@ -44,6 +44,38 @@ func makeBinaryExprChain(first, rest any, c *current) any {
 	return ret
 }
 
+// makeSetOpChain builds a left-associative chain of SQL set operations
+// where each element of rest holds the operation name, its DISTINCT flag,
+// and its right-hand operand.
+func makeSetOpChain(first, rest any) any {
+	out := first.(ast.SQLQueryBody)
+	for _, p := range rest.([]any) {
+		part := p.([]any)
+		op := part[0].([]any)
+		right := part[2].(ast.SQLQueryBody)
+		loc := ast.Loc{First: out.Pos(), Last: right.End()}
+		if op[0] == "union" {
+			out = &ast.SQLUnion{
+				Kind:     "SQLUnion",
+				Distinct: op[1].(bool),
+				Left:     out,
+				Right:    right,
+				Loc:      loc,
+			}
+			continue
+		}
+		out = &ast.SQLSetOp{
+			Kind:     "SQLSetOp",
+			Op:       op[0].(string),
+			Distinct: op[1].(bool),
+			Left:     out,
+			Right:    right,
+			Loc:      loc,
+		}
+	}
+	return out
+}
+
 func makeArgMap(args any) (any, error) {
 	m := make(map[string]any)
 	for _, a := range args.([]any) {
@@ -0,0 +1,271 @@
+package parser
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/dag"
+)
+
+var ErrNotMergeInto = errors.New("not a MERGE INTO statement")
+
+// mergeRowColumn holds a copy of each target row in a MERGE INTO query.
+const mergeRowColumn = "__merge_row"
+
+// UpdateWhereQuery returns a query that scans pool at commit, filters the
+// scan with the operators of where, and then applies the operators of a.
+func (a *AST) UpdateWhereQuery(where *AST, pool, commit string) *AST {
+	seq := ast.Seq{&ast.FromOp{Kind: "FromOp", Item: poolItem(pool, commit)}}
+	seq = append(seq, where.Copy()...)
+	seq = append(seq, a.Copy()...)
+	return &AST{seq: seq, files: a.files, params: a.params}
+}
+
+// MergeTarget returns the pool and branch named by the target of the
+// MERGE INTO statement comprising a.  The branch is empty when the target
+// does not specify one.
+func (a *AST) MergeTarget() (string, string, error) {
+	m, ok := a.mergeInto()
+	if !ok {
+		return "", "", ErrNotMergeInto
+	}
+	return mergeTarget(m)
+}
+
+func (a *AST) mergeInto() (*ast.SQLMerge, bool) {
+	if len(a.seq) != 1 {
+		return nil, false
+	}
+	m, ok := a.seq[0].(*ast.SQLMerge)
+	return m, ok
+}
+
+func mergeTarget(m *ast.SQLMerge) (string, string, error) {
+	errBadTarget := errors.New("MERGE INTO target must be a pool name")
+	text, ok := m.Target.Source.(*ast.Text)
+	if !ok {
+		return "", "", errBadTarget
+	}
+	var branch string
+	for _, arg := range m.Target.Args {
+		arg, ok := arg.(*ast.ArgText)
+		if !ok || arg.Key != "commit" {
+			return "", "", errBadTarget
+		}
+		branch = arg.Value.Text
+	}
+	return text.Text, branch, nil
+}
+
+// MergeIntoQuery returns the query that computes the changes made by the
+// MERGE INTO statement comprising a when it is applied to pool at commit.
+// Each value output by the query is a record {target,op,row} where op is
+// "update", "delete", "insert", or null when no clause applies, target is
+// the matched row of the pool (null for an insert), and row is the new row
+// (null for a delete).
+func (a *AST) MergeIntoQuery(pool, commit string) (*AST, error) {
+	orig, ok := a.mergeInto()
+	if !ok {
+		return nil, ErrNotMergeInto
+	}
+	if _, _, err := mergeTarget(orig); err != nil {
+		return nil, err
+	}
+	if orig.Alias != nil && len(orig.Alias.Columns) != 0 {
+		return nil, errors.New("MERGE INTO target alias cannot have column names")
+	}
+	var matched, notMatched bool
+	for _, clause := range orig.Clauses {
+		if clause.Matched {
+			matched = true
+		} else {
+			notMatched = true
+		}
+		if clause.Action != "delete" && len(clause.Columns) != len(clause.Values) {
+			return nil, errors.New("MERGE INTO: number of columns does not match number of values")
+		}
+	}
+	var bodies []ast.SQLQueryBody
+	if matched {
+		// Each pair of matching target and source rows is output.
+		m := mergeCopy(orig)
+		alias := mergeAlias(m)
+		row := &ast.BinaryExpr{
+			Kind: "BinaryExpr",
+			Op:   ".",
+			LHS:  &ast.IDExpr{Kind: "IDExpr", ID: ast.ID{Name: alias}},
+			RHS:  &ast.IDExpr{Kind: "IDExpr", ID: ast.ID{Name: mergeRowColumn}},
+		}
+		bodies = append(bodies, &ast.SQLSelect{
+			Kind:      "SQLSelect",
+			Selection: mergeSelection(m, true, row),
+			From: &ast.SQLJoin{
+				Kind:  "SQLJoin",
+				Style: "inner",
+				Left:  mergeTargetItem(m, mergeTargetInput(pool, commit), alias),
+				Right: m.Source,
+				Cond:  &ast.JoinOnCond{Kind: "JoinOnCond", Expr: m.Cond, Loc: ast.NewLoc(m.Cond.Pos(), m.Cond.End())},
+				Loc:   m.Loc,
+			},
+			Loc: m.Loc,
+		})
+	}
+	if notMatched {
+		// Each source row without a matching target row is output.
+		m := mergeCopy(orig)
+		alias := mergeAlias(m)
+		bodies = append(bodies, &ast.SQLSelect{
+			Kind:      "SQLSelect",
+			Selection: mergeSelection(m, false, nullExpr()),
+			From: &ast.SQLJoin{
+				Kind:  "SQLJoin",
+				Style: "anti",
+				Left:  m.Source,
+				Right: mergeTargetItem(m, mergeTargetInput(pool, commit), alias),
+				Cond:  &ast.JoinOnCond{Kind: "JoinOnCond", Expr: m.Cond, Loc: ast.NewLoc(m.Cond.Pos(), m.Cond.End())},
+				Loc:   m.Loc,
+			},
+			Loc: m.Loc,
+		})
+	}
+	body := bodies[0]
+	if len(bodies) == 2 {
+		body = &ast.SQLUnion{
+			Kind:  "SQLUnion",
+			Left:  bodies[0],
+			Right: bodies[1],
+			Loc:   orig.Loc,
+		}
+	}
+	op := &ast.SQLOp{
+		Kind: "SQLOp",
+		Body: &ast.SQLQuery{Kind: "SQLQuery", Body: body, Loc: orig.Loc},
+		Loc:  orig.Loc,
+	}
+	return &AST{seq: ast.Seq{op}, files: a.files, params: a.params}, nil
+}
+
+// mergeCopy returns a copy of m whose references to columns of the target
+// refer instead to the columns of the row in mergeRowColumn.
+func mergeCopy(orig *ast.SQLMerge) *ast.SQLMerge {
+	m := ast.Copy(orig).(*ast.SQLMerge)
+	alias := mergeAlias(m)
+	rewrite := func(e *ast.BinaryExpr) *ast.BinaryExpr {
+		if id, ok := e.LHS.(*ast.IDExpr); ok && e.Op == "." && id.Name == alias {
+			e.LHS = &ast.BinaryExpr{
+				Kind: "BinaryExpr",
+				Op:   ".",
+				LHS:  id,
+				RHS:  &ast.IDExpr{Kind: "IDExpr", ID: ast.ID{Name: mergeRowColumn, Loc: id.Loc}},
+				Loc:  id.Loc,
+			}
+		}
+		return e
+	}
+	dag.WalkT(reflect.ValueOf(&m.Cond).Elem(), rewrite)
+	dag.WalkT(reflect.ValueOf(m.Clauses), rewrite)
+	return m
+}
+
+// mergeTargetInput returns the target of a MERGE INTO statement as a table
+// with the single column mergeRowColumn holding each row of pool.  A pool is
+// a dynamic input, whose columns can't be referenced in a join, but the
+// columns of this table are known.
+func mergeTargetInput(pool, commit string) ast.SQLTableInput {
+	return &ast.SQLPipe{
+		Kind: "SQLPipe",
+		Body: ast.Seq{
+			&ast.FromOp{Kind: "FromOp", Item: poolItem(pool, commit)},
+			&ast.ValuesOp{
+				Kind: "ValuesOp",
+				Exprs: []ast.Expr{&ast.RecordExpr{
+					Kind: "RecordExpr",
+					Elems: []ast.RecordElem{&ast.FieldElem{
+						Kind:  "FieldElem",
+						Name:  &ast.Text{Kind: "Text", Text: mergeRowColumn},
+						Value: &ast.IDExpr{Kind: "IDExpr", ID: ast.ID{Name: "this"}},
+					}},
+				}},
+			},
+		},
+	}
+}
+
+// mergeAlias returns the name by which the statement refers to the target.
+func mergeAlias(m *ast.SQLMerge) string {
+	if m.Alias != nil {
+		return m.Alias.Name
+	}
+	return m.Target.Source.(*ast.Text).Text
+}
+
+func mergeTargetItem(m *ast.SQLMerge, input ast.SQLTableInput, alias string) *ast.SQLFromItem {
+	return &ast.SQLFromItem{
+		Kind:  "SQLFromItem",
+		Input: input,
+		Alias: &ast.TableAlias{Name: alias, Loc: m.Target.Loc},
+		Loc:   m.Target.Loc,
+	}
+}
+
+// mergeSelection returns the target, op, and row columns for the clauses
+// of m that apply to matched rows or to unmatched rows.
+func mergeSelection(m *ast.SQLMerge, matched bool, target ast.Expr) ast.SQLSelection {
+	op := &ast.CaseExpr{Kind: "CaseExpr", Else: nullExpr(), Loc: m.Loc}
+	row := &ast.CaseExpr{Kind: "CaseExpr", Else: nullExpr(), Loc: m.Loc}
+	for _, clause := range m.Clauses {
+		if clause.Matched != matched {
+			continue
+		}
+		cond := clause.Cond
+		if cond == nil {
+			cond = &ast.Primitive{Kind: "Primitive", Type: "bool", Text: "true", Loc: clause.Loc}
+		}
+		op.Whens = append(op.Whens, ast.When{Cond: cond, Then: stringExpr(clause.Action), Loc: clause.Loc})
+		var val ast.Expr = nullExpr()
+		if clause.Action != "delete" {
+			rec := &ast.RecordExpr{Kind: "RecordExpr", Loc: clause.Loc}
+			if clause.Action == "update" {
+				rec.Elems = append(rec.Elems, &ast.SpreadElem{Kind: "SpreadElem", Expr: target, Loc: clause.Loc})
+			}
+			for k, col := range clause.Columns {
+				rec.Elems = append(rec.Elems, &ast.FieldElem{
+					Kind:  "FieldElem",
+					Name:  &ast.Text{Kind: "Text", Text: col.Name, Loc: col.Loc},
+					Value: clause.Values[k],
+					Loc:   col.Loc,
+				})
+			}
+			val = rec
+		}
+		row.Whens = append(row.Whens, ast.When{Cond: cond, Then: val, Loc: clause.Loc})
+	}
+	return ast.SQLSelection{
+		Args: []ast.SQLAsExpr{
+			{Kind: "SQLAsExpr", Label: &ast.ID{Name: "target"}, Expr: target},
+			{Kind: "SQLAsExpr", Label: &ast.ID{Name: "op"}, Expr: op},
+			{Kind: "SQLAsExpr", Label: &ast.ID{Name: "row"}, Expr: row},
+		},
+		Loc: m.Loc,
+	}
+}
+
+func poolItem(pool, commit string) *ast.FromItem {
+	return &ast.FromItem{
+		Source: &ast.Text{Kind: "Text", Text: pool},
+		Args: []ast.OpArg{&ast.ArgText{
+			Kind:  "ArgText",
+			Key:   "commit",
+			Value: &ast.Text{Kind: "Text", Text: commit},
+		}},
+	}
+}
+
+func nullExpr() ast.Expr {
+	return &ast.Primitive{Kind: "Primitive", Type: "null"}
+}
+
+func stringExpr(s string) ast.Expr {
+	return &ast.Primitive{Kind: "Primitive", Type: "string", Text: s}
+}
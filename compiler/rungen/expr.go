@@ -63,6 +63,18 @@ func (b *Builder) compileLval(e dag.Expr) (*expr.Lval, error) {
 	return nil, fmt.Errorf("internal error: invalid lval %#v", e)
 }
 
+func (b *Builder) compileExprs(in []dag.Expr) ([]expr.Evaluator, error) {
+	var exprs []expr.Evaluator
+	for _, e := range in {
+		ev, err := b.compileExpr(e)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, ev)
+	}
+	return exprs, nil
+}
+
 func (b *Builder) compileSortExprs(sortExprs []dag.SortExpr) ([]expr.SortExpr, error) {
 	var out []expr.SortExpr
 	for _, se := range sortExprs {
@@ -0,0 +1,69 @@
+package rungen
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/sup"
+)
+
+// compileIndexFilter compiles an index filter created by the optimizer.
+func (b *Builder) compileIndexFilter(e dag.Expr) (index.Filter, error) {
+	bin, ok := e.(*dag.BinaryExpr)
+	if !ok {
+		return nil, fmt.Errorf("internal error: invalid index filter %T", e)
+	}
+	switch bin.Op {
+	case "and", "or":
+		lhs, err := b.compileIndexFilter(bin.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := b.compileIndexFilter(bin.RHS)
+		if err != nil {
+			return nil, err
+		}
+		if bin.Op == "and" {
+			return index.NewAnd(lhs, rhs), nil
+		}
+		return index.NewOr(lhs, rhs), nil
+	}
+	this, ok := bin.LHS.(*dag.ThisExpr)
+	if !ok {
+		return nil, fmt.Errorf("internal error: invalid index filter field %T", bin.LHS)
+	}
+	if bin.Op == "in" {
+		array, ok := bin.RHS.(*dag.ArrayExpr)
+		if !ok {
+			return nil, fmt.Errorf("internal error: invalid index filter elements %T", bin.RHS)
+		}
+		var vals []super.Value
+		for _, elem := range array.Elems {
+			v, ok := elem.(*dag.VectorValue)
+			if !ok {
+				return nil, fmt.Errorf("internal error: invalid index filter element %T", elem)
+			}
+			val, err := b.compileIndexLiteral(v.Expr)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, val)
+		}
+		return index.NewIn(this.Path, vals), nil
+	}
+	val, err := b.compileIndexLiteral(bin.RHS)
+	if err != nil {
+		return nil, err
+	}
+	return index.NewCompare(bin.Op, this.Path, val), nil
+}
+
+func (b *Builder) compileIndexLiteral(e dag.Expr) (super.Value, error) {
+	literal, ok := e.(*dag.PrimitiveExpr)
+	if !ok {
+		return super.Value{}, fmt.Errorf("internal error: invalid index filter literal %T", e)
+	}
+	return sup.ParseValue(b.sctx(), literal.Value)
+}
@@ -0,0 +1,77 @@
+package rungen
+
+import (
+	"slices"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/joinfilter"
+	"github.com/brimdata/super/runtime/sam/expr"
+)
+
+// joinFilter is the runtime filter of a hash join as seen by the join and
+// by the scan at the head of its probe path.  For the scan, path is the
+// path of the probe key in the scanned values.
+type joinFilter struct {
+	filter *joinfilter.Filter
+	path   field.Path
+}
+
+// addJoinFilter creates the runtime filter that join publishes to the scan
+// on its probe path in fork, which the optimizer has checked is a scan
+// followed only by filters.
+func (b *Builder) addJoinFilter(fork *dag.ForkOp, join *dag.HashJoinOp) {
+	if len(fork.Paths) != 2 {
+		return
+	}
+	probe, key := fork.Paths[0], join.LeftKey
+	if join.Build == "left" {
+		probe, key = fork.Paths[1], join.RightKey
+	}
+	this, ok := key.(*dag.ThisExpr)
+	if !ok || len(probe) == 0 {
+		return
+	}
+	if b.joinFilters == nil {
+		b.joinFilters = map[dag.Op]*joinFilter{}
+	}
+	f := joinfilter.New()
+	b.joinFilters[join] = &joinFilter{filter: f}
+	b.joinFilters[probe[0]] = &joinFilter{filter: f, path: this.Path}
+}
+
+// metaProjection returns projection with the paths of the metadata needed
+// by the runtime filter of scan, if any.
+func (b *Builder) metaProjection(scan dag.Op, projection []field.Path) []field.Path {
+	jf, ok := b.joinFilters[scan]
+	if !ok {
+		return projection
+	}
+	min := slices.Concat(jf.path, field.Path{"min"})
+	max := slices.Concat(jf.path, field.Path{"max"})
+	return append(slices.Clone(projection), min, max)
+}
+
+// listerPruner returns pruner, the pruner of a pool lister for scan as
+// compiled from the query, extended to prune the objects whose pool key
+// ranges are ruled out by the runtime filter of scan, if any.
+func (b *Builder) listerPruner(scan dag.Op, pool *db.Pool, pruner expr.Evaluator) expr.Evaluator {
+	jf, ok := b.joinFilters[scan]
+	if !ok || pool.SortKeys.IsNil() || !pool.SortKeys.Primary().Key.Equal(jf.path) {
+		return pruner
+	}
+	return joinfilter.NewPruner(b.mctx, jf.filter, pruner)
+}
+
+// listerIndexFilter returns filter, the index filter of a pool lister for
+// scan as compiled from the query, extended to prune the objects whose
+// indexes are ruled out by the runtime filter of scan, if any.
+func (b *Builder) listerIndexFilter(scan dag.Op, pool *db.Pool, filter index.Filter) index.Filter {
+	jf, ok := b.joinFilters[scan]
+	if !ok || !jf.path.In(index.Fields(pool.Indexes)) {
+		return filter
+	}
+	return index.NewAnd(filter, joinfilter.NewIndexFilter(jf.filter, jf.path))
+}
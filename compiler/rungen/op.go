@@ -11,6 +11,7 @@ import (
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/optimizer"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
@@ -41,6 +42,7 @@ type Builder struct {
 	deletes         *sync.Map
 	funcs           map[string]*dag.FuncDef
 	compiledVamUDFs map[string]*vamexpr.UDF
+	joinFilters     map[dag.Op]*joinFilter
 }
 
 func NewBuilder(rctx *runtime.Context, env *exec.Environment) *Builder {
@@ -147,7 +149,10 @@ func (b *Builder) compileLeaf(o dag.Op, parent sbuf.Puller) (sbuf.Puller, error)
 		}
 		return meta.NewCommitMetaScanner(b.rctx.Context, b.sctx(), b.env.DB(), v.Pool, v.Commit, v.Meta, pruner)
 	case *dag.DBMetaScan:
-		return meta.NewDBMetaScanner(b.rctx.Context, b.sctx(), b.env.DB(), v.Meta)
+		visible := func(pool ksuid.KSUID, branch string) bool {
+			return b.env.AuthorizePool(b.rctx.Context, pool, branch, exec.AccessList) == nil
+		}
+		return meta.NewDBMetaScanner(b.rctx.Context, b.sctx(), b.env.DB(), v.Meta, visible)
 	case *dag.DeleterScan:
 		pool, err := b.lookupPool(v.Pool)
 		if err != nil {
@@ -183,7 +188,21 @@ func (b *Builder) compileLeaf(o dag.Op, parent sbuf.Puller) (sbuf.Puller, error)
 				return nil, err
 			}
 		}
-		return meta.NewSortedLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
+		lister, err := meta.NewSortedLister(b.rctx.Context, b.mctx, pool, v.Commit, b.listerPruner(v, pool, pruner))
+		if err != nil {
+			return nil, err
+		}
+		var filter index.Filter
+		if v.IndexFilter != nil {
+			filter, err = b.compileIndexFilter(v.IndexFilter)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if filter = b.listerIndexFilter(v, pool, filter); filter != nil {
+			lister.SetIndexFilter(filter)
+		}
+		return lister, nil
 	case *dag.NullScan:
 		return sbuf.NewPuller(sbuf.NewArray([]super.Value{super.Null})), nil
 	case *dag.PoolMetaScan:
@@ -253,10 +272,13 @@ func (b *Builder) compilePoolScan(scan *dag.PoolScan) (sbuf.Puller, error) {
 	if err != nil {
 		return nil, err
 	}
-	l, err := meta.NewSortedLister(b.rctx.Context, b.mctx, pool, scan.Commit, nil)
+	l, err := meta.NewSortedLister(b.rctx.Context, b.mctx, pool, scan.Commit, b.listerPruner(scan, pool, nil))
 	if err != nil {
 		return nil, err
 	}
+	if filter := b.listerIndexFilter(scan, pool, nil); filter != nil {
+		l.SetIndexFilter(filter)
+	}
 	slicer := meta.NewSlicer(l, b.mctx)
 	return meta.NewSequenceScanner(b.rctx, slicer, pool, nil, nil, b.progress), nil
 }
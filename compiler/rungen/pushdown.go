@@ -3,6 +3,7 @@ package rungen
 import (
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/joinfilter"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sbuf"
 )
@@ -14,6 +15,7 @@ type pushdown struct {
 	projection     field.Projection
 	metaProjection field.Projection
 	unordred       bool
+	joinFilter     *joinFilter
 }
 
 var _ sbuf.Pushdown = (*pushdown)(nil)
@@ -33,12 +35,18 @@ func (p *pushdown) BSUPFilter() (*expr.BufferFilter, error) {
 }
 
 func (p *pushdown) MetaFilter() (expr.Evaluator, field.Projection, error) {
-	if p.metaFilter == nil {
-		return nil, nil, nil
+	var e expr.Evaluator
+	if p.metaFilter != nil {
+		var err error
+		if e, err = p.builder.compileExpr(p.metaFilter); err != nil {
+			return nil, nil, err
+		}
+	}
+	if jf := p.joinFilter; jf != nil {
+		e = joinfilter.NewMetaFilter(jf.filter, jf.path, e)
 	}
-	e, err := p.builder.compileExpr(p.metaFilter)
-	if err != nil {
-		return nil, nil, err
+	if e == nil {
+		return nil, nil, nil
 	}
 	return e, p.metaProjection, nil
 }
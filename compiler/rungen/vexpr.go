@@ -75,6 +75,18 @@ func (b *Builder) compileVamExpr(e dag.Expr) (vamexpr.Evaluator, error) {
 	}
 }
 
+func (b *Builder) compileVamSortExprs(sortExprs []dag.SortExpr) ([]vamexpr.SortExpr, error) {
+	var out []vamexpr.SortExpr
+	for _, se := range sortExprs {
+		e, err := b.compileVamExpr(se.Key)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, vamexpr.NewSortExpr(e, se.Order, se.Nulls))
+	}
+	return out, nil
+}
+
 func (b *Builder) compileVamExprWithEmpty(e dag.Expr) (vamexpr.Evaluator, error) {
 	if e == nil {
 		return nil, nil
@@ -14,6 +14,8 @@ import (
 	vamagg "github.com/brimdata/super/runtime/vam/expr/agg"
 	vamop "github.com/brimdata/super/runtime/vam/op"
 	"github.com/brimdata/super/runtime/vam/op/aggregate"
+	"github.com/brimdata/super/runtime/vam/op/sort"
+	"github.com/brimdata/super/runtime/vam/op/window"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/vector/vio"
 )
@@ -26,6 +28,15 @@ func (b *Builder) compileVam(o dag.Op, parents []vio.Puller) ([]vio.Puller, erro
 		return []vio.Puller{b.combineVam(parents)}, nil
 	case *dag.ForkOp:
 		return b.compileVamFork(o, b.combineVam(parents))
+	case *dag.AsofJoinOp:
+		if len(parents) != 2 {
+			return nil, ErrJoinParents
+		}
+		join, err := b.compileVamAsofJoin(o, parents[0], parents[1])
+		if err != nil {
+			return nil, err
+		}
+		return []vio.Puller{join}, nil
 	case *dag.HashJoinOp:
 		if len(parents) != 2 {
 			return nil, ErrJoinParents
@@ -38,7 +49,10 @@ func (b *Builder) compileVam(o dag.Op, parents []vio.Puller) ([]vio.Puller, erro
 		if err != nil {
 			return nil, err
 		}
-		join := vamop.NewHashJoin(b.rctx, o.Style, parents[0], parents[1], leftKey, rightKey, o.LeftAlias, o.RightAlias)
+		join := vamop.NewHashJoin(b.rctx, o.Style, parents[0], parents[1], leftKey, rightKey, o.LeftAlias, o.RightAlias, o.Build, o.Partitioned)
+		if jf, ok := b.joinFilters[o]; ok {
+			join.SetFilter(jf.filter)
+		}
 		return []vio.Puller{join}, nil
 	case *dag.JoinOp:
 		if len(parents) != 2 {
@@ -61,6 +75,11 @@ func (b *Builder) compileVam(o dag.Op, parents []vio.Puller) ([]vio.Puller, erro
 		}
 		cmp := expr.NewComparator(exprs...).WithMissingAsNull()
 		return []vio.Puller{vamop.NewMerge(b.rctx, parents, cmp.Compare)}, nil
+	case *dag.SetOp:
+		if len(parents) != 2 {
+			return nil, fmt.Errorf("%s requires two upstream parallel query paths", o.Op)
+		}
+		return []vio.Puller{vamop.NewSetOp(b.rctx, o.Op, o.Distinct, parents[0], parents[1])}, nil
 	case *dag.ScatterOp:
 		return b.compileVamScatter(o, parents)
 	case *dag.SwitchOp:
@@ -108,6 +127,40 @@ func (b *Builder) compileVamFork(fork *dag.ForkOp, parent vio.Puller) ([]vio.Pul
 	return exits, nil
 }
 
+func (b *Builder) compileVamAsofJoin(o *dag.AsofJoinOp, left, right vio.Puller) (*vamop.AsofJoin, error) {
+	var leftKey, rightKey vamexpr.Evaluator
+	if o.LeftKey != nil {
+		var err error
+		if leftKey, err = b.compileVamExpr(o.LeftKey); err != nil {
+			return nil, err
+		}
+		if rightKey, err = b.compileVamExpr(o.RightKey); err != nil {
+			return nil, err
+		}
+	}
+	leftOn, err := b.compileVamExpr(o.LeftOn)
+	if err != nil {
+		return nil, err
+	}
+	rightOn, err := b.compileVamExpr(o.RightOn)
+	if err != nil {
+		return nil, err
+	}
+	var bound vamexpr.Evaluator
+	if o.Tolerance != nil {
+		// The tolerance window for a left value extends back from its
+		// "on" value for > and >= and forward for < and <=.
+		op := "+"
+		if o.Op == ">" || o.Op == ">=" {
+			op = "-"
+		}
+		if bound, err = b.compileVamExpr(dag.NewBinaryExpr(op, dag.CopyExpr(o.LeftOn), o.Tolerance)); err != nil {
+			return nil, err
+		}
+	}
+	return vamop.NewAsofJoin(b.rctx, o.Style, left, right, leftKey, rightKey, leftOn, rightOn, o.Op, o.LeftAlias, o.RightAlias, bound, o.Merge), nil
+}
+
 func (b *Builder) compileVamScatter(scatter *dag.ScatterOp, parents []vio.Puller) ([]vio.Puller, error) {
 	if len(parents) != 1 {
 		return nil, errors.New("internal error: scatter operator requires a single parent")
@@ -237,7 +290,8 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vio.Puller) (vio.Puller, error
 			metaFilter = mf.Expr
 			metaProjection = mf.Projection
 		}
-		pushdown := b.newMetaPushdown(metaFilter, o.Pushdown.Projection, metaProjection, o.Pushdown.Unordered)
+		pushdown := b.newMetaPushdown(metaFilter, o.Pushdown.Projection, b.metaProjection(o, metaProjection), o.Pushdown.Unordered)
+		pushdown.joinFilter = b.joinFilters[o]
 		return vamop.NewFileScan(b.rctx, b.env, o.Paths, o.Format, pushdown), nil
 	case *dag.FilterOp:
 		e, err := b.compileVamExpr(o.Expr)
@@ -285,11 +339,11 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vio.Puller) (vio.Puller, error
 	case *dag.SkipOp:
 		return vamop.NewSkip(parent, o.Count), nil
 	case *dag.SortOp:
-		exprs, err := b.compileSortExprs(o.Exprs)
+		exprs, err := b.compileVamSortExprs(o.Exprs)
 		if err != nil {
 			return nil, err
 		}
-		return vamop.NewSort(b.rctx, parent, exprs, o.Reverse), nil
+		return sort.New(b.rctx, parent, exprs, o.Reverse), nil
 	case *dag.TailOp:
 		return vamop.NewTail(parent, o.Count), nil
 	case *dag.UnnestOp:
@@ -298,6 +352,8 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vio.Puller) (vio.Puller, error
 			return nil, err
 		}
 		return vamop.NewUnnest(b.sctx(), parent, e), nil
+	case *dag.WindowOp:
+		return b.compileVamWindow(o, parent)
 	case *dag.ValuesOp:
 		exprs, err := b.compileVamExprs(o.Exprs)
 		if err != nil {
@@ -358,7 +414,12 @@ func mergeRecordExprWithPath(rec *dag.RecordExpr, path []string) {
 }
 
 func (b *Builder) compileVamSeq(seq dag.Seq, parents []vio.Puller) ([]vio.Puller, error) {
-	for _, o := range seq {
+	for k, o := range seq {
+		if fork, ok := o.(*dag.ForkOp); ok && k+1 < len(seq) {
+			if join, ok := seq[k+1].(*dag.HashJoinOp); ok && join.RuntimeFilter {
+				b.addJoinFilter(fork, join)
+			}
+		}
 		var err error
 		parents, err = b.compileVam(o, parents)
 		if err != nil {
@@ -407,7 +468,7 @@ func (b *Builder) compileVamAggregate(s *dag.AggregateOp, parent vio.Puller) (vi
 	if len(keyExprs) == 0 {
 		return aggregate.NewScalar(parent, b.sctx(), aggs, aggNames, aggExprs, s.PartialsIn, s.PartialsOut)
 	}
-	return aggregate.New(parent, b.sctx(), aggNames, aggExprs, aggs, keyNames, keyExprs, s.PartialsIn, s.PartialsOut)
+	return aggregate.New(b.rctx, parent, aggNames, aggExprs, aggs, keyNames, keyExprs, s.PartialsIn, s.PartialsOut)
 }
 
 func (b *Builder) compileVamAgg(agg *dag.AggExpr) (*vamexpr.Aggregator, error) {
@@ -433,3 +494,67 @@ func (b *Builder) compileVamAgg(agg *dag.AggExpr) (*vamexpr.Aggregator, error) {
 	}
 	return vamexpr.NewAggregator(name, agg.Distinct, arg, filter, pattern)
 }
+
+func (b *Builder) compileVamWindow(o *dag.WindowOp, parent vio.Puller) (vio.Puller, error) {
+	partitionBy, err := b.compileVamExprs(o.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := b.compileVamSortExprs(o.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	var funcs []window.Function
+	for _, assignment := range o.Funcs {
+		lhs, ok := assignment.LHS.(*dag.ThisExpr)
+		if !ok || len(lhs.Path) != 1 {
+			return nil, errors.New("invalid lval in window function")
+		}
+		f, err := b.compileWindowFunc(assignment.RHS.(*dag.WindowExpr))
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, lhs.Path[0])
+		funcs = append(funcs, f)
+	}
+	return window.New(b.rctx, parent, partitionBy, orderBy, names, funcs), nil
+}
+
+func (b *Builder) compileWindowFunc(e *dag.WindowExpr) (window.Function, error) {
+	args, err := b.compileVamExprs(e.Args)
+	if err != nil {
+		return nil, err
+	}
+	var filter vamexpr.Evaluator
+	if e.Filter != nil {
+		filter, err = b.compileVamExpr(e.Filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var frame *window.Frame
+	if e.Frame != nil {
+		start, err := b.compileWindowBound(e.Frame.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := b.compileWindowBound(e.Frame.End)
+		if err != nil {
+			return nil, err
+		}
+		frame = &window.Frame{Unit: e.Frame.Unit, Start: start, End: end}
+	}
+	return window.NewFunction(b.sctx(), e.Name, args, filter, frame)
+}
+
+func (b *Builder) compileWindowBound(bound dag.WindowBound) (window.Bound, error) {
+	if bound.Offset == nil {
+		return window.Bound{Type: bound.Type}, nil
+	}
+	val, err := b.evalAtCompileTime(bound.Offset)
+	if err != nil {
+		return window.Bound{}, err
+	}
+	return window.Bound{Type: bound.Type, Offset: val}, nil
+}
@@ -23,6 +23,9 @@ func Analyze(ctx context.Context, p *parser.AST, env *exec.Environment, extInput
 		p.PrependFileScan([]string{"stdio:stdin"})
 	}
 	t := newTranslator(ctx, reporter{p.Files()}, env)
+	if err := t.bindParams(p.Params()); err != nil {
+		return nil, err
+	}
 	t.checker.pushErrs()
 	seq, _ := t.seq(p.Parsed(), super.TypeNull)
 	errs := t.checker.popErrs()
@@ -58,6 +61,7 @@ type translator struct {
 	hasVectorizedInput bool
 	opCnt              map[*ast.OpDecl]int
 	opStack            []string
+	params             map[string]super.Value
 	cteStack           []*ast.SQLCTE
 	env                *exec.Environment
 	scope              *Scope
@@ -0,0 +1,235 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/semantic/sem"
+)
+
+var (
+	errAsofCond       = errors.New("ASOF join condition must be comparisons between the left and right inputs combined with AND")
+	errAsofInequality = errors.New("ASOF join condition must have exactly one inequality (<, <=, >, or >=)")
+)
+
+// asofCond is the condition of an ASOF join split into the equality
+// comparisons that match values from the left and right inputs and the
+// inequality that orders them.
+type asofCond struct {
+	leftKeys  []sem.Expr
+	rightKeys []sem.Expr
+	// op compares leftOn to rightOn and is one of <, <=, >, or >=.
+	op      string
+	leftOn  sem.Expr
+	rightOn sem.Expr
+}
+
+func isAsofStyle(style string) bool {
+	return style == "asof" || style == "asof left"
+}
+
+// splitAsofCond splits cond, which must be a conjunction of equality
+// comparisons and exactly one inequality, each between an expression of the
+// left input and an expression of the right input.
+func splitAsofCond(cond sem.Expr, leftAlias, rightAlias string) (*asofCond, error) {
+	var a asofCond
+	for _, e := range splitConjunction(cond) {
+		b, ok := e.(*sem.BinaryExpr)
+		if !ok {
+			return nil, errAsofCond
+		}
+		lhs, rhs, op := b.LHS, b.RHS, b.Op
+		switch {
+		case exprAlias(lhs) == leftAlias && exprAlias(rhs) == rightAlias:
+		case exprAlias(lhs) == rightAlias && exprAlias(rhs) == leftAlias:
+			lhs, rhs, op = rhs, lhs, reverseComparison(op)
+		default:
+			return nil, errAsofCond
+		}
+		switch op {
+		case "==":
+			a.leftKeys = append(a.leftKeys, lhs)
+			a.rightKeys = append(a.rightKeys, rhs)
+		case "<", "<=", ">", ">=":
+			if a.op != "" {
+				return nil, errAsofInequality
+			}
+			a.op, a.leftOn, a.rightOn = op, lhs, rhs
+		default:
+			return nil, errAsofCond
+		}
+	}
+	if a.op == "" {
+		return nil, errAsofInequality
+	}
+	return &a, nil
+}
+
+func splitConjunction(e sem.Expr) []sem.Expr {
+	if b, ok := e.(*sem.BinaryExpr); ok && b.Op == "and" {
+		return append(splitConjunction(b.LHS), splitConjunction(b.RHS)...)
+	}
+	return []sem.Expr{e}
+}
+
+// exprAlias returns the first path component common to every this reference
+// in e or the empty string if there is no such component.
+func exprAlias(e sem.Expr) string {
+	var alias string
+	ok := true
+	// e is wrapped in []sem.Expr to work around CanSet() model in WalkT.
+	dag.WalkT(reflect.ValueOf([]sem.Expr{e}), func(this *sem.ThisExpr) *sem.ThisExpr {
+		switch {
+		case len(this.Path) == 0:
+			ok = false
+		case alias == "":
+			alias = this.Path[0]
+		case alias != this.Path[0]:
+			ok = false
+		}
+		return this
+	})
+	if !ok {
+		return ""
+	}
+	return alias
+}
+
+func reverseComparison(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+// usingComparison returns the comparison for the kth of n fields of a USING
+// clause.  For an ASOF join, the last field orders the inputs so that a left
+// value matches the latest right value at or before it.
+func usingComparison(style string, k, n int) string {
+	if isAsofStyle(style) && k == n-1 {
+		return ">="
+	}
+	return "=="
+}
+
+// asofJoin checks the condition of a join of the given style and returns
+// its tolerance.  Only an ASOF join may have a tolerance, which must be a
+// non-negative constant number or duration.
+func (t *translator) asofJoin(style string, cond ast.JoinCond, e sem.Expr, tolerance ast.Expr, leftAlias, rightAlias string) sem.Expr {
+	if !isAsofStyle(style) {
+		if tolerance != nil {
+			t.error(tolerance, errors.New("TOLERANCE requires an ASOF join"))
+		}
+		return nil
+	}
+	if !hasBadExpr(e) {
+		if _, err := splitAsofCond(e, leftAlias, rightAlias); err != nil {
+			t.error(cond, err)
+		}
+	}
+	if tolerance == nil {
+		return nil
+	}
+	te, _ := t.expr(tolerance, super.TypeNull)
+	val, ok := t.mustEval(te)
+	if !ok {
+		return badExpr
+	}
+	if !isTolerance(val) {
+		t.error(tolerance, errors.New("TOLERANCE must be a non-negative number or duration"))
+		return badExpr
+	}
+	return sem.NewLiteral(te, val, t.defs)
+}
+
+// hasBadExpr returns true if e contains an expression for which an error has
+// already been reported.
+func hasBadExpr(e sem.Expr) bool {
+	var bad bool
+	dag.WalkT(reflect.ValueOf([]sem.Expr{e}), func(b *sem.BadExpr) *sem.BadExpr {
+		bad = true
+		return b
+	})
+	return bad
+}
+
+func isTolerance(val super.Value) bool {
+	if val.IsNull() {
+		return false
+	}
+	switch id := val.Type().ID(); {
+	case id <= super.IDUint64:
+		return true
+	case id >= super.IDInt8 && id <= super.IDInt64, id == super.IDDuration:
+		return val.Int() >= 0
+	case id >= super.IDFloat16 && id <= super.IDFloat64:
+		return val.Float() >= 0
+	}
+	return false
+}
+
+func (d *dagen) asofJoin(op *sem.JoinOp) dag.Op {
+	cond, err := splitAsofCond(op.Cond, op.LeftAlias, op.RightAlias)
+	if err != nil {
+		d.error(op, err)
+		return &dag.PassOp{Kind: "PassOp"}
+	}
+	style := "inner"
+	if op.Style == "asof left" {
+		style = "left"
+	}
+	join := &dag.AsofJoinOp{
+		Kind:       "AsofJoinOp",
+		Style:      style,
+		LeftAlias:  op.LeftAlias,
+		RightAlias: op.RightAlias,
+		LeftOn:     d.joinExpr(cond.leftOn),
+		RightOn:    d.joinExpr(cond.rightOn),
+		Op:         cond.op,
+		Tolerance:  d.expr(op.Tolerance),
+	}
+	if len(cond.leftKeys) > 0 {
+		join.LeftKey = d.joinKey(cond.leftKeys)
+		join.RightKey = d.joinKey(cond.rightKeys)
+	}
+	return join
+}
+
+// joinKey returns an expression for keys, which refer to one side of a join,
+// that is evaluated on values from that side.
+func (d *dagen) joinKey(keys []sem.Expr) dag.Expr {
+	if len(keys) == 1 {
+		return d.joinExpr(keys[0])
+	}
+	var elems []dag.RecordElem
+	for k, e := range keys {
+		elems = append(elems, &dag.Field{
+			Kind:  "Field",
+			Name:  fmt.Sprintf("c%d", k),
+			Value: d.joinExpr(e),
+		})
+	}
+	return &dag.RecordExpr{Kind: "RecordExpr", Elems: elems}
+}
+
+// joinExpr returns e, which refers to one side of a join, as an expression
+// that is evaluated on values from that side.
+func (d *dagen) joinExpr(e sem.Expr) dag.Expr {
+	out := d.expr(e)
+	dag.WalkT(reflect.ValueOf(out), func(this dag.ThisExpr) dag.ThisExpr {
+		this.Path = this.Path[1:]
+		return this
+	})
+	return out
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brimdata/super/compiler/ast"
 	"github.com/brimdata/super/compiler/semantic/sem"
 	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/sup"
 )
 
@@ -37,6 +38,11 @@ func (c *checker) seq(typ super.Type, seq sem.Seq) super.Type {
 				seq = seq[2:]
 				continue
 			}
+			if setOp, ok := seq[1].(*sem.SetOp); ok {
+				typ = c.setOp(c.fork(typ, fork), setOp)
+				seq = seq[2:]
+				continue
+			}
 		}
 		if swtch, ok := seq[0].(*sem.SwitchOp); ok && len(seq) >= 2 {
 			if join, ok := seq[1].(*sem.JoinOp); ok {
@@ -131,6 +137,9 @@ func (c *checker) op(typ super.Type, op sem.Op) super.Type {
 	case *sem.RenameOp:
 		// TBD
 		return c.unknown
+	case *sem.SetOp:
+		c.error(op, fmt.Errorf("%s requires two inputs", op.Op))
+		return c.unknown
 	case *sem.SkipOp:
 		return typ
 	case *sem.SortOp:
@@ -154,6 +163,10 @@ func (c *checker) op(typ super.Type, op sem.Op) super.Type {
 		return c.seq(c.unnest(op.Expr, c.expr(typ, op.Expr)), op.Body)
 	case *sem.ValuesOp:
 		return c.fuse(c.exprs(typ, op.Exprs))
+	case *sem.WindowOp:
+		c.exprs(typ, op.PartitionBy)
+		c.sortExprs(typ, op.OrderBy)
+		return c.putPaths(typ, c.assignments(typ, op.Funcs))
 	default:
 		panic(op)
 	}
@@ -189,6 +202,15 @@ func (c *checker) join(types []super.Type, op *sem.JoinOp) super.Type {
 	return typ
 }
 
+func (c *checker) setOp(types []super.Type, op *sem.SetOp) super.Type {
+	if len(types) != 2 {
+		c.error(op, fmt.Errorf("%s requires two query inputs", op.Op))
+		return c.unknown
+	}
+	// Rows are returned from the left input.
+	return types[0]
+}
+
 func (c *checker) unnest(loc ast.Node, typ super.Type) super.Type {
 	c.pushErrs()
 	typ, ok := c.unnestCheck(loc, typ)
@@ -414,6 +436,16 @@ func (c *checker) expr(typ super.Type, e sem.Expr) super.Type {
 		default:
 			panic(e.Op)
 		}
+	case *sem.WindowFunc:
+		argTypes := c.exprs(typ, e.Args)
+		if e.Filter != nil {
+			c.boolean(e.Filter, c.expr(typ, e.Filter))
+		}
+		return c.windowFunc(e.Name, argTypes)
+	case *sem.WindowRef:
+		// Like AggRef, WindowRefs are replaced before the select body
+		// is type checked.
+		return c.unknown
 	default:
 		panic(e)
 	}
@@ -441,7 +473,7 @@ func (c *checker) binary(op string, loc, lloc, rloc ast.Node, lhs, rhs super.Typ
 	case "+":
 		return c.plus(loc, lhs, rhs)
 	case "-", "*", "/", "%":
-		return c.arithmetic(lloc, rloc, lhs, rhs)
+		return c.arithmetic(op, loc, lloc, rloc, lhs, rhs)
 	default:
 		panic(op)
 	}
@@ -728,8 +760,7 @@ func (c *checker) integer(loc ast.Node, typ super.Type) bool {
 
 func (c *checker) number(loc ast.Node, typ super.Type) bool {
 	ok := typeCheck(typ, func(typ super.Type) bool {
-		id := typ.ID()
-		return super.IsNumber(id) || id == super.IDNull
+		return isNumeric(typ) || typ.ID() == super.IDNull
 	})
 	if !ok {
 		c.error(loc, fmt.Errorf("numeric type required, encountered %s", sup.FormatType(typ)))
@@ -877,8 +908,8 @@ func comparable(a, b super.Type) bool {
 	if aid == bid || aid == super.IDNull || bid == super.IDNull {
 		return true
 	}
-	if super.IsNumber(aid) {
-		return super.IsNumber(bid)
+	if isNumeric(a) {
+		return isNumeric(b)
 	}
 	switch super.TypeUnder(a).(type) {
 	case *super.TypeRecord:
@@ -903,10 +934,13 @@ func comparable(a, b super.Type) bool {
 	return false
 }
 
-func (c *checker) arithmetic(lloc, rloc ast.Node, lhs, rhs super.Type) super.Type {
+func (c *checker) arithmetic(op string, loc, lloc, rloc ast.Node, lhs, rhs super.Type) super.Type {
 	if hasUnknown(lhs) || hasUnknown(rhs) {
 		return c.unknown
 	}
+	if typ, ok := c.timeArith(op, loc, lhs, rhs); ok {
+		return typ
+	}
 	c.number(lloc, lhs)
 	c.number(rloc, rhs)
 	return c.fuse([]super.Type{lhs, rhs})
@@ -916,6 +950,9 @@ func (c *checker) plus(loc ast.Node, lhs, rhs super.Type) super.Type {
 	if hasUnknown(lhs) || hasUnknown(rhs) {
 		return c.unknown
 	}
+	if typ, ok := c.timeArith("+", loc, lhs, rhs); ok {
+		return typ
+	}
 	if hasNumber(lhs) && hasNumber(rhs) {
 		return c.fuse([]super.Type{lhs, rhs})
 	}
@@ -923,9 +960,26 @@ func (c *checker) plus(loc ast.Node, lhs, rhs super.Type) super.Type {
 	return c.unknown
 }
 
+// timeArith returns the type of lhs op rhs and true if lhs and rhs are
+// primitive types and one is a time or a duration.
+func (c *checker) timeArith(op string, loc ast.Node, lhs, rhs super.Type) (super.Type, bool) {
+	lid, rid := super.TypeUnder(lhs).ID(), super.TypeUnder(rhs).ID()
+	if lid >= super.IDTypeComplex || rid >= super.IDTypeComplex || !coerce.IsTime(lid, rid) {
+		return nil, false
+	}
+	if lid == super.IDNull || rid == super.IDNull {
+		return nil, false
+	}
+	typ := coerce.TimeArithType(op, lid, rid)
+	if typ == nil {
+		c.error(loc, fmt.Errorf("type mismatch: %s %s %s", sup.FormatType(lhs), op, sup.FormatType(rhs)))
+		return c.unknown, true
+	}
+	return typ, true
+}
+
 func hasNumber(typ super.Type) bool {
-	id := super.TypeUnder(typ).ID()
-	if super.IsNumber(id) || id == super.IDNull {
+	if isNumeric(typ) || super.TypeUnder(typ).ID() == super.IDNull {
 		return true
 	}
 	if u, ok := super.TypeUnder(typ).(*super.TypeUnion); ok {
@@ -936,6 +990,11 @@ func hasNumber(typ super.Type) bool {
 	return false
 }
 
+// isNumeric returns true if typ is a number or a decimal.
+func isNumeric(typ super.Type) bool {
+	return super.IsNumber(super.TypeUnder(typ).ID()) || super.IsDecimal(typ)
+}
+
 func hasString(typ super.Type) bool {
 	switch typ := super.TypeUnder(typ).(type) {
 	case *super.TypeError:
@@ -1093,8 +1152,8 @@ func (c *checker) coerceable(from, to super.Type) bool {
 	if fromID == toID || fromID == super.IDNull || toID == super.IDNull {
 		return true
 	}
-	if super.IsNumber(toID) {
-		return super.IsNumber(fromID)
+	if isNumeric(to) {
+		return isNumeric(from)
 	}
 	switch super.TypeUnder(to).(type) {
 	case *super.TypeRecord:
@@ -1167,3 +1226,19 @@ func (c *checker) aggFunc(name string, arg ast.Node, argType super.Type, filter
 	//XXX TBD... need to type check args and get return value
 	return c.unknown
 }
+
+// windowFunc returns the type of the ranking and offset window functions.
+// Aggregate functions used as window functions are typed as in aggFunc.
+func (c *checker) windowFunc(name string, argTypes []super.Type) super.Type {
+	switch name {
+	case "row_number", "rank", "dense_rank", "ntile":
+		return super.TypeInt64
+	case "percent_rank", "cume_dist":
+		return super.TypeFloat64
+	case "lag", "lead", "first_value", "last_value", "nth_value":
+		if len(argTypes) > 0 {
+			return argTypes[0]
+		}
+	}
+	return c.unknown
+}
@@ -97,7 +97,7 @@ func eqOp(aop, bop sem.Op) bool {
 		return ok && a.Count == b.Count
 	case *sem.JoinOp:
 		b, ok := bop.(*sem.JoinOp)
-		return ok && a.Style == b.Style && a.LeftAlias == b.LeftAlias && a.RightAlias == b.RightAlias && eqExpr(a.Cond, b.Cond)
+		return ok && a.Style == b.Style && a.LeftAlias == b.LeftAlias && a.RightAlias == b.RightAlias && eqExpr(a.Cond, b.Cond) && eqExpr(a.Tolerance, b.Tolerance)
 	case *sem.LoadOp:
 		b, ok := bop.(*sem.LoadOp)
 		return ok && a.Pool == b.Pool && a.Branch == b.Branch && a.Author == b.Author && a.Message == b.Message && a.Meta == b.Meta
@@ -113,6 +113,9 @@ func eqOp(aop, bop sem.Op) bool {
 	case *sem.RenameOp:
 		b, ok := bop.(*sem.RenameOp)
 		return ok && eqAssignments(a.Args, b.Args)
+	case *sem.SetOp:
+		b, ok := bop.(*sem.SetOp)
+		return ok && a.Op == b.Op && a.Distinct == b.Distinct
 	case *sem.SkipOp:
 		b, ok := bop.(*sem.SkipOp)
 		return ok && a.Count == b.Count
@@ -148,6 +151,9 @@ func eqOp(aop, bop sem.Op) bool {
 	case *sem.ValuesOp:
 		b, ok := bop.(*sem.ValuesOp)
 		return ok && eqExprs(a.Exprs, b.Exprs)
+	case *sem.WindowOp:
+		b, ok := bop.(*sem.WindowOp)
+		return ok && eqExprs(a.PartitionBy, b.PartitionBy) && eqSortExprs(a.OrderBy, b.OrderBy) && eqAssignments(a.Funcs, b.Funcs)
 	default:
 		panic(a)
 	}
@@ -285,11 +291,28 @@ func eqExpr(aexpr, bexpr sem.Expr) bool {
 	case *sem.UnaryExpr:
 		b, ok := bexpr.(*sem.UnaryExpr)
 		return ok && a.Op == b.Op && eqExpr(a.Operand, b.Operand)
+	case *sem.WindowFunc:
+		b, ok := bexpr.(*sem.WindowFunc)
+		return ok && a.Name == b.Name && eqExprs(a.Args, b.Args) && eqExpr(a.Filter, b.Filter) && eqWindowFrame(a.Frame, b.Frame)
+	case *sem.WindowRef:
+		b, ok := bexpr.(*sem.WindowRef)
+		return ok && a.Index == b.Index
 	default:
 		panic(aexpr)
 	}
 }
 
+func eqWindowFrame(a, b *sem.WindowFrame) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Unit == b.Unit && eqWindowBound(a.Start, b.Start) && eqWindowBound(a.End, b.End)
+}
+
+func eqWindowBound(a, b sem.WindowBound) bool {
+	return a.Type == b.Type && eqExpr(a.Offset, b.Offset)
+}
+
 func eqArrayElems(a, b []sem.ArrayElem) bool {
 	if len(a) != len(b) {
 		return false
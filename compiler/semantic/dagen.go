@@ -183,6 +183,9 @@ func (d *dagen) op(op sem.Op) dag.Op {
 			Limit: op.Limit,
 		}
 	case *sem.JoinOp:
+		if isAsofStyle(op.Style) {
+			return d.asofJoin(op)
+		}
 		return &dag.JoinOp{
 			Kind:       "JoinOp",
 			Style:      op.Style,
@@ -228,6 +231,12 @@ func (d *dagen) op(op sem.Op) dag.Op {
 			Kind: "RenameOp",
 			Args: d.assignments(op.Args),
 		}
+	case *sem.SetOp:
+		return &dag.SetOp{
+			Kind:     "SetOp",
+			Op:       op.Op,
+			Distinct: op.Distinct,
+		}
 	case *sem.SkipOp:
 		return &dag.SkipOp{
 			Kind:  "SkipOp",
@@ -269,6 +278,13 @@ func (d *dagen) op(op sem.Op) dag.Op {
 			Kind:  "ValuesOp",
 			Exprs: d.exprs(op.Exprs),
 		}
+	case *sem.WindowOp:
+		return &dag.WindowOp{
+			Kind:        "WindowOp",
+			PartitionBy: d.exprs(op.PartitionBy),
+			OrderBy:     d.sortExprs(op.OrderBy),
+			Funcs:       d.windowAssignments(op.Funcs),
+		}
 	}
 	panic(op)
 }
@@ -346,6 +362,40 @@ func (d *dagen) aggExpr(e sem.Expr) dag.Expr {
 	}
 }
 
+func (d *dagen) windowAssignments(assignments []sem.Assignment) []dag.Assignment {
+	var out []dag.Assignment
+	for _, e := range assignments {
+		out = append(out, dag.Assignment{
+			Kind: "Assignment",
+			LHS:  d.expr(e.LHS),
+			RHS:  d.windowExpr(e.RHS),
+		})
+	}
+	return out
+}
+
+func (d *dagen) windowExpr(e sem.Expr) dag.Expr {
+	f, ok := e.(*sem.WindowFunc)
+	if !ok {
+		return &dag.BadExpr{Kind: "BadExpr"}
+	}
+	var frame *dag.WindowFrame
+	if f.Frame != nil {
+		frame = &dag.WindowFrame{
+			Unit:  f.Frame.Unit,
+			Start: dag.WindowBound{Type: f.Frame.Start.Type, Offset: d.expr(f.Frame.Start.Offset)},
+			End:   dag.WindowBound{Type: f.Frame.End.Type, Offset: d.expr(f.Frame.End.Offset)},
+		}
+	}
+	return &dag.WindowExpr{
+		Kind:   "WindowExpr",
+		Name:   f.Name,
+		Args:   d.exprs(f.Args),
+		Filter: d.expr(f.Filter),
+		Frame:  frame,
+	}
+}
+
 func (d *dagen) sortExprs(exprs []sem.SortExpr) []dag.SortExpr {
 	var out []dag.SortExpr
 	for _, e := range exprs {
@@ -374,6 +424,9 @@ func (d *dagen) expr(e sem.Expr) dag.Expr {
 	case *sem.AggFunc:
 		d.error(e, errors.New("call to aggregate function in non-aggregate context"))
 		return &dag.BadExpr{Kind: "BadExpr"}
+	case *sem.WindowFunc:
+		d.error(e, errors.New("call to window function outside of window context"))
+		return &dag.BadExpr{Kind: "BadExpr"}
 	case *sem.ArrayExpr:
 		return &dag.ArrayExpr{
 			Kind:  "ArrayExpr",
@@ -142,6 +142,9 @@ func (e *evaluator) op(op sem.Op) bool {
 		return e.assignments(op.Args) && e.constThis
 	case *sem.RenameOp:
 		return e.assignments(op.Args) && e.constThis
+	case *sem.SetOp:
+		// Like join, this depends on the parents handled in the fork above.
+		return e.constThis
 	case *sem.SkipOp:
 		return e.constThis
 	case *sem.SortOp:
@@ -169,6 +172,8 @@ func (e *evaluator) op(op sem.Op) bool {
 		return e.seq(op.Body)
 	case *sem.ValuesOp:
 		return e.exprs(op.Exprs)
+	case *sem.WindowOp:
+		return e.exprs(op.PartitionBy) && e.sortExprs(op.OrderBy) && e.assignments(op.Funcs) && e.constThis
 	default:
 		panic(op)
 	}
@@ -272,6 +277,8 @@ func (e *evaluator) expr(expr sem.Expr) bool {
 		return e.constThis
 	case *sem.UnaryExpr:
 		return e.expr(expr.Operand)
+	case *sem.WindowFunc:
+		return e.exprs(expr.Args) && e.expr(expr.Filter)
 	default:
 		panic(e)
 	}
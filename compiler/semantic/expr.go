@@ -14,6 +14,7 @@ import (
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/reglob"
 	"github.com/brimdata/super/runtime/sam/expr"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/runtime/vam/expr/agg"
 	"github.com/brimdata/super/sup"
@@ -30,6 +31,8 @@ func (t *translator) expr(e ast.Expr, inType super.Type) (sem.Expr, super.Type)
 			return badExpr, badType
 		}
 		return t.aggFunc(e, nameLower, e.Expr, e.Filter, e.Distinct, inType)
+	case *ast.WindowExpr:
+		return t.windowExpr(e, inType)
 	case *ast.ArrayExpr:
 		elems, elemsType := t.arrayElems(e.Elems, inType)
 		if subquery := t.arraySubquery(elems); subquery != nil {
@@ -105,6 +108,9 @@ func (t *translator) expr(e ast.Expr, inType super.Type) (sem.Expr, super.Type)
 	case *ast.CallExpr:
 		return t.semCall(e, inType)
 	case *ast.CastExpr:
+		if lit := bigIntLiteral(e.Expr, e.Type); lit != nil {
+			return t.expr(lit, inType)
+		}
 		expr, _ := t.expr(e.Expr, inType)
 		if _, ok := e.Type.(*ast.DateTypeHack); ok {
 			// cast to time then bucket by 1d as a workaround for not currently
@@ -187,6 +193,8 @@ func (t *translator) expr(e ast.Expr, inType super.Type) (sem.Expr, super.Type)
 			Node:    e,
 			Entries: entries,
 		}, t.sctx.LookupTypeMap(t.checker.fuse(keyTypes), t.checker.fuse(valTypes))
+	case *ast.ParamExpr:
+		return t.paramExpr(e, inType)
 	case *ast.Primitive:
 		val, err := sup.ParsePrimitive(e.Type, e.Text)
 		if err != nil {
@@ -573,6 +581,24 @@ func (t *translator) regexp(b *ast.BinaryExpr, inType super.Type) (sem.Expr, sup
 	}, super.TypeBool
 }
 
+// bigIntLiteral returns the literal of a 128- or 256-bit integer type when e
+// is an integer literal cast to typ.  Integer literals are otherwise int64 so
+// this allows values outside the int64 range, e.g., 18446744073709551616::uint128.
+func bigIntLiteral(e ast.Expr, typ ast.Type) *ast.Primitive {
+	lit, ok := e.(*ast.Primitive)
+	if !ok || lit.Type != "int64" {
+		return nil
+	}
+	p, ok := typ.(*ast.TypePrimitive)
+	if !ok {
+		return nil
+	}
+	if t := super.LookupPrimitive(p.Name); t == nil || !super.IsBigInt(t.ID()) {
+		return nil
+	}
+	return &ast.Primitive{Kind: "Primitive", Type: p.Name, Text: lit.Text, TextPos: lit.TextPos, Loc: lit.Loc}
+}
+
 func (t *translator) binaryExpr(e *ast.BinaryExpr, inType super.Type) (sem.Expr, super.Type) {
 	if e, typ := t.regexp(e, inType); e != nil {
 		return e, typ
@@ -581,6 +607,13 @@ func (t *translator) binaryExpr(e *ast.BinaryExpr, inType super.Type) (sem.Expr,
 	if op == "." {
 		return t.dot(e, inType)
 	}
+	if op == "::" {
+		if typ, ok := e.RHS.(*ast.TypeValue); ok {
+			if lit := bigIntLiteral(e.LHS, typ.Value); lit != nil {
+				return t.expr(lit, inType)
+			}
+		}
+	}
 	lhs, lhsType := t.expr(e.LHS, inType)
 	rhs, rhsType := t.expr(e.RHS, inType)
 	if op == "like" || op == "not like" {
@@ -1007,6 +1040,8 @@ func deriveNameFromExpr(e ast.Expr) string {
 	switch e := e.(type) {
 	case *ast.AggFuncExpr:
 		return e.Name
+	case *ast.WindowExpr:
+		return deriveNameFromExpr(e.Func)
 	case *ast.CallExpr:
 		f, ok := e.Func.(*ast.FuncNameExpr)
 		if !ok {
@@ -1106,7 +1141,7 @@ func (t *translator) maybeConvertAgg(call *ast.CallExpr, inType super.Type) (sem
 	if _, err := agg.NewPattern(t.sctx, nameLower, false, true); err != nil {
 		return nil, t.checker.unknown
 	}
-	if err := function.CheckArgCount(len(call.Args), 0, 1); err != nil {
+	if err := checkAggArgCount(nameLower, len(call.Args)); err != nil {
 		if nameLower == "min" || nameLower == "max" {
 			// min and max are special cases as they are also functions. If the
 			// number of args is greater than 1 they're probably a function so do not
@@ -1116,11 +1151,31 @@ func (t *translator) maybeConvertAgg(call *ast.CallExpr, inType super.Type) (sem
 		t.error(call, err)
 		return badExpr, t.checker.unknown
 	}
-	var e ast.Expr
-	if len(call.Args) == 1 {
-		e = call.Args[0]
+	return t.aggFunc(call, nameLower, aggArg(call.Args), nil, false, inType)
+}
+
+func checkAggArgCount(name string, n int) error {
+	nargs := samagg.NumArgs(name)
+	if nargs == 1 {
+		return function.CheckArgCount(n, 0, 1)
+	}
+	return function.CheckArgCount(n, nargs, nargs)
+}
+
+// aggArg returns the argument expression of an aggregate function call with
+// args, combining multiple arguments into a tuple.
+func aggArg(args []ast.Expr) ast.Expr {
+	switch len(args) {
+	case 0:
+		return nil
+	case 1:
+		return args[0]
+	}
+	return &ast.TupleExpr{
+		Kind:  "TupleExpr",
+		Elems: args,
+		Loc:   ast.NewLoc(args[0].Pos(), args[len(args)-1].End()),
 	}
-	return t.aggFunc(call, nameLower, e, nil, false, inType)
 }
 
 func (t *translator) aggFunc(n ast.Node, name string, arg ast.Expr, filter ast.Expr, distinct bool, inType super.Type) (sem.Expr, super.Type) {
@@ -75,6 +75,7 @@ func clrOp(op sem.Op) {
 	case *sem.JoinOp:
 		op.Node = nil
 		clrExpr(op.Cond)
+		clrExpr(op.Tolerance)
 	case *sem.LoadOp:
 		op.Node = nil
 	case *sem.MergeOp:
@@ -88,6 +89,8 @@ func clrOp(op sem.Op) {
 	case *sem.RenameOp:
 		op.Node = nil
 		clrAssignments(op.Args)
+	case *sem.SetOp:
+		op.Node = nil
 	case *sem.SkipOp:
 		op.Node = nil
 	case *sem.SortOp:
@@ -114,6 +117,11 @@ func clrOp(op sem.Op) {
 	case *sem.ValuesOp:
 		op.Node = nil
 		clrExprs(op.Exprs)
+	case *sem.WindowOp:
+		op.Node = nil
+		clrExprs(op.PartitionBy)
+		clrSortExprs(op.OrderBy)
+		clrAssignments(op.Funcs)
 	default:
 		panic(op)
 	}
@@ -212,6 +220,14 @@ func clrExpr(expr sem.Expr) {
 	case *sem.UnaryExpr:
 		expr.Node = nil
 		clrExpr(expr.Operand)
+	case *sem.WindowFunc:
+		expr.Node = nil
+		clrExprs(expr.Args)
+		clrExpr(expr.Filter)
+		if expr.Frame != nil {
+			clrExpr(expr.Frame.Start.Offset)
+			clrExpr(expr.Frame.End.Offset)
+		}
 	default:
 		panic(expr)
 	}
@@ -1,6 +1,7 @@
 package semantic
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"net/url"
@@ -19,6 +20,7 @@ import (
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/plural"
 	"github.com/brimdata/super/pkg/reglob"
+	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/sio"
@@ -90,15 +92,15 @@ func (t *translator) fromSource(entity ast.FromSource, args []ast.OpArg, seq sem
 		if seq, typ := t.scope.lookupQuery(t, entity.Text); seq != nil {
 			return seq, typ, entity.Text
 		}
-		op, def := t.fromName(entity, entity.Text, args)
-		if op, ok := op.(*sem.FileScan); ok {
+		seq, def := t.fromName(entity, entity.Text, args)
+		if op, ok := seq[0].(*sem.FileScan); ok {
 			typ := op.Type
 			if typ == nil {
 				typ = t.checker.unknown
 			}
-			return sem.Seq{op}, typ, def
+			return seq, typ, def
 		}
-		return sem.Seq{op}, t.checker.unknown, def
+		return seq, t.checker.unknown, def
 	case *ast.FromEval:
 		seq, def := t.fromFString(entity, args, seq)
 		return seq, t.checker.unknown, def
@@ -230,8 +232,7 @@ func (t *translator) hasFromParent(loc ast.Node, seq sem.Seq) sem.Seq {
 
 func (t *translator) fromConst(val super.Value, entity *ast.FromEval, args []ast.OpArg) (sem.Seq, string) {
 	if super.TypeUnder(val.Type()) == super.TypeString {
-		op, name := t.fromName(entity, val.AsString(), args)
-		return sem.Seq{op}, name
+		return t.fromName(entity, val.AsString(), args)
 	}
 	vals, ok := val.Elements()
 	if !ok {
@@ -247,13 +248,13 @@ func (t *translator) fromConst(val super.Value, entity *ast.FromEval, args []ast
 		names = append(names, val.AsString())
 	}
 	if len(names) == 1 {
-		op, _ := t.fromName(entity, names[0], args)
-		return sem.Seq{op}, names[0]
+		seq, _ := t.fromName(entity, names[0], args)
+		return seq, names[0]
 	}
 	var paths []sem.Seq
 	for _, name := range names {
-		op, _ := t.fromName(entity, name, args)
-		paths = append(paths, sem.Seq{op})
+		seq, _ := t.fromName(entity, name, args)
+		paths = append(paths, seq)
 	}
 	return sem.Seq{
 		&sem.ForkOp{
@@ -262,15 +263,15 @@ func (t *translator) fromConst(val super.Value, entity *ast.FromEval, args []ast
 	}, ""
 }
 
-func (t *translator) fromName(node ast.Node, name string, args []ast.OpArg) (sem.Op, string) {
+func (t *translator) fromName(node ast.Node, name string, args []ast.OpArg) (sem.Seq, string) {
 	if isURL(name) {
-		return t.fromURL(node, name, args), ""
+		return sem.Seq{t.fromURL(node, name, args)}, ""
 	}
 	prefix := strings.Split(filepath.Base(name), ".")[0]
 	if t.env.IsAttached() {
-		return t.pool(node, name, args), prefix
+		return t.restrictedPool(node, name, args), prefix
 	}
-	return t.file(node, name, args), prefix
+	return sem.Seq{t.file(node, name, args)}, prefix
 }
 
 func (t *translator) asFormatArg(args []ast.OpArg) string {
@@ -284,7 +285,7 @@ func (t *translator) file(n ast.Node, name string, args []ast.OpArg) sem.Op {
 	if format == "" {
 		format = sio.FormatFromPath(name)
 	}
-	if format == "csup" || format == "json" || format == "parquet" {
+	if format == "csup" || format == "json" || format == "orc" || format == "parquet" {
 		t.hasVectorizedInput = true
 	}
 	typ, err := t.fileType(name, format)
@@ -304,6 +305,11 @@ func (t *translator) fileType(path, format string) (super.Type, error) {
 	if t.env.Dynamic {
 		return t.checker.unknown, nil
 	}
+	if path == "stdio:stdin" && t.env.Stdin != nil {
+		// Standard input has been replaced by the environment's puller,
+		// so don't sample the process's standard input.
+		return t.checker.unknown, nil
+	}
 	engine := t.env.Engine()
 	if engine == nil {
 		return t.checker.unknown, nil
@@ -410,7 +416,7 @@ func (t *translator) fromPoolRegexp(node ast.Node, re, orig, which string, args
 	}
 	var paths []sem.Seq
 	for _, name := range poolNames {
-		paths = append(paths, sem.Seq{t.pool(node, name, args)})
+		paths = append(paths, t.restrictedPool(node, name, args))
 	}
 	return sem.Seq{&sem.ForkOp{Paths: paths}}
 }
@@ -456,16 +462,29 @@ func (t *translator) pool(node ast.Node, poolName string, args []ast.OpArg) sem.
 	}
 	var commitID ksuid.KSUID
 	commit, commitLoc := t.textArg(opArgs, "commit")
+	meta, metaLoc := t.textArg(opArgs, "meta")
+	branch := "main"
 	if commit != "" {
-		if commitID, err = dbid.ParseID(commit); err != nil {
-			commitID, err = t.env.CommitObject(t.ctx, poolID, commit)
-			if err != nil {
-				t.error(commitLoc, err)
-				return badOp
-			}
+		branch = commit
+		if id, err := dbid.ParseID(commit); err == nil {
+			commitID = id
+			branch = ""
+		}
+	}
+	if _, ok := dag.PoolMetas[meta]; ok {
+		branch = ""
+	}
+	if err := t.env.AuthorizePool(t.ctx, poolID, branch, exec.AccessRead); err != nil {
+		t.error(node, err)
+		return badOp
+	}
+	if commit != "" && commitID == ksuid.Nil {
+		commitID, err = t.env.CommitObject(t.ctx, poolID, commit)
+		if err != nil {
+			t.error(commitLoc, err)
+			return badOp
 		}
 	}
-	meta, metaLoc := t.textArg(opArgs, "meta")
 	if meta != "" {
 		if _, ok := dag.CommitMetas[meta]; ok {
 			if commitID == ksuid.Nil {
@@ -628,6 +647,9 @@ func (t *translator) semOp(o ast.Op, seq sem.Seq, inType super.Type) (sem.Seq, s
 	case *ast.FromOp:
 		seq, typ, _ := t.fromSource(o.Item.Source, o.Item.Args, seq)
 		return seq, typ
+	case *ast.SQLMerge:
+		t.error(o, errors.New(`MERGE INTO must be run with "super db update"`))
+		return append(seq, badOp), t.checker.unknown
 	case *ast.Delete:
 		if len(seq) > 0 {
 			panic("analyzer.SemOp: delete scan cannot have parent in AST")
@@ -1056,6 +1078,10 @@ func (t *translator) semOp(o ast.Op, seq sem.Seq, inType super.Type) (sem.Seq, s
 		}
 		opArgs := t.opArgs(o.Args, "commit", "author", "message", "meta")
 		branch, _ := t.textArg(opArgs, "commit")
+		if err := t.env.AuthorizePool(t.ctx, poolID, cmp.Or(branch, "main"), exec.AccessLoad); err != nil {
+			t.error(o, err)
+			return append(seq, badOp), badType
+		}
 		author, _ := t.textArg(opArgs, "author")
 		message, _ := t.textArg(opArgs, "message")
 		meta, _ := t.textArg(opArgs, "meta")
@@ -1136,20 +1162,21 @@ func (t *translator) joinOp(op *ast.JoinOp, parent sem.Seq, inTypes []super.Type
 		super.NewField(leftAlias, leftType),
 		super.NewField(rightAlias, rightType),
 	})
-	var cond sem.Expr
-	if op.Cond != nil {
-		cond = t.pipeJoinCond(op.Cond, leftAlias, rightAlias, typ)
-	}
 	style := op.Style
 	if style == "" {
 		style = "inner"
 	}
+	var cond sem.Expr
+	if op.Cond != nil {
+		cond = t.pipeJoinCond(op.Cond, style, leftAlias, rightAlias, typ)
+	}
 	join := &sem.JoinOp{
 		Node:       op,
 		Style:      style,
 		LeftAlias:  leftAlias,
 		RightAlias: rightAlias,
 		Cond:       cond,
+		Tolerance:  t.asofJoin(style, op.Cond, cond, op.Tolerance, leftAlias, rightAlias),
 	}
 	if subquery == nil {
 		return append(parent, join), typ
@@ -1163,7 +1190,7 @@ func (t *translator) joinOp(op *ast.JoinOp, parent sem.Seq, inTypes []super.Type
 	return sem.Seq{fork, join}, typ
 }
 
-func (t *translator) pipeJoinCond(cond ast.JoinCond, leftAlias, rightAlias string, inType super.Type) sem.Expr {
+func (t *translator) pipeJoinCond(cond ast.JoinCond, style, leftAlias, rightAlias string, inType super.Type) sem.Expr {
 	switch cond := cond.(type) {
 	case *ast.JoinOnCond:
 		e, typ := t.expr(cond.Expr, inType)
@@ -1178,10 +1205,10 @@ func (t *translator) pipeJoinCond(cond ast.JoinCond, leftAlias, rightAlias strin
 		return e
 	case *ast.JoinUsingCond:
 		var exprs []sem.Expr
-		for _, id := range cond.Fields {
+		for k, id := range cond.Fields {
 			lhs := sem.NewThis(id, []string{leftAlias, id.Name})
 			rhs := sem.NewThis(id, []string{rightAlias, id.Name})
-			exprs = append(exprs, sem.NewBinaryExpr(id, "==", lhs, rhs))
+			exprs = append(exprs, sem.NewBinaryExpr(id, usingComparison(style, k, len(cond.Fields)), lhs, rhs))
 		}
 		return andUsingExprs(cond, exprs)
 	default:
@@ -0,0 +1,67 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/semantic/sem"
+	"github.com/brimdata/super/sup"
+)
+
+// bindParams parses the SUP values bound to query parameters.  Parameters are
+// substituted as literals so their values can never alter the structure of
+// the query.
+func (t *translator) bindParams(params map[string]string) error {
+	vals, err := parseParams(t.sctx, params)
+	if err != nil {
+		return err
+	}
+	t.params = vals
+	return nil
+}
+
+func parseParams(sctx *super.Context, params map[string]string) (map[string]super.Value, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	vals := make(map[string]super.Value)
+	for name, text := range params {
+		val, err := parseParam(sctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("parameter $%s: invalid value %q: %w", name, text, err)
+		}
+		vals[name] = val
+	}
+	return vals, nil
+}
+
+// parseParam parses text, which must hold exactly one SUP value, so a
+// parameter given no value or a value followed by other text is an error
+// rather than a null or a truncated value.
+func parseParam(sctx *super.Context, text string) (super.Value, error) {
+	p := sup.NewParser(strings.NewReader(text))
+	v, err := p.ParseValue()
+	if err != nil {
+		return super.Null, err
+	}
+	if v == nil {
+		return super.Null, errors.New("no value found")
+	}
+	if err := p.MatchEnd(); err != nil {
+		return super.Null, err
+	}
+	return sup.ParseValueFromAST(sctx, v)
+}
+
+func (t *translator) paramExpr(e *ast.ParamExpr, inType super.Type) (sem.Expr, super.Type) {
+	val, ok := t.params[e.Name]
+	if !ok {
+		t.error(e, fmt.Errorf("parameter $%s is not bound", e.Name))
+		return badExpr, t.checker.unknown
+	}
+	lit := sem.NewLiteral(e, val, t.defs)
+	return lit, t.checker.expr(inType, lit)
+}
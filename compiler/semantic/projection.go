@@ -24,7 +24,9 @@ func replaceGroupings(t *translator, in sem.Expr, groupings []exprloc) (sem.Expr
 					return e, true
 				case "out", "g":
 				default:
-					panic(s)
+					if !isWindowTmp(s) {
+						panic(s)
+					}
 				}
 			}
 			return e, false
@@ -34,6 +36,9 @@ func replaceGroupings(t *translator, in sem.Expr, groupings []exprloc) (sem.Expr
 			panic(e)
 		case *sem.AggRef:
 			return sem.NewThis(e.Node, []string{"g", aggTmp(e.Index)}), false
+		case *sem.WindowRef:
+			// The window's expressions are replaced separately.
+			return e, true
 		}
 		return e, false
 	})
@@ -119,6 +124,14 @@ func exprWalk(e sem.Expr, visit exprVisitor) sem.Expr {
 	case *sem.TypeExpr:
 	case *sem.UnaryExpr:
 		e.Operand = exprWalk(e.Operand, visit)
+	case *sem.WindowFunc:
+		var out []sem.Expr
+		for _, arg := range e.Args {
+			out = append(out, exprWalk(arg, visit))
+		}
+		e.Args = out
+		e.Filter = exprWalk(e.Filter, visit)
+	case *sem.WindowRef:
 	default:
 		panic(e)
 	}
@@ -0,0 +1,74 @@
+package semantic
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/compiler/semantic/sem"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/segmentio/ksuid"
+)
+
+// restrictedPool is like pool but appends the operators of any restriction
+// the environment places on the values of the pool.  The metadata of a
+// restricted pool's commits cannot be read since it would reveal values
+// hidden by the restriction.
+func (t *translator) restrictedPool(node ast.Node, poolName string, args []ast.OpArg) sem.Seq {
+	op := t.pool(node, poolName, args)
+	var poolID ksuid.KSUID
+	switch op := op.(type) {
+	case *sem.PoolScan:
+		poolID = op.ID
+	case *sem.CommitMetaScan:
+		poolID = op.Pool
+	default:
+		return sem.Seq{op}
+	}
+	r, err := t.env.PoolRestriction(t.ctx, poolID)
+	if err != nil {
+		t.error(node, err)
+		return sem.Seq{badOp}
+	}
+	if r == nil {
+		return sem.Seq{op}
+	}
+	if _, ok := op.(*sem.PoolScan); !ok {
+		t.error(node, fmt.Errorf("cannot read metadata of restricted pool %q", poolName))
+		return sem.Seq{badOp}
+	}
+	return t.restrict(node, poolName, sem.Seq{op}, r)
+}
+
+// restrict appends the operators of r to seq.  The operators are translated
+// in a new top-level scope with only the parameters bound by r so that
+// neither the declarations nor the parameters of the query can alter them.
+// Errors in r are reported at node.
+func (t *translator) restrict(node ast.Node, poolName string, seq sem.Seq, r *exec.Restriction) sem.Seq {
+	p, err := parser.ParseText(r.Text)
+	if err != nil {
+		t.error(node, fmt.Errorf("restriction on pool %q: %w", poolName, err))
+		return sem.Seq{badOp}
+	}
+	params, err := parseParams(t.sctx, r.Params)
+	if err != nil {
+		t.error(node, fmt.Errorf("restriction on pool %q: %w", poolName, err))
+		return sem.Seq{badOp}
+	}
+	saved, scope, savedParams := t.reporter, t.scope, t.params
+	t.reporter, t.scope, t.params = reporter{p.Files()}, NewScope(nil), params
+	t.checker.pushErrs()
+	var typ super.Type = t.checker.unknown
+	for _, op := range p.Parsed() {
+		seq, typ = t.semOp(op, seq, typ)
+	}
+	t.checker.popErrs().flushErrs(t.reporter)
+	err = t.Error()
+	t.reporter, t.scope, t.params = saved, scope, savedParams
+	if err != nil {
+		t.error(node, fmt.Errorf("restriction on pool %q: %w", poolName, err))
+		return sem.Seq{badOp}
+	}
+	return seq
+}
@@ -78,6 +78,20 @@ type (
 		aggs     []*sem.AggFunc
 		aggTypes []super.Type
 
+		// windowOk is set when it's ok to process window functions in
+		// expressions, i.e., in the projection and QUALIFY clause.
+		windowOk bool
+
+		// aggScan is set while ORDER BY and HAVING are scanned for agg
+		// functions, where window functions are skipped.
+		aggScan bool
+
+		// windows is the set of window functions computed over the
+		// (possibly grouped) input table and windowDefs holds the
+		// named windows of the WINDOW clause.
+		windows    []window
+		windowDefs map[string]*ast.WindowSpec
+
 		// groupings holds the grouping expressions which are needed to do
 		// expression matching for having, order by, and the projection.
 		// These expressions are always relative to the "in" scope.
@@ -580,6 +594,9 @@ func (s *selectScope) superType(sctx *super.Context, unknown *super.TypeError) s
 	if s.isGrouped() {
 		fields = append(fields, super.NewField("g", s.gType(sctx)))
 	}
+	for k, w := range s.windows {
+		fields = append(fields, super.NewField(windowTmp(k), w.typ))
+	}
 	if s.out != nil {
 		fields = append(fields, super.NewField("out", s.out.superType(sctx, unknown)))
 	}
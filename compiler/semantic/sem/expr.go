@@ -130,6 +130,17 @@ type (
 		Op      string
 		Operand Expr
 	}
+	WindowFunc struct {
+		ast.Node
+		Name   string // convert to lower case
+		Args   []Expr
+		Filter Expr
+		Frame  *WindowFrame
+	}
+	WindowRef struct {
+		ast.Node
+		Index int
+	}
 )
 
 // Support structures embedded in Expr nodes
@@ -140,6 +151,19 @@ type Entry struct {
 	Value Expr
 }
 
+// WindowFrame and WindowBound used by WindowFunc
+type (
+	WindowFrame struct {
+		Unit  string
+		Start WindowBound
+		End   WindowBound
+	}
+	WindowBound struct {
+		Type   string
+		Offset Expr
+	}
+)
+
 // The sum type for array, set, and record elements.  There is not an easy way
 // of creating a Go pseudo-type
 
@@ -204,6 +228,8 @@ func (*SubqueryExpr) exprNode()     {}
 func (*ThisExpr) exprNode()         {}
 func (*TypeExpr) exprNode()         {}
 func (*UnaryExpr) exprNode()        {}
+func (*WindowFunc) exprNode()       {}
+func (*WindowRef) exprNode()        {}
 
 // FuncRef is a pseudo-expression that represents a function reference as a value.
 // It is not used by the runtime (but could be if we wanted to support this).  Instead,
@@ -461,11 +487,35 @@ func CopyExpr(e Expr) Expr {
 			Op:      e.Op,
 			Operand: CopyExpr(e.Operand),
 		}
+	case *WindowFunc:
+		return &WindowFunc{
+			Node:   e.Node,
+			Name:   e.Name,
+			Args:   copyExprs(e.Args),
+			Filter: CopyExpr(e.Filter),
+			Frame:  CopyWindowFrame(e.Frame),
+		}
+	case *WindowRef:
+		return &WindowRef{
+			Node:  e.Node,
+			Index: e.Index,
+		}
 	default:
 		panic(e)
 	}
 }
 
+func CopyWindowFrame(f *WindowFrame) *WindowFrame {
+	if f == nil {
+		return nil
+	}
+	return &WindowFrame{
+		Unit:  f.Unit,
+		Start: WindowBound{Type: f.Start.Type, Offset: CopyExpr(f.Start.Offset)},
+		End:   WindowBound{Type: f.End.Type, Offset: CopyExpr(f.End.Offset)},
+	}
+}
+
 func copyExprs(exprs []Expr) []Expr {
 	var out []Expr
 	for _, e := range exprs {
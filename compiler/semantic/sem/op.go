@@ -153,6 +153,7 @@ type (
 		LeftAlias  string
 		RightAlias string
 		Cond       Expr
+		Tolerance  Expr
 	}
 	LoadOp struct {
 		ast.Node
@@ -181,6 +182,11 @@ type (
 		ast.Node
 		Args []Assignment
 	}
+	SetOp struct {
+		ast.Node
+		Op       string
+		Distinct bool
+	}
 	SkipOp struct {
 		ast.Node
 		Count int
@@ -218,6 +224,12 @@ type (
 		ast.Node
 		Exprs []Expr
 	}
+	WindowOp struct {
+		ast.Node
+		PartitionBy []Expr
+		OrderBy     []SortExpr
+		Funcs       []Assignment
+	}
 )
 
 // Suport structs for Ops
@@ -259,6 +271,7 @@ func (*OutputOp) opNode()    {}
 func (*PassOp) opNode()      {}
 func (*PutOp) opNode()       {}
 func (*RenameOp) opNode()    {}
+func (*SetOp) opNode()       {}
 func (*SkipOp) opNode()      {}
 func (*SortOp) opNode()      {}
 func (*SwitchOp) opNode()    {}
@@ -267,6 +280,7 @@ func (*TopOp) opNode()       {}
 func (*UnnestOp) opNode()    {}
 func (*UniqOp) opNode()      {}
 func (*ValuesOp) opNode()    {}
+func (*WindowOp) opNode()    {}
 
 func NewValues(n ast.Node, expr ...Expr) *ValuesOp {
 	return &ValuesOp{Node: n, Exprs: expr}
@@ -410,6 +424,7 @@ func CopyOp(op Op) Op {
 			LeftAlias:  op.LeftAlias,
 			RightAlias: op.RightAlias,
 			Cond:       CopyExpr(op.Cond),
+			Tolerance:  CopyExpr(op.Tolerance),
 		}
 	case *LoadOp:
 		return &LoadOp{
@@ -442,6 +457,12 @@ func CopyOp(op Op) Op {
 			Node: op.Node,
 			Args: copyAssignments(op.Args),
 		}
+	case *SetOp:
+		return &SetOp{
+			Node:     op.Node,
+			Op:       op.Op,
+			Distinct: op.Distinct,
+		}
 	case *SkipOp:
 		return &SkipOp{
 			Node:  op.Node,
@@ -494,6 +515,13 @@ func CopyOp(op Op) Op {
 			Node:  op.Node,
 			Exprs: copyExprs(op.Exprs),
 		}
+	case *WindowOp:
+		return &WindowOp{
+			Node:        op.Node,
+			PartitionBy: copyExprs(op.PartitionBy),
+			OrderBy:     copySortExprs(op.OrderBy),
+			Funcs:       copyAssignments(op.Funcs),
+		}
 	default:
 		panic(op)
 	}
@@ -45,6 +45,7 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 	defer func() {
 		t.scope.sql = save
 	}()
+	t.windowDefs(scope, sel.Windows)
 	// We first form column slots (and expand *'s) but delay analysis of expressions.
 	// This will let us properly resolve lateral column aliases from any
 	// GROUP BY expressions that reference them.
@@ -71,6 +72,7 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 	// encountered and entered into the scope.aggs table and the real
 	// type checking will be handled above by the ORDER BY logic.
 	t.checker.pushErrs()
+	scope.aggScan = true
 	for _, e := range demand {
 		t.expr(e, inType)
 	}
@@ -79,20 +81,41 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 		t.expr(sel.Having, inType)
 		scope.lateral = true
 	}
+	scope.aggScan = false
 	t.checker.popErrs()
 
 	// Next analyze all the columns so we know if there are aggregate functions
 	// (in particular, we know this when there is no GROUP BY) so after this,
-	// we know for sure if the output is grouped or not.
+	// we know for sure if the output is grouped or not.  Window functions
+	// are collected into scope.windows along the way.
 	scope.lateral = false
+	scope.windowOk = true
 	t.projection(scope.columns, inType)
 	scope.lateral = true
+	// QUALIFY is like WHERE for window functions and may refer to lateral
+	// column aliases.
+	var qualify sem.Expr
+	if sel.Qualify != nil {
+		var typ super.Type
+		qualify, typ = t.groupedExpr(scope, sel.Qualify, inType)
+		if qualify != nil {
+			t.checker.boolean(sel.Qualify, typ)
+		}
+	}
+	scope.windowOk = false
 	if scope.isGrouped() {
 		// Match grouping expressions in the projected column expressions.
 		t.replaceGroupings(scope, scope.columns)
+		t.replaceWindowGroupings(scope)
 	} else if sel.Having != nil {
 		t.error(sel.Having, errors.New("HAVING clause requires aggregation functions and/or a GROUP BY clause"))
 	}
+	if len(scope.windows) > 0 {
+		for k, c := range scope.columns {
+			scope.columns[k].semExpr = replaceWindowRefs(c.semExpr)
+		}
+		qualify = replaceWindowRefs(qualify)
+	}
 	// Now we stitch together the fragments into pipeline operators depending
 	// on whether the ouput is grouped or not.
 	seq = valuesExpr(wrapThis(sel, "in"), seq)
@@ -103,7 +126,9 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 		seq = t.genAggregate(sel.Loc, scope, seq)
 		seq = valuesExpr(wrapThis(sel, "g"), seq)
 	}
-	seq, scope.out = t.emitProjection(scope.columns, scope.isGrouped(), seq)
+	var projection sem.Seq
+	projection, scope.out = t.emitProjection(scope.columns, scope.isGrouped(), len(scope.windows), nil)
+	var having *sem.FilterOp
 	if sel.Having != nil {
 		// We build the having expression after the projection but having
 		// name resolution is relative to the before-projection scope while
@@ -114,12 +139,32 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 		save := scope.out
 		scope.out = nil
 		scope.lateral = false
-		having, typ := t.groupedExpr(scope, sel.Having, typ)
+		e, typ := t.groupedExpr(scope, sel.Having, typ)
 		scope.out = save
 		scope.lateral = true
-		if having != nil {
+		if e != nil {
 			t.checker.boolean(sel.Having, typ)
-			seq = append(seq, sem.NewFilter(sel.Having, having))
+			having = sem.NewFilter(sel.Having, e)
+		}
+	}
+	if len(scope.windows) > 0 {
+		// Window functions are computed over the rows that remain after
+		// grouping and HAVING and before QUALIFY and the projection.
+		if having != nil {
+			seq = append(seq, having)
+		}
+		seq = t.genWindows(sel, scope, seq)
+		if qualify != nil {
+			seq = append(seq, sem.NewFilter(sel.Qualify, qualify))
+		}
+		seq = append(seq, projection...)
+	} else {
+		if qualify != nil {
+			seq = append(seq, sem.NewFilter(sel.Qualify, qualify))
+		}
+		seq = append(seq, projection...)
+		if having != nil {
+			seq = append(seq, having)
 		}
 	}
 	if sel.Distinct {
@@ -216,7 +261,7 @@ func (t *translator) projection(columns []column, inType super.Type) {
 	}
 }
 
-func (t *translator) emitProjection(columns []column, grouped bool, seq sem.Seq) (sem.Seq, *staticTable) {
+func (t *translator) emitProjection(columns []column, grouped bool, nwindows int, seq sem.Seq) (sem.Seq, *staticTable) {
 	if len(columns) == 0 {
 		return seq, nil
 	}
@@ -237,23 +282,33 @@ func (t *translator) emitProjection(columns []column, grouped bool, seq sem.Seq)
 		in = "in"
 	}
 	loc := columns[0].astExpr //XXX
-	e := &sem.RecordExpr{
+	elems := []sem.RecordElem{
+		&sem.FieldElem{
+			Node:  loc,
+			Name:  in,
+			Value: sem.NewThis(loc, field.Path{in}),
+		},
+	}
+	// Carry the window results past the projection so ORDER BY can
+	// refer to them.
+	for k := range nwindows {
+		elems = append(elems, &sem.FieldElem{
+			Node:  loc,
+			Name:  windowTmp(k),
+			Value: sem.NewThis(loc, field.Path{windowTmp(k)}),
+		})
+	}
+	elems = append(elems, &sem.FieldElem{
 		Node: loc,
-		Elems: []sem.RecordElem{
-			&sem.FieldElem{
-				Node:  loc,
-				Name:  in,
-				Value: sem.NewThis(loc, field.Path{in}),
-			},
-			&sem.FieldElem{
-				Node: loc,
-				Name: "out",
-				Value: &sem.RecordExpr{
-					Node:  loc,
-					Elems: outs,
-				},
-			},
+		Name: "out",
+		Value: &sem.RecordExpr{
+			Node:  loc,
+			Elems: outs,
 		},
+	})
+	e := &sem.RecordExpr{
+		Node:  loc,
+		Elems: elems,
 	}
 	outType := t.sctx.MustLookupTypeRecord(fields)
 	return append(seq, sem.NewValues(loc, e)), &staticTable{typ: outType}
@@ -466,32 +521,9 @@ func (t *translator) sqlQueryBody(query ast.SQLQueryBody, demand []ast.Expr, seq
 		}
 		return seq, scope
 	case *ast.SQLUnion:
-		left, leftScope := t.sqlQueryBody(query.Left, nil, seq, nil)
-		left, leftTable := leftScope.endScope(query.Left.(ast.Node), left)
-		right, rightScope := t.sqlQueryBody(query.Right, nil, seq, nil)
-		right, rightTable := rightScope.endScope(query.Right.(ast.Node), right)
-		if leftTable == badTable || rightTable == badTable {
-			return right, badTable
-		}
-		if leftTable.width() != rightTable.width() {
-			t.error(query, errors.New("set operations can only be applied to sources with the same number of columns"))
-			return sem.Seq{badOp}, badTable
-		}
-		if !slices.EqualFunc(leftTable.typ.Fields, rightTable.typ.Fields, func(f1 super.Field, f2 super.Field) bool {
-			return f1.Name == f2.Name
-		}) {
-			// Rename fields on the right to match the left.
-			var elems []sem.RecordElem
-			for i, col := range leftTable.typ.Fields {
-				elems = append(elems, &sem.FieldElem{
-					Name: col.Name,
-					Value: &sem.IndexExpr{
-						Expr:  sem.NewThis(nil, nil),
-						Index: sem.NewLiteral(nil, super.NewInt64(int64(i)), t.defs),
-					},
-				})
-			}
-			right = append(right, sem.NewValues(nil, &sem.RecordExpr{Elems: elems}))
+		left, right, table := t.sqlSetOperands(query, query.Left, query.Right, seq)
+		if table == badTable {
+			return left, badTable
 		}
 		out := sem.Seq{
 			&sem.ForkOp{Node: query, Paths: []sem.Seq{left, right}},
@@ -504,12 +536,55 @@ func (t *translator) sqlQueryBody(query ast.SQLQueryBody, demand []ast.Expr, seq
 		if query.Distinct {
 			out = t.genDistinct(sem.NewThis(query, nil), out)
 		}
-		return out, leftTable
+		return out, table
+	case *ast.SQLSetOp:
+		left, right, table := t.sqlSetOperands(query, query.Left, query.Right, seq)
+		if table == badTable {
+			return left, badTable
+		}
+		return sem.Seq{
+			&sem.ForkOp{Node: query, Paths: []sem.Seq{left, right}},
+			&sem.SetOp{Node: query, Op: query.Op, Distinct: query.Distinct},
+		}, table
 	default:
 		panic(query)
 	}
 }
 
+// sqlSetOperands translates the operands of a set operation, renaming the
+// columns of the right operand to match the left if needed.  It returns
+// badTable if either operand is invalid.
+func (t *translator) sqlSetOperands(n ast.Node, leftBody, rightBody ast.SQLQueryBody, seq sem.Seq) (sem.Seq, sem.Seq, *staticTable) {
+	left, leftScope := t.sqlQueryBody(leftBody, nil, seq, nil)
+	left, leftTable := leftScope.endScope(leftBody.(ast.Node), left)
+	right, rightScope := t.sqlQueryBody(rightBody, nil, seq, nil)
+	right, rightTable := rightScope.endScope(rightBody.(ast.Node), right)
+	if leftTable == badTable || rightTable == badTable {
+		return right, nil, badTable
+	}
+	if leftTable.width() != rightTable.width() {
+		t.error(n, errors.New("set operations can only be applied to sources with the same number of columns"))
+		return sem.Seq{badOp}, nil, badTable
+	}
+	if !slices.EqualFunc(leftTable.typ.Fields, rightTable.typ.Fields, func(f1 super.Field, f2 super.Field) bool {
+		return f1.Name == f2.Name
+	}) {
+		// Rename fields on the right to match the left.
+		var elems []sem.RecordElem
+		for i, col := range leftTable.typ.Fields {
+			elems = append(elems, &sem.FieldElem{
+				Name: col.Name,
+				Value: &sem.IndexExpr{
+					Expr:  sem.NewThis(nil, nil),
+					Index: sem.NewLiteral(nil, super.NewInt64(int64(i)), t.defs),
+				},
+			})
+		}
+		right = append(right, sem.NewValues(nil, &sem.RecordExpr{Elems: elems}))
+	}
+	return left, right, leftTable
+}
+
 func exprsFromSortExprs(in []ast.SortExpr) []ast.Expr {
 	var out []ast.Expr
 	for _, e := range in {
@@ -589,12 +664,12 @@ func (t *translator) sqlJoin(join *ast.SQLJoin, seq sem.Seq) (sem.Seq, relScope)
 	}
 	saved := t.scope.sql
 	t.scope.sql = scope
-	cond := t.sqlJoinCond(join.Cond, scope.superType(t.sctx, t.checker.unknown))
-	t.scope.sql = saved
 	style := join.Style
 	if style == "" {
 		style = "inner"
 	}
+	cond := t.sqlJoinCond(join.Cond, style, scope.superType(t.sctx, t.checker.unknown))
+	t.scope.sql = saved
 	par := &sem.ForkOp{Paths: []sem.Seq{leftSeq, rightSeq}}
 	dagJoin := &sem.JoinOp{
 		Node:       join,
@@ -602,11 +677,12 @@ func (t *translator) sqlJoin(join *ast.SQLJoin, seq sem.Seq) (sem.Seq, relScope)
 		LeftAlias:  "left",
 		RightAlias: "right",
 		Cond:       cond,
+		Tolerance:  t.asofJoin(style, join.Cond, cond, join.Tolerance, "left", "right"),
 	}
 	return sem.Seq{par, dagJoin}, scope
 }
 
-func (t *translator) sqlJoinCond(cond ast.JoinCond, typ super.Type) sem.Expr {
+func (t *translator) sqlJoinCond(cond ast.JoinCond, style string, typ super.Type) sem.Expr {
 	switch cond := cond.(type) {
 	case *ast.JoinOnCond:
 		e, typ := t.expr(cond.Expr, typ)
@@ -615,7 +691,7 @@ func (t *translator) sqlJoinCond(cond ast.JoinCond, typ super.Type) sem.Expr {
 	case *ast.JoinUsingCond:
 		jsch := t.scope.sql.(*joinUsingScope).joinScope
 		var exprs []sem.Expr
-		for _, id := range cond.Fields {
+		for k, id := range cond.Fields {
 			left, _, err := jsch.left.resolveUnqualified(id.Name)
 			if err != nil {
 				t.error(id, err)
@@ -636,7 +712,7 @@ func (t *translator) sqlJoinCond(cond ast.JoinCond, typ super.Type) sem.Expr {
 			}
 			lhs := sem.NewThis(id, append([]string{"left"}, left...))
 			rhs := sem.NewThis(id, append([]string{"right"}, right...))
-			exprs = append(exprs, sem.NewBinaryExpr(id, "==", lhs, rhs))
+			exprs = append(exprs, sem.NewBinaryExpr(id, usingComparison(style, k, len(cond.Fields)), lhs, rhs))
 		}
 		if len(exprs) == 0 {
 			return badExpr
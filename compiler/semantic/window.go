@@ -0,0 +1,389 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/semantic/sem"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+)
+
+// window is a window function along with the partitioning and ordering
+// of the window over which it is computed.  All of the expressions are
+// relative to the "in" scope of the select (or the "g" scope once
+// groupings have been replaced).
+type window struct {
+	partitionBy []sem.Expr
+	orderBy     []sem.SortExpr
+	fn          *sem.WindowFunc
+	typ         super.Type
+}
+
+func windowTmp(k int) string {
+	return fmt.Sprintf("w%d", k)
+}
+
+func isWindowTmp(s string) bool {
+	if len(s) < 2 || s[0] != 'w' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// windowDefs enters the named windows of a WINDOW clause into the select
+// scope.  A window definition may refer to a previously defined window.
+func (t *translator) windowDefs(scope *selectScope, defs []ast.SQLWindowDef) {
+	for _, def := range defs {
+		name := def.Name.Name
+		if _, ok := scope.windowDefs[name]; ok {
+			t.error(def.Name, fmt.Errorf("window %q is already defined", name))
+			continue
+		}
+		spec := t.resolveWindowSpec(scope, def.Spec)
+		if spec == nil {
+			continue
+		}
+		if scope.windowDefs == nil {
+			scope.windowDefs = make(map[string]*ast.WindowSpec)
+		}
+		scope.windowDefs[name] = spec
+	}
+}
+
+// resolveWindowSpec merges a window specification that refers to a named
+// window with the named window's specification following the rules of
+// the SQL standard: the referencing window may add an ORDER BY clause when
+// the named window has none and may add a frame clause but may not
+// override PARTITION BY.
+func (t *translator) resolveWindowSpec(scope *selectScope, spec *ast.WindowSpec) *ast.WindowSpec {
+	if spec.Name == nil {
+		return spec
+	}
+	name := spec.Name.Name
+	base, ok := scope.windowDefs[name]
+	if !ok {
+		t.error(spec.Name, fmt.Errorf("window %q does not exist", name))
+		return nil
+	}
+	if spec.PartitionBy == nil && spec.OrderBy == nil && spec.Frame == nil {
+		return base
+	}
+	if spec.PartitionBy != nil {
+		t.error(spec, fmt.Errorf("cannot override PARTITION BY clause of window %q", name))
+		return nil
+	}
+	if spec.OrderBy != nil && base.OrderBy != nil {
+		t.error(spec, fmt.Errorf("cannot override ORDER BY clause of window %q", name))
+		return nil
+	}
+	if base.Frame != nil {
+		t.error(spec, fmt.Errorf("cannot copy window %q because it has a frame clause", name))
+		return nil
+	}
+	out := &ast.WindowSpec{
+		PartitionBy: base.PartitionBy,
+		OrderBy:     base.OrderBy,
+		Frame:       spec.Frame,
+		Loc:         spec.Loc,
+	}
+	if spec.OrderBy != nil {
+		out.OrderBy = spec.OrderBy
+	}
+	return out
+}
+
+func (t *translator) windowExpr(e *ast.WindowExpr, inType super.Type) (sem.Expr, super.Type) {
+	scope, ok := t.scope.sql.(*selectScope)
+	if ok && scope.aggScan {
+		return badExpr, t.checker.unknown
+	}
+	if !ok || !scope.windowOk {
+		t.error(e, errors.New("window functions are allowed only in SELECT and QUALIFY clauses"))
+		return badExpr, t.checker.unknown
+	}
+	spec := t.resolveWindowSpec(scope, e.Over)
+	if spec == nil {
+		return badExpr, t.checker.unknown
+	}
+	name, args, filter, ok := t.windowCall(e.Func)
+	if !ok {
+		return badExpr, t.checker.unknown
+	}
+	// Window functions may contain aggregate functions (which are computed
+	// before the window) but may not be nested.
+	scope.windowOk = false
+	defer func() {
+		scope.windowOk = true
+	}()
+	fn := &sem.WindowFunc{
+		Node: e,
+		Name: name,
+	}
+	var argTypes []super.Type
+	for _, arg := range args {
+		e, typ := t.expr(arg, inType)
+		fn.Args = append(fn.Args, e)
+		argTypes = append(argTypes, typ)
+	}
+	var filterType super.Type
+	fn.Filter, filterType = t.exprNullable(filter, inType)
+	var partitionBy []sem.Expr
+	for _, e := range spec.PartitionBy {
+		e, _ := t.expr(e, inType)
+		partitionBy = append(partitionBy, e)
+	}
+	var orderBy []sem.SortExpr
+	for _, s := range spec.OrderBy {
+		orderBy = append(orderBy, t.sortExpr(nil, s, false, inType))
+	}
+	if spec.Frame != nil {
+		fn.Frame = t.windowFrame(spec.Frame, orderBy)
+	}
+	var typ super.Type
+	if _, ok := windowFuncs[name]; ok {
+		typ = t.checker.windowFunc(name, argTypes)
+	} else {
+		var arg ast.Expr
+		var argType super.Type = super.TypeNull
+		if len(args) == 1 {
+			arg, argType = args[0], argTypes[0]
+		}
+		typ = t.checker.aggFunc(name, arg, argType, filter, filterType)
+	}
+	w := window{
+		partitionBy: partitionBy,
+		orderBy:     orderBy,
+		fn:          fn,
+		typ:         typ,
+	}
+	for k, prev := range scope.windows {
+		if eqExprs(w.partitionBy, prev.partitionBy) && eqSortExprs(w.orderBy, prev.orderBy) && eqExpr(w.fn, prev.fn) {
+			return &sem.WindowRef{Node: e, Index: k}, prev.typ
+		}
+	}
+	scope.windows = append(scope.windows, w)
+	return &sem.WindowRef{Node: e, Index: len(scope.windows) - 1}, typ
+}
+
+// windowFuncs holds the argument count limits of the ranking and offset
+// window functions.  Any aggregate function may also be used as a window
+// function.
+var windowFuncs = map[string][2]int{
+	"cume_dist":    {0, 0},
+	"dense_rank":   {0, 0},
+	"first_value":  {1, 1},
+	"lag":          {1, 3},
+	"last_value":   {1, 1},
+	"lead":         {1, 3},
+	"nth_value":    {2, 2},
+	"ntile":        {1, 1},
+	"percent_rank": {0, 0},
+	"rank":         {0, 0},
+	"row_number":   {0, 0},
+}
+
+func (t *translator) windowCall(e ast.Expr) (string, []ast.Expr, ast.Expr, bool) {
+	var name string
+	var args []ast.Expr
+	var filter ast.Expr
+	switch e := e.(type) {
+	case *ast.AggFuncExpr:
+		if e.Distinct {
+			t.error(e, fmt.Errorf("DISTINCT is not supported in window function %q", e.Name))
+			return "", nil, nil, false
+		}
+		name = strings.ToLower(e.Name)
+		if e.Expr != nil {
+			args = []ast.Expr{e.Expr}
+		}
+		filter = e.Filter
+	case *ast.CallExpr:
+		f, ok := e.Func.(*ast.FuncNameExpr)
+		if !ok {
+			t.error(e, errors.New("window function must be called by name"))
+			return "", nil, nil, false
+		}
+		name = strings.ToLower(f.Name)
+		args = e.Args
+	default:
+		t.error(e, errors.New("OVER clause requires a window or aggregate function"))
+		return "", nil, nil, false
+	}
+	if limits, ok := windowFuncs[name]; ok {
+		if filter != nil {
+			t.error(e, fmt.Errorf("FILTER is not supported in window function %q", name))
+			return "", nil, nil, false
+		}
+		if err := function.CheckArgCount(len(args), limits[0], limits[1]); err != nil {
+			t.error(e, err)
+			return "", nil, nil, false
+		}
+		return name, args, nil, true
+	}
+	if _, err := agg.NewPattern(name, false, len(args) > 0); err != nil {
+		t.error(e, fmt.Errorf("%q is not a window or aggregate function", name))
+		return "", nil, nil, false
+	}
+	if err := checkAggArgCount(name, len(args)); err != nil {
+		t.error(e, err)
+		return "", nil, nil, false
+	}
+	if len(args) > 1 {
+		args = []ast.Expr{aggArg(args)}
+	}
+	return name, args, filter, true
+}
+
+var boundRank = map[string]int{
+	"unbounded_preceding": 0,
+	"preceding":           1,
+	"current_row":         2,
+	"following":           3,
+	"unbounded_following": 4,
+}
+
+func (t *translator) windowFrame(frame *ast.WindowFrame, orderBy []sem.SortExpr) *sem.WindowFrame {
+	if frame.From.Type == "unbounded_following" {
+		t.error(frame, errors.New("frame start cannot be UNBOUNDED FOLLOWING"))
+		return nil
+	}
+	if frame.To.Type == "unbounded_preceding" {
+		t.error(frame, errors.New("frame end cannot be UNBOUNDED PRECEDING"))
+		return nil
+	}
+	if boundRank[frame.From.Type] > boundRank[frame.To.Type] {
+		t.error(frame, errors.New("frame start cannot follow frame end"))
+		return nil
+	}
+	start, ok := t.windowBound(frame.Unit, frame.From, orderBy)
+	if !ok {
+		return nil
+	}
+	end, ok := t.windowBound(frame.Unit, frame.To, orderBy)
+	if !ok {
+		return nil
+	}
+	return &sem.WindowFrame{
+		Unit:  frame.Unit,
+		Start: start,
+		End:   end,
+	}
+}
+
+func (t *translator) windowBound(unit string, b ast.WindowBound, orderBy []sem.SortExpr) (sem.WindowBound, bool) {
+	if b.Offset == nil {
+		return sem.WindowBound{Type: b.Type}, true
+	}
+	e, _ := t.expr(b.Offset, super.TypeNull)
+	val, ok := t.mustEval(e)
+	if !ok {
+		return sem.WindowBound{}, false
+	}
+	if val.IsNull() || val.IsError() {
+		t.error(b.Offset, errors.New("frame offset must not be null"))
+		return sem.WindowBound{}, false
+	}
+	id := val.Type().ID()
+	switch unit {
+	case "rows":
+		if !super.IsInteger(id) {
+			t.error(b.Offset, errors.New("ROWS frame offset must be an integer"))
+			return sem.WindowBound{}, false
+		}
+	case "range":
+		if len(orderBy) != 1 {
+			t.error(b.Offset, errors.New("RANGE frame with offset requires exactly one ORDER BY column"))
+			return sem.WindowBound{}, false
+		}
+		if !super.IsNumber(id) && id != super.IDDuration {
+			t.error(b.Offset, errors.New("RANGE frame offset must be a number or duration"))
+			return sem.WindowBound{}, false
+		}
+	}
+	if isNegative(val) {
+		t.error(b.Offset, errors.New("frame offset must not be negative"))
+		return sem.WindowBound{}, false
+	}
+	return sem.WindowBound{Type: b.Type, Offset: sem.NewLiteral(e, val, t.defs)}, true
+}
+
+func isNegative(val super.Value) bool {
+	id := val.Type().ID()
+	switch {
+	case super.IsSigned(id):
+		return val.Int() < 0
+	case super.IsFloat(id):
+		return val.Float() < 0
+	}
+	return false
+}
+
+// genWindows emits a WindowOp for each distinct partitioning and ordering
+// of the windows in scope.  Each WindowOp appends the results of its
+// functions to the input record as fields named by windowTmp.
+func (t *translator) genWindows(loc ast.Node, scope *selectScope, seq sem.Seq) sem.Seq {
+	done := make([]bool, len(scope.windows))
+	for k, w := range scope.windows {
+		if done[k] {
+			continue
+		}
+		op := &sem.WindowOp{
+			Node:        loc,
+			PartitionBy: w.partitionBy,
+			OrderBy:     w.orderBy,
+		}
+		for j := k; j < len(scope.windows); j++ {
+			other := scope.windows[j]
+			if done[j] || !eqExprs(w.partitionBy, other.partitionBy) || !eqSortExprs(w.orderBy, other.orderBy) {
+				continue
+			}
+			done[j] = true
+			op.Funcs = append(op.Funcs, sem.Assignment{
+				Node: other.fn.Node,
+				LHS:  sem.NewThis(other.fn.Node, []string{windowTmp(j)}),
+				RHS:  other.fn,
+			})
+		}
+		seq = append(seq, op)
+	}
+	return seq
+}
+
+// replaceWindowGroupings matches the grouping expressions and aggregate
+// references in the arguments, partitioning, and ordering of each window
+// function of a grouped select.
+func (t *translator) replaceWindowGroupings(scope *selectScope) {
+	for k := range scope.windows {
+		w := &scope.windows[k]
+		for i, e := range w.fn.Args {
+			w.fn.Args[i], _ = replaceGroupings(t, e, scope.groupings)
+		}
+		w.fn.Filter, _ = replaceGroupings(t, w.fn.Filter, scope.groupings)
+		for i, e := range w.partitionBy {
+			w.partitionBy[i], _ = replaceGroupings(t, e, scope.groupings)
+		}
+		for i, s := range w.orderBy {
+			w.orderBy[i].Expr, _ = replaceGroupings(t, s.Expr, scope.groupings)
+		}
+	}
+}
+
+// replaceWindowRefs replaces each WindowRef in e with a reference to the
+// field computed by its WindowOp.
+func replaceWindowRefs(e sem.Expr) sem.Expr {
+	return exprWalk(e, func(e sem.Expr) (sem.Expr, bool) {
+		if ref, ok := e.(*sem.WindowRef); ok {
+			return sem.NewThis(ref.Node, []string{windowTmp(ref.Index)}), true
+		}
+		return e, false
+	})
+}
@@ -86,6 +86,16 @@ func (c *canon) expr(e ast.Expr, parent string) {
 			c.write(" filter ")
 			c.expr(e.Filter, "")
 		}
+	case *ast.WindowExpr:
+		c.expr(e.Func, "")
+		c.write(" over ")
+		if e.Over.Name != nil && e.Over.PartitionBy == nil && e.Over.OrderBy == nil && e.Over.Frame == nil {
+			c.write(e.Over.Name.Name)
+			break
+		}
+		c.write("(")
+		c.windowSpec(e.Over)
+		c.write(")")
 	case *ast.SQLAsExpr:
 		c.expr(e.Expr, "")
 		if e.Label != nil {
@@ -94,7 +104,14 @@ func (c *canon) expr(e ast.Expr, parent string) {
 	case *ast.Primitive:
 		c.literal(*e)
 	case *ast.IDExpr:
-		c.write(e.Name)
+		if strings.HasPrefix(e.Name, "$") {
+			// Quote so the identifier isn't parsed as a query parameter.
+			c.write("`%s`", e.Name)
+		} else {
+			c.write(e.Name)
+		}
+	case *ast.ParamExpr:
+		c.write("$%s", e.Name)
 	case *ast.DoubleQuoteExpr:
 		c.write("%q", e.Text)
 	case *ast.UnaryExpr:
@@ -655,6 +672,7 @@ func (c *canon) op(p ast.Op) {
 			c.write(" as {%s,%s}", p.Alias.Left.Name, p.Alias.Right.Name)
 		}
 		c.joinCond(p.Cond)
+		c.tolerance(p.Tolerance)
 	case *ast.AssignmentOp:
 		c.next()
 		which := "put "
@@ -676,6 +694,8 @@ func (c *canon) op(p ast.Op) {
 		c.exprs(p.Exprs)
 	case *ast.SQLOp:
 		c.sqlQueryBody(p.Body)
+	case *ast.SQLMerge:
+		c.sqlMerge(p)
 	case *ast.OutputOp:
 		c.next()
 		c.write("output %s", p.Name.Name)
@@ -770,6 +790,23 @@ func (c *canon) sqlQueryBody(query ast.SQLQueryBody) {
 			c.write("having ")
 			c.expr(query.Having, "")
 		}
+		if len(query.Windows) > 0 {
+			c.ret()
+			c.write("window ")
+			for i, w := range query.Windows {
+				if i > 0 {
+					c.write(", ")
+				}
+				c.write("%s as (", w.Name.Name)
+				c.windowSpec(w.Spec)
+				c.write(")")
+			}
+		}
+		if query.Qualify != nil {
+			c.ret()
+			c.write("qualify ")
+			c.expr(query.Qualify, "")
+		}
 	case *ast.SQLUnion:
 		c.sqlQueryBody(query.Left)
 		c.ret()
@@ -781,6 +818,17 @@ func (c *canon) sqlQueryBody(query ast.SQLQueryBody) {
 		}
 		c.head = true
 		c.sqlQueryBody(query.Right)
+	case *ast.SQLSetOp:
+		c.sqlQueryBody(query.Left)
+		c.ret()
+		c.write(query.Op)
+		if query.Distinct {
+			c.write(" distinct")
+		} else {
+			c.write(" all")
+		}
+		c.head = true
+		c.sqlQueryBody(query.Right)
 	case *ast.SQLValues:
 		c.next()
 		c.write("values ")
@@ -790,6 +838,51 @@ func (c *canon) sqlQueryBody(query ast.SQLQueryBody) {
 	}
 }
 
+func (c *canon) sqlMerge(m *ast.SQLMerge) {
+	c.next()
+	c.write("merge into ")
+	c.fromItem(m.Target)
+	if m.Alias != nil {
+		c.tableAlias(m.Alias)
+	}
+	c.write(" using ")
+	c.tableExpr(m.Source)
+	c.write(" on ")
+	c.expr(m.Cond, "")
+	for _, clause := range m.Clauses {
+		c.ret()
+		c.write("when ")
+		if !clause.Matched {
+			c.write("not ")
+		}
+		c.write("matched")
+		if clause.Cond != nil {
+			c.write(" and ")
+			c.expr(clause.Cond, "")
+		}
+		c.write(" then ")
+		switch clause.Action {
+		case "update":
+			c.write("update set ")
+			for k, col := range clause.Columns {
+				if k > 0 {
+					c.write(", ")
+				}
+				c.write("%s = ", sup.QuotedName(col.Name))
+				c.expr(clause.Values[k], "")
+			}
+		case "delete":
+			c.write("delete")
+		case "insert":
+			c.write("insert (")
+			c.ids(clause.Columns)
+			c.write(") values (")
+			c.exprs(clause.Values)
+			c.write(")")
+		}
+	}
+}
+
 func (c *canon) funcOrExprs(args []ast.Expr) {
 	for k, a := range args {
 		if k > 0 {
@@ -880,6 +973,7 @@ func (c *canon) tableExpr(e ast.SQLTableExpr) {
 		c.write("join ")
 		c.tableExpr(e.Right)
 		c.joinCond(e.Cond)
+		c.tolerance(e.Tolerance)
 	default:
 		panic(fmt.Sprintf("unknown from expression: %T", e))
 	}
@@ -908,6 +1002,13 @@ func (c *canon) joinCond(e ast.JoinCond) {
 	}
 }
 
+func (c *canon) tolerance(e ast.Expr) {
+	if e != nil {
+		c.write(" tolerance ")
+		c.expr(e, "")
+	}
+}
+
 func (c *canon) unnest(o *ast.UnnestOp) {
 	c.next()
 	c.write("unnest ")
@@ -960,6 +1061,38 @@ func (c *canon) sortExprs(sortExprs []ast.SortExpr) {
 	}
 }
 
+func (c *canon) windowSpec(spec *ast.WindowSpec) {
+	var sep string
+	if spec.Name != nil {
+		c.write(spec.Name.Name)
+		sep = " "
+	}
+	if len(spec.PartitionBy) > 0 {
+		c.write("%spartition by ", sep)
+		c.exprs(spec.PartitionBy)
+		sep = " "
+	}
+	if len(spec.OrderBy) > 0 {
+		c.write("%sorder by", sep)
+		c.sortExprs(spec.OrderBy)
+		sep = " "
+	}
+	if f := spec.Frame; f != nil {
+		c.write("%s%s between ", sep, f.Unit)
+		c.windowBound(f.From)
+		c.write(" and ")
+		c.windowBound(f.To)
+	}
+}
+
+func (c *canon) windowBound(b ast.WindowBound) {
+	if b.Offset != nil {
+		c.expr(b.Offset, "")
+		c.write(" ")
+	}
+	c.write(strings.ReplaceAll(b.Type, "_", " "))
+}
+
 func (c *canon) opArgs(args []ast.OpArg) {
 	if len(args) == 0 {
 		return
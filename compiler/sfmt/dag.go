@@ -7,6 +7,7 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/sup"
 )
 
@@ -74,7 +75,15 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 			distinct = "distinct "
 		}
 		c.write("%s(%s", e.Name, distinct)
-		if e.Expr != nil {
+		if rec, ok := e.Expr.(*dag.RecordExpr); ok && agg.NumArgs(e.Name) > 1 {
+			// Print the arguments that were combined into a record.
+			for k, elem := range rec.Elems {
+				if k > 0 {
+					c.write(", ")
+				}
+				c.expr(elem.(*dag.Field).Value, "")
+			}
+		} else if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
 		c.write(")")
@@ -180,6 +189,20 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 			c.write(e.Op)
 			c.expr(e.Operand, "not")
 		}
+	case *dag.WindowExpr:
+		c.write("%s(", e.Name)
+		c.exprs(e.Args)
+		c.write(")")
+		if e.Filter != nil {
+			c.write(" filter ")
+			c.expr(e.Filter, "")
+		}
+		if f := e.Frame; f != nil {
+			c.write(" %s between ", f.Unit)
+			c.windowBound(f.Start)
+			c.write(" and ")
+			c.windowBound(f.End)
+		}
 	default:
 		c.open("(unknown expr %T)", e)
 		c.close()
@@ -350,6 +373,11 @@ func (c *canonDAG) op(p dag.Op) {
 			c.expr(p.KeyPruner, "")
 			c.write(")")
 		}
+		if p.IndexFilter != nil {
+			c.write(" index (")
+			c.expr(p.IndexFilter, "")
+			c.write(")")
+		}
 		c.close()
 	case *dag.NullScan:
 		c.next()
@@ -457,12 +485,40 @@ func (c *canonDAG) op(p dag.Op) {
 	case *dag.FuseOp:
 		c.next()
 		c.write("fuse")
+	case *dag.AsofJoinOp:
+		c.next()
+		c.write("%s asofjoin as {%s,%s} on ", p.Style, p.LeftAlias, p.RightAlias)
+		if p.LeftKey != nil {
+			c.expr(p.LeftKey, "")
+			c.write("==")
+			c.expr(p.RightKey, "")
+			c.write(" and ")
+		}
+		c.expr(p.LeftOn, "")
+		c.write(p.Op)
+		c.expr(p.RightOn, "")
+		if p.Tolerance != nil {
+			c.write(" tolerance ")
+			c.expr(p.Tolerance, "")
+		}
+		if p.Merge {
+			c.write(" merge")
+		}
 	case *dag.HashJoinOp:
 		c.next()
 		c.write("%s hashjoin as {%s,%s} on ", p.Style, p.LeftAlias, p.RightAlias)
 		c.expr(p.LeftKey, "")
 		c.write("==")
 		c.expr(p.RightKey, "")
+		if p.Build != "" {
+			c.write(" build %s", p.Build)
+		}
+		if p.Partitioned {
+			c.write(" partitioned")
+		}
+		if p.RuntimeFilter {
+			c.write(" runtime filter")
+		}
 	case *dag.HeadOp:
 		c.next()
 		c.write("head %d", p.Count)
@@ -523,6 +579,14 @@ func (c *canonDAG) op(p dag.Op) {
 		}
 		c.close()
 		c.flush()
+	case *dag.SetOp:
+		c.next()
+		c.write(p.Op)
+		if p.Distinct {
+			c.write(" distinct")
+		} else {
+			c.write(" all")
+		}
 	case *dag.SkipOp:
 		c.next()
 		c.write("skip %d", p.Count)
@@ -585,6 +649,22 @@ func (c *canonDAG) op(p dag.Op) {
 		c.next()
 		c.write("values ")
 		c.exprs(p.Exprs)
+	case *dag.WindowOp:
+		c.next()
+		c.open("window")
+		c.ret()
+		c.open()
+		c.assignments(p.Funcs)
+		if len(p.PartitionBy) != 0 {
+			c.write(" partition by ")
+			c.exprs(p.PartitionBy)
+		}
+		if len(p.OrderBy) != 0 {
+			c.write(" order by")
+			c.sortExprs(p.OrderBy)
+		}
+		c.close()
+		c.close()
 	default:
 		c.next()
 		c.open("unknown operator: %T", p)
@@ -630,6 +710,14 @@ func (c *canonDAG) main(main *dag.Main) {
 	c.seq(main.Body)
 }
 
+func (c *canonDAG) windowBound(b dag.WindowBound) {
+	if b.Offset != nil {
+		c.expr(b.Offset, "")
+		c.write(" ")
+	}
+	c.write(strings.ReplaceAll(b.Type, "_", " "))
+}
+
 func (c *canonDAG) sortExprs(sortExprs []dag.SortExpr) {
 	for i, s := range sortExprs {
 		if i > 0 {
@@ -66,6 +66,8 @@ func (s *shared) typ(t ast.Type) {
 		//XXX need to figure out syntax for enum literal which may
 		// be different than SUP, requiring some ast adjustments.
 		s.write("TBD:ENUM")
+	case *ast.TypeDecimal:
+		s.write("decimal(%d,%d)", t.Precision, t.Scale)
 	case *ast.TypeMap:
 		s.write("map{")
 		s.typ(t.KeyType)
@@ -74,11 +74,11 @@ func Concat(inputs []Input) (*List, error) {
 		}
 		// Skip over any empties.
 		if len(bytes) > 0 {
-			files = append(files, newFile(name, b.Len(), bytes))
 			// Separate file content with a newline but only when needed.
 			if needSep {
 				b.WriteByte('\n')
 			}
+			files = append(files, newFile(name, b.Len(), bytes))
 			b.Write(bytes)
 			needSep = !unicode.IsSpace(rune(bytes[len(bytes)-1]))
 		}
@@ -228,6 +228,31 @@ func (c *Context) LookupTypeEnum(symbols []string) *TypeEnum {
 	return typ
 }
 
+// LookupTypeDecimal returns the decimal type with the given precision
+// and scale.
+func (c *Context) LookupTypeDecimal(precision, scale int) (*TypeDecimal, error) {
+	if err := CheckDecimal(precision, scale); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := c.typedefs.LookupTypeDecimal(precision, scale)
+	if typ, ok := c.byID[id]; ok {
+		return typ.(*TypeDecimal), nil
+	}
+	typ := NewTypeDecimal(int(id), precision, scale)
+	c.byID[id] = typ
+	return typ, nil
+}
+
+func (c *Context) MustLookupTypeDecimal(precision, scale int) *TypeDecimal {
+	typ, err := c.LookupTypeDecimal(precision, scale)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
 // LookupByName returns the named type last bound to name by LookupTypeNamed.
 // It returns nil if name is unbound.
 func (c *Context) LookupByName(name string) *TypeNamed {
@@ -592,6 +617,20 @@ func (c *Context) DecodeTypeValue(tv []byte) (Type, []byte) {
 			return nil, nil
 		}
 		return typ, tv
+	case IDDecimal32, IDDecimal64, IDDecimal128, IDDecimal256:
+		precision, tv := DecodeLength(tv)
+		if tv == nil {
+			return nil, nil
+		}
+		scale, tv := DecodeLength(tv)
+		if tv == nil || DecimalWidth(precision) != int(id) {
+			return nil, nil
+		}
+		typ, err := c.LookupTypeDecimal(precision, scale)
+		if err != nil {
+			return nil, nil
+		}
+		return typ, tv
 	default:
 		typ, err := LookupPrimitiveByID(int(id))
 		if err != nil {
@@ -776,15 +815,16 @@ func (t *TypeCache) LookupType(id int) (Type, error) {
 }
 
 const (
-	TypeDefRecord = 0
-	TypeDefArray  = 1
-	TypeDefSet    = 2
-	TypeDefMap    = 3
-	TypeDefUnion  = 4
-	TypeDefEnum   = 5
-	TypeDefError  = 6
-	TypeDefNamed  = 7
-	TypeDefFusion = 8
+	TypeDefRecord  = 0
+	TypeDefArray   = 1
+	TypeDefSet     = 2
+	TypeDefMap     = 3
+	TypeDefUnion   = 4
+	TypeDefEnum    = 5
+	TypeDefError   = 6
+	TypeDefNamed   = 7
+	TypeDefFusion  = 8
+	TypeDefDecimal = 9
 )
 
 // Different modes for named types:
@@ -897,6 +937,8 @@ func (t *TypeDefs) LookupType(ext Type) uint32 {
 		return t.LookupTypeNamed(ext.Name, ext.Type)
 	case *TypeFusion:
 		return t.LookupTypeWrapped(TypeDefFusion, ext.Type)
+	case *TypeDecimal:
+		return t.LookupTypeDecimal(ext.Precision, ext.Scale)
 	default:
 		panic(ext)
 	}
@@ -986,6 +1028,14 @@ func (t *TypeDefs) LookupTypeEnum(symbols []string) uint32 {
 	return t.Lookup(at)
 }
 
+func (t *TypeDefs) LookupTypeDecimal(precision, scale int) uint32 {
+	at := len(t.bytes)
+	t.bytes = append(t.bytes, TypeDefDecimal)
+	t.bytes = binary.AppendUvarint(t.bytes, uint64(precision))
+	t.bytes = binary.AppendUvarint(t.bytes, uint64(scale))
+	return t.Lookup(at)
+}
+
 func (t *TypeDefs) LookupTypeNamed(name string, inner Type) uint32 {
 	if id, ok := t.lookupName(name); ok {
 		return id
@@ -1115,7 +1165,7 @@ func (t *TypeDefs) loops(id uint32, scoreboard map[string]comp) {
 			id, b = MustDecodeID(b)
 			t.loops(id, scoreboard)
 		}
-	case TypeDefEnum:
+	case TypeDefEnum, TypeDefDecimal:
 	case TypeDefError:
 		id, _ := MustDecodeID(b)
 		t.loops(id, scoreboard)
@@ -1200,6 +1250,16 @@ func (d *TypeDefs) AppendBytes(bytes []byte) bool {
 					return false
 				}
 			}
+		case TypeDefDecimal:
+			var precision, scale int
+			precision, bytes = DecodeLength(bytes)
+			if bytes == nil {
+				return false
+			}
+			scale, bytes = DecodeLength(bytes)
+			if bytes == nil || CheckDecimal(precision, scale) != nil {
+				return false
+			}
 		default:
 			return false
 		}
@@ -1302,7 +1362,13 @@ func (t *TypeDefsMapper) lookupType(id uint32) Type {
 		// At this point, this name has not been declared and is not
 		// in an existing mutually recursive loop that is being resolved.
 		// So we can see if it initiates a loop, and otherwise, just resolve it.
-		compIDs := t.conncomps(id)
+		// Components already being patched by a call frame up the stack
+		// (e.g., a named type referenced more than once from within another
+		// named type) must not be declared again here.
+		compIDs := slices.DeleteFunc(t.conncomps(id), func(c compID) bool {
+			_, ok := t.patches[c.name]
+			return ok
+		})
 		if len(compIDs) == 0 {
 			// There's no loop to deal with...
 			inner := t.LookupType(innerID)
@@ -1450,6 +1516,20 @@ func (t *TypeDefsMapper) lookupType(id uint32) Type {
 			return nil
 		}
 		return t.sctx.LookupTypeFusion(inner)
+	case TypeDefDecimal:
+		precision, b := DecodeLength(b)
+		if b == nil {
+			return nil
+		}
+		scale, b := DecodeLength(b)
+		if b == nil {
+			return nil
+		}
+		typ, err := t.sctx.LookupTypeDecimal(precision, scale)
+		if err != nil {
+			return nil
+		}
+		return typ
 	default:
 		panic(id)
 	}
@@ -1578,6 +1658,14 @@ func (t *TypeDefsMerger) LookupID(extID uint32) uint32 {
 		at = len(t.bytes)
 		t.bytes = append(t.bytes, TypeDefFusion)
 		t.bytes = binary.AppendUvarint(t.bytes, uint64(id))
+	case TypeDefDecimal:
+		var precision, scale int
+		precision, bytes = MustDecodeLength(bytes)
+		scale, _ = MustDecodeLength(bytes)
+		at = len(t.bytes)
+		t.bytes = append(t.bytes, TypeDefDecimal)
+		t.bytes = binary.AppendUvarint(t.bytes, uint64(precision))
+		t.bytes = binary.AppendUvarint(t.bytes, uint64(scale))
 	default:
 		panic(id)
 	}
@@ -1,9 +1,15 @@
 package super_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/brimdata/super/sio/supio"
+	"github.com/brimdata/super/sup"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,3 +48,26 @@ func TestContextLookupTypeNamedAndLookupTypeDef(t *testing.T) {
 	require.NoError(t, err)
 	assert.Same(t, named1, sctx.LookupByName("x"))
 }
+
+func TestContextNamedTypeReferencedTwiceInNamedType(t *testing.T) {
+	// A named type referenced more than once from within another named
+	// type looks like a loop to the connected components analysis, which
+	// must not cause the inner type to be declared twice.
+	const s = `
+type p=[string]
+type inner={a:p,b:p}
+type outer={x:inner}
+{x:{a:["a"],b:["b"]}}::outer
+`
+	val, err := supio.NewReader(super.NewContext(), strings.NewReader(s)).Read()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w := bsupio.NewWriter(sio.NopCloser(&buf))
+	require.NoError(t, w.Write(*val))
+	require.NoError(t, w.Close())
+	r := bsupio.NewReader(super.NewContext(), &buf)
+	defer r.Close()
+	out, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, sup.String(*val), sup.String(*out))
+}
@@ -0,0 +1,61 @@
+package csup
+
+import (
+	"io"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"golang.org/x/sync/errgroup"
+)
+
+type BigIntEncoder struct {
+	typ      super.Type
+	bytes    *BytesTableEncoder
+	min, max []byte
+}
+
+func NewBigIntEncoder(vec *vector.BigInt) *BigIntEncoder {
+	return &BigIntEncoder{
+		typ:   vec.Typ,
+		bytes: &BytesTableEncoder{table: vec.Table()},
+	}
+}
+
+func (b *BigIntEncoder) Encode(group *errgroup.Group) {
+	b.bytes.Encode(group)
+	group.Go(func() error {
+		table := b.bytes.table
+		if table.Len() == 0 {
+			return nil
+		}
+		vec := vector.NewBigInt(b.typ, table)
+		min, max := vec.Value(0), vec.Value(0)
+		b.min, b.max = table.Bytes(0), table.Bytes(0)
+		for i := range table.Len() {
+			v := vec.Value(i)
+			if v.Cmp(min) < 0 {
+				min, b.min = v, table.Bytes(i)
+			}
+			if v.Cmp(max) > 0 {
+				max, b.max = v, table.Bytes(i)
+			}
+		}
+		return nil
+	})
+}
+
+func (b *BigIntEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
+	off, bytesLoc, offsLoc := b.bytes.Segment(off)
+	return off, cctx.enter(&BigInt{
+		Typ:     b.typ,
+		Bytes:   bytesLoc,
+		Offsets: offsLoc,
+		Min:     b.min,
+		Max:     b.max,
+		Count:   b.bytes.table.Len(),
+	})
+}
+
+func (b *BigIntEncoder) Emit(w io.Writer) error {
+	return b.bytes.Emit(w)
+}
@@ -0,0 +1,62 @@
+package csup
+
+import (
+	"io"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"golang.org/x/sync/errgroup"
+)
+
+type DecimalEncoder struct {
+	typ      *super.TypeDecimal
+	bytes    *BytesTableEncoder
+	min, max []byte
+}
+
+func NewDecimalEncoder(vec *vector.Decimal) *DecimalEncoder {
+	return &DecimalEncoder{
+		typ:   vec.Typ,
+		bytes: &BytesTableEncoder{table: vec.Table()},
+	}
+}
+
+func (d *DecimalEncoder) Encode(group *errgroup.Group) {
+	d.bytes.Encode(group)
+	group.Go(func() error {
+		table := d.bytes.table
+		if table.Len() == 0 {
+			return nil
+		}
+		min, max := super.DecodeDecimal(table.Bytes(0)), super.DecodeDecimal(table.Bytes(0))
+		d.min, d.max = table.Bytes(0), table.Bytes(0)
+		for i := range table.Len() {
+			b := table.Bytes(i)
+			v := super.DecodeDecimal(b)
+			if v.Cmp(min) < 0 {
+				min, d.min = v, b
+			}
+			if v.Cmp(max) > 0 {
+				max, d.max = v, b
+			}
+		}
+		return nil
+	})
+}
+
+func (d *DecimalEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
+	off, bytesLoc, offsLoc := d.bytes.Segment(off)
+	return off, cctx.enter(&Decimal{
+		Precision: d.typ.Precision,
+		Scale:     d.typ.Scale,
+		Bytes:     bytesLoc,
+		Offsets:   offsLoc,
+		Min:       d.min,
+		Max:       d.max,
+		Count:     d.bytes.table.Len(),
+	})
+}
+
+func (d *DecimalEncoder) Emit(w io.Writer) error {
+	return d.bytes.Emit(w)
+}
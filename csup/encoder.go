@@ -112,6 +112,10 @@ func NewPrimitiveEncoder(cctx *Context, vec vector.Any, root bool) Encoder {
 			return NewPrimitiveEncoder(cctx, out, false)
 		}
 		return NewBytesEncoder(super.TypeBytes, vec.Table())
+	case *vector.Decimal:
+		return NewDecimalEncoder(vec)
+	case *vector.BigInt:
+		return NewBigIntEncoder(vec)
 	case *vector.IP:
 		if root {
 			out := maybeConvertToDictOrConst(vec.Values, func(vals []netip.Addr) vector.Any {
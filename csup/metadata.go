@@ -212,6 +212,37 @@ func (b *Bytes) Len(*Context) uint32 {
 	return b.Count
 }
 
+type Decimal struct {
+	Precision int
+	Scale     int
+	Bytes     Segment
+	Offsets   Segment
+	Min       []byte
+	Max       []byte
+	Count     uint32
+}
+
+func (d *Decimal) Len(*Context) uint32 {
+	return d.Count
+}
+
+type BigInt struct {
+	Typ     super.Type `super:"Type"`
+	Bytes   Segment
+	Offsets Segment
+	Min     []byte
+	Max     []byte
+	Count   uint32
+}
+
+func (b *BigInt) Type(*Context, *super.Context) super.Type {
+	return b.Typ
+}
+
+func (b *BigInt) Len(*Context) uint32 {
+	return b.Count
+}
+
 type TypeValue struct {
 	Location Segment
 	Length   uint32
@@ -373,6 +404,19 @@ func metadataValue(cctx *Context, sctx *super.Context, b *scode.Builder, id ID,
 		return metadataLeaf(sctx, b, super.NewFloat(m.Typ, m.Min), super.NewFloat(m.Typ, m.Max))
 	case *Bytes:
 		return metadataLeaf(sctx, b, super.NewValue(m.Typ, m.Min), super.NewValue(m.Typ, m.Max))
+	case *Decimal:
+		if m.Count == 0 {
+			b.Append(nil)
+			return super.TypeNull
+		}
+		typ := sctx.MustLookupTypeDecimal(m.Precision, m.Scale)
+		return metadataLeaf(sctx, b, super.NewValue(typ, m.Min), super.NewValue(typ, m.Max))
+	case *BigInt:
+		if m.Count == 0 {
+			b.Append(nil)
+			return super.TypeNull
+		}
+		return metadataLeaf(sctx, b, super.NewValue(m.Typ, m.Min), super.NewValue(m.Typ, m.Max))
 	case *IP:
 		return metadataLeaf(sctx, b, super.NewIP(m.Min), super.NewIP(m.Max))
 	case *Net:
@@ -412,6 +456,8 @@ var Template = []any{
 	Uint{},
 	Float{},
 	Bytes{},
+	Decimal{},
+	BigInt{},
 	TypeValue{},
 	Named{},
 	Error{},
@@ -12,6 +12,7 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
@@ -27,12 +28,15 @@ import (
 
 type Interface interface {
 	Root() *db.Root
-	Query(ctx context.Context, query []srcfiles.Input) (vio.Scanner, error)
+	Query(ctx context.Context, query []srcfiles.Input, params map[string]string) (vio.Scanner, error)
 	PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
 	CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error)
 	CreatePool(context.Context, string, order.SortKeys, int64) (ksuid.KSUID, error)
 	RemovePool(context.Context, ksuid.KSUID) error
 	RenamePool(context.Context, ksuid.KSUID, string) error
+	SetRetention(context.Context, ksuid.KSUID, pools.Retention) error
+	AddIndexRules(context.Context, ksuid.KSUID, []index.Rule) error
+	DeleteIndexRules(context.Context, ksuid.KSUID, []string) ([]index.Rule, error)
 	CreateBranch(ctx context.Context, pool ksuid.KSUID, name string, parent ksuid.KSUID) error
 	RemoveBranch(ctx context.Context, pool ksuid.KSUID, branchName string) error
 	MergeBranch(ctx context.Context, pool ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error)
@@ -40,9 +44,13 @@ type Interface interface {
 	Load(ctx context.Context, sctx *super.Context, pool ksuid.KSUID, branch string, r sio.Reader, message api.CommitMessage) (ksuid.KSUID, error)
 	Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, tags []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error)
+	UpdateWhere(ctx context.Context, poolID ksuid.KSUID, branchName, where, set string, commit api.CommitMessage) (ksuid.KSUID, error)
+	MergeInto(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error)
 	Revert(ctx context.Context, poolID ksuid.KSUID, branch string, commitID ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error)
 	AddVectors(ctx context.Context, pool, revision string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	DeleteVectors(ctx context.Context, pool, revision string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
+	UpdateIndexes(ctx context.Context, poolID ksuid.KSUID, branchName string) ([]ksuid.KSUID, error)
+	Retain(ctx context.Context, poolID ksuid.KSUID, branchName string, dryrun bool, message api.CommitMessage) (api.RetainResponse, error)
 	Vacate(ctx context.Context, pool string, time nano.Ts, dryrun bool) ([]ksuid.KSUID, error)
 	Vacuum(ctx context.Context, pool, revision string, dryrun bool) ([]ksuid.KSUID, error)
 }
@@ -61,7 +69,7 @@ func IsRemote(u string) bool {
 func LookupPoolByName(ctx context.Context, api Interface, name string) (*pools.Config, error) {
 	b := newBuffer(pools.Config{})
 	query := fmt.Sprintf("from :pools | name == '%s'", name)
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +93,7 @@ func LookupPoolByName(ctx context.Context, api Interface, name string) (*pools.C
 
 func GetPools(ctx context.Context, api Interface) ([]*pools.Config, error) {
 	b := newBuffer(pools.Config{})
-	q, err := api.Query(ctx, srcfiles.Plain("from :pools"))
+	q, err := api.Query(ctx, srcfiles.Plain("from :pools"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +111,7 @@ func GetPools(ctx context.Context, api Interface) ([]*pools.Config, error) {
 func LookupPoolByID(ctx context.Context, api Interface, id ksuid.KSUID) (*pools.Config, error) {
 	b := newBuffer(pools.Config{})
 	query := fmt.Sprintf("from :pools | id == hex('%s')", idToHex(id))
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +136,7 @@ func LookupPoolByID(ctx context.Context, api Interface, id ksuid.KSUID) (*pools.
 func LookupBranchByName(ctx context.Context, api Interface, poolName, branchName string) (*db.BranchMeta, error) {
 	b := newBuffer(db.BranchMeta{})
 	query := fmt.Sprintf("from :branches | pool.name == '%s' branch.name == '%s'", poolName, branchName)
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +161,7 @@ func LookupBranchByName(ctx context.Context, api Interface, poolName, branchName
 func LookupBranchByID(ctx context.Context, api Interface, id ksuid.KSUID) (*db.BranchMeta, error) {
 	b := newBuffer(db.BranchMeta{})
 	query := fmt.Sprintf("from :branches | branch.id == 'hex(%s)'", idToHex(id))
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +197,7 @@ func GetCommit(ctx context.Context, api Interface, pool, revision string) (*comm
 	}
 	b := newBuffer(commits.Commit{})
 	query := fmt.Sprintf("from %q:log | where id == 0x%s", poolID, idToHex(commit))
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/brimdata/super/compiler/parser"
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/nano"
@@ -86,6 +88,21 @@ func (l *local) RenamePool(ctx context.Context, id ksuid.KSUID, name string) err
 	return l.db.RenamePool(ctx, id, name)
 }
 
+func (l *local) SetRetention(ctx context.Context, id ksuid.KSUID, retention pools.Retention) error {
+	if retention.Age < 0 || retention.Commits < 0 {
+		return errors.New("retention age and commits cannot be negative")
+	}
+	return l.db.SetRetention(ctx, id, retention)
+}
+
+func (l *local) AddIndexRules(ctx context.Context, id ksuid.KSUID, rules []index.Rule) error {
+	return l.db.AddIndexRules(ctx, id, rules)
+}
+
+func (l *local) DeleteIndexRules(ctx context.Context, id ksuid.KSUID, names []string) ([]index.Rule, error) {
+	return l.db.DeleteIndexRules(ctx, id, names)
+}
+
 func (l *local) CreateBranch(ctx context.Context, poolID ksuid.KSUID, name string, parent ksuid.KSUID) error {
 	_, err := l.db.CreateBranch(ctx, poolID, name, parent)
 	return err
@@ -107,11 +124,12 @@ func (l *local) Compact(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	return exec.Compact(ctx, l.db, pool, branchName, objects, writeVectors, commit.Author, commit.Body, commit.Meta)
 }
 
-func (l *local) Query(ctx context.Context, inputs []srcfiles.Input) (vio.Scanner, error) {
+func (l *local) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (vio.Scanner, error) {
 	ast, err := parser.ParseFiles(inputs)
 	if err != nil {
 		return nil, err
 	}
+	ast.SetParams(params)
 	q, err := runtime.CompileQueryForDB(ctx, super.NewContext(), l.compiler, ast)
 	if err != nil {
 		return nil, err
@@ -179,6 +197,34 @@ func (l *local) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName,
 	return branch.DeleteWhere(ctx, l.compiler, ast, commit.Author, commit.Body, commit.Meta)
 }
 
+func (l *local) UpdateWhere(ctx context.Context, poolID ksuid.KSUID, branchName, where, set string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	whereAST, err := parser.ParseFiles(srcfiles.Plain(where))
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	setAST, err := parser.ParseFiles(srcfiles.Plain(set))
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.UpdateWhere(ctx, l.compiler, whereAST, setAST, commit.Author, commit.Body, commit.Meta)
+}
+
+func (l *local) MergeInto(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	ast, err := parser.ParseFiles(srcfiles.Plain(src))
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return branch.MergeInto(ctx, l.compiler, ast, commit.Author, commit.Body, commit.Meta)
+}
+
 func (l *local) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
 	return l.db.Revert(ctx, poolID, branchName, commitID, message.Author, message.Body)
 }
@@ -207,6 +253,30 @@ func (l *local) DeleteVectors(ctx context.Context, pool, revision string, ids []
 	return branch.DeleteVectors(ctx, ids, message.Author, message.Body)
 }
 
+func (l *local) UpdateIndexes(ctx context.Context, poolID ksuid.KSUID, branchName string) ([]ksuid.KSUID, error) {
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return nil, err
+	}
+	return branch.UpdateIndexes(ctx)
+}
+
+func (l *local) Retain(ctx context.Context, poolID ksuid.KSUID, branchName string, dryrun bool, message api.CommitMessage) (api.RetainResponse, error) {
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	if err != nil {
+		return api.RetainResponse{}, err
+	}
+	r, err := branch.Retain(ctx, nano.Now(), dryrun, message.Author, message.Body)
+	if err != nil {
+		return api.RetainResponse{}, err
+	}
+	return api.RetainResponse{
+		Commit:    r.Commit,
+		ObjectIDs: r.Objects,
+		CommitIDs: r.Commits,
+	}, nil
+}
+
 func (l *local) Vacate(ctx context.Context, pool string, ts nano.Ts, dryrun bool) ([]ksuid.KSUID, error) {
 	poolID, err := l.PoolID(ctx, pool)
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 	"github.com/brimdata/super/api/queryio"
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
@@ -101,6 +103,24 @@ func (r *remote) RenamePool(ctx context.Context, pool ksuid.KSUID, name string)
 	return r.conn.RenamePool(ctx, pool, api.PoolPutRequest{Name: name})
 }
 
+func (r *remote) SetRetention(ctx context.Context, pool ksuid.KSUID, retention pools.Retention) error {
+	return r.conn.SetRetention(ctx, pool, api.RetentionPutRequest{Age: retention.Age, Commits: retention.Commits})
+}
+
+func (r *remote) AddIndexRules(ctx context.Context, pool ksuid.KSUID, rules []index.Rule) error {
+	return r.conn.AddIndexRules(ctx, pool, api.IndexRulesPostRequest{Rules: rules})
+}
+
+func (r *remote) DeleteIndexRules(ctx context.Context, pool ksuid.KSUID, names []string) ([]index.Rule, error) {
+	res, err := r.conn.DeleteIndexRules(ctx, pool, names)
+	return res.Rules, err
+}
+
+func (r *remote) UpdateIndexes(ctx context.Context, poolID ksuid.KSUID, branchName string) ([]ksuid.KSUID, error) {
+	res, err := r.conn.UpdateIndexes(ctx, poolID, branchName)
+	return res.ObjectIDs, err
+}
+
 func (r *remote) Load(ctx context.Context, _ *super.Context, poolID ksuid.KSUID, branchName string, reader sio.Reader, commit api.CommitMessage) (ksuid.KSUID, error) {
 	pr, pw := io.Pipe()
 	go func() {
@@ -120,8 +140,8 @@ func (r *remote) Revert(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	return res.Commit, err
 }
 
-func (r *remote) Query(ctx context.Context, inputs []srcfiles.Input) (vio.Scanner, error) {
-	res, err := r.conn.Query(ctx, inputs)
+func (r *remote) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (vio.Scanner, error) {
+	res, err := r.conn.Query(ctx, inputs, params)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +158,16 @@ func (r *remote) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName
 	return res.Commit, err
 }
 
+func (r *remote) UpdateWhere(ctx context.Context, poolID ksuid.KSUID, branchName, where, set string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.UpdateWhere(ctx, poolID, branchName, where, set, commit)
+	return res.Commit, err
+}
+
+func (r *remote) MergeInto(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error) {
+	res, err := r.conn.MergeInto(ctx, poolID, branchName, src, commit)
+	return res.Commit, err
+}
+
 func (r *remote) AddVectors(ctx context.Context, pool, revision string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
 	res, err := r.conn.AddVectors(ctx, pool, revision, objects, message)
 	return res.Commit, err
@@ -148,6 +178,10 @@ func (r *remote) DeleteVectors(ctx context.Context, pool, revision string, ids [
 	return res.Commit, err
 }
 
+func (r *remote) Retain(ctx context.Context, poolID ksuid.KSUID, branchName string, dryrun bool, message api.CommitMessage) (api.RetainResponse, error) {
+	return r.conn.Retain(ctx, poolID, branchName, dryrun, message)
+}
+
 func (r *remote) Vacate(ctx context.Context, pool string, ts nano.Ts, dryrun bool) ([]ksuid.KSUID, error) {
 	res, err := r.conn.Vacate(ctx, pool, ts, dryrun)
 	return res.CommitIDs, err
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/segmentio/ksuid"
+)
+
+// CreateIndex writes the index of an existing Object for rules, replacing
+// any existing index.
+func CreateIndex(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID, rules []index.Rule) error {
+	get, err := engine.Get(ctx, SequenceURI(path, id))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// Make a cleaner error.
+			err = fmt.Errorf("object %s: %w", id, fs.ErrNotExist)
+		}
+		return err
+	}
+	defer get.Close()
+	reader := bsupio.NewReader(super.NewContext(), get)
+	defer reader.Close()
+	builder := index.NewBuilder(rules)
+	if builder == nil {
+		return DeleteIndex(ctx, engine, path, id)
+	}
+	for {
+		val, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			break
+		}
+		builder.Add(*val)
+	}
+	return builder.Object().Write(ctx, engine, IndexURI(path, id))
+}
+
+// DeleteIndex deletes the index of an Object.  It is not an error if
+// the Object has no index.
+func DeleteIndex(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID) error {
+	if err := engine.Delete(ctx, IndexURI(path, id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
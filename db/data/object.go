@@ -124,6 +124,14 @@ func VectorURI(path *storage.URI, id ksuid.KSUID) *storage.URI {
 	return path.JoinPath(fmt.Sprintf("%s.csup", id))
 }
 
+func (o Object) IndexURI(path *storage.URI) *storage.URI {
+	return IndexURI(path, o.ID)
+}
+
+func IndexURI(path *storage.URI, id ksuid.KSUID) *storage.URI {
+	return path.JoinPath(fmt.Sprintf("%s-index.bsup", id))
+}
+
 // Remove deletes the object.
 // Any 'not found' errors are ignored.
 func (o Object) Remove(ctx context.Context, engine storage.Engine, path *storage.URI) error {
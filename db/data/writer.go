@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/bufwriter"
 	"github.com/brimdata/super/pkg/storage"
@@ -20,12 +21,15 @@ type Writer struct {
 	writer      *bsupio.Writer
 	sortKey     order.SortKey
 	first       bool
+	engine      storage.Engine
+	path        *storage.URI
+	index       *index.Builder
 }
 
 // NewWriter returns a writer for writing the data of a BSUP object.  We assume all records are
 // non-volatile until Close as super.Values from the various record bodies are referenced across
-// calls to Write.
-func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *storage.URI, sortKey order.SortKey) (*Writer, error) {
+// calls to Write.  If rules is not empty, Close also writes the object's index.
+func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *storage.URI, sortKey order.SortKey, rules []index.Rule) (*Writer, error) {
 	out, err := engine.Put(ctx, o.SequenceURI(path))
 	if err != nil {
 		return nil, err
@@ -37,6 +41,9 @@ func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *sto
 		writer:      bsupio.NewWriter(counter),
 		sortKey:     sortKey,
 		first:       true,
+		engine:      engine,
+		path:        path,
+		index:       index.NewBuilder(rules),
 	}, nil
 }
 
@@ -50,6 +57,9 @@ func (w *Writer) WriteWithKey(key, val super.Value) error {
 	if err := w.writer.Write(val); err != nil {
 		return err
 	}
+	if w.index != nil {
+		w.index.Add(val)
+	}
 	if w.first {
 		w.first = false
 		w.object.Min.CopyFrom(key)
@@ -74,6 +84,9 @@ func (w *Writer) Close(ctx context.Context) error {
 	if w.sortKey.Order == order.Desc {
 		w.object.Min, w.object.Max = w.object.Max, w.object.Min
 	}
+	if w.index != nil {
+		return w.index.Object().Write(ctx, w.engine, w.object.IndexURI(w.path))
+	}
 	return nil
 }
 
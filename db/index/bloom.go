@@ -0,0 +1,64 @@
+package index
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// falsePositiveRate is the target false positive rate of bloom filters.
+const falsePositiveRate = 0.01
+
+// Bloom is a bloom filter of the keys of a set of values.
+type Bloom struct {
+	Bits   []byte `super:"bits"`
+	Hashes int    `super:"hashes"`
+}
+
+func newBloom(keys map[string]struct{}) *Bloom {
+	n := max(len(keys), 1)
+	m := int(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k := max(int(math.Round(float64(m)/float64(n)*math.Ln2)), 1)
+	b := &Bloom{
+		Bits:   make([]byte, (m+7)/8),
+		Hashes: k,
+	}
+	for key := range keys {
+		b.add(key)
+	}
+	return b
+}
+
+func (b *Bloom) add(key string) {
+	h1, h2 := hashes(key)
+	nbits := uint64(len(b.Bits)) * 8
+	for i := range uint64(b.Hashes) {
+		bit := (h1 + i*h2) % nbits
+		b.Bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// mayContain returns false if key is definitely not in the filter.
+func (b *Bloom) mayContain(key string) bool {
+	if len(b.Bits) == 0 {
+		return true
+	}
+	h1, h2 := hashes(key)
+	nbits := uint64(len(b.Bits)) * 8
+	for i := range uint64(b.Hashes) {
+		bit := (h1 + i*h2) % nbits
+		if b.Bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hashes returns two independent hashes of key for double hashing.
+func hashes(key string) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	h1 := h.Sum64()
+	h = fnv.New64()
+	h.Write([]byte(key))
+	return h1, h.Sum64() | 1
+}
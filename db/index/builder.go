@@ -0,0 +1,123 @@
+package index
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/brimdata/super"
+)
+
+const (
+	// MaxValues is the maximum number of distinct values held by a value
+	// entry.  A field with more values leaves the entry incomplete.
+	MaxValues = 1000
+	// maxBloomKeys bounds the memory used to build a bloom entry.
+	maxBloomKeys = 1 << 20
+)
+
+// Builder computes the index of a data object from the object's values.
+type Builder struct {
+	entries []*entryBuilder
+}
+
+type entryBuilder struct {
+	Entry
+	keys map[string]struct{}
+}
+
+// NewBuilder returns a Builder for rules or nil if there are no rules.
+func NewBuilder(rules []Rule) *Builder {
+	if len(rules) == 0 {
+		return nil
+	}
+	b := &Builder{}
+	for _, r := range rules {
+		e := &entryBuilder{
+			Entry: Entry{Rule: r.Name, Kind: r.Kind, Field: r.Field},
+		}
+		if r.Kind != KindMinMax {
+			e.keys = make(map[string]struct{})
+		}
+		b.entries = append(b.entries, e)
+	}
+	return b
+}
+
+// Add adds val to the index.
+func (b *Builder) Add(val super.Value) {
+	for _, e := range b.entries {
+		if !e.Incomplete {
+			if v := val.DerefPath(e.Field); v != nil {
+				e.add(v.Under())
+			}
+		}
+	}
+}
+
+func (e *entryBuilder) add(val super.Value) {
+	if e.Kind == KindMinMax {
+		e.addMinMax(val)
+		return
+	}
+	key, ok, indexable := key(val)
+	switch {
+	case !indexable:
+		e.Incomplete = true
+	case ok:
+		e.keys[key] = struct{}{}
+		if e.Kind == KindValue && len(e.keys) > MaxValues || len(e.keys) > maxBloomKeys {
+			e.Incomplete = true
+		}
+	}
+	if e.Incomplete {
+		e.keys = nil
+	}
+}
+
+func (e *entryBuilder) addMinMax(val super.Value) {
+	if val.IsNull() || val.IsError() {
+		return
+	}
+	f, ok, indexable := number(val)
+	switch {
+	case !indexable:
+		e.Incomplete = true
+	case ok:
+		if e.NumMin == nil {
+			e.NumMin, e.NumMax = new(float64), new(float64)
+			*e.NumMin, *e.NumMax = f, f
+		}
+		*e.NumMin = min(*e.NumMin, f)
+		*e.NumMax = max(*e.NumMax, f)
+	case val.Type().ID() == super.IDString:
+		s := super.DecodeString(val.Bytes())
+		if e.StrMin == nil {
+			e.StrMin, e.StrMax = new(string), new(string)
+			*e.StrMin, *e.StrMax = s, s
+		}
+		if s < *e.StrMin {
+			*e.StrMin = s
+		}
+		if s > *e.StrMax {
+			*e.StrMax = s
+		}
+	}
+}
+
+// Object returns the index of the values added to b.
+func (b *Builder) Object() *Object {
+	o := &Object{}
+	for _, e := range b.entries {
+		entry := e.Entry
+		if !entry.Incomplete {
+			switch entry.Kind {
+			case KindBloom:
+				entry.Bloom = newBloom(e.keys)
+			case KindValue:
+				entry.Values = slices.Sorted(maps.Keys(e.keys))
+			}
+		}
+		o.Entries = append(o.Entries, entry)
+	}
+	return o
+}
@@ -0,0 +1,147 @@
+package index
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+)
+
+// Filter decides from the index of a data object whether the object can be
+// skipped by a query.
+type Filter interface {
+	// Prune returns true if no value in the object indexed by o can
+	// satisfy the filter.  A nil o never prunes.
+	Prune(o *Object) bool
+}
+
+// NewAnd returns a Filter that prunes if either lhs or rhs prunes.  Either
+// may be nil.
+func NewAnd(lhs, rhs Filter) Filter {
+	if lhs == nil {
+		return rhs
+	}
+	if rhs == nil {
+		return lhs
+	}
+	return &and{lhs, rhs}
+}
+
+// NewOr returns a Filter that prunes if both lhs and rhs prune or nil if
+// either is nil.
+func NewOr(lhs, rhs Filter) Filter {
+	if lhs == nil || rhs == nil {
+		return nil
+	}
+	return &or{lhs, rhs}
+}
+
+// NewCompare returns a Filter for the comparison of the value at path
+// with val using op, which is one of "==", "<", "<=", ">", or ">=".  It
+// returns nil if op is not one of these.
+func NewCompare(op string, path field.Path, val super.Value) Filter {
+	switch op {
+	case "==", "<", "<=", ">", ">=":
+	default:
+		return nil
+	}
+	val = val.Under()
+	c := &compare{op: op, path: path}
+	c.key, c.keyOK, _ = key(val)
+	c.num, c.isNum, _ = number(val)
+	if !c.isNum && !val.IsNull() && val.Type().ID() == super.IDString {
+		c.str, c.isStr = super.DecodeString(val.Bytes()), true
+	}
+	return c
+}
+
+// NewIn returns a Filter for the comparison of the value at path with
+// each of vals for equality.
+func NewIn(path field.Path, vals []super.Value) Filter {
+	if len(vals) == 0 {
+		return nil
+	}
+	f := NewCompare("==", path, vals[0])
+	for _, val := range vals[1:] {
+		f = NewOr(f, NewCompare("==", path, val))
+	}
+	return f
+}
+
+type and struct {
+	lhs Filter
+	rhs Filter
+}
+
+func (a *and) Prune(o *Object) bool {
+	return a.lhs.Prune(o) || a.rhs.Prune(o)
+}
+
+type or struct {
+	lhs Filter
+	rhs Filter
+}
+
+func (a *or) Prune(o *Object) bool {
+	return a.lhs.Prune(o) && a.rhs.Prune(o)
+}
+
+type compare struct {
+	op    string
+	path  field.Path
+	key   string
+	keyOK bool
+	num   float64
+	isNum bool
+	str   string
+	isStr bool
+}
+
+func (c *compare) Prune(o *Object) bool {
+	for _, e := range o.entries(c.path) {
+		if c.prune(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *compare) prune(e *Entry) bool {
+	switch e.Kind {
+	case KindMinMax:
+		if c.isNum {
+			return pruneRange(c.op, c.num, e.NumMin, e.NumMax)
+		}
+		if c.isStr {
+			return pruneRange(c.op, c.str, e.StrMin, e.StrMax)
+		}
+	case KindBloom:
+		return c.op == "==" && c.keyOK && e.Bloom != nil && !e.Bloom.mayContain(c.key)
+	case KindValue:
+		if c.op == "==" && c.keyOK {
+			_, found := slices.BinarySearch(e.Values, c.key)
+			return !found
+		}
+	}
+	return false
+}
+
+// pruneRange returns true if no value in the range [min, max] satisfies
+// a comparison with val using op.  Values are compared only with values of
+// the same kind so a nil range, which has no values of val's kind, always
+// prunes.  Numbers are compared as float64s, whose rounding can make
+// distinct numbers equal, so equality is never used to prune.
+func pruneRange[T float64 | string](op string, val T, min, max *T) bool {
+	if min == nil || max == nil {
+		return true
+	}
+	switch op {
+	case "==":
+		return val < *min || val > *max
+	case "<", "<=":
+		return *min > val
+	case ">", ">=":
+		return *max < val
+	}
+	return false
+}
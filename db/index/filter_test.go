@@ -0,0 +1,87 @@
+package index_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func build(t *testing.T, rules []index.Rule, values string) *index.Object {
+	sctx := super.NewContext()
+	b := index.NewBuilder(rules)
+	for _, s := range strings.Fields(values) {
+		val, err := sup.ParseValue(sctx, s)
+		require.NoError(t, err)
+		b.Add(val)
+	}
+	return b.Object()
+}
+
+func compare(t *testing.T, op, path, literal string) index.Filter {
+	val, err := sup.ParseValue(super.NewContext(), literal)
+	require.NoError(t, err)
+	return index.NewCompare(op, field.Dotted(path), val)
+}
+
+func TestFilter(t *testing.T) {
+	rules := []index.Rule{
+		{Name: "b", Kind: index.KindBloom, Field: field.Path{"s"}},
+		{Name: "v", Kind: index.KindValue, Field: field.Path{"x", "y"}},
+		{Name: "m", Kind: index.KindMinMax, Field: field.Path{"n"}},
+	}
+	o := build(t, rules, `{s:"a",x:{y:1},n:5} {s:"b",x:{y:2::uint8},n:10::int8} {n:"m"} {n:null}`)
+	tests := []struct {
+		filter index.Filter
+		prune  bool
+	}{
+		{compare(t, "==", "s", `"a"`), false},
+		{compare(t, "==", "s", `"z"`), true},
+		{compare(t, "==", "s", "0x61"), true},
+		{compare(t, "<", "s", `"z"`), false},
+		{compare(t, "==", "x.y", "2"), false},
+		{compare(t, "==", "x.y", "2."), false},
+		{compare(t, "==", "x.y", "3"), true},
+		{compare(t, "==", "x.y", `"1"`), true},
+		{compare(t, "==", "n", "5."), false},
+		{compare(t, "==", "n", "12"), true},
+		{compare(t, "<", "n", "5"), false},
+		{compare(t, "<", "n", "4"), true},
+		{compare(t, ">=", "n", "10"), false},
+		{compare(t, ">", "n", "11"), true},
+		{compare(t, ">", "n", `"a"`), false},
+		{compare(t, "<", "n", `"a"`), true},
+		{compare(t, "==", "n", "127.0.0.1"), false},
+		{compare(t, "==", "unindexed", "1"), false},
+		{index.NewIn(field.Path{"s"}, []super.Value{super.NewString("y"), super.NewString("z")}), true},
+		{index.NewIn(field.Path{"s"}, []super.Value{super.NewString("y"), super.NewString("b")}), false},
+		{index.NewAnd(compare(t, "==", "s", `"a"`), compare(t, "==", "n", "12")), true},
+		{index.NewOr(compare(t, "==", "s", `"a"`), compare(t, "==", "n", "12")), false},
+		{index.NewOr(compare(t, "==", "s", `"z"`), compare(t, "==", "n", "12")), true},
+	}
+	for i, tc := range tests {
+		assert.Equal(t, tc.prune, tc.filter.Prune(o), "test %d", i)
+		assert.False(t, tc.filter.Prune(nil), "test %d", i)
+	}
+}
+
+func TestFilterIncomplete(t *testing.T) {
+	rules := []index.Rule{
+		{Name: "v", Kind: index.KindValue, Field: field.Path{"x"}},
+		{Name: "m", Kind: index.KindMinMax, Field: field.Path{"y"}},
+	}
+	var sb strings.Builder
+	for i := range index.MaxValues + 1 {
+		sb.WriteString(`{x:"`)
+		sb.WriteString(strings.Repeat("a", i+1))
+		sb.WriteString(`",y:1::int128} `)
+	}
+	o := build(t, rules, sb.String())
+	assert.False(t, compare(t, "==", "x", `"b"`).Prune(o))
+	assert.False(t, compare(t, ">", "y", "2").Prune(o))
+}
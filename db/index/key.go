@@ -0,0 +1,55 @@
+package index
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+)
+
+// key returns a canonical form of val such that values that compare equal
+// in a query have the same key.  Numbers (including durations and times)
+// compare numerically across types so they are keyed by their float64
+// values, which may cause distinct numbers to share a key but never causes
+// equal numbers to have distinct keys.  The second return value is false for
+// nulls and errors, which are never equal to anything, and the third
+// is false for values that cannot be keyed.
+func key(val super.Value) (string, bool, bool) {
+	val = val.Under()
+	if val.IsNull() || val.IsError() {
+		return "", false, true
+	}
+	if f, ok, indexable := number(val); ok || !indexable {
+		if !indexable {
+			return "", false, false
+		}
+		return "n" + strconv.FormatFloat(f, 'g', -1, 64), true, true
+	}
+	if id := val.Type().ID(); id < super.IDTypeComplex {
+		return strconv.Itoa(id) + ":" + string(val.Bytes()), true, true
+	}
+	return "c" + sup.String(val), true, true
+}
+
+// number returns the numeric value of val as a float64 if val is a number.
+// The third return value is false for numbers that have no float64 form
+// suitable for indexing.
+func number(val super.Value) (float64, bool, bool) {
+	id := val.Type().ID()
+	switch {
+	case !super.IsNumber(id):
+		return 0, false, true
+	case id <= super.IDUint64:
+		return float64(val.Uint()), true, true
+	case id >= super.IDInt8 && id <= super.IDInt64, id == super.IDDuration, id == super.IDTime:
+		return float64(val.Int()), true, true
+	case id >= super.IDFloat16 && id <= super.IDFloat64:
+		f := val.Float()
+		if math.IsNaN(f) {
+			return 0, false, true
+		}
+		return f, true, true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,105 @@
+package index
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bufwriter"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/brimdata/super/sup"
+)
+
+// Object is the index of a data object.  It holds an entry for each of the
+// pool's rules at the time the data object was written.
+type Object struct {
+	Entries []Entry
+}
+
+// Entry is the index of one field of a data object computed for a rule.
+type Entry struct {
+	Rule  string     `super:"rule"`
+	Kind  string     `super:"kind"`
+	Field field.Path `super:"field"`
+	// Incomplete is true if the entry does not account for every value
+	// of the field and so cannot be used to skip the object.
+	Incomplete bool `super:"incomplete"`
+	// NumMin, NumMax, StrMin, and StrMax are the bounds of the numeric
+	// and string values of a minmax entry.  They are nil if there are no
+	// values of the respective kind.
+	NumMin *float64 `super:"num_min"`
+	NumMax *float64 `super:"num_max"`
+	StrMin *string  `super:"str_min"`
+	StrMax *string  `super:"str_max"`
+	// Bloom is the filter of a bloom entry.
+	Bloom *Bloom `super:"bloom"`
+	// Values are the keys of the distinct values of a value entry.
+	Values []string `super:"values"`
+}
+
+// entries returns the complete entries of o for path.
+func (o *Object) entries(path field.Path) []*Entry {
+	if o == nil {
+		return nil
+	}
+	var entries []*Entry
+	for k := range o.Entries {
+		if e := &o.Entries[k]; !e.Incomplete && e.Field.Equal(path) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Write writes o to uri as a Super Binary stream of entries.
+func (o *Object) Write(ctx context.Context, engine storage.Engine, uri *storage.URI) error {
+	out, err := engine.Put(ctx, uri)
+	if err != nil {
+		return err
+	}
+	w := bsupio.NewWriter(bufwriter.New(out))
+	m := sup.NewBSUPMarshaler()
+	for _, e := range o.Entries {
+		val, err := m.Marshal(e)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// Load reads the index at uri.  It returns a nil Object and no error if
+// there is no index at uri, as is the case for data objects written before
+// the pool had index rules.
+func Load(ctx context.Context, engine storage.Engine, uri *storage.URI) (*Object, error) {
+	r, err := engine.Get(ctx, uri)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer r.Close()
+	zr := bsupio.NewReader(super.NewContext(), r)
+	defer zr.Close()
+	var o Object
+	for {
+		val, err := zr.Read()
+		if val == nil || err != nil {
+			return &o, err
+		}
+		var e Entry
+		if err := sup.UnmarshalBSUP(*val, &e); err != nil {
+			return nil, err
+		}
+		o.Entries = append(o.Entries, e)
+	}
+}
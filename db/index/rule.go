@@ -0,0 +1,67 @@
+// Package index implements secondary indexes for the data objects of a pool.
+//
+// A pool's index rules each name a field and a kind of index.  When a data
+// object is written, an index of each rule's field is computed over the
+// object's values and stored in a sidecar file next to the object.  When a
+// query filters a pool, the sidecar of each object is consulted to skip
+// objects that cannot contain a value satisfying the filter.
+package index
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super/pkg/field"
+)
+
+const (
+	// KindBloom indexes a field with a bloom filter of its values.
+	KindBloom = "bloom"
+	// KindMinMax indexes a field with the minimum and maximum of its
+	// numeric and string values.
+	KindMinMax = "minmax"
+	// KindValue indexes a field with the set of its distinct values.
+	KindValue = "value"
+)
+
+var (
+	ErrExists   = errors.New("index rule already exists")
+	ErrNotFound = errors.New("index rule not found")
+)
+
+// Rule describes an index of a field computed for each data object of a pool.
+type Rule struct {
+	Name  string     `super:"name"`
+	Kind  string     `super:"kind"`
+	Field field.Path `super:"field"`
+}
+
+func (r Rule) String() string {
+	return fmt.Sprintf("%s %s %s", r.Name, r.Kind, r.Field)
+}
+
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return errors.New("index rule name cannot be empty")
+	}
+	switch r.Kind {
+	case KindBloom, KindMinMax, KindValue:
+	default:
+		return fmt.Errorf("unknown index kind %q", r.Kind)
+	}
+	if len(r.Field) == 0 {
+		return errors.New("index rule field cannot be empty")
+	}
+	return nil
+}
+
+// Fields returns the distinct fields indexed by rules.
+func Fields(rules []Rule) []field.Path {
+	var fields []field.Path
+	for _, r := range rules {
+		if !r.Field.In(fields) {
+			fields = append(fields, r.Field)
+		}
+	}
+	return fields
+}
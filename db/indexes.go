@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"github.com/brimdata/super/db/data"
+	"github.com/segmentio/ksuid"
+)
+
+// UpdateIndexes rewrites the index of each data object in the branch
+// according to the pool's current index rules and returns the IDs of the
+// objects indexed.  Since data objects are immutable, an index written under
+// earlier rules remains correct, but it lacks entries for rules added since.
+func (b *Branch) UpdateIndexes(ctx context.Context) ([]ksuid.KSUID, error) {
+	snap, err := b.pool.commits.Snapshot(ctx, b.Commit)
+	if err != nil {
+		return nil, err
+	}
+	var ids []ksuid.KSUID
+	for _, o := range snap.Select(nil, b.pool.SortKeys.Primary().Order) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := data.CreateIndex(ctx, b.pool.engine, b.pool.DataPath, o.ID, b.pool.Indexes); err != nil {
+			return nil, err
+		}
+		ids = append(ids, o.ID)
+	}
+	return ids, nil
+}
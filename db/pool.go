@@ -159,12 +159,15 @@ func (p *Pool) ResolveRevision(ctx context.Context, revision string) (ksuid.KSUI
 	return id, nil
 }
 
-func (p *Pool) BatchifyBranches(ctx context.Context, sctx *super.Context, recs []super.Value, m *sup.MarshalBSUPContext, f expr.Evaluator) ([]super.Value, error) {
+func (p *Pool) BatchifyBranches(ctx context.Context, sctx *super.Context, recs []super.Value, m *sup.MarshalBSUPContext, f expr.Evaluator, visible VisibleFunc) ([]super.Value, error) {
 	branches, err := p.ListBranches(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, branchRef := range branches {
+		if visible != nil && !visible(p.ID, branchRef.Name) {
+			continue
+		}
 		meta := BranchMeta{p.Config, branchRef}
 		rec, err := m.Marshal(&meta)
 		if err != nil {
@@ -232,6 +235,11 @@ func (p *Pool) vacateCommits(ctx context.Context, ts nano.Ts, dryrun bool) ([]ks
 	if err != nil {
 		return nil, err
 	}
+	return p.vacateTo(ctx, commit, dryrun)
+}
+
+// vacateTo removes the commits preceding commit from the commit history.
+func (p *Pool) vacateTo(ctx context.Context, commit ksuid.KSUID, dryrun bool) ([]ksuid.KSUID, error) {
 	branches, err := p.branches.All(ctx)
 	if err != nil {
 		return nil, err
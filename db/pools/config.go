@@ -1,8 +1,13 @@
 package pools
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
@@ -18,6 +23,36 @@ type Config struct {
 	ID        ksuid.KSUID    `super:"id"`
 	SortKeys  order.SortKeys `super:"layout"`
 	Threshold int64          `super:"threshold"`
+	Retention Retention      `super:"retention"`
+	Indexes   []index.Rule   `super:"indexes"`
+}
+
+// Retention is a pool's retention policy.  When Age is positive, data objects
+// whose greatest pool key is a time older than Age are deleted.  When Commits
+// is positive, the commit history of the main branch is truncated to its most
+// recent Commits commits.
+type Retention struct {
+	Age     nano.Duration `super:"age"`
+	Commits int64         `super:"commits"`
+}
+
+// ErrRetentionKey is returned for a retention policy with an age on a pool
+// whose key is not a time.
+var ErrRetentionKey = errors.New("retention age requires a pool key of type time")
+
+func (r Retention) IsZero() bool {
+	return r.Age <= 0 && r.Commits <= 0
+}
+
+func (r Retention) String() string {
+	var policies []string
+	if r.Age > 0 {
+		policies = append(policies, "age "+r.Age.String())
+	}
+	if r.Commits > 0 {
+		policies = append(policies, fmt.Sprintf("commits %d", r.Commits))
+	}
+	return strings.Join(policies, " ")
 }
 
 var _ journal.Entry = (*Config)(nil)
@@ -38,6 +73,22 @@ func NewConfig(name string, sortKeys order.SortKeys, thresh int64) *Config {
 	}
 }
 
+// CheckRetention returns ErrRetentionKey if retention has an age and any of
+// keys, which are pool key values such as the bounds of the pool's data
+// objects, is neither null nor a time.  A pool's key has no declared type
+// so its values are checked instead.
+func (p *Config) CheckRetention(retention Retention, keys ...super.Value) error {
+	if retention.Age <= 0 {
+		return nil
+	}
+	for _, key := range keys {
+		if key = key.Under(); !key.IsNull() && key.Type().ID() != super.IDTime {
+			return fmt.Errorf("pool %q: %w", p.Name, ErrRetentionKey)
+		}
+	}
+	return nil
+}
+
 func (p *Config) Key() string {
 	return p.Name
 }
@@ -50,11 +101,13 @@ func (p *Config) Path(root *storage.URI) *storage.URI {
 // previous versions. At some point we'll do a migration so we don't have to do
 // this.
 type marshalConfig struct {
-	Ts        nano.Ts     `super:"ts"`
-	Name      string      `super:"name"`
-	ID        ksuid.KSUID `super:"id"`
-	SortKey   oldSortKey  `super:"layout"`
-	Threshold int64       `super:"threshold"`
+	Ts        nano.Ts      `super:"ts"`
+	Name      string       `super:"name"`
+	ID        ksuid.KSUID  `super:"id"`
+	SortKey   oldSortKey   `super:"layout"`
+	Threshold int64        `super:"threshold"`
+	Retention Retention    `super:"retention"`
+	Indexes   []index.Rule `super:"indexes"`
 }
 
 type oldSortKey struct {
@@ -74,6 +127,8 @@ func (p Config) MarshalBSUP(ctx *sup.MarshalBSUPContext) (super.Type, error) {
 		Name:      p.Name,
 		ID:        p.ID,
 		Threshold: p.Threshold,
+		Retention: p.Retention,
+		Indexes:   p.Indexes,
 	}
 	if !p.SortKeys.IsNil() {
 		m.SortKey.Order = p.SortKeys[0].Order
@@ -95,6 +150,8 @@ func (p *Config) UnmarshalBSUP(ctx *sup.UnmarshalBSUPContext, val super.Value) e
 	p.Name = m.Name
 	p.ID = m.ID
 	p.Threshold = m.Threshold
+	p.Retention = m.Retention
+	p.Indexes = m.Indexes
 	for _, k := range m.SortKey.Keys {
 		p.SortKeys = append(p.SortKeys, order.NewSortKey(m.SortKey.Order, k))
 	}
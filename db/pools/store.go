@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/segmentio/ksuid"
@@ -98,6 +99,38 @@ func (s *Store) Rename(ctx context.Context, id ksuid.KSUID, newName string) erro
 	return err
 }
 
+func (s *Store) SetRetention(ctx context.Context, id ksuid.KSUID, retention Retention) error {
+	return s.update(ctx, id, func(config *Config) {
+		config.Retention = retention
+	})
+}
+
+// SetIndexes replaces the index rules of a pool.
+func (s *Store) SetIndexes(ctx context.Context, id ksuid.KSUID, rules []index.Rule) error {
+	return s.update(ctx, id, func(config *Config) {
+		config.Indexes = rules
+	})
+}
+
+func (s *Store) update(ctx context.Context, id ksuid.KSUID, fn func(*Config)) error {
+	config, err := s.LookupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	fn(config)
+	err = s.store.Update(ctx, config, func(e journal.Entry) bool {
+		p, ok := e.(*Config)
+		return ok && p.ID == id
+	})
+	switch err {
+	case journal.ErrNoSuchKey:
+		return fmt.Errorf("%s: %w", id, ErrNotFound)
+	case journal.ErrConstraint:
+		return fmt.Errorf("%s: pool %q renamed during update", config.Name, id)
+	}
+	return err
+}
+
 // Remove deletes a pool from the configuration journal.
 func (s *Store) Remove(ctx context.Context, config Config) error {
 	err := s.store.Delete(ctx, config.Name, func(v journal.Entry) bool {
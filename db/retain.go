@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/plural"
+	"github.com/segmentio/ksuid"
+)
+
+// Retained describes the changes made by enforcing a pool's retention policy.
+type Retained struct {
+	// Commit is the commit that deleted Objects or ksuid.Nil if no
+	// objects were deleted.
+	Commit  ksuid.KSUID
+	Objects []ksuid.KSUID
+	// Commits are the commits vacated from the pool's history.
+	Commits []ksuid.KSUID
+}
+
+// Retain enforces the pool's retention policy as of now.  Data objects
+// older than the policy's age are deleted from the branch in a single commit
+// and the history of the main branch is then truncated to the policy's
+// number of commits.  If dryrun is true, nothing is changed and the objects
+// and commits that would be removed are returned.
+func (b *Branch) Retain(ctx context.Context, now nano.Ts, dryrun bool, author, message string) (Retained, error) {
+	var r Retained
+	policy := b.pool.Retention
+	if policy.Age > 0 {
+		cutoff := now.Sub(policy.Age)
+		if dryrun {
+			snap, err := b.pool.commits.Snapshot(ctx, b.Commit)
+			if err != nil {
+				return Retained{}, err
+			}
+			if err := b.pool.CheckRetention(policy, poolKeys(snap)...); err != nil {
+				return Retained{}, err
+			}
+			r.Objects = objectIDs(expiredObjects(snap, cutoff))
+		} else {
+			commit, err := b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+				snap, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+				if err != nil {
+					return nil, err
+				}
+				if err := b.pool.CheckRetention(policy, poolKeys(snap)...); err != nil {
+					return nil, err
+				}
+				expired := expiredObjects(snap, cutoff)
+				if len(expired) == 0 {
+					return nil, commits.ErrEmptyTransaction
+				}
+				r.Objects = objectIDs(expired)
+				msg := message
+				if msg == "" {
+					msg = retainMessage(expired, cutoff)
+				}
+				return commits.NewDeletesObject(parent.Commit, retries, author, msg, r.Objects), nil
+			})
+			if err != nil && !errors.Is(err, commits.ErrEmptyTransaction) {
+				return Retained{}, err
+			}
+			r.Commit = commit
+		}
+	}
+	if policy.Commits > 0 {
+		n := policy.Commits
+		if dryrun && len(r.Objects) > 0 {
+			// Account for the commit that would have deleted the objects.
+			n--
+		}
+		vacated, err := b.pool.retainCommits(ctx, n, dryrun)
+		if err != nil {
+			return Retained{}, err
+		}
+		r.Commits = vacated
+	}
+	return r, nil
+}
+
+// expiredObjects returns the objects in snap whose greatest pool key is a
+// time before cutoff.  A pool whose keys are not times is rejected by
+// CheckRetention beforehand.
+func expiredObjects(snap commits.View, cutoff nano.Ts) []*data.Object {
+	var expired []*data.Object
+	for _, o := range snap.SelectAll() {
+		key := o.Max.Under()
+		if key.Type().ID() == super.IDTime && !key.IsNull() && key.AsTime() < cutoff {
+			expired = append(expired, o)
+		}
+	}
+	return expired
+}
+
+// poolKeys returns the pool key bounds of the objects in snap.
+func poolKeys(snap commits.View) []super.Value {
+	var keys []super.Value
+	for _, o := range snap.SelectAll() {
+		keys = append(keys, o.Min, o.Max)
+	}
+	return keys
+}
+
+func objectIDs(objects []*data.Object) []ksuid.KSUID {
+	var ids []ksuid.KSUID
+	for _, o := range objects {
+		ids = append(ids, o.ID)
+	}
+	return ids
+}
+
+func retainMessage(objects []*data.Object, cutoff nano.Ts) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "deleted %d data object%s older than %s per retention policy\n\n", len(objects), plural.Slice(objects, "s"), cutoff)
+	printObjects(&b, objects, maxMessageObjects)
+	return b.String()
+}
+
+// retainCommits truncates the commit history of the main branch to its most
+// recent n commits.  n may be zero only for a dry run, in which case the
+// entire history is returned.
+func (p *Pool) retainCommits(ctx context.Context, n int64, dryrun bool) ([]ksuid.KSUID, error) {
+	main, err := p.Main(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if main.Branch.Commit.IsNil() {
+		return nil, nil
+	}
+	path, err := p.commits.Path(ctx, main.Branch.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(path)) <= n {
+		return nil, nil
+	}
+	if n == 0 {
+		return path, nil
+	}
+	base := path[n-1]
+	if !dryrun {
+		_, commit, err := p.commits.GetBytes(ctx, base)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.vacateBranchStore(ctx, commit.Date, dryrun); err != nil {
+			return nil, err
+		}
+	}
+	return p.vacateTo(ctx, base, dryrun)
+}
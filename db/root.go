@@ -6,12 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/db/branches"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/storage"
@@ -182,7 +184,12 @@ func (r *Root) readMagic(ctx context.Context) error {
 	return nil
 }
 
-func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Evaluator) ([]super.Value, error) {
+// VisibleFunc returns true if a listing of database metadata may include the
+// named branch of the pool with the given ID or, if branch is empty, the
+// pool as a whole.
+type VisibleFunc func(pool ksuid.KSUID, branch string) bool
+
+func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Evaluator, visible VisibleFunc) ([]super.Value, error) {
 	m := sup.NewBSUPMarshalerWithContext(sctx)
 	m.Decorate(sup.StylePackage)
 	pools, err := r.ListPools(ctx)
@@ -191,6 +198,9 @@ func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Ev
 	}
 	var vals []super.Value
 	for k := range pools {
+		if visible != nil && !visible(pools[k].ID, "") {
+			continue
+		}
 		rec, err := m.Marshal(&pools[k])
 		if err != nil {
 			return nil, err
@@ -202,7 +212,7 @@ func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Ev
 	return vals, nil
 }
 
-func (r *Root) BatchifyBranches(ctx context.Context, sctx *super.Context, f expr.Evaluator) ([]super.Value, error) {
+func (r *Root) BatchifyBranches(ctx context.Context, sctx *super.Context, f expr.Evaluator, visible VisibleFunc) ([]super.Value, error) {
 	m := sup.NewBSUPMarshalerWithContext(sctx)
 	m.Decorate(sup.StylePackage)
 	poolRefs, err := r.ListPools(ctx)
@@ -220,7 +230,7 @@ func (r *Root) BatchifyBranches(ctx context.Context, sctx *super.Context, f expr
 			}
 			return nil, err
 		}
-		vals, err = pool.BatchifyBranches(ctx, sctx, vals, m, f)
+		vals, err = pool.BatchifyBranches(ctx, sctx, vals, m, f, visible)
 		if err != nil {
 			return nil, err
 		}
@@ -313,6 +323,73 @@ func (r *Root) RenamePool(ctx context.Context, id ksuid.KSUID, newName string) e
 	return r.pools.Rename(ctx, id, newName)
 }
 
+// SetRetention sets the retention policy of a pool.  A policy with an age
+// is rejected if the pool key of any data object on a branch is not a time.
+func (r *Root) SetRetention(ctx context.Context, id ksuid.KSUID, retention pools.Retention) error {
+	pool, err := r.OpenPool(ctx, id)
+	if err != nil {
+		return err
+	}
+	configs, err := pool.ListBranches(ctx)
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		snap, err := pool.Snapshot(ctx, config.Commit)
+		if err != nil {
+			return err
+		}
+		if err := pool.CheckRetention(retention, poolKeys(snap)...); err != nil {
+			return err
+		}
+	}
+	return r.pools.SetRetention(ctx, id, retention)
+}
+
+// AddIndexRules adds rules to the index rules of a pool.  Data objects
+// written subsequently are indexed by the new rules; UpdateIndexes indexes
+// existing objects.
+func (r *Root) AddIndexRules(ctx context.Context, id ksuid.KSUID, rules []index.Rule) error {
+	config, err := r.pools.LookupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	existing := slices.Clone(config.Indexes)
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if slices.ContainsFunc(existing, func(r index.Rule) bool { return r.Name == rule.Name }) {
+			return fmt.Errorf("%s: %w", rule.Name, index.ErrExists)
+		}
+		existing = append(existing, rule)
+	}
+	return r.pools.SetIndexes(ctx, id, existing)
+}
+
+// DeleteIndexRules deletes the named index rules of a pool and returns the
+// deleted rules.
+func (r *Root) DeleteIndexRules(ctx context.Context, id ksuid.KSUID, names []string) ([]index.Rule, error) {
+	config, err := r.pools.LookupByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var deleted, remaining []index.Rule
+	for _, rule := range config.Indexes {
+		if slices.Contains(names, rule.Name) {
+			deleted = append(deleted, rule)
+		} else {
+			remaining = append(remaining, rule)
+		}
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(deleted, func(r index.Rule) bool { return r.Name == name }) {
+			return nil, fmt.Errorf("%s: %w", name, index.ErrNotFound)
+		}
+	}
+	return deleted, r.pools.SetIndexes(ctx, id, remaining)
+}
+
 func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, thresh int64) (*Pool, error) {
 	if name == "HEAD" {
 		return nil, fmt.Errorf("pool cannot be named %q", name)
@@ -0,0 +1,308 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/plural"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+	"github.com/segmentio/ksuid"
+)
+
+var ErrMultipleMatches = errors.New("MERGE INTO: a target row matches more than one source row")
+
+// UpdateWhere applies the put operation in set to each row matching the
+// filter in where.  Each data object containing a matching row is replaced
+// by objects holding its updated and unchanged rows in a single commit.
+func (b *Branch) UpdateWhere(ctx context.Context, c runtime.Compiler, where, set *parser.AST, author, message, meta string) (ksuid.KSUID, error) {
+	sctx := super.NewContext()
+	appMeta, err := loadMeta(sctx, meta)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		rctx := runtime.NewContext(ctx, sctx)
+		defer rctx.Cancel()
+		commitish := &dbid.Commitish{
+			Pool:   b.pool.Name,
+			Branch: parent.Commit.String(),
+		}
+		update, err := c.NewUpdateQuery(rctx, where, set, commitish)
+		if err != nil {
+			return nil, err
+		}
+		defer update.Pull(true)
+		// The delete query outputs the rows that do not match where from
+		// the objects that have a matching row and reports those objects
+		// in its deletion set.
+		keep, err := c.NewDeleteQuery(rctx, where.Clone(), commitish)
+		if err != nil {
+			return nil, err
+		}
+		defer keep.Pull(true)
+		w, err := NewWriter(ctx, sctx, b.pool)
+		if err != nil {
+			return nil, err
+		}
+		err = vio.Copy(w, keep)
+		if err == nil {
+			err = vio.Copy(w, update)
+		}
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		var replaced []*data.Object
+		for _, id := range keep.DeletionSet() {
+			o, err := base.Lookup(id)
+			if err != nil {
+				return nil, err
+			}
+			replaced = append(replaced, o)
+		}
+		if len(replaced) == 0 {
+			return nil, commits.ErrEmptyTransaction
+		}
+		return newRewriteCommit(base, parent, retries, replaced, w.Objects(), author, message, appMeta)
+	})
+}
+
+// MergeInto executes the MERGE INTO statement in ast.  Each data object
+// containing a row that is updated or deleted is replaced by objects holding
+// its new and unchanged rows, and inserted rows are added as new objects,
+// all in a single commit.
+func (b *Branch) MergeInto(ctx context.Context, c runtime.Compiler, ast *parser.AST, author, message, meta string) (ksuid.KSUID, error) {
+	sctx := super.NewContext()
+	appMeta, err := loadMeta(sctx, meta)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		rctx := runtime.NewContext(ctx, sctx)
+		defer rctx.Cancel()
+		commitish := &dbid.Commitish{
+			Pool:   b.pool.Name,
+			Branch: parent.Commit.String(),
+		}
+		query, err := c.NewMergeQuery(rctx, ast, commitish)
+		if err != nil {
+			return nil, err
+		}
+		defer query.Pull(true)
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		w, err := NewWriter(ctx, sctx, b.pool)
+		if err != nil {
+			return nil, err
+		}
+		changes := &mergeChanges{
+			sctx:    sctx,
+			w:       w,
+			sortKey: b.pool.SortKeys.Primary(),
+			targets: map[string][]*super.Value{},
+		}
+		var replaced []*data.Object
+		err = vio.Copy(changes, query)
+		if err == nil {
+			replaced, err = changes.rewrite(ctx, b.pool, base.SelectAll())
+		}
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(replaced) == 0 && len(w.Objects()) == 0 {
+			return nil, commits.ErrEmptyTransaction
+		}
+		return newRewriteCommit(base, parent, retries, replaced, w.Objects(), author, message, appMeta)
+	})
+}
+
+func newRewriteCommit(base commits.View, parent *branches.Config, retries int, replaced []*data.Object, objects []data.Object, author, message string, meta super.Value) (*commits.Object, error) {
+	patch := commits.NewPatch(base)
+	for _, o := range replaced {
+		if err := patch.DeleteObject(o.ID); err != nil {
+			return nil, err
+		}
+	}
+	var added []*data.Object
+	for _, o := range objects {
+		if err := patch.AddDataObject(&o); err != nil {
+			return nil, err
+		}
+		added = append(added, &o)
+	}
+	if message == "" {
+		message = updateMessage(replaced, added)
+	}
+	return patch.NewCommitObject(parent.Commit, retries, author, message, meta), nil
+}
+
+func updateMessage(replaced, added []*data.Object) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "updated %d data object%s\n\n", len(replaced), plural.Slice(replaced, "s"))
+	printObjects(&b, replaced, maxMessageObjects)
+	if len(added) > 0 {
+		fmt.Fprintf(&b, "\nadded %d data object%s\n\n", len(added), plural.Slice(added, "s"))
+		printObjects(&b, added, maxMessageObjects-len(replaced))
+	}
+	return b.String()
+}
+
+// mergeChanges consumes the output of a MERGE INTO query.  Inserted rows
+// are written as they arrive while updated and deleted rows are held by the
+// key of the target row they replace until the objects containing the
+// targets are rewritten.
+type mergeChanges struct {
+	sctx    *super.Context
+	w       *Writer
+	sortKey order.SortKey
+	// targets maps a target row to its replacements, one for each time it
+	// matched a source row.  A nil replacement deletes the row.
+	targets map[string][]*super.Value
+	// poolKeys holds the pool key of each target row, which limits the
+	// objects scanned for targets to those whose span contains a pool key.
+	// If a target has no pool key, every object is scanned.
+	poolKeys []super.Value
+	unkeyed  bool
+}
+
+func (m *mergeChanges) Push(vec vector.Any) error {
+	return sbuf.WriteVec(m, vec)
+}
+
+func (m *mergeChanges) Write(val super.Value) error {
+	row := val.Deref("row")
+	switch op := val.Deref("op"); op.AsString() {
+	case "insert":
+		return m.w.Write(*row)
+	case "update", "delete":
+		var replacement *super.Value
+		if op.AsString() == "update" {
+			replacement = row.Copy().Ptr()
+		}
+		target := val.Deref("target")
+		key := rowKey(*target)
+		if _, ok := m.targets[key]; !ok {
+			if poolKey := target.Deunion().Ptr().DerefPath(m.sortKey.Key); poolKey == nil || poolKey.IsNull() {
+				m.unkeyed = true
+			} else {
+				m.poolKeys = append(m.poolKeys, poolKey.Copy())
+			}
+		}
+		m.targets[key] = append(m.targets[key], replacement)
+	}
+	return nil
+}
+
+// rewrite writes the rows of each object containing a target row to the
+// writer with the target rows replaced and returns the rewritten objects.
+func (m *mergeChanges) rewrite(ctx context.Context, pool *Pool, objects []*data.Object) ([]*data.Object, error) {
+	if len(m.targets) == 0 {
+		return nil, nil
+	}
+	cmp := expr.NewValueCompareFn(m.sortKey.Order, m.sortKey.Order.NullsMax(true))
+	slices.SortFunc(m.poolKeys, cmp)
+	var rewritten []*data.Object
+	for _, o := range objects {
+		if !m.unkeyed {
+			// Skip the object unless its span contains a pool key.
+			span := o.Span(m.sortKey.Order)
+			i, _ := slices.BinarySearchFunc(m.poolKeys, span.First(), cmp)
+			if i == len(m.poolKeys) || !span.In(m.poolKeys[i]) {
+				continue
+			}
+		}
+		// Scan once to see if the object has a target and again to
+		// rewrite it so objects are streamed rather than held in memory.
+		found, err := m.scan(ctx, pool, o, false)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		if _, err := m.scan(ctx, pool, o, true); err != nil {
+			return nil, err
+		}
+		rewritten = append(rewritten, o)
+	}
+	for _, replacements := range m.targets {
+		if len(replacements) != 0 {
+			return nil, ErrMultipleMatches
+		}
+	}
+	return rewritten, nil
+}
+
+// scan reports whether o contains a target row or, if write is true, writes
+// the rows of o to the writer with its target rows replaced.
+func (m *mergeChanges) scan(ctx context.Context, pool *Pool, o *data.Object, write bool) (bool, error) {
+	r, err := o.NewReader(ctx, pool.engine, pool.DataPath)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+	zr := bsupio.NewReader(m.sctx, r)
+	defer zr.Close()
+	for {
+		val, err := zr.Read()
+		if val == nil || err != nil {
+			return false, err
+		}
+		key := rowKey(*val)
+		replacements, ok := m.targets[key]
+		if !write {
+			if ok {
+				return true, nil
+			}
+			continue
+		}
+		if !ok {
+			if err := m.w.Write(*val); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if len(replacements) == 0 {
+			return false, errors.New("internal error: MERGE INTO target row not found in query output")
+		}
+		m.targets[key] = replacements[1:]
+		if replacement := replacements[0]; replacement != nil {
+			if err := m.w.Write(*replacement); err != nil {
+				return false, err
+			}
+		}
+	}
+}
+
+func rowKey(val super.Value) string {
+	val = val.Deunion()
+	key := binary.LittleEndian.AppendUint32(nil, uint32(val.Type().ID()))
+	return string(append(key, val.Bytes()...))
+}
@@ -0,0 +1,115 @@
+package super
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/super/scode"
+)
+
+// MaxDecimalPrecision is the largest number of decimal digits representable
+// by a decimal type, i.e., the precision of decimal256.
+const MaxDecimalPrecision = 76
+
+// TypeDecimal is a fixed-point decimal type with Precision total digits,
+// Scale of which follow the decimal point.  A decimal value is stored as its
+// unscaled integer using the same counted varint encoding as the integer types
+// but extended to arbitrary width so that precisions beyond 18 digits (i.e.,
+// decimal128 and decimal256) are represented exactly.
+type TypeDecimal struct {
+	id        int
+	Precision int
+	Scale     int
+}
+
+func NewTypeDecimal(id, precision, scale int) *TypeDecimal {
+	return &TypeDecimal{id, precision, scale}
+}
+
+func (t *TypeDecimal) ID() int {
+	return t.id
+}
+
+func (t *TypeDecimal) Kind() Kind {
+	return DecimalKind
+}
+
+// Width returns the primitive decimal ID (IDDecimal32, IDDecimal64,
+// IDDecimal128, or IDDecimal256) of the smallest storage class that
+// holds the type's precision.
+func (t *TypeDecimal) Width() int {
+	return DecimalWidth(t.Precision)
+}
+
+// DecimalWidth returns the primitive decimal ID of the smallest storage
+// class that holds precision digits.
+func DecimalWidth(precision int) int {
+	switch {
+	case precision <= 9:
+		return IDDecimal32
+	case precision <= 18:
+		return IDDecimal64
+	case precision <= 38:
+		return IDDecimal128
+	default:
+		return IDDecimal256
+	}
+}
+
+func CheckDecimal(precision, scale int) error {
+	if precision < 1 || precision > MaxDecimalPrecision {
+		return fmt.Errorf("decimal precision %d out of range [1,%d]", precision, MaxDecimalPrecision)
+	}
+	if scale < 0 || scale > precision {
+		return fmt.Errorf("decimal scale %d out of range [0,%d]", scale, precision)
+	}
+	return nil
+}
+
+// TypeDecimalOf returns the underlying decimal type of typ or nil
+// if typ is not a decimal.
+func TypeDecimalOf(typ Type) *TypeDecimal {
+	t, _ := TypeUnder(typ).(*TypeDecimal)
+	return t
+}
+
+func IsDecimal(typ Type) bool {
+	return TypeDecimalOf(typ) != nil
+}
+
+func EncodeDecimal(v *big.Int) scode.Bytes {
+	return AppendDecimal(nil, v)
+}
+
+// AppendDecimal appends the encoding of the unscaled decimal value v.
+// Values that fit in an int64 encode identically to EncodeInt.
+func AppendDecimal(bytes scode.Bytes, v *big.Int) scode.Bytes {
+	return AppendBigInt(bytes, v)
+}
+
+// AppendDecimalInt64 appends the encoding of the unscaled decimal value v.
+func AppendDecimalInt64(bytes scode.Bytes, v int64) scode.Bytes {
+	if v == math.MinInt64 {
+		return AppendDecimal(bytes, big.NewInt(v))
+	}
+	return scode.AppendCountedVarint(bytes, v)
+}
+
+// DecodeDecimal decodes the unscaled value of a decimal.
+func DecodeDecimal(bytes scode.Bytes) *big.Int {
+	return DecodeBigInt(bytes)
+}
+
+// DecodeDecimalInt64 decodes the unscaled value of a decimal, returning
+// false if the value does not fit in an int64.
+func DecodeDecimalInt64(bytes scode.Bytes) (int64, bool) {
+	if len(bytes) < 8 || len(bytes) == 8 && bytes[7] < 0x80 {
+		return scode.DecodeCountedVarint(bytes), true
+	}
+	v := DecodeDecimal(bytes)
+	if !v.IsInt64() {
+		return 0, false
+	}
+	return v.Int64(), true
+}
@@ -1,18 +1,27 @@
 package anymath
 
-import "math"
+import (
+	"math"
+	"math/big"
+)
 
 type Float64 func(float64, float64) float64
 type Int64 func(int64, int64) int64
 type Uint64 func(uint64, uint64) uint64
 type String func(string, string) string
 
+// Decimal combines two unscaled decimal values of the same scale and is
+// also used to combine 128- and 256-bit integers.
+// The result may alias either argument.
+type Decimal func(*big.Int, *big.Int) *big.Int
+
 type Function struct {
 	Init
 	Float64
 	Int64
 	Uint64
 	String
+	Decimal
 }
 
 type Init struct {
@@ -27,6 +36,12 @@ var Min = &Function{
 	Int64:   func(a, b int64) int64 { return min(a, b) },
 	Uint64:  func(a, b uint64) uint64 { return min(a, b) },
 	String:  func(a, b string) string { return min(a, b) },
+	Decimal: func(a, b *big.Int) *big.Int {
+		if a.Cmp(b) <= 0 {
+			return a
+		}
+		return b
+	},
 }
 
 var Max = &Function{
@@ -35,10 +50,17 @@ var Max = &Function{
 	Int64:   func(a, b int64) int64 { return max(a, b) },
 	Uint64:  func(a, b uint64) uint64 { return max(a, b) },
 	String:  func(a, b string) string { return max(a, b) },
+	Decimal: func(a, b *big.Int) *big.Int {
+		if a.Cmp(b) >= 0 {
+			return a
+		}
+		return b
+	},
 }
 
 var Add = &Function{
 	Float64: func(a, b float64) float64 { return a + b },
 	Int64:   func(a, b int64) int64 { return a + b },
 	Uint64:  func(a, b uint64) uint64 { return a + b },
+	Decimal: func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) },
 }
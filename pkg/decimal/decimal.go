@@ -0,0 +1,314 @@
+// Package decimal implements parsing, formatting, and exact arithmetic on
+// fixed-point decimal values represented as an unscaled big.Int paired with
+// a scale, i.e., the number of digits that follow the decimal point.
+package decimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxPrecision is the maximum number of digits in a decimal value.
+const MaxPrecision = 76
+
+var (
+	ErrOverflow  = errors.New("decimal overflow")
+	ErrSyntax    = errors.New("invalid decimal syntax")
+	ErrDivByZero = errors.New("divide by zero")
+)
+
+var (
+	one = big.NewInt(1)
+	ten = big.NewInt(10)
+)
+
+var powers [2*MaxPrecision + 2]*big.Int
+
+func init() {
+	powers[0] = big.NewInt(1)
+	for k := 1; k < len(powers); k++ {
+		powers[k] = new(big.Int).Mul(powers[k-1], ten)
+	}
+}
+
+// Pow10 returns 10**n.  The result must not be modified.
+func Pow10(n int) *big.Int {
+	if n < len(powers) {
+		return powers[n]
+	}
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+// Parse parses a decimal string of the form [+-]digits[.digits] with an
+// optional exponent and returns the unscaled value and its scale.
+func Parse(s string) (*big.Int, int, error) {
+	mantissa, exp := s, 0
+	if k := strings.IndexAny(s, "eE"); k >= 0 {
+		mantissa = s[:k]
+		e, ok := parseExp(s[k+1:])
+		if !ok {
+			return nil, 0, ErrSyntax
+		}
+		exp = e
+	}
+	var neg bool
+	switch {
+	case strings.HasPrefix(mantissa, "-"):
+		neg = true
+		mantissa = mantissa[1:]
+	case strings.HasPrefix(mantissa, "+"):
+		mantissa = mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, 0, ErrSyntax
+	}
+	v, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return nil, 0, ErrSyntax
+	}
+	if neg {
+		v.Neg(v)
+	}
+	scale := len(fracPart) - exp
+	if scale < 0 {
+		if -scale > 2*MaxPrecision {
+			return nil, 0, ErrOverflow
+		}
+		v.Mul(v, Pow10(-scale))
+		scale = 0
+	}
+	return v, scale, nil
+}
+
+func parseExp(s string) (int, bool) {
+	var neg bool
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if s == "" || !isDigits(s) || len(s) > 4 {
+		return 0, false
+	}
+	var n int
+	for _, c := range s {
+		n = n*10 + int(c-'0')
+	}
+	if neg {
+		n = -n
+	}
+	return n, true
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Format returns the canonical string for unscaled value v with the given
+// scale, e.g., Format(-1234, 2) is "-12.34".
+func Format(v *big.Int, scale int) string {
+	return string(Append(nil, v, scale))
+}
+
+// Append appends the canonical string for v with the given scale to b.
+func Append(b []byte, v *big.Int, scale int) []byte {
+	if v.Sign() < 0 {
+		b = append(b, '-')
+	}
+	digits := new(big.Int).Abs(v).Append(nil, 10)
+	if scale <= 0 {
+		b = append(b, digits...)
+		for range -scale {
+			b = append(b, '0')
+		}
+		return b
+	}
+	if len(digits) <= scale {
+		b = append(b, '0', '.')
+		for range scale - len(digits) {
+			b = append(b, '0')
+		}
+		return append(b, digits...)
+	}
+	n := len(digits) - scale
+	b = append(b, digits[:n]...)
+	b = append(b, '.')
+	return append(b, digits[n:]...)
+}
+
+// Digits returns the number of decimal digits in the magnitude of v,
+// where zero has one digit.
+func Digits(v *big.Int) int {
+	if v.Sign() == 0 {
+		return 1
+	}
+	// Estimate from the bit length then correct.
+	n := int(float64(v.BitLen())*math.Log10(2)) + 1
+	abs := new(big.Int).Abs(v)
+	if n > 1 && abs.Cmp(Pow10(n-1)) < 0 {
+		n--
+	}
+	if abs.Cmp(Pow10(n)) >= 0 {
+		n++
+	}
+	return n
+}
+
+// Fits returns true if v has no more than precision digits.
+func Fits(v *big.Int, precision int) bool {
+	return new(big.Int).Abs(v).Cmp(Pow10(precision)) < 0
+}
+
+// Rescale returns v with scale from converted to scale to.  When the scale
+// decreases, the result is rounded half away from zero.
+func Rescale(v *big.Int, from, to int) *big.Int {
+	switch {
+	case to == from:
+		return new(big.Int).Set(v)
+	case to > from:
+		return new(big.Int).Mul(v, Pow10(to-from))
+	default:
+		return divRound(v, Pow10(from-to))
+	}
+}
+
+// divRound returns n/d rounded half away from zero.
+func divRound(n, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	r.Abs(r).Lsh(r, 1)
+	if r.Cmp(new(big.Int).Abs(d)) >= 0 {
+		if n.Sign()*d.Sign() < 0 {
+			q.Sub(q, one)
+		} else {
+			q.Add(q, one)
+		}
+	}
+	return q
+}
+
+// Float64 converts v with the given scale to the nearest float64.
+func Float64(v *big.Int, scale int) float64 {
+	if scale == 0 {
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f
+	}
+	r := new(big.Rat).SetFrac(v, Pow10(scale))
+	f, _ := r.Float64()
+	return f
+}
+
+// FromFloat64 converts f to an unscaled value at the given scale,
+// rounding half away from zero.  It returns false if f is not finite.
+func FromFloat64(f float64, scale int) (*big.Int, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return nil, false
+	}
+	r.Mul(r, new(big.Rat).SetInt(Pow10(scale)))
+	return divRound(r.Num(), r.Denom()), true
+}
+
+// Compare compares a with scale as to b with scale bs.
+func Compare(a *big.Int, as int, b *big.Int, bs int) int {
+	if as == bs {
+		return a.Cmp(b)
+	}
+	s := max(as, bs)
+	return Rescale(a, as, s).Cmp(Rescale(b, bs, s))
+}
+
+// AddType returns the precision and scale of the sum or difference of
+// decimals of type (p1,s1) and (p2,s2).
+func AddType(p1, s1, p2, s2 int) (int, int) {
+	scale := max(s1, s2)
+	return min(max(p1-s1, p2-s2)+scale+1, MaxPrecision), scale
+}
+
+// MulType returns the precision and scale of the product of
+// decimals of type (p1,s1) and (p2,s2).
+func MulType(p1, s1, p2, s2 int) (int, int) {
+	return min(p1+p2+1, MaxPrecision), min(s1+s2, MaxPrecision)
+}
+
+// DivType returns the precision and scale of the quotient of
+// decimals of type (p1,s1) and (p2,s2).
+func DivType(p1, s1, p2, s2 int) (int, int) {
+	scale := max(6, s1+p2+1)
+	precision := p1 - s1 + s2 + scale
+	if precision > MaxPrecision {
+		// Sacrifice scale for integral digits but keep at least 6.
+		scale = max(min(scale, 6), scale-(precision-MaxPrecision))
+		precision = MaxPrecision
+	}
+	return precision, min(scale, precision)
+}
+
+// ModType returns the precision and scale of the remainder of
+// decimals of type (p1,s1) and (p2,s2).
+func ModType(p1, s1, p2, s2 int) (int, int) {
+	scale := max(s1, s2)
+	return max(min(p1-s1, p2-s2)+scale, 1), scale
+}
+
+// Add returns a (scale as) plus b (scale bs) at the result scale.
+func Add(a *big.Int, as int, b *big.Int, bs int, scale int) *big.Int {
+	s := max(as, bs)
+	sum := new(big.Int).Add(Rescale(a, as, s), Rescale(b, bs, s))
+	return Rescale(sum, s, scale)
+}
+
+// Sub returns a (scale as) minus b (scale bs) at the result scale.
+func Sub(a *big.Int, as int, b *big.Int, bs int, scale int) *big.Int {
+	s := max(as, bs)
+	diff := new(big.Int).Sub(Rescale(a, as, s), Rescale(b, bs, s))
+	return Rescale(diff, s, scale)
+}
+
+// Mul returns a (scale as) times b (scale bs) at the result scale.
+func Mul(a *big.Int, as int, b *big.Int, bs int, scale int) *big.Int {
+	return Rescale(new(big.Int).Mul(a, b), as+bs, scale)
+}
+
+// Div returns a (scale as) divided by b (scale bs) at the result scale
+// rounded half away from zero.
+func Div(a *big.Int, as int, b *big.Int, bs int, scale int) (*big.Int, error) {
+	if b.Sign() == 0 {
+		return nil, ErrDivByZero
+	}
+	// a/10^as / (b/10^bs) * 10^scale = a*10^(bs+scale-as) / b
+	n := new(big.Int).Set(a)
+	d := new(big.Int).Set(b)
+	if e := bs + scale - as; e >= 0 {
+		n.Mul(n, Pow10(e))
+	} else {
+		d.Mul(d, Pow10(-e))
+	}
+	return divRound(n, d), nil
+}
+
+// Mod returns the remainder of a (scale as) divided by b (scale bs)
+// at the result scale max(as, bs), which takes the sign of a.
+func Mod(a *big.Int, as int, b *big.Int, bs int) (*big.Int, error) {
+	if b.Sign() == 0 {
+		return nil, ErrDivByZero
+	}
+	s := max(as, bs)
+	return new(big.Int).Rem(Rescale(a, as, s), Rescale(b, bs, s)), nil
+}
@@ -0,0 +1,98 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	cases := []struct {
+		in    string
+		scale int
+		out   string
+	}{
+		{"0", 0, "0"},
+		{"12.34", 2, "12.34"},
+		{"-12.340", 3, "-12.340"},
+		{".5", 1, "0.5"},
+		{"-0.001", 3, "-0.001"},
+		{"1.5e2", 0, "150"},
+		{"1.5e-2", 3, "0.015"},
+		{"+7.", 0, "7"},
+		{"123456789012345678901234567890.123456789", 9, "123456789012345678901234567890.123456789"},
+	}
+	for _, c := range cases {
+		v, scale, err := Parse(c.in)
+		require.NoError(t, err, c.in)
+		assert.Equal(t, c.scale, scale, c.in)
+		assert.Equal(t, c.out, Format(v, scale), c.in)
+	}
+	for _, s := range []string{"", "-", ".", "1.2.3", "abc", "1e", "1e99999"} {
+		_, _, err := Parse(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRescale(t *testing.T) {
+	assert.Equal(t, "1.24", Format(Rescale(big.NewInt(12350), 4, 2), 2))
+	assert.Equal(t, "-1.24", Format(Rescale(big.NewInt(-12350), 4, 2), 2))
+	assert.Equal(t, "1.23", Format(Rescale(big.NewInt(12349), 4, 2), 2))
+	assert.Equal(t, "1.2300", Format(Rescale(big.NewInt(123), 2, 4), 4))
+}
+
+func TestArith(t *testing.T) {
+	a, as, _ := Parse("10.25")
+	b, bs, _ := Parse("0.3")
+	assert.Equal(t, "10.55", Format(Add(a, as, b, bs, 2), 2))
+	assert.Equal(t, "9.95", Format(Sub(a, as, b, bs, 2), 2))
+	assert.Equal(t, "3.075", Format(Mul(a, as, b, bs, 3), 3))
+	q, err := Div(a, as, b, bs, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "34.166667", Format(q, 6))
+	_, err = Div(a, as, big.NewInt(0), 0, 6)
+	assert.ErrorIs(t, err, ErrDivByZero)
+	r, err := Mod(a, as, b, bs)
+	require.NoError(t, err)
+	assert.Equal(t, "0.05", Format(r, 2))
+}
+
+func TestTypes(t *testing.T) {
+	p, s := AddType(5, 2, 10, 4)
+	assert.Equal(t, [2]int{11, 4}, [2]int{p, s})
+	p, s = MulType(5, 2, 10, 4)
+	assert.Equal(t, [2]int{16, 6}, [2]int{p, s})
+	p, s = DivType(10, 2, 5, 0)
+	assert.Equal(t, [2]int{16, 8}, [2]int{p, s})
+	p, s = ModType(5, 2, 10, 4)
+	assert.Equal(t, [2]int{7, 4}, [2]int{p, s})
+	p, s = DivType(76, 10, 76, 10)
+	assert.Equal(t, MaxPrecision, p)
+	assert.GreaterOrEqual(t, s, 6)
+}
+
+func TestDigits(t *testing.T) {
+	for _, s := range []string{"0", "9", "10", "99", "100", "999999999999999999999", "1000000000000000000000"} {
+		v, _ := new(big.Int).SetString(s, 10)
+		assert.Equal(t, len(s), Digits(v), s)
+		assert.Equal(t, len(s), Digits(new(big.Int).Neg(v)), s)
+	}
+	assert.True(t, Fits(big.NewInt(99999), 5))
+	assert.False(t, Fits(big.NewInt(-100000), 5))
+}
+
+func TestFloat(t *testing.T) {
+	v, ok := FromFloat64(0.1, 2)
+	require.True(t, ok)
+	assert.Equal(t, "0.10", Format(v, 2))
+	v, ok = FromFloat64(-2.675, 2)
+	require.True(t, ok)
+	assert.Equal(t, "-2.68", Format(v, 2))
+	assert.Equal(t, 12.34, Float64(big.NewInt(1234), 2))
+	_, ok = FromFloat64(1.0/zero(), 2)
+	assert.False(t, ok)
+}
+
+func zero() float64 { return 0 }
@@ -0,0 +1,50 @@
+// Package murmur3 implements the 32-bit variant of Austin Appleby's
+// MurmurHash3, a fast non-cryptographic hash function.
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	c1 = 0xcc9e2d51
+	c2 = 0x1b873593
+)
+
+// Sum32 returns the MurmurHash3 x86_32 hash of data with the given seed.
+func Sum32(data []byte, seed uint32) uint32 {
+	h := seed
+	n := len(data)
+	for ; len(data) >= 4; data = data[4:] {
+		k := binary.LittleEndian.Uint32(data)
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+	var k uint32
+	switch len(data) {
+	case 3:
+		k ^= uint32(data[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(data[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(data[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+	h ^= uint32(n)
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
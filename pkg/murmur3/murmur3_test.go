@@ -0,0 +1,25 @@
+package murmur3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum32(t *testing.T) {
+	cases := []struct {
+		in       string
+		seed     uint32
+		expected uint32
+	}{
+		{"", 0, 0},
+		{"", 1, 0x514e28b7},
+		{"", 0xffffffff, 0x81f16f39},
+		{"hello", 0, 0x248bfa47},
+		{"Hello, world!", 1234, 0xfaf6cdb3},
+		{"The quick brown fox jumps over the lazy dog", 0, 0x2e4ff723},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, Sum32([]byte(c.in), c.seed), "input %q seed %d", c.in, c.seed)
+	}
+}
@@ -0,0 +1,172 @@
+package nano
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unit is a unit of calendar time.  Units up to and including UnitWeek have
+// a fixed length in wall-clock time while months, quarters, and years do not.
+type Unit int
+
+const (
+	UnitNanosecond Unit = iota
+	UnitMicrosecond
+	UnitMillisecond
+	UnitSecond
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitYear
+)
+
+var unitNames = map[string]Unit{
+	"nanosecond":  UnitNanosecond,
+	"ns":          UnitNanosecond,
+	"microsecond": UnitMicrosecond,
+	"us":          UnitMicrosecond,
+	"millisecond": UnitMillisecond,
+	"ms":          UnitMillisecond,
+	"second":      UnitSecond,
+	"s":           UnitSecond,
+	"minute":      UnitMinute,
+	"m":           UnitMinute,
+	"hour":        UnitHour,
+	"h":           UnitHour,
+	"day":         UnitDay,
+	"d":           UnitDay,
+	"week":        UnitWeek,
+	"w":           UnitWeek,
+	"month":       UnitMonth,
+	"quarter":     UnitQuarter,
+	"year":        UnitYear,
+	"y":           UnitYear,
+}
+
+// ParseUnit parses the name of a unit, which may be plural and is not case
+// sensitive, e.g., "day", "Days", or "d".
+func ParseUnit(s string) (Unit, bool) {
+	s = strings.ToLower(s)
+	if u, ok := unitNames[s]; ok {
+		return u, true
+	}
+	if s, ok := strings.CutSuffix(s, "s"); ok && len(s) > 1 {
+		u, ok := unitNames[s]
+		return u, ok
+	}
+	return 0, false
+}
+
+// ParseInterval parses a calendar interval of the form "<n> <unit>" or
+// "<unit>", e.g., "3 months" or "week", where n is a positive integer small
+// enough that the interval does not overflow a Duration or count of months.
+func ParseInterval(s string) (int64, Unit, bool) {
+	fields := strings.Fields(s)
+	n := int64(1)
+	switch len(fields) {
+	case 1:
+	case 2:
+		var err error
+		if n, err = strconv.ParseInt(fields[0], 10, 64); err != nil || n <= 0 {
+			return 0, 0, false
+		}
+	default:
+		return 0, 0, false
+	}
+	u, ok := ParseUnit(fields[len(fields)-1])
+	if !ok || n > maxUnits(u) {
+		return 0, 0, false
+	}
+	return n, u, true
+}
+
+// maxUnits returns the largest number of units u in an interval.
+func maxUnits(u Unit) int64 {
+	switch {
+	case u <= UnitWeek:
+		return math.MaxInt64 / int64(unitDurations[u])
+	case u == UnitQuarter:
+		return math.MaxInt64 / 3
+	}
+	return math.MaxInt64
+}
+
+var unitDurations = [...]Duration{
+	UnitNanosecond:  Nanosecond,
+	UnitMicrosecond: Microsecond,
+	UnitMillisecond: Millisecond,
+	UnitSecond:      Second,
+	UnitMinute:      Minute,
+	UnitHour:        Hour,
+	UnitDay:         Day,
+	UnitWeek:        Week,
+}
+
+// weekOrigin is the number of days from the Unix epoch, a Thursday, back to
+// the preceding Monday, so weeks start on Monday as in ISO 8601.
+const weekOrigin = 3 * Day
+
+// TruncIn returns the start of the interval of n units containing t, where
+// intervals are aligned to the date and time of day in loc.  Intervals of
+// fixed-length units are aligned to the Unix epoch in loc (or, for weeks, to
+// the Monday before), months and quarters to the start of a year, and years
+// to year zero, so, e.g., 3-month intervals start on quarters and 10-year
+// intervals on decades.
+func (t Ts) TruncIn(n int64, u Unit, loc *time.Location) Ts {
+	if n <= 0 {
+		n = 1
+	}
+	if u <= UnitWeek {
+		wall := Duration(t.WallClock(loc))
+		var origin Duration
+		if u == UnitWeek {
+			origin = -weekOrigin
+		}
+		trunc := origin + floorMultiple(wall-origin, Duration(n)*unitDurations[u])
+		// When the offset of loc is the same at the start of the
+		// interval as at t, the start is simply t less the elapsed
+		// wall-clock time.  This resolves a wall-clock time that
+		// occurs twice, as when clocks fall back, to the occurrence
+		// containing t.
+		if start := t.Add(trunc - wall); Duration(start.WallClock(loc)) == trunc {
+			return start
+		}
+		return fromWallClock(Ts(trunc), loc)
+	}
+	tm := t.Time().In(loc)
+	switch u {
+	case UnitQuarter:
+		n *= 3
+		fallthrough
+	case UnitMonth:
+		months := floorMultiple(int64(tm.Year())*12+int64(tm.Month())-1, n)
+		return TimeToTs(time.Date(int(floorDiv(months, 12)), time.Month(months-floorDiv(months, 12)*12+1), 1, 0, 0, 0, 0, loc))
+	case UnitYear:
+		return TimeToTs(time.Date(int(floorMultiple(int64(tm.Year()), n)), time.January, 1, 0, 0, 0, 0, loc))
+	}
+	panic(u)
+}
+
+// fromWallClock is the inverse of WallClock, returning the time whose date
+// and time of day in loc equal the UTC date and time of day of wall.
+func fromWallClock(wall Ts, loc *time.Location) Ts {
+	w := wall.Time()
+	return TimeToTs(time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), loc))
+}
+
+func floorDiv[T ~int64](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func floorMultiple[T ~int64](a, b T) T {
+	return floorDiv(a, b) * b
+}
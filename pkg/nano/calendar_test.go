@@ -0,0 +1,62 @@
+package nano_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncIn(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		ts       string
+		interval string
+		zone     string
+		expected string
+	}{
+		{"2024-03-10T12:34:56Z", "hour", "UTC", "2024-03-10T12:00:00Z"},
+		{"2024-03-10T12:34:56Z", "15 minutes", "UTC", "2024-03-10T12:30:00Z"},
+		{"2024-03-10T12:34:56Z", "hour", "+05:30", "2024-03-10T12:30:00Z"},
+		{"2024-03-10T03:00:00Z", "day", "America/New_York", "2024-03-09T05:00:00Z"},
+		// The day on which daylight saving time begins has 23 hours.
+		{"2024-03-11T03:00:00Z", "day", "America/New_York", "2024-03-10T05:00:00Z"},
+		// The wall-clock hour from 1:00 to 2:00 occurs twice on the day
+		// on which daylight saving time ends.
+		{"2024-11-03T05:30:45Z", "minute", "America/New_York", "2024-11-03T05:30:00Z"},
+		{"2024-11-03T06:30:45Z", "minute", "America/New_York", "2024-11-03T06:30:00Z"},
+		{"2024-11-03T05:30:45Z", "hour", "America/New_York", "2024-11-03T05:00:00Z"},
+		{"2024-11-03T06:30:45Z", "hour", "America/New_York", "2024-11-03T06:00:00Z"},
+		{"2024-11-03T06:30:45Z", "6 hours", "America/New_York", "2024-11-03T04:00:00Z"},
+		{"2024-11-03T06:30:45Z", "day", "America/New_York", "2024-11-03T04:00:00Z"},
+		{"2024-03-10T08:30:00Z", "6 hours", "America/New_York", "2024-03-10T05:00:00Z"},
+		{"2024-03-10T12:00:00Z", "week", "UTC", "2024-03-04T00:00:00Z"},
+		{"1969-12-31T12:00:00Z", "day", "UTC", "1969-12-31T00:00:00Z"},
+		{"2024-03-10T12:00:00Z", "month", "America/New_York", "2024-03-01T05:00:00Z"},
+		{"2024-08-10T12:00:00Z", "quarter", "UTC", "2024-07-01T00:00:00Z"},
+		{"2024-08-10T12:00:00Z", "6 months", "UTC", "2024-07-01T00:00:00Z"},
+		{"2024-08-10T12:00:00Z", "years", "UTC", "2024-01-01T00:00:00Z"},
+		{"2024-08-10T12:00:00Z", "10 years", "UTC", "2020-01-01T00:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := nano.ParseRFC3339Nano([]byte(c.ts))
+		require.NoError(t, err)
+		n, unit, ok := nano.ParseInterval(c.interval)
+		require.True(t, ok, c.interval)
+		loc, err := nano.LoadLocation(c.zone)
+		require.NoError(t, err)
+		expected, err := nano.ParseRFC3339Nano([]byte(c.expected))
+		require.NoError(t, err)
+		assert.Equal(t, expected, ts.TruncIn(n, unit, loc), "%s %s %s", c.ts, c.interval, c.zone)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"", "0 days", "-1 day", "1 fortnight", "1 2 days",
+		"106752 days", "3074457345618258603 quarters"} {
+		_, _, ok := nano.ParseInterval(s)
+		assert.False(t, ok, s)
+	}
+}
@@ -0,0 +1,83 @@
+package nano
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // Time zone names must resolve on hosts without a zoneinfo database.
+)
+
+var locations sync.Map
+
+// LoadLocation returns the time zone with the given name, which is either an
+// IANA time zone name like "America/New_York", "UTC", or a fixed offset from
+// UTC of the form ±hh, ±hhmm, or ±hh:mm.  Locations are cached, so
+// LoadLocation may be called for each value of a query.
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	var loc *time.Location
+	if name != "" && (name[0] == '+' || name[0] == '-') {
+		offset, ok := parseOffset(name)
+		if !ok {
+			return nil, fmt.Errorf("invalid time zone offset %q", name)
+		}
+		loc = time.FixedZone(name, offset)
+	} else {
+		// The empty name and "Local" are valid for time.LoadLocation but
+		// would make results depend on the host.
+		var err error
+		if name == "" || strings.EqualFold(name, "local") {
+			err = errors.New("no such zone")
+		} else {
+			loc, err = time.LoadLocation(name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", name)
+		}
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// parseOffset parses a UTC offset of the form ±hh, ±hhmm, or ±hh:mm and
+// returns it in seconds.
+func parseOffset(s string) (int, bool) {
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, false
+	}
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	hh, mm := s[1:3], "00"
+	switch rest := s[3:]; {
+	case rest == "":
+	case len(rest) == 2:
+		mm = rest
+	case len(rest) == 3 && rest[0] == ':':
+		mm = rest[1:]
+	default:
+		return 0, false
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || h > 23 || hh[0] == '+' || hh[0] == '-' {
+		return 0, false
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m > 59 || mm[0] == '+' || mm[0] == '-' {
+		return 0, false
+	}
+	return sign * (h*3600 + m*60), true
+}
+
+// WallClock returns the time whose UTC date and time of day equal the date and
+// time of day of t in loc.
+func (t Ts) WallClock(loc *time.Location) Ts {
+	_, offset := t.Time().In(loc).Zone()
+	return t.Add(Duration(offset) * Second)
+}
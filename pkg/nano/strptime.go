@@ -0,0 +1,346 @@
+package nano
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	monthNames   = []string{"january", "february", "march", "april", "may", "june", "july", "august", "september", "october", "november", "december"}
+	weekdayNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+)
+
+// Strptime parses s according to format, which consists of the conversion
+// specifications of strftime(3) and literal text.  Whitespace in format
+// matches zero or more whitespace characters in s.  Fields that are absent
+// from format default to the Unix epoch and times are in UTC unless format
+// includes %z or %Z.  A fractional second may follow the digits matched by %S.
+func Strptime(format, s string) (Ts, error) {
+	p := strptime{
+		in:    s,
+		year:  1970,
+		month: 1,
+		day:   1,
+		yday:  -1,
+		loc:   time.UTC,
+	}
+	if err := p.parse(format); err != nil {
+		return 0, err
+	}
+	if p.in != "" {
+		return 0, fmt.Errorf("extra text at end of input: %q", p.in)
+	}
+	return p.time()
+}
+
+type strptime struct {
+	in    string
+	year  int
+	month int
+	day   int
+	yday  int
+	hour  int
+	min   int
+	sec   int
+	nsec  int
+	// pm is 0 if there is no %p, 1 for AM, or 2 for PM, and applies only
+	// to hours parsed by %I or %l.
+	pm      int
+	hour12  bool
+	loc     *time.Location
+	epoch   bool
+	epochTs Ts
+	// mday is true if the day of the month was parsed, so %j is ignored.
+	mday bool
+}
+
+func (p *strptime) parse(format string) error {
+	for len(format) > 0 {
+		c := format[0]
+		format = format[1:]
+		if isSpace(c) {
+			p.in = strings.TrimLeft(p.in, " \t\n\r\v\f")
+			continue
+		}
+		if c != '%' {
+			if p.in == "" || p.in[0] != c {
+				return p.mismatch(string(c))
+			}
+			p.in = p.in[1:]
+			continue
+		}
+		if format == "" {
+			return errors.New("format ends with %")
+		}
+		verb := format[0]
+		format = format[1:]
+		if err := p.verb(verb, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *strptime) verb(verb byte, format string) error {
+	var err error
+	switch verb {
+	case 'Y':
+		p.year, err = p.signedNumber('Y', 4)
+	case 'y':
+		var y int
+		if y, err = p.number('y', 2, 0, 99); err == nil {
+			// POSIX maps 69-99 to the twentieth century and 00-68 to the
+			// twenty-first.
+			if y < 69 {
+				y += 100
+			}
+			p.year = 1900 + y
+		}
+	case 'm':
+		p.month, err = p.number('m', 2, 1, 12)
+	case 'd', 'e':
+		p.trimSpace()
+		p.day, err = p.number(verb, 2, 1, 31)
+		p.mday = true
+	case 'j':
+		var yday int
+		if yday, err = p.number('j', 3, 1, 366); err == nil {
+			p.yday = yday
+		}
+	case 'H', 'k':
+		p.trimSpace()
+		p.hour, err = p.number(verb, 2, 0, 23)
+		p.hour12 = false
+	case 'I', 'l':
+		p.trimSpace()
+		p.hour, err = p.number(verb, 2, 1, 12)
+		p.hour12 = true
+	case 'M':
+		p.min, err = p.number('M', 2, 0, 59)
+	case 'S':
+		if p.sec, err = p.number('S', 2, 0, 60); err == nil && !strings.HasPrefix(format, ".") && !strings.HasPrefix(format, ",") {
+			if len(p.in) > 1 && (p.in[0] == '.' || p.in[0] == ',') && isDigit(p.in[1]) {
+				p.in = p.in[1:]
+				err = p.fraction()
+			}
+		}
+	case 'f':
+		err = p.fraction()
+	case 'p':
+		switch {
+		case hasPrefixFold(p.in, "am"):
+			p.pm = 1
+		case hasPrefixFold(p.in, "pm"):
+			p.pm = 2
+		default:
+			return p.mismatch("%p")
+		}
+		p.in = p.in[2:]
+	case 'b', 'B', 'h':
+		var m int
+		if m, err = p.name(verb, monthNames); err == nil {
+			p.month = m + 1
+		}
+	case 'a', 'A':
+		_, err = p.name(verb, weekdayNames)
+	case 'z':
+		err = p.offset()
+	case 'Z':
+		err = p.zone()
+	case 's':
+		var sec int
+		if sec, err = p.signedNumber('s', 19); err == nil {
+			p.epoch = true
+			p.epochTs = Unix(int64(sec), 0)
+		}
+	case 'T':
+		err = p.parse("%H:%M:%S")
+	case 'D':
+		err = p.parse("%m/%d/%y")
+	case 'F':
+		err = p.parse("%Y-%m-%d")
+	case 'R':
+		err = p.parse("%H:%M")
+	case 'n', 't':
+		p.in = strings.TrimLeft(p.in, " \t\n\r\v\f")
+	case '%':
+		if !strings.HasPrefix(p.in, "%") {
+			return p.mismatch("%")
+		}
+		p.in = p.in[1:]
+	default:
+		return fmt.Errorf("unsupported conversion %%%c", verb)
+	}
+	return err
+}
+
+func (p *strptime) time() (Ts, error) {
+	if p.epoch {
+		return p.epochTs, nil
+	}
+	hour := p.hour
+	if p.hour12 {
+		hour %= 12
+		if p.pm == 2 {
+			hour += 12
+		}
+	}
+	month, day := p.month, p.day
+	if p.yday >= 0 && !p.mday {
+		t := time.Date(p.year, time.January, p.yday, 0, 0, 0, 0, time.UTC)
+		if t.Year() != p.year {
+			return 0, fmt.Errorf("day of year %d out of range", p.yday)
+		}
+		month, day = int(t.Month()), t.Day()
+	}
+	t := time.Date(p.year, time.Month(month), day, hour, p.min, p.sec, p.nsec, p.loc)
+	if t.Day() != day {
+		return 0, fmt.Errorf("day %d out of range for month %d", day, month)
+	}
+	return TimeToTs(t), nil
+}
+
+func (p *strptime) mismatch(want string) error {
+	if p.in == "" {
+		return fmt.Errorf("input ends before %q", want)
+	}
+	return fmt.Errorf("cannot parse %q as %q", p.in, want)
+}
+
+// trimSpace skips a space padding a number as for %e.
+func (p *strptime) trimSpace() {
+	if strings.HasPrefix(p.in, " ") {
+		p.in = p.in[1:]
+	}
+}
+
+func (p *strptime) digits(max int) string {
+	n := 0
+	for n < max && n < len(p.in) && isDigit(p.in[n]) {
+		n++
+	}
+	s := p.in[:n]
+	p.in = p.in[n:]
+	return s
+}
+
+func (p *strptime) number(verb byte, width, min, max int) (int, error) {
+	s := p.digits(width)
+	if s == "" {
+		return 0, p.mismatch("%" + string(verb))
+	}
+	var v int
+	for _, c := range []byte(s) {
+		v = v*10 + int(c-'0')
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %s out of range for %%%c", s, verb)
+	}
+	return v, nil
+}
+
+func (p *strptime) signedNumber(verb byte, width int) (int, error) {
+	neg := false
+	if strings.HasPrefix(p.in, "-") || strings.HasPrefix(p.in, "+") {
+		neg = p.in[0] == '-'
+		p.in = p.in[1:]
+	}
+	v, err := p.number(verb, width, 0, int(^uint(0)>>1))
+	if neg {
+		v = -v
+	}
+	return v, err
+}
+
+func (p *strptime) fraction() error {
+	s := p.digits(9)
+	if s == "" {
+		return p.mismatch("%f")
+	}
+	p.nsec = 0
+	for i := range 9 {
+		p.nsec *= 10
+		if i < len(s) {
+			p.nsec += int(s[i] - '0')
+		}
+	}
+	// Digits beyond nanoseconds are ignored.
+	p.digits(len(p.in))
+	return nil
+}
+
+// name matches a full or three-letter name from names and returns its index.
+func (p *strptime) name(verb byte, names []string) (int, error) {
+	for i, name := range names {
+		if hasPrefixFold(p.in, name) {
+			p.in = p.in[len(name):]
+			return i, nil
+		}
+	}
+	for i, name := range names {
+		if hasPrefixFold(p.in, name[:3]) {
+			p.in = p.in[3:]
+			return i, nil
+		}
+	}
+	return 0, p.mismatch("%" + string(verb))
+}
+
+func (p *strptime) offset() error {
+	if strings.HasPrefix(p.in, "Z") {
+		p.in = p.in[1:]
+		p.loc = time.UTC
+		return nil
+	}
+	n := 3
+	if len(p.in) >= 5 && isDigit(p.in[3]) {
+		n = 5
+	} else if len(p.in) >= 6 && p.in[3] == ':' {
+		n = 6
+	}
+	if len(p.in) < n {
+		return p.mismatch("%z")
+	}
+	offset, ok := parseOffset(p.in[:n])
+	if !ok {
+		return p.mismatch("%z")
+	}
+	p.loc = time.FixedZone("", offset)
+	p.in = p.in[n:]
+	return nil
+}
+
+func (p *strptime) zone() error {
+	n := 0
+	for n < len(p.in) && isZoneChar(p.in[n]) {
+		n++
+	}
+	if n == 0 {
+		return p.mismatch("%Z")
+	}
+	loc, err := LoadLocation(p.in[:n])
+	if err != nil {
+		return err
+	}
+	p.loc = loc
+	p.in = p.in[n:]
+	return nil
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isZoneChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c == '/' || c == '_' || c == '+' || c == '-'
+}
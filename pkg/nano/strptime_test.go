@@ -0,0 +1,57 @@
+package nano_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrptime(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format   string
+		in       string
+		expected string
+	}{
+		{"%Y-%m-%d", "2024-03-10", "2024-03-10T00:00:00Z"},
+		{"%F %T", "2024-03-10 12:34:56.789", "2024-03-10T12:34:56.789Z"},
+		{"%Y-%m-%dT%H:%M:%S.%f%z", "2024-03-10T12:34:56.5-05:00", "2024-03-10T17:34:56.5Z"},
+		{"%d/%b/%Y:%H:%M:%S %z", "10/Mar/2024:01:02:03 +0530", "2024-03-09T19:32:03Z"},
+		{"%A, %B %e, %Y %I:%M %p", "sunday, March  3, 2024 12:15 am", "2024-03-03T00:15:00Z"},
+		{"%D %l%p", "03/10/24 7PM", "2024-03-10T19:00:00Z"},
+		{"%Y %j", "2024 366", "2024-12-31T00:00:00Z"},
+		{"%s", "1700000000", "2023-11-14T22:13:20Z"},
+		{"%Y-%m-%d %H:%M %Z", "2024-07-01 09:00 America/New_York", "2024-07-01T13:00:00Z"},
+		{"%H%%", "12%", "1970-01-01T12:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := nano.Strptime(c.format, c.in)
+		require.NoError(t, err, "format %q input %q", c.format, c.in)
+		expected, err := nano.ParseRFC3339Nano([]byte(c.expected))
+		require.NoError(t, err)
+		assert.Equal(t, expected, ts, "format %q input %q", c.format, c.in)
+	}
+}
+
+func TestStrptimeErrors(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format string
+		in     string
+		err    string
+	}{
+		{"%Y-%m-%d", "2024-13-01", "value 13 out of range for %m"},
+		{"%Y-%m-%d", "2024-02-30", "day 30 out of range for month 2"},
+		{"%Y-%m-%d", "2024-02", `input ends before "-"`},
+		{"%Y", "2024x", `extra text at end of input: "x"`},
+		{"%Q", "x", "unsupported conversion %Q"},
+		{"%b", "Foo", `cannot parse "Foo" as "%b"`},
+		{"%Z", "Mars/Olympus", `unknown time zone "Mars/Olympus"`},
+	}
+	for _, c := range cases {
+		_, err := nano.Strptime(c.format, c.in)
+		assert.EqualError(t, err, c.err, "format %q input %q", c.format, c.in)
+	}
+}
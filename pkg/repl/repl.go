@@ -3,6 +3,12 @@
 package repl
 
 import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/brimdata/super/pkg/terminal"
 	"github.com/peterh/liner"
 )
 
@@ -11,14 +17,57 @@ type Consumer interface {
 	Prompt() string
 }
 
-// Run executes the REPL.
+// A Completer is a Consumer that completes the word under the cursor when
+// the tab key is pressed.  Complete returns the line split into the text
+// before the word, the candidate replacements for the word, and the text
+// after the cursor.
+type Completer interface {
+	Complete(line string, pos int) (head string, completions []string, tail string)
+}
+
+// A Historian is a Consumer whose line history is saved across sessions in
+// the file returned by HistoryFile.  History is not saved if HistoryFile
+// returns the empty string.
+type Historian interface {
+	HistoryFile() string
+}
+
+// Run executes the REPL.  If standard input is not a terminal, Run consumes
+// its lines without prompting.  At end of input, Run consumes an empty line
+// so the Consumer can complete any pending input, and returns nil.
 func Run(c Consumer) error {
+	if !terminal.IsTerminalFile(os.Stdin) {
+		return runLines(c, os.Stdin)
+	}
 	l := liner.NewLiner()
 	defer l.Close()
 	l.SetMultiLineMode(true)
+	if completer, ok := c.(Completer); ok {
+		l.SetWordCompleter(completer.Complete)
+	}
+	var history string
+	if h, ok := c.(Historian); ok {
+		history = h.HistoryFile()
+	}
+	if history != "" {
+		if f, err := os.Open(history); err == nil {
+			l.ReadHistory(f)
+			f.Close()
+		}
+		defer func() {
+			if f, err := os.Create(history); err == nil {
+				l.WriteHistory(f)
+				f.Close()
+			}
+		}()
+	}
 	for {
 		line, err := l.Prompt(c.Prompt())
 		if err != nil {
+			if errors.Is(err, io.EOF) {
+				c.Consume("")
+				return nil
+			}
 			return err
 		}
 		if c.Consume(line) {
@@ -28,6 +77,20 @@ func Run(c Consumer) error {
 	}
 }
 
+func runLines(c Consumer, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if c.Consume(scanner.Text()) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	c.Consume("")
+	return nil
+}
+
 func Ask(prompt string) (string, error) {
 	l := liner.NewLiner()
 	defer l.Close()
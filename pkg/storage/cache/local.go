@@ -6,19 +6,18 @@ import (
 
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/pkg/storage"
-	arc "github.com/hashicorp/golang-lru/arc/v2"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 type LocalCache struct {
 	storage.Engine
 	metrics
-	lru       *arc.ARCCache[string, []byte]
+	store     *LocalStore
 	cacheable Cacheable
 }
 
 func NewLocalCache(engine storage.Engine, cacheable Cacheable, size int, registerer prometheus.Registerer) (*LocalCache, error) {
-	lru, err := arc.NewARC[string, []byte](size)
+	store, err := NewLocalStore(size)
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +28,7 @@ func NewLocalCache(engine storage.Engine, cacheable Cacheable, size int, registe
 		Engine:    engine,
 		metrics:   newMetrics(registerer),
 		cacheable: cacheable,
-		lru:       lru,
+		store:     store,
 	}, nil
 }
 
@@ -38,7 +37,7 @@ func (c *LocalCache) Get(ctx context.Context, u *storage.URI) (storage.Reader, e
 		return c.Engine.Get(ctx, u)
 	}
 	kind, _, _ := data.FileMatch(path.Base(u.Path))
-	if b, ok := c.lru.Get(u.String()); ok {
+	if b, ok, _ := c.store.Get(ctx, u.String()); ok {
 		c.hits.WithLabelValues(kind.Description()).Inc()
 		return storage.NewBytesReader(b), nil
 	}
@@ -46,7 +45,7 @@ func (c *LocalCache) Get(ctx context.Context, u *storage.URI) (storage.Reader, e
 	if err != nil {
 		return nil, err
 	}
-	c.lru.Add(u.String(), b)
+	c.store.Put(ctx, u.String(), b)
 	c.misses.WithLabelValues(kind.Description()).Inc()
 	return storage.NewBytesReader(b), nil
 }
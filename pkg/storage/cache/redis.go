@@ -2,7 +2,6 @@ package cache
 
 import (
 	"context"
-	"errors"
 	"io"
 	"path"
 	"time"
@@ -16,8 +15,7 @@ import (
 type RedisCache struct {
 	storage.Engine
 	metrics
-	client    *redis.Client
-	expiry    time.Duration
+	store     *RedisStore
 	cacheable Cacheable
 }
 
@@ -25,8 +23,7 @@ func NewRedisCache(engine storage.Engine, client *redis.Client, cacheable Cachea
 	return &RedisCache{
 		Engine:    engine,
 		metrics:   newMetrics(reg),
-		expiry:    expiration,
-		client:    client,
+		store:     NewRedisStore(client, expiration),
 		cacheable: cacheable,
 	}
 }
@@ -36,16 +33,13 @@ func (c *RedisCache) Get(ctx context.Context, u *storage.URI) (storage.Reader, e
 		return c.Engine.Get(ctx, u)
 	}
 	kind, _, _ := data.FileMatch(path.Base(u.Path))
-	res := c.client.Get(ctx, u.String())
-	if err := res.Err(); err == nil {
+	b, ok, err := c.store.Get(ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	if ok {
 		c.hits.WithLabelValues(kind.Description()).Inc()
-		b, err := res.Bytes()
-		if err != nil {
-			return nil, err
-		}
 		return storage.NewBytesReader(b), nil
-	} else if !errors.Is(err, redis.Nil) {
-		return nil, err
 	}
 	reader, err := c.Engine.Get(ctx, u)
 	if err != nil {
@@ -54,10 +48,10 @@ func (c *RedisCache) Get(ctx context.Context, u *storage.URI) (storage.Reader, e
 	// Redis values are read in the their entitety and not streamed but
 	// that's okay since we only use this for smallish items like metadata
 	// and small search indexes from low-cardinality sources.
-	b, err := io.ReadAll(reader)
+	b, err = io.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 	c.misses.WithLabelValues(kind.Description()).Inc()
-	return storage.NewBytesReader(b), c.client.Set(ctx, u.String(), b, c.expiry).Err()
+	return storage.NewBytesReader(b), c.store.Put(ctx, u.String(), b)
 }
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	arc "github.com/hashicorp/golang-lru/arc/v2"
+)
+
+// Store is a key-value store of immutable byte slices.
+type Store interface {
+	// Get returns the value for key and true if key is present or nil and
+	// false if it is not.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Put(ctx context.Context, key string, val []byte) error
+}
+
+// LocalStore is a Store that keeps a fixed number of entries in memory.
+type LocalStore struct {
+	lru *arc.ARCCache[string, []byte]
+}
+
+func NewLocalStore(size int) (*LocalStore, error) {
+	lru, err := arc.NewARC[string, []byte](size)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalStore{lru}, nil
+}
+
+func (s *LocalStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	b, ok := s.lru.Get(key)
+	return b, ok, nil
+}
+
+func (s *LocalStore) Put(_ context.Context, key string, val []byte) error {
+	s.lru.Add(key, val)
+	return nil
+}
+
+// RedisStore is a Store backed by a Redis server.
+type RedisStore struct {
+	client *redis.Client
+	expiry time.Duration
+}
+
+// NewRedisStore returns a RedisStore whose keys expire after expiration.  A
+// zero expiration means no expiration and should only be used when Redis is
+// configured with a key eviction policy.
+func NewRedisStore(client *redis.Client, expiration time.Duration) *RedisStore {
+	return &RedisStore{
+		client: client,
+		expiry: expiration,
+	}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	b, err := s.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			err = nil
+		}
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+func (s *RedisStore) Put(ctx context.Context, key string, val []byte) error {
+	return s.client.Set(ctx, key, val, s.expiry).Err()
+}
@@ -0,0 +1,203 @@
+// Package tdigest implements the merging t-digest of Dunning and Ertl, a
+// compact sketch of a distribution of numbers from which quantiles can be
+// estimated.  Digests of disjoint sets of numbers may be merged into a digest
+// of their union, so the sketch is suitable for partial aggregation.
+package tdigest
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// DefaultCompression bounds the number of centroids of a digest to roughly
+// 100 while providing quantile estimates within about 1% of the true rank.
+const DefaultCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// Digest is a t-digest.  The zero value is not usable; use New.
+type Digest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	min         float64
+	max         float64
+}
+
+// New returns an empty Digest with the given compression.
+func New(compression float64) *Digest {
+	return &Digest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds x to d.  NaN is ignored.
+func (d *Digest) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	d.buffer = append(d.buffer, centroid{x, 1})
+	d.min = min(d.min, x)
+	d.max = max(d.max, x)
+	if len(d.buffer) >= d.bufferSize() {
+		d.compress()
+	}
+}
+
+// Merge adds the numbers summarized by o to d.
+func (d *Digest) Merge(o *Digest) {
+	o.compress()
+	if len(o.centroids) == 0 {
+		return
+	}
+	d.buffer = append(d.buffer, o.centroids...)
+	d.min = min(d.min, o.min)
+	d.max = max(d.max, o.max)
+	d.compress()
+}
+
+// Count returns the number of numbers added to d.
+func (d *Digest) Count() float64 {
+	var n float64
+	for _, c := range d.centroids {
+		n += c.weight
+	}
+	for _, c := range d.buffer {
+		n += c.weight
+	}
+	return n
+}
+
+// Quantile returns an estimate of the q-quantile of the numbers added to d,
+// interpolating linearly between centroids.  It returns NaN if d is empty.
+func (d *Digest) Quantile(q float64) float64 {
+	d.compress()
+	if len(d.centroids) == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+	total := d.Count()
+	target := q * total
+	// Each centroid is taken to lie at the middle of the ranks it covers.
+	first := d.centroids[0]
+	if target < first.weight/2 {
+		return d.min + (first.mean-d.min)*target/(first.weight/2)
+	}
+	var cum float64
+	for k := 0; k < len(d.centroids)-1; k++ {
+		c, next := d.centroids[k], d.centroids[k+1]
+		lo := cum + c.weight/2
+		hi := cum + c.weight + next.weight/2
+		if target < hi {
+			return c.mean + (next.mean-c.mean)*(target-lo)/(hi-lo)
+		}
+		cum += c.weight
+	}
+	last := d.centroids[len(d.centroids)-1]
+	lo := total - last.weight/2
+	if target <= lo {
+		return last.mean
+	}
+	return last.mean + (d.max-last.mean)*(target-lo)/(last.weight/2)
+}
+
+func (d *Digest) bufferSize() int {
+	return 5 * int(math.Ceil(d.compression))
+}
+
+// compress merges the buffered centroids into the centroids of d, combining
+// adjacent centroids as long as each covers no more than one unit of the
+// arcsine scale function k(q) = compression/(2π)·asin(2q-1).
+func (d *Digest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := append(d.buffer, d.centroids...)
+	slices.SortFunc(all, func(a, b centroid) int {
+		switch {
+		case a.mean < b.mean:
+			return -1
+		case a.mean > b.mean:
+			return 1
+		}
+		return 0
+	})
+	var total float64
+	for _, c := range all {
+		total += c.weight
+	}
+	out := make([]centroid, 0, len(d.centroids)+1)
+	cur := all[0]
+	var sofar float64
+	klow := d.scale(0)
+	for _, c := range all[1:] {
+		if d.scale((sofar+cur.weight+c.weight)/total)-klow <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		out = append(out, cur)
+		sofar += cur.weight
+		klow = d.scale(sofar / total)
+		cur = c
+	}
+	d.centroids = append(out, cur)
+	d.buffer = d.buffer[:0]
+}
+
+func (d *Digest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*min(q, 1)-1)
+}
+
+// MarshalBinary encodes d as its compression, minimum, maximum, and the mean
+// and weight of each centroid, all as little-endian float64s.
+func (d *Digest) MarshalBinary() ([]byte, error) {
+	d.compress()
+	b := make([]byte, 0, 8*(3+2*len(d.centroids)))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.compression))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.min))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.max))
+	for _, c := range d.centroids {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.mean))
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.weight))
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes a digest encoded by MarshalBinary into d.
+func (d *Digest) UnmarshalBinary(b []byte) error {
+	if len(b) < 24 || len(b)%16 != 8 {
+		return errors.New("t-digest: invalid encoding")
+	}
+	next := func() float64 {
+		f := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+		return f
+	}
+	d.compression = next()
+	d.min = next()
+	d.max = next()
+	d.buffer = nil
+	d.centroids = make([]centroid, 0, len(b)/16)
+	for len(b) > 0 {
+		mean := next()
+		weight := next()
+		if weight <= 0 {
+			return errors.New("t-digest: invalid centroid weight")
+		}
+		d.centroids = append(d.centroids, centroid{mean, weight})
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantileSmall(t *testing.T) {
+	d := New(DefaultCompression)
+	assert.True(t, math.IsNaN(d.Quantile(0.5)))
+	for i := range 10 {
+		d.Add(float64(i + 1))
+	}
+	assert.Equal(t, 10.0, d.Count())
+	assert.Equal(t, 1.0, d.Quantile(0))
+	assert.Equal(t, 5.5, d.Quantile(0.5))
+	assert.Equal(t, 10.0, d.Quantile(1))
+}
+
+func TestQuantileMerge(t *testing.T) {
+	const n = 100000
+	r := rand.New(rand.NewPCG(1, 2))
+	parts := []*Digest{New(DefaultCompression), New(DefaultCompression), New(DefaultCompression)}
+	for _, x := range r.Perm(n) {
+		parts[x%len(parts)].Add(float64(x))
+	}
+	d := New(DefaultCompression)
+	for _, p := range parts {
+		b, err := p.MarshalBinary()
+		require.NoError(t, err)
+		var u Digest
+		require.NoError(t, u.UnmarshalBinary(b))
+		d.Merge(&u)
+	}
+	assert.Equal(t, float64(n), d.Count())
+	assert.Less(t, len(d.centroids), 2*DefaultCompression)
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.99, 0.999} {
+		assert.InDelta(t, q*n, d.Quantile(q), 0.01*n, "q=%g", q)
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	var d Digest
+	assert.Error(t, d.UnmarshalBinary([]byte{1, 2, 3}))
+}
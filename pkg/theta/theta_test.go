@@ -0,0 +1,76 @@
+package theta
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sketch(from, to int) *Sketch {
+	s := New(DefaultK)
+	for i := from; i < to; i++ {
+		s.Add([]byte(strconv.Itoa(i)))
+	}
+	return s
+}
+
+func TestExact(t *testing.T) {
+	s := sketch(0, 100)
+	s.Add([]byte("0"))
+	assert.Equal(t, 100.0, s.Estimate())
+	assert.Equal(t, 50.0, Intersect(s, sketch(50, 200)).Estimate())
+	assert.Equal(t, 50.0, Difference(s, sketch(50, 200)).Estimate())
+	assert.Equal(t, 200.0, Union(s, sketch(50, 200)).Estimate())
+}
+
+func TestEstimate(t *testing.T) {
+	a := sketch(0, 100000)
+	b := sketch(50000, 200000)
+	const tolerance = 0.05
+	assert.InEpsilon(t, 100000, a.Estimate(), tolerance)
+	assert.InEpsilon(t, 200000, Union(a, b).Estimate(), tolerance)
+	assert.InEpsilon(t, 50000, Intersect(a, b).Estimate(), tolerance)
+	assert.InEpsilon(t, 50000, Difference(a, b).Estimate(), tolerance)
+	assert.Equal(t, 0.0, Intersect(sketch(0, 100000), sketch(100000, 200000)).Estimate())
+}
+
+func TestMarshal(t *testing.T) {
+	a := sketch(0, 100000)
+	b, err := a.MarshalBinary()
+	require.NoError(t, err)
+	var u Sketch
+	require.NoError(t, u.UnmarshalBinary(b))
+	assert.Equal(t, a.Estimate(), u.Estimate())
+	u.Merge(sketch(100000, 200000))
+	assert.InEpsilon(t, 200000, u.Estimate(), 0.05)
+	assert.Error(t, u.UnmarshalBinary(b[:20]))
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	encode := func(k, theta uint64, hashes ...uint64) []byte {
+		b := binary.LittleEndian.AppendUint64(nil, k)
+		b = binary.LittleEndian.AppendUint64(b, theta)
+		for _, h := range hashes {
+			b = binary.LittleEndian.AppendUint64(b, h)
+		}
+		return b
+	}
+	var s Sketch
+	require.NoError(t, s.UnmarshalBinary(encode(4, 10, 1, 2, 9)))
+	for name, b := range map[string][]byte{
+		"zero k":      encode(0, 10),
+		"too many":    encode(2, 10, 1, 2, 3),
+		"unsorted":    encode(4, 10, 2, 1),
+		"duplicate":   encode(4, 10, 1, 1),
+		"equal theta": encode(4, 10, 1, 10),
+		"above theta": encode(4, 10, 11),
+	} {
+		assert.Error(t, s.UnmarshalBinary(b), name)
+	}
+	// A failed decoding leaves the sketch unchanged.
+	assert.Equal(t, 3.0/(10.0/math.MaxUint64), s.Estimate())
+}
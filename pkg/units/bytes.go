@@ -2,6 +2,7 @@ package units
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/alecthomas/units"
 )
@@ -26,6 +27,16 @@ func (b *Bytes) Set(s string) error {
 	return nil
 }
 
+// UnmarshalText implements encoding.TextUnmarshaler.  In addition to the
+// forms accepted by Set, it accepts a plain number of bytes.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if n, err := strconv.ParseInt(string(text), 10, 64); err == nil {
+		*b = Bytes(n)
+		return nil
+	}
+	return b.Set(string(text))
+}
+
 func format(b units.MetricBytes, suffix string, unit units.MetricBytes) string {
 	amt := b / unit
 	if amt*unit == b {
@@ -13,3 +13,12 @@ func TestBytesAbrrev(t *testing.T) {
 	require.Exactly(t, "1.23MB", Bytes(1_234_000).Abbrev())
 	require.Exactly(t, "1.23GB", Bytes(1_234_000_000).Abbrev())
 }
+
+func TestBytesUnmarshalText(t *testing.T) {
+	var b Bytes
+	require.NoError(t, b.UnmarshalText([]byte("1000")))
+	require.Exactly(t, Bytes(1000), b)
+	require.NoError(t, b.UnmarshalText([]byte("64MiB")))
+	require.Exactly(t, Bytes(64*1024*1024), b)
+	require.Error(t, b.UnmarshalText([]byte("64 apples")))
+}
@@ -276,6 +276,46 @@ func (t *TypeOfUint64) Kind() Kind {
 	return PrimitiveKind
 }
 
+type TypeOfInt128 struct{}
+
+func (t *TypeOfInt128) ID() int {
+	return IDInt128
+}
+
+func (t *TypeOfInt128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfUint128 struct{}
+
+func (t *TypeOfUint128) ID() int {
+	return IDUint128
+}
+
+func (t *TypeOfUint128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfInt256 struct{}
+
+func (t *TypeOfInt256) ID() int {
+	return IDInt256
+}
+
+func (t *TypeOfInt256) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfUint256 struct{}
+
+func (t *TypeOfUint256) ID() int {
+	return IDUint256
+}
+
+func (t *TypeOfUint256) Kind() Kind {
+	return PrimitiveKind
+}
+
 type TypeOfIP struct{}
 
 func AppendIP(zb scode.Bytes, a netip.Addr) scode.Bytes {
@@ -13,6 +13,8 @@ import (
 type Compiler interface {
 	NewQuery(*Context, *parser.AST, []vio.Puller, int) (Query, error)
 	NewDeleteQuery(*Context, *parser.AST, *dbid.Commitish) (DeleteQuery, error)
+	NewUpdateQuery(*Context, *parser.AST, *parser.AST, *dbid.Commitish) (Query, error)
+	NewMergeQuery(*Context, *parser.AST, *dbid.Commitish) (Query, error)
 }
 
 type Query interface {
@@ -15,7 +15,9 @@ type Context struct {
 	// (e.g., removing temporary files) before Cancel returns.
 	WaitGroup sync.WaitGroup
 	Sctx      *super.Context
-	cancel    context.CancelFunc
+	// Memory accounts for the memory used by the hash tables of operators.
+	Memory *Memory
+	cancel context.CancelFunc
 }
 
 func NewContext(ctx context.Context, sctx *super.Context) *Context {
@@ -24,6 +26,7 @@ func NewContext(ctx context.Context, sctx *super.Context) *Context {
 		Context: ctx,
 		cancel:  cancel,
 		Sctx:    sctx,
+		Memory:  NewMemory(MemMaxBytes),
 	}
 }
 
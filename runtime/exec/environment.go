@@ -25,10 +25,40 @@ type ConcurrentPuller interface {
 	ConcurrentPull(done bool, id int) (vector.Any, error)
 }
 
+// Access is the kind of access a query makes to a pool.
+type Access int
+
+const (
+	AccessRead Access = iota
+	AccessLoad
+	// AccessList is the access of a query that lists a pool or branch in
+	// database metadata.  A denial omits the pool or branch from the
+	// listing rather than failing the query.
+	AccessList
+)
+
+// A Restriction limits the values of a pool visible to a query.  Text is
+// the text of a query whose operators are applied to every scan of the
+// pool, and Params binds the parameters referenced by Text to SUP values.
+type Restriction struct {
+	Text   string
+	Params map[string]string
+}
+
 type Environment struct {
 	engine storage.Engine
 	db     *db.Root
 
+	// Authorize, if not nil, is called during semantic analysis for each
+	// pool a query reads or loads, and at run time for each pool and branch
+	// a query lists, and returns an error if the access is not permitted.
+	// Branch is empty when the query names a commit ID rather than a branch
+	// or reads or lists metadata of the pool as a whole.
+	Authorize func(ctx context.Context, pool ksuid.KSUID, branch string, access Access) error
+	// Restrict, if not nil, is called during semantic analysis for each
+	// pool a query reads and returns the restriction on the pool's values
+	// or nil if there is none.
+	Restrict         func(ctx context.Context, pool ksuid.KSUID) (*Restriction, error)
 	Dynamic          bool
 	IgnoreOpenErrors bool
 	ReaderOpts       anyio.ReaderOpts
@@ -64,6 +94,22 @@ func (e *Environment) PoolID(ctx context.Context, name string) (ksuid.KSUID, err
 	return e.db.PoolID(ctx, name)
 }
 
+// AuthorizePool calls e.Authorize if it is not nil.
+func (e *Environment) AuthorizePool(ctx context.Context, pool ksuid.KSUID, branch string, access Access) error {
+	if e.Authorize == nil {
+		return nil
+	}
+	return e.Authorize(ctx, pool, branch, access)
+}
+
+// PoolRestriction calls e.Restrict if it is not nil.
+func (e *Environment) PoolRestriction(ctx context.Context, pool ksuid.KSUID) (*Restriction, error) {
+	if e.Restrict == nil {
+		return nil, nil
+	}
+	return e.Restrict(ctx, pool)
+}
+
 func (e *Environment) CommitObject(ctx context.Context, id ksuid.KSUID, name string) (ksuid.KSUID, error) {
 	if e.db != nil {
 		return e.db.CommitObject(ctx, id, name)
@@ -0,0 +1,130 @@
+package joinfilter
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/index"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+)
+
+type metaFilter struct {
+	filter *Filter
+	min    field.Path
+	max    field.Path
+	expr   expr.Evaluator
+}
+
+// NewMetaFilter returns a metadata filter, as used by the CSUP, ORC, and
+// Parquet readers, that evaluates to false for metadata whose range of
+// values for path rules out every key of f and otherwise evaluates e.  A
+// nil e is treated as true.
+func NewMetaFilter(f *Filter, path field.Path, e expr.Evaluator) expr.Evaluator {
+	return &metaFilter{
+		filter: f,
+		min:    append(slices.Clone(path), "min"),
+		max:    append(slices.Clone(path), "max"),
+		expr:   e,
+	}
+}
+
+func (m *metaFilter) Eval(this super.Value) super.Value {
+	if min, max := this.DerefPath(m.min), this.DerefPath(m.max); min != nil && max != nil {
+		if m.filter.Prune(*min, *max) {
+			return super.False
+		}
+	}
+	if m.expr == nil {
+		return super.True
+	}
+	return m.expr.Eval(this)
+}
+
+type pruner struct {
+	filter *Filter
+	defuse *function.Defuse
+	expr   expr.Evaluator
+}
+
+// NewPruner returns a pruner for the data objects of a pool, as used by
+// the pool lister, that evaluates to true for an object whose range of
+// pool key values rules out every key of f and otherwise evaluates e.
+// A nil e is treated as false.
+func NewPruner(sctx *super.Context, f *Filter, e expr.Evaluator) expr.Evaluator {
+	return &pruner{filter: f, defuse: function.NewDefuse(sctx), expr: e}
+}
+
+func (p *pruner) Eval(this super.Value) super.Value {
+	if min, max := this.Deref("min"), this.Deref("max"); min != nil && max != nil {
+		// The bounds of a data object are fused to type any.
+		min, max := p.defuse.Call([]super.Value{*min}), p.defuse.Call([]super.Value{*max})
+		if p.filter.Prune(min, max) {
+			return super.True
+		}
+	}
+	if p.expr == nil {
+		return super.False
+	}
+	return p.expr.Eval(this)
+}
+
+type indexFilter struct {
+	filter *Filter
+	path   field.Path
+	once   sync.Once
+	index  index.Filter
+}
+
+// NewIndexFilter returns an index filter that prunes a data object whose
+// index for path rules out every key of f.
+func NewIndexFilter(f *Filter, path field.Path) index.Filter {
+	return &indexFilter{filter: f, path: path}
+}
+
+func (i *indexFilter) Prune(o *index.Object) bool {
+	if !i.filter.Published() {
+		return false
+	}
+	i.once.Do(func() {
+		i.index = i.filter.newIndexFilter(i.path)
+	})
+	return i.index != nil && i.index.Prune(o)
+}
+
+// newIndexFilter returns an index filter equivalent to f for path.  An
+// object is pruned if it is ruled out by the filter for both the numeric
+// and string keys, so when there are no keys of one kind, only the keys of
+// the other kind are considered.
+func (f *Filter) newIndexFilter(path field.Path) index.Filter {
+	if f.disabled || f.nums.n == 0 && f.strs.n == 0 {
+		return nil
+	}
+	nums := indexKeys(path, &f.nums, super.NewFloat64)
+	strs := indexKeys(path, &f.strs, super.NewString)
+	switch {
+	case f.nums.n == 0:
+		return strs
+	case f.strs.n == 0:
+		return nums
+	}
+	return index.NewOr(nums, strs)
+}
+
+func indexKeys[T float64 | string](path field.Path, k *keys[T], newValue func(T) super.Value) index.Filter {
+	if k.n == 0 {
+		return nil
+	}
+	if k.vals == nil {
+		return index.NewAnd(
+			index.NewCompare(">=", path, newValue(k.min)),
+			index.NewCompare("<=", path, newValue(k.max)))
+	}
+	var vals []super.Value
+	for _, v := range k.vals {
+		vals = append(vals, newValue(v))
+	}
+	return index.NewIn(path, vals)
+}
@@ -0,0 +1,163 @@
+// Package joinfilter implements the runtime filters that a hash join
+// publishes to the scan on its probe side.  Once the join has built its
+// table, the filter describes the keys of the table so the scan can skip
+// objects and row groups whose keys cannot match any of them before
+// reading their values.
+package joinfilter
+
+import (
+	"math"
+	"slices"
+	"sync/atomic"
+
+	"github.com/brimdata/super"
+)
+
+// MaxValues is the largest number of distinct keys of each kind, numbers
+// and strings, that a Filter tracks individually.  Beyond this, a Filter
+// tracks only the range of the keys.
+const MaxValues = 1024
+
+// A Filter describes the keys of a hash join's table.  Keys are added by
+// the join while it builds its table and the Filter is consulted by scans
+// only after the join publishes it.  As in db/index, numbers are compared as
+// float64s and strings are compared bytewise, so a Filter never rules out a
+// value that equals a key, whatever their types.
+type Filter struct {
+	published atomic.Bool
+	// disabled is true if some key is neither a number nor a string or is
+	// null, in which case nothing can be ruled out.
+	disabled bool
+	nums     keys[float64]
+	strs     keys[string]
+}
+
+type keys[T float64 | string] struct {
+	n        int
+	min, max T
+	// set holds the distinct keys until there are more than MaxValues.
+	set map[T]struct{}
+	// vals holds the keys of set in order once the Filter is published.
+	vals []T
+}
+
+func (k *keys[T]) add(v T) {
+	if k.n == 0 || v < k.min {
+		k.min = v
+	}
+	if k.n == 0 || v > k.max {
+		k.max = v
+	}
+	k.n++
+	if k.set == nil && k.n == 1 {
+		k.set = map[T]struct{}{}
+	}
+	if k.set != nil {
+		k.set[v] = struct{}{}
+		if len(k.set) > MaxValues {
+			k.set = nil
+		}
+	}
+}
+
+func (k *keys[T]) publish() {
+	if k.set != nil {
+		k.vals = make([]T, 0, len(k.set))
+		for v := range k.set {
+			k.vals = append(k.vals, v)
+		}
+		slices.Sort(k.vals)
+		k.set = nil
+	}
+}
+
+// overlaps returns true if a key might lie in the range [min, max].
+func (k *keys[T]) overlaps(min, max T) bool {
+	if k.n == 0 || max < k.min || min > k.max {
+		return false
+	}
+	if k.vals == nil {
+		return true
+	}
+	i, _ := slices.BinarySearch(k.vals, min)
+	return i < len(k.vals) && k.vals[i] <= max
+}
+
+func New() *Filter {
+	return &Filter{}
+}
+
+// Add adds key to f.  It must not be called after Publish.
+func (f *Filter) Add(key super.Value) {
+	if f.disabled {
+		return
+	}
+	key = key.Under()
+	if key.IsNull() {
+		// Null keys match null keys.
+		f.disabled = true
+		return
+	}
+	if v, ok := number(key); ok {
+		f.nums.add(v)
+		return
+	}
+	if key.Type().ID() == super.IDString {
+		f.strs.add(super.DecodeString(key.Bytes()))
+		return
+	}
+	f.disabled = true
+}
+
+// Publish makes f visible to scans.  Keys may not be added to f once it is
+// published.
+func (f *Filter) Publish() {
+	f.nums.publish()
+	f.strs.publish()
+	f.published.Store(true)
+}
+
+// Published returns true if f has been published.
+func (f *Filter) Published() bool {
+	return f.published.Load()
+}
+
+// Prune returns true if f has been published and none of its keys lies in
+// the range of values from min to max, as found in the metadata of an
+// object or row group.
+func (f *Filter) Prune(min, max super.Value) bool {
+	if !f.Published() || f.disabled {
+		return false
+	}
+	min, max = min.Under(), max.Under()
+	if min.IsNull() || max.IsNull() {
+		return false
+	}
+	if lo, ok := number(min); ok {
+		hi, ok := number(max)
+		return ok && !f.nums.overlaps(lo, hi)
+	}
+	if min.Type().ID() == super.IDString && max.Type().ID() == super.IDString {
+		lo, hi := super.DecodeString(min.Bytes()), super.DecodeString(max.Bytes())
+		return !f.strs.overlaps(lo, hi)
+	}
+	return false
+}
+
+// number returns the value of val as a float64 if val is a number other
+// than NaN.
+func number(val super.Value) (float64, bool) {
+	id := val.Type().ID()
+	switch {
+	case !super.IsNumber(id):
+		return 0, false
+	case id <= super.IDUint64:
+		return float64(val.Uint()), true
+	case id >= super.IDInt8 && id <= super.IDInt64, id == super.IDDuration, id == super.IDTime:
+		return float64(val.Int()), true
+	case id >= super.IDFloat16 && id <= super.IDFloat64:
+		f := val.Float()
+		return f, !math.IsNaN(f)
+	}
+	return 0, false
+}
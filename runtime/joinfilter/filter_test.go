@@ -0,0 +1,69 @@
+package joinfilter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/joinfilter"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, s string) super.Value {
+	val, err := sup.ParseValue(super.NewContext(), s)
+	require.NoError(t, err)
+	return val
+}
+
+func build(t *testing.T, keys string) *joinfilter.Filter {
+	f := joinfilter.New()
+	for _, s := range strings.Fields(keys) {
+		f.Add(parse(t, s))
+	}
+	return f
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		keys     string
+		min, max string
+		prune    bool
+	}{
+		{"1 5 10", "6", "9", true},
+		{"1 5 10", "4", "6", false},
+		{"1 5 10", "11", "20", true},
+		{"1 5 10", "0", "1", false},
+		{"1 5 10", "5.::float32", "5.", false},
+		{"1 5 10", `"a"`, `"z"`, true},
+		{`"b" "d"`, `"c"`, `"cz"`, true},
+		{`"b" "d"`, `"c"`, `"d"`, false},
+		{`"b" "d"`, "1", "2", true},
+		{`"b" 3`, "1", "2", true},
+		{`"b" 3`, "1", "3", false},
+		{"1 null", "6", "9", false},
+		{"1 127.0.0.1", "6", "9", false},
+		{"1 5 10", "true", "true", false},
+		{"1 5 10", "null", "9", false},
+	}
+	for _, tc := range tests {
+		f := build(t, tc.keys)
+		min, max := parse(t, tc.min), parse(t, tc.max)
+		assert.False(t, f.Prune(min, max), "unpublished keys %s", tc.keys)
+		f.Publish()
+		assert.Equal(t, tc.prune, f.Prune(min, max), "keys %s range %s to %s", tc.keys, tc.min, tc.max)
+	}
+}
+
+func TestFilterRange(t *testing.T) {
+	f := joinfilter.New()
+	for i := range joinfilter.MaxValues + 1 {
+		f.Add(super.NewInt64(int64(2 * i)))
+	}
+	f.Publish()
+	// With more than MaxValues keys, only the range of keys is tracked.
+	assert.False(t, f.Prune(super.NewInt64(1), super.NewInt64(1)))
+	assert.True(t, f.Prune(super.NewInt64(-10), super.NewInt64(-1)))
+	assert.True(t, f.Prune(super.NewInt64(2*joinfilter.MaxValues+1), super.NewInt64(10000)))
+}
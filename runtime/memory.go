@@ -0,0 +1,41 @@
+package runtime
+
+import "sync/atomic"
+
+// MemMaxBytes is the default budget for the memory held by the hash tables
+// of the operators of a query.  Operators that exceed the budget spill their
+// state to temporary files.
+var MemMaxBytes = 1024 * 1024 * 1024
+
+// Memory accounts for the memory used by operators against a budget shared
+// by the operators of a query.  Accounting is approximate and cooperative:
+// operators report what they allocate and release.
+type Memory struct {
+	max  int64
+	used atomic.Int64
+}
+
+func NewMemory(max int) *Memory {
+	return &Memory{max: int64(max)}
+}
+
+// Grow reserves n bytes and reports whether the reservation fits in the
+// budget.  If it does not, nothing is reserved and the caller should release
+// memory, e.g., by spilling, before trying again.
+func (m *Memory) Grow(n int) bool {
+	if m.used.Add(int64(n)) > m.max {
+		m.used.Add(-int64(n))
+		return false
+	}
+	return true
+}
+
+// Shrink releases n bytes reserved by Grow.
+func (m *Memory) Shrink(n int) {
+	m.used.Add(-int64(n))
+}
+
+// Used returns the number of bytes reserved.
+func (m *Memory) Used() int {
+	return int(m.used.Load())
+}
@@ -2,6 +2,7 @@ package agg
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/anymath"
@@ -81,6 +82,29 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 		pattern = func() Function {
 			return &And{}
 		}
+	case "stddev", "stddev_samp", "stddev_pop", "variance", "var_samp", "var_pop":
+		sample := !strings.HasSuffix(op, "_pop")
+		sqrt := strings.HasPrefix(op, "stddev")
+		pattern = func() Function {
+			return newVariance(sample, sqrt)
+		}
+	case "median", "percentile_cont", "percentile_disc":
+		pattern = func() Function {
+			return newPercentile(op)
+		}
+	case "approx_quantile":
+		pattern = func() Function {
+			return newApproxQuantile()
+		}
+	case "hll_sketch", "hll_merge", "tdigest_sketch", "tdigest_merge", "theta_sketch", "theta_merge":
+		pattern = func() Function {
+			return newSketch(op)
+		}
+	case "corr", "covar_pop", "covar_samp", "regr_avgx", "regr_avgy", "regr_count",
+		"regr_intercept", "regr_r2", "regr_slope", "regr_sxx", "regr_sxy", "regr_syy":
+		pattern = func() Function {
+			return newRegr(op)
+		}
 	case "or":
 		pattern = func() Function {
 			return &Or{}
@@ -100,3 +124,16 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 	}
 	return pattern, nil
 }
+
+// NumArgs returns the number of arguments of the aggregate function op.
+// Functions of more than one argument receive them as a record with fields
+// c0, c1, and so on.
+func NumArgs(op string) int {
+	switch op {
+	case "approx_quantile", "percentile_cont", "percentile_disc", "corr", "covar_pop", "covar_samp",
+		"regr_avgx", "regr_avgy", "regr_count", "regr_intercept", "regr_r2", "regr_slope",
+		"regr_sxx", "regr_sxy", "regr_syy":
+		return 2
+	}
+	return 1
+}
@@ -10,9 +10,18 @@ import (
 	"github.com/brimdata/super/sup"
 )
 
+// Avg averages numbers as float64 except when decimals are mixed only
+// with integers, in which case the sum is exact and the average is a
+// decimal.
 type Avg struct {
 	sum   float64
 	count uint64
+	// dec is the exact sum from the first decimal until a float is
+	// consumed, if ever.  digits is the largest number of digits of the
+	// integers summed in sum before it.
+	dec    *Decimal
+	digits int
+	float  bool
 }
 
 var _ Function = (*Avg)(nil)
@@ -21,14 +30,46 @@ func (a *Avg) Consume(val super.Value) {
 	if val.IsNull() {
 		return
 	}
+	if a.decimal(val.Type()) {
+		if a.dec.Update(val) {
+			a.count++
+		}
+		return
+	}
 	if d, ok := coerce.ToFloat(val, super.TypeFloat64); ok {
 		a.sum += float64(d)
 		a.count++
 	}
 }
 
-func (a *Avg) Result(*super.Context) super.Value {
+// decimal updates the state for a value of type typ and returns true if
+// the value belongs in the decimal sum.
+func (a *Avg) decimal(typ super.Type) bool {
+	switch id := super.TypeUnder(typ).ID(); {
+	case super.IsFloat(id):
+		if a.dec != nil {
+			a.sum = a.dec.Float64()
+			a.dec = nil
+		}
+		a.float = true
+	case super.IsInteger(id):
+		if a.dec == nil {
+			a.digits = max(a.digits, coerce.IntegerPrecision(id))
+		}
+	case super.IsDecimal(typ):
+		if a.dec == nil && !a.float {
+			a.dec = NewDecimalSum(a.sum, a.digits)
+			a.sum = 0
+		}
+	}
+	return a.dec != nil
+}
+
+func (a *Avg) Result(sctx *super.Context) super.Value {
 	if a.count > 0 {
+		if a.dec != nil {
+			return a.dec.Avg(sctx, a.count)
+		}
 		return super.NewFloat64(a.sum / float64(a.count))
 	}
 	return super.Null
@@ -44,7 +85,7 @@ func (a *Avg) ConsumeAsPartial(partial super.Value) {
 	if sumVal.IsMissing() {
 		panic(errors.New("avg: partial sum is missing"))
 	}
-	if sumVal.Type() != super.TypeFloat64 {
+	if sumVal.Type() != super.TypeFloat64 && !super.IsDecimal(sumVal.Type()) {
 		panic(fmt.Errorf("avg: partial sum has bad type: %s", sup.FormatValue(*sumVal)))
 	}
 	countVal := partial.Deref(countName)
@@ -54,16 +95,27 @@ func (a *Avg) ConsumeAsPartial(partial super.Value) {
 	if countVal.Type() != super.TypeUint64 {
 		panic(fmt.Errorf("avg: partial count has bad type: %s", sup.FormatValue(*countVal)))
 	}
-	a.sum += sumVal.Float()
+	switch {
+	case a.decimal(sumVal.Type()):
+		a.dec.Update(*sumVal)
+	case super.IsDecimal(sumVal.Type()):
+		a.sum += coerce.DecimalToFloat(*sumVal)
+	default:
+		a.sum += sumVal.Float()
+	}
 	a.count += countVal.Uint()
 }
 
 func (a *Avg) ResultAsPartial(sctx *super.Context) super.Value {
+	sum := super.NewFloat64(a.sum)
+	if a.dec != nil {
+		sum = a.dec.Value(sctx)
+	}
 	var b scode.Builder
-	b.Append(super.EncodeFloat64(a.sum))
+	b.Append(sum.Bytes())
 	b.Append(super.EncodeUint(a.count))
 	typ := sctx.MustLookupTypeRecord([]super.Field{
-		super.NewField(sumName, super.TypeFloat64),
+		super.NewField(sumName, sum.Type()),
 		super.NewField(countName, super.TypeUint64),
 	})
 	return super.NewValue(typ, b.Bytes())
@@ -0,0 +1,82 @@
+package agg
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+// BigInt reduces integer values to a 128- or 256-bit integer result.  The
+// state is kept exactly so the result is an error only if the final value
+// does not fit the result type.
+type BigInt struct {
+	state    *big.Int
+	outtyp   super.Type
+	function anymath.Decimal
+}
+
+func NewBigInt(f *anymath.Function, val super.Value, id int) *BigInt {
+	typ, err := super.LookupPrimitiveByID(id)
+	if err != nil {
+		panic(err)
+	}
+	b := &BigInt{outtyp: typ, function: f.Decimal}
+	if !val.IsNull() {
+		// A state carried over from a narrower type may not fit the
+		// promoted type (e.g., a uint128 state promoted to int128) so
+		// keep it regardless and let Value report any overflow.
+		b.state, _ = coerce.ToBigInt(val, typ)
+	}
+	return b
+}
+
+// Int returns the state or nil if no value has been consumed.
+func (b *BigInt) Int() *big.Int {
+	return b.state
+}
+
+// Value returns the result or an error if it overflows the result type.
+func (b *BigInt) Value(sctx *super.Context) super.Value {
+	if b.state == nil {
+		return super.NewValue(b.outtyp, nil)
+	}
+	if !super.FitsInt(b.outtyp.ID(), b.state) {
+		return sctx.NewError(coerce.ErrIntOverflow)
+	}
+	return super.NewBigInt(b.outtyp, b.state)
+}
+
+// Update folds the integer value val into the state.
+func (b *BigInt) Update(val super.Value) {
+	v, ok := coerce.ToBigInt(val, b.outtyp)
+	if !ok {
+		return
+	}
+	if b.state == nil {
+		b.state = v
+	} else {
+		b.state = b.function(b.state, v)
+	}
+}
+
+// result returns the state without checking for overflow and is used when
+// the reduction continues with another type.
+func (b *BigInt) result() super.Value {
+	if b.state == nil {
+		return super.NewValue(b.outtyp, nil)
+	}
+	return super.NewBigInt(b.outtyp, b.state)
+}
+
+// Type returns the result type.
+func (b *BigInt) Type() super.Type {
+	return b.outtyp
+}
+
+func (b *BigInt) consume(val super.Value) {
+	b.Update(val)
+}
+
+func (b *BigInt) typ() super.Type { return b.outtyp }
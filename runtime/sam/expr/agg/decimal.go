@@ -0,0 +1,134 @@
+package agg
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
+	"github.com/brimdata/super/pkg/decimal"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+// sumDigits is the number of integral digits added to the result type of
+// a sum of decimals to make room for the growth of the sum.
+const sumDigits = 10
+
+// Decimal reduces decimal and integer values to a decimal result.  Since
+// inputs may have different precisions and scales, the state is kept as an
+// unscaled value at the largest scale seen along with the largest number of
+// integral digits seen, and the result type is determined when the result is
+// computed.
+type Decimal struct {
+	state    *big.Int
+	digits   int
+	scale    int
+	grow     int
+	last     super.Type
+	function anymath.Decimal
+}
+
+func NewDecimal(f *anymath.Function, val super.Value) *Decimal {
+	d := &Decimal{function: f.Decimal}
+	if f == anymath.Add {
+		d.grow = sumDigits
+	}
+	if !val.IsNull() {
+		d.Update(val)
+	}
+	return d
+}
+
+// NewDecimalSum returns a decimal sum whose state is the integral value f
+// having the given number of integral digits.  It carries over the float64
+// sum of integers that an average consumes before its first decimal.
+func NewDecimalSum(f float64, digits int) *Decimal {
+	d := &Decimal{function: anymath.Add.Decimal, grow: sumDigits, digits: digits}
+	if digits > 0 {
+		d.state, _ = decimal.FromFloat64(f, 0)
+	}
+	return d
+}
+
+// Update folds the decimal or integer value val into the state and
+// returns false if val is neither.
+func (d *Decimal) Update(val super.Value) bool {
+	v, p, s, ok := coerce.DecimalParts(val)
+	if !ok {
+		return false
+	}
+	d.last = val.Type()
+	d.digits = max(d.digits, p-s)
+	switch {
+	case s > d.scale:
+		if d.state != nil {
+			d.state = decimal.Rescale(d.state, d.scale, s)
+		}
+		d.scale = s
+	case s < d.scale:
+		v = decimal.Rescale(v, s, d.scale)
+	}
+	if d.state == nil {
+		d.state = v
+	} else {
+		d.state = d.function(d.state, v)
+	}
+	return true
+}
+
+// Value returns the decimal result or an error if it overflows its type.
+func (d *Decimal) Value(sctx *super.Context) super.Value {
+	if d.state == nil {
+		return super.Null
+	}
+	precision := d.precision()
+	if !decimal.Fits(d.state, precision) {
+		return sctx.NewError(decimal.ErrOverflow)
+	}
+	typ := sctx.MustLookupTypeDecimal(precision, min(d.scale, precision))
+	return super.NewValue(typ, super.EncodeDecimal(d.state))
+}
+
+// Avg returns the state divided by count or an error if the quotient
+// overflows its type, which has the precision of the sum and a scale of
+// at least 6.
+func (d *Decimal) Avg(sctx *super.Context, count uint64) super.Value {
+	if d.state == nil || count == 0 {
+		return super.Null
+	}
+	precision := d.precision()
+	scale := min(max(d.scale, 6), precision)
+	v, err := decimal.Div(d.state, d.scale, new(big.Int).SetUint64(count), 0, scale)
+	if err != nil {
+		return sctx.NewError(err)
+	}
+	if !decimal.Fits(v, precision) {
+		return sctx.NewError(decimal.ErrOverflow)
+	}
+	typ := sctx.MustLookupTypeDecimal(precision, scale)
+	return super.NewValue(typ, super.EncodeDecimal(v))
+}
+
+func (d *Decimal) precision() int {
+	return max(min(d.digits+d.scale+d.grow, decimal.MaxPrecision), 1)
+}
+
+// Float64 returns the state converted to float64.
+func (d *Decimal) Float64() float64 {
+	if d.state == nil {
+		return 0
+	}
+	return decimal.Float64(d.state, d.scale)
+}
+
+func (d *Decimal) consume(val super.Value) {
+	d.Update(val)
+}
+
+// result returns the state as a float64 since a decimal result requires a
+// type context (see Value).  It is used only when a float value is mixed
+// with decimals and the reduction continues as float64.
+func (d *Decimal) result() super.Value {
+	return super.NewFloat64(d.Float64())
+}
+
+func (d *Decimal) typ() super.Type { return d.last }
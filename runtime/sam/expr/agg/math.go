@@ -36,6 +36,12 @@ func (m *mathReducer) Result(sctx *super.Context) super.Value {
 	if !m.hasval {
 		return super.Null
 	}
+	switch math := m.math.(type) {
+	case *Decimal:
+		return math.Value(sctx)
+	case *BigInt:
+		return math.Value(sctx)
+	}
 	return m.math.result()
 }
 
@@ -49,6 +55,10 @@ func (m *mathReducer) consumeVal(val super.Value) {
 		m.consumeString(val)
 		return
 	}
+	if _, ok := m.math.(*Decimal); ok || super.IsDecimal(val.Type()) {
+		m.consumeDecimal(val)
+		return
+	}
 	var id int
 	if m.math != nil {
 		var err error
@@ -70,6 +80,8 @@ func (m *mathReducer) consumeVal(val super.Value) {
 			m.math = NewInt64(m.function, state)
 		case super.IDUint8, super.IDUint16, super.IDUint32, super.IDUint64:
 			m.math = NewUint64(m.function, state)
+		case super.IDInt128, super.IDInt256, super.IDUint128, super.IDUint256:
+			m.math = NewBigInt(m.function, state, id)
 		case super.IDFloat16, super.IDFloat32, super.IDFloat64:
 			m.math = NewFloat64(m.function, state)
 		case super.IDDuration:
@@ -88,6 +100,39 @@ func (m *mathReducer) consumeVal(val super.Value) {
 	m.math.consume(val)
 }
 
+// consumeDecimal consumes val when either val or the current state is
+// a decimal.  Decimals mixed with integers reduce to a decimal while
+// decimals mixed with floats reduce to float64.
+func (m *mathReducer) consumeDecimal(val super.Value) {
+	id := val.Type().ID()
+	switch math := m.math.(type) {
+	case nil:
+		m.math = NewDecimal(m.function, super.Null)
+	case *Decimal:
+		if super.IsFloat(id) {
+			m.math = NewFloat64(m.function, math.result())
+		} else if !super.IsInteger(id) && !super.IsDecimal(val.Type()) {
+			// Skip invalid values.
+			return
+		}
+	case *Int64, *Uint64, *BigInt:
+		state := super.Null
+		if m.hasval {
+			state = math.result()
+		}
+		m.math = NewDecimal(m.function, state)
+	case *Float64:
+	default:
+		// Skip invalid values.
+		return
+	}
+	if val.IsNull() {
+		return
+	}
+	m.hasval = true
+	m.math.consume(val)
+}
+
 func (m *mathReducer) consumeString(val super.Value) {
 	if m.math == nil {
 		m.math = NewString(m.function)
@@ -100,13 +145,13 @@ func (m *mathReducer) consumeString(val super.Value) {
 		}
 		m.hasval = true
 		m.math.consume(val)
-	} else if super.IsNumber(aid) || super.IsNumber(bid) {
+	} else if super.IsNumber(aid) || super.IsNumber(bid) || super.IsDecimal(val.Type()) || super.IsDecimal(m.math.typ()) {
 		m.stringErr = true
 	}
 }
 
-func (m *mathReducer) ResultAsPartial(*super.Context) super.Value {
-	return m.Result(nil)
+func (m *mathReducer) ResultAsPartial(sctx *super.Context) super.Value {
+	return m.Result(sctx)
 }
 
 func (m *mathReducer) ConsumeAsPartial(val super.Value) {
@@ -0,0 +1,311 @@
+package agg
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sup"
+)
+
+// fraction tracks the fraction argument of a percentile function, which must
+// be the same number between 0 and 1 for every value.
+type fraction struct {
+	op    string
+	value float64
+	ok    bool
+	err   string
+}
+
+func (f *fraction) set(val *super.Value) {
+	if f.err != "" || val == nil || val.IsNull() {
+		return
+	}
+	v, ok := coerce.ToFloat(*val, super.TypeFloat64)
+	switch {
+	case !ok:
+		f.err = "fraction is not a number: " + sup.FormatValue(*val)
+	case v < 0 || v > 1 || math.IsNaN(v):
+		f.err = fmt.Sprintf("fraction %g is not between 0 and 1", v)
+	case f.ok && v != f.value:
+		f.err = "fraction must be the same for all values"
+	default:
+		f.value, f.ok = v, true
+	}
+}
+
+func (f *fraction) error(sctx *super.Context) (super.Value, bool) {
+	if f.err != "" {
+		return sctx.NewErrorf("%s: %s", f.op, f.err), true
+	}
+	return super.Value{}, false
+}
+
+// consumeError records the error of an erroneous partial.
+func (f *fraction) consumeError(partial super.Value) bool {
+	if !partial.IsError() {
+		return false
+	}
+	if f.err == "" {
+		inner := super.NewValue(super.TypeUnder(partial.Type()).(*super.TypeError).Type, partial.Bytes())
+		f.err = sup.FormatValue(inner)
+		if inner.IsString() {
+			f.err = strings.TrimPrefix(inner.AsString(), f.op+": ")
+		}
+	}
+	return true
+}
+
+const (
+	fractionName = "fraction"
+	valuesName   = "values"
+	digestName   = "digest"
+)
+
+func (f *fraction) partial() []byte {
+	if !f.ok {
+		return nil
+	}
+	return super.EncodeFloat64(f.value)
+}
+
+// Percentile computes percentile_cont, percentile_disc, and median exactly
+// by retaining every value.  Functions other than median take the value and
+// the fraction as a record with fields c0 and c1.  Since percentile_disc
+// returns one of its inputs, it retains the input values themselves while
+// the others retain them as float64.
+type Percentile struct {
+	fraction
+	disc   bool
+	values []float64
+	inputs []super.Value
+}
+
+var _ Function = (*Percentile)(nil)
+
+func newPercentile(op string) *Percentile {
+	p := &Percentile{
+		fraction: fraction{op: op},
+		disc:     op == "percentile_disc",
+	}
+	if op == "median" {
+		p.value, p.ok = 0.5, true
+	}
+	return p
+}
+
+func (p *Percentile) Consume(val super.Value) {
+	if p.op != "median" {
+		p.set(arg(val, 1))
+		v := arg(val, 0)
+		if v == nil {
+			return
+		}
+		val = *v
+	}
+	if val.IsNull() {
+		return
+	}
+	if p.disc {
+		p.consumeInput(val)
+		return
+	}
+	if f, ok := coerce.ToFloat(val, super.TypeFloat64); ok && !math.IsNaN(f) {
+		p.values = append(p.values, f)
+	}
+}
+
+func (p *Percentile) consumeInput(val super.Value) {
+	val = val.Deunion()
+	id := val.Type().ID()
+	if !super.IsNumber(id) && !super.IsDecimal(val.Type()) || val.IsNull() {
+		return
+	}
+	if super.IsFloat(id) && math.IsNaN(val.Float()) {
+		return
+	}
+	p.inputs = append(p.inputs, val.Copy())
+}
+
+func (p *Percentile) Result(sctx *super.Context) super.Value {
+	if err, ok := p.error(sctx); ok {
+		return err
+	}
+	if p.disc {
+		n := len(p.inputs)
+		if n == 0 || !p.ok {
+			return super.Null
+		}
+		slices.SortStableFunc(p.inputs, compareNumbers)
+		// The first value whose cumulative distribution is at least
+		// the fraction.
+		k := int(math.Ceil(p.value*float64(n))) - 1
+		return p.inputs[max(k, 0)]
+	}
+	n := len(p.values)
+	if n == 0 || !p.ok {
+		return super.Null
+	}
+	slices.Sort(p.values)
+	pos := p.value * float64(n-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	f := p.values[lo] + (p.values[hi]-p.values[lo])*(pos-float64(lo))
+	return super.NewFloat64(f)
+}
+
+func (p *Percentile) ConsumeAsPartial(partial super.Value) {
+	if p.consumeError(partial) {
+		return
+	}
+	p.set(partial.Deref(fractionName))
+	values := partial.Deref(valuesName)
+	if values.IsMissing() {
+		panic(fmt.Errorf("%s: partial %s is missing", p.op, valuesName))
+	}
+	if p.disc {
+		if values.IsNull() {
+			return
+		}
+		typ, ok := values.Type().(*super.TypeArray)
+		if !ok {
+			panic(fmt.Errorf("%s: partial %s has bad type: %s", p.op, valuesName, sup.FormatValue(*values)))
+		}
+		for it := values.ContainerIter(); !it.Done(); {
+			p.consumeInput(super.NewValue(typ.Type, it.Next()))
+		}
+		return
+	}
+	if typ, ok := values.Type().(*super.TypeArray); !ok || typ.Type != super.TypeFloat64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", p.op, valuesName, sup.FormatValue(*values)))
+	}
+	for it := values.ContainerIter(); !it.Done(); {
+		p.values = append(p.values, super.DecodeFloat64(it.Next()))
+	}
+}
+
+func (p *Percentile) ResultAsPartial(sctx *super.Context) super.Value {
+	if err, ok := p.error(sctx); ok {
+		return err
+	}
+	var b scode.Builder
+	b.Append(p.partial())
+	valuesType := super.Type(sctx.LookupTypeArray(super.TypeFloat64))
+	if p.disc {
+		values := newArray(sctx, p.inputs)
+		b.Append(values.Bytes())
+		valuesType = values.Type()
+	} else {
+		b.BeginContainer()
+		for _, f := range p.values {
+			b.Append(super.EncodeFloat64(f))
+		}
+		b.EndContainer()
+	}
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField(fractionName, super.TypeFloat64),
+		super.NewField(valuesName, valuesType),
+	})
+	return super.NewValue(typ, b.Bytes())
+}
+
+// compareNumbers orders the numbers a and b, comparing integers, times, and
+// decimals by their exact values.
+func compareNumbers(a, b super.Value) int {
+	aid, bid := a.Type().ID(), b.Type().ID()
+	switch {
+	case super.IsDecimal(a.Type()) || super.IsDecimal(b.Type()):
+		c, _ := coerce.CompareDecimal(a, b)
+		return c
+	case super.IsBigInt(aid) || super.IsBigInt(bid):
+		c, _ := coerce.CompareBigInt(a, b)
+		return c
+	case super.IsFloat(aid) || super.IsFloat(bid):
+		return cmp.Compare(coerce.ToNumeric[float64](a), coerce.ToNumeric[float64](b))
+	case super.IsSigned(aid) && super.IsSigned(bid):
+		return cmp.Compare(a.Int(), b.Int())
+	case super.IsUnsigned(aid) && super.IsUnsigned(bid):
+		return cmp.Compare(a.Uint(), b.Uint())
+	case super.IsSigned(aid):
+		if a.Int() < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(a.Int()), b.Uint())
+	default:
+		if b.Int() < 0 {
+			return 1
+		}
+		return cmp.Compare(a.Uint(), uint64(b.Int()))
+	}
+}
+
+// ApproxQuantile estimates a quantile with a t-digest, which requires far
+// less memory than Percentile.  It takes the value and the quantile as a
+// record with fields c0 and c1.
+type ApproxQuantile struct {
+	fraction
+	digest *tdigest.Digest
+}
+
+var _ Function = (*ApproxQuantile)(nil)
+
+func newApproxQuantile() *ApproxQuantile {
+	return &ApproxQuantile{
+		fraction: fraction{op: "approx_quantile"},
+		digest:   tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (a *ApproxQuantile) Consume(val super.Value) {
+	a.set(arg(val, 1))
+	if f, ok := argFloat(val, 0); ok {
+		a.digest.Add(f)
+	}
+}
+
+func (a *ApproxQuantile) Result(sctx *super.Context) super.Value {
+	if err, ok := a.error(sctx); ok {
+		return err
+	}
+	if a.digest.Count() == 0 || !a.ok {
+		return super.Null
+	}
+	return super.NewFloat64(a.digest.Quantile(a.value))
+}
+
+func (a *ApproxQuantile) ConsumeAsPartial(partial super.Value) {
+	if a.consumeError(partial) {
+		return
+	}
+	a.set(partial.Deref(fractionName))
+	b := partialField(a.op, partial, digestName, super.TypeBytes).Bytes()
+	var d tdigest.Digest
+	if err := d.UnmarshalBinary(b); err != nil {
+		panic(fmt.Errorf("%s: unmarshaling partial: %w", a.op, err))
+	}
+	a.digest.Merge(&d)
+}
+
+func (a *ApproxQuantile) ResultAsPartial(sctx *super.Context) super.Value {
+	if err, ok := a.error(sctx); ok {
+		return err
+	}
+	digest, err := a.digest.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("%s: marshaling partial: %w", a.op, err))
+	}
+	var b scode.Builder
+	b.Append(a.partial())
+	b.Append(digest)
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField(fractionName, super.TypeFloat64),
+		super.NewField(digestName, super.TypeBytes),
+	})
+	return super.NewValue(typ, b.Bytes())
+}
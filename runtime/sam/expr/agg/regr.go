@@ -0,0 +1,183 @@
+package agg
+
+import (
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/scode"
+)
+
+// CoMoments accumulates the count, means, sums of squared deviations, and
+// sum of the products of deviations of a sequence of (y, x) pairs.
+type CoMoments struct {
+	N     uint64
+	MeanX float64
+	MeanY float64
+	M2X   float64
+	M2Y   float64
+	C     float64
+}
+
+func (m *CoMoments) Add(y, x float64) {
+	m.N++
+	n := float64(m.N)
+	dx := x - m.MeanX
+	dy := y - m.MeanY
+	m.MeanX += dx / n
+	m.MeanY += dy / n
+	m.M2X += dx * (x - m.MeanX)
+	m.M2Y += dy * (y - m.MeanY)
+	m.C += dx * (y - m.MeanY)
+}
+
+// Merge adds the pairs summarized by o to m.
+func (m *CoMoments) Merge(o CoMoments) {
+	if o.N == 0 {
+		return
+	}
+	n := float64(m.N + o.N)
+	w := float64(m.N) * float64(o.N) / n
+	dx := o.MeanX - m.MeanX
+	dy := o.MeanY - m.MeanY
+	m.MeanX += dx * float64(o.N) / n
+	m.MeanY += dy * float64(o.N) / n
+	m.M2X += o.M2X + dx*dx*w
+	m.M2Y += o.M2Y + dy*dy*w
+	m.C += o.C + dx*dy*w
+	m.N += o.N
+}
+
+// Regr computes the correlation, covariance, and linear regression
+// functions of SQL, which take a dependent variable y and an independent
+// variable x as a record with fields c0 and c1.  Pairs in which either value
+// is null or not a number are ignored.
+type Regr struct {
+	CoMoments
+	op string
+}
+
+var _ Function = (*Regr)(nil)
+
+func newRegr(op string) *Regr {
+	return &Regr{op: op}
+}
+
+func (r *Regr) Consume(val super.Value) {
+	y, ok1 := argFloat(val, 0)
+	x, ok2 := argFloat(val, 1)
+	if ok1 && ok2 {
+		r.Add(y, x)
+	}
+}
+
+func (r *Regr) Result(*super.Context) super.Value {
+	if r.op == "regr_count" {
+		return super.NewInt64(int64(r.N))
+	}
+	if r.N == 0 {
+		return super.Null
+	}
+	n := float64(r.N)
+	var f float64
+	switch r.op {
+	case "corr":
+		if r.M2X == 0 || r.M2Y == 0 {
+			return super.Null
+		}
+		f = r.C / math.Sqrt(r.M2X*r.M2Y)
+	case "covar_pop":
+		f = r.C / n
+	case "covar_samp":
+		if r.N < 2 {
+			return super.Null
+		}
+		f = r.C / (n - 1)
+	case "regr_avgx":
+		f = r.MeanX
+	case "regr_avgy":
+		f = r.MeanY
+	case "regr_sxx":
+		f = r.M2X
+	case "regr_syy":
+		f = r.M2Y
+	case "regr_sxy":
+		f = r.C
+	case "regr_slope":
+		if r.M2X == 0 {
+			return super.Null
+		}
+		f = r.C / r.M2X
+	case "regr_intercept":
+		if r.M2X == 0 {
+			return super.Null
+		}
+		f = r.MeanY - r.C/r.M2X*r.MeanX
+	case "regr_r2":
+		if r.M2X == 0 {
+			return super.Null
+		}
+		if r.M2Y == 0 {
+			return super.NewFloat64(1)
+		}
+		f = r.C * r.C / (r.M2X * r.M2Y)
+	default:
+		panic(r.op)
+	}
+	return super.NewFloat64(f)
+}
+
+const (
+	meanXName = "mean_x"
+	meanYName = "mean_y"
+	m2XName   = "m2_x"
+	m2YName   = "m2_y"
+	cName     = "c"
+)
+
+func (r *Regr) ConsumeAsPartial(partial super.Value) {
+	r.Merge(CoMoments{
+		N:     partialUint(r.op, partial, countName),
+		MeanX: partialFloat(r.op, partial, meanXName),
+		MeanY: partialFloat(r.op, partial, meanYName),
+		M2X:   partialFloat(r.op, partial, m2XName),
+		M2Y:   partialFloat(r.op, partial, m2YName),
+		C:     partialFloat(r.op, partial, cName),
+	})
+}
+
+func (r *Regr) ResultAsPartial(sctx *super.Context) super.Value {
+	var b scode.Builder
+	b.Append(super.EncodeUint(r.N))
+	b.Append(super.EncodeFloat64(r.MeanX))
+	b.Append(super.EncodeFloat64(r.MeanY))
+	b.Append(super.EncodeFloat64(r.M2X))
+	b.Append(super.EncodeFloat64(r.M2Y))
+	b.Append(super.EncodeFloat64(r.C))
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField(countName, super.TypeUint64),
+		super.NewField(meanXName, super.TypeFloat64),
+		super.NewField(meanYName, super.TypeFloat64),
+		super.NewField(m2XName, super.TypeFloat64),
+		super.NewField(m2YName, super.TypeFloat64),
+		super.NewField(cName, super.TypeFloat64),
+	})
+	return super.NewValue(typ, b.Bytes())
+}
+
+// arg returns argument k of a function taking several arguments, which are
+// passed as a record with fields c0, c1, and so on.
+func arg(val super.Value, k int) *super.Value {
+	if _, ok := super.TypeUnder(val.Type()).(*super.TypeRecord); !ok || val.IsNull() {
+		return nil
+	}
+	return val.DerefByColumn(k)
+}
+
+func argFloat(val super.Value, k int) (float64, bool) {
+	v := arg(val, k)
+	if v == nil || v.IsNull() {
+		return 0, false
+	}
+	return coerce.ToFloat(*v, super.TypeFloat64)
+}
@@ -0,0 +1,224 @@
+package agg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/pkg/theta"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+)
+
+// Names of the types of sketch values, each of which is a named bytes type.
+const (
+	HLLTypeName     = "hll"
+	TDigestTypeName = "tdigest"
+	ThetaTypeName   = "theta"
+)
+
+type sketch interface {
+	add(super.Value)
+	merge([]byte) error
+	marshal() []byte
+}
+
+// Sketch computes a sketch of its input values or, for the merge functions,
+// of the union of its input sketches.  The result is a named bytes value
+// whose type name identifies the kind of sketch.
+type Sketch struct {
+	op       string
+	typeName string
+	sketch   sketch
+	merge    bool
+	err      string
+}
+
+var _ Function = (*Sketch)(nil)
+
+func newSketch(op string) *Sketch {
+	s := &Sketch{
+		op:    op,
+		merge: strings.HasSuffix(op, "_merge"),
+	}
+	switch op {
+	case "hll_sketch", "hll_merge":
+		s.typeName = HLLTypeName
+		s.sketch = &hllSketch{Sketch: hyperloglog.New()}
+	case "tdigest_sketch", "tdigest_merge":
+		s.typeName = TDigestTypeName
+		s.sketch = &tdigestSketch{tdigest.New(tdigest.DefaultCompression)}
+	case "theta_sketch", "theta_merge":
+		s.typeName = ThetaTypeName
+		s.sketch = &thetaSketch{Sketch: theta.New(theta.DefaultK)}
+	default:
+		panic(op)
+	}
+	return s
+}
+
+func (s *Sketch) Consume(val super.Value) {
+	if val.IsNull() || s.err != "" {
+		return
+	}
+	if s.merge {
+		s.consumeSketch(val)
+		return
+	}
+	s.sketch.add(val)
+}
+
+func (s *Sketch) consumeSketch(val super.Value) {
+	b, err := SketchBytes(val, s.typeName)
+	if err != nil {
+		s.err = fmt.Sprintf("%s: %s", err, sup.FormatValue(val))
+		return
+	}
+	if err := s.sketch.merge(b); err != nil {
+		s.err = fmt.Sprintf("invalid %s sketch: %s", s.typeName, err)
+	}
+}
+
+func (s *Sketch) Result(sctx *super.Context) super.Value {
+	if s.err != "" {
+		return sctx.NewErrorf("%s: %s", s.op, s.err)
+	}
+	typ, err := sctx.LookupTypeNamed(s.typeName, super.TypeBytes)
+	if err != nil {
+		panic(err)
+	}
+	return super.NewValue(typ, s.sketch.marshal())
+}
+
+func (s *Sketch) ConsumeAsPartial(partial super.Value) {
+	if s.err != "" {
+		return
+	}
+	if partial.IsError() {
+		inner := super.NewValue(super.TypeUnder(partial.Type()).(*super.TypeError).Type, partial.Bytes())
+		s.err = sup.FormatValue(inner)
+		if inner.IsString() {
+			s.err = strings.TrimPrefix(inner.AsString(), s.op+": ")
+		}
+		return
+	}
+	s.consumeSketch(partial)
+}
+
+func (s *Sketch) ResultAsPartial(sctx *super.Context) super.Value {
+	return s.Result(sctx)
+}
+
+type hllSketch struct {
+	*hyperloglog.Sketch
+	keys sketchKeys
+}
+
+func (h *hllSketch) add(val super.Value) {
+	if !val.DeunionIntoNameds().IsNull() {
+		h.Insert(h.keys.key(val))
+	}
+}
+
+func (h *hllSketch) merge(b []byte) error {
+	var s hyperloglog.Sketch
+	if err := s.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	return h.Merge(&s)
+}
+
+func (h *hllSketch) marshal() []byte {
+	b, err := h.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("hll: marshaling sketch: %w", err))
+	}
+	return b
+}
+
+type tdigestSketch struct {
+	*tdigest.Digest
+}
+
+func (t *tdigestSketch) add(val super.Value) {
+	if f, ok := coerce.ToFloat(val, super.TypeFloat64); ok {
+		t.Add(f)
+	}
+}
+
+func (t *tdigestSketch) merge(b []byte) error {
+	var d tdigest.Digest
+	if err := d.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	t.Merge(&d)
+	return nil
+}
+
+func (t *tdigestSketch) marshal() []byte {
+	b, _ := t.MarshalBinary()
+	return b
+}
+
+type thetaSketch struct {
+	*theta.Sketch
+	keys sketchKeys
+}
+
+func (t *thetaSketch) add(val super.Value) {
+	if !val.DeunionIntoNameds().IsNull() {
+		t.Add(t.keys.key(val))
+	}
+}
+
+func (t *thetaSketch) merge(b []byte) error {
+	var s theta.Sketch
+	if err := s.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	t.Merge(&s)
+	return nil
+}
+
+func (t *thetaSketch) marshal() []byte {
+	b, _ := t.MarshalBinary()
+	return b
+}
+
+// sketchKeys builds the keys of the values added to a sketch from the
+// canonical encoding of their types, as does the hash function, so equal
+// values have equal keys in sketches built with different type contexts
+// and those sketches may be merged.
+type sketchKeys struct {
+	types map[super.Type][]byte
+	buf   []byte
+}
+
+// key returns the key of val, which is valid until the next call.
+func (s *sketchKeys) key(val super.Value) []byte {
+	typ, ok := s.types[val.Type()]
+	if !ok {
+		if s.types == nil {
+			s.types = make(map[super.Type][]byte)
+		}
+		typ = super.EncodeTypeValue(val.Type())
+		s.types[val.Type()] = typ
+	}
+	s.buf = append(append(s.buf[:0], typ...), val.Bytes()...)
+	return s.buf
+}
+
+// SketchBytes returns the encoding of the sketch val whose type must be
+// named typeName or be bytes.
+func SketchBytes(val super.Value, typeName string) ([]byte, error) {
+	if named, ok := val.Type().(*super.TypeNamed); ok && named.Name != typeName {
+		return nil, errors.New("not a " + typeName + " sketch")
+	}
+	if val.Under().Type() != super.TypeBytes {
+		return nil, errors.New("not a " + typeName + " sketch")
+	}
+	return val.Under().Bytes(), nil
+}
@@ -0,0 +1,122 @@
+package agg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sup"
+)
+
+// Moments accumulates the count, mean, and sum of squared deviations from
+// the mean of a sequence of numbers using Welford's algorithm, which avoids
+// the loss of precision of summing squares.
+type Moments struct {
+	N    uint64
+	Mean float64
+	M2   float64
+}
+
+func (m *Moments) Add(x float64) {
+	m.N++
+	delta := x - m.Mean
+	m.Mean += delta / float64(m.N)
+	m.M2 += delta * (x - m.Mean)
+}
+
+// Merge adds the numbers summarized by o to m.
+func (m *Moments) Merge(o Moments) {
+	if o.N == 0 {
+		return
+	}
+	n := float64(m.N + o.N)
+	delta := o.Mean - m.Mean
+	m.Mean += delta * float64(o.N) / n
+	m.M2 += o.M2 + delta*delta*float64(m.N)*float64(o.N)/n
+	m.N += o.N
+}
+
+// Variance computes the population or sample variance or standard deviation
+// of numeric values.
+type Variance struct {
+	Moments
+	sample bool
+	sqrt   bool
+}
+
+var _ Function = (*Variance)(nil)
+
+func newVariance(sample, sqrt bool) *Variance {
+	return &Variance{sample: sample, sqrt: sqrt}
+}
+
+func (v *Variance) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	if f, ok := coerce.ToFloat(val, super.TypeFloat64); ok {
+		v.Add(f)
+	}
+}
+
+func (v *Variance) Result(*super.Context) super.Value {
+	n := v.N
+	if v.sample {
+		n--
+	}
+	if v.N == 0 || n == 0 {
+		return super.Null
+	}
+	f := v.M2 / float64(n)
+	if v.sqrt {
+		f = math.Sqrt(f)
+	}
+	return super.NewFloat64(f)
+}
+
+const (
+	meanName = "mean"
+	m2Name   = "m2"
+)
+
+func (v *Variance) ConsumeAsPartial(partial super.Value) {
+	v.Merge(Moments{
+		N:    partialUint("variance", partial, countName),
+		Mean: partialFloat("variance", partial, meanName),
+		M2:   partialFloat("variance", partial, m2Name),
+	})
+}
+
+func (v *Variance) ResultAsPartial(sctx *super.Context) super.Value {
+	var b scode.Builder
+	b.Append(super.EncodeUint(v.N))
+	b.Append(super.EncodeFloat64(v.Mean))
+	b.Append(super.EncodeFloat64(v.M2))
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField(countName, super.TypeUint64),
+		super.NewField(meanName, super.TypeFloat64),
+		super.NewField(m2Name, super.TypeFloat64),
+	})
+	return super.NewValue(typ, b.Bytes())
+}
+
+func partialField(op string, partial super.Value, name string, typ super.Type) super.Value {
+	val := partial.Deref(name)
+	if val.IsMissing() {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if val.Type() != typ {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, sup.FormatValue(*val)))
+	}
+	return *val
+}
+
+func partialFloat(op string, partial super.Value, name string) float64 {
+	return partialField(op, partial, name, super.TypeFloat64).Float()
+}
+
+func partialUint(op string, partial super.Value, name string) uint64 {
+	return partialField(op, partial, name, super.TypeUint64).Uint()
+}
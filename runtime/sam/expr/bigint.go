@@ -0,0 +1,35 @@
+package expr
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+// evalBigInt computes "lhs op rhs" where typ is the 128- or 256-bit integer
+// type to which lhs and rhs are promoted.
+func evalBigInt(sctx *super.Context, op string, typ super.Type, lhs, rhs super.Value) super.Value {
+	v, err := coerce.BigIntArith(op, typ.ID(), lhs.BigInt(), rhs.BigInt())
+	if err != nil {
+		return sctx.NewError(err)
+	}
+	return super.NewBigInt(typ, v)
+}
+
+// negBigInt negates val, whose type is a 128- or 256-bit integer.
+// An unsigned value is negated as the signed type of the same width.
+func negBigInt(sctx *super.Context, val super.Value) super.Value {
+	typ := val.Type()
+	switch typ.ID() {
+	case super.IDUint128:
+		typ = super.TypeInt128
+	case super.IDUint256:
+		typ = super.TypeInt256
+	}
+	v := new(big.Int).Neg(val.BigInt())
+	if !super.FitsInt(typ.ID(), v) {
+		return sctx.WrapError("unary '-' underflow", val)
+	}
+	return super.NewBigInt(typ, v)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/araddon/dateparse"
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/byteconv"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/sup"
@@ -18,6 +19,9 @@ func LookupPrimitiveCaster(sctx *super.Context, typ super.Type) Evaluator {
 	if enum, ok := typ.(*super.TypeEnum); ok {
 		return &casterEnum{sctx, enum}
 	}
+	if dec, ok := typ.(*super.TypeDecimal); ok {
+		return &casterDecimal{sctx, dec}
+	}
 	switch typ {
 	case super.TypeBool:
 		return &casterBool{sctx}
@@ -25,6 +29,8 @@ func LookupPrimitiveCaster(sctx *super.Context, typ super.Type) Evaluator {
 		return &casterIntN{sctx, typ}
 	case super.TypeUint8, super.TypeUint16, super.TypeUint32, super.TypeUint64:
 		return &casterUintN{sctx, typ}
+	case super.TypeInt128, super.TypeInt256, super.TypeUint128, super.TypeUint256:
+		return &casterBigInt{sctx, typ}
 	case super.TypeFloat16, super.TypeFloat32, super.TypeFloat64:
 		return &casterFloat{sctx, typ}
 	case super.TypeIP:
@@ -74,6 +80,32 @@ func (c *casterUintN) Eval(val super.Value) super.Value {
 	return super.NewUint(c.typ, v)
 }
 
+type casterBigInt struct {
+	sctx *super.Context
+	typ  super.Type
+}
+
+func (c *casterBigInt) Eval(val super.Value) super.Value {
+	v, ok := coerce.ToBigInt(val, c.typ)
+	if !ok {
+		return c.sctx.WrapError("cannot cast to "+sup.FormatType(c.typ), val)
+	}
+	return super.NewBigInt(c.typ, v)
+}
+
+type casterDecimal struct {
+	sctx *super.Context
+	typ  *super.TypeDecimal
+}
+
+func (c *casterDecimal) Eval(val super.Value) super.Value {
+	v, ok := coerce.ToDecimal(val, c.typ)
+	if !ok {
+		return c.sctx.WrapError("cannot cast to "+sup.FormatType(c.typ), val)
+	}
+	return super.NewValue(c.typ, super.EncodeDecimal(v))
+}
+
 type casterBool struct {
 	sctx *super.Context
 }
@@ -230,6 +262,9 @@ func (c *casterString) Eval(val super.Value) super.Value {
 		}
 		return super.NewString(symbol)
 	}
+	if dec, ok := val.Type().(*super.TypeDecimal); ok {
+		return super.NewString(decimal.Format(super.DecodeDecimal(val.Bytes()), dec.Scale))
+	}
 	// Otherwise, we'll use a canonical SUP value for the string rep
 	// of an arbitrary value cast to a string.
 	return super.NewString(sup.FormatValue(val))
@@ -0,0 +1,111 @@
+package coerce
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
+)
+
+var (
+	ErrIntOverflow  = errors.New("integer overflow")
+	ErrDivideByZero = errors.New("divide by zero")
+)
+
+// ToBigInt converts val to a value of the integer type typ, which may be
+// any integer type but is typically int128, int256, uint128, or uint256.
+// It returns false if val cannot be represented by typ.
+func ToBigInt(val super.Value, typ super.Type) (*big.Int, bool) {
+	val = val.Under()
+	var v *big.Int
+	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		v = decimalToInteger(val)
+	case super.IsInteger(id):
+		v = val.BigInt()
+	case super.IsFloat(id):
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		// Like the other integer conversions, truncate toward zero.
+		v, _ = big.NewFloat(f).Int(nil)
+	case id == super.IDBool:
+		v = new(big.Int)
+		if val.Bool() {
+			v.SetInt64(1)
+		}
+	case id == super.IDString:
+		var ok bool
+		if v, ok = new(big.Int).SetString(val.AsString(), 10); !ok {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	return v, super.FitsInt(super.TypeUnder(typ).ID(), v)
+}
+
+// BigIntToFloat returns the float64 nearest to v.
+func BigIntToFloat(v *big.Int) float64 {
+	return decimal.Float64(v, 0)
+}
+
+// CompareBigInt compares a and b where at least one is a 128- or 256-bit
+// integer and the other is a number.  A float operand is compared as float64
+// and a decimal operand by its exact value.  It returns false if the values
+// are not comparable.
+func CompareBigInt(a, b super.Value) (int, bool) {
+	a, b = a.Under(), b.Under()
+	aid, bid := a.Type().ID(), b.Type().ID()
+	switch {
+	case !super.IsNumber(aid) && !super.IsDecimal(a.Type()) || !super.IsNumber(bid) && !super.IsDecimal(b.Type()):
+		return 0, false
+	case super.IsDecimal(a.Type()) || super.IsDecimal(b.Type()):
+		return CompareDecimal(a, b)
+	case super.IsFloat(aid) || super.IsFloat(bid):
+		af, bf := ToNumeric[float64](a), ToNumeric[float64](b)
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		}
+		return 0, true
+	}
+	return a.BigInt().Cmp(b.BigInt()), true
+}
+
+// BigIntArith computes "l op r" for the arithmetic operators +, -, *, /,
+// and % where the result has the integer type with the given ID.  Unlike the
+// 64-bit integer types, whose arithmetic wraps, a result that does not fit
+// is an error.  As with int64, division truncates toward zero.
+func BigIntArith(op string, id int, l, r *big.Int) (*big.Int, error) {
+	v := new(big.Int)
+	switch op {
+	case "+":
+		v.Add(l, r)
+	case "-":
+		v.Sub(l, r)
+	case "*":
+		v.Mul(l, r)
+	case "/":
+		if r.Sign() == 0 {
+			return nil, ErrDivideByZero
+		}
+		v.Quo(l, r)
+	case "%":
+		if r.Sign() == 0 {
+			return nil, ErrDivideByZero
+		}
+		v.Rem(l, r)
+	default:
+		panic(op)
+	}
+	if !super.FitsInt(id, v) {
+		return nil, ErrIntOverflow
+	}
+	return v, nil
+}
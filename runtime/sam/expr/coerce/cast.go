@@ -11,6 +11,16 @@ import (
 func ToUint(val super.Value, typUint super.Type) (uint64, bool) {
 	val = val.Under()
 	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		v := decimalToInteger(val)
+		if !v.IsUint64() {
+			return 0, false
+		}
+		max, check := FromUintOverflowCheck(super.TypeUint64, typUint)
+		return v.Uint64(), !check || v.Uint64() <= max
+	case super.IsBigInt(id):
+		v := val.BigInt()
+		return v.Uint64(), super.FitsInt(typUint.ID(), v)
 	case super.IsUnsigned(id):
 		v := val.Uint()
 		max, check := FromUintOverflowCheck(val.Type(), typUint)
@@ -39,6 +49,16 @@ func ToUint(val super.Value, typUint super.Type) (uint64, bool) {
 func ToInt(val super.Value, typInt super.Type) (int64, bool) {
 	val = val.Under()
 	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		v := decimalToInteger(val)
+		if !v.IsInt64() {
+			return 0, false
+		}
+		min, max, check := FromIntOverflowCheck(super.TypeInt64, typInt)
+		return v.Int64(), !check || v.Int64() >= min && v.Int64() <= max
+	case super.IsBigInt(id):
+		v := val.BigInt()
+		return v.Int64(), super.FitsInt(typInt.ID(), v)
 	case super.IsUnsigned(id):
 		v := val.Uint()
 		max, check := FromUintOverflowCheck(val.Type(), typInt)
@@ -69,6 +89,10 @@ func ToFloat(val super.Value, typ super.Type) (float64, bool) {
 	val = val.Under()
 	fromId := val.Type().ID()
 	switch {
+	case super.IsDecimal(val.Type()):
+		v = DecimalToFloat(val)
+	case super.IsBigInt(fromId):
+		v = BigIntToFloat(val.BigInt())
 	case super.IsUnsigned(fromId):
 		v = float64(val.Uint())
 	case super.IsSigned(fromId):
@@ -107,6 +131,12 @@ func ToBool(val super.Value) (bool, bool) {
 		v, err := byteconv.ParseBool(val.Bytes())
 		return v, err == nil
 	}
+	if super.IsDecimal(val.Type()) {
+		return super.DecodeDecimal(val.Bytes()).Sign() != 0, true
+	}
+	if super.IsBigInt(val.Type().ID()) {
+		return val.BigInt().Sign() != 0, true
+	}
 	v, ok := ToInt(val, super.TypeInt64)
 	return v != 0, ok
 }
@@ -16,6 +16,12 @@ func Equal(a, b super.Value) bool {
 		return false
 	}
 	switch aid, bid := a.Type().ID(), b.Type().ID(); {
+	case super.IsDecimal(a.Type()) || super.IsDecimal(b.Type()):
+		c, ok := CompareDecimal(a, b)
+		return ok && c == 0
+	case super.IsBigInt(aid) || super.IsBigInt(bid):
+		c, ok := CompareBigInt(a, b)
+		return ok && c == 0
 	case !super.IsNumber(aid) || !super.IsNumber(bid):
 		if aid != bid {
 			return false
@@ -51,6 +57,16 @@ func ToNumeric[T constraints.Integer | constraints.Float](val super.Value) T {
 	}
 	val = val.Under()
 	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		return T(DecimalToFloat(val))
+	case super.IsBigInt(id):
+		v := val.BigInt()
+		var zero T
+		switch any(zero).(type) {
+		case float32, float64:
+			return T(BigIntToFloat(v))
+		}
+		return T(v.Int64())
 	case super.IsUnsigned(id):
 		return T(val.Uint())
 	case super.IsSigned(id):
@@ -0,0 +1,147 @@
+package coerce
+
+import (
+	"cmp"
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
+)
+
+// IntegerPrecision returns the number of decimal digits needed to represent
+// every value of the integer type with the given ID.
+func IntegerPrecision(id int) int {
+	switch id {
+	case super.IDUint8, super.IDInt8:
+		return 3
+	case super.IDUint16, super.IDInt16:
+		return 5
+	case super.IDUint32, super.IDInt32:
+		return 10
+	case super.IDInt64, super.IDDuration, super.IDTime:
+		return 19
+	case super.IDUint64:
+		return 20
+	case super.IDUint128, super.IDInt128:
+		return 39
+	default:
+		// The 256-bit types need 78 digits so values beyond the largest
+		// decimal do not convert.
+		return decimal.MaxPrecision
+	}
+}
+
+// DecimalOf returns typ as a decimal type if it is a decimal or an integer
+// (as decimal(IntegerPrecision(id),0)).  Otherwise, it returns nil.
+func DecimalOf(sctx *super.Context, typ super.Type) *super.TypeDecimal {
+	if dec := super.TypeDecimalOf(typ); dec != nil {
+		return dec
+	}
+	if id := super.TypeUnder(typ).ID(); super.IsInteger(id) {
+		return sctx.MustLookupTypeDecimal(IntegerPrecision(id), 0)
+	}
+	return nil
+}
+
+// PromoteDecimal returns the type to which a and b are converted when
+// one of them is a decimal and both are compared or combined.  A decimal
+// with a float promotes to float64 while a decimal with an integer or another
+// decimal promotes to a decimal that holds the integral digits and scale of both.
+func PromoteDecimal(sctx *super.Context, a, b super.Type) (super.Type, error) {
+	aid, bid := super.TypeUnder(a).ID(), super.TypeUnder(b).ID()
+	if super.IsFloat(aid) || super.IsFloat(bid) {
+		return super.TypeFloat64, nil
+	}
+	adec, bdec := DecimalOf(sctx, a), DecimalOf(sctx, b)
+	if adec == nil || bdec == nil {
+		return nil, ErrIncompatibleTypes
+	}
+	if adec == bdec {
+		return adec, nil
+	}
+	scale := max(adec.Scale, bdec.Scale)
+	precision := min(max(adec.Precision-adec.Scale, bdec.Precision-bdec.Scale)+scale, decimal.MaxPrecision)
+	return sctx.LookupTypeDecimal(precision, min(scale, precision))
+}
+
+// ToDecimal converts val to the unscaled value of decimal type typ.
+// It returns false if val cannot be represented by typ.
+func ToDecimal(val super.Value, typ *super.TypeDecimal) (*big.Int, bool) {
+	val = val.Under()
+	var v *big.Int
+	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		from := super.TypeDecimalOf(val.Type())
+		v = decimal.Rescale(super.DecodeDecimal(val.Bytes()), from.Scale, typ.Scale)
+	case super.IsInteger(id):
+		v = val.BigInt()
+		v.Mul(v, decimal.Pow10(typ.Scale))
+	case super.IsFloat(id):
+		var ok bool
+		if v, ok = decimal.FromFloat64(val.Float(), typ.Scale); !ok {
+			return nil, false
+		}
+	case id == super.IDBool:
+		v = new(big.Int)
+		if val.Bool() {
+			v.Set(decimal.Pow10(typ.Scale))
+		}
+	case id == super.IDString:
+		u, scale, err := decimal.Parse(val.AsString())
+		if err != nil {
+			return nil, false
+		}
+		v = decimal.Rescale(u, scale, typ.Scale)
+	default:
+		return nil, false
+	}
+	return v, decimal.Fits(v, typ.Precision)
+}
+
+// DecimalToFloat returns the float64 nearest to the decimal value val.
+func DecimalToFloat(val super.Value) float64 {
+	typ := super.TypeDecimalOf(val.Type())
+	return decimal.Float64(super.DecodeDecimal(val.Bytes()), typ.Scale)
+}
+
+// decimalToInteger returns the decimal value val rounded to an integer.
+func decimalToInteger(val super.Value) *big.Int {
+	typ := super.TypeDecimalOf(val.Type())
+	return decimal.Rescale(super.DecodeDecimal(val.Bytes()), typ.Scale, 0)
+}
+
+// CompareDecimal compares a and b where at least one is a decimal and the
+// other is a number.  A float operand is compared as float64.  It returns
+// false if the values are not comparable.
+func CompareDecimal(a, b super.Value) (int, bool) {
+	aid, bid := a.Type().ID(), b.Type().ID()
+	if !super.IsNumber(aid) && !super.IsDecimal(a.Type()) || !super.IsNumber(bid) && !super.IsDecimal(b.Type()) {
+		return 0, false
+	}
+	if super.IsFloat(aid) || super.IsFloat(bid) {
+		return cmp.Compare(ToNumeric[float64](a), ToNumeric[float64](b)), true
+	}
+	av, _, as, ok := DecimalParts(a)
+	if !ok {
+		return 0, false
+	}
+	bv, _, bs, ok := DecimalParts(b)
+	if !ok {
+		return 0, false
+	}
+	return decimal.Compare(av, as, bv, bs), true
+}
+
+// DecimalParts returns the unscaled value, precision, and scale of val,
+// which must be a decimal or an integer.  Otherwise, it returns false.
+func DecimalParts(val super.Value) (*big.Int, int, int, bool) {
+	val = val.Under()
+	switch id := val.Type().ID(); {
+	case super.IsDecimal(val.Type()):
+		typ := super.TypeDecimalOf(val.Type())
+		return super.DecodeDecimal(val.Bytes()), typ.Precision, typ.Scale, true
+	case super.IsInteger(id):
+		return val.BigInt(), IntegerPrecision(id), 0, true
+	}
+	return nil, 0, 0, false
+}
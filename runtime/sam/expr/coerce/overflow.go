@@ -16,6 +16,10 @@ func IntBits(typ super.Type) int {
 		return 32
 	case super.IDInt64, super.IDDuration, super.IDTime:
 		return 64
+	case super.IDInt128:
+		return 128
+	case super.IDInt256:
+		return 256
 	default:
 		panic(typ)
 	}
@@ -31,6 +35,10 @@ func UintBits(typ super.Type) int {
 		return 32
 	case super.IDUint64:
 		return 64
+	case super.IDUint128:
+		return 128
+	case super.IDUint256:
+		return 256
 	default:
 		panic(typ)
 	}
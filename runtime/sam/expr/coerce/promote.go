@@ -32,15 +32,15 @@ func Promote(a, b super.Value) (int, error) {
 		}
 	case super.IsSigned(aid):
 		if super.IsUnsigned(bid) {
-			if b.Uint() > math.MaxInt64 {
-				return 0, ErrOverflow
+			if err := checkPromoteUint(aid, b); err != nil {
+				return 0, err
 			}
 			bid = promoteInt[bid]
 		}
 	case super.IsSigned(bid):
 		if super.IsUnsigned(aid) {
-			if a.Uint() > math.MaxInt64 {
-				return 0, ErrOverflow
+			if err := checkPromoteUint(bid, a); err != nil {
+				return 0, err
 			}
 			aid = promoteInt[aid]
 		}
@@ -51,19 +51,35 @@ func Promote(a, b super.Value) (int, error) {
 	return bid, nil
 }
 
+// checkPromoteUint returns an error if the unsigned value u does not fit in
+// the signed type to which it is promoted alongside the signed type sid.
+func checkPromoteUint(sid int, u super.Value) error {
+	id := max(sid, promoteInt[u.Type().ID()])
+	if !super.IsBigInt(id) {
+		if u.Uint() > math.MaxInt64 {
+			return ErrOverflow
+		}
+		return nil
+	}
+	if !super.FitsInt(id, u.BigInt()) {
+		return ErrIntOverflow
+	}
+	return nil
+}
+
 var promoteFloat = []int{
 	super.IDFloat16,  // IDUint8      = 0
 	super.IDFloat16,  // IDUint16     = 1
 	super.IDFloat32,  // IDUint32     = 2
 	super.IDFloat64,  // IDUint64     = 3
-	super.IDFloat128, // IDUint128    = 4
-	super.IDFloat256, // IDUint256    = 5
+	super.IDFloat64,  // IDUint128    = 4
+	super.IDFloat64,  // IDUint256    = 5
 	super.IDFloat16,  // IDInt8       = 6
 	super.IDFloat16,  // IDInt16      = 7
 	super.IDFloat32,  // IDInt32      = 8
 	super.IDFloat64,  // IDInt64      = 9
-	super.IDFloat128, // IDInt128     = 10
-	super.IDFloat256, // IDInt256     = 11
+	super.IDFloat64,  // IDInt128     = 10
+	super.IDFloat64,  // IDInt256     = 11
 	super.IDFloat64,  // IDDuration   = 12
 	super.IDFloat64,  // IDTime       = 13
 	super.IDFloat16,  // IDFloat16    = 14
@@ -0,0 +1,80 @@
+package coerce
+
+import "github.com/brimdata/super"
+
+// Kinds of operands of arithmetic on times and durations.
+const (
+	timeOperandOther = iota
+	timeOperandTime
+	timeOperandDuration
+	timeOperandInt
+	timeOperandFloat
+)
+
+func timeOperand(id int) int {
+	switch {
+	case id == super.IDTime:
+		return timeOperandTime
+	case id == super.IDDuration:
+		return timeOperandDuration
+	case super.IsBigInt(id):
+		return timeOperandOther
+	case super.IsInteger(id):
+		return timeOperandInt
+	case super.IsFloat(id):
+		return timeOperandFloat
+	}
+	return timeOperandOther
+}
+
+// IsTime returns true if arithmetic on operands with type IDs lid and rid is
+// arithmetic on times or durations.
+func IsTime(lid, rid int) bool {
+	return lid == super.IDTime || lid == super.IDDuration || rid == super.IDTime || rid == super.IDDuration
+}
+
+// TimeArithType returns the type of lhs op rhs where lhs or rhs is a time or
+// a duration, or nil if the operator does not apply.  Integers added to or
+// subtracted from a time or duration are nanoseconds.
+func TimeArithType(op string, lid, rid int) super.Type {
+	l, r := timeOperand(lid), timeOperand(rid)
+	switch op {
+	case "+":
+		switch {
+		case l == timeOperandTime && (r == timeOperandDuration || r == timeOperandInt),
+			r == timeOperandTime && (l == timeOperandDuration || l == timeOperandInt):
+			return super.TypeTime
+		case l == timeOperandDuration && (r == timeOperandDuration || r == timeOperandInt),
+			r == timeOperandDuration && l == timeOperandInt:
+			return super.TypeDuration
+		}
+	case "-":
+		switch {
+		case l == timeOperandTime && (r == timeOperandDuration || r == timeOperandInt):
+			return super.TypeTime
+		case l == timeOperandTime && r == timeOperandTime,
+			l == timeOperandDuration && (r == timeOperandDuration || r == timeOperandInt):
+			return super.TypeDuration
+		}
+	case "*":
+		if l == timeOperandDuration && isScalar(r) || r == timeOperandDuration && isScalar(l) {
+			return super.TypeDuration
+		}
+	case "/":
+		switch {
+		case l == timeOperandDuration && isScalar(r):
+			return super.TypeDuration
+		case l == timeOperandDuration && r == timeOperandDuration:
+			return super.TypeFloat64
+		}
+	case "%":
+		if l == timeOperandDuration && (r == timeOperandDuration || r == timeOperandInt) {
+			return super.TypeDuration
+		}
+	}
+	return nil
+}
+
+func isScalar(operand int) bool {
+	return operand == timeOperandInt || operand == timeOperandFloat
+}
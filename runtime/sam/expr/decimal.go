@@ -0,0 +1,50 @@
+package expr
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+// evalDecimal computes lhs op rhs where at least one side is a decimal and
+// the other is a decimal or an integer.  The result type follows from the
+// operand types as described in package decimal.
+func evalDecimal(sctx *super.Context, op string, lhs, rhs super.Value) super.Value {
+	ltyp, rtyp := coerce.DecimalOf(sctx, lhs.Type()), coerce.DecimalOf(sctx, rhs.Type())
+	if ltyp == nil || rtyp == nil {
+		return sctx.NewError(coerce.ErrIncompatibleTypes)
+	}
+	l, _ := coerce.ToDecimal(lhs, ltyp)
+	r, _ := coerce.ToDecimal(rhs, rtyp)
+	var p, s int
+	var v *big.Int
+	var err error
+	switch op {
+	case "+":
+		p, s = decimal.AddType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+		v = decimal.Add(l, ltyp.Scale, r, rtyp.Scale, s)
+	case "-":
+		p, s = decimal.AddType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+		v = decimal.Sub(l, ltyp.Scale, r, rtyp.Scale, s)
+	case "*":
+		p, s = decimal.MulType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+		v = decimal.Mul(l, ltyp.Scale, r, rtyp.Scale, s)
+	case "/":
+		p, s = decimal.DivType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+		v, err = decimal.Div(l, ltyp.Scale, r, rtyp.Scale, s)
+	case "%":
+		p, s = decimal.ModType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+		v, err = decimal.Mod(l, ltyp.Scale, r, rtyp.Scale)
+	default:
+		panic(op)
+	}
+	if err != nil {
+		return sctx.NewError(err)
+	}
+	if !decimal.Fits(v, p) {
+		return sctx.NewError(decimal.ErrOverflow)
+	}
+	return super.NewValue(sctx.MustLookupTypeDecimal(p, s), super.EncodeDecimal(v))
+}
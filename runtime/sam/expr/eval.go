@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 
 	"github.com/brimdata/super"
@@ -247,6 +248,13 @@ func (n *numeric) evalAndPromote(this super.Value) (super.Value, super.Value, su
 	if errVal != nil {
 		return super.Null, super.Null, nil, errVal
 	}
+	if super.IsDecimal(lhsVal.Type()) || super.IsDecimal(rhsVal.Type()) {
+		typ, err := coerce.PromoteDecimal(n.sctx, lhsVal.Type(), rhsVal.Type())
+		if err != nil {
+			return super.Null, super.Null, nil, n.sctx.NewError(err).Ptr()
+		}
+		return lhsVal, rhsVal, typ, nil
+	}
 	id, err := coerce.Promote(lhsVal, rhsVal)
 	if err != nil {
 		return super.Null, super.Null, nil, n.sctx.NewError(err).Ptr()
@@ -318,8 +326,18 @@ func (c *Compare) Eval(this super.Value) super.Value {
 		return super.Null
 	}
 	switch lid, rid := lhs.Type().ID(), rhs.Type().ID(); {
+	case super.IsBigInt(lid) || super.IsBigInt(rid):
+		if result, ok := coerce.CompareBigInt(lhs, rhs); ok {
+			return c.result(result)
+		}
+		return super.False
 	case super.IsNumber(lid) && super.IsNumber(rid):
 		return c.result(compareNumbers(lhs, rhs, lid, rid))
+	case super.IsDecimal(lhs.Type()) || super.IsDecimal(rhs.Type()):
+		if result, ok := coerce.CompareDecimal(lhs, rhs); ok {
+			return c.result(result)
+		}
+		return super.False
 	case lid != rid:
 		return super.False
 	case lid == super.IDBool:
@@ -441,6 +459,12 @@ func (a *Add) Eval(this super.Value) super.Value {
 	if errVal != nil {
 		return *errVal
 	}
+	if super.IsDecimal(typ) {
+		return evalDecimal(a.sctx, "+", lhsVal, rhsVal)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return evalBigInt(a.sctx, "+", typ, lhsVal, rhsVal)
+	}
 	switch id := typ.ID(); {
 	case super.IsUnsigned(id):
 		return super.NewUint(typ, toUint(lhsVal)+toUint(rhsVal))
@@ -457,6 +481,12 @@ func (s *Subtract) Eval(this super.Value) super.Value {
 	if errVal != nil {
 		return *errVal
 	}
+	if super.IsDecimal(typ) {
+		return evalDecimal(s.sctx, "-", lhsVal, rhsVal)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return evalBigInt(s.sctx, "-", typ, lhsVal, rhsVal)
+	}
 	switch id := typ.ID(); {
 	case super.IsUnsigned(id):
 		return super.NewUint(typ, toUint(lhsVal)-toUint(rhsVal))
@@ -480,6 +510,12 @@ func (m *Multiply) Eval(this super.Value) super.Value {
 	if errVal != nil {
 		return *errVal
 	}
+	if super.IsDecimal(typ) {
+		return evalDecimal(m.sctx, "*", lhsVal, rhsVal)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return evalBigInt(m.sctx, "*", typ, lhsVal, rhsVal)
+	}
 	switch id := typ.ID(); {
 	case super.IsUnsigned(id):
 		return super.NewUint(typ, toUint(lhsVal)*toUint(rhsVal))
@@ -496,6 +532,12 @@ func (d *Divide) Eval(this super.Value) super.Value {
 	if errVal != nil {
 		return *errVal
 	}
+	if super.IsDecimal(typ) {
+		return evalDecimal(d.sctx, "/", lhsVal, rhsVal)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return evalBigInt(d.sctx, "/", typ, lhsVal, rhsVal)
+	}
 	switch id := typ.ID(); {
 	case super.IsUnsigned(id):
 		v := toUint(rhsVal)
@@ -520,6 +562,12 @@ func (m *Modulo) Eval(this super.Value) super.Value {
 	if errVal != nil {
 		return *errVal
 	}
+	if super.IsDecimal(typ) {
+		return evalDecimal(m.sctx, "%", lhsVal, rhsVal)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return evalBigInt(m.sctx, "%", typ, lhsVal, rhsVal)
+	}
 	switch id := typ.ID(); {
 	case super.IsUnsigned(id):
 		v := toUint(rhsVal)
@@ -555,6 +603,12 @@ func (u *UnaryMinus) Eval(this super.Value) super.Value {
 		return val
 	}
 	typ := val.Type()
+	if dec, ok := typ.(*super.TypeDecimal); ok {
+		return super.NewValue(dec, super.EncodeDecimal(new(big.Int).Neg(super.DecodeDecimal(val.Bytes()))))
+	}
+	if super.IsBigInt(typ.ID()) {
+		return negBigInt(u.sctx, val)
+	}
 	if super.IsUnsigned(typ.ID()) {
 		switch typ.ID() {
 		case super.IDUint8:
@@ -698,7 +752,7 @@ func (i *Index) Eval(this super.Value) super.Value {
 
 func getNumericIndex(sctx *super.Context, index super.Value, base1 bool) (int, bool, *super.Value) {
 	id := index.Type().ID()
-	if super.IsUnsigned(id) {
+	if super.IsUnsigned(id) || super.IsBigInt(id) {
 		index = LookupPrimitiveCaster(sctx, super.TypeInt64).Eval(index)
 		id = index.Type().ID()
 	}
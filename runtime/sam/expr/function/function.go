@@ -22,6 +22,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 	switch name {
 	case "abs":
 		f = &Abs{sctx: sctx}
+	case "at_time_zone":
+		argmin, argmax = 2, 2
+		f = NewAtTimeZone(sctx)
 	case "base64":
 		f = &Base64{sctx: sctx}
 	case "bucket":
@@ -53,6 +56,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		argmin = 2
 		argmax = 2
 		f = &DatePart{sctx}
+	case "date_trunc":
+		argmin, argmax = 2, 3
+		f = NewDateTrunc(sctx)
 	case "defuse":
 		f = NewDefuse(sctx)
 	case "downcast":
@@ -86,8 +92,15 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = &Has{}
 	case "has_error":
 		f = HasError{}
+	case "hash":
+		f = NewHash()
 	case "hex":
 		f = &Hex{sctx: sctx}
+	case "hll_estimate":
+		f = NewHLLEstimate(sctx)
+	case "hmac_md5", "hmac_sha1", "hmac_sha256", "hmac_sha512":
+		argmin, argmax = 2, 2
+		f = NewDigest(sctx, name)
 	case "is":
 		argmin = 2
 		argmax = 2
@@ -115,9 +128,14 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = &Log{sctx: sctx}
 	case "lower":
 		f = &ToLower{sctx: sctx}
+	case "md5", "sha1", "sha256", "sha512":
+		f = NewDigest(sctx, name)
 	case "missing":
 		argmax = -1
 		f = &Missing{}
+	case "murmur3_32":
+		argmax = 2
+		f = NewMurmur3(sctx)
 	case "nameof":
 		f = &NameOf{sctx: sctx}
 	case "nest_dotted":
@@ -164,6 +182,20 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 	case "strftime":
 		argmin, argmax = 2, 2
 		f = &Strftime{sctx: sctx}
+	case "strptime":
+		argmin, argmax = 2, 2
+		f = NewStrptime(sctx)
+	case "tdigest_quantile":
+		argmin, argmax = 2, 2
+		f = NewTDigestQuantile(sctx)
+	case "theta_difference", "theta_intersect", "theta_union":
+		argmin, argmax = 2, 2
+		f = NewThetaSetOp(sctx, name)
+	case "theta_estimate":
+		f = NewThetaEstimate(sctx)
+	case "time_bucket":
+		argmin, argmax = 2, 3
+		f = NewTimeBucket(sctx)
 	case "trim":
 		f = &Trim{sctx: sctx}
 	case "typename":
@@ -181,6 +213,8 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = &Upcast{sctx}
 	case "upper":
 		f = &ToUpper{sctx: sctx}
+	case "xxhash64":
+		f = NewXXHash64(sctx)
 	default:
 		return nil, ErrNoSuchFunction
 	}
@@ -0,0 +1,177 @@
+package function
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/murmur3"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/cespare/xxhash/v2"
+)
+
+// DigestFunc returns the constructor of the cryptographic hash function
+// named by name, e.g., md5 or hmac_sha256, and whether it is an HMAC.
+func DigestFunc(name string) (func() hash.Hash, bool) {
+	switch name {
+	case "md5":
+		return md5.New, false
+	case "sha1":
+		return sha1.New, false
+	case "sha256":
+		return sha256.New, false
+	case "sha512":
+		return sha512.New, false
+	case "hmac_md5":
+		return md5.New, true
+	case "hmac_sha1":
+		return sha1.New, true
+	case "hmac_sha256":
+		return sha256.New, true
+	case "hmac_sha512":
+		return sha512.New, true
+	}
+	return nil, false
+}
+
+// Digest computes the hexadecimal digest of a string or bytes value with a
+// cryptographic hash function or, for the hmac functions, the HMAC of the
+// value under a string or bytes key.
+type Digest struct {
+	sctx    *super.Context
+	name    string
+	newHash func() hash.Hash
+	hmac    bool
+}
+
+func NewDigest(sctx *super.Context, name string) *Digest {
+	newHash, isHMAC := DigestFunc(name)
+	if newHash == nil {
+		panic(name)
+	}
+	return &Digest{sctx: sctx, name: name, newHash: newHash, hmac: isHMAC}
+}
+
+func (d *Digest) Call(args []super.Value) super.Value {
+	args = underAll(args)
+	val := args[0]
+	if val.IsNull() {
+		return super.Null
+	}
+	if !IsBytesOrString(val.Type()) {
+		return d.sctx.WrapError(d.name+": argument must be a bytes or string type", val)
+	}
+	var h hash.Hash
+	if d.hmac {
+		key := args[1]
+		if key.IsNull() {
+			return super.Null
+		}
+		if !IsBytesOrString(key.Type()) {
+			return d.sctx.WrapError(d.name+": key must be a bytes or string type", key)
+		}
+		h = hmac.New(d.newHash, key.Bytes())
+	} else {
+		h = d.newHash()
+	}
+	h.Write(val.Bytes())
+	return super.NewString(hex.EncodeToString(h.Sum(nil)))
+}
+
+// XXHash64 computes the 64-bit xxHash of a string or bytes value as an
+// int64, so it can be combined with integer literals without overflow.
+type XXHash64 struct {
+	sctx *super.Context
+}
+
+func NewXXHash64(sctx *super.Context) *XXHash64 {
+	return &XXHash64{sctx}
+}
+
+func (x *XXHash64) Call(args []super.Value) super.Value {
+	val := args[0].Under()
+	if val.IsNull() {
+		return super.Null
+	}
+	if !IsBytesOrString(val.Type()) {
+		return x.sctx.WrapError("xxhash64: argument must be a bytes or string type", val)
+	}
+	return super.NewInt64(int64(xxhash.Sum64(val.Bytes())))
+}
+
+// Murmur3 computes the 32-bit MurmurHash3 of a string or bytes value with an
+// optional seed.
+type Murmur3 struct {
+	sctx *super.Context
+}
+
+func NewMurmur3(sctx *super.Context) *Murmur3 {
+	return &Murmur3{sctx}
+}
+
+func (m *Murmur3) Call(args []super.Value) super.Value {
+	args = underAll(args)
+	val := args[0]
+	if val.IsNull() {
+		return super.Null
+	}
+	if !IsBytesOrString(val.Type()) {
+		return m.sctx.WrapError("murmur3_32: argument must be a bytes or string type", val)
+	}
+	var seed uint32
+	if len(args) > 1 {
+		if args[1].IsNull() {
+			return super.Null
+		}
+		s, ok := coerce.ToUint(args[1], super.TypeUint32)
+		if !ok || !super.IsInteger(args[1].Type().ID()) {
+			return m.sctx.WrapError("murmur3_32: seed must be a uint32", args[1])
+		}
+		seed = uint32(s)
+	}
+	return super.NewUint32(murmur3.Sum32(val.Bytes(), seed))
+}
+
+// Hash computes a hash of any value from its type and its scode encoding,
+// so equal values of equal types have equal hashes regardless of their type
+// context.  The hash is a non-negative int64 so that, e.g., hash(x) % 100 is
+// in the range [0,100).
+type Hash struct {
+	types map[super.Type][]byte
+	buf   []byte
+}
+
+func NewHash() *Hash {
+	return &Hash{types: make(map[super.Type][]byte)}
+}
+
+func (h *Hash) Call(args []super.Value) super.Value {
+	val := args[0]
+	if val.IsNull() {
+		return super.Null
+	}
+	return super.NewInt64(int64(h.Sum64(val) & math.MaxInt64))
+}
+
+// Sum64 returns the hash of val.
+func (h *Hash) Sum64(val super.Value) uint64 {
+	typ, ok := h.types[val.Type()]
+	if !ok {
+		typ = super.EncodeTypeValue(val.Type())
+		h.types[val.Type()] = typ
+	}
+	h.buf = append(append(h.buf[:0], typ...), val.Bytes()...)
+	return xxhash.Sum64(h.buf)
+}
+
+// IsBytesOrString returns true if typ is bytes or string.
+func IsBytesOrString(typ super.Type) bool {
+	id := typ.ID()
+	return id == super.IDBytes || id == super.IDString
+}
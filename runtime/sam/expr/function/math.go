@@ -21,6 +21,16 @@ func (a *Abs) Call(args []super.Value) super.Value {
 	switch id := val.Type().ID(); {
 	case super.IsUnsigned(id):
 		return val
+	case super.IsBigInt(id):
+		v := val.BigInt()
+		if v.Sign() >= 0 {
+			return val
+		}
+		v.Neg(v)
+		if !super.FitsInt(id, v) {
+			return a.sctx.WrapError("abs: overflow", val)
+		}
+		return super.NewBigInt(val.Type(), v)
 	case super.IsSigned(id):
 		x := val.Int()
 		if x < 0 {
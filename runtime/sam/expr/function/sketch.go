@@ -0,0 +1,143 @@
+package function
+
+import (
+	"math"
+
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/pkg/theta"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+type HLLEstimate struct {
+	sctx *super.Context
+}
+
+func NewHLLEstimate(sctx *super.Context) *HLLEstimate {
+	return &HLLEstimate{sctx}
+}
+
+func (h *HLLEstimate) Call(args []super.Value) super.Value {
+	val := args[0]
+	if val.IsNull() {
+		return super.Null
+	}
+	b, err := agg.SketchBytes(val, agg.HLLTypeName)
+	if err != nil {
+		return h.sctx.WrapError("hll_estimate: "+err.Error(), val)
+	}
+	var s hyperloglog.Sketch
+	if err := s.UnmarshalBinary(b); err != nil {
+		return h.sctx.WrapError("hll_estimate: invalid hll sketch", val)
+	}
+	return super.NewInt64(int64(s.Estimate()))
+}
+
+type TDigestQuantile struct {
+	sctx *super.Context
+}
+
+func NewTDigestQuantile(sctx *super.Context) *TDigestQuantile {
+	return &TDigestQuantile{sctx}
+}
+
+func (t *TDigestQuantile) Call(args []super.Value) super.Value {
+	val, qval := args[0], args[1]
+	if val.IsNull() || qval.IsNull() {
+		return super.Null
+	}
+	b, err := agg.SketchBytes(val, agg.TDigestTypeName)
+	if err != nil {
+		return t.sctx.WrapError("tdigest_quantile: "+err.Error(), val)
+	}
+	q, ok := coerce.ToFloat(qval, super.TypeFloat64)
+	if !ok || q < 0 || q > 1 {
+		return t.sctx.WrapError("tdigest_quantile: quantile must be a number between 0 and 1", qval)
+	}
+	var d tdigest.Digest
+	if err := d.UnmarshalBinary(b); err != nil {
+		return t.sctx.WrapError("tdigest_quantile: invalid tdigest sketch", val)
+	}
+	f := d.Quantile(q)
+	if math.IsNaN(f) {
+		return super.Null
+	}
+	return super.NewFloat64(f)
+}
+
+type ThetaEstimate struct {
+	sctx *super.Context
+}
+
+func NewThetaEstimate(sctx *super.Context) *ThetaEstimate {
+	return &ThetaEstimate{sctx}
+}
+
+func (t *ThetaEstimate) Call(args []super.Value) super.Value {
+	val := args[0]
+	if val.IsNull() {
+		return super.Null
+	}
+	s, errVal := thetaArg(t.sctx, "theta_estimate", val)
+	if s == nil {
+		return errVal
+	}
+	return super.NewInt64(int64(math.Round(s.Estimate())))
+}
+
+// ThetaSetOp computes the union, intersection, or difference of two theta
+// sketches.
+type ThetaSetOp struct {
+	sctx *super.Context
+	name string
+	fn   func(a, b *theta.Sketch) *theta.Sketch
+}
+
+func NewThetaSetOp(sctx *super.Context, name string) *ThetaSetOp {
+	op := &ThetaSetOp{sctx: sctx, name: name}
+	switch name {
+	case "theta_union":
+		op.fn = theta.Union
+	case "theta_intersect":
+		op.fn = theta.Intersect
+	case "theta_difference":
+		op.fn = theta.Difference
+	default:
+		panic(name)
+	}
+	return op
+}
+
+func (t *ThetaSetOp) Call(args []super.Value) super.Value {
+	if args[0].IsNull() || args[1].IsNull() {
+		return super.Null
+	}
+	a, errVal := thetaArg(t.sctx, t.name, args[0])
+	if a == nil {
+		return errVal
+	}
+	b, errVal := thetaArg(t.sctx, t.name, args[1])
+	if b == nil {
+		return errVal
+	}
+	out, _ := t.fn(a, b).MarshalBinary()
+	typ, err := t.sctx.LookupTypeNamed(agg.ThetaTypeName, super.TypeBytes)
+	if err != nil {
+		panic(err)
+	}
+	return super.NewValue(typ, out)
+}
+
+func thetaArg(sctx *super.Context, name string, val super.Value) (*theta.Sketch, super.Value) {
+	b, err := agg.SketchBytes(val, agg.ThetaTypeName)
+	if err != nil {
+		return nil, sctx.WrapError(name+": "+err.Error(), val)
+	}
+	var s theta.Sketch
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, sctx.WrapError(name+": invalid theta sketch", val)
+	}
+	return &s, super.Value{}
+}
@@ -1,6 +1,8 @@
 package function
 
 import (
+	"time"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/lestrrat-go/strftime"
@@ -72,3 +74,148 @@ func (s *Strftime) Call(args []super.Value) super.Value {
 	out := s.formatter.FormatString(timeArg.AsTime().Time())
 	return super.NewString(out)
 }
+
+type Strptime struct {
+	sctx *super.Context
+}
+
+func NewStrptime(sctx *super.Context) *Strptime {
+	return &Strptime{sctx}
+}
+
+func (s *Strptime) Call(args []super.Value) super.Value {
+	formatArg, strArg := args[0].Under(), args[1].Under()
+	if formatArg.IsNull() || strArg.IsNull() {
+		return super.Null
+	}
+	if !formatArg.IsString() {
+		return s.sctx.WrapError("strptime: string value required for format arg", formatArg)
+	}
+	if !strArg.IsString() {
+		return s.sctx.WrapError("strptime: string value required for string arg", args[1])
+	}
+	ts, err := nano.Strptime(formatArg.AsString(), strArg.AsString())
+	if err != nil {
+		return s.sctx.WrapError("strptime: "+err.Error(), strArg)
+	}
+	return super.NewTime(ts)
+}
+
+// DateTrunc truncates a time to the start of the calendar unit containing it,
+// optionally in a time zone other than UTC.
+type DateTrunc struct {
+	sctx *super.Context
+}
+
+func NewDateTrunc(sctx *super.Context) *DateTrunc {
+	return &DateTrunc{sctx}
+}
+
+func (d *DateTrunc) Call(args []super.Value) super.Value {
+	args = underAll(args)
+	unitArg, timeArg := args[0], args[1]
+	if unitArg.IsNull() || timeArg.IsNull() {
+		return super.Null
+	}
+	if !unitArg.IsString() {
+		return d.sctx.WrapError("date_trunc: string value required for unit argument", unitArg)
+	}
+	if timeArg.Type().ID() != super.IDTime {
+		return d.sctx.WrapError("date_trunc: time value required for time argument", timeArg)
+	}
+	unit, ok := nano.ParseUnit(unitArg.AsString())
+	if !ok {
+		return d.sctx.WrapError("date_trunc: unsupported unit", unitArg)
+	}
+	loc, errVal := locationArg(d.sctx, "date_trunc", args[2:])
+	if loc == nil {
+		return errVal
+	}
+	return super.NewTime(timeArg.AsTime().TruncIn(1, unit, loc))
+}
+
+// TimeBucket is like Bucket but its bucket width may also be a calendar
+// interval like "1 month" and buckets may be aligned to a time zone.
+type TimeBucket struct {
+	sctx *super.Context
+}
+
+func NewTimeBucket(sctx *super.Context) *TimeBucket {
+	return &TimeBucket{sctx}
+}
+
+func (t *TimeBucket) Call(args []super.Value) super.Value {
+	args = underAll(args)
+	widthArg, timeArg := args[0], args[1]
+	if widthArg.IsNull() || timeArg.IsNull() {
+		return super.Null
+	}
+	var n int64
+	var unit nano.Unit
+	switch {
+	case widthArg.Type().ID() == super.IDDuration:
+		n, unit = widthArg.Int(), nano.UnitNanosecond
+		if n <= 0 {
+			return t.sctx.WrapError("time_bucket: bucket width must be positive", widthArg)
+		}
+	case widthArg.IsString():
+		var ok bool
+		if n, unit, ok = nano.ParseInterval(widthArg.AsString()); !ok {
+			return t.sctx.WrapError("time_bucket: invalid bucket width", widthArg)
+		}
+	default:
+		return t.sctx.WrapError("time_bucket: duration or string value required for width argument", widthArg)
+	}
+	if timeArg.Type().ID() != super.IDTime {
+		return t.sctx.WrapError("time_bucket: time value required for time argument", timeArg)
+	}
+	loc, errVal := locationArg(t.sctx, "time_bucket", args[2:])
+	if loc == nil {
+		return errVal
+	}
+	return super.NewTime(timeArg.AsTime().TruncIn(n, unit, loc))
+}
+
+// AtTimeZone converts a time to the time whose UTC date and time of day are
+// the date and time of day of the original time in a time zone.
+type AtTimeZone struct {
+	sctx *super.Context
+}
+
+func NewAtTimeZone(sctx *super.Context) *AtTimeZone {
+	return &AtTimeZone{sctx}
+}
+
+func (a *AtTimeZone) Call(args []super.Value) super.Value {
+	args = underAll(args)
+	timeArg := args[0]
+	if timeArg.IsNull() || args[1].IsNull() {
+		return super.Null
+	}
+	if timeArg.Type().ID() != super.IDTime {
+		return a.sctx.WrapError("at_time_zone: time value required for time argument", timeArg)
+	}
+	loc, errVal := locationArg(a.sctx, "at_time_zone", args[1:])
+	if loc == nil {
+		return errVal
+	}
+	return super.NewTime(timeArg.AsTime().WallClock(loc))
+}
+
+// locationArg returns the time zone named by the optional argument in args,
+// or UTC if args is empty.  If the argument is not a valid time zone,
+// locationArg returns a nil location and an error value.
+func locationArg(sctx *super.Context, name string, args []super.Value) (*time.Location, super.Value) {
+	if len(args) == 0 || args[0].IsNull() {
+		return time.UTC, super.Value{}
+	}
+	zoneArg := args[0]
+	if !zoneArg.IsString() {
+		return nil, sctx.WrapError(name+": string value required for time zone argument", zoneArg)
+	}
+	loc, err := nano.LoadLocation(zoneArg.AsString())
+	if err != nil {
+		return nil, sctx.WrapError(name+": "+err.Error(), zoneArg)
+	}
+	return loc, super.Value{}
+}
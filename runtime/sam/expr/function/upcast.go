@@ -219,6 +219,10 @@ func UpcastUnionTag(types []super.Type, out super.Type) int {
 		id := out.ID()
 		return slices.IndexFunc(types, func(t super.Type) bool { return !super.IsTypeNamed(t) && t.ID() == id })
 	}
+	if k == super.DecimalKind {
+		// Decimals of differing precision or scale are distinct types.
+		return slices.Index(types, out)
+	}
 	return slices.IndexFunc(types, func(t super.Type) bool { return !super.IsTypeNamed(t) && t.Kind() == k })
 }
 func (u *Upcast) toError(b *scode.Builder, typ super.Type, bytes scode.Bytes, to *super.TypeError) bool {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/sio"
 )
 
@@ -46,7 +47,7 @@ func (c *Comparator) sortStableIndices(vals []super.Value) []uint32 {
 		indices[i] = uint32(i)
 		val := c.exprs[0].Eval(vals[i])
 		val0s[i] = val
-		if id := val.Type().ID(); id <= super.IDTime {
+		if id := val.Type().ID(); id <= super.IDTime && !super.IsBigInt(id) {
 			if val.IsNull() {
 				if nullsMax0 {
 					i64s[i] = math.MaxInt64
@@ -167,8 +168,18 @@ func compareValues(a, b super.Value, nullsMax bool) int {
 		}
 	}
 	switch aid, bid := a.Type().ID(), b.Type().ID(); {
+	case super.IsBigInt(aid) || super.IsBigInt(bid):
+		if v, ok := coerce.CompareBigInt(a, b); ok {
+			return v
+		}
+		return super.CompareTypes(a.Type(), b.Type())
 	case super.IsNumber(aid) && super.IsNumber(bid):
 		return compareNumbers(a, b, aid, bid)
+	case super.IsDecimal(a.Type()) || super.IsDecimal(b.Type()):
+		if v, ok := coerce.CompareDecimal(a, b); ok {
+			return v
+		}
+		return super.CompareTypes(a.Type(), b.Type())
 	case aid != bid:
 		return super.CompareTypes(a.Type(), b.Type())
 	case aid == super.IDBool:
@@ -10,6 +10,7 @@ import (
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/commits"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sbuf"
@@ -27,6 +28,7 @@ type Lister struct {
 	pool      *db.Pool
 	snap      commits.View
 	pruner    *pruner
+	index     index.Filter
 	group     *errgroup.Group
 	marshaler *sup.MarshalBSUPContext
 	mu        sync.Mutex
@@ -68,6 +70,12 @@ func NewSortedListerFromSnap(ctx context.Context, sctx *super.Context, pool *db.
 	return l
 }
 
+// SetIndexFilter arranges for l to skip objects whose index shows they
+// cannot satisfy filter.
+func (l *Lister) SetIndexFilter(filter index.Filter) {
+	l.index = filter
+}
+
 func (l *Lister) Snapshot() commits.View {
 	return l.snap
 }
@@ -89,9 +97,20 @@ func (l *Lister) Pull(done bool) (sbuf.Batch, error) {
 			l.err = err
 			return nil, err
 		}
-		if !l.pruner.prune(val) {
-			return sbuf.NewArray([]super.Value{val}), nil
+		if l.pruner.prune(val) {
+			continue
+		}
+		if l.index != nil {
+			idx, err := index.Load(l.ctx, l.pool.Storage(), o.IndexURI(l.pool.DataPath))
+			if err != nil {
+				l.err = err
+				return nil, err
+			}
+			if l.index.Prune(idx) {
+				continue
+			}
 		}
+		return sbuf.NewArray([]super.Value{val}), nil
 	}
 	return nil, nil
 }
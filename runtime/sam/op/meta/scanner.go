@@ -15,14 +15,16 @@ import (
 	"github.com/segmentio/ksuid"
 )
 
-func NewDBMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, meta string) (sbuf.Scanner, error) {
+// NewDBMetaScanner returns a scanner of the database metadata meta, which
+// includes only the pools and branches for which visible returns true.
+func NewDBMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, meta string, visible db.VisibleFunc) (sbuf.Scanner, error) {
 	var vals []super.Value
 	var err error
 	switch meta {
 	case "pools":
-		vals, err = r.BatchifyPools(ctx, sctx, nil)
+		vals, err = r.BatchifyPools(ctx, sctx, nil, visible)
 	case "branches":
-		vals, err = r.BatchifyBranches(ctx, sctx, nil)
+		vals, err = r.BatchifyBranches(ctx, sctx, nil, visible)
 	default:
 		return nil, fmt.Errorf("unknown database metadata type: %q", meta)
 	}
@@ -42,7 +44,7 @@ func NewPoolMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, po
 	case "branches":
 		m := sup.NewBSUPMarshalerWithContext(sctx)
 		m.Decorate(sup.StylePackage)
-		vals, err = p.BatchifyBranches(ctx, sctx, nil, m, nil)
+		vals, err = p.BatchifyBranches(ctx, sctx, nil, m, nil, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -72,6 +72,17 @@ func NewPattern(sctx *super.Context, op string, distinct, hasarg bool) (expr.Agg
 		pattern = func() expr.AggFunc {
 			return &or{}
 		}
+	case "stddev", "stddev_samp", "stddev_pop", "variance", "var_samp", "var_pop":
+		pattern = func() expr.AggFunc {
+			return newVariance(op)
+		}
+	case "hll_sketch", "hll_merge", "tdigest_sketch", "tdigest_merge", "theta_sketch", "theta_merge",
+		"approx_quantile", "median", "percentile_cont", "percentile_disc",
+		"corr", "covar_pop", "covar_samp", "regr_avgx", "regr_avgy", "regr_count",
+		"regr_intercept", "regr_r2", "regr_slope", "regr_sxx", "regr_sxy", "regr_syy":
+		pattern = func() expr.AggFunc {
+			return newSamFunc(op)
+		}
 	default:
 		return nil, fmt.Errorf("unknown aggregation function: %s", op)
 	}
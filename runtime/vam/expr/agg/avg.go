@@ -2,19 +2,38 @@ package agg
 
 import (
 	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/vector"
 )
 
+// avg averages numbers as float64 except when decimals are mixed only
+// with integers, in which case the sum is exact and the average is a
+// decimal (see samagg.Avg).
 type avg struct {
-	sum   float64
-	count uint64
+	sum    float64
+	count  uint64
+	dec    *samagg.Decimal
+	digits int
+	float  bool
 }
 
 var _ expr.AggFunc = (*avg)(nil)
 
 func (a *avg) Consume(vec vector.Any) {
 	vec = vector.Under(vec)
+	if a.decimal(vec.Type()) {
+		a.consumeDecimal(vec)
+		return
+	}
+	if super.IsDecimal(vec.Type()) {
+		vec = decimalsToFloat64(vec)
+	} else if super.IsBigInt(vec.Type().ID()) {
+		vec = bigIntsToFloat64(vec)
+	}
 	if !super.IsNumber(vec.Type().ID()) {
 		return
 	}
@@ -22,8 +41,45 @@ func (a *avg) Consume(vec vector.Any) {
 	a.sum = sum(a.sum, vec)
 }
 
-func (a *avg) Result(*super.Context) vector.Any {
+// decimal updates the state for a vector of type typ and returns true if
+// the vector belongs in the decimal sum.
+func (a *avg) decimal(typ super.Type) bool {
+	switch id := typ.ID(); {
+	case super.IsFloat(id):
+		if a.dec != nil {
+			a.sum = a.dec.Float64()
+			a.dec = nil
+		}
+		a.float = true
+	case super.IsInteger(id):
+		if a.dec == nil {
+			a.digits = max(a.digits, coerce.IntegerPrecision(id))
+		}
+	case super.IsDecimal(typ):
+		if a.dec == nil && !a.float {
+			a.dec = samagg.NewDecimalSum(a.sum, a.digits)
+			a.sum = 0
+		}
+	}
+	return a.dec != nil
+}
+
+func (a *avg) consumeDecimal(vec vector.Any) {
+	var b scode.Builder
+	for i := range vec.Len() {
+		b.Truncate()
+		if a.dec.Update(vector.ValueAt(&b, vec, i)) {
+			a.count++
+		}
+	}
+}
+
+func (a *avg) Result(sctx *super.Context) vector.Any {
 	if a.count > 0 {
+		if a.dec != nil {
+			val := a.dec.Avg(sctx, a.count)
+			return sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{val}))
+		}
 		f := a.sum / float64(a.count)
 		return vector.NewFloat(super.TypeFloat64, []float64{f})
 	}
@@ -56,18 +112,28 @@ func (a *avg) ConsumeAsPartial(partial vector.Any) {
 	fields := rec.Fields
 	sumVal := fields[si]
 	countVal := fields[ci]
-	if sumVal.Type() != super.TypeFloat64 || countVal.Type() != super.TypeUint64 {
+	if sumVal.Type() != super.TypeFloat64 && !super.IsDecimal(sumVal.Type()) || countVal.Type() != super.TypeUint64 {
 		panic("avg: invalid partial")
 	}
-	a.sum += vector.FloatValue(sumVal, idx)
+	switch {
+	case a.decimal(sumVal.Type()):
+		a.dec.Update(vector.ValueAt(nil, sumVal, idx))
+	case super.IsDecimal(sumVal.Type()):
+		a.sum += coerce.DecimalToFloat(vector.ValueAt(nil, sumVal, idx))
+	default:
+		a.sum += vector.FloatValue(sumVal, idx)
+	}
 	a.count += vector.UintValue(countVal, idx)
 }
 
 func (a *avg) ResultAsPartial(sctx *super.Context) vector.Any {
-	sum := vector.NewFloat(super.TypeFloat64, []float64{a.sum})
+	var sum vector.Any = vector.NewFloat(super.TypeFloat64, []float64{a.sum})
+	if a.dec != nil {
+		sum = sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{a.dec.Value(sctx)}))
+	}
 	count := vector.NewUint(super.TypeUint64, []uint64{a.count})
 	typ := sctx.MustLookupTypeRecord([]super.Field{
-		super.NewField(sumName, super.TypeFloat64),
+		super.NewField(sumName, sum.Type()),
 		super.NewField(countName, super.TypeUint64),
 	})
 	return vector.NewRecord(typ, []vector.Any{sum, count}, 1)
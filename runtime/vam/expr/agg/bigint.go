@@ -0,0 +1,50 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+type reduceBigInt struct {
+	state *samagg.BigInt
+}
+
+func newReduceBigInt(f *mathFunc, val super.Value, id int) *reduceBigInt {
+	return &reduceBigInt{samagg.NewBigInt(f.function, val, id)}
+}
+
+func (b *reduceBigInt) consume(vec vector.Any) {
+	var sb scode.Builder
+	for i := range vec.Len() {
+		sb.Truncate()
+		b.state.Update(vector.ValueAt(&sb, vec, i))
+	}
+}
+
+// result returns the state without checking for overflow and is used only
+// when the reduction continues with another type (see resultBigInt).
+func (b *reduceBigInt) result() vector.Any {
+	out := vector.NewBigIntEmpty(b.typ(), 1)
+	out.Append(b.state.Int())
+	return out
+}
+
+func (b *reduceBigInt) resultBigInt(sctx *super.Context) vector.Any {
+	val := b.state.Value(sctx)
+	return sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{val}))
+}
+
+func (b *reduceBigInt) typ() super.Type { return b.state.Type() }
+
+// bigIntsToFloat64 converts a vector of 128- or 256-bit integers to float64.
+func bigIntsToFloat64(vec vector.Any) *vector.Float {
+	vals := make([]float64, vec.Len())
+	for i := range vec.Len() {
+		vals[i] = coerce.BigIntToFloat(vector.BigIntValue(vec, i))
+	}
+	return vector.NewFloat(super.TypeFloat64, vals)
+}
@@ -0,0 +1,49 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+type reduceDecimal struct {
+	state *samagg.Decimal
+}
+
+func newReduceDecimal(f *mathFunc, val super.Value) *reduceDecimal {
+	return &reduceDecimal{samagg.NewDecimal(f.function, val)}
+}
+
+func (d *reduceDecimal) consume(vec vector.Any) {
+	var b scode.Builder
+	for i := range vec.Len() {
+		b.Truncate()
+		d.state.Update(vector.ValueAt(&b, vec, i))
+	}
+}
+
+// result returns the state as a float64 and is used only when a float
+// vector is mixed with decimals (see resultDecimal).
+func (d *reduceDecimal) result() vector.Any {
+	return vector.NewFloat(super.TypeFloat64, []float64{d.state.Float64()})
+}
+
+func (d *reduceDecimal) resultDecimal(sctx *super.Context) vector.Any {
+	val := d.state.Value(sctx)
+	return sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{val}))
+}
+
+func (d *reduceDecimal) typ() super.Type { return nil }
+
+// decimalsToFloat64 converts a vector of decimals to float64.
+func decimalsToFloat64(vec vector.Any) *vector.Float {
+	scale := super.TypeDecimalOf(vec.Type()).Scale
+	vals := make([]float64, vec.Len())
+	for i := range vec.Len() {
+		vals[i] = decimal.Float64(vector.DecimalValue(vec, i), scale)
+	}
+	return vector.NewFloat(super.TypeFloat64, vals)
+}
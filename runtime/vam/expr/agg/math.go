@@ -36,6 +36,12 @@ func (m *mathReducer) Result(sctx *super.Context) vector.Any {
 	if !m.hasval {
 		return vector.NewNull(1)
 	}
+	switch math := m.math.(type) {
+	case *reduceDecimal:
+		return math.resultDecimal(sctx)
+	case *reduceBigInt:
+		return math.resultBigInt(sctx)
+	}
 	return m.math.result()
 }
 
@@ -48,7 +54,13 @@ func (m *mathReducer) Consume(vec vector.Any) {
 	switch {
 	case typ == super.TypeString:
 		m.consumeString(vec)
+	case super.IsDecimal(typ):
+		m.consumeDecimal(vec)
 	case super.IsNumber(typ.ID()):
+		if _, ok := m.math.(*reduceDecimal); ok {
+			m.consumeDecimal(vec)
+			return
+		}
 		m.consumeNumeric(vec)
 	}
 }
@@ -65,8 +77,41 @@ func (m *mathReducer) consumeString(vec vector.Any) {
 	m.math.consume(vec)
 }
 
+// consumeDecimal consumes vec when either vec or the current state is
+// a decimal.  Decimals mixed with integers reduce to a decimal while
+// decimals mixed with floats reduce to float64.
+func (m *mathReducer) consumeDecimal(vec vector.Any) {
+	id := vec.Type().ID()
+	switch math := m.math.(type) {
+	case nil:
+		m.math = newReduceDecimal(m.function, super.Null)
+	case *reduceDecimal:
+		if super.IsFloat(id) {
+			m.math = newReduceFloat64(m.function, vector.ValueAt(nil, math.result(), 0))
+		} else if !super.IsInteger(id) && !super.IsDecimal(vec.Type()) {
+			// Skip invalid values.
+			return
+		}
+	case *reduceInt64:
+		if math.outtyp != super.TypeInt64 {
+			// Skip decimals mixed with durations or times.
+			return
+		}
+		m.math = newReduceDecimal(m.function, vector.ValueAt(nil, math.result(), 0))
+	case *reduceUint64, *reduceBigInt:
+		m.math = newReduceDecimal(m.function, vector.ValueAt(nil, math.result(), 0))
+	case *reduceFloat64:
+		vec = decimalsToFloat64(vec)
+	default:
+		m.mixedTypesErr = true
+		return
+	}
+	m.hasval = true
+	m.math.consume(vec)
+}
+
 func (m *mathReducer) consumeNumeric(vec vector.Any) {
-	if m.math != nil && !super.IsNumber(m.math.typ().ID()) {
+	if _, ok := m.math.(*reduceString); ok {
 		m.mixedTypesErr = true
 		return
 	}
@@ -96,12 +141,17 @@ func (m *mathReducer) consumeNumeric(vec vector.Any) {
 			m.math = newReduceInt64(m.function, state, super.TypeDuration)
 		case super.IDTime:
 			m.math = newReduceInt64(m.function, state, super.TypeTime)
+		case super.IDInt128, super.IDInt256, super.IDUint128, super.IDUint256:
+			m.math = newReduceBigInt(m.function, state, id)
 		case super.IDFloat16, super.IDFloat32, super.IDFloat64:
 			m.math = newReduceFloat64(m.function, state)
 		default:
 			panic(id)
 		}
 	}
+	if super.IsBigInt(typ.ID()) && super.IsFloat(id) {
+		vec = bigIntsToFloat64(vec)
+	}
 	m.hasval = true
 	m.math.consume(vec)
 }
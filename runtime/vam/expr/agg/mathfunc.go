@@ -9,6 +9,7 @@ import (
 
 var mathSum = &mathFunc{
 	Init:        anymath.Add.Init,
+	function:    anymath.Add,
 	funcFloat64: sum[float64],
 	funcInt64:   sum[int64],
 	funcUint64:  sum[uint64],
@@ -16,6 +17,7 @@ var mathSum = &mathFunc{
 
 var mathMin = &mathFunc{
 	Init:        anymath.Min.Init,
+	function:    anymath.Min,
 	funcFloat64: minNumeric[float64],
 	funcInt64:   minNumeric[int64],
 	funcUint64:  minNumeric[uint64],
@@ -24,6 +26,7 @@ var mathMin = &mathFunc{
 
 var mathMax = &mathFunc{
 	Init:        anymath.Max.Init,
+	function:    anymath.Max,
 	funcFloat64: maxNumeric[float64],
 	funcInt64:   maxNumeric[int64],
 	funcUint64:  maxNumeric[uint64],
@@ -37,6 +40,8 @@ type funcString func(string, vector.Any) string
 
 type mathFunc struct {
 	anymath.Init
+	// function provides the reduction of decimal values.
+	function *anymath.Function
 	funcFloat64
 	funcInt64
 	funcUint64
@@ -0,0 +1,86 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// samFunc adapts a sequential aggregate function to vectors by consuming
+// the values of a vector one at a time.
+type samFunc struct {
+	fn samagg.Function
+}
+
+func newSamFunc(op string) *samFunc {
+	pattern, err := samagg.NewPattern(op, false, true)
+	if err != nil {
+		panic(err)
+	}
+	return &samFunc{pattern()}
+}
+
+func (s *samFunc) Consume(vec vector.Any) {
+	if k := vec.Kind(); k == vector.KindNull || k == vector.KindNone {
+		return
+	}
+	var b scode.Builder
+	for i := range vec.Len() {
+		s.fn.Consume(vector.ValueAt(&b, vec, i))
+	}
+}
+
+func (s *samFunc) Result(sctx *super.Context) vector.Any {
+	val := s.fn.Result(sctx)
+	return sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{val}))
+}
+
+func (s *samFunc) ConsumeAsPartial(partial vector.Any) {
+	var b scode.Builder
+	for i := range partial.Len() {
+		s.fn.ConsumeAsPartial(vector.ValueAt(&b, partial, i))
+	}
+}
+
+func (s *samFunc) ResultAsPartial(sctx *super.Context) vector.Any {
+	val := s.fn.ResultAsPartial(sctx)
+	return sbuf.Dematerialize(sctx, sbuf.NewArray([]super.Value{val}))
+}
+
+// variance computes the variance and standard deviation functions directly
+// from numeric vectors.
+type variance struct {
+	samFunc
+	moments *samagg.Moments
+}
+
+func newVariance(op string) *variance {
+	s := newSamFunc(op)
+	return &variance{*s, &s.fn.(*samagg.Variance).Moments}
+}
+
+func (v *variance) Consume(vec vector.Any) {
+	vec = vector.Under(vec)
+	if super.IsDecimal(vec.Type()) {
+		vec = decimalsToFloat64(vec)
+	} else if super.IsBigInt(vec.Type().ID()) {
+		vec = bigIntsToFloat64(vec)
+	}
+	id := vec.Type().ID()
+	switch {
+	case super.IsFloat(id):
+		for i := range vec.Len() {
+			v.moments.Add(vector.FloatValue(vec, i))
+		}
+	case super.IsSigned(id):
+		for i := range vec.Len() {
+			v.moments.Add(float64(vector.IntValue(vec, i)))
+		}
+	case super.IsUnsigned(id):
+		for i := range vec.Len() {
+			v.moments.Add(float64(vector.UintValue(vec, i)))
+		}
+	}
+}
@@ -4,10 +4,14 @@ package expr
 
 import (
 	"fmt"
+	"math/big"
 	"runtime"
+	"slices"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/vam/expr/cast"
 	"github.com/brimdata/super/sup"
 	"github.com/brimdata/super/vector"
 )
@@ -33,6 +37,22 @@ func (a *Arith) eval(vecs ...vector.Any) (out vector.Any) {
 	}
 	lhs := vector.Under(vecs[0])
 	rhs := vector.Under(vecs[1])
+	if coerce.IsTime(lhs.Type().ID(), rhs.Type().ID()) {
+		return a.evalTime(lhs, rhs)
+	}
+	if super.IsDecimal(lhs.Type()) || super.IsDecimal(rhs.Type()) {
+		if !super.IsFloat(lhs.Type().ID()) && !super.IsFloat(rhs.Type().ID()) {
+			return a.evalDecimal(lhs, rhs)
+		}
+		// Decimal mixed with float is computed as float64.
+		lhs = cast.To(a.sctx, lhs, super.TypeFloat64)
+		rhs = cast.To(a.sctx, rhs, super.TypeFloat64)
+	}
+	if lid, rid := lhs.Type().ID(), rhs.Type().ID(); super.IsBigInt(lid) || super.IsBigInt(rid) {
+		if !super.IsFloat(lid) && !super.IsFloat(rid) {
+			return a.evalBigInt(lhs, rhs)
+		}
+	}
 	lhs, rhs, errVal := coerceVals(a.sctx, lhs, rhs)
 	if errVal != nil {
 		return errVal
@@ -115,3 +135,70 @@ func (a *Arith) evalDivideByZero(kind vector.Kind, lhs, rhs vector.Any) vector.A
 	}
 	return out
 }
+
+// evalDecimal computes lhs op rhs where at least one side is a decimal and
+// the other is a decimal or an integer.  The result type follows from the
+// operand types as described in package decimal.
+func (a *Arith) evalDecimal(lhs, rhs vector.Any) vector.Any {
+	ltyp, rtyp := coerce.DecimalOf(a.sctx, lhs.Type()), coerce.DecimalOf(a.sctx, rhs.Type())
+	if ltyp == nil || rtyp == nil {
+		return vector.NewStringError(a.sctx, coerce.ErrIncompatibleTypes.Error(), lhs.Len())
+	}
+	lhs, rhs = cast.To(a.sctx, lhs, ltyp), cast.To(a.sctx, rhs, rtyp)
+	var p, s int
+	switch a.opCode {
+	case vector.ArithAdd, vector.ArithSub:
+		p, s = decimal.AddType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+	case vector.ArithMul:
+		p, s = decimal.MulType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+	case vector.ArithDiv:
+		p, s = decimal.DivType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+	case vector.ArithMod:
+		p, s = decimal.ModType(ltyp.Precision, ltyp.Scale, rtyp.Precision, rtyp.Scale)
+	default:
+		panic(a.opCode)
+	}
+	typ := a.sctx.MustLookupTypeDecimal(p, s)
+	out := vector.NewDecimalEmpty(typ, lhs.Len())
+	var overflows, divzeros []uint32
+	for i := range lhs.Len() {
+		l, r := vector.DecimalValue(lhs, i), vector.DecimalValue(rhs, i)
+		var v *big.Int
+		var err error
+		switch a.opCode {
+		case vector.ArithAdd:
+			v = decimal.Add(l, ltyp.Scale, r, rtyp.Scale, s)
+		case vector.ArithSub:
+			v = decimal.Sub(l, ltyp.Scale, r, rtyp.Scale, s)
+		case vector.ArithMul:
+			v = decimal.Mul(l, ltyp.Scale, r, rtyp.Scale, s)
+		case vector.ArithDiv:
+			v, err = decimal.Div(l, ltyp.Scale, r, rtyp.Scale, s)
+		case vector.ArithMod:
+			v, err = decimal.Mod(l, ltyp.Scale, r, rtyp.Scale)
+		}
+		switch {
+		case err != nil:
+			divzeros = append(divzeros, i)
+		case !decimal.Fits(v, p):
+			overflows = append(overflows, i)
+		default:
+			out.Append(v)
+		}
+	}
+	if len(overflows) == 0 && len(divzeros) == 0 {
+		return out
+	}
+	// Combine the error slots in ascending order with their messages.
+	errs := append(overflows, divzeros...)
+	slices.Sort(errs)
+	msgs := vector.NewStringEmpty(uint32(len(errs)))
+	for _, slot := range errs {
+		if _, ok := slices.BinarySearch(divzeros, slot); ok {
+			msgs.Append(decimal.ErrDivByZero.Error())
+		} else {
+			msgs.Append(decimal.ErrOverflow.Error())
+		}
+	}
+	return vector.Combine(out, errs, vector.NewError(a.sctx.LookupTypeError(super.TypeString), msgs))
+}
@@ -0,0 +1,98 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+)
+
+// evalTime computes lhs op rhs where lhs or rhs is a time or a duration.
+// Times are instants and durations are the intervals between them, so, e.g.,
+// the difference of two times is a duration, a duration may be scaled by a
+// number, and the quotient of two durations is their float64 ratio.
+func (a *Arith) evalTime(lhs, rhs vector.Any) vector.Any {
+	op := vector.ArithOpToString(a.opCode)
+	lid, rid := lhs.Type().ID(), rhs.Type().ID()
+	typ := coerce.TimeArithType(op, lid, rid)
+	if typ == nil {
+		temporal := lhs.Type()
+		if lid != super.IDTime && lid != super.IDDuration {
+			temporal = rhs.Type()
+		}
+		s := fmt.Sprintf("type %s incompatible with '%s' operator", sup.FormatType(temporal), op)
+		return vector.NewStringError(a.sctx, s, lhs.Len())
+	}
+	n := lhs.Len()
+	if typ == super.TypeFloat64 {
+		out := vector.NewFloatEmpty(typ, n)
+		for i := range n {
+			out.Append(float64(vector.IntValue(lhs, i)) / float64(vector.IntValue(rhs, i)))
+		}
+		return out
+	}
+	float := super.IsFloat(lid) || super.IsFloat(rid)
+	out := vector.NewIntEmpty(typ, n)
+	var errs []uint32
+	for i := range n {
+		if float {
+			l, r := timeOperandFloatValue(lhs, i), timeOperandFloatValue(rhs, i)
+			var v float64
+			if a.opCode == vector.ArithMul {
+				v = l * r
+			} else {
+				v = l / r
+			}
+			if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) >= math.MaxInt64 {
+				errs = append(errs, i)
+				continue
+			}
+			out.Append(int64(math.Round(v)))
+			continue
+		}
+		l, r := timeOperandIntValue(lhs, i), timeOperandIntValue(rhs, i)
+		switch a.opCode {
+		case vector.ArithAdd:
+			out.Append(l + r)
+		case vector.ArithSub:
+			out.Append(l - r)
+		case vector.ArithMul:
+			out.Append(l * r)
+		case vector.ArithDiv, vector.ArithMod:
+			if r == 0 {
+				errs = append(errs, i)
+				continue
+			}
+			if a.opCode == vector.ArithDiv {
+				out.Append(l / r)
+			} else {
+				out.Append(l % r)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		msg := "divide by zero"
+		if float {
+			msg = "duration out of range"
+		}
+		return vector.Combine(out, errs, vector.NewStringError(a.sctx, msg, uint32(len(errs))))
+	}
+	return out
+}
+
+func timeOperandIntValue(vec vector.Any, slot uint32) int64 {
+	if super.IsUnsigned(vec.Type().ID()) {
+		return int64(vector.UintValue(vec, slot))
+	}
+	return vector.IntValue(vec, slot)
+}
+
+func timeOperandFloatValue(vec vector.Any, slot uint32) float64 {
+	if super.IsFloat(vec.Type().ID()) {
+		return vector.FloatValue(vec, slot)
+	}
+	return float64(timeOperandIntValue(vec, slot))
+}
@@ -0,0 +1,90 @@
+package expr
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// evalBigInt computes lhs op rhs where at least one side is a 128- or
+// 256-bit integer and the other is an integer.  Since the generated
+// kernels operate on 64-bit values, the operands are promoted and
+// computed slot by slot with math/big.
+func (a *Arith) evalBigInt(lhs, rhs vector.Any) vector.Any {
+	id, err := coerce.Promote(super.NewValue(lhs.Type(), nil), super.NewValue(rhs.Type(), nil))
+	if err != nil {
+		return vector.NewStringError(a.sctx, err.Error(), lhs.Len())
+	}
+	typ, err := super.LookupPrimitiveByID(id)
+	if err != nil {
+		panic(err)
+	}
+	op := vector.ArithOpToString(a.opCode)
+	out := vector.NewBigIntEmpty(typ, lhs.Len())
+	var errs []uint32
+	msgs := vector.NewStringEmpty(0)
+	var lb, rb scode.Builder
+	for i := range lhs.Len() {
+		l, lok := coerce.ToBigInt(vector.ValueAt(&lb, lhs, i), typ)
+		r, rok := coerce.ToBigInt(vector.ValueAt(&rb, rhs, i), typ)
+		var v *big.Int
+		err := coerce.ErrIntOverflow
+		if lok && rok {
+			v, err = coerce.BigIntArith(op, id, l, r)
+		}
+		if err != nil {
+			errs = append(errs, i)
+			msgs.Append(err.Error())
+			continue
+		}
+		out.Append(v)
+	}
+	if len(errs) == 0 {
+		return out
+	}
+	return vector.Combine(out, errs, vector.NewError(a.sctx.LookupTypeError(super.TypeString), msgs))
+}
+
+// compareBigInts compares lhs and rhs where at least one side is a 128- or
+// 256-bit integer.  Slots whose values are not comparable are unequal.
+func (c *Compare) compareBigInts(lhs, rhs vector.Any) vector.Any {
+	out := vector.NewFalse(lhs.Len())
+	var lb, rb scode.Builder
+	for i := range lhs.Len() {
+		result, ok := coerce.CompareBigInt(vector.ValueAt(&lb, lhs, i), vector.ValueAt(&rb, rhs, i))
+		if ok && isCompareOpSatisfied(c.opCode, result) || !ok && c.opCode == vector.CompNE {
+			out.Set(i)
+		}
+	}
+	return out
+}
+
+// negBigInts negates a vector of 128- or 256-bit integers.  Unsigned values
+// are negated as the signed type of the same width.
+func (u *unaryMinus) negBigInts(vec vector.Any) vector.Any {
+	typ := vec.Type()
+	switch typ.ID() {
+	case super.IDUint128:
+		typ = super.TypeInt128
+	case super.IDUint256:
+		typ = super.TypeInt256
+	}
+	out := vector.NewBigIntEmpty(typ, vec.Len())
+	var errs []uint32
+	for i := range vec.Len() {
+		v := new(big.Int).Neg(vector.BigIntValue(vec, i))
+		if !super.FitsInt(typ.ID(), v) {
+			errs = append(errs, i)
+			continue
+		}
+		out.Append(v)
+	}
+	if len(errs) == 0 {
+		return out
+	}
+	err := vector.NewWrappedError(u.sctx, "unary '-' underflow", vector.Pick(vec, errs))
+	return vector.Combine(out, errs, err)
+}
@@ -0,0 +1,110 @@
+package cast
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+func castToBigInt(vec vector.Any, typ super.Type, index []uint32) (vector.Any, []uint32, string, bool) {
+	switch id := vec.Type().ID(); {
+	case super.IsDecimal(vec.Type()), super.IsNumber(id), id == super.IDBool, id == super.IDString:
+	default:
+		return nil, nil, "", false
+	}
+	n := lengthOf(vec, index)
+	out := vector.NewBigIntEmpty(typ, n)
+	var errs []uint32
+	var b scode.Builder
+	for i := range n {
+		idx := i
+		if index != nil {
+			idx = index[i]
+		}
+		b.Reset()
+		vec.Serialize(&b, idx)
+		v, ok := coerce.ToBigInt(super.NewValue(vec.Type(), b.Bytes().Body()), typ)
+		if !ok {
+			errs = append(errs, i)
+			continue
+		}
+		out.Append(v)
+	}
+	return out, errs, "", true
+}
+
+// bigIntToNumber casts a vector of 128- or 256-bit integers to the 64-bit
+// number type typ.
+func bigIntToNumber(vec *vector.BigInt, typ super.Type, index []uint32) (vector.Any, []uint32) {
+	n := lengthOf(vec, index)
+	var ints []int64
+	var uints []uint64
+	var floats []float64
+	var errs []uint32
+	id := typ.ID()
+	for i := range n {
+		idx := i
+		if index != nil {
+			idx = index[i]
+		}
+		val := super.NewValue(vec.Typ, vec.Table().Bytes(idx))
+		var ok bool
+		switch {
+		case super.IsSigned(id):
+			var v int64
+			if v, ok = coerce.ToInt(val, typ); ok {
+				ints = append(ints, v)
+			}
+		case super.IsUnsigned(id):
+			var v uint64
+			if v, ok = coerce.ToUint(val, typ); ok {
+				uints = append(uints, v)
+			}
+		default:
+			floats = append(floats, coerce.BigIntToFloat(val.BigInt()))
+			ok = true
+		}
+		if !ok {
+			errs = append(errs, i)
+		}
+	}
+	switch {
+	case super.IsSigned(id):
+		return vector.NewInt(typ, ints), errs
+	case super.IsUnsigned(id):
+		return vector.NewUint(typ, uints), errs
+	default:
+		adjustFloatPrecision(floats, id)
+		return vector.NewFloat(typ, floats), errs
+	}
+}
+
+func bigIntToString(vec *vector.BigInt, index []uint32, n uint32) ([]uint32, []byte) {
+	var bytes []byte
+	offs := []uint32{0}
+	for i := range n {
+		idx := i
+		if index != nil {
+			idx = index[i]
+		}
+		bytes = vec.Value(idx).Append(bytes, 10)
+		offs = append(offs, uint32(len(bytes)))
+	}
+	return offs, bytes
+}
+
+func bigIntToBool(vec *vector.BigInt, index []uint32) *vector.Bool {
+	n := lengthOf(vec, index)
+	out := vector.NewFalse(n)
+	for i := range n {
+		idx := i
+		if index != nil {
+			idx = index[i]
+		}
+		if vec.Value(idx).Sign() != 0 {
+			out.Set(i)
+		}
+	}
+	return out
+}
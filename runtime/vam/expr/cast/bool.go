@@ -13,6 +13,10 @@ func castToBool(vec vector.Any, index []uint32) (vector.Any, []uint32, string, b
 		return numberToBool(vec.Values, index), nil, "", true
 	case *vector.Float:
 		return numberToBool(vec.Values, index), nil, "", true
+	case *vector.Decimal:
+		return decimalToBool(vec, index), nil, "", true
+	case *vector.BigInt:
+		return bigIntToBool(vec, index), nil, "", true
 	case *vector.Bool:
 		out := vector.Any(vec)
 		if index != nil {
@@ -19,7 +19,15 @@ func To(sctx *super.Context, vec vector.Any, typ super.Type) vector.Any {
 	}
 	var c caster
 	id := typ.ID()
-	if super.IsNumber(id) {
+	if dec, ok := typ.(*super.TypeDecimal); ok {
+		c = func(vec vector.Any, index []uint32) (vector.Any, []uint32, string, bool) {
+			return castToDecimal(vec, dec, index)
+		}
+	} else if super.IsBigInt(id) {
+		c = func(vec vector.Any, index []uint32) (vector.Any, []uint32, string, bool) {
+			return castToBigInt(vec, typ, index)
+		}
+	} else if super.IsNumber(id) {
 		c = func(vec vector.Any, index []uint32) (vector.Any, []uint32, string, bool) {
 			return castToNumber(vec, typ, index)
 		}
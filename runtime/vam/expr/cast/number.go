@@ -22,6 +22,12 @@ func castToNumber(vec vector.Any, typ super.Type, index []uint32) (vector.Any, [
 	case id == super.IDString:
 		out, errs := castStringToNumber(vec, typ, index)
 		return out, errs, "", true
+	case super.IsDecimal(vec.Type()):
+		out, errs := decimalToNumber(vec.(*vector.Decimal), typ, index)
+		return out, errs, "", true
+	case super.IsBigInt(id):
+		out, errs := bigIntToNumber(vec.(*vector.BigInt), typ, index)
+		return out, errs, "", true
 	case !super.IsNumber(id) && id != super.IDBool:
 		return nil, nil, "", false
 	}
@@ -62,6 +62,10 @@ func castToString(vec vector.Any, index []uint32) (vector.Any, []uint32, string,
 			bytes = strconv.AppendFloat(bytes, v, 'g', -1, bitSize)
 			offs = append(offs, uint32(len(bytes)))
 		}
+	case *vector.Decimal:
+		offs, bytes = decimalToString(vec, index, n)
+	case *vector.BigInt:
+		offs, bytes = bigIntToString(vec, index, n)
 	case *vector.String:
 		if index == nil {
 			return vec, nil, "", true
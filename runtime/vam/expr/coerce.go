@@ -22,6 +22,13 @@ func coerceVals(sctx *super.Context, a, b vector.Any) (vector.Any, vector.Any, v
 		// sam doesn't support this yet.
 		return a, b, nil
 	}
+	if super.IsDecimal(a.Type()) || super.IsDecimal(b.Type()) {
+		typ, err := coerce.PromoteDecimal(sctx, a.Type(), b.Type())
+		if err != nil {
+			return nil, nil, vector.NewStringError(sctx, err.Error(), a.Len())
+		}
+		return cast.To(sctx, a, typ), cast.To(sctx, b, typ), nil
+	}
 	if !super.IsNumber(aid) || !super.IsNumber(bid) {
 		return nil, nil, vector.NewStringError(sctx, coerce.ErrIncompatibleTypes.Error(), a.Len())
 	}
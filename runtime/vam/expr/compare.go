@@ -39,6 +39,9 @@ func (c *Compare) eval(vecs ...vector.Any) vector.Any {
 	}
 	lhs := vector.Under(vector.Super(vecs[0]))
 	rhs := vector.Under(vector.Super(vecs[1]))
+	if super.IsBigInt(lhs.Type().ID()) || super.IsBigInt(rhs.Type().ID()) {
+		return c.compareBigInts(lhs, rhs)
+	}
 	lhs, rhs, errVal := coerceVals(c.sctx, lhs, rhs)
 	if errVal != nil {
 		// Incompatible types so return true for != and false otherwise.
@@ -52,6 +55,8 @@ func (c *Compare) eval(vecs ...vector.Any) vector.Any {
 	switch kind {
 	case vector.KindBool:
 		return c.compareBool(lhs, rhs)
+	case vector.KindDecimal:
+		return c.compareDecimals(lhs, rhs)
 	case vector.KindIP:
 		return c.compareIPs(lhs, rhs)
 	case vector.KindNet:
@@ -126,6 +131,18 @@ func (c *Compare) compareIPs(lhs, rhs vector.Any) vector.Any {
 	return out
 }
 
+func (c *Compare) compareDecimals(lhs, rhs vector.Any) vector.Any {
+	out := vector.NewFalse(lhs.Len())
+	for i := range lhs.Len() {
+		l := vector.DecimalValue(lhs, i)
+		r := vector.DecimalValue(rhs, i)
+		if isCompareOpSatisfied(c.opCode, l.Cmp(r)) {
+			out.Set(i)
+		}
+	}
+	return out
+}
+
 func (c *Compare) compareNets(lhs, rhs vector.Any) vector.Any {
 	if c.opCode != vector.CompEQ && c.opCode != vector.CompNE {
 		s := fmt.Sprintf("type net incompatible with '%s' operator", vector.CompareOpToString(c.opCode))
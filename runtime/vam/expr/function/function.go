@@ -19,6 +19,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 	switch name {
 	case "abs":
 		f = &Abs{sctx}
+	case "at_time_zone":
+		argmin, argmax = 2, 2
+		f = newSamFunc(sctx, function.NewAtTimeZone(sctx))
 	case "base64":
 		f = &Base64{sctx}
 	case "bucket":
@@ -50,6 +53,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		argmin = 2
 		argmax = 2
 		f = &DatePart{sctx}
+	case "date_trunc":
+		argmin, argmax = 2, 3
+		f = newSamFunc(sctx, function.NewDateTrunc(sctx))
 	case "defuse":
 		f = defuse{}
 	case "downcast":
@@ -79,8 +85,15 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = newHas(sctx)
 	case "has_error":
 		f = HasError{sctx}
+	case "hash":
+		f = newSamFunc(sctx, function.NewHash())
 	case "hex":
 		f = &Hex{sctx}
+	case "hll_estimate":
+		f = newSamFunc(sctx, function.NewHLLEstimate(sctx))
+	case "hmac_md5", "hmac_sha1", "hmac_sha256", "hmac_sha512":
+		argmin, argmax = 2, 2
+		f = newDigest(sctx, name)
 	case "is":
 		argmin = 2
 		argmax = 2
@@ -108,9 +121,14 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = &Log{sctx}
 	case "lower":
 		f = &ToLower{sctx}
+	case "md5", "sha1", "sha256", "sha512":
+		f = newDigest(sctx, name)
 	case "missing":
 		argmax = -1
 		f = &Missing{sctx}
+	case "murmur3_32":
+		argmax = 2
+		f = &Murmur3{sctx}
 	case "nameof":
 		f = &NameOf{sctx: sctx}
 	case "nest_dotted":
@@ -155,6 +173,20 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 	case "strftime":
 		argmin, argmax = 2, 2
 		f = &Strftime{sctx: sctx}
+	case "strptime":
+		argmin, argmax = 2, 2
+		f = newSamFunc(sctx, function.NewStrptime(sctx))
+	case "tdigest_quantile":
+		argmin, argmax = 2, 2
+		f = newSamFunc(sctx, function.NewTDigestQuantile(sctx))
+	case "theta_difference", "theta_intersect", "theta_union":
+		argmin, argmax = 2, 2
+		f = newSamFunc(sctx, function.NewThetaSetOp(sctx, name))
+	case "theta_estimate":
+		f = newSamFunc(sctx, function.NewThetaEstimate(sctx))
+	case "time_bucket":
+		argmin, argmax = 2, 3
+		f = newSamFunc(sctx, function.NewTimeBucket(sctx))
 	case "trim":
 		f = &Trim{sctx}
 	case "typename":
@@ -172,6 +204,8 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, error) {
 		f = NewUpcast(sctx)
 	case "upper":
 		f = &ToUpper{sctx}
+	case "xxhash64":
+		f = &XXHash64{sctx}
 	default:
 		return nil, function.ErrNoSuchFunction
 	}
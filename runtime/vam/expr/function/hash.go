@@ -0,0 +1,131 @@
+package function
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/murmur3"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/cast"
+	"github.com/brimdata/super/vector"
+	"github.com/cespare/xxhash/v2"
+)
+
+type Digest struct {
+	sctx    *super.Context
+	name    string
+	newHash func() hash.Hash
+	hmac    bool
+}
+
+func newDigest(sctx *super.Context, name string) *Digest {
+	newHash, isHMAC := function.DigestFunc(name)
+	if newHash == nil {
+		panic(name)
+	}
+	return &Digest{sctx: sctx, name: name, newHash: newHash, hmac: isHMAC}
+}
+
+func (d *Digest) Call(args ...vector.Any) vector.Any {
+	if vec, ok := expr.CheckForNullThenError(args); ok {
+		return vec
+	}
+	args = underAll(args)
+	val := args[0]
+	if !function.IsBytesOrString(val.Type()) {
+		return vector.NewWrappedError(d.sctx, d.name+": argument must be a bytes or string type", val)
+	}
+	var key vector.Any
+	var h hash.Hash
+	if d.hmac {
+		key = args[1]
+		if !function.IsBytesOrString(key.Type()) {
+			return vector.NewWrappedError(d.sctx, d.name+": key must be a bytes or string type", key)
+		}
+		if _, ok := key.(*vector.Const); ok {
+			h = hmac.New(d.newHash, bytesValue(key, 0))
+			key = nil
+		}
+	} else {
+		h = d.newHash()
+	}
+	var sum []byte
+	out := vector.NewStringEmpty(val.Len())
+	for i := range val.Len() {
+		if key != nil {
+			h = hmac.New(d.newHash, bytesValue(key, i))
+		} else {
+			h.Reset()
+		}
+		h.Write(bytesValue(val, i))
+		sum = h.Sum(sum[:0])
+		out.Append(hex.EncodeToString(sum))
+	}
+	return out
+}
+
+type XXHash64 struct {
+	sctx *super.Context
+}
+
+func (x *XXHash64) Call(args ...vector.Any) vector.Any {
+	if vec, ok := expr.CheckForNullThenError(args); ok {
+		return vec
+	}
+	val := vector.Under(args[0])
+	if !function.IsBytesOrString(val.Type()) {
+		return vector.NewWrappedError(x.sctx, "xxhash64: argument must be a bytes or string type", val)
+	}
+	out := vector.NewIntEmpty(super.TypeInt64, val.Len())
+	for i := range val.Len() {
+		out.Append(int64(xxhash.Sum64(bytesValue(val, i))))
+	}
+	return out
+}
+
+type Murmur3 struct {
+	sctx *super.Context
+}
+
+func (m *Murmur3) Call(args ...vector.Any) vector.Any {
+	if vec, ok := expr.CheckForNullThenError(args); ok {
+		return vec
+	}
+	args = underAll(args)
+	val := args[0]
+	if !function.IsBytesOrString(val.Type()) {
+		return vector.NewWrappedError(m.sctx, "murmur3_32: argument must be a bytes or string type", val)
+	}
+	var seed vector.Any
+	if len(args) > 1 {
+		seed = args[1]
+		if !super.IsInteger(seed.Type().ID()) || super.IsBigInt(seed.Type().ID()) {
+			return vector.NewWrappedError(m.sctx, "murmur3_32: seed must be a uint32", seed)
+		}
+		seed = cast.To(m.sctx, seed, super.TypeUint32)
+		if seed.Kind() != vector.KindUint {
+			// Some seeds are out of range so fall back to the sequential
+			// implementation to produce the errors.
+			return newSamFunc(m.sctx, function.NewMurmur3(m.sctx)).Call(args...)
+		}
+	}
+	out := vector.NewUintEmpty(super.TypeUint32, val.Len())
+	for i := range val.Len() {
+		var s uint32
+		if seed != nil {
+			s = uint32(vector.UintValue(seed, i))
+		}
+		out.Append(uint64(murmur3.Sum32(bytesValue(val, i), s)))
+	}
+	return out
+}
+
+func bytesValue(vec vector.Any, slot uint32) []byte {
+	if vec.Type().ID() == super.IDString {
+		return []byte(vector.StringValue(vec, slot))
+	}
+	return vector.BytesValue(vec, slot)
+}
@@ -21,12 +21,33 @@ func (a *Abs) Call(args ...vector.Any) vector.Any {
 	switch id := vec.Type().ID(); {
 	case super.IsUnsigned(id):
 		return vec
+	case super.IsBigInt(id):
+		return a.absBigInt(vec)
 	case super.IsSigned(id) || super.IsFloat(id):
 		return a.abs(vec)
 	}
 	return vector.NewWrappedError(a.sctx, "abs: not a number", vec)
 }
 
+func (a *Abs) absBigInt(vec vector.Any) vector.Any {
+	out := vector.NewBigIntEmpty(vec.Type(), vec.Len())
+	var errs []uint32
+	for i := range vec.Len() {
+		v := vector.BigIntValue(vec, i)
+		v.Abs(v)
+		if !super.FitsInt(vec.Type().ID(), v) {
+			errs = append(errs, i)
+			continue
+		}
+		out.Append(v)
+	}
+	if len(errs) == 0 {
+		return out
+	}
+	err := vector.NewWrappedError(a.sctx, "abs: overflow", vector.Pick(vec, errs))
+	return vector.Combine(out, errs, err)
+}
+
 func (a *Abs) abs(vec vector.Any) vector.Any {
 	switch vec := vec.(type) {
 	case *vector.Const:
@@ -0,0 +1,21 @@
+package expr
+
+import "github.com/brimdata/super/order"
+
+// SortExpr is a sort key whose values are compared in Order with nulls
+// placed according to Nulls.
+type SortExpr struct {
+	Evaluator
+	Order order.Which
+	Nulls order.Nulls
+}
+
+func NewSortExpr(eval Evaluator, o order.Which, n order.Nulls) SortExpr {
+	return SortExpr{eval, o, n}
+}
+
+// NullsMax reports whether s treats null as the maximum value.
+func (s *SortExpr) NullsMax() bool {
+	return s.Order == order.Asc && s.Nulls == order.NullsLast ||
+		s.Order == order.Desc && s.Nulls == order.NullsFirst
+}
@@ -2,6 +2,7 @@ package expr
 
 import (
 	"math"
+	"math/big"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/vam/expr/cast"
@@ -26,10 +27,20 @@ func (u *unaryMinus) eval(vecs ...vector.Any) vector.Any {
 		return vec
 	}
 	vec := vector.Under(vecs[0])
+	if dec, ok := vec.Type().(*super.TypeDecimal); ok {
+		out := vector.NewDecimalEmpty(dec, vec.Len())
+		for i := range vec.Len() {
+			out.Append(new(big.Int).Neg(vector.DecimalValue(vec, i)))
+		}
+		return out
+	}
 	id := vec.Type().ID()
 	if !super.IsNumber(id) {
 		return vector.NewWrappedError(u.sctx, "type incompatible with unary '-' operator", vecs[0])
 	}
+	if super.IsBigInt(id) {
+		return u.negBigInts(vec)
+	}
 	if super.IsUnsigned(id) {
 		var typ super.Type
 		switch id {
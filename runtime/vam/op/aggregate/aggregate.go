@@ -3,19 +3,33 @@ package aggregate
 import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/op/spill"
+	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/vector/vio"
 )
 
+// numPartitions is the number of partitions into which an aggregate divides
+// its spilled state.
+const numPartitions = 16
+
+// maxLevel is the deepest level of partitioning.  A partition at this level
+// is aggregated in memory regardless of the memory budget.
+const maxLevel = 3
+
 type Aggregate struct {
+	rctx   *runtime.Context
 	parent vio.Puller
 	sctx   *super.Context
 	defuse *expr.Defuse
 	// XX Abstract this runtime into a generic table computation.
 	// Then the generic interface can execute fast paths for simple scenarios.
 	aggs        []*expr.Aggregator
+	aggNames    []field.Path
 	aggExprs    []expr.Evaluator
+	keyNames    []field.Path
 	keyExprs    []expr.Evaluator
 	typeTable   *super.TypeVectorTable
 	builder     *vector.RecordBuilder
@@ -25,19 +39,30 @@ type Aggregate struct {
 	types   []super.Type
 	tables  map[int]aggTable
 	results []aggTable
+	nbytes  int
+
+	// State for spilling, which divides the input among partitions by key
+	// when the hash tables exceed the memory budget of rctx.
+	level      int
+	partitions *spill.Partitions
+	partition  int
+	child      *Aggregate
 }
 
-func New(parent vio.Puller, sctx *super.Context, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
-	builder, err := vector.NewRecordBuilder(sctx, append(keyNames, aggNames...))
+func New(rctx *runtime.Context, parent vio.Puller, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
+	builder, err := vector.NewRecordBuilder(rctx.Sctx, append(keyNames, aggNames...))
 	if err != nil {
 		return nil, err
 	}
 	return &Aggregate{
+		rctx:        rctx,
 		parent:      parent,
-		sctx:        sctx,
-		defuse:      expr.NewDefuse(sctx),
+		sctx:        rctx.Sctx,
+		defuse:      expr.NewDefuse(rctx.Sctx),
 		aggs:        aggs,
+		aggNames:    aggNames,
 		aggExprs:    aggExprs,
+		keyNames:    keyNames,
 		keyExprs:    keyExprs,
 		tables:      make(map[int]aggTable),
 		typeTable:   super.NewTypeVectorTable(),
@@ -50,19 +75,39 @@ func New(parent vio.Puller, sctx *super.Context, aggNames []field.Path, aggExprs
 
 func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 	if done {
-		_, err := a.parent.Pull(done)
+		err := a.reset()
+		if _, pullErr := a.parent.Pull(done); err == nil {
+			err = pullErr
+		}
 		return nil, err
 	}
+	if a.partitions != nil {
+		return a.nextPartition()
+	}
 	if a.results != nil {
 		return a.next(), nil
 	}
 	for {
-		//XXX check context Done
+		if err := a.rctx.Err(); err != nil {
+			return nil, err
+		}
 		vec, err := a.parent.Pull(false)
 		if err != nil {
+			a.reset()
 			return nil, err
 		}
 		if vec == nil {
+			if a.partitions != nil {
+				if err := a.spill(); err != nil {
+					a.reset()
+					return nil, err
+				}
+				if err := a.partitions.Rewind(a.rctx, a.sctx); err != nil {
+					a.reset()
+					return nil, err
+				}
+				return a.nextPartition()
+			}
 			for _, t := range a.tables {
 				a.results = append(a.results, t)
 			}
@@ -82,18 +127,30 @@ func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 				vals = append(vals, e.Eval(vec))
 			}
 		}
+		var nbytes int
 		vector.Apply(vector.ApplyRipUnions|vector.ApplyRipFusions, func(args ...vector.Any) vector.Any {
-			a.consume(args[:len(keys)], args[len(keys):])
+			nbytes += a.consume(args[:len(keys)], args[len(keys):])
 			// XXX Perhaps there should be a "consume" version of Apply where
 			// no return value is expected.
 			return vector.NewNull(args[0].Len())
 		}, append(keys, vals...)...)
+		if a.rctx.Memory.Grow(nbytes) {
+			a.nbytes += nbytes
+		} else if a.level < maxLevel {
+			// The values just consumed are spilled along with the rest.
+			if err := a.spill(); err != nil {
+				a.reset()
+				return nil, err
+			}
+		}
+		// Otherwise, partitioning is exhausted and the tables grow past
+		// the budget.
 	}
 }
 
-func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) {
+func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) int {
 	if keys[0].Len() == 0 {
-		return
+		return 0
 	}
 	var keyTypes []super.Type
 	for _, k := range keys {
@@ -105,7 +162,7 @@ func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) {
 		table = a.newAggTable(keyTypes)
 		a.tables[tableID] = table
 	}
-	table.update(keys, vals)
+	return table.update(keys, vals)
 }
 
 func (a *Aggregate) newAggTable(keyTypes []super.Type) aggTable {
@@ -132,9 +189,119 @@ func (a *Aggregate) isCountByString(keyTypes []super.Type) bool {
 func (a *Aggregate) next() vector.Any {
 	if len(a.results) == 0 {
 		a.results = nil
+		a.releaseMemory()
 		return nil
 	}
 	t := a.results[0]
 	a.results = a.results[1:]
-	return t.materialize()
+	return t.materialize(a.partialsOut)
+}
+
+// spill writes the partial results of a.tables to a.partitions, dividing
+// them by key, and empties a.tables.
+func (a *Aggregate) spill() error {
+	if a.partitions == nil {
+		var err error
+		if a.partitions, err = spill.NewPartitions(numPartitions, a.level); err != nil {
+			return err
+		}
+	}
+	var keyExprs []expr.Evaluator
+	for _, name := range a.keyNames {
+		keyExprs = append(keyExprs, expr.NewDottedExpr(a.sctx, name))
+	}
+	var b scode.Builder
+	for _, t := range a.tables {
+		vec := t.materialize(true)
+		var keys []vector.Any
+		for _, e := range keyExprs {
+			keys = append(keys, e.Eval(vec))
+		}
+		indexes := make([][]uint32, numPartitions)
+		for slot := range vec.Len() {
+			b.Truncate()
+			for _, key := range keys {
+				key.Serialize(&b, slot)
+			}
+			i := a.partitions.Index(b.Bytes())
+			indexes[i] = append(indexes[i], slot)
+		}
+		if err := a.partitions.Push(vec, indexes); err != nil {
+			return err
+		}
+	}
+	clear(a.tables)
+	a.releaseMemory()
+	return nil
+}
+
+// nextPartition returns the next vector of results from aggregating the
+// partial results in a.partitions one partition at a time.
+func (a *Aggregate) nextPartition() (vector.Any, error) {
+	for {
+		if a.child == nil {
+			if a.partition == len(a.partitions.Files) {
+				return nil, a.reset()
+			}
+			child, err := a.newChild(a.partitions.Files[a.partition])
+			if err != nil {
+				a.reset()
+				return nil, err
+			}
+			a.child = child
+			a.partition++
+		}
+		vec, err := a.child.Pull(false)
+		if err != nil {
+			a.reset()
+			return nil, err
+		}
+		if vec != nil {
+			return vec, nil
+		}
+		a.child = nil
+	}
+}
+
+// newChild returns an Aggregate for the partial results in a partition.
+func (a *Aggregate) newChild(parent vio.Puller) (*Aggregate, error) {
+	var aggExprs, keyExprs []expr.Evaluator
+	for _, name := range a.aggNames {
+		aggExprs = append(aggExprs, expr.NewDottedExpr(a.sctx, name))
+	}
+	for _, name := range a.keyNames {
+		keyExprs = append(keyExprs, expr.NewDottedExpr(a.sctx, name))
+	}
+	child, err := New(a.rctx, parent, a.aggNames, aggExprs, a.aggs, a.keyNames, keyExprs, true, a.partialsOut)
+	if err != nil {
+		return nil, err
+	}
+	child.level = a.level + 1
+	return child, nil
+}
+
+func (a *Aggregate) releaseMemory() {
+	a.rctx.Memory.Shrink(a.nbytes)
+	a.nbytes = 0
+}
+
+// reset discards the state of the current stream, including any spilled
+// partitions.
+func (a *Aggregate) reset() error {
+	var err error
+	if a.child != nil {
+		err = a.child.reset()
+		a.child = nil
+	}
+	if a.partitions != nil {
+		if removeErr := a.partitions.CloseAndRemove(); err == nil {
+			err = removeErr
+		}
+		a.partitions = nil
+		a.partition = 0
+	}
+	clear(a.tables)
+	a.results = nil
+	a.releaseMemory()
+	return err
 }
@@ -15,10 +15,18 @@ import (
 
 // one aggTable per fixed set of types of aggs and keys.
 type aggTable interface {
-	update([]vector.Any, []vector.Any)
-	materialize() vector.Any
+	// update returns the approximate number of bytes of memory added to
+	// the table.
+	update([]vector.Any, []vector.Any) int
+	// materialize returns the rows of the table with aggregate values as
+	// partial results if partials is true.
+	materialize(partials bool) vector.Any
 }
 
+// rowOverhead approximates the memory used by a table for each row in
+// addition to its key.
+const rowOverhead = 64
+
 type superTable struct {
 	aggs        []*expr.Aggregator
 	builder     *vector.RecordBuilder
@@ -36,7 +44,8 @@ type aggRow struct {
 	funcs []expr.AggFunc
 }
 
-func (s *superTable) update(keys []vector.Any, args []vector.Any) {
+func (s *superTable) update(keys []vector.Any, args []vector.Any) int {
+	var nbytes int
 	m := make(map[string][]uint32)
 	if len(keys) > 0 {
 		var b scode.Builder
@@ -57,6 +66,7 @@ func (s *superTable) update(keys []vector.Any, args []vector.Any) {
 			id = len(s.rows)
 			s.table[rowKey] = id
 			s.rows = append(s.rows, s.newRow(keys, index))
+			nbytes += 2*len(rowKey) + rowOverhead*(1+len(args))
 		}
 		row := s.rows[id]
 		for i, arg := range args {
@@ -64,12 +74,25 @@ func (s *superTable) update(keys []vector.Any, args []vector.Any) {
 				arg = vector.Pick(arg, index)
 			}
 			if s.partialsIn {
-				row.funcs[i].ConsumeAsPartial(arg)
+				consumePartials(row.funcs[i], arg)
 			} else {
 				row.funcs[i].Consume(arg)
 			}
 		}
 	}
+	return nbytes
+}
+
+// consumePartials consumes each partial result in vec, which holds more
+// than one when partials for a key are spilled more than once.
+func consumePartials(f expr.AggFunc, vec vector.Any) {
+	if vec.Len() == 1 {
+		f.ConsumeAsPartial(vec)
+		return
+	}
+	for slot := range vec.Len() {
+		f.ConsumeAsPartial(vector.Pick(vec, []uint32{slot}))
+	}
 }
 
 func (s *superTable) newRow(keys []vector.Any, index []uint32) aggRow {
@@ -86,7 +109,7 @@ func (s *superTable) newRow(keys []vector.Any, index []uint32) aggRow {
 	return row
 }
 
-func (s *superTable) materialize() vector.Any {
+func (s *superTable) materialize(partials bool) vector.Any {
 	if len(s.rows) == 0 {
 		return vector.NewNull(0)
 	}
@@ -95,7 +118,7 @@ func (s *superTable) materialize() vector.Any {
 		vecs = append(vecs, s.materializeKey(i))
 	}
 	for i := range s.rows[0].funcs {
-		vecs = append(vecs, s.materializeAgg(i))
+		vecs = append(vecs, s.materializeAgg(i, partials))
 	}
 	// Since aggs can return dynamic values need to do apply to create record.
 	return vector.Apply(vector.ApplyNone, func(vecs ...vector.Any) vector.Any {
@@ -111,10 +134,10 @@ func (s *superTable) materializeKey(i int) vector.Any {
 	return b.Build(s.sctx)
 }
 
-func (s *superTable) materializeAgg(i int) vector.Any {
+func (s *superTable) materializeAgg(i int, partials bool) vector.Any {
 	b := vbuild.NewDynamicBuilder()
 	for _, row := range s.rows {
-		if s.partialsOut {
+		if partials {
 			b.Write(row.funcs[i].ResultAsPartial(s.sctx))
 		} else {
 			b.Write(row.funcs[i].Result(s.sctx))
@@ -139,12 +162,20 @@ func newCountByString(typ super.Type, b *vector.RecordBuilder, partialsIn bool)
 	}
 }
 
-func (c *countByString) update(keys, vals []vector.Any) {
+func (c *countByString) update(keys, vals []vector.Any) int {
+	n := len(c.table)
 	if c.partialsIn {
 		c.updatePartial(keys[0], vals[0])
-		return
+	} else {
+		c.updateCounts(keys[0])
 	}
-	switch val := vector.Under(keys[0]).(type) {
+	// The new keys are not known so estimate their size from the keys
+	// of this update.
+	return (len(c.table) - n) * (rowOverhead + avgLen(keys[0]))
+}
+
+func (c *countByString) updateCounts(keys vector.Any) {
+	switch val := vector.Under(keys).(type) {
 	case *vector.String:
 		c.count(val)
 	case *vector.Dict:
@@ -159,14 +190,33 @@ func (c *countByString) update(keys, vals []vector.Any) {
 }
 
 func (c *countByString) updatePartial(keyvec, valvec vector.Any) {
-	key, ok1 := vector.Under(keyvec).(*vector.String)
-	val, ok2 := valvec.(*vector.Int)
-	if !ok1 || !ok2 {
+	// Partials read from a spill file may be dictionary or constant encoded.
+	key := vector.Under(keyvec)
+	if key.Type().ID() != super.IDString || valvec.Type() != super.TypeInt64 {
 		panic("count by string: invalid partials in")
 	}
 	for i := range key.Len() {
-		c.table[key.Value(i)] += val.Values[i]
+		c.table[vector.StringValue(key, i)] += vector.IntValue(valvec, i)
+	}
+}
+
+// avgLen returns the average length of the strings in vec.
+func avgLen(vec vector.Any) int {
+	var n int
+	switch vec := vector.Under(vec).(type) {
+	case *vector.String:
+		n = len(vec.Table().RawBytes())
+	case *vector.Dict:
+		return avgLen(vec.Any)
+	case *vector.Const:
+		return avgLen(vec.Any)
+	case *vector.View:
+		return avgLen(vec.Any)
+	}
+	if vec.Len() == 0 {
+		return 0
 	}
+	return n / int(vec.Len())
 }
 
 func (c *countByString) count(vec *vector.String) {
@@ -196,7 +246,7 @@ func (c *countByString) countView(vec *vector.View) {
 	}
 }
 
-func (c *countByString) materialize() vector.Any {
+func (c *countByString) materialize(bool) vector.Any {
 	length := len(c.table)
 	counts := make([]int64, length)
 	var bytes []byte
@@ -0,0 +1,501 @@
+package op
+
+import (
+	"encoding/binary"
+	"iter"
+	"slices"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+// asofBatchSize is the number of joined values in each output vector.
+const asofBatchSize = 2048
+
+// AsofJoin matches each value from its left input with the nearest value
+// from its right input whose key is equal.  The nearest value is the one
+// whose "on" value is closest to the left value's "on" value in the direction
+// given by op, which compares the left and right "on" values.  For > and >=,
+// this is the latest preceding right value, and for < and <=, it is the
+// earliest following right value.  A left value matches nothing if its key
+// is missing or its "on" value is null or an error.
+//
+// If merge is true, both inputs must be sorted in ascending order of their
+// "on" values, and AsofJoin merges them as they stream, holding only the
+// latest right value for each key (for > and >=) or the left values waiting
+// for a right value (for < and <=).  Otherwise, AsofJoin builds a table of
+// the right input sorted by "on" value and searches it for each left value.
+type AsofJoin struct {
+	rctx       *runtime.Context
+	style      string
+	left       vio.Puller
+	right      vio.Puller
+	leftKey    expr.Evaluator
+	rightKey   expr.Evaluator
+	leftOn     expr.Evaluator
+	rightOn    expr.Evaluator
+	op         string
+	leftAlias  string
+	rightAlias string
+	merge      bool
+	// bound, if not nil, is the tolerance window for a left value, i.e.,
+	// the earliest "on" value of a matching right value for > and >= or
+	// the latest for < and <=.
+	bound expr.Evaluator
+	cmp   samexpr.CompareFn
+
+	next func() (vector.Any, error, bool)
+	stop func()
+
+	out     *vector.DynamicValueBuilder
+	nout    int
+	builder scode.Builder
+}
+
+// NewAsofJoin returns an AsofJoin for style "inner" or "left".  The keys are
+// nil if values are matched only by their "on" values.
+func NewAsofJoin(rctx *runtime.Context, style string, left, right vio.Puller, leftKey, rightKey,
+	leftOn, rightOn expr.Evaluator, op, leftAlias, rightAlias string, bound expr.Evaluator, merge bool) *AsofJoin {
+	return &AsofJoin{
+		rctx:       rctx,
+		style:      style,
+		left:       left,
+		right:      right,
+		leftKey:    leftKey,
+		rightKey:   rightKey,
+		leftOn:     leftOn,
+		rightOn:    rightOn,
+		op:         op,
+		leftAlias:  leftAlias,
+		rightAlias: rightAlias,
+		merge:      merge,
+		bound:      bound,
+		cmp:        samexpr.NewValueCompareFn(order.Asc, order.NullsLast),
+		out:        vector.NewDynamicValueBuilder(),
+	}
+}
+
+func (a *AsofJoin) Pull(done bool) (vector.Any, error) {
+	if done {
+		a.done()
+		_, err := a.left.Pull(true)
+		if err == nil {
+			_, err = a.right.Pull(true)
+		}
+		return nil, err
+	}
+	if a.next == nil {
+		a.next, a.stop = iter.Pull2(a.join)
+	}
+	vec, err, ok := a.next()
+	if vec == nil || err != nil || !ok {
+		a.done()
+	}
+	return vec, err
+}
+
+func (a *AsofJoin) done() {
+	a.next = nil
+	if a.stop != nil {
+		a.stop()
+		a.stop = nil
+	}
+	a.out = vector.NewDynamicValueBuilder()
+	a.nout = 0
+}
+
+// join performs the join, passing results to yield.  It implements iter.Seq2.
+func (a *AsofJoin) join(yield func(vector.Any, error) bool) {
+	var err error
+	switch {
+	case !a.merge:
+		err = a.searchJoin(yield)
+	case a.backward():
+		err = a.mergeBackward(yield)
+	default:
+		err = a.mergeForward(yield)
+	}
+	if err != nil {
+		yield(nil, err)
+		return
+	}
+	if vec := a.flush(); vec != nil && !yield(vec, nil) {
+		return
+	}
+	yield(nil, nil)
+}
+
+// backward returns true if a right value matches when it precedes a left
+// value.
+func (a *AsofJoin) backward() bool {
+	return a.op == ">" || a.op == ">="
+}
+
+// precedes returns true if a right value with "on" value r comes before a
+// left value with "on" value l in the order of the merged inputs.  For > and
+// >=, a right value that precedes a left value may match it, and for < and
+// <=, a right value matches only left values that it does not precede.
+func (a *AsofJoin) precedes(r, l super.Value) bool {
+	c := a.cmp(r, l)
+	if a.op == ">=" || a.op == "<" {
+		return c <= 0
+	}
+	return c < 0
+}
+
+// withinTolerance returns true if a right value with "on" value r lies in the
+// tolerance window given by bound for a left value.
+func (a *AsofJoin) withinTolerance(r super.Value, bound *super.Value) bool {
+	if a.bound == nil {
+		return true
+	}
+	if bound.IsNull() || bound.IsError() || !comparableValues(r, *bound) {
+		return false
+	}
+	if a.backward() {
+		return a.cmp(r, *bound) >= 0
+	}
+	return a.cmp(r, *bound) <= 0
+}
+
+// comparableValues returns true if x and y are numbers or values of the same type.
+func comparableValues(x, y super.Value) bool {
+	x, y = x.Under(), y.Under()
+	return x.Type() == y.Type() || super.IsNumber(x.Type().ID()) && super.IsNumber(y.Type().ID())
+}
+
+type asofEntry struct {
+	on  super.Value
+	val super.Value
+}
+
+// searchJoin builds a table from the right input and then searches it for
+// each value from the left input.
+func (a *AsofJoin) searchJoin(yield func(vector.Any, error) bool) error {
+	// Pull from both inputs concurrently in case they share a parent.
+	leftBuf, rightBuf, err := pullRace(a.rctx.Context, a.left, a.right)
+	if err != nil {
+		return err
+	}
+	table := map[string][]asofEntry{}
+	right := newAsofInput(rightBuf, a.rightKey, a.rightOn, nil)
+	for {
+		ok, err := right.more()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if key, on, ok := right.keyOn(); ok {
+			table[key] = append(table[key], asofEntry{on.Copy(), right.value().Copy()})
+		}
+		right.off++
+	}
+	for _, entries := range table {
+		slices.SortStableFunc(entries, func(x, y asofEntry) int {
+			return a.cmp(x.on, y.on)
+		})
+	}
+	left := newAsofInput(leftBuf, a.leftKey, a.leftOn, a.bound)
+	for {
+		ok, err := left.more()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		var match *super.Value
+		if key, on, ok := left.keyOn(); ok {
+			match = a.search(table[key], on, left.boundValue())
+		}
+		if !a.emit(yield, left.value(), match) {
+			return nil
+		}
+		left.off++
+	}
+}
+
+// search returns the value in entries, which are sorted by "on" value, that
+// matches a left value with "on" value on or nil if there is none.  Of the
+// right values with equal "on" values, the last matches for > and >= and the
+// first matches for < and <=.
+func (a *AsofJoin) search(entries []asofEntry, on super.Value, bound *super.Value) *super.Value {
+	// k is the index of the first entry that doesn't precede on.
+	k := sort.Search(len(entries), func(i int) bool {
+		return !a.precedes(entries[i].on, on)
+	})
+	if a.backward() {
+		k--
+	}
+	if k < 0 || k >= len(entries) || !a.withinTolerance(entries[k].on, bound) {
+		return nil
+	}
+	return &entries[k].val
+}
+
+// mergeBackward merges sorted inputs for > and >=, matching each left value
+// with the latest right value with the same key that precedes it.
+func (a *AsofJoin) mergeBackward(yield func(vector.Any, error) bool) error {
+	left := newAsofInput(a.left, a.leftKey, a.leftOn, a.bound)
+	right := newAsofInput(a.right, a.rightKey, a.rightOn, nil)
+	latest := map[string]asofEntry{}
+	rightOK := true
+	for {
+		ok, err := left.more()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		var match *super.Value
+		if key, on, ok := left.keyOn(); ok {
+			for rightOK {
+				if rightOK, err = right.more(); err != nil {
+					return err
+				}
+				if !rightOK {
+					break
+				}
+				rkey, ron, ok := right.keyOn()
+				if ok {
+					if !a.precedes(ron, on) {
+						break
+					}
+					latest[rkey] = asofEntry{ron.Copy(), right.value().Copy()}
+				}
+				right.off++
+			}
+			if e, ok := latest[key]; ok && a.withinTolerance(e.on, left.boundValue()) {
+				match = &e.val
+			}
+		}
+		if !a.emit(yield, left.value(), match) {
+			return nil
+		}
+		left.off++
+	}
+	if rightOK {
+		_, err := a.right.Pull(true)
+		return err
+	}
+	return nil
+}
+
+type asofPending struct {
+	bound super.Value
+	val   super.Value
+}
+
+// mergeForward merges sorted inputs for < and <=, holding each left value
+// until the earliest right value with the same key that follows it arrives.
+func (a *AsofJoin) mergeForward(yield func(vector.Any, error) bool) error {
+	left := newAsofInput(a.left, a.leftKey, a.leftOn, a.bound)
+	right := newAsofInput(a.right, a.rightKey, a.rightOn, nil)
+	pending := map[string][]asofPending{}
+	// resolve matches the right value at the cursor with the left values
+	// waiting for it.
+	resolve := func() bool {
+		key, on, ok := right.keyOn()
+		if !ok || len(pending[key]) == 0 {
+			return true
+		}
+		val := right.value()
+		for _, p := range pending[key] {
+			var match *super.Value
+			if a.withinTolerance(on, &p.bound) {
+				match = &val
+			}
+			if !a.emit(yield, p.val, match) {
+				return false
+			}
+		}
+		delete(pending, key)
+		return true
+	}
+	rightOK := true
+	for {
+		ok, err := left.more()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		key, on, ok := left.keyOn()
+		if !ok {
+			if !a.emit(yield, left.value(), nil) {
+				return nil
+			}
+			left.off++
+			continue
+		}
+		for rightOK {
+			if rightOK, err = right.more(); err != nil {
+				return err
+			}
+			if !rightOK {
+				break
+			}
+			if _, ron, ok := right.keyOn(); ok && !a.precedes(ron, on) {
+				break
+			}
+			if !resolve() {
+				return nil
+			}
+			right.off++
+		}
+		var bound super.Value
+		if b := left.boundValue(); b != nil {
+			bound = b.Copy()
+		}
+		pending[key] = append(pending[key], asofPending{bound, left.value().Copy()})
+		left.off++
+	}
+	for rightOK && len(pending) > 0 {
+		var err error
+		if rightOK, err = right.more(); err != nil {
+			return err
+		}
+		if rightOK {
+			if !resolve() {
+				return nil
+			}
+			right.off++
+		}
+	}
+	for _, p := range pending {
+		for _, p := range p {
+			if !a.emit(yield, p.val, nil) {
+				return nil
+			}
+		}
+	}
+	if rightOK {
+		_, err := a.right.Pull(true)
+		return err
+	}
+	return nil
+}
+
+// emit adds the result for left and its match, if any, to the output and
+// passes the output to yield when it is full.  emit returns false if yield
+// does.
+func (a *AsofJoin) emit(yield func(vector.Any, error) bool, left super.Value, right *super.Value) bool {
+	if right == nil && a.style != "left" {
+		return true
+	}
+	a.out.Write(wrapJoin(a.rctx.Sctx, &a.builder, a.leftAlias, a.rightAlias, &left, right))
+	a.nout++
+	if a.nout < asofBatchSize {
+		return true
+	}
+	return yield(a.flush(), nil)
+}
+
+func (a *AsofJoin) flush() vector.Any {
+	if a.nout == 0 {
+		return nil
+	}
+	vec := a.out.Build(a.rctx.Sctx)
+	a.out = vector.NewDynamicValueBuilder()
+	a.nout = 0
+	return vec
+}
+
+// asofInput is a cursor over the values of an input to an AsofJoin.
+type asofInput struct {
+	parent vio.Puller
+	key    expr.Evaluator
+	on     expr.Evaluator
+	bound  expr.Evaluator
+
+	vec    vector.Any
+	keys   vector.Any
+	ons    vector.Any
+	bounds vector.Any
+	off    uint32
+
+	keyBuilder   scode.Builder
+	onBuilder    scode.Builder
+	boundBuilder scode.Builder
+	valBuilder   scode.Builder
+}
+
+func newAsofInput(parent vio.Puller, key, on, bound expr.Evaluator) *asofInput {
+	return &asofInput{parent: parent, key: key, on: on, bound: bound}
+}
+
+// more returns true if there is a value at the cursor, pulling from the
+// parent as needed.
+func (a *asofInput) more() (bool, error) {
+	for a.vec == nil || a.off >= a.vec.Len() {
+		vec, err := a.parent.Pull(false)
+		if vec == nil || err != nil {
+			a.vec = nil
+			return false, err
+		}
+		a.vec, a.off = vec, 0
+		if a.key != nil {
+			a.keys = a.key.Eval(vec)
+		}
+		a.ons = a.on.Eval(vec)
+		if a.bound != nil {
+			a.bounds = a.bound.Eval(vec)
+		}
+	}
+	return true, nil
+}
+
+// keyOn returns the hash key and "on" value at the cursor.  It returns false
+// if the key is missing or the "on" value is null or an error.  The "on"
+// value is valid until the next call to keyOn.
+//
+// The hash key includes the class of the "on" value so that, as in a
+// comparison expression, a left value matches only right values whose "on"
+// values are comparable with its own, i.e., numbers of any type or values
+// of the same type.  The total order of values used to sort and search
+// "on" values is otherwise defined across types.
+func (a *asofInput) keyOn() (string, super.Value, bool) {
+	var key []byte
+	if a.key != nil {
+		val := vector.ValueAt(&a.keyBuilder, a.keys, a.off)
+		if val.IsMissing() {
+			return "", super.Value{}, false
+		}
+		key = []byte(hashKey(val))
+	}
+	on := vector.ValueAt(&a.onBuilder, a.ons, a.off).Under()
+	if on.IsNull() || on.IsError() {
+		return "", super.Value{}, false
+	}
+	class := on.Type().ID()
+	if super.IsNumber(class) {
+		class = super.IDFloat64
+	}
+	return string(binary.LittleEndian.AppendUint32(key, uint32(class))), on, true
+}
+
+// boundValue returns the bound at the cursor or nil if there is no bound.
+// It is valid until the next call to boundValue.
+func (a *asofInput) boundValue() *super.Value {
+	if a.bound == nil {
+		return nil
+	}
+	return vector.ValueAt(&a.boundBuilder, a.bounds, a.off).Ptr()
+}
+
+// value returns the value at the cursor.  It is valid until the next call to
+// value.
+func (a *asofInput) value() super.Value {
+	return vector.ValueAt(&a.valBuilder, a.vec, a.off)
+}
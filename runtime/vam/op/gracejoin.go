@@ -0,0 +1,198 @@
+package op
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/joinfilter"
+	"github.com/brimdata/super/runtime/vam/op/spill"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+// joinPartitions is the number of partitions into which a hash join divides
+// each input when its table exceeds the memory budget.
+const joinPartitions = 16
+
+// joinMaxLevel is the deepest level of partitioning.  The table for a
+// partition at this level is built in memory regardless of the budget.
+const joinMaxLevel = 3
+
+// graceJoin is a hash join whose table does not fit in memory.  Both inputs
+// are divided among partitions by the hash of their keys so that matching
+// values fall in the same partition, and then each pair of partitions is
+// joined in memory, partitioning again where a table is still too large.
+type graceJoin struct {
+	h         *HashJoin
+	level     int
+	buildLeft bool
+	build     *spill.Partitions
+	probe     *spill.Partitions
+	partition int
+	join      *hashJoin
+	nbytes    int
+	child     *graceJoin
+}
+
+// newGraceJoin partitions table, for which nbytes are reserved, and the
+// remaining values of the build and probe inputs.
+func newGraceJoin(h *HashJoin, level int, buildLeft bool, table map[string][]super.Value, nbytes int, build, probe vio.Puller) (*graceJoin, error) {
+	g := &graceJoin{h: h, level: level, buildLeft: buildLeft}
+	err := g.partitionTable(table)
+	h.rctx.Memory.Shrink(nbytes)
+	if err != nil {
+		g.close()
+		return nil, err
+	}
+	if err := g.partitionInput(g.build, build, buildLeft); err != nil {
+		g.close()
+		return nil, err
+	}
+	h.publishFilter()
+	if err := g.partitionInput(g.probe, probe, !buildLeft); err != nil {
+		g.close()
+		return nil, err
+	}
+	if err := g.build.Rewind(h.rctx, h.rctx.Sctx); err != nil {
+		g.close()
+		return nil, err
+	}
+	if err := g.probe.Rewind(h.rctx, h.rctx.Sctx); err != nil {
+		g.close()
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *graceJoin) partitionTable(table map[string][]super.Value) error {
+	var err error
+	if g.build, err = spill.NewPartitions(joinPartitions, g.level); err != nil {
+		return err
+	}
+	if g.probe, err = spill.NewPartitions(joinPartitions, g.level); err != nil {
+		return err
+	}
+	builders := make([]*vector.DynamicValueBuilder, joinPartitions)
+	for key, vals := range table {
+		i := g.build.Index([]byte(key))
+		if builders[i] == nil {
+			builders[i] = vector.NewDynamicValueBuilder()
+		}
+		for _, val := range vals {
+			builders[i].Write(val)
+		}
+	}
+	for i, b := range builders {
+		if b == nil {
+			continue
+		}
+		if err := g.build.Files[i].Push(b.Build(g.h.rctx.Sctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partitionInput writes the values pulled from p to parts.  As when building
+// a table, values whose keys are missing are dropped and the keys of the build
+// input are added to the join's filter.
+func (g *graceJoin) partitionInput(parts *spill.Partitions, p vio.Puller, left bool) error {
+	keyExpr := g.h.rightKey
+	if left {
+		keyExpr = g.h.leftKey
+	}
+	var filter *joinfilter.Filter
+	if parts == g.build {
+		filter = g.h.collectingFilter()
+	}
+	var sb scode.Builder
+	for {
+		if err := g.h.rctx.Err(); err != nil {
+			return err
+		}
+		vec, err := p.Pull(false)
+		if vec == nil || err != nil {
+			return err
+		}
+		keyVec := keyExpr.Eval(vec)
+		indexes := make([][]uint32, joinPartitions)
+		for slot := range vec.Len() {
+			keyVal := vector.ValueAt(&sb, keyVec, slot)
+			if keyVal.IsMissing() {
+				continue
+			}
+			if filter != nil {
+				filter.Add(keyVal)
+			}
+			i := parts.Index([]byte(hashKey(keyVal)))
+			indexes[i] = append(indexes[i], slot)
+		}
+		if err := parts.Push(vec, indexes); err != nil {
+			return err
+		}
+	}
+}
+
+func (g *graceJoin) Pull() (vector.Any, error) {
+	for {
+		if g.child != nil {
+			vec, err := g.child.Pull()
+			if vec != nil || err != nil {
+				return vec, err
+			}
+			if err := g.child.close(); err != nil {
+				return nil, err
+			}
+			g.child = nil
+		}
+		if g.join != nil {
+			vec, err := g.join.Pull()
+			if vec != nil || err != nil {
+				return vec, err
+			}
+			g.join = nil
+			g.h.rctx.Memory.Shrink(g.nbytes)
+			g.nbytes = 0
+		}
+		if g.partition == joinPartitions {
+			return nil, nil
+		}
+		build, probe := g.build.Files[g.partition], g.probe.Files[g.partition]
+		g.partition++
+		table, nbytes, complete, err := g.h.buildTable(build, g.buildLeft, g.level < joinMaxLevel)
+		if err != nil {
+			g.h.rctx.Memory.Shrink(nbytes)
+			return nil, err
+		}
+		if !complete {
+			g.child, err = newGraceJoin(g.h, g.level+1, g.buildLeft, table, nbytes, build, probe)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		g.join = g.h.newHashJoin(table, probe, g.buildLeft)
+		g.nbytes = nbytes
+	}
+}
+
+// close releases the memory and removes the files held by g.
+func (g *graceJoin) close() error {
+	var err error
+	if g.child != nil {
+		err = g.child.close()
+		g.child = nil
+	}
+	g.join = nil
+	g.h.rctx.Memory.Shrink(g.nbytes)
+	g.nbytes = 0
+	for _, parts := range []*spill.Partitions{g.build, g.probe} {
+		if parts == nil {
+			continue
+		}
+		if removeErr := parts.CloseAndRemove(); err == nil {
+			err = removeErr
+		}
+	}
+	g.build, g.probe = nil, nil
+	return err
+}
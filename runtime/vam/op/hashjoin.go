@@ -7,6 +7,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/joinfilter"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/vector"
@@ -23,102 +24,223 @@ type HashJoin struct {
 	rightKey   expr.Evaluator
 	leftAlias  string
 	rightAlias string
+	// build is "left" or "right" to build the table from that input or
+	// empty to build it from the first input to reach EOS.
+	build string
+	// partitioned is true to partition both inputs by key before joining
+	// rather than first trying to build the table in memory.
+	partitioned bool
+	// filter, if not nil, is published with the keys of the table.
+	filter *joinfilter.Filter
 
 	hashJoin *hashJoin
+	grace    *graceJoin
+	// nbytes is the memory reserved for the table of hashJoin.
+	nbytes int
 }
 
 func NewHashJoin(rctx *runtime.Context, style string, left, right vio.Puller,
-	leftKey, rightKey expr.Evaluator, leftAlias, rightAlias string) *HashJoin {
+	leftKey, rightKey expr.Evaluator, leftAlias, rightAlias, build string, partitioned bool) *HashJoin {
 	if style == "right" {
 		leftKey, rightKey = rightKey, leftKey
 		left, right = right, left
+		switch build {
+		case "left":
+			build = "right"
+		case "right":
+			build = "left"
+		}
 	}
 	if style == "cross" {
 		panic("cross join not compatible with hash join")
 	}
 	return &HashJoin{
-		rctx:       rctx,
-		style:      style,
-		left:       left,
-		right:      right,
-		leftKey:    leftKey,
-		rightKey:   rightKey,
-		leftAlias:  leftAlias,
-		rightAlias: rightAlias,
+		rctx:        rctx,
+		style:       style,
+		left:        left,
+		right:       right,
+		leftKey:     leftKey,
+		rightKey:    rightKey,
+		leftAlias:   leftAlias,
+		rightAlias:  rightAlias,
+		build:       build,
+		partitioned: partitioned,
 	}
 }
 
+// SetFilter arranges for h to add the keys of its table to f and publish f
+// once all of the keys are known.
+func (h *HashJoin) SetFilter(f *joinfilter.Filter) {
+	h.filter = f
+}
+
 func (h *HashJoin) Pull(done bool) (vector.Any, error) {
 	if done {
 		_, err := h.left.Pull(true)
 		if err == nil {
 			_, err = h.right.Pull(true)
 		}
-		h.hashJoin = nil
+		if closeErr := h.close(); err == nil {
+			err = closeErr
+		}
 		return nil, err
 	}
-	if h.hashJoin == nil {
+	if h.hashJoin == nil && h.grace == nil {
 		if err := h.tableInit(); err != nil {
+			h.close()
 			return nil, err
 		}
 	}
-	vec, err := h.hashJoin.Pull()
+	var vec vector.Any
+	var err error
+	if h.grace != nil {
+		vec, err = h.grace.Pull()
+	} else {
+		vec, err = h.hashJoin.Pull()
+	}
 	if vec == nil || err != nil {
-		h.hashJoin = nil
+		if closeErr := h.close(); err == nil {
+			err = closeErr
+		}
 	}
 	return vec, err
 }
 
+func (h *HashJoin) close() error {
+	h.hashJoin = nil
+	h.rctx.Memory.Shrink(h.nbytes)
+	h.nbytes = 0
+	if h.grace != nil {
+		err := h.grace.close()
+		h.grace = nil
+		return err
+	}
+	return nil
+}
+
 func (h *HashJoin) tableInit() error {
-	// Read from both leftBuf and rightBuf parent and find the shortest parent to
-	// create the table from.
-	leftBuf, rightBuf, err := pullRace(h.rctx.Context, h.left, h.right)
+	// The table is built from the left input if buildLeft is true.
+	var build, probe vio.Puller
+	var buildLeft bool
+	// The inputs are wrapped in bufPullers, as with pullRace, so that they
+	// stay at EOS once they reach it.
+	switch h.build {
+	case "left":
+		build, probe, buildLeft = &bufPuller{puller: h.left}, &bufPuller{puller: h.right}, true
+	case "right":
+		build, probe, buildLeft = &bufPuller{puller: h.right}, &bufPuller{puller: h.left}, false
+	default:
+		// Read from both leftBuf and rightBuf parent and find the shortest
+		// parent to create the table from.
+		leftBuf, rightBuf, err := pullRace(h.rctx.Context, h.left, h.right)
+		if err != nil {
+			return err
+		}
+		build, probe, buildLeft = leftBuf, rightBuf, true
+		if rightBuf.EOS {
+			build, probe, buildLeft = rightBuf, leftBuf, false
+		}
+	}
+	if h.partitioned {
+		var err error
+		h.grace, err = newGraceJoin(h, 0, buildLeft, nil, 0, build, probe)
+		return err
+	}
+	table, nbytes, complete, err := h.buildTable(build, buildLeft, true)
 	if err != nil {
+		h.rctx.Memory.Shrink(nbytes)
 		return err
 	}
-	var table map[string][]super.Value
-	var left, right vio.Puller
-	if rightBuf.EOS {
-		table = buildTable(rightBuf, h.rightKey)
-		left = leftBuf
-	} else {
-		table = buildTable(leftBuf, h.leftKey)
-		right = rightBuf
+	if !complete {
+		h.grace, err = newGraceJoin(h, 0, buildLeft, table, nbytes, build, probe)
+		return err
 	}
-	h.hashJoin = &hashJoin{
+	h.publishFilter()
+	h.hashJoin = h.newHashJoin(table, probe, buildLeft)
+	h.nbytes = nbytes
+	return nil
+}
+
+func (h *HashJoin) newHashJoin(table map[string][]super.Value, probe vio.Puller, buildLeft bool) *hashJoin {
+	j := &hashJoin{
 		sctx:       h.rctx.Sctx,
 		style:      h.style,
 		table:      table,
-		left:       left,
-		right:      right,
 		leftAlias:  h.leftAlias,
 		rightAlias: h.rightAlias,
 		leftKey:    h.leftKey,
 		rightKey:   h.rightKey,
 		hits:       make(map[string]bool),
 	}
-	return nil
+	if buildLeft {
+		j.right = probe
+	} else {
+		j.left = probe
+	}
+	return j
 }
 
-func buildTable(p vio.Puller, key expr.Evaluator) map[string][]super.Value {
+// valueOverhead approximates the memory used by a hash table for each value
+// in addition to the value and its key.
+const valueOverhead = 48
+
+// buildTable returns a table of the values pulled from p and the number of
+// bytes reserved for it in h.rctx.Memory.  If canSpill is true and the table
+// exceeds the memory budget, buildTable stops pulling from p and reports
+// that the table is incomplete.
+func (h *HashJoin) buildTable(p vio.Puller, buildLeft, canSpill bool) (map[string][]super.Value, int, bool, error) {
+	keyExpr := h.rightKey
+	if buildLeft {
+		keyExpr = h.leftKey
+	}
 	var sb scode.Builder
 	table := map[string][]super.Value{}
+	var nbytes int
 	for {
-		vec, _ := p.Pull(false)
+		vec, err := p.Pull(false)
+		if err != nil {
+			return table, nbytes, false, err
+		}
 		if vec == nil {
-			break
+			return table, nbytes, true, nil
 		}
-		rightKeyVec := key.Eval(vec)
+		keyVec := keyExpr.Eval(vec)
+		filter := h.collectingFilter()
+		var delta int
 		for i := range vec.Len() {
-			keyVal := vector.ValueAt(&sb, rightKeyVec, i)
+			keyVal := vector.ValueAt(&sb, keyVec, i)
 			if keyVal.IsMissing() {
 				continue
 			}
+			if filter != nil {
+				filter.Add(keyVal)
+			}
 			key := hashKey(keyVal)
-			table[key] = append(table[key], vector.ValueAt(&sb, vec, i).Copy())
+			val := vector.ValueAt(&sb, vec, i).Copy()
+			table[key] = append(table[key], val)
+			delta += len(key) + len(val.Bytes()) + valueOverhead
+		}
+		if h.rctx.Memory.Grow(delta) {
+			nbytes += delta
+		} else if canSpill {
+			return table, nbytes, false, nil
 		}
 	}
-	return table
+}
+
+// collectingFilter returns the filter to which the keys of the table are
+// added or nil if there is none or it has been published.
+func (h *HashJoin) collectingFilter() *joinfilter.Filter {
+	if h.filter == nil || h.filter.Published() {
+		return nil
+	}
+	return h.filter
+}
+
+func (h *HashJoin) publishFilter() {
+	if h.filter != nil && !h.filter.Published() {
+		h.filter.Publish()
+	}
 }
 
 // pullRace pulls from a and b concurrently until one reaches EOS.  It returns
@@ -273,20 +395,26 @@ func (j *hashJoin) wrap(l, r *super.Value) super.Value {
 	if j.style == "right" {
 		l, r = r, l
 	}
-	j.builder.Reset()
+	return wrapJoin(j.sctx, &j.builder, j.leftAlias, j.rightAlias, l, r)
+}
+
+// wrapJoin returns a record with a field named leftAlias for l and a field
+// named rightAlias for r, omitting either field if its value is nil.
+func wrapJoin(sctx *super.Context, b *scode.Builder, leftAlias, rightAlias string, l, r *super.Value) super.Value {
+	b.Reset()
 	var fields []super.Field
 	if l != nil {
 		left := l.Deunion()
-		fields = append(fields, super.Field{Name: j.leftAlias, Type: left.Type()})
-		j.builder.Append(left.Bytes())
+		fields = append(fields, super.Field{Name: leftAlias, Type: left.Type()})
+		b.Append(left.Bytes())
 	}
 	if r != nil {
 		right := r.Deunion()
-		fields = append(fields, super.Field{Name: j.rightAlias, Type: right.Type()})
-		j.builder.Append(right.Bytes())
+		fields = append(fields, super.Field{Name: rightAlias, Type: right.Type()})
+		b.Append(right.Bytes())
 
 	}
-	return super.NewValue(j.sctx.MustLookupTypeRecord(fields), j.builder.Bytes())
+	return super.NewValue(sctx.MustLookupTypeRecord(fields), b.Bytes())
 }
 
 type bufPuller struct {
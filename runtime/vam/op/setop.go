@@ -0,0 +1,121 @@
+package op
+
+import (
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+// SetOp implements the SQL INTERSECT and EXCEPT operations.  Values from
+// the right parent are counted in a hash table keyed by their type and
+// bytes, so nulls compare as equal, and values from the left parent are
+// then filtered against the table in order.
+type SetOp struct {
+	rctx     *runtime.Context
+	except   bool
+	distinct bool
+	left     vio.Puller
+	right    vio.Puller
+
+	counts  map[string]int
+	emitted map[string]struct{}
+	probe   vio.Puller
+}
+
+func NewSetOp(rctx *runtime.Context, op string, distinct bool, left, right vio.Puller) *SetOp {
+	return &SetOp{
+		rctx:     rctx,
+		except:   op == "except",
+		distinct: distinct,
+		left:     left,
+		right:    right,
+	}
+}
+
+func (s *SetOp) Pull(done bool) (vector.Any, error) {
+	if done {
+		_, err := s.left.Pull(true)
+		if err == nil {
+			_, err = s.right.Pull(true)
+		}
+		s.counts, s.emitted, s.probe = nil, nil, nil
+		return nil, err
+	}
+	if s.probe == nil {
+		if err := s.buildTable(); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		vec, err := s.probe.Pull(false)
+		if vec == nil || err != nil {
+			s.counts, s.emitted, s.probe = nil, nil, nil
+			return nil, err
+		}
+		if out := s.filter(vec); out != nil {
+			return out, nil
+		}
+	}
+}
+
+func (s *SetOp) buildTable() error {
+	// Pull from both parents concurrently since they may share an upstream
+	// fork.
+	leftBuf, rightBuf, err := pullRace(s.rctx.Context, s.left, s.right)
+	if err != nil {
+		return err
+	}
+	var sb scode.Builder
+	counts := map[string]int{}
+	for {
+		vec, err := rightBuf.Pull(false)
+		if err != nil {
+			return err
+		}
+		if vec == nil {
+			break
+		}
+		for i := range vec.Len() {
+			counts[hashKey(vector.ValueAt(&sb, vec, i))]++
+		}
+	}
+	s.counts = counts
+	s.emitted = map[string]struct{}{}
+	s.probe = leftBuf
+	return nil
+}
+
+func (s *SetOp) filter(vec vector.Any) vector.Any {
+	var sb scode.Builder
+	var index []uint32
+	for i := range vec.Len() {
+		key := hashKey(vector.ValueAt(&sb, vec, i))
+		if s.distinct {
+			if _, ok := s.emitted[key]; ok {
+				continue
+			}
+			if (s.counts[key] > 0) != s.except {
+				s.emitted[key] = struct{}{}
+				index = append(index, i)
+			}
+			continue
+		}
+		// With ALL, each right value cancels one matching left value.
+		if n := s.counts[key]; n > 0 {
+			s.counts[key] = n - 1
+			if !s.except {
+				index = append(index, i)
+			}
+		} else if s.except {
+			index = append(index, i)
+		}
+	}
+	if len(index) == 0 {
+		return nil
+	}
+	if len(index) == int(vec.Len()) {
+		return vec
+	}
+	return vector.Pick(vec, index)
+}
@@ -0,0 +1,282 @@
+package sort
+
+import (
+	"cmp"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// kind classifies the values of a column.  Columns of the same kind other
+// than kindValue, and numeric columns of different kinds, are compared
+// without forming super.Values.
+type kind int
+
+const (
+	kindValue kind = iota
+	kindInt
+	kindUint
+	kindFloat
+	kindString
+)
+
+// column holds the values of a sort key for the slots of a vector in a
+// form that can be compared quickly.
+type column struct {
+	kind   kind
+	nulls  []bool // nil if no value is null
+	ints   []int64
+	uints  []uint64
+	floats []float64
+	strs   []string
+	// vec holds the key values for comparing columns of kindValue and of
+	// different kinds.
+	vec vector.Any
+}
+
+func newColumn(vec vector.Any) *column {
+	vec = vector.Deunion(vec)
+	if d, ok := vec.(*vector.Dynamic); ok {
+		return newDynamicColumn(d)
+	}
+	c := &column{kind: kindOf(vec.Type()), vec: vec}
+	n := vec.Len()
+	switch c.kind {
+	case kindInt:
+		c.ints = make([]int64, n)
+		for i := range n {
+			c.ints[i] = vector.IntValue(vec, i)
+		}
+	case kindUint:
+		c.uints = make([]uint64, n)
+		for i := range n {
+			c.uints[i] = vector.UintValue(vec, i)
+		}
+	case kindFloat:
+		c.floats = make([]float64, n)
+		for i := range n {
+			c.floats[i] = vector.FloatValue(vec, i)
+		}
+	case kindString:
+		under := vector.Under(vec)
+		c.strs = make([]string, n)
+		for i := range n {
+			c.strs[i] = vector.StringValue(under, i)
+		}
+	default:
+		c.nulls = nullsOf(vec)
+	}
+	return c
+}
+
+// newDynamicColumn returns a column for a vector of mixed types.  The column
+// has a kind other than kindValue if every value that is not null has the
+// same kind.
+func newDynamicColumn(d *vector.Dynamic) *column {
+	subs := make([]*column, len(d.Values))
+	k, first := kindValue, true
+	for i, vec := range d.Values {
+		if vec == nil || vec.Len() == 0 {
+			continue
+		}
+		subs[i] = newColumn(vec)
+		if isAllNull(subs[i]) {
+			continue
+		}
+		if first {
+			k, first = subs[i].kind, false
+		} else if k != subs[i].kind {
+			k = kindValue
+			break
+		}
+	}
+	n := d.Len()
+	c := &column{kind: k, nulls: make([]bool, n), vec: d}
+	switch k {
+	case kindInt:
+		c.ints = make([]int64, n)
+	case kindUint:
+		c.uints = make([]uint64, n)
+	case kindFloat:
+		c.floats = make([]float64, n)
+	case kindString:
+		c.strs = make([]string, n)
+	}
+	forward := d.ForwardTagMap()
+	for i, tag := range d.Tags {
+		sub, j := subs[tag], forward[i]
+		if sub == nil {
+			// A sub-column that was skipped because of a change of kind.
+			sub = newColumn(d.Values[tag])
+			subs[tag] = sub
+		}
+		if sub.isNull(j) {
+			c.nulls[i] = true
+			continue
+		}
+		switch k {
+		case kindInt:
+			c.ints[i] = sub.ints[j]
+		case kindUint:
+			c.uints[i] = sub.uints[j]
+		case kindFloat:
+			c.floats[i] = sub.floats[j]
+		case kindString:
+			c.strs[i] = sub.strs[j]
+		}
+	}
+	return c
+}
+
+func kindOf(typ super.Type) kind {
+	switch id := super.TypeUnder(typ).ID(); {
+	case id >= super.IDInt8 && id <= super.IDInt64, id == super.IDDuration, id == super.IDTime:
+		return kindInt
+	case id >= super.IDUint8 && id <= super.IDUint64:
+		return kindUint
+	case id >= super.IDFloat16 && id <= super.IDFloat64:
+		return kindFloat
+	case id == super.IDString:
+		return kindString
+	}
+	return kindValue
+}
+
+// nullsOf returns the slots of vec whose values are null or missing, which
+// sort treats as null, or nil if there are no such slots.
+func nullsOf(vec vector.Any) []bool {
+	switch vector.Under(vec).Kind() {
+	case vector.KindNull:
+		nulls := make([]bool, vec.Len())
+		for i := range nulls {
+			nulls[i] = true
+		}
+		return nulls
+	case vector.KindError:
+		var nulls []bool
+		var b scode.Builder
+		for i := range vec.Len() {
+			if val := vector.ValueAt(&b, vec, i); val.IsMissing() {
+				if nulls == nil {
+					nulls = make([]bool, vec.Len())
+				}
+				nulls[i] = true
+			}
+		}
+		return nulls
+	}
+	return nil
+}
+
+func (c *column) isNull(slot uint32) bool {
+	return c.nulls != nil && c.nulls[slot]
+}
+
+func isAllNull(c *column) bool {
+	for i := range c.vec.Len() {
+		if !c.isNull(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys holds the values of the sort keys of a Comparator for the slots of a
+// vector.
+type Keys []*column
+
+// Comparator compares values by a list of sort keys.
+type Comparator struct {
+	exprs    []expr.SortExpr
+	compares []samexpr.CompareFn
+	a, b     scode.Builder
+}
+
+// NewComparator returns a Comparator for exprs.
+func NewComparator(exprs []expr.SortExpr) *Comparator {
+	var compares []samexpr.CompareFn
+	for _, e := range exprs {
+		// The comparison for kindValue treats nulls nested in container
+		// values according to e.  Order is applied by Comparator.Compare.
+		nulls := order.NullsFirst
+		if e.NullsMax() {
+			nulls = order.NullsLast
+		}
+		compares = append(compares, samexpr.NewValueCompareFn(order.Asc, nulls))
+	}
+	return &Comparator{exprs: exprs, compares: compares}
+}
+
+// Keys evaluates the sort keys for the values in vec.
+func (c *Comparator) Keys(vec vector.Any) Keys {
+	cols := make(Keys, len(c.exprs))
+	for k, e := range c.exprs {
+		cols[k] = newColumn(e.Eval(vec))
+	}
+	return cols
+}
+
+// Compare compares the value at slot i of the keys in a with the value at
+// slot j of the keys in b.
+func (c *Comparator) Compare(a Keys, i uint32, b Keys, j uint32) int {
+	for k, e := range c.exprs {
+		v := c.compareKey(k, a[k], i, b[k], j)
+		if v != 0 {
+			if e.Order == order.Desc {
+				return -v
+			}
+			return v
+		}
+	}
+	return 0
+}
+
+func (c *Comparator) compareKey(k int, a *column, i uint32, b *column, j uint32) int {
+	if an, bn := a.isNull(i), b.isNull(j); an || bn {
+		switch {
+		case an && bn:
+			return 0
+		case an == c.exprs[k].NullsMax():
+			return 1
+		}
+		return -1
+	}
+	switch {
+	case a.kind == kindInt && b.kind == kindInt:
+		return cmp.Compare(a.ints[i], b.ints[j])
+	case a.kind == kindUint && b.kind == kindUint:
+		return cmp.Compare(a.uints[i], b.uints[j])
+	case a.kind == kindFloat && b.kind == kindFloat:
+		return cmp.Compare(a.floats[i], b.floats[j])
+	case a.kind == kindString && b.kind == kindString:
+		return cmp.Compare(a.strs[i], b.strs[j])
+	case a.kind == kindInt && b.kind == kindUint:
+		if a.ints[i] < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(a.ints[i]), b.uints[j])
+	case a.kind == kindUint && b.kind == kindInt:
+		if b.ints[j] < 0 {
+			return 1
+		}
+		return cmp.Compare(a.uints[i], uint64(b.ints[j]))
+	case a.kind == kindFloat && (b.kind == kindInt || b.kind == kindUint):
+		return cmp.Compare(a.floats[i], b.float(j))
+	case b.kind == kindFloat && (a.kind == kindInt || a.kind == kindUint):
+		return cmp.Compare(a.float(i), b.floats[j])
+	}
+	aval := vector.ValueAt(&c.a, a.vec, i)
+	bval := vector.ValueAt(&c.b, b.vec, j)
+	return c.compares[k](aval, bval)
+}
+
+func (c *column) float(slot uint32) float64 {
+	if c.kind == kindInt {
+		return float64(c.ints[slot])
+	}
+	return float64(c.uints[slot])
+}
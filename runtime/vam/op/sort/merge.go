@@ -0,0 +1,107 @@
+package sort
+
+import (
+	"container/heap"
+
+	"github.com/brimdata/super/runtime/vam/op/spill"
+	"github.com/brimdata/super/vector"
+)
+
+// run is the position of a merge in a sorted run.
+type run struct {
+	file  *spill.File
+	index int
+	batch *batch
+	slot  uint32
+}
+
+// merge returns a function that returns the values of o.runs in sorted order.
+// Values with equal keys are returned in the order of their runs so the sort
+// remains stable.
+func (o *Op) merge() (func() (vector.Any, error), error) {
+	m := &merger{comparator: o.comparator}
+	for i, f := range o.runs {
+		if err := f.Rewind(o.rctx.Context, o.rctx.Sctx); err != nil {
+			return nil, err
+		}
+		r := &run{file: f, index: i}
+		if ok, err := m.advance(r); err != nil {
+			return nil, err
+		} else if ok {
+			m.runs = append(m.runs, r)
+		}
+	}
+	heap.Init(m)
+	return func() (vector.Any, error) {
+		if err := o.rctx.Err(); err != nil {
+			return nil, err
+		}
+		var c chunk
+		for n := 0; n < chunkLen && m.Len() > 0; n++ {
+			r := m.runs[0]
+			r.batch.add(&c, r.slot)
+			r.slot++
+			if r.slot < r.batch.vec.Len() {
+				heap.Fix(m, 0)
+				continue
+			}
+			if ok, err := m.advance(r); err != nil {
+				return nil, err
+			} else if ok {
+				heap.Fix(m, 0)
+			} else {
+				heap.Pop(m)
+			}
+		}
+		if len(c.segs) == 0 {
+			return nil, nil
+		}
+		return c.build(), nil
+	}, nil
+}
+
+// merger is a heap of runs ordered by their current values.
+type merger struct {
+	comparator *Comparator
+	runs       []*run
+	nsegs      int
+}
+
+// advance reads the next vector of r and reports whether there was one.
+func (m *merger) advance(r *run) (bool, error) {
+	for {
+		vec, err := r.file.Pull(false)
+		if vec == nil || err != nil {
+			return false, err
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		// Segment identifiers must be unique only within an output chunk
+		// but are never reused here for simplicity.
+		r.batch = newBatch(vec, m.comparator.Keys(vec), m.nsegs)
+		m.nsegs += len(r.batch.segs)
+		r.slot = 0
+		return true, nil
+	}
+}
+
+func (m *merger) Len() int { return len(m.runs) }
+
+func (m *merger) Less(i, j int) bool {
+	a, b := m.runs[i], m.runs[j]
+	if v := m.comparator.Compare(a.batch.cols, a.slot, b.batch.cols, b.slot); v != 0 {
+		return v < 0
+	}
+	return a.index < b.index
+}
+
+func (m *merger) Swap(i, j int) { m.runs[i], m.runs[j] = m.runs[j], m.runs[i] }
+
+func (m *merger) Push(x any) { m.runs = append(m.runs, x.(*run)) }
+
+func (m *merger) Pop() any {
+	x := m.runs[len(m.runs)-1]
+	m.runs = m.runs[:len(m.runs)-1]
+	return x
+}
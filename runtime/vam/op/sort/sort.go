@@ -0,0 +1,385 @@
+// Package sort implements the sort operator for the vector runtime.  Sort
+// keys are evaluated a vector at a time and input values are reordered by
+// picking from their vectors, so dictionaries, views, and constants pass
+// through a sort without being materialized.  When the input exceeds
+// MemMaxBytes, sorted runs are spilled to temporary files and merged.
+package sort
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/op/sort"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/op/spill"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vbuild"
+	"github.com/brimdata/super/vector/vio"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each sort operator
+// will consume.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// chunkLen is the maximum length of the vectors returned by Op.Pull.
+const chunkLen = 64 * 1024
+
+type Op struct {
+	rctx         *runtime.Context
+	parent       vio.Puller
+	exprs        []expr.SortExpr
+	guessReverse bool
+
+	comparator *Comparator
+	batches    []*batch
+	nbytes     int
+	nsegs      int
+	runs       []*spill.File
+	// next returns the next vector of sorted output.  It is non-nil while
+	// sorted output remains for the current stream.
+	next func() (vector.Any, error)
+}
+
+func New(rctx *runtime.Context, parent vio.Puller, exprs []expr.SortExpr, guessReverse bool) *Op {
+	o := &Op{
+		rctx:         rctx,
+		parent:       parent,
+		exprs:        exprs,
+		guessReverse: guessReverse,
+	}
+	if len(exprs) > 0 {
+		o.comparator = NewComparator(exprs)
+	}
+	return o
+}
+
+func (o *Op) Pull(done bool) (vector.Any, error) {
+	if done {
+		if o.next != nil {
+			// The input for this stream has been consumed.
+			return nil, o.reset()
+		}
+		_, err := o.parent.Pull(true)
+		return nil, err
+	}
+	if o.next == nil {
+		if err := o.load(); err != nil {
+			o.reset()
+			return nil, err
+		}
+	}
+	vec, err := o.next()
+	if vec == nil || err != nil {
+		if resetErr := o.reset(); err == nil {
+			err = resetErr
+		}
+	}
+	return vec, err
+}
+
+// load reads the input for a stream and prepares o.next to return the input
+// in sorted order.
+func (o *Op) load() error {
+	for {
+		vec, err := o.parent.Pull(false)
+		if err != nil {
+			return err
+		}
+		if vec == nil {
+			break
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		if o.comparator == nil {
+			o.comparator = o.guess(vec)
+		}
+		o.batches = append(o.batches, o.newBatch(vec))
+		o.nbytes += size(vec)
+		if o.nbytes >= MemMaxBytes {
+			if err := o.spill(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(o.runs) == 0 {
+		o.next = o.sorted()
+		return nil
+	}
+	if len(o.batches) > 0 {
+		if err := o.spill(); err != nil {
+			return err
+		}
+	}
+	next, err := o.merge()
+	if err != nil {
+		return err
+	}
+	o.next = next
+	return nil
+}
+
+// guess returns a comparator for the key chosen by sort.GuessSortKey for the
+// first value of vec.  As in the sequential runtime, the key is chosen once.
+func (o *Op) guess(vec vector.Any) *Comparator {
+	var b scode.Builder
+	path := sort.GuessSortKey(vector.ValueAt(&b, vec, 0))
+	which := order.Asc
+	if o.guessReverse {
+		which = order.Desc
+	}
+	e := expr.NewDottedExpr(o.rctx.Sctx, path)
+	return NewComparator([]expr.SortExpr{expr.NewSortExpr(e, which, order.NullsLast)})
+}
+
+func (o *Op) newBatch(vec vector.Any) *batch {
+	b := newBatch(vec, o.comparator.Keys(vec), o.nsegs)
+	o.nsegs += len(b.segs)
+	return b
+}
+
+// sorted returns a function that returns the values of o.batches in
+// sorted order.
+func (o *Op) sorted() func() (vector.Any, error) {
+	refs := o.sort()
+	batches := o.batches
+	return func() (vector.Any, error) {
+		if len(refs) == 0 {
+			return nil, nil
+		}
+		n := min(len(refs), chunkLen)
+		var c chunk
+		for _, r := range refs[:n] {
+			batches[r.batch].add(&c, r.slot)
+		}
+		refs = refs[n:]
+		return c.build(), nil
+	}
+}
+
+type ref struct {
+	batch int32
+	slot  uint32
+}
+
+// sort returns references to the values of o.batches in sorted order.
+func (o *Op) sort() []ref {
+	var n int
+	for _, b := range o.batches {
+		n += int(b.vec.Len())
+	}
+	refs := make([]ref, 0, n)
+	for i, b := range o.batches {
+		for slot := range b.vec.Len() {
+			refs = append(refs, ref{int32(i), slot})
+		}
+	}
+	slices.SortStableFunc(refs, func(a, b ref) int {
+		return o.comparator.Compare(o.batches[a.batch].cols, a.slot, o.batches[b.batch].cols, b.slot)
+	})
+	return refs
+}
+
+// spill sorts o.batches and writes them to a new run.
+func (o *Op) spill() error {
+	f, err := spill.NewFile("")
+	if err != nil {
+		return err
+	}
+	o.runs = append(o.runs, f)
+	next := o.sorted()
+	for {
+		if err := o.rctx.Err(); err != nil {
+			return err
+		}
+		vec, err := next()
+		if vec == nil || err != nil {
+			if err != nil {
+				return err
+			}
+			break
+		}
+		if err := f.Push(vec); err != nil {
+			return err
+		}
+	}
+	o.batches = nil
+	o.nbytes = 0
+	o.nsegs = 0
+	return nil
+}
+
+func (o *Op) reset() error {
+	var err error
+	for _, f := range o.runs {
+		if closeErr := f.CloseAndRemove(); err == nil {
+			err = closeErr
+		}
+	}
+	o.runs = nil
+	o.batches = nil
+	o.nbytes = 0
+	o.nsegs = 0
+	o.next = nil
+	return err
+}
+
+// batch is an input vector and the values of its sort keys.  The values of
+// a batch are held in one or more segments, which are the values vectors of
+// a Dynamic or else the input vector itself.
+type batch struct {
+	vec     vector.Any
+	cols    []*column
+	tags    []uint32 // nil if vec is not a Dynamic
+	forward []uint32
+	segs    []vector.Any
+	// base identifies the first segment of the batch uniquely among the
+	// batches of an output chunk.
+	base int
+}
+
+func newBatch(vec vector.Any, cols []*column, base int) *batch {
+	b := &batch{vec: vec, cols: cols, base: base}
+	if d, ok := vec.(*vector.Dynamic); ok {
+		b.tags = d.Tags
+		b.forward = d.ForwardTagMap()
+		b.segs = d.Values
+	} else {
+		b.segs = []vector.Any{vec}
+	}
+	return b
+}
+
+// add adds the value at slot of b to c.
+func (b *batch) add(c *chunk, slot uint32) {
+	if b.tags == nil {
+		c.add(b.base, b.segs[0], slot)
+		return
+	}
+	tag := b.tags[slot]
+	c.add(b.base+int(tag), b.segs[tag], b.forward[slot])
+}
+
+// chunk accumulates the values of an output vector.
+type chunk struct {
+	which   map[int]uint32
+	segs    []vector.Any
+	indexes [][]uint32
+	tags    []uint32
+}
+
+func (c *chunk) add(id int, seg vector.Any, slot uint32) {
+	if c.which == nil {
+		c.which = make(map[int]uint32)
+	}
+	tag, ok := c.which[id]
+	if !ok {
+		tag = uint32(len(c.segs))
+		c.which[id] = tag
+		c.segs = append(c.segs, seg)
+		c.indexes = append(c.indexes, nil)
+	}
+	c.indexes[tag] = append(c.indexes[tag], slot)
+	c.tags = append(c.tags, tag)
+}
+
+func (c *chunk) build() vector.Any {
+	if len(c.segs) == 1 {
+		return vector.Pick(c.segs[0], c.indexes[0])
+	}
+	// Each values vector of a Dynamic must have a distinct type, so the
+	// values picked from segments of the same type are concatenated and
+	// then put in order with a final pick.
+	which := make(map[super.Type]uint32)
+	groupOf := make([]uint32, len(c.segs))
+	var groups [][]int
+	for i, seg := range c.segs {
+		g, ok := which[seg.Type()]
+		if !ok {
+			g = uint32(len(groups))
+			which[seg.Type()] = g
+			groups = append(groups, nil)
+		}
+		groupOf[i] = g
+		groups[g] = append(groups[g], i)
+	}
+	vecs := make([]vector.Any, len(groups))
+	offsets := make([]uint32, len(c.segs))
+	for g, segs := range groups {
+		if len(segs) == 1 {
+			vecs[g] = vector.Pick(c.segs[segs[0]], c.indexes[segs[0]])
+			continue
+		}
+		b := vbuild.New(c.segs[segs[0]].Type())
+		var off uint32
+		for _, i := range segs {
+			offsets[i] = off
+			b.Write(vector.Pick(c.segs[i], c.indexes[i]))
+			off += uint32(len(c.indexes[i]))
+		}
+		vecs[g] = b.Build()
+	}
+	tags := make([]uint32, len(c.tags))
+	orders := make([][]uint32, len(groups))
+	for k, i := range c.tags {
+		g := groupOf[i]
+		tags[k] = g
+		orders[g] = append(orders[g], offsets[i])
+		offsets[i]++
+	}
+	for g, segs := range groups {
+		if len(segs) > 1 {
+			vecs[g] = vector.Pick(vecs[g], orders[g])
+		}
+	}
+	if len(vecs) == 1 {
+		return vecs[0]
+	}
+	return vector.NewDynamic(tags, vecs)
+}
+
+// size returns the approximate number of bytes of memory held by vec.
+func size(vec vector.Any) int {
+	switch vec := vec.(type) {
+	case *vector.String:
+		return len(vec.Table().RawBytes()) + 4*int(vec.Len())
+	case *vector.Bytes:
+		return len(vec.Table().RawBytes()) + 4*int(vec.Len())
+	case *vector.Const:
+		return size(vec.Any)
+	case *vector.Dict:
+		return size(vec.Any) + len(vec.Index)
+	case *vector.View:
+		return size(vec.Any) + 4*len(vec.Index)
+	case *vector.Named:
+		return size(vec.Any)
+	case *vector.Error:
+		return size(vec.Vals)
+	case *vector.Record:
+		n := 0
+		for _, f := range vec.Fields {
+			n += size(f)
+		}
+		return n
+	case *vector.Array:
+		return size(vec.Values) + 4*len(vec.Offsets)
+	case *vector.Set:
+		return size(vec.Values) + 4*len(vec.Offsets)
+	case *vector.Map:
+		return size(vec.Keys) + size(vec.Values) + 4*len(vec.Offsets)
+	case *vector.Dynamic:
+		n := 4 * len(vec.Tags)
+		for _, v := range vec.Values {
+			if v != nil {
+				n += size(v)
+			}
+		}
+		return n
+	case *vector.Null:
+		return 0
+	}
+	return 8 * int(vec.Len())
+}
@@ -0,0 +1,80 @@
+// Package spill provides temporary storage for vectors that do not fit in
+// memory.
+package spill
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/csup"
+	"github.com/brimdata/super/pkg/bufwriter"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/brimdata/super/vector"
+)
+
+// File is a temporary file holding a sequence of vectors in CSUP format.
+// Vectors are written to a File with Push and, after a call to Rewind, read
+// back in the same order with Pull.  File implements vio.Pusher and
+// vio.Puller.
+type File struct {
+	file       *os.File
+	serializer *csup.Serializer
+	reader     *csupio.Reader
+}
+
+// NewFile returns a File in dir or, if dir is empty, in the default
+// directory for temporary files.
+func NewFile(dir string) (*File, error) {
+	f, err := os.CreateTemp(dir, spill.TempPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &File{
+		file:       f,
+		serializer: csupio.NewSerializer(bufwriter.New(sio.NopCloser(f))),
+	}, nil
+}
+
+func (f *File) Push(vec vector.Any) error {
+	return f.serializer.Push(vec)
+}
+
+// Rewind flushes the vectors written to f and prepares f for reading them
+// back with Pull.  Types of the vectors read are allocated in sctx.
+func (f *File) Rewind(ctx context.Context, sctx *super.Context) error {
+	if err := f.serializer.Close(); err != nil {
+		return err
+	}
+	size, err := f.file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	f.reader, err = csupio.NewReader(ctx, sctx, io.NewSectionReader(f.file, 0, size), nil, 1)
+	return err
+}
+
+func (f *File) Pull(done bool) (vector.Any, error) {
+	return f.reader.Pull(done)
+}
+
+// Size returns the size of f in bytes.
+func (f *File) Size() (int64, error) {
+	info, err := f.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// CloseAndRemove closes and removes the underlying file.
+func (f *File) CloseAndRemove() error {
+	err := f.file.Close()
+	if rmErr := os.Remove(f.file.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package spill
+
+import (
+	"context"
+	"hash/maphash"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+var seed = maphash.MakeSeed()
+
+// Partitions is a set of Files among which values are divided by the hash of
+// a key, as in a grace hash join.  Each level of partitioning hashes keys
+// differently so that the values of one partition are divided evenly when
+// they are partitioned again.
+type Partitions struct {
+	Files []*File
+	level byte
+}
+
+// NewPartitions returns n Partitions at the given level in the default
+// directory for temporary files.
+func NewPartitions(n, level int) (*Partitions, error) {
+	p := &Partitions{level: byte(level)}
+	for range n {
+		f, err := NewFile("")
+		if err != nil {
+			p.CloseAndRemove()
+			return nil, err
+		}
+		p.Files = append(p.Files, f)
+	}
+	return p, nil
+}
+
+// Index returns the index of the partition for key.
+func (p *Partitions) Index(key []byte) int {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	h.WriteByte(p.level)
+	h.Write(key)
+	return int(h.Sum64() % uint64(len(p.Files)))
+}
+
+// Push writes the values of vec at the slots in indexes[i] to the i-th
+// partition.
+func (p *Partitions) Push(vec vector.Any, indexes [][]uint32) error {
+	for i, index := range indexes {
+		if len(index) == 0 {
+			continue
+		}
+		out := vec
+		if len(index) != int(vec.Len()) {
+			out = vector.Pick(vec, index)
+		}
+		if err := p.Files[i].Push(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rewind calls Rewind on each partition.
+func (p *Partitions) Rewind(ctx context.Context, sctx *super.Context) error {
+	for _, f := range p.Files {
+		if err := f.Rewind(ctx, sctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CloseAndRemove closes and removes the files of all partitions.
+func (p *Partitions) CloseAndRemove() error {
+	var err error
+	for _, f := range p.Files {
+		if closeErr := f.CloseAndRemove(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
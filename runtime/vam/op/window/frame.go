@@ -0,0 +1,271 @@
+package window
+
+import (
+	"cmp"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/op/sort"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// Frame is the set of rows of a partition over which a window function
+// is computed for each row.  Unit is "rows" or "range".
+type Frame struct {
+	Unit  string
+	Start Bound
+	End   Bound
+}
+
+// Bound is a frame boundary.  Type is one of "unbounded_preceding",
+// "preceding", "current_row", "following", or "unbounded_following" and
+// Offset is set for "preceding" and "following".
+type Bound struct {
+	Type   string
+	Offset super.Value
+}
+
+// defaultFrame is the frame used when a window has no frame clause.
+// Without an ORDER BY, every row is a peer of every other row so the
+// frame is the whole partition.
+var defaultFrame = &Frame{
+	Unit:  "range",
+	Start: Bound{Type: "unbounded_preceding"},
+	End:   Bound{Type: "current_row"},
+}
+
+// partition is a vector holding the values of a partition in sort order.
+type partition struct {
+	sctx      *super.Context
+	vec       vector.Any
+	n         int
+	peerStart []int
+	peerEnd   []int
+	rangeKey  *expr.SortExpr
+	keys      []rangeKey
+	floatKeys bool
+	segStart  []int
+	segEnd    []int
+}
+
+type rangeKey struct {
+	ok    bool
+	float bool
+	i     int64
+	f     float64
+}
+
+// newPartition returns a partition for vec.  If peers is nil, every value
+// is a peer of every other value.
+func newPartition(sctx *super.Context, vec vector.Any, peers *sort.Comparator, rangeKey *expr.SortExpr) *partition {
+	n := int(vec.Len())
+	p := &partition{
+		sctx:      sctx,
+		vec:       vec,
+		n:         n,
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+		rangeKey:  rangeKey,
+	}
+	var keys sort.Keys
+	if peers != nil {
+		keys = peers.Keys(vec)
+	}
+	for start := 0; start < n; {
+		end := start + 1
+		for end < n && (peers == nil || peers.Compare(keys, uint32(end-1), keys, uint32(end)) == 0) {
+			end++
+		}
+		for i := start; i < end; i++ {
+			p.peerStart[i] = start
+			p.peerEnd[i] = end
+		}
+		start = end
+	}
+	return p
+}
+
+// bounds returns the half-open interval of rows in the frame of row i.
+func (p *partition) bounds(frame *Frame, i int) (int, int) {
+	if frame == nil {
+		frame = defaultFrame
+	}
+	lo := p.bound(frame, frame.Start, i, true)
+	hi := p.bound(frame, frame.End, i, false)
+	lo = max(0, min(lo, p.n))
+	hi = max(lo, min(hi, p.n))
+	return lo, hi
+}
+
+func (p *partition) bound(frame *Frame, b Bound, i int, start bool) int {
+	switch b.Type {
+	case "unbounded_preceding":
+		return 0
+	case "unbounded_following":
+		return p.n
+	case "current_row":
+		if frame.Unit == "rows" {
+			if start {
+				return i
+			}
+			return i + 1
+		}
+		if start {
+			return p.peerStart[i]
+		}
+		return p.peerEnd[i]
+	}
+	if frame.Unit == "rows" {
+		k, _ := coerce.ToInt(b.Offset, super.TypeInt64)
+		// Clamp k so huge offsets don't overflow.
+		k = min(k, int64(p.n)+1)
+		if b.Type == "preceding" {
+			k = -k
+		}
+		j := int64(i) + k
+		if !start {
+			j++
+		}
+		return int(j)
+	}
+	return p.rangeBound(b, i, start)
+}
+
+// rangeBound returns the bound of a RANGE frame with an offset by binary
+// searching the run of rows with numeric keys that contains row i.  Rows
+// with a null or non-numeric key have only their peers in the frame.
+func (p *partition) rangeBound(b Bound, i int, start bool) int {
+	p.loadKeys()
+	key := p.keys[i]
+	if !key.ok {
+		if start {
+			return p.peerStart[i]
+		}
+		return p.peerEnd[i]
+	}
+	// Keys are non-decreasing in "position" order, i.e., negated
+	// when the sort is descending.
+	dir := int64(1)
+	if p.rangeKey.Order == order.Desc {
+		dir = -1
+	}
+	if b.Type == "preceding" {
+		dir = -dir
+	}
+	lo, hi := p.segStart[i], p.segEnd[i]
+	if p.isFloat(b.Offset) {
+		k, _ := coerce.ToFloat(b.Offset, super.TypeFloat64)
+		target := key.f + float64(dir)*k
+		return search(lo, hi, func(j int) int {
+			return cmp.Compare(p.keys[j].f, target)
+		}, p.rangeKey.Order, start)
+	}
+	k, _ := coerce.ToInt(b.Offset, super.TypeInt64)
+	target := key.i + dir*k
+	return search(lo, hi, func(j int) int {
+		return cmp.Compare(p.keys[j].i, target)
+	}, p.rangeKey.Order, start)
+}
+
+// search returns the first row in [lo,hi) that is after (or, for a start
+// bound, not before) the target in sort order.  compare returns the comparison
+// of the key of row j with the target in ascending order.
+func search(lo, hi int, compare func(int) int, which order.Which, start bool) int {
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		c := compare(mid)
+		if which == order.Desc {
+			c = -c
+		}
+		if c < 0 || c == 0 && !start {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+func (p *partition) loadKeys() {
+	if p.keys != nil {
+		return
+	}
+	n := p.n
+	p.keys = rangeKeys(p.rangeKey.Eval(p.vec))
+	p.segStart = make([]int, n)
+	p.segEnd = make([]int, n)
+	for _, key := range p.keys {
+		p.floatKeys = p.floatKeys || key.ok && key.float
+	}
+	for start := 0; start < n; {
+		end := start + 1
+		for end < n && p.keys[end].ok == p.keys[start].ok {
+			end++
+		}
+		for j := start; j < end; j++ {
+			p.segStart[j] = start
+			p.segEnd[j] = end
+		}
+		start = end
+	}
+}
+
+// rangeKeys returns the numeric values of vec for RANGE arithmetic.
+func rangeKeys(vec vector.Any) []rangeKey {
+	vec = vector.Deunion(vec)
+	n := vec.Len()
+	keys := make([]rangeKey, n)
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for tag, index := range d.ReverseTagMap() {
+			if d.Values[tag] == nil {
+				continue
+			}
+			for j, key := range rangeKeys(d.Values[tag]) {
+				keys[index[j]] = key
+			}
+		}
+		return keys
+	}
+	switch id := super.TypeUnder(vec.Type()).ID(); {
+	case id >= super.IDInt8 && id <= super.IDInt64, id == super.IDDuration, id == super.IDTime:
+		for i := range n {
+			v := vector.IntValue(vec, i)
+			keys[i] = rangeKey{ok: true, i: v, f: float64(v)}
+		}
+	case id >= super.IDUint8 && id <= super.IDUint64:
+		for i := range n {
+			if v := vector.UintValue(vec, i); v <= math.MaxInt64 {
+				keys[i] = rangeKey{ok: true, i: int64(v), f: float64(v)}
+			}
+		}
+	case id >= super.IDFloat16 && id <= super.IDFloat64:
+		for i := range n {
+			keys[i] = rangeKey{ok: true, float: true, f: vector.FloatValue(vec, i)}
+		}
+	case super.IsNumber(id):
+		// Big integers and decimals are converted a value at a time.
+		var b scode.Builder
+		for i := range n {
+			val := vector.ValueAt(&b, vec, i).Under()
+			if super.IsInteger(id) {
+				v, ok := coerce.ToInt(val, super.TypeInt64)
+				keys[i] = rangeKey{ok: ok, i: v, f: float64(v)}
+			} else {
+				v, ok := coerce.ToFloat(val, super.TypeFloat64)
+				keys[i] = rangeKey{ok: ok, float: true, f: v}
+			}
+		}
+	}
+	return keys
+}
+
+// isFloat reports whether range arithmetic on the keys of p with offset
+// must be done in floating point.
+func (p *partition) isFloat(offset super.Value) bool {
+	typ := offset.Under().Type()
+	return p.floatKeys || super.IsFloat(typ.ID()) || super.IsDecimal(typ)
+}
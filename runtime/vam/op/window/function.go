@@ -0,0 +1,362 @@
+package window
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// Function computes a window function over a partition, returning a vector
+// with the result for each value of the partition.
+type Function interface {
+	Eval(p *partition) vector.Any
+}
+
+// NewFunction returns the window function called name.  The ranking and
+// offset functions are implemented here while any other name must be an
+// aggregate function, which is computed over the frame of each row.
+func NewFunction(sctx *super.Context, name string, args []expr.Evaluator, filter expr.Evaluator, frame *Frame) (Function, error) {
+	switch name {
+	case "row_number":
+		return &rowNumber{}, nil
+	case "rank":
+		return &rank{}, nil
+	case "dense_rank":
+		return &denseRank{}, nil
+	case "percent_rank":
+		return &percentRank{}, nil
+	case "cume_dist":
+		return &cumeDist{}, nil
+	case "ntile":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s: wrong number of arguments", name)
+		}
+		return &ntile{sctx, args[0]}, nil
+	case "lag", "lead":
+		if len(args) < 1 || len(args) > 3 {
+			return nil, fmt.Errorf("%s: wrong number of arguments", name)
+		}
+		return newShift(sctx, name, args), nil
+	case "first_value", "last_value":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s: wrong number of arguments", name)
+		}
+		return &nthValue{sctx: sctx, expr: args[0], last: name == "last_value", frame: frame}, nil
+	case "nth_value":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("%s: wrong number of arguments", name)
+		}
+		return &nthValue{sctx: sctx, expr: args[0], nth: args[1], frame: frame}, nil
+	}
+	var arg expr.Evaluator
+	switch len(args) {
+	case 0:
+	case 1:
+		arg = args[0]
+	default:
+		return nil, fmt.Errorf("%s: wrong number of arguments", name)
+	}
+	pattern, err := agg.NewPattern(sctx, name, false, arg != nil)
+	if err != nil {
+		return nil, err
+	}
+	aggregator, err := expr.NewAggregator(name, false, arg, filter, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &aggregate{sctx, aggregator, frame}, nil
+}
+
+type rowNumber struct{}
+
+func (*rowNumber) Eval(p *partition) vector.Any {
+	vals := make([]int64, p.n)
+	for i := range vals {
+		vals[i] = int64(i + 1)
+	}
+	return vector.NewInt(super.TypeInt64, vals)
+}
+
+type rank struct{}
+
+func (*rank) Eval(p *partition) vector.Any {
+	vals := make([]int64, p.n)
+	for i := range vals {
+		vals[i] = int64(p.peerStart[i] + 1)
+	}
+	return vector.NewInt(super.TypeInt64, vals)
+}
+
+type denseRank struct{}
+
+func (*denseRank) Eval(p *partition) vector.Any {
+	vals := make([]int64, p.n)
+	var r int64
+	for i := range vals {
+		if p.peerStart[i] == i {
+			r++
+		}
+		vals[i] = r
+	}
+	return vector.NewInt(super.TypeInt64, vals)
+}
+
+type percentRank struct{}
+
+func (*percentRank) Eval(p *partition) vector.Any {
+	vals := make([]float64, p.n)
+	if p.n > 1 {
+		for i := range vals {
+			vals[i] = float64(p.peerStart[i]) / float64(p.n-1)
+		}
+	}
+	return vector.NewFloat(super.TypeFloat64, vals)
+}
+
+type cumeDist struct{}
+
+func (*cumeDist) Eval(p *partition) vector.Any {
+	vals := make([]float64, p.n)
+	for i := range vals {
+		vals[i] = float64(p.peerEnd[i]) / float64(p.n)
+	}
+	return vector.NewFloat(super.TypeFloat64, vals)
+}
+
+// ntile divides the partition into buckets numbered from 1 where the sizes
+// of the buckets differ by at most one and the larger buckets come first.
+type ntile struct {
+	sctx    *super.Context
+	buckets expr.Evaluator
+}
+
+func (t *ntile) Eval(p *partition) vector.Any {
+	// The number of buckets is taken from the first row.
+	var b scode.Builder
+	val := vector.ValueAt(&b, t.buckets.Eval(vector.Pick(p.vec, []uint32{0})), 0)
+	if val.IsNull() {
+		return vector.NewNull(uint32(p.n))
+	}
+	buckets, ok := coerce.ToInt(val, super.TypeInt64)
+	if !ok || buckets <= 0 || !super.IsInteger(val.Under().Type().ID()) {
+		err := t.sctx.WrapError("ntile: argument must be a positive integer", val)
+		return vector.NewConstFromValue(t.sctx, err, uint32(p.n))
+	}
+	n := int64(p.n)
+	size, rem := n/buckets, n%buckets
+	vals := make([]int64, n)
+	for i := range n {
+		if i < rem*(size+1) {
+			vals[i] = i/(size+1) + 1
+		} else {
+			vals[i] = (i-rem)/size + 1
+		}
+	}
+	return vector.NewInt(super.TypeInt64, vals)
+}
+
+// shift implements lag and lead.
+type shift struct {
+	sctx   *super.Context
+	name   string
+	dir    int64
+	expr   expr.Evaluator
+	offset expr.Evaluator
+	dflt   expr.Evaluator
+}
+
+func newShift(sctx *super.Context, name string, args []expr.Evaluator) *shift {
+	s := &shift{sctx: sctx, name: name, dir: 1, expr: args[0]}
+	if name == "lag" {
+		s.dir = -1
+	}
+	if len(args) > 1 {
+		s.offset = args[1]
+	}
+	if len(args) > 2 {
+		s.dflt = args[2]
+	}
+	return s
+}
+
+func (s *shift) Eval(p *partition) vector.Any {
+	const (
+		fromExpr = iota
+		fromDefault
+		fromNull
+		fromError
+	)
+	n := int64(p.n)
+	var dflts, errs vector.Any
+	if s.dflt != nil {
+		dflts = s.dflt.Eval(p.vec)
+	}
+	var offsets *argValues
+	if s.offset != nil {
+		vec := s.offset.Eval(p.vec)
+		offsets = newArgValues(vec)
+		errs = vector.NewWrappedError(s.sctx, s.name+": offset must be a non-negative integer", vec)
+	}
+	pk := newPicker(s.expr.Eval(p.vec), dflts, vector.NewNull(uint32(n)), errs)
+	for i := range n {
+		offset := int64(1)
+		if offsets != nil {
+			val := offsets.at(uint32(i))
+			if val.IsNull() {
+				pk.add(fromNull, uint32(i))
+				continue
+			}
+			var ok bool
+			offset, ok = coerce.ToInt(val, super.TypeInt64)
+			if !ok || offset < 0 || !super.IsInteger(val.Under().Type().ID()) {
+				pk.add(fromError, uint32(i))
+				continue
+			}
+		}
+		offset = min(offset, n)
+		if j := i + s.dir*offset; j >= 0 && j < n {
+			pk.add(fromExpr, uint32(j))
+		} else if dflts != nil {
+			pk.add(fromDefault, uint32(i))
+		} else {
+			pk.add(fromNull, uint32(i))
+		}
+	}
+	return pk.build()
+}
+
+// nthValue implements first_value, last_value, and nth_value.
+type nthValue struct {
+	sctx  *super.Context
+	expr  expr.Evaluator
+	nth   expr.Evaluator
+	last  bool
+	frame *Frame
+}
+
+func (n *nthValue) Eval(p *partition) vector.Any {
+	const (
+		fromExpr = iota
+		fromNull
+		fromError
+	)
+	var nths *argValues
+	var errs vector.Any
+	if n.nth != nil {
+		vec := n.nth.Eval(p.vec)
+		nths = newArgValues(vec)
+		errs = vector.NewWrappedError(n.sctx, "nth_value: argument must be a positive integer", vec)
+	}
+	pk := newPicker(n.expr.Eval(p.vec), vector.NewNull(uint32(p.n)), errs)
+	for i := range p.n {
+		lo, hi := p.bounds(n.frame, i)
+		j := lo
+		switch {
+		case n.last:
+			j = hi - 1
+		case nths != nil:
+			val := nths.at(uint32(i))
+			if val.IsNull() {
+				pk.add(fromNull, uint32(i))
+				continue
+			}
+			k, ok := coerce.ToInt(val, super.TypeInt64)
+			if !ok || k <= 0 || !super.IsInteger(val.Under().Type().ID()) {
+				pk.add(fromError, uint32(i))
+				continue
+			}
+			j = lo + int(min(k, int64(hi-lo)+1)) - 1
+		}
+		if j < lo || j >= hi {
+			pk.add(fromNull, uint32(i))
+			continue
+		}
+		pk.add(fromExpr, uint32(j))
+	}
+	return pk.build()
+}
+
+// argValues returns the values of an argument vector, which is usually a
+// constant that need only be read once.
+type argValues struct {
+	vec   vector.Any
+	b     scode.Builder
+	val   super.Value
+	konst bool
+}
+
+func newArgValues(vec vector.Any) *argValues {
+	a := &argValues{vec: vec}
+	if _, ok := vec.(*vector.Const); ok && vec.Len() > 0 {
+		a.val = vector.ValueAt(&a.b, vec, 0)
+		a.konst = true
+	}
+	return a
+}
+
+func (a *argValues) at(slot uint32) super.Value {
+	if a.konst {
+		return a.val
+	}
+	a.b.Truncate()
+	return vector.ValueAt(&a.b, a.vec, slot)
+}
+
+// aggregate computes an aggregate function over the frame of each row.
+// When the frame starts at the beginning of the partition, the frame
+// never shrinks so the aggregation is computed incrementally.  Otherwise,
+// it is recomputed for each distinct frame.
+type aggregate struct {
+	sctx  *super.Context
+	agg   *expr.Aggregator
+	frame *Frame
+}
+
+func (a *aggregate) Eval(p *partition) vector.Any {
+	frame := a.frame
+	if frame == nil {
+		frame = defaultFrame
+	}
+	vals := a.agg.Eval(p.vec)
+	pk := newPicker()
+	if frame.Start.Type == "unbounded_preceding" {
+		f := a.agg.Pattern()
+		k, end := -1, 0
+		for i := range p.n {
+			_, hi := p.bounds(frame, i)
+			if k < 0 || hi > end {
+				consume(f, pickRange(vals, uint32(end), uint32(max(end, hi))))
+				end = max(end, hi)
+				k = pk.push(f.Result(a.sctx))
+			}
+			pk.add(k, 0)
+		}
+		return pk.build()
+	}
+	k, prevLo, prevHi := -1, 0, 0
+	for i := range p.n {
+		lo, hi := p.bounds(frame, i)
+		if k < 0 || lo != prevLo || hi != prevHi {
+			f := a.agg.Pattern()
+			consume(f, pickRange(vals, uint32(lo), uint32(hi)))
+			k, prevLo, prevHi = pk.push(f.Result(a.sctx)), lo, hi
+		}
+		pk.add(k, 0)
+	}
+	return pk.build()
+}
+
+func consume(f expr.AggFunc, vec vector.Any) {
+	if vec.Len() == 0 {
+		return
+	}
+	vector.Apply(vector.ApplyRipUnions|vector.ApplyRipFusions, func(vecs ...vector.Any) vector.Any {
+		f.Consume(vecs[0])
+		return vector.NewNull(vecs[0].Len())
+	}, vec)
+}
@@ -0,0 +1,106 @@
+package window
+
+import (
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vbuild"
+)
+
+// picker builds a vector from values picked from the slots of other vectors.
+type picker struct {
+	vecs    []vector.Any
+	indexes [][]uint32
+	tags    []uint32
+}
+
+func newPicker(vecs ...vector.Any) *picker {
+	return &picker{vecs: vecs, indexes: make([][]uint32, len(vecs))}
+}
+
+// push adds vec to the vectors of p and returns its index.
+func (p *picker) push(vec vector.Any) int {
+	p.vecs = append(p.vecs, vec)
+	p.indexes = append(p.indexes, nil)
+	return len(p.vecs) - 1
+}
+
+// add appends the value at slot of the kth vector of p.
+func (p *picker) add(k int, slot uint32) {
+	p.tags = append(p.tags, uint32(k))
+	p.indexes[k] = append(p.indexes[k], slot)
+}
+
+func (p *picker) build() vector.Any {
+	vecs := make([]vector.Any, len(p.vecs))
+	for k, index := range p.indexes {
+		if len(index) > 0 {
+			vecs[k] = vector.Pick(p.vecs[k], index)
+		}
+	}
+	return combine(p.tags, vecs)
+}
+
+// pickRange returns the values of vec in the half-open interval [lo,hi).
+func pickRange(vec vector.Any, lo, hi uint32) vector.Any {
+	if lo == 0 && hi == vec.Len() {
+		return vec
+	}
+	index := make([]uint32, 0, hi-lo)
+	for slot := lo; slot < hi; slot++ {
+		index = append(index, slot)
+	}
+	return vector.Pick(vec, index)
+}
+
+// concat returns the values of vecs in order as a single vector.
+func concat(vecs []vector.Any) vector.Any {
+	if len(vecs) == 1 {
+		return vecs[0]
+	}
+	var tags []uint32
+	for k, vec := range vecs {
+		for range vec.Len() {
+			tags = append(tags, uint32(k))
+		}
+	}
+	return combine(tags, vecs)
+}
+
+// combine returns a vector whose ith value is the next unused value of
+// vecs[tags[i]].  A vector of vecs that is not referenced by tags may be nil.
+func combine(tags []uint32, vecs []vector.Any) vector.Any {
+	var values []vector.Any
+	bases := make([]uint32, len(vecs))
+	var last vector.Any
+	var n int
+	for k, vec := range vecs {
+		bases[k] = uint32(len(values))
+		if vec == nil {
+			continue
+		}
+		last = vec
+		n++
+		// Each values vector of a Dynamic must not itself be a Dynamic.
+		if d, ok := vec.(*vector.Dynamic); ok {
+			values = append(values, d.Values...)
+		} else {
+			values = append(values, vec)
+		}
+	}
+	switch n {
+	case 0:
+		return vector.NewNull(0)
+	case 1:
+		return last
+	}
+	next := make([]uint32, len(vecs))
+	out := make([]uint32, len(tags))
+	for i, k := range tags {
+		out[i] = bases[k]
+		if d, ok := vecs[k].(*vector.Dynamic); ok {
+			out[i] += d.Tags[next[k]]
+		}
+		next[k]++
+	}
+	// Each values vector of a Dynamic must have a distinct type.
+	return vbuild.MergeSameTypesInDynamic(vector.NewDynamic(out, values))
+}
@@ -0,0 +1,215 @@
+// Package window implements the window operator, which computes SQL window
+// functions over partitions of its input.
+//
+// The input is sorted by the partition keys followed by the ordering keys
+// using the vector sort operator, which spills to disk when its input
+// exceeds sort.MemMaxBytes.  The sorted vectors are then split into
+// partitions by comparing the partition keys of adjacent values, and each
+// partition is gathered into a vector over which the window functions are
+// computed.  Only the current partition is held in memory.
+package window
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/op/sort"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/vio"
+)
+
+type Op struct {
+	rctx      *runtime.Context
+	parent    vio.Puller
+	partition *sort.Comparator
+	peers     *sort.Comparator
+	rangeKey  *expr.SortExpr
+	funcs     []Function
+	record    expr.Evaluator
+	results   []vector.Any
+
+	// pieces holds the vectors of the current partition.  The partition
+	// keys of its last value are at lastSlot of lastKeys.
+	pieces   []vector.Any
+	lastKeys sort.Keys
+	lastSlot uint32
+	eos      bool
+}
+
+// New returns a window operator that computes funcs over the partitions
+// of parent given by partitionBy and ordered by orderBy.  The result of
+// funcs[k] is appended to each input record as the field names[k].
+func New(rctx *runtime.Context, parent vio.Puller, partitionBy []expr.Evaluator, orderBy []expr.SortExpr, names []string, funcs []Function) *Op {
+	var partitionKeys []expr.SortExpr
+	for _, e := range partitionBy {
+		partitionKeys = append(partitionKeys, expr.NewSortExpr(e, order.Asc, order.NullsLast))
+	}
+	o := &Op{
+		rctx:    rctx,
+		parent:  parent,
+		funcs:   funcs,
+		results: make([]vector.Any, len(funcs)),
+	}
+	if keys := append(slices.Clone(partitionKeys), orderBy...); len(keys) > 0 {
+		o.parent = sort.New(rctx, parent, keys, false)
+	}
+	// Without any keys, the input is a single partition in its original
+	// order.
+	if len(partitionKeys) > 0 {
+		o.partition = sort.NewComparator(partitionKeys)
+	}
+	if len(orderBy) > 0 {
+		o.peers = sort.NewComparator(orderBy)
+		o.rangeKey = &orderBy[0]
+	}
+	elems := []expr.RecordElem{&expr.SpreadElem{Expr: &expr.This{}}}
+	for k, name := range names {
+		elems = append(elems, &expr.FieldElem{Name: name, Expr: &result{o, k}})
+	}
+	o.record = expr.NewRecordExpr(rctx.Sctx, elems)
+	return o
+}
+
+func (o *Op) Pull(done bool) (vector.Any, error) {
+	if o.eos {
+		// The parent has already reached end of stream.
+		o.eos = false
+		return nil, nil
+	}
+	if done {
+		o.reset()
+		_, err := o.parent.Pull(true)
+		return nil, err
+	}
+	for {
+		vec, err := o.parent.Pull(false)
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		if vec == nil {
+			out := o.flush()
+			o.reset()
+			if out == nil {
+				return nil, nil
+			}
+			o.eos = true
+			return out, nil
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		if out := o.split(vec); out != nil {
+			return out, nil
+		}
+	}
+}
+
+func (o *Op) reset() {
+	o.pieces = nil
+	o.lastKeys = nil
+	clear(o.results)
+}
+
+// split adds the values of vec to the current partition and returns the
+// output for the partitions that vec completes or nil if there are none.
+func (o *Op) split(vec vector.Any) vector.Any {
+	if o.partition == nil {
+		o.pieces = append(o.pieces, vec)
+		return nil
+	}
+	keys := o.partition.Keys(vec)
+	var outs []vector.Any
+	var start uint32
+	for i := range vec.Len() {
+		var c int
+		if i > 0 {
+			c = o.partition.Compare(keys, i-1, keys, i)
+		} else if len(o.pieces) > 0 {
+			c = o.partition.Compare(o.lastKeys, o.lastSlot, keys, 0)
+		}
+		if c != 0 {
+			o.add(vec, start, i)
+			outs = append(outs, o.flush())
+			start = i
+		}
+	}
+	o.add(vec, start, vec.Len())
+	o.lastKeys, o.lastSlot = keys, vec.Len()-1
+	if len(outs) == 0 {
+		return nil
+	}
+	return concat(outs)
+}
+
+// add adds the values of vec in the half-open interval [lo,hi) to the
+// current partition.
+func (o *Op) add(vec vector.Any, lo, hi uint32) {
+	if lo < hi {
+		o.pieces = append(o.pieces, pickRange(vec, lo, hi))
+	}
+}
+
+// flush computes the window functions over the current partition and
+// returns its output or nil if the partition is empty.
+func (o *Op) flush() vector.Any {
+	if len(o.pieces) == 0 {
+		return nil
+	}
+	vec := concat(o.pieces)
+	o.pieces = nil
+	p := newPartition(o.rctx.Sctx, vec, o.peers, o.rangeKey)
+	for k, f := range o.funcs {
+		o.results[k] = f.Eval(p)
+	}
+	return o.wrap(vec, o.record.Eval(vec))
+}
+
+// wrap returns out with the values of vec that are not records replaced by
+// errors.  Errors in vec are passed through.
+func (o *Op) wrap(vec, out vector.Any) vector.Any {
+	const (
+		isRecord = iota
+		isError
+		isOther
+	)
+	kindOf := func(typ super.Type) int {
+		switch typ.Kind() {
+		case super.RecordKind:
+			return isRecord
+		case super.ErrorKind:
+			return isError
+		}
+		return isOther
+	}
+	var kinds []int
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for i := range d.Len() {
+			kinds = append(kinds, kindOf(d.TypeOf(i)))
+		}
+	} else {
+		kinds = slices.Repeat([]int{kindOf(vec.Type())}, int(vec.Len()))
+	}
+	if !slices.ContainsFunc(kinds, func(k int) bool { return k != isRecord }) {
+		return out
+	}
+	p := newPicker(out, vec, vector.NewWrappedError(o.rctx.Sctx, "window: not a record", vec))
+	for i, k := range kinds {
+		p.add(k, uint32(i))
+	}
+	return p.build()
+}
+
+// result evaluates to the values computed by a window function for the
+// partition currently being written.
+type result struct {
+	o *Op
+	k int
+}
+
+func (r *result) Eval(vector.Any) vector.Any {
+	return r.o.results[r.k]
+}
@@ -0,0 +1,46 @@
+package window_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super/runtime/vam/op/sort"
+	"github.com/brimdata/super/ztest"
+)
+
+func TestWindowExternal(t *testing.T) {
+	saved := sort.MemMaxBytes
+	sort.MemMaxBytes = 1024
+	defer func() {
+		sort.MemMaxBytes = saved
+	}()
+
+	// Create enough records to exceed 2 * sort.MemMaxBytes and give
+	// each partition a running count and sum over its values.
+	var input, output strings.Builder
+	const nparts = 3
+	var n int
+	var vals [nparts][]int
+	for i := 0; n <= 2*sort.MemMaxBytes; i++ {
+		k := rand.Intn(nparts)
+		vals[k] = append(vals[k], i)
+		s := fmt.Sprintf("{k:%d,v:%d}\n", k, i)
+		n += len(s)
+		input.WriteString(s)
+	}
+	for k, vs := range vals {
+		var sum int
+		for i, v := range vs {
+			sum += v
+			fmt.Fprintf(&output, "{k:%d,v:%d,rn:%d,s:%d}\n", k, v, i+1, sum)
+		}
+	}
+	in := input.String()
+	(&ztest.ZTest{
+		SPQ:    "select k, v, row_number() over w as rn, sum(v) over w as s window w as (partition by k order by v) order by k, v",
+		Input:  &in,
+		Output: output.String(),
+	}).Run(t, "", "")
+}
@@ -0,0 +1,43 @@
+package vcache
+
+import (
+	"sync"
+
+	"github.com/brimdata/super/csup"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/vector"
+)
+
+type bigInt struct {
+	mu    sync.Mutex
+	meta  *csup.BigInt
+	table *vector.BytesTable
+}
+
+func newBigInt(meta *csup.BigInt) *bigInt {
+	return &bigInt{meta: meta}
+}
+
+func (b *bigInt) length() uint32 {
+	return b.meta.Count
+}
+
+func (*bigInt) unmarshal(*csup.Context, field.Projection) {}
+
+func (b *bigInt) project(loader *loader, projection field.Projection) vector.Any {
+	if len(projection) > 0 {
+		return vector.NewMissing(loader.sctx, b.length())
+	}
+	return vector.NewBigInt(b.meta.Typ, b.load(loader))
+}
+
+func (b *bigInt) load(loader *loader) vector.BytesTable {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.table != nil {
+		return *b.table
+	}
+	table := loadBytesTable(loader, b.meta.Offsets, b.meta.Bytes)
+	b.table = &table
+	return table
+}
@@ -0,0 +1,44 @@
+package vcache
+
+import (
+	"sync"
+
+	"github.com/brimdata/super/csup"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/vector"
+)
+
+type decimal struct {
+	mu    sync.Mutex
+	meta  *csup.Decimal
+	table *vector.BytesTable
+}
+
+func newDecimal(meta *csup.Decimal) *decimal {
+	return &decimal{meta: meta}
+}
+
+func (d *decimal) length() uint32 {
+	return d.meta.Count
+}
+
+func (*decimal) unmarshal(*csup.Context, field.Projection) {}
+
+func (d *decimal) project(loader *loader, projection field.Projection) vector.Any {
+	if len(projection) > 0 {
+		return vector.NewMissing(loader.sctx, d.length())
+	}
+	typ := loader.sctx.MustLookupTypeDecimal(d.meta.Precision, d.meta.Scale)
+	return vector.NewDecimal(typ, d.load(loader))
+}
+
+func (d *decimal) load(loader *loader) vector.BytesTable {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.table != nil {
+		return *d.table
+	}
+	table := loadBytesTable(loader, d.meta.Offsets, d.meta.Bytes)
+	d.table = &table
+	return table
+}
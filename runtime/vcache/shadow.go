@@ -79,6 +79,10 @@ func newShadow(cctx *csup.Context, id csup.ID) shadow {
 		return newBool(meta)
 	case *csup.Bytes:
 		return newBytes(cctx, meta)
+	case *csup.Decimal:
+		return newDecimal(meta)
+	case *csup.BigInt:
+		return newBigInt(meta)
 	case *csup.IP:
 		return newIP(meta)
 	case *csup.Net:
@@ -15,6 +15,12 @@ import (
 type AuthConfig struct {
 	Enabled  bool
 	JWKSPath string
+	// PolicyPath is the path of a YAML file containing an auth.Policy.
+	// If it is empty, every authenticated request is permitted.
+	PolicyPath string
+	// AuditLogPath is the path of the log of operations denied by the
+	// policy.  If it is empty, denials are written to the service log.
+	AuditLogPath string
 
 	// Audience, ClientID, and Domain are sent in the /auth/method response so API
 	// clients can interact with the right Auth0 tenant (production, testing, etc.)
@@ -30,6 +36,8 @@ func (c *AuthConfig) SetFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.ClientID, "auth.clientid", "", "Auth0 client ID for API clients (will be publicly accessible)")
 	fs.StringVar(&c.Domain, "auth.domain", "", "Auth0 domain (as a URL) for API clients (will be publicly accessible)")
 	fs.StringVar(&c.JWKSPath, "auth.jwkspath", "", "path to JSON Web Key Set file")
+	fs.StringVar(&c.PolicyPath, "auth.policy", "", "path to YAML authorization policy file")
+	fs.StringVar(&c.AuditLogPath, "auth.auditlog", "", "path of log of operations denied by authorization policy")
 }
 
 type Auth0Authenticator struct {
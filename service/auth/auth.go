@@ -15,6 +15,7 @@ const (
 type Identity struct {
 	TenantID TenantID
 	UserID   UserID
+	Groups   []string
 }
 
 type identityKey struct{}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/sup"
+	"github.com/goccy/go-yaml"
+)
+
+// Role is a level of access to a pool or branch.  Each role includes the
+// permissions of the roles below it.
+type Role int
+
+const (
+	RoleNone Role = iota
+	// RoleRead permits queries and reading of pool and branch metadata.
+	RoleRead
+	// RoleLoad permits loading data, creating branches, merging, and
+	// compaction.
+	RoleLoad
+	// RoleDelete permits deleting, updating, and reverting data.
+	RoleDelete
+	// RoleAdmin permits creating, renaming, deleting, and vacuuming pools,
+	// deleting branches, and changing pool settings.
+	RoleAdmin
+)
+
+// ErrPermissionDenied is wrapped by the errors returned by Policy.Authorize.
+var ErrPermissionDenied = errors.New("permission denied")
+
+var roleNames = []string{"none", "read", "load", "delete", "admin"}
+
+func (r Role) String() string {
+	if r < 0 || int(r) >= len(roleNames) {
+		return fmt.Sprintf("Role(%d)", int(r))
+	}
+	return roleNames[r]
+}
+
+func (r *Role) UnmarshalText(text []byte) error {
+	i := slices.Index(roleNames, string(text))
+	if i < 0 {
+		return fmt.Errorf("unknown role %q (must be none, read, load, delete, or admin)", text)
+	}
+	*r = Role(i)
+	return nil
+}
+
+// Policy maps identities to roles on pools and branches.  An identity's
+// role for a pool or branch is the highest role granted by Default and by
+// the rules that match the identity, pool, and branch.  Restrictions
+// further limit the values of a pool that an identity may read.
+type Policy struct {
+	// Default is the role granted to every identity on every pool.
+	Default      Role          `yaml:"default"`
+	Rules        []Rule        `yaml:"rules"`
+	Restrictions []Restriction `yaml:"restrictions"`
+}
+
+// Rule grants Role to the identities it names on the pools and branches
+// it names.  A rule naming no users, tenants, or groups applies to every
+// identity.  Pools and Branches are patterns in the syntax of path.Match.
+// A rule with no pools applies to every pool.  A rule with no branches
+// applies to every branch and to the pool as a whole, while a rule with
+// branches applies only to operations on matching branches.
+type Rule struct {
+	Users    []UserID   `yaml:"users"`
+	Tenants  []TenantID `yaml:"tenants"`
+	Groups   []string   `yaml:"groups"`
+	Pools    []string   `yaml:"pools"`
+	Branches []string   `yaml:"branches"`
+	Role     Role       `yaml:"role"`
+}
+
+// Restriction limits the values of the pools it names that are visible to
+// the identities it names.  A restriction naming no users, tenants, or groups
+// applies to every identity.  A restriction never applies to members of
+// ExemptGroups.  Pools are patterns in the syntax of path.Match, and a
+// restriction with no pools applies to every pool.
+//
+// Where is a Boolean expression that a value must satisfy to be visible.
+// It may refer to the identity through the query parameters $user_id,
+// $tenant_id, and $groups.  Drop lists the fields removed from every
+// visible value.
+type Restriction struct {
+	Users        []UserID   `yaml:"users"`
+	Tenants      []TenantID `yaml:"tenants"`
+	Groups       []string   `yaml:"groups"`
+	ExemptGroups []string   `yaml:"exempt_groups"`
+	Pools        []string   `yaml:"pools"`
+	Where        string     `yaml:"where"`
+	Drop         []string   `yaml:"drop"`
+}
+
+// LoadPolicy reads a Policy from the YAML file at path.
+func LoadPolicy(path string) (*Policy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	if err := yaml.UnmarshalWithOptions(b, &p, yaml.DisallowUnknownField()); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &p, nil
+}
+
+func (p *Policy) validate() error {
+	for i, rule := range p.Rules {
+		if rule.Role == RoleNone {
+			return fmt.Errorf("rule %d: role must be read, load, delete, or admin", i+1)
+		}
+		for _, pattern := range slices.Concat(rule.Pools, rule.Branches) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("rule %d: bad pattern %q: %w", i+1, pattern, err)
+			}
+		}
+	}
+	for i, r := range p.Restrictions {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("restriction %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (r *Restriction) validate() error {
+	if r.Where == "" && len(r.Drop) == 0 {
+		return errors.New("where or drop is required")
+	}
+	for _, pattern := range r.Pools {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("bad pattern %q: %w", pattern, err)
+		}
+	}
+	// Each expression must parse as exactly one operator so that it cannot
+	// alter the query assembled by Policy.Restrict.
+	if r.Where != "" {
+		if _, ok := parseOp("where " + r.Where).(*ast.WhereOp); !ok {
+			return fmt.Errorf("bad where expression %q", r.Where)
+		}
+	}
+	for _, field := range r.Drop {
+		if drop, ok := parseOp("drop " + field).(*ast.DropOp); !ok || len(drop.Args) != 1 {
+			return fmt.Errorf("bad drop field %q", field)
+		}
+	}
+	return nil
+}
+
+// parseOp returns the operator in text or nil if text is not a single
+// operator.
+func parseOp(text string) ast.Op {
+	p, err := parser.ParseText(text)
+	if err != nil || len(p.Parsed()) != 1 {
+		return nil
+	}
+	return p.Parsed()[0]
+}
+
+// Role returns the role of ident on the named pool and branch.  An empty
+// branch denotes the pool as a whole.
+func (p *Policy) Role(ident Identity, pool, branch string) Role {
+	role := p.Default
+	for _, rule := range p.Rules {
+		if rule.Role > role && rule.matches(ident, pool, branch) {
+			role = rule.Role
+		}
+	}
+	return role
+}
+
+// Authorize returns an error if ident does not have role on the named pool
+// and branch.
+func (p *Policy) Authorize(ident Identity, pool, branch string, role Role) error {
+	if p.Role(ident, pool, branch) >= role {
+		return nil
+	}
+	target := fmt.Sprintf("pool %q", pool)
+	if branch != "" {
+		target = fmt.Sprintf("branch %q of pool %q", branch, pool)
+	}
+	return fmt.Errorf("%w: %s requires %s role", ErrPermissionDenied, target, role)
+}
+
+// Restrict returns the text of a query that applies the restrictions on the
+// named pool for ident and the SUP values of the query parameters it refers
+// to.  Restrict returns an empty string if no restriction applies.
+func (p *Policy) Restrict(ident Identity, pool string) (string, map[string]string) {
+	var ops, drops []string
+	for _, r := range p.Restrictions {
+		if !r.matches(ident, pool) {
+			continue
+		}
+		if r.Where != "" {
+			ops = append(ops, "where "+r.Where)
+		}
+		for _, field := range r.Drop {
+			if !slices.Contains(drops, field) {
+				drops = append(drops, field)
+			}
+		}
+	}
+	if len(drops) > 0 {
+		ops = append(ops, "drop "+strings.Join(drops, ", "))
+	}
+	if len(ops) == 0 {
+		return "", nil
+	}
+	groups := make([]string, 0, len(ident.Groups))
+	for _, g := range ident.Groups {
+		groups = append(groups, sup.QuotedString(g))
+	}
+	params := map[string]string{
+		"groups":    "[" + strings.Join(groups, ",") + "]::[string]",
+		"tenant_id": sup.QuotedString(string(ident.TenantID)),
+		"user_id":   sup.QuotedString(string(ident.UserID)),
+	}
+	// Operators are separated by newlines so a comment ending one
+	// expression cannot hide the operators that follow it.
+	return strings.Join(ops, "\n| "), params
+}
+
+func (r *Restriction) matches(ident Identity, pool string) bool {
+	if slices.ContainsFunc(r.ExemptGroups, func(g string) bool { return slices.Contains(ident.Groups, g) }) {
+		return false
+	}
+	return matchIdentity(ident, r.Users, r.Tenants, r.Groups) && (len(r.Pools) == 0 || matchAny(r.Pools, pool))
+}
+
+func (r *Rule) matches(ident Identity, pool, branch string) bool {
+	if !matchIdentity(ident, r.Users, r.Tenants, r.Groups) {
+		return false
+	}
+	if len(r.Pools) > 0 && !matchAny(r.Pools, pool) {
+		return false
+	}
+	if len(r.Branches) > 0 {
+		return branch != "" && matchAny(r.Branches, branch)
+	}
+	return true
+}
+
+// matchIdentity returns true if ident is named by users, tenants, or groups
+// or if all three are empty.
+func matchIdentity(ident Identity, users []UserID, tenants []TenantID, groups []string) bool {
+	if len(users) == 0 && len(tenants) == 0 && len(groups) == 0 {
+		return true
+	}
+	return slices.Contains(users, ident.UserID) ||
+		slices.Contains(tenants, ident.TenantID) ||
+		slices.ContainsFunc(groups, func(g string) bool { return slices.Contains(ident.Groups, g) })
+}
+
+func matchAny(patterns []string, name string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, name)
+		return ok
+	})
+}
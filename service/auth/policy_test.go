@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRole(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+default: read
+rules:
+  - users: [alice]
+    role: admin
+  - groups: [etl]
+    pools: ["logs-*"]
+    role: load
+  - tenants: [acme]
+    pools: [sales]
+    branches: ["dev-*"]
+    role: delete
+`), 0644))
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+	alice := Identity{UserID: "alice"}
+	etl := Identity{UserID: "bob", Groups: []string{"etl"}}
+	acme := Identity{TenantID: "acme", UserID: "carol"}
+	assert.Equal(t, RoleAdmin, p.Role(alice, "sales", ""))
+	assert.Equal(t, RoleLoad, p.Role(etl, "logs-web", "main"))
+	assert.Equal(t, RoleRead, p.Role(etl, "sales", "main"))
+	assert.Equal(t, RoleDelete, p.Role(acme, "sales", "dev-x"))
+	assert.Equal(t, RoleRead, p.Role(acme, "sales", "main"))
+	// A rule restricted to branches does not apply to the pool as a whole.
+	assert.Equal(t, RoleRead, p.Role(acme, "sales", ""))
+	assert.NoError(t, p.Authorize(etl, "logs-web", "main", RoleLoad))
+	err = p.Authorize(etl, "logs-web", "main", RoleDelete)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	assert.EqualError(t, err, `permission denied: branch "main" of pool "logs-web" requires delete role`)
+}
+
+func TestPolicyErrors(t *testing.T) {
+	dir := t.TempDir()
+	for _, c := range []struct {
+		yaml string
+		err  string
+	}{
+		{"rules:\n  - role: owner\n", `unknown role "owner"`},
+		{"rules:\n  - pools: [a]\n", "rule 1: role must be read, load, delete, or admin"},
+		{"rules:\n  - pools: [\"[\"]\n    role: read\n", `rule 1: bad pattern "["`},
+		{"rule: []\n", "unknown field"},
+		{"restrictions:\n  - pools: [a]\n", "restriction 1: where or drop is required"},
+		{"restrictions:\n  - where: \"x | values 1\"\n", `restriction 1: bad where expression "x | values 1"`},
+		{"restrictions:\n  - drop: [\"a, b\"]\n", `restriction 1: bad drop field "a, b"`},
+	} {
+		path := filepath.Join(dir, "policy.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(c.yaml), 0644))
+		_, err := LoadPolicy(path)
+		assert.ErrorContains(t, err, c.err)
+	}
+}
+
+func TestPolicyRestrict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+restrictions:
+  - pools: ["sales-*"]
+    exempt_groups: [auditors]
+    where: tenant == $tenant_id -- rows of the caller's tenant
+    drop: [ssn]
+  - groups: [contractors]
+    drop: [ssn, salary]
+`), 0644))
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+	ident := Identity{TenantID: "acme", UserID: "alice", Groups: []string{"contractors"}}
+	text, params := p.Restrict(ident, "sales-east")
+	assert.Equal(t, "where tenant == $tenant_id -- rows of the caller's tenant\n| drop ssn, salary", text)
+	assert.Equal(t, map[string]string{
+		"groups":    `["contractors"]::[string]`,
+		"tenant_id": `"acme"`,
+		"user_id":   `"alice"`,
+	}, params)
+	text, _ = p.Restrict(ident, "hr")
+	assert.Equal(t, "drop ssn, salary", text)
+	text, params = p.Restrict(Identity{Groups: []string{"auditors"}}, "sales-east")
+	assert.Equal(t, "", text)
+	assert.Nil(t, params)
+}
@@ -19,6 +19,9 @@ const (
 	// access token.
 	TenantIDClaim = "https://db.brimdata.io/tenant_id"
 	UserIDClaim   = "https://db.brimdata.io/user_id"
+	// GroupsClaim is an optional custom claim listing the groups of the
+	// user for authorization by a Policy.
+	GroupsClaim = "https://db.brimdata.io/groups"
 )
 
 type TokenValidator struct {
@@ -90,7 +93,7 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 	if !claims.VerifyIssuer(v.expectedIssuer, true) {
 		return Identity{}, srverr.ErrNoCredentials("invalid issuer")
 	}
-	ident := Identity{AnonymousTenantID, AnonymousUserID}
+	ident := Identity{TenantID: AnonymousTenantID, UserID: AnonymousUserID}
 	if v, ok := claims[TenantIDClaim]; ok {
 		s, _ := v.(string)
 		if s == "" || TenantID(s) == AnonymousTenantID {
@@ -105,6 +108,19 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 		}
 		ident.UserID = UserID(s)
 	}
+	if v, ok := claims[GroupsClaim]; ok {
+		groups, ok := v.([]any)
+		if !ok {
+			return Identity{}, srverr.ErrNoCredentials("invalid groups")
+		}
+		for _, g := range groups {
+			s, ok := g.(string)
+			if !ok || s == "" {
+				return Identity{}, srverr.ErrNoCredentials("invalid groups")
+			}
+			ident.Groups = append(ident.Groups, s)
+		}
+	}
 	return ident, nil
 }
 
@@ -34,7 +34,7 @@ func TestValidate(t *testing.T) {
 		UserID:   "test_user_id",
 	}
 	token, err := GenerateAccessToken(testKeyID, testKeyFile, 1*time.Hour,
-		testAudience, "https://testdomain", "test_tenant_id", "test_user_id")
+		testAudience, "https://testdomain", "test_tenant_id", "test_user_id", nil)
 	require.NoError(t, err)
 	validator := testValidator(t)
 
@@ -65,7 +65,7 @@ func TestValidateNoTenantIDAndUserIDClaims(t *testing.T) {
 	require.NoError(t, err)
 	ident, err := testValidator(t).Validate(token)
 	require.NoError(t, err)
-	require.Equal(t, Identity{AnonymousTenantID, AnonymousUserID}, ident)
+	require.Equal(t, Identity{TenantID: AnonymousTenantID, UserID: AnonymousUserID}, ident)
 }
 
 func TestBadClaims(t *testing.T) {
@@ -238,3 +238,29 @@ func TestAudienceSlice(t *testing.T) {
 	_, err = validator.Validate(token)
 	require.Error(t, err)
 }
+
+func TestValidateGroups(t *testing.T) {
+	validator := testValidator(t)
+	token := genToken(t, jwt.MapClaims{
+		"aud":         testAudience,
+		"exp":         time.Now().Add(1 * time.Hour).Unix(),
+		"iss":         "https://testdomain/",
+		TenantIDClaim: "test_tenant_id",
+		UserIDClaim:   "test_user_id",
+		GroupsClaim:   []string{"analysts", "ops"},
+	})
+	ident, err := validator.Validate(token)
+	require.NoError(t, err)
+	require.Equal(t, []string{"analysts", "ops"}, ident.Groups)
+
+	token = genToken(t, jwt.MapClaims{
+		"aud":         testAudience,
+		"exp":         time.Now().Add(1 * time.Hour).Unix(),
+		"iss":         "https://testdomain/",
+		TenantIDClaim: "test_tenant_id",
+		UserIDClaim:   "test_user_id",
+		GroupsClaim:   "analysts",
+	})
+	_, err = validator.Validate(token)
+	require.Error(t, err)
+}
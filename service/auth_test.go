@@ -1,8 +1,14 @@
 package service_test
 
 import (
+	"bufio"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +17,7 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/service"
 	"github.com/brimdata/super/service/auth"
+	"github.com/segmentio/ksuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,10 +31,10 @@ func testAuthConfig() service.AuthConfig {
 	}
 }
 
-func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID) string {
+func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID, groups ...string) string {
 	ac := testAuthConfig()
 	token, err := auth.GenerateAccessToken("testkey", "testdata/auth-private-key",
-		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID)
+		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID, groups)
 	require.NoError(t, err)
 	return token
 }
@@ -37,7 +44,7 @@ func TestAuthIdentity(t *testing.T) {
 	core, conn := newCoreWithConfig(t, service.Config{
 		Auth: authConfig,
 	})
-	_, err := conn.Query(t.Context(), srcfiles.Plain("from [pools]"))
+	_, err := conn.Query(t.Context(), srcfiles.Plain("from [pools]"), nil)
 	require.Error(t, err)
 	require.Equal(t, 1.0, promCounterValue(core.Registry(), "request_errors_unauthorized_total"))
 
@@ -59,7 +66,7 @@ func TestAuthIdentity(t *testing.T) {
 		UserID:   "test_user_id",
 	}, res)
 
-	_, err = conn.Query(t.Context(), srcfiles.Plain("from :pools"))
+	_, err = conn.Query(t.Context(), srcfiles.Plain("from :pools"), nil)
 	require.NoError(t, err)
 }
 
@@ -88,3 +95,319 @@ func TestAuthMethodGet(t *testing.T) {
 		}, resp)
 	})
 }
+
+func TestAuthorization(t *testing.T) {
+	dir := t.TempDir()
+	policyPath := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`
+rules:
+  - users: [admin]
+    role: admin
+  - groups: [analysts]
+    role: read
+  - users: [loader]
+    pools: [logs]
+    branches: [main]
+    role: load
+`), 0644))
+	auditPath := filepath.Join(dir, "audit.log")
+	authConfig := testAuthConfig()
+	authConfig.PolicyPath = policyPath
+	authConfig.AuditLogPath = auditPath
+	core, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+	requireForbidden := func(err error) {
+		t.Helper()
+		var resErr *client.ErrorResponse
+		require.True(t, errors.As(err, &resErr), "%v", err)
+		require.Equal(t, http.StatusForbidden, resErr.StatusCode)
+	}
+	// Connection.Query returns compilation errors without the HTTP
+	// response so check the status of a query with a raw request.
+	requireQueryForbidden := func(query string) {
+		t.Helper()
+		req := conn.NewRequest(t.Context(), http.MethodPost, "/query", api.QueryRequest{Query: query})
+		_, err := conn.Do(req)
+		requireForbidden(err)
+	}
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin"))
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "logs"})
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "loader"))
+	conn.TestLoad(poolID, "main", strings.NewReader("{x:1}\n"))
+	_, err := conn.CreatePool(t.Context(), api.PoolPostRequest{Name: "other"})
+	requireForbidden(err)
+	requireForbidden(conn.RemovePool(t.Context(), poolID))
+	// The loader's role applies only to the main branch.
+	require.Equal(t, "{x:1}\n", conn.TestQuery("from logs"))
+	requireQueryForbidden("from logs@dev")
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "analyst", "analysts"))
+	require.Equal(t, "{x:1}\n", conn.TestQuery("from logs"))
+	_, err = conn.Load(t.Context(), poolID, "main", "", strings.NewReader("{x:2}\n"), api.CommitMessage{})
+	requireForbidden(err)
+	_, err = conn.Query(t.Context(), srcfiles.Plain("values {x:2} | load logs"), nil)
+	require.ErrorContains(t, err, `permission denied: branch "main" of pool "logs" requires load role`)
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "nobody"))
+	requireQueryForbidden("from logs")
+
+	require.Equal(t, 6.0, promCounterValue(core.Registry(), "request_errors_forbidden_total"))
+	b, err := os.ReadFile(auditPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 6)
+	require.Contains(t, lines[0], `"msg":"Permission denied"`)
+	require.Contains(t, lines[0], `"user_id":"loader"`)
+	require.Contains(t, lines[0], `"operation":"POST /pool"`)
+	require.Contains(t, lines[0], `"role":"admin"`)
+	require.Contains(t, lines[4], `"groups":["analysts"]`)
+	require.Contains(t, lines[4], `"operation":"query load"`)
+}
+
+func TestMetadataAuthorization(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`
+rules:
+  - users: [admin]
+    role: admin
+  - users: [reader]
+    pools: [logs]
+    role: read
+  - users: [tester]
+    pools: [secret]
+    branches: [dev]
+    role: read
+`), 0644))
+	authConfig := testAuthConfig()
+	authConfig.PolicyPath = policyPath
+	_, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin"))
+	conn.TestPoolPost(api.PoolPostRequest{Name: "logs"})
+	secretID := conn.TestPoolPost(api.PoolPostRequest{Name: "secret"})
+	commit := conn.TestLoad(secretID, "main", strings.NewReader("{x:1}\n"))
+	conn.TestBranchPost(secretID, api.BranchPostRequest{Name: "dev", Commit: commit.String()})
+	const pools = "from :pools | sort name | values name"
+	const branches = "from :branches | sort pool.name, branch.name | values f'{pool.name}@{branch.name}'"
+	require.Equal(t, "\"logs\"\n\"secret\"\n", conn.TestQuery(pools))
+
+	// Database metadata lists only the pools and branches a user may read.
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "reader"))
+	require.Equal(t, "\"logs\"\n", conn.TestQuery(pools))
+	require.Equal(t, "\"logs@main\"\n", conn.TestQuery(branches))
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "tester"))
+	require.Equal(t, "", conn.TestQuery(pools))
+	require.Equal(t, "\"secret@dev\"\n", conn.TestQuery(branches))
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "nobody"))
+	require.Equal(t, "", conn.TestQuery(pools))
+	require.Equal(t, "", conn.TestQuery(branches))
+}
+
+func TestRestrictions(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`
+default: read
+rules:
+  - users: [admin]
+    role: admin
+  - users: [editor]
+    role: delete
+restrictions:
+  - pools: [people]
+    exempt_groups: [auditors]
+    where: tenant == $tenant_id
+    drop: [ssn]
+`), 0644))
+	authConfig := testAuthConfig()
+	authConfig.PolicyPath = policyPath
+	_, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+
+	conn.SetAuthToken(genToken(t, "acme", "admin", "auditors"))
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "people"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`
+{tenant:"acme",name:"alice",ssn:"123"}
+{tenant:"globex",name:"bob",ssn:"456"}
+`))
+	require.Equal(t, `{tenant:"acme",name:"alice",ssn:"123"}
+{tenant:"globex",name:"bob",ssn:"456"}
+`, conn.TestQuery("from people | sort name"))
+
+	conn.SetAuthToken(genToken(t, "acme", "carol"))
+	const acme = `{tenant:"acme",name:"alice"}` + "\n"
+	require.Equal(t, acme, conn.TestQuery("from people"))
+	require.Equal(t, acme, conn.TestQuery("from people | sort name"))
+	require.Equal(t, acme, conn.TestQuery(`from people | fork ( pass ) ( from people | where false )`))
+	// Neither declarations nor parameters of the query alter the restriction.
+	require.Equal(t, acme, conn.TestQuery(`const tenant = "globex" from people`))
+	require.Equal(t, acme, conn.TestQueryParams("from people", map[string]string{"tenant_id": `"globex"`}))
+	require.Equal(t, "", conn.TestQuery(`from people | where has(ssn)`))
+	require.Equal(t, `{name:"alice"}`+"\n", conn.TestQuery("select name from people"))
+	_, err := conn.Query(t.Context(), srcfiles.Plain("from people@main:objects"), nil)
+	require.ErrorContains(t, err, `cannot read metadata of restricted pool "people"`)
+
+	conn.SetAuthToken(genToken(t, "globex", "dave"))
+	require.Equal(t, `{tenant:"globex",name:"bob"}`+"\n", conn.TestQuery("from people"))
+
+	// A restricted user may not modify the pool since the restriction
+	// hides values the modification would read or replace.
+	conn.SetAuthToken(genToken(t, "acme", "editor"))
+	requireRestricted := func(err error) {
+		t.Helper()
+		var resErr *client.ErrorResponse
+		require.True(t, errors.As(err, &resErr), "%v", err)
+		require.Equal(t, http.StatusForbidden, resErr.StatusCode)
+		require.ErrorContains(t, err, `pool is restricted: cannot modify pool "people"`)
+	}
+	_, err = conn.UpdateWhere(t.Context(), poolID, "main", "name=='alice'", "ssn:='789'", api.CommitMessage{})
+	requireRestricted(err)
+	_, err = conn.MergeInto(t.Context(), poolID, "main", `
+MERGE INTO people USING (values {name:'bob',ssn:'789'}) AS s ON people.name = s.name
+WHEN MATCHED THEN UPDATE SET ssn = s.ssn
+WHEN NOT MATCHED THEN INSERT (name, ssn) VALUES (s.name, s.ssn)`, api.CommitMessage{})
+	requireRestricted(err)
+	_, err = conn.DeleteWhere(t.Context(), poolID, "main", "name=='bob'", api.CommitMessage{})
+	requireRestricted(err)
+	_, err = conn.Delete(t.Context(), poolID, "main", []ksuid.KSUID{ksuid.New()}, api.CommitMessage{})
+	requireRestricted(err)
+
+	conn.SetAuthToken(genToken(t, "acme", "admin", "auditors"))
+	require.Equal(t, `{tenant:"acme",name:"alice",ssn:"123"}
+{tenant:"globex",name:"bob",ssn:"456"}
+`, conn.TestQuery("from people | sort name"))
+}
+
+func TestQueryOwner(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`
+rules:
+  - users: [admin]
+    role: admin
+  - users: [operator]
+    pools: [logs]
+    role: admin
+`), 0644))
+	authConfig := testAuthConfig()
+	authConfig.PolicyPath = policyPath
+	_, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+	// Each query reads from a server that sends one value and then blocks,
+	// so the query runs until it is canceled.
+	block := make(chan struct{})
+	defer close(block)
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{x:1}\n"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-block:
+		case <-r.Context().Done():
+		}
+	}))
+	defer src.Close()
+	as := func(tenant auth.TenantID, user auth.UserID) {
+		conn.SetAuthToken(genToken(t, tenant, user))
+	}
+	startQuery := func() string {
+		t.Helper()
+		res, err := conn.Query(t.Context(), srcfiles.Plain(fmt.Sprintf("from '%s' (format sup)", src.URL)), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res.Header.Get("X-Request-ID")
+	}
+	// Connection has no method for query status so use a raw request.
+	queryStatus := func(id string) error {
+		t.Helper()
+		req := conn.NewRequest(t.Context(), http.MethodGet, "/query/status/"+id, nil)
+		res, err := conn.Do(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+	listQueries := func() []string {
+		t.Helper()
+		infos, err := conn.QueryList(t.Context())
+		require.NoError(t, err)
+		var ids []string
+		for _, info := range infos {
+			ids = append(ids, info.RequestID)
+		}
+		return ids
+	}
+
+	as("acme", "alice")
+	alice := startQuery()
+	as("acme", "bob")
+	bob := startQuery()
+	require.Equal(t, []string{bob}, listQueries())
+	as("acme", "alice")
+	require.Equal(t, []string{alice}, listQueries())
+	require.ErrorIs(t, conn.QueryCancel(t.Context(), bob), client.ErrQueryNotFound)
+	require.ErrorContains(t, queryStatus(bob), "query not found")
+	// A user of the same name in another tenant is someone else.
+	as("globex", "alice")
+	require.Empty(t, listQueries())
+	require.ErrorIs(t, conn.QueryCancel(t.Context(), alice), client.ErrQueryNotFound)
+	require.ErrorContains(t, queryStatus(alice), "query not found")
+	// An admin of only some pools is not an admin of the service.
+	as("acme", "operator")
+	require.Empty(t, listQueries())
+	require.ErrorIs(t, conn.QueryCancel(t.Context(), alice), client.ErrQueryNotFound)
+	require.ErrorContains(t, queryStatus(alice), "query not found")
+	as("acme", "admin")
+	require.ElementsMatch(t, []string{alice, bob}, listQueries())
+
+	as("acme", "alice")
+	require.NoError(t, conn.QueryCancel(t.Context(), alice))
+	require.NoError(t, queryStatus(alice))
+	as("acme", "admin")
+	require.NoError(t, conn.QueryCancel(t.Context(), bob))
+	require.NoError(t, queryStatus(bob))
+	require.Empty(t, listQueries())
+}
+
+func TestEventsAuthorization(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`
+rules:
+  - users: [admin]
+    role: admin
+  - users: [reader]
+    pools: [logs]
+    role: read
+`), 0644))
+	authConfig := testAuthConfig()
+	authConfig.PolicyPath = policyPath
+	_, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "reader"))
+	req := conn.NewRequest(t.Context(), http.MethodGet, "/events", nil)
+	req.Header.Set("Accept", api.MediaTypeJSON)
+	res, err := conn.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin"))
+	secretID := conn.TestPoolPost(api.PoolPostRequest{Name: "secret"})
+	conn.TestLoad(secretID, "main", strings.NewReader("{x:1}\n"))
+	require.NoError(t, conn.RemovePool(t.Context(), secretID))
+	logsID := conn.TestPoolPost(api.PoolPostRequest{Name: "logs"})
+	conn.TestLoad(logsID, "main", strings.NewReader("{x:1}\n"))
+	require.NoError(t, conn.RemovePool(t.Context(), logsID))
+
+	// The reader sees the events of the logs pool, whose deletion is last,
+	// and none of the events of the secret pool.
+	var events []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		require.NotContains(t, line, fmt.Sprintf("%#x", secretID.Bytes()))
+		if name, ok := strings.CutPrefix(line, "event: "); ok {
+			events = append(events, name)
+			if len(events) == 3 {
+				break
+			}
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.ElementsMatch(t, []string{"pool-new", "branch-commit", "pool-delete"}, events)
+}
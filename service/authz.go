@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/service/logger"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// authorizer enforces a role-based authorization policy and records each
+// denied operation in an audit log.
+type authorizer struct {
+	policy    *auth.Policy
+	audit     *zap.Logger
+	forbidden prometheus.Counter
+}
+
+func newAuthorizer(conf AuthConfig, log *zap.Logger, registerer prometheus.Registerer) (*authorizer, error) {
+	if conf.PolicyPath == "" {
+		if conf.AuditLogPath != "" {
+			return nil, errors.New("auth.auditlog requires auth.policy")
+		}
+		return nil, nil
+	}
+	policy, err := auth.LoadPolicy(conf.PolicyPath)
+	if err != nil {
+		return nil, err
+	}
+	audit := log.Named("audit")
+	if conf.AuditLogPath != "" {
+		audit, err = logger.New(logger.Config{
+			Path:  conf.AuditLogPath,
+			Mode:  logger.FileModeAppend,
+			Level: zapcore.InfoLevel,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &authorizer{
+		policy: policy,
+		audit:  audit,
+		forbidden: promauto.With(registerer).NewCounter(prometheus.CounterOpts{
+			Name: "request_errors_forbidden_total",
+			Help: "Number of operations denied by the authorization policy.",
+		}),
+	}, nil
+}
+
+// authorize returns an error and records the denial in the audit log if
+// the identity in ctx does not have role on the named pool and branch.
+// Operation describes the denied operation in the audit log.
+func (a *authorizer) authorize(ctx context.Context, operation, pool, branch string, role auth.Role) error {
+	ident := auth.IdentityFromContext(ctx)
+	err := a.policy.Authorize(ident, pool, branch, role)
+	if err != nil {
+		a.forbidden.Inc()
+		a.audit.Info("Permission denied",
+			zap.String("request_id", api.RequestIDFromContext(ctx)),
+			zap.String("tenant_id", string(ident.TenantID)),
+			zap.String("user_id", string(ident.UserID)),
+			zap.Strings("groups", ident.Groups),
+			zap.String("operation", operation),
+			zap.String("pool", pool),
+			zap.String("branch", branch),
+			zap.Stringer("role", role),
+		)
+	}
+	return err
+}
+
+// authorize returns a forbidden error if the identity of r does not have
+// role on the named pool and branch.
+func (c *Core) authorize(r *Request, pool, branch string, role auth.Role) error {
+	if c.authz == nil {
+		return nil
+	}
+	if err := c.authz.authorize(r.Context(), r.Method+" "+r.URL.Path, pool, branch, role); err != nil {
+		return srverr.ErrForbidden(err)
+	}
+	return nil
+}
+
+// isAdmin returns true if the authorization policy grants the identity of r
+// the admin role on every pool.  Without a policy, no identity is an admin.
+func (c *Core) isAdmin(r *Request) bool {
+	if c.authz == nil {
+		return false
+	}
+	return c.authz.policy.Role(auth.IdentityFromContext(r.Context()), "", "") >= auth.RoleAdmin
+}
+
+// canSeeEvent returns true if the identity of r has the read role on the
+// pool and branch that ev concerns.
+func (c *Core) canSeeEvent(r *Request, ev event) bool {
+	if c.authz == nil || ev.pool == "" {
+		return true
+	}
+	return c.authz.policy.Role(auth.IdentityFromContext(r.Context()), ev.pool, ev.branch) >= auth.RoleRead
+}
+
+// authorizePool is like authorize but identifies the pool by ID.
+func (c *Core) authorizePool(r *Request, id ksuid.KSUID, branch string, role auth.Role) error {
+	if c.authz == nil {
+		return nil
+	}
+	pool, err := c.root.OpenPool(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return c.authorize(r, pool.Name, branch, role)
+}
+
+// authorizePath returns a handler that calls next only if the identity of
+// the request has role on the pool and branch named in the request path.
+func (c *Core) authorizePath(role auth.Role, next func(*Core, *ResponseWriter, *Request)) func(*Core, *ResponseWriter, *Request) {
+	return func(c *Core, w *ResponseWriter, r *Request) {
+		vars := mux.Vars(r.Request)
+		pool, err := url.QueryUnescape(vars["pool"])
+		if err != nil {
+			w.Error(srverr.ErrInvalid("invalid path param %q: %w", "pool", err))
+			return
+		}
+		// The pool may be named by ID, but the policy refers to pools by name.
+		if id, err := dbid.ParseID(pool); err == nil {
+			if p, err := c.root.OpenPool(r.Context(), id); err == nil {
+				pool = p.Name
+			}
+		}
+		branch, err := url.QueryUnescape(vars["branch"])
+		if err != nil {
+			w.Error(srverr.ErrInvalid("invalid path param %q: %w", "branch", err))
+			return
+		}
+		if err := c.authorize(r, pool, branch, role); err != nil {
+			w.Error(err)
+			return
+		}
+		next(c, w, r)
+	}
+}
+
+type queryDeniedKey struct{}
+
+// withQueryAuthorization returns a context for compiling a query whose
+// result reports whether the query was denied access to a pool.
+func withQueryAuthorization(ctx context.Context) (context.Context, func() bool) {
+	var denied bool
+	return context.WithValue(ctx, queryDeniedKey{}, &denied), func() bool { return denied }
+}
+
+// queryError returns the error for a query that failed to compile with err.
+func queryError(err error, denied bool) error {
+	if denied {
+		return srverr.ErrForbidden(err)
+	}
+	return srverr.ErrInvalid(err)
+}
+
+// authorizeQuery implements exec.Environment.Authorize.
+func (c *Core) authorizeQuery(ctx context.Context, id ksuid.KSUID, branch string, access exec.Access) error {
+	pool, err := c.root.OpenPool(ctx, id)
+	if err != nil {
+		return err
+	}
+	if access == exec.AccessList {
+		// A listing omits the pool or branch so the denial isn't audited.
+		return c.authz.policy.Authorize(auth.IdentityFromContext(ctx), pool.Name, branch, auth.RoleRead)
+	}
+	role, operation := auth.RoleRead, "query read"
+	if access == exec.AccessLoad {
+		role, operation = auth.RoleLoad, "query load"
+	}
+	if err := c.authz.authorize(ctx, operation, pool.Name, branch, role); err != nil {
+		if denied, ok := ctx.Value(queryDeniedKey{}).(*bool); ok {
+			*denied = true
+		}
+		return err
+	}
+	return nil
+}
+
+// checkUnrestricted returns a forbidden error if the identity of r is
+// restricted in the values of pool it may see.
+func (c *Core) checkUnrestricted(r *Request, pool *db.Pool) error {
+	if c.authz == nil {
+		return nil
+	}
+	text, _ := c.authz.policy.Restrict(auth.IdentityFromContext(r.Context()), pool.Name)
+	if text != "" {
+		return srverr.ErrForbidden(fmt.Errorf("%w: cannot modify pool %q", compiler.ErrRestrictedPool, pool.Name))
+	}
+	return nil
+}
+
+// restrictQuery implements exec.Environment.Restrict.
+func (c *Core) restrictQuery(ctx context.Context, id ksuid.KSUID) (*exec.Restriction, error) {
+	pool, err := c.root.OpenPool(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	text, params := c.authz.policy.Restrict(auth.IdentityFromContext(ctx), pool.Name)
+	if text == "" {
+		return nil, nil
+	}
+	return &exec.Restriction{Text: text, Params: params}, nil
+}
@@ -48,7 +48,7 @@ func (c *testClient) TestBranchGet(id ksuid.KSUID) (config db.BranchMeta) {
 }
 
 func (c *testClient) TestPoolList() []pools.Config {
-	r, err := c.Query(c.Context(), srcfiles.Plain("from :pools"))
+	r, err := c.Query(c.Context(), srcfiles.Plain("from :pools"), nil)
 	require.NoError(c, err)
 	defer r.Body.Close()
 	var confs []pools.Config
@@ -80,7 +80,11 @@ func (c *testClient) TestBranchPost(poolID ksuid.KSUID, payload api.BranchPostRe
 }
 
 func (c *testClient) TestQuery(query string) string {
-	r, err := c.Connection.Query(c.Context(), srcfiles.Plain(query))
+	return c.TestQueryParams(query, nil)
+}
+
+func (c *testClient) TestQueryParams(query string, params map[string]string) string {
+	r, err := c.Connection.Query(c.Context(), srcfiles.Plain(query), params)
 	require.NoError(c, err)
 	defer r.Body.Close()
 	zr := bsupio.NewReader(super.NewContext(), r.Body)
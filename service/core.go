@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,20 +9,27 @@ import (
 	"io"
 	"net/http"
 	"net/http/pprof"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/service/auth"
 	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector/vio"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
 
@@ -46,6 +54,7 @@ type Config struct {
 	Auth                  AuthConfig
 	CORSAllowedOrigins    []string
 	DefaultResponseFormat string
+	ResultCache           ResultCacheConfig
 	Root                  *storage.URI
 	RootContent           io.ReadSeeker
 	Version               string
@@ -54,11 +63,14 @@ type Config struct {
 
 type Core struct {
 	auth             *Auth0Authenticator
+	authz            *authorizer
 	compiler         runtime.Compiler
 	conf             Config
 	engine           storage.Engine
+	env              *exec.Environment
 	logger           *zap.Logger
 	registry         *prometheus.Registry
+	results          *resultCache
 	root             *db.Root
 	routerAPI        *mux.Router
 	routerAux        *mux.Router
@@ -112,6 +124,18 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	if err != nil {
 		return nil, err
 	}
+	authz, err := newAuthorizer(conf.Auth, conf.Logger, registry)
+	if err != nil {
+		return nil, err
+	}
+	results, err := newResultCache(conf.ResultCache, conf.Logger.Named("resultcache"), registry)
+	if err != nil {
+		return nil, err
+	}
+	// We configure a remote storage engine into the compiler so that
+	// "from" operators that source http or s3 will work, but stdio and
+	// file system accesses will be rejected at open time.
+	env := exec.NewEnvironment(storage.NewRemoteEngine(), root)
 
 	routerAux := mux.NewRouter()
 	routerAux.Use(corsMiddleware(conf.CORSAllowedOrigins))
@@ -144,18 +168,25 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 
 	c := &Core{
 		auth:           authenticator,
-		compiler:       compiler.NewCompilerForDB(root),
+		authz:          authz,
+		compiler:       compiler.NewCompilerWithEnv(env),
 		conf:           conf,
 		engine:         engine,
+		env:            env,
 		logger:         conf.Logger.Named("core"),
 		root:           root,
 		registry:       registry,
+		results:        results,
 		routerAPI:      routerAPI,
 		routerAux:      routerAux,
 		runningQueries: make(map[string]*queryStatus),
 		subscriptions:  make(map[chan event]struct{}),
 	}
 
+	if authz != nil {
+		env.Authorize = c.authorizeQuery
+		env.Restrict = c.restrictQuery
+	}
 	c.addAPIServerRoutes()
 	c.logger.Info("Started",
 		zap.Bool("auth_enabled", conf.Auth.Enabled),
@@ -166,30 +197,38 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 }
 
 func (c *Core) addAPIServerRoutes() {
-	c.authhandle("/auth/identity", handleAuthIdentityGet).Methods("GET")
+	c.authhandle("/auth/identity", auth.RoleNone, handleAuthIdentityGet).Methods("GET")
 	// /auth/method intentionally requires no authentication
 	c.routerAPI.Handle("/auth/method", c.handler(handleAuthMethodGet)).Methods("GET")
-	c.authhandle("/compile", handleCompile).Methods("POST")
-	c.authhandle("/events", handleEvents).Methods("GET")
-	c.authhandle("/pool", handlePoolPost).Methods("POST")
-	c.authhandle("/pool/{pool}", handlePoolDelete).Methods("DELETE")
-	c.authhandle("/pool/{pool}", handleBranchPost).Methods("POST")
-	c.authhandle("/pool/{pool}", handlePoolPut).Methods("PUT")
-	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchGet).Methods("GET")
-	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchDelete).Methods("DELETE")
-	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchLoad).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/compact", handleCompact).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/delete", handleDelete).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", handleBranchMerge).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", handleRevertPost).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vacuum", handleVacuum).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorPost).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorDelete).Methods("DELETE")
-	c.authhandle("/pool/{pool}/stats", handlePoolStats).Methods("GET")
-	c.authhandle("/pool/{pool}/vacate", handleVacate).Methods("POST")
-	c.authhandle("/query", handleQuery).Methods("OPTIONS", "POST")
-	c.authhandle("/query/describe", handleQueryDescribe).Methods("OPTIONS", "POST")
-	c.authhandle("/query/status/{requestID}", handleQueryStatus).Methods("GET")
+	c.authhandle("/compile", auth.RoleNone, handleCompile).Methods("POST")
+	c.authhandle("/events", auth.RoleNone, handleEvents).Methods("GET")
+	c.authhandle("/pool", auth.RoleNone, handlePoolPost).Methods("POST")
+	c.authhandle("/pool/{pool}", auth.RoleAdmin, handlePoolDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}", auth.RoleNone, handleBranchPost).Methods("POST")
+	c.authhandle("/pool/{pool}", auth.RoleAdmin, handlePoolPut).Methods("PUT")
+	c.authhandle("/pool/{pool}/branch/{branch}", auth.RoleRead, handleBranchGet).Methods("GET")
+	c.authhandle("/pool/{pool}/branch/{branch}", auth.RoleAdmin, handleBranchDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}/branch/{branch}", auth.RoleLoad, handleBranchLoad).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/compact", auth.RoleLoad, handleCompact).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/delete", auth.RoleDelete, handleDelete).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", auth.RoleLoad, handleBranchMerge).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", auth.RoleDelete, handleRevertPost).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/update", auth.RoleDelete, handleUpdate).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vacuum", auth.RoleAdmin, handleVacuum).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vector", auth.RoleLoad, handleVectorPost).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vector", auth.RoleLoad, handleVectorDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}/stats", auth.RoleRead, handlePoolStats).Methods("GET")
+	c.authhandle("/pool/{pool}/vacate", auth.RoleAdmin, handleVacate).Methods("POST")
+	c.authhandle("/pool/{pool}/retention", auth.RoleAdmin, handleRetentionPut).Methods("PUT")
+	c.authhandle("/pool/{pool}/branch/{branch}/retain", auth.RoleDelete, handleRetain).Methods("POST")
+	c.authhandle("/pool/{pool}/indexes", auth.RoleAdmin, handleIndexRulesPost).Methods("POST")
+	c.authhandle("/pool/{pool}/indexes", auth.RoleAdmin, handleIndexRulesDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}/branch/{branch}/indexes/update", auth.RoleLoad, handleIndexesUpdate).Methods("POST")
+	c.authhandle("/query", auth.RoleNone, handleQuery).Methods("OPTIONS", "POST")
+	c.authhandle("/query", auth.RoleNone, handleQueryList).Methods("GET")
+	c.authhandle("/query/{requestID}", auth.RoleNone, handleQueryCancel).Methods("DELETE")
+	c.authhandle("/query/describe", auth.RoleNone, handleQueryDescribe).Methods("OPTIONS", "POST")
+	c.authhandle("/query/status/{requestID}", auth.RoleNone, handleQueryStatus).Methods("GET")
 }
 
 func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
@@ -200,7 +239,13 @@ func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
 	})
 }
 
-func (c *Core) authhandle(path string, f func(*Core, *ResponseWriter, *Request)) *mux.Route {
+// authhandle registers a handler that requires authentication, when enabled,
+// and role on the pool and branch in the path, when an authorization policy
+// is configured.
+func (c *Core) authhandle(path string, role auth.Role, f func(*Core, *ResponseWriter, *Request)) *mux.Route {
+	if c.authz != nil && role != auth.RoleNone {
+		f = c.authorizePath(role, f)
+	}
 	if c.auth != nil {
 		f = c.auth.Middleware(f)
 	}
@@ -221,15 +266,52 @@ func (c *Core) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
+	pool, branch := c.eventTarget(data)
+	c.publish(w.Logger, event{name: name, pool: pool, branch: branch}, data)
+}
+
+// Publish sends an event to the subscribers of the events endpoint.
+func (c *Core) Publish(name string, data any) {
+	pool, branch := c.eventTarget(data)
+	c.publish(c.logger, event{name: name, pool: pool, branch: branch}, data)
+}
+
+// eventTarget returns the names of the pool and branch that the event
+// data concerns.  The pool is named by its ID if it no longer exists.
+func (c *Core) eventTarget(data any) (string, string) {
+	var id ksuid.KSUID
+	var branch string
+	switch data := data.(type) {
+	case api.EventPool:
+		id = data.PoolID
+	case api.EventBranch:
+		id, branch = data.PoolID, data.Branch
+	case api.EventBranchCommit:
+		id, branch = data.PoolID, data.Branch
+	case api.EventBranchCompact:
+		id, branch = data.PoolID, data.Branch
+	case api.EventManageProgress:
+		id, branch = data.PoolID, data.Branch
+	default:
+		return "", ""
+	}
+	if pool, err := c.root.OpenPool(context.Background(), id); err == nil {
+		return pool.Name, branch
+	}
+	return id.String(), branch
+}
+
+// publish sends ev with the value of data to the subscribers.
+func (c *Core) publish(logger *zap.Logger, ev event, data any) {
 	marshaler := sup.NewBSUPMarshaler()
 	marshaler.Decorate(sup.StyleSimple)
 	zv, err := marshaler.Marshal(data)
 	if err != nil {
-		w.Logger.Error("Error marshaling published event", zap.Error(err))
+		logger.Error("Error marshaling published event", zap.Error(err))
 		return
 	}
+	ev.value = zv
 	go func() {
-		ev := event{name: name, value: zv}
 		c.subscriptionsMu.RLock()
 		for sub := range c.subscriptions {
 			sub <- ev
@@ -238,7 +320,7 @@ func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
 	}()
 }
 
-func (c *Core) newQueryStatus(r *Request) *queryStatus {
+func (c *Core) newQueryStatus(r *Request, query string, meter vio.Meter, cancel context.CancelCauseFunc) *queryStatus {
 	id := r.ID()
 	remove := func() {
 		// Have query status wait around for a few seconds after done is signaled
@@ -248,7 +330,19 @@ func (c *Core) newQueryStatus(r *Request) *queryStatus {
 		delete(c.runningQueries, id)
 		c.runningQueriesMu.Unlock()
 	}
-	q := &queryStatus{remove: remove}
+	owner := auth.IdentityFromContext(r.Context())
+	q := &queryStatus{
+		remove: remove,
+		owner:  owner,
+		info: api.QueryInfo{
+			RequestID: id,
+			Query:     query,
+			UserID:    string(owner.UserID),
+			StartTime: nano.Now(),
+		},
+		meter:  meter,
+		cancel: cancel,
+	}
 	q.wg.Add(1)
 	c.runningQueriesMu.Lock()
 	c.runningQueries[id] = q
@@ -256,10 +350,59 @@ func (c *Core) newQueryStatus(r *Request) *queryStatus {
 	return q
 }
 
+// listQueries returns the queries that are running and visible to the
+// caller of r, ordered by start time.
+func (c *Core) listQueries(r *Request) []api.QueryInfo {
+	admin := c.isAdmin(r)
+	ident := auth.IdentityFromContext(r.Context())
+	c.runningQueriesMu.Lock()
+	defer c.runningQueriesMu.Unlock()
+	infos := []api.QueryInfo{}
+	for _, q := range c.runningQueries {
+		if !q.done.Load() && (admin || q.ownedBy(ident)) {
+			infos = append(infos, q.Info())
+		}
+	}
+	slices.SortFunc(infos, func(a, b api.QueryInfo) int {
+		return cmp.Or(cmp.Compare(a.StartTime, b.StartTime), cmp.Compare(a.RequestID, b.RequestID))
+	})
+	return infos
+}
+
+// lookupQuery returns the query with the given request ID and returns false
+// if there is no such query visible to the caller of r.
+func (c *Core) lookupQuery(r *Request, id string) (*queryStatus, bool) {
+	c.runningQueriesMu.Lock()
+	q, ok := c.runningQueries[id]
+	c.runningQueriesMu.Unlock()
+	if !ok || !c.isAdmin(r) && !q.ownedBy(auth.IdentityFromContext(r.Context())) {
+		return nil, false
+	}
+	return q, true
+}
+
+// cancelQuery cancels the running query with the given request ID and
+// returns false if there is no such query visible to the caller of r.
+func (c *Core) cancelQuery(r *Request, id string) bool {
+	q, ok := c.lookupQuery(r, id)
+	if !ok || q.done.Load() {
+		return false
+	}
+	q.cancel(errQueryCanceled)
+	return true
+}
+
+var errQueryCanceled = errors.New("query canceled")
+
 type queryStatus struct {
 	wg     sync.WaitGroup
 	remove func()
 	error  string
+	info   api.QueryInfo
+	owner  auth.Identity
+	meter  vio.Meter
+	cancel context.CancelCauseFunc
+	done   atomic.Bool
 }
 
 func (q *queryStatus) setError(err error) {
@@ -268,7 +411,20 @@ func (q *queryStatus) setError(err error) {
 	}
 }
 
+// ownedBy returns true if ident started the query.
+func (q *queryStatus) ownedBy(ident auth.Identity) bool {
+	return q.owner.TenantID == ident.TenantID && q.owner.UserID == ident.UserID
+}
+
+// Info returns a description of the query including its progress so far.
+func (q *queryStatus) Info() api.QueryInfo {
+	info := q.info
+	info.Progress = q.meter.Progress()
+	return info
+}
+
 func (q *queryStatus) Done() {
+	q.done.Store(true)
 	q.wg.Done()
 	go q.remove()
 }
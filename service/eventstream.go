@@ -11,9 +11,14 @@ import (
 	"github.com/brimdata/super/sio/anyio"
 )
 
+// event is a published event.  Pool and branch name the pool and branch
+// the event concerns, which a subscriber must be allowed to read, or are
+// empty if it concerns none.
 type event struct {
-	name  string
-	value super.Value
+	name   string
+	value  super.Value
+	pool   string
+	branch string
 }
 
 type eventStreamWriter struct {
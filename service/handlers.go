@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -17,10 +18,10 @@ import (
 	dbapi "github.com/brimdata/super/db/api"
 	"github.com/brimdata/super/db/commits"
 	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/nano"
-	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/runtime/sam/op"
@@ -31,6 +32,7 @@ import (
 	"github.com/brimdata/super/sio/anyio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csvio"
+	"github.com/brimdata/super/vector/vio"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
@@ -60,12 +62,43 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
+	ast.SetParams(req.Params)
+	ctx, cancel := context.WithCancelCause(r.Context())
+	defer cancel(nil)
 	sctx := super.NewContext()
-	flowgraph, err := runtime.CompileQueryForDB(r.Context(), sctx, c.compiler, ast)
+	actx, denied := withQueryAuthorization(ctx)
+	rctx := runtime.NewContext(actx, sctx)
+	main, err := compiler.Analyze(rctx, ast, c.env, false)
+	if err == nil {
+		err = compiler.Optimize(rctx, main, c.env, compiler.Parallelism)
+	}
 	if err != nil {
-		w.Error(srverr.ErrInvalid(err))
+		rctx.Cancel()
+		w.Error(queryError(err, denied()))
 		return
 	}
+	var flowgraph vio.Scanner
+	var recorder *resultRecorder
+	if key, ok := c.results.key(main); ok {
+		if flowgraph = c.results.get(ctx, key); flowgraph != nil {
+			rctx.Cancel()
+		} else {
+			recorder = c.results.newRecorder(sctx, key)
+		}
+	}
+	if flowgraph == nil {
+		q, err := compiler.BuildQuery(rctx, main, c.env)
+		if err != nil {
+			rctx.Cancel()
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+		flowgraph = q
+	}
+	// Register the query before writing the response so it is listed by
+	// the time the client sees the response.
+	status := c.newQueryStatus(r, req.Query, flowgraph, cancel)
+	defer status.Done()
 	flusher, _ := w.ResponseWriter.(http.Flusher)
 	writer, err := queryio.NewWriter(sctx, sio.NopCloser(w), w.Format, flusher, ctrl)
 	if err != nil {
@@ -76,12 +109,12 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	// response body and for errors after this point, we must call
 	// writer.WriterError() instead of w.Error().
 	defer writer.Close()
-	// Launch query status which will report and runtime errors (i.e., system
-	// errors that occur after the OK header has been sent) to the query status
-	// endpoint.
-	status := c.newQueryStatus(r)
-	defer status.Done()
+	// Query status reports runtime errors (i.e., system errors that occur
+	// after the OK header has been sent) to the query status endpoint.
 	handleError := func(err error) {
+		if errors.Is(context.Cause(ctx), errQueryCanceled) {
+			err = errQueryCanceled
+		}
 		writer.WriteError(err)
 		status.setError(err)
 	}
@@ -97,11 +130,10 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	}()
 	timer := time.NewTicker(queryStatsInterval)
 	defer timer.Stop()
-	meter := flowgraph.Meter()
 	for {
 		select {
 		case <-timer.C:
-			if err := writer.WriteProgress(meter.Progress()); err != nil {
+			if err := writer.WriteProgress(flowgraph.Progress()); err != nil {
 				w.Logger.Warn("Error writing progress", zap.Error(err))
 				handleError(err)
 				return
@@ -116,7 +148,10 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 				return
 			}
 			if batch == nil {
-				if err := writer.WriteProgress(meter.Progress()); err != nil {
+				if recorder != nil {
+					recorder.store(ctx)
+				}
+				if err := writer.WriteProgress(flowgraph.Progress()); err != nil {
 					w.Logger.Warn("Error writing progress", zap.Error(err))
 					handleError(err)
 				}
@@ -124,6 +159,9 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 			}
 			if len(batch.Values()) == 0 {
 				if eoc, ok := batch.(*sbuf.EndOfChannel); ok {
+					if recorder != nil {
+						recorder.writeChannelEnd(string(*eoc))
+					}
 					if err := writer.WhiteChannelEnd(string(*eoc)); err != nil {
 						w.Logger.Warn("Error writing channel end", zap.Error(err))
 						handleError(err)
@@ -134,6 +172,9 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 			}
 			var label string
 			batch, label = sbuf.Unlabel(batch)
+			if recorder != nil {
+				recorder.writeBatch(label, batch)
+			}
 			if err := writer.WriteBatch(label, batch); err != nil {
 				w.Logger.Warn("Error writing batch", zap.Error(err))
 				handleError(err)
@@ -148,9 +189,7 @@ func handleQueryStatus(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
-	c.runningQueriesMu.Lock()
-	q, ok := c.runningQueries[id]
-	c.runningQueriesMu.Unlock()
+	q, ok := c.lookupQuery(r, id)
 	if !ok {
 		w.Error(srverr.ErrInvalid("query not found"))
 		return
@@ -159,6 +198,22 @@ func handleQueryStatus(c *Core, w *ResponseWriter, r *Request) {
 	w.Respond(http.StatusOK, api.QueryError{Error: q.error})
 }
 
+func handleQueryList(c *Core, w *ResponseWriter, r *Request) {
+	w.Respond(http.StatusOK, c.listQueries(r))
+}
+
+func handleQueryCancel(c *Core, w *ResponseWriter, r *Request) {
+	id, ok := r.StringFromPath(w, "requestID")
+	if !ok {
+		return
+	}
+	if !c.cancelQuery(r, id) {
+		w.Error(srverr.ErrNotFound("query not found"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func handleCompile(c *Core, w *ResponseWriter, r *Request) {
 	var req api.QueryRequest
 	if !r.Unmarshal(w, &req) {
@@ -177,10 +232,10 @@ func handleQueryDescribe(c *Core, w *ResponseWriter, r *Request) {
 	if !r.Unmarshal(w, &req) {
 		return
 	}
-	env := exec.NewEnvironment(storage.NewRemoteEngine(), c.root)
-	info, err := describe.Analyze(r.Context(), req.Query, env)
+	ctx, denied := withQueryAuthorization(r.Context())
+	info, err := describe.Analyze(ctx, req.Query, c.env)
 	if err != nil {
-		w.Error(srverr.ErrInvalid(err))
+		w.Error(queryError(err, denied()))
 		return
 	}
 	w.Respond(http.StatusOK, info)
@@ -246,6 +301,10 @@ func handlePoolPost(c *Core, w *ResponseWriter, r *Request) {
 	if len(req.SortKeys.Keys) > 0 {
 		sortKeys = append(sortKeys, order.NewSortKey(req.SortKeys.Order, req.SortKeys.Keys[0]))
 	}
+	if err := c.authorize(r, req.Name, "", auth.RoleAdmin); err != nil {
+		w.Error(err)
+		return
+	}
 	pool, err := c.root.CreatePool(r.Context(), req.Name, sortKeys, req.Thresh)
 	if err != nil {
 		w.Error(err)
@@ -269,6 +328,10 @@ func handlePoolPut(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if err := c.authorize(r, req.Name, "", auth.RoleAdmin); err != nil {
+		w.Error(err)
+		return
+	}
 	if err := c.root.RenamePool(r.Context(), id, req.Name); err != nil {
 		w.Error(err)
 		return
@@ -286,6 +349,10 @@ func handleBranchPost(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if err := c.authorizePool(r, poolID, req.Name, auth.RoleLoad); err != nil {
+		w.Error(err)
+		return
+	}
 	commit, err := dbid.ParseID(req.Commit)
 	if err != nil {
 		w.Error(srverr.ErrInvalid("invalid commit object: %s", req.Commit))
@@ -343,6 +410,10 @@ func handleBranchMerge(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if err := c.authorizePool(r, poolID, childBranch, auth.RoleRead); err != nil {
+		w.Error(err)
+		return
+	}
 	message, ok := r.decodeCommitMessage(w)
 	if !ok {
 		return
@@ -366,12 +437,18 @@ func handlePoolDelete(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	pool, err := c.root.OpenPool(r.Context(), id)
+	if err != nil {
+		w.Error(err)
+		return
+	}
 	if err := c.root.RemovePool(r.Context(), id); err != nil {
 		w.Error(err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
-	c.publishEvent(w, "pool-delete", api.EventPool{PoolID: id})
+	// The pool no longer exists so name it for the event's subscribers.
+	c.publish(w.Logger, event{name: "pool-delete", pool: pool.Name}, api.EventPool{PoolID: id})
 }
 
 func handleBranchDelete(c *Core, w *ResponseWriter, r *Request) {
@@ -426,7 +503,7 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
-	if format == "parquet" || format == "csup" {
+	if format == "parquet" || format == "orc" || format == "csup" {
 		// These formats require a reader that implements io.ReaderAt and
 		// io.Seeker.  Copy the reader to a temporary file and use that.
 		//
@@ -531,6 +608,12 @@ func handleCompact(c *Core, w *ResponseWriter, r *Request) {
 		PoolID:   pool.ID,
 		Branch:   branch,
 	})
+	c.publishEvent(w, "branch-compact", api.EventBranchCompact{
+		CommitID: commit,
+		PoolID:   pool.ID,
+		Branch:   branch,
+		Objects:  len(req.ObjectIDs),
+	})
 }
 
 func handleDelete(c *Core, w *ResponseWriter, r *Request) {
@@ -567,6 +650,11 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 			w.Error(srverr.ErrInvalid(err))
 			return
 		}
+		// Objects may hold values hidden by a restriction on the pool.
+		if err := c.checkUnrestricted(r, pool); err != nil {
+			w.Error(err)
+			return
+		}
 		commit, err = branch.Delete(r.Context(), ids, message.Author, message.Body)
 	} else {
 		if payload.Where == "" {
@@ -582,6 +670,8 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 		if errors.Is(err, commits.ErrEmptyTransaction) ||
 			errors.Is(err, &compiler.InvalidDeleteWhereQuery{}) {
 			err = srverr.ErrInvalid(err)
+		} else if errors.Is(err, compiler.ErrRestrictedPool) {
+			err = srverr.ErrForbidden(err)
 		}
 	}
 	if err != nil {
@@ -596,6 +686,207 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 	})
 }
 
+func handleUpdate(c *Core, w *ResponseWriter, r *Request) {
+	branchName, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	message, ok := r.decodeCommitMessage(w)
+	if !ok {
+		return
+	}
+	var payload api.UpdateRequest
+	if !r.Unmarshal(w, &payload) {
+		return
+	}
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	branch, err := pool.OpenBranchByName(r.Context(), branchName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	var commit ksuid.KSUID
+	if payload.Merge != "" {
+		if payload.Where != "" || payload.Set != "" {
+			w.Error(srverr.ErrInvalid("merge cannot be set with where or set"))
+			return
+		}
+		ast, perr := parser.ParseText(payload.Merge)
+		if perr != nil {
+			w.Error(srverr.ErrInvalid(perr))
+			return
+		}
+		commit, err = branch.MergeInto(r.Context(), c.compiler, ast, message.Author, message.Body, message.Meta)
+	} else {
+		if payload.Where == "" || payload.Set == "" {
+			w.Error(srverr.ErrInvalid("either merge or both where and set must be set"))
+			return
+		}
+		where, perr := parser.ParseText(payload.Where)
+		if perr != nil {
+			w.Error(srverr.ErrInvalid(perr))
+			return
+		}
+		set, perr := parser.ParseText(payload.Set)
+		if perr != nil {
+			w.Error(srverr.ErrInvalid(perr))
+			return
+		}
+		commit, err = branch.UpdateWhere(r.Context(), c.compiler, where, set, message.Author, message.Body, message.Meta)
+	}
+	if errors.Is(err, commits.ErrEmptyTransaction) ||
+		errors.Is(err, &compiler.InvalidDeleteWhereQuery{}) ||
+		errors.Is(err, &compiler.InvalidUpdateQuery{}) ||
+		errors.Is(err, &compiler.InvalidMergeQuery{}) ||
+		errors.Is(err, db.ErrMultipleMatches) {
+		err = srverr.ErrInvalid(err)
+	} else if errors.Is(err, compiler.ErrRestrictedPool) {
+		err = srverr.ErrForbidden(err)
+	}
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Marshal(api.CommitResponse{Commit: commit})
+	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+		CommitID: commit,
+		PoolID:   pool.ID,
+		Branch:   branchName,
+	})
+}
+
+func handleRetentionPut(c *Core, w *ResponseWriter, r *Request) {
+	var req api.RetentionPutRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	id, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	if req.Age < 0 || req.Commits < 0 {
+		w.Error(srverr.ErrInvalid("retention age and commits cannot be negative"))
+		return
+	}
+	retention := pools.Retention{Age: req.Age, Commits: req.Commits}
+	if err := c.root.SetRetention(r.Context(), id, retention); err != nil {
+		if errors.Is(err, pools.ErrRetentionKey) {
+			err = srverr.ErrInvalid(err)
+		}
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
+}
+
+func handleIndexRulesPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.IndexRulesPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	id, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	for _, rule := range req.Rules {
+		if err := rule.Validate(); err != nil {
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+	}
+	if err := c.root.AddIndexRules(r.Context(), id, req.Rules); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
+}
+
+func handleIndexRulesDelete(c *Core, w *ResponseWriter, r *Request) {
+	var req api.IndexRulesDeleteRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	id, ok := r.PoolID(w, c.root)
+	if !ok {
+		return
+	}
+	rules, err := c.root.DeleteIndexRules(r.Context(), id, req.Names)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.IndexRulesDeleteResponse{Rules: rules})
+	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
+}
+
+func handleIndexesUpdate(c *Core, w *ResponseWriter, r *Request) {
+	branchName, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	branch, err := pool.OpenBranchByName(r.Context(), branchName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	ids, err := branch.UpdateIndexes(r.Context())
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.IndexUpdateResponse{ObjectIDs: ids})
+}
+
+func handleRetain(c *Core, w *ResponseWriter, r *Request) {
+	branchName, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	dryrun, ok := r.BoolFromQuery(w, "dryrun")
+	if !ok {
+		return
+	}
+	message, ok := r.decodeCommitMessage(w)
+	if !ok {
+		return
+	}
+	pool, ok := r.openPool(w, c.root)
+	if !ok {
+		return
+	}
+	branch, err := pool.OpenBranchByName(r.Context(), branchName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	retained, err := branch.Retain(r.Context(), nano.Now(), dryrun, message.Author, message.Body)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.RetainResponse{
+		Commit:    retained.Commit,
+		ObjectIDs: retained.Objects,
+		CommitIDs: retained.Commits,
+	})
+	if !retained.Commit.IsNil() {
+		c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+			CommitID: retained.Commit,
+			PoolID:   pool.ID,
+			Branch:   branchName,
+		})
+	}
+}
+
 func handleVacate(c *Core, w *ResponseWriter, r *Request) {
 	pool, ok := r.StringFromPath(w, "pool")
 	if !ok {
@@ -733,6 +1024,9 @@ func handleEvents(c *Core, w *ResponseWriter, r *Request) {
 	for {
 		select {
 		case ev := <-subscription:
+			if !c.canSeeEvent(r, ev) {
+				continue
+			}
 			if err := writer.writeEvent(ev); err != nil {
 				w.Error(err)
 				continue
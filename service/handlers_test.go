@@ -2,6 +2,9 @@ package service_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
@@ -9,10 +12,14 @@ import (
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
+	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/pkg/storage/cache"
+	"github.com/brimdata/super/pkg/units"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/service"
+	"github.com/brimdata/super/service/auth"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/segmentio/ksuid"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +45,121 @@ func TestQueryEmptyPool(t *testing.T) {
 	assert.Equal(t, "", conn.TestQuery("from test"))
 }
 
+func TestQueryParams(t *testing.T) {
+	_, conn := newCore(t)
+	params := map[string]string{"n": "2", "s": `"a' or true"`}
+	assert.Equal(t, "3\n\"a' or true\"\n", conn.TestQueryParams("values $n + 1, :s", params))
+	_, err := conn.Query(t.Context(), srcfiles.Plain("values $x"), nil)
+	assert.ErrorContains(t, err, "parameter $x is not bound")
+	_, err = conn.Query(t.Context(), srcfiles.Plain("values $x"), map[string]string{"x": "{a"})
+	assert.ErrorContains(t, err, "parameter $x")
+	// JSON request bodies bind parameters with an object.
+	body := strings.NewReader(`{"query":"values $x","params":{"x":"1::uint8"}}`)
+	req := conn.NewRequest(t.Context(), http.MethodPost, "/query", body)
+	req.Header.Set("Content-Type", api.MediaTypeJSON)
+	req.Header.Set("Accept", api.MediaTypeSUP)
+	res, err := conn.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "1::uint8\n", string(b))
+}
+
+func TestQueryResultCache(t *testing.T) {
+	core, conn := newCoreWithConfig(t, service.Config{
+		ResultCache: service.ResultCacheConfig{
+			Kind:      cache.KindLocal,
+			LocalSize: 16,
+			MaxBytes:  units.Bytes(1 << 20),
+		},
+	})
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader("{x:1}\n{x:2}\n"))
+	const query = "from test | aggregate sum(x)"
+	assert.Equal(t, "3\n", conn.TestQuery(query))
+	assert.Equal(t, 0.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	assert.Equal(t, 1.0, promCounterValue(core.Registry(), "query_result_cache_misses_total"))
+	assert.Equal(t, "3\n", conn.TestQuery(query))
+	assert.Equal(t, 1.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	// A cached result is rendered in the requested format.
+	body := strings.NewReader(`{"query":"from test | aggregate sum(x)"}`)
+	req := conn.NewRequest(t.Context(), http.MethodPost, "/query", body)
+	req.Header.Set("Content-Type", api.MediaTypeJSON)
+	req.Header.Set("Accept", api.MediaTypeJSON)
+	res, err := conn.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `[3]`+"\n", string(b))
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	// A new commit moves the branch so the query misses.
+	conn.TestLoad(poolID, "main", strings.NewReader("{x:3}\n"))
+	assert.Equal(t, "6\n", conn.TestQuery(query))
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_misses_total"))
+	// Queries with time-dependent results are not cached.
+	conn.TestQuery("from test | values now()")
+	conn.TestQuery("from test | values now()")
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_misses_total"))
+}
+
+func TestQueryResultCacheMaxBytes(t *testing.T) {
+	core, conn := newCoreWithConfig(t, service.Config{
+		ResultCache: service.ResultCacheConfig{
+			Kind:      cache.KindLocal,
+			LocalSize: 16,
+			MaxBytes:  units.Bytes(64),
+		},
+	})
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader("{s:\""+strings.Repeat("x", 100)+"\"}\n"))
+	conn.TestQuery("from test")
+	conn.TestQuery("from test")
+	assert.Equal(t, 0.0, promCounterValue(core.Registry(), "query_result_cache_hits_total"))
+	assert.Equal(t, 2.0, promCounterValue(core.Registry(), "query_result_cache_misses_total"))
+}
+
+func TestQueryListAndCancel(t *testing.T) {
+	// The query reads from a server that sends one value and then blocks,
+	// so the query runs until it is canceled.
+	block := make(chan struct{})
+	defer close(block)
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{x:1}\n"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-block:
+		case <-r.Context().Done():
+		}
+	}))
+	defer src.Close()
+	_, conn := newCore(t)
+	query := fmt.Sprintf("from '%s' (format sup)", src.URL)
+	res, err := conn.Query(t.Context(), srcfiles.Plain(query), nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	requestID := res.Header.Get("X-Request-ID")
+	infos, err := conn.QueryList(t.Context())
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.Equal(t, requestID, infos[0].RequestID)
+	assert.Equal(t, query, infos[0].Query)
+	assert.Equal(t, string(auth.AnonymousUserID), infos[0].UserID)
+	assert.NotZero(t, infos[0].StartTime)
+	require.NoError(t, conn.QueryCancel(t.Context(), requestID))
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "query canceled")
+	infos, err = conn.QueryList(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, infos, 0)
+	err = conn.QueryCancel(t.Context(), requestID)
+	assert.ErrorIs(t, err, client.ErrQueryNotFound)
+}
+
 func TestPoolStats(t *testing.T) {
 	src := `
 {_path:"conn",ts:1970-01-01T00:00:01Z,uid:"CBrzd94qfowOqJwCHa"}
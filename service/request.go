@@ -17,6 +17,7 @@ import (
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/branches"
 	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/index"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
@@ -315,10 +316,10 @@ func errorResponse(e error) (status int, ae *api.Error) {
 	}
 
 	switch {
-	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists):
+	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists) || errors.Is(e, index.ErrExists):
 		ze.Kind = srverr.Conflict
 	case errors.Is(e, branches.ErrNotFound) || errors.Is(e, commits.ErrNotFound) ||
-		errors.Is(e, pools.ErrNotFound) || errors.Is(e, fs.ErrNotExist):
+		errors.Is(e, pools.ErrNotFound) || errors.Is(e, index.ErrNotFound) || errors.Is(e, fs.ErrNotExist):
 		ze.Kind = srverr.NotFound
 	}
 
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api/queryio"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/storage/cache"
+	"github.com/brimdata/super/pkg/units"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/vector/vio"
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+type ResultCacheConfig struct {
+	Kind cache.Kind
+	// LocalSize is the number of query results kept by a local cache.
+	LocalSize int
+	// MaxBytes is the size of the largest encoded query result that will
+	// be cached.
+	MaxBytes units.Bytes
+	// RedisAddr is the address of the Redis server used by a redis cache.
+	RedisAddr string
+	// RedisKeyExpiration is the expiration value used when creating keys.
+	// A value of zero (meaning no expiration) should only be used when
+	// Redis is configured with a key eviction policy.
+	RedisKeyExpiration time.Duration
+}
+
+func (c *ResultCacheConfig) SetFlags(fs *flag.FlagSet) {
+	fs.Var(&c.Kind, "resultcache.kind", "kind of query result cache (none, local, or redis)")
+	fs.IntVar(&c.LocalSize, "resultcache.local.size", 128, "number of query results to keep in local cache")
+	c.MaxBytes = 16 * units.Bytes(1<<20)
+	fs.Var(&c.MaxBytes, "resultcache.maxbytes", "size of largest query result to cache in MiB, MB, etc")
+	fs.StringVar(&c.RedisAddr, "resultcache.redis.addr", "localhost:6379", "address of Redis server for redis cache")
+	fs.DurationVar(&c.RedisKeyExpiration, "resultcache.redis.keyexpiry", time.Hour*24, "expiration duration of Redis keys for cached results")
+}
+
+// resultCache holds the results of queries whose data sources are all
+// resolved to commit objects.  Since commit objects are immutable, such a
+// query always produces the same result and its optimized DAG, which
+// includes the commit IDs, serves as the cache key.  A new commit to a
+// branch changes the DAG of a query that reads the branch, so the cache
+// never needs to be invalidated.
+type resultCache struct {
+	store    cache.Store
+	maxBytes int
+	logger   *zap.Logger
+	hits     prometheus.Counter
+	misses   prometheus.Counter
+}
+
+func newResultCache(conf ResultCacheConfig, logger *zap.Logger, reg prometheus.Registerer) (*resultCache, error) {
+	var store cache.Store
+	switch conf.Kind {
+	case "", cache.KindNone:
+		return nil, nil
+	case cache.KindLocal:
+		var err error
+		if store, err = cache.NewLocalStore(conf.LocalSize); err != nil {
+			return nil, fmt.Errorf("result cache: %w", err)
+		}
+	case cache.KindRedis:
+		client := redis.NewClient(&redis.Options{Addr: conf.RedisAddr})
+		store = cache.NewRedisStore(client, conf.RedisKeyExpiration)
+	default:
+		return nil, fmt.Errorf("unknown result cache kind: %q", conf.Kind)
+	}
+	if conf.MaxBytes <= 0 {
+		return nil, fmt.Errorf("result cache maximum size must be greater than zero: %s", conf.MaxBytes)
+	}
+	factory := promauto.With(reg)
+	return &resultCache{
+		store:    store,
+		maxBytes: int(conf.MaxBytes),
+		logger:   logger,
+		hits: factory.NewCounter(prometheus.CounterOpts{
+			Name: "query_result_cache_hits_total",
+			Help: "Number of queries answered from the result cache.",
+		}),
+		misses: factory.NewCounter(prometheus.CounterOpts{
+			Name: "query_result_cache_misses_total",
+			Help: "Number of cacheable queries not found in the result cache.",
+		}),
+	}, nil
+}
+
+// key returns the cache key for main or false if the results of main cannot
+// be cached.
+func (r *resultCache) key(main *dag.Main) (string, bool) {
+	if r == nil || !isCacheable(main) {
+		return "", false
+	}
+	b, err := json.Marshal(main)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	return "query-result/" + hex.EncodeToString(sum[:]), true
+}
+
+// isCacheable returns true if every data source in main is resolved to a
+// commit object and main has no side effects or time-dependent functions.
+func isCacheable(main *dag.Main) bool {
+	cacheable := true
+	dag.WalkT(reflect.ValueOf(main), func(op dag.Op) dag.Op {
+		switch op := op.(type) {
+		case *dag.CommitMetaScan:
+			cacheable = cacheable && op.Commit != ksuid.Nil
+		case *dag.ListerScan:
+			cacheable = cacheable && op.Commit != ksuid.Nil
+		case *dag.PoolScan:
+			cacheable = cacheable && op.Commit != ksuid.Nil
+		case *dag.SeqScan:
+			cacheable = cacheable && op.Commit != ksuid.Nil
+		case *dag.DBMetaScan, *dag.DeleterScan, *dag.DeleteScan, *dag.FileScan,
+			*dag.HTTPScan, *dag.LoadOp, *dag.PoolMetaScan, *dag.RobotScan:
+			cacheable = false
+		}
+		return op
+	})
+	dag.WalkT(reflect.ValueOf(main), func(e *dag.CallExpr) *dag.CallExpr {
+		if e != nil && (e.Tag == "now" || e.Tag == "ksuid" && len(e.Args) == 0) {
+			cacheable = false
+		}
+		return e
+	})
+	return cacheable
+}
+
+// get returns a scanner for the cached result with key or nil if there is no
+// such result.
+func (r *resultCache) get(ctx context.Context, key string) vio.Scanner {
+	b, ok, err := r.store.Get(ctx, key)
+	if err != nil {
+		r.logger.Warn("Error reading result cache", zap.Error(err))
+	}
+	if !ok {
+		r.misses.Inc()
+		return nil
+	}
+	scanner, err := queryio.NewScanner(ctx, io.NopCloser(bytes.NewReader(b)))
+	if err != nil {
+		r.logger.Warn("Error reading result cache", zap.Error(err))
+		r.misses.Inc()
+		return nil
+	}
+	r.hits.Inc()
+	return scanner
+}
+
+// resultRecorder encodes a query result for storage in a resultCache.
+// Encoding stops once the result grows beyond the cache's size limit.
+type resultRecorder struct {
+	cache  *resultCache
+	key    string
+	buf    bytes.Buffer
+	writer *queryio.Writer
+}
+
+func (r *resultCache) newRecorder(sctx *super.Context, key string) *resultRecorder {
+	rec := &resultRecorder{cache: r, key: key}
+	rec.writer, _ = queryio.NewWriter(sctx, sio.NopCloser(&rec.buf), "bsup", nil, true)
+	return rec
+}
+
+func (r *resultRecorder) writeBatch(label string, batch sbuf.Batch) {
+	if r.writer != nil {
+		// WriteBatch releases a reference to batch once it is serialized,
+		// so take one for it and leave the caller's to the response writer.
+		batch.Ref()
+		r.check(r.writer.WriteBatch(label, batch))
+	}
+}
+
+func (r *resultRecorder) writeChannelEnd(label string) {
+	if r.writer != nil {
+		r.check(r.writer.WhiteChannelEnd(label))
+	}
+}
+
+func (r *resultRecorder) check(err error) {
+	if err != nil || r.buf.Len() > r.cache.maxBytes {
+		r.writer = nil
+		r.buf = bytes.Buffer{}
+	}
+}
+
+// store adds the recorded result to the cache.  It must be called only after
+// the query has run to completion without error.
+func (r *resultRecorder) store(ctx context.Context) {
+	if r.writer == nil {
+		return
+	}
+	r.check(r.writer.Close())
+	if r.writer == nil {
+		return
+	}
+	if err := r.cache.store.Put(ctx, r.key, r.buf.Bytes()); err != nil {
+		r.cache.logger.Warn("Error writing result cache", zap.Error(err))
+	}
+	r.writer = nil
+}
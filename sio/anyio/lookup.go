@@ -9,11 +9,13 @@ import (
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csupio"
 	"github.com/brimdata/super/sio/csvio"
 	"github.com/brimdata/super/sio/jsonio"
 	"github.com/brimdata/super/sio/lineio"
+	"github.com/brimdata/super/sio/orcio"
 	"github.com/brimdata/super/sio/parquetio"
 	"github.com/brimdata/super/sio/supio"
 	"github.com/brimdata/super/sio/zeekio"
@@ -28,6 +30,12 @@ func lookupReader(ctx context.Context, sctx *super.Context, r io.Reader, opts Re
 			return nil, err
 		}
 		return newVioPuller(sctx, r), nil
+	case "avro":
+		r, err := avroio.NewReader(sctx, r)
+		if err != nil {
+			return nil, err
+		}
+		return newVioPuller(sctx, r), nil
 	case "bsup":
 		scanner, err := bsupio.NewReaderWithOpts(sctx, r, opts.BSUP).NewScanner(ctx, opts.Pushdown)
 		if err != nil {
@@ -42,6 +50,8 @@ func lookupReader(ctx context.Context, sctx *super.Context, r io.Reader, opts Re
 		return newVioPuller(sctx, lineio.NewReader(r)), nil
 	case "json":
 		return jsonio.NewReader(context.Background(), sctx, r, opts.Pushdown, opts.ConcurrentReaders), nil
+	case "orc":
+		return orcio.NewReader(ctx, sctx, r, opts.Pushdown, opts.ConcurrentReaders)
 	case "parquet":
 		return parquetio.NewReader(ctx, sctx, r, opts.Pushdown, opts.ConcurrentReaders)
 	case "sup":
@@ -15,10 +15,12 @@ import (
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csupio"
 	"github.com/brimdata/super/sio/csvio"
 	"github.com/brimdata/super/sio/jsonio"
+	"github.com/brimdata/super/sio/orcio"
 	"github.com/brimdata/super/sio/parquetio"
 	"github.com/brimdata/super/sio/supio"
 	"github.com/brimdata/super/sio/zeekio"
@@ -57,6 +59,13 @@ func NewReader(ctx context.Context, sctx *super.Context, r io.Reader, opts Reade
 	parquetErr = fmt.Errorf("parquet: %w", parquetErr)
 	track.Reset()
 
+	orcErr := isORCStream(ctx, track)
+	if orcErr == nil {
+		return orcio.NewReader(ctx, sctx, track.Reader(), opts.Pushdown, opts.ConcurrentReaders)
+	}
+	orcErr = fmt.Errorf("orc: %w", orcErr)
+	track.Reset()
+
 	arrowsErr := isArrowStream(track)
 	if arrowsErr == nil {
 		r, err := arrowio.NewReader(sctx, track.Reader())
@@ -65,6 +74,14 @@ func NewReader(ctx context.Context, sctx *super.Context, r io.Reader, opts Reade
 	arrowsErr = fmt.Errorf("arrows: %w", arrowsErr)
 	track.Reset()
 
+	avroErr := isAvroStream(track)
+	if avroErr == nil {
+		r, err := avroio.NewReader(sctx, track.Reader())
+		return newVioPuller(sctx, r), err
+	}
+	avroErr = fmt.Errorf("avro: %w", avroErr)
+	track.Reset()
+
 	zeekErr := match(zeekio.NewReader(super.NewContext(), track), "zeek", 1)
 	if zeekErr == nil {
 		return newVioPuller(sctx, zeekio.NewReader(sctx, track.Reader())), nil
@@ -120,11 +137,13 @@ func NewReader(ctx context.Context, sctx *super.Context, r io.Reader, opts Reade
 	lineErr := errors.New("line: auto-detection not supported")
 	return nil, joinErrs([]error{
 		arrowsErr,
+		avroErr,
 		bsupErr,
 		csupErr,
 		csvErr,
 		jsonErr,
 		lineErr,
+		orcErr,
 		parquetErr,
 		supErr,
 		tsvErr,
@@ -230,6 +249,43 @@ func isParquetStream(ctx context.Context, track *Track) error {
 	return err
 }
 
+func isORCStream(ctx context.Context, track *Track) error {
+	// An ORC stream starts with a 3-byte magic, "ORC", but as with Parquet
+	// we must read the entire stream to check the file tail.
+	var buf [3]byte
+	if _, err := io.ReadFull(track, buf[:]); err != nil {
+		return err
+	}
+	if string(buf[:]) != "ORC" {
+		return errors.New("invalid header")
+	}
+	if track.recorder != nil {
+		track.Reset()
+		b, err := io.ReadAll(track)
+		if err != nil {
+			return err
+		}
+		*track = *NewTrack(bytes.NewReader(b))
+	}
+	_, err := orcio.NewReader(ctx, super.NewContext(), track.Reader(), nil, 1)
+	return err
+}
+
+func isAvroStream(track *Track) error {
+	// An Avro object container file starts with a 4-byte magic, "Obj\x01",
+	// followed by a header holding the schema.
+	var buf [4]byte
+	if _, err := io.ReadFull(track, buf[:]); err != nil {
+		return err
+	}
+	if string(buf[:]) != "Obj\x01" {
+		return errors.New("invalid header")
+	}
+	track.Reset()
+	_, err := avroio.NewReader(super.NewContext(), track)
+	return err
+}
+
 func joinErrs(errs []error) error {
 	var b strings.Builder
 	b.WriteString("format detection error")
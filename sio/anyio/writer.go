@@ -8,11 +8,13 @@ import (
 	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csvio"
 	"github.com/brimdata/super/sio/dbio"
 	"github.com/brimdata/super/sio/jsonio"
 	"github.com/brimdata/super/sio/lineio"
+	"github.com/brimdata/super/sio/orcio"
 	"github.com/brimdata/super/sio/parquetio"
 	"github.com/brimdata/super/sio/supio"
 	"github.com/brimdata/super/sio/tableio"
@@ -35,6 +37,8 @@ func NewWriter(w io.WriteCloser, opts WriterOpts) (vio.PushCloser, error) {
 	switch opts.Format {
 	case "arrows":
 		return newDefuser(arrowio.NewWriter(w)), nil
+	case "avro":
+		return newDefuser(avroio.NewWriter(w)), nil
 	case "bsup":
 		if opts.BSUP == nil {
 			return bsupio.NewWriter(w), nil
@@ -52,6 +56,8 @@ func NewWriter(w io.WriteCloser, opts WriterOpts) (vio.PushCloser, error) {
 		return newDefuser(lineio.NewWriter(w)), nil
 	case "null":
 		return &nullWriter{}, nil
+	case "orc":
+		return newDefuser(orcio.NewWriter(w)), nil
 	case "parquet":
 		return newDefuser(parquetio.NewWriter(w)), nil
 	case "sup", "":
@@ -138,10 +138,6 @@ var dayTimeIntervalFields = []super.Field{
 	{Name: "days", Type: super.TypeInt32},
 	{Name: "milliseconds", Type: super.TypeUint32},
 }
-var decimal128Fields = []super.Field{
-	{Name: "high", Type: super.TypeInt64},
-	{Name: "low", Type: super.TypeUint64},
-}
 var monthDayNanoIntervalFields = []super.Field{
 	{Name: "month", Type: super.TypeInt32},
 	{Name: "day", Type: super.TypeInt32},
@@ -207,14 +203,9 @@ func (r *Reader) newTypeFromDataType(dt arrow.DataType) (super.Type, error) {
 			return nil, err
 		}
 		return r.sctx.LookupTypeNamed("arrow_day_time_interval", typ)
-	case arrow.DECIMAL128:
-		typ, err := r.sctx.LookupTypeRecord(decimal128Fields)
-		if err != nil {
-			return nil, err
-		}
-		return r.sctx.LookupTypeNamed("arrow_decimal128", typ)
-	case arrow.DECIMAL256:
-		return r.sctx.LookupTypeNamed("arrow_decimal256", r.sctx.LookupTypeArray(super.TypeUint64))
+	case arrow.DECIMAL32, arrow.DECIMAL64, arrow.DECIMAL128, arrow.DECIMAL256:
+		d := dt.(arrow.DecimalType)
+		return r.sctx.LookupTypeDecimal(int(d.GetPrecision()), int(d.GetScale()))
 	case arrow.LIST:
 		typ, err := r.newTypeFromField(dt.(*arrow.ListType).ElemField())
 		if err != nil {
@@ -406,18 +397,14 @@ func (r *Reader) buildScode(typ super.Type, a arrow.Array, i int) error {
 		b.Append(super.EncodeInt(int64(v.Days)))
 		b.Append(super.EncodeInt(int64(v.Milliseconds)))
 		b.EndContainer()
+	case arrow.DECIMAL32:
+		b.Append(super.AppendDecimalInt64(nil, int64(array.NewDecimal32Data(data).Value(i))))
+	case arrow.DECIMAL64:
+		b.Append(super.AppendDecimalInt64(nil, int64(array.NewDecimal64Data(data).Value(i))))
 	case arrow.DECIMAL128:
-		v := array.NewDecimal128Data(data).Value(i)
-		b.BeginContainer()
-		b.Append(super.EncodeInt(v.HighBits()))
-		b.Append(super.EncodeUint(v.LowBits()))
-		b.EndContainer()
+		b.Append(super.EncodeDecimal(array.NewDecimal128Data(data).Value(i).BigInt()))
 	case arrow.DECIMAL256:
-		b.BeginContainer()
-		for _, u := range array.NewDecimal256Data(data).Value(i).Array() {
-			b.Append(super.EncodeUint(u))
-		}
-		b.EndContainer()
+		b.Append(super.EncodeDecimal(array.NewDecimal256Data(data).Value(i).BigInt()))
 	case arrow.LIST:
 		v := array.NewListData(data)
 		start, end := v.ValueOffsets(i)
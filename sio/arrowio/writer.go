@@ -212,6 +212,15 @@ func (w *Writer) newArrowDataType(typ super.Type) (arrow.DataType, error) {
 		return arrow.BinaryTypes.String, nil
 	case *super.TypeOfNull, *super.TypeOfNone:
 		return arrow.Null, nil
+	case *super.TypeDecimal:
+		// Arrow's 32- and 64-bit decimals aren't widely supported
+		// (notably not by Parquet) so use 128 bits for decimal32,
+		// decimal64, and decimal128.
+		precision, scale := int32(typ.Precision), int32(typ.Scale)
+		if typ.Width() == super.IDDecimal256 {
+			return &arrow.Decimal256Type{Precision: precision, Scale: scale}, nil
+		}
+		return &arrow.Decimal128Type{Precision: precision, Scale: scale}, nil
 	case *super.TypeRecord:
 		if len(typ.Fields) == 0 {
 			return nil, fmt.Errorf("%w: empty record", ErrUnsupportedType)
@@ -221,10 +230,6 @@ func (w *Writer) newArrowDataType(typ super.Type) (arrow.DataType, error) {
 			if slices.Equal(typ.Fields, dayTimeIntervalFields) {
 				return arrow.FixedWidthTypes.DayTimeInterval, nil
 			}
-		case "arrow_decimal128":
-			if slices.Equal(typ.Fields, decimal128Fields) {
-				return &arrow.Decimal128Type{}, nil
-			}
 		case "arrow_month_day_nano_interval":
 			if slices.Equal(typ.Fields, monthDayNanoIntervalFields) {
 				return arrow.FixedWidthTypes.MonthDayNanoInterval, nil
@@ -241,9 +246,6 @@ func (w *Writer) newArrowDataType(typ super.Type) (arrow.DataType, error) {
 		return arrow.StructOf(fields...), nil
 	case *super.TypeArray, *super.TypeSet:
 		innerType := super.InnerType(typ)
-		if name == "arrow_decimal256" && innerType == super.TypeUint64 {
-			return &arrow.Decimal256Type{}, nil
-		}
 		field, err := w.newArrowField("", innerType)
 		if err != nil {
 			return nil, err
@@ -430,17 +432,9 @@ func (w *Writer) buildArrowValue(b array.Builder, typ super.Type, bytes scode.By
 			Milliseconds: int32(super.DecodeInt(ms)),
 		})
 	case *array.Decimal128Builder:
-		it := bytes.Iter()
-		high := it.Next()
-		low := it.Next()
-		b.Append(decimal128.New(super.DecodeInt(high), super.DecodeUint(low)))
+		b.Append(decimal128.FromBigInt(super.DecodeDecimal(bytes)))
 	case *array.Decimal256Builder:
-		it := bytes.Iter()
-		x4 := super.DecodeUint(it.Next())
-		x3 := super.DecodeUint(it.Next())
-		x2 := super.DecodeUint(it.Next())
-		x1 := super.DecodeUint(it.Next())
-		b.Append(decimal256.New(x1, x2, x3, x4))
+		b.Append(decimal256.FromBigInt(super.DecodeDecimal(bytes)))
 	case *array.ListBuilder:
 		w.buildArrowListValue(b, typ, bytes)
 	case *array.StructBuilder:
@@ -0,0 +1,459 @@
+package avroio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sio"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+var magic = []byte("Obj\x01")
+
+const (
+	syncSize = 16
+	// maxBlockSize limits memory consumed by a corrupt block header.
+	maxBlockSize = 1 << 30
+)
+
+// Reader is a sio.Reader for the Avro object container file format.
+type Reader struct {
+	sctx   *super.Context
+	r      *bufio.Reader
+	schema *schema
+	types  *typeMapper
+	typ    super.Type
+	codec  string
+	sync   [syncSize]byte
+
+	block []byte
+	count int64
+	zstd  *zstd.Decoder
+
+	builder scode.Builder
+	val     super.Value
+}
+
+var _ sio.Typer = (*Reader)(nil)
+
+func NewReader(sctx *super.Context, r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	var header [4]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil || !bytes.Equal(header[:], magic) {
+		return nil, errors.New("invalid header")
+	}
+	meta, err := readMetadata(br)
+	if err != nil {
+		return nil, err
+	}
+	schemaJSON, ok := meta["avro.schema"]
+	if !ok {
+		return nil, errors.New("missing avro.schema metadata")
+	}
+	schema, err := parseSchema(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+	ar := &Reader{
+		sctx:   sctx,
+		r:      br,
+		schema: schema,
+		types:  newTypeMapper(sctx),
+		codec:  string(meta["avro.codec"]),
+	}
+	switch ar.codec {
+	case "":
+		ar.codec = "null"
+	case "null", "deflate", "snappy":
+	case "zstandard":
+		if ar.zstd, err = zstd.NewReader(nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Avro codec %q", ar.codec)
+	}
+	if _, err := io.ReadFull(br, ar.sync[:]); err != nil {
+		return nil, fmt.Errorf("reading sync marker: %w", noEOF(err))
+	}
+	if ar.typ, err = ar.types.lookup(schema); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+func readMetadata(r *bufio.Reader) (map[string][]byte, error) {
+	meta := map[string][]byte{}
+	for {
+		n, err := readBlockCount(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading metadata: %w", err)
+		}
+		if n == 0 {
+			return meta, nil
+		}
+		for range n {
+			key, err := readBytes(r)
+			if err != nil {
+				return nil, fmt.Errorf("reading metadata: %w", err)
+			}
+			val, err := readBytes(r)
+			if err != nil {
+				return nil, fmt.Errorf("reading metadata: %w", err)
+			}
+			meta[string(key)] = val
+		}
+	}
+}
+
+func readBlockCount(r *bufio.Reader) (int64, error) {
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return 0, noEOF(err)
+	}
+	if n < 0 {
+		// A negative count is followed by the block size in bytes.
+		if _, err := binary.ReadVarint(r); err != nil {
+			return 0, noEOF(err)
+		}
+		n = -n
+	}
+	return n, nil
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	if n < 0 || n > maxBlockSize {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, noEOF(err)
+	}
+	return b, nil
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (r *Reader) Type() (super.Type, error) {
+	return r.typ, nil
+}
+
+func (r *Reader) Close() error {
+	if r.zstd != nil {
+		r.zstd.Close()
+	}
+	return nil
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	for r.count == 0 {
+		if len(r.block) > 0 {
+			return nil, errors.New("Avro block has extra data")
+		}
+		if err := r.readBlock(); err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+	r.builder.Truncate()
+	rest, err := r.decode(r.schema, r.typ, r.block)
+	if err != nil {
+		return nil, err
+	}
+	r.block = rest
+	r.count--
+	r.val = super.NewValue(r.typ, r.builder.Bytes().Body())
+	return &r.val, nil
+}
+
+func (r *Reader) readBlock() error {
+	count, err := binary.ReadVarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return fmt.Errorf("reading Avro block: %w", err)
+	}
+	size, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return fmt.Errorf("reading Avro block: %w", noEOF(err))
+	}
+	if count < 0 || size < 0 || size > maxBlockSize {
+		return errors.New("invalid Avro block header")
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return fmt.Errorf("reading Avro block: %w", noEOF(err))
+	}
+	var sync [syncSize]byte
+	if _, err := io.ReadFull(r.r, sync[:]); err != nil {
+		return fmt.Errorf("reading Avro block: %w", noEOF(err))
+	}
+	if sync != r.sync {
+		return errors.New("Avro sync marker mismatch")
+	}
+	if r.block, err = r.decompress(data); err != nil {
+		return fmt.Errorf("decompressing Avro block: %w", err)
+	}
+	r.count = count
+	return nil
+}
+
+func (r *Reader) decompress(b []byte) ([]byte, error) {
+	switch r.codec {
+	case "deflate":
+		return io.ReadAll(flate.NewReader(bytes.NewReader(b)))
+	case "snappy":
+		// The compressed data is followed by a CRC-32 checksum of the
+		// uncompressed data.
+		if len(b) < 4 {
+			return nil, errors.New("truncated snappy block")
+		}
+		out, err := snappy.Decode(nil, b[:len(b)-4])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(b[len(b)-4:]) {
+			return nil, errors.New("snappy checksum mismatch")
+		}
+		return out, nil
+	case "zstandard":
+		return r.zstd.DecodeAll(b, nil)
+	}
+	return b, nil
+}
+
+var errTruncated = errors.New("truncated Avro value")
+
+// decode decodes the value for s from b, appending it to r.builder, and
+// returns the remainder of b.
+func (r *Reader) decode(s *schema, typ super.Type, b []byte) ([]byte, error) {
+	switch s.kind {
+	case "null":
+		r.builder.Append(nil)
+		return b, nil
+	case "boolean":
+		if len(b) < 1 {
+			return nil, errTruncated
+		}
+		r.builder.Append(super.EncodeBool(b[0] != 0))
+		return b[1:], nil
+	case "int", "long":
+		v, b, err := decodeLong(b)
+		if err != nil {
+			return nil, err
+		}
+		switch typ {
+		case super.TypeTime:
+			r.builder.Append(super.EncodeTime(timestamp(s.logical, v)))
+		case super.TypeDuration:
+			unit := time.Millisecond
+			if s.logical == "time-micros" {
+				unit = time.Microsecond
+			}
+			r.builder.Append(super.EncodeDuration(nano.Duration(v * int64(unit))))
+		default:
+			r.builder.Append(super.EncodeInt(v))
+		}
+		return b, nil
+	case "float":
+		if len(b) < 4 {
+			return nil, errTruncated
+		}
+		r.builder.Append(super.EncodeFloat32(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+		return b[4:], nil
+	case "double":
+		if len(b) < 8 {
+			return nil, errTruncated
+		}
+		r.builder.Append(super.EncodeFloat64(math.Float64frombits(binary.LittleEndian.Uint64(b))))
+		return b[8:], nil
+	case "bytes", "string":
+		v, b, err := decodeBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		r.appendBytes(typ, v)
+		return b, nil
+	case "fixed":
+		if len(b) < s.size {
+			return nil, errTruncated
+		}
+		if s.logical == "uuid" && typ == super.TypeString {
+			r.builder.Append(super.EncodeString(formatUUID(b[:s.size])))
+		} else {
+			r.appendBytes(typ, b[:s.size])
+		}
+		return b[s.size:], nil
+	case "enum":
+		v, b, err := decodeLong(b)
+		if err != nil {
+			return nil, err
+		}
+		if v < 0 || v >= int64(len(s.symbols)) {
+			return nil, fmt.Errorf("Avro enum index %d out of range", v)
+		}
+		r.builder.Append(super.EncodeUint(uint64(v)))
+		return b, nil
+	case "record":
+		recType := super.TypeRecordOf(typ)
+		r.builder.BeginContainer()
+		for k, f := range s.fields {
+			var err error
+			if b, err = r.decode(f.schema, recType.Fields[k].Type, b); err != nil {
+				return nil, err
+			}
+		}
+		r.builder.EndContainer()
+		return b, nil
+	case "array", "map":
+		var inner super.Type
+		if s.kind == "array" {
+			inner = super.InnerType(typ)
+		} else {
+			inner = super.TypeUnder(typ).(*super.TypeMap).ValType
+		}
+		r.builder.BeginContainer()
+		for {
+			n, rest, err := decodeBlockCount(b)
+			if err != nil {
+				return nil, err
+			}
+			b = rest
+			if n == 0 {
+				break
+			}
+			for range n {
+				if s.kind == "map" {
+					key, rest, err := decodeBytes(b)
+					if err != nil {
+						return nil, err
+					}
+					r.builder.Append(key)
+					b = rest
+				}
+				if b, err = r.decode(s.items, inner, b); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if s.kind == "map" {
+			r.builder.TransformContainer(super.NormalizeMap)
+		}
+		r.builder.EndContainer()
+		return b, nil
+	case "union":
+		v, b, err := decodeLong(b)
+		if err != nil {
+			return nil, err
+		}
+		if v < 0 || v >= int64(len(s.branches)) {
+			return nil, fmt.Errorf("Avro union index %d out of range", v)
+		}
+		branch := s.branches[v]
+		union, ok := super.TypeUnder(typ).(*super.TypeUnion)
+		if !ok {
+			// All branches map to the same type.
+			return r.decode(branch, typ, b)
+		}
+		tag := r.types.unionTags[s][v]
+		super.BeginUnion(&r.builder, tag)
+		b, err = r.decode(branch, union.Types[tag], b)
+		if err != nil {
+			return nil, err
+		}
+		r.builder.EndContainer()
+		return b, nil
+	}
+	return nil, fmt.Errorf("unsupported Avro type %q", s.kind)
+}
+
+func (r *Reader) appendBytes(typ super.Type, b []byte) {
+	if _, ok := typ.(*super.TypeDecimal); ok {
+		r.builder.Append(super.EncodeDecimal(twosComplement(b)))
+		return
+	}
+	r.builder.Append(b)
+}
+
+func timestamp(logical string, v int64) nano.Ts {
+	switch logical {
+	case "date":
+		return nano.Ts(v * int64(24*time.Hour))
+	case "timestamp-millis", "local-timestamp-millis":
+		return nano.Ts(v * int64(time.Millisecond))
+	case "timestamp-micros", "local-timestamp-micros":
+		return nano.Ts(v * int64(time.Microsecond))
+	}
+	return nano.Ts(v)
+}
+
+// twosComplement interprets b as a big-endian two's-complement integer.
+func twosComplement(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return v
+}
+
+func formatUUID(b []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func decodeLong(b []byte) (int64, []byte, error) {
+	v, n := binary.Varint(b)
+	if n <= 0 {
+		return 0, nil, errTruncated
+	}
+	return v, b[n:], nil
+}
+
+func decodeBytes(b []byte) ([]byte, []byte, error) {
+	n, b, err := decodeLong(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n < 0 || n > int64(len(b)) {
+		return nil, nil, errTruncated
+	}
+	return b[:n], b[n:], nil
+}
+
+func decodeBlockCount(b []byte) (int64, []byte, error) {
+	n, b, err := decodeLong(b)
+	if err != nil {
+		return 0, nil, err
+	}
+	if n < 0 {
+		// A negative count is followed by the block size in bytes.
+		if _, b, err = decodeLong(b); err != nil {
+			return 0, nil, err
+		}
+		n = -n
+	}
+	return n, b, nil
+}
@@ -0,0 +1,121 @@
+package avroio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"math/big"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "type": "record",
+  "name": "Event",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+    {"name": "key", "type": {"type": "fixed", "name": "UUID", "size": 16, "logicalType": "uuid"}},
+    {"name": "ts", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+    {"name": "ms", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "day", "type": {"type": "int", "logicalType": "date"}},
+    {"name": "tod", "type": {"type": "int", "logicalType": "time-millis"}},
+    {"name": "amount", "type": {"type": "fixed", "name": "Amount", "size": 4, "logicalType": "decimal", "precision": 9, "scale": 2}},
+    {"name": "color", "type": {"type": "enum", "name": "Color", "symbols": ["RED", "GREEN"]}},
+    {"name": "other", "type": "Color"},
+    {"name": "attrs", "type": {"type": "map", "values": "long"}},
+    {"name": "note", "type": ["null", "string"]},
+    {"name": "raw", "type": "com.example.UUID"},
+    {"name": "f", "type": "float"}
+  ]
+}`
+
+func TestReaderSnappy(t *testing.T) {
+	var rec []byte
+	rec = appendBytes(rec, []byte("123e4567-e89b-12d3-a456-426614174000"))
+	key := []byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+	rec = append(rec, key...)
+	rec = binary.AppendVarint(rec, 1_700_000_000_123_456)
+	rec = binary.AppendVarint(rec, 1_700_000_000_123)
+	rec = binary.AppendVarint(rec, 19000)
+	rec = binary.AppendVarint(rec, 3_600_000)
+	rec = append(rec, 0xff, 0xff, 0xff, 0x85) // -123
+	rec = binary.AppendVarint(rec, 1)
+	rec = binary.AppendVarint(rec, 0)
+	// A map block with a negative count followed by its size.
+	var entries []byte
+	entries = appendBytes(entries, []byte("b"))
+	entries = binary.AppendVarint(entries, 2)
+	entries = appendBytes(entries, []byte("a"))
+	entries = binary.AppendVarint(entries, -1)
+	rec = binary.AppendVarint(rec, -2)
+	rec = binary.AppendVarint(rec, int64(len(entries)))
+	rec = append(rec, entries...)
+	rec = binary.AppendVarint(rec, 0)
+	rec = binary.AppendVarint(rec, 1)
+	rec = appendBytes(rec, []byte("hello"))
+	rec = append(rec, key...)
+	rec = append(rec, 0, 0, 0xc0, 0x3f) // 1.5
+	// Second record with a null note.
+	rec2 := bytes.Clone(rec)
+	i := bytes.Index(rec2, []byte("\x02\x0ahello"))
+	rec2 = append(append(rec2[:i:i], 0), rec2[i+7:]...)
+
+	sync := bytes.Repeat([]byte{0xab}, syncSize)
+	var b []byte
+	b = append(b, magic...)
+	b = binary.AppendVarint(b, 2)
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, []byte(testSchema))
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte("snappy"))
+	b = binary.AppendVarint(b, 0)
+	b = append(b, sync...)
+	for count, data := range [][]byte{rec, append(bytes.Clone(rec), rec2...)} {
+		block := snappy.Encode(nil, data)
+		block = binary.BigEndian.AppendUint32(block, crc32.ChecksumIEEE(data))
+		b = binary.AppendVarint(b, int64(count+1))
+		b = binary.AppendVarint(b, int64(len(block)))
+		b = append(append(b, block...), sync...)
+	}
+
+	r, err := NewReader(super.NewContext(), bytes.NewReader(b))
+	require.NoError(t, err)
+	typ, err := r.Type()
+	require.NoError(t, err)
+	require.Equal(t, "{id:string,key:string,ts:time,ms:time,day:time,tod:duration,amount:decimal(9,2),color:enum(RED,GREEN),other:enum(RED,GREEN),attrs:map{string:int64},note:string|null,raw:string,f:float32}", sup.FormatType(typ))
+	const expected = `{id:"123e4567-e89b-12d3-a456-426614174000",key:"123e4567-e89b-12d3-a456-426614174000",ts:2023-11-14T22:13:20.123456Z,ms:2023-11-14T22:13:20.123Z,day:2022-01-08T00:00:00Z,tod:1h,amount:-1.23::decimal(9,2),color:"GREEN"::enum(RED,GREEN),other:"RED"::enum(RED,GREEN),attrs:map{"a":-1,"b":2},note:"hello"::(string|null),raw:"123e4567-e89b-12d3-a456-426614174000",f:1.5::float32}`
+	var vals []string
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			break
+		}
+		vals = append(vals, sup.FormatValue(*val))
+	}
+	require.Len(t, vals, 3)
+	require.Equal(t, expected, vals[0])
+	require.Equal(t, expected, vals[1])
+	require.Contains(t, vals[2], `note:null::(string|null)`)
+}
+
+func TestRecursiveSchema(t *testing.T) {
+	s, err := parseSchema([]byte(`{"type":"record","name":"Node","fields":[{"name":"next","type":["null","Node"]}]}`))
+	require.NoError(t, err)
+	_, err = newTypeMapper(super.NewContext()).lookup(s)
+	require.EqualError(t, err, "recursive Avro type Node is not supported")
+}
+
+func TestTwosComplement(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 127, 128, -128, -129, 255, 1 << 40, -(1 << 40)} {
+		b := twosComplementBytes(big.NewInt(v))
+		require.Equal(t, v, twosComplement(b).Int64(), "%d", v)
+	}
+	require.Equal(t, []byte{0x80}, twosComplementBytes(big.NewInt(-128)))
+	require.Equal(t, []byte{0x00, 0x80}, twosComplementBytes(big.NewInt(128)))
+}
@@ -0,0 +1,296 @@
+package avroio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+)
+
+// schema is a parsed Avro schema.  Named types referenced by name are
+// resolved to the same *schema.
+type schema struct {
+	kind     string // Primitive type name, "record", "enum", "array", "map", "fixed", or "union"
+	logical  string
+	name     string // Full name of a named type
+	fields   []schemaField
+	symbols  []string
+	items    *schema // Items of an array or values of a map
+	size     int     // Size of a fixed
+	branches []*schema
+	// Precision and scale of a decimal logical type
+	precision int
+	scale     int
+}
+
+type schemaField struct {
+	name   string
+	schema *schema
+}
+
+var primitives = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+func parseSchema(b []byte) (*schema, error) {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+	p := &schemaParser{named: map[string]*schema{}}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	named map[string]*schema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*schema, error) {
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(primitives, v) {
+			return &schema{kind: v}, nil
+		}
+		if s, ok := p.named[fullName(v, namespace)]; ok {
+			return s, nil
+		}
+		if s, ok := p.named[v]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("unknown Avro type %q", v)
+	case []any:
+		s := &schema{kind: "union"}
+		for _, branch := range v {
+			b, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			if b.kind == "union" {
+				return nil, errors.New("Avro union may not immediately contain a union")
+			}
+			s.branches = append(s.branches, b)
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseObject(v, namespace)
+	}
+	return nil, fmt.Errorf("invalid Avro schema: %v", v)
+}
+
+func (p *schemaParser) parseObject(obj map[string]any, namespace string) (*schema, error) {
+	kind, _ := obj["type"].(string)
+	if kind == "" {
+		// A type such as {"type": {"type": "array", ...}}.
+		if t, ok := obj["type"]; ok {
+			return p.parse(t, namespace)
+		}
+		return nil, errors.New("Avro schema object is missing \"type\"")
+	}
+	logical, _ := obj["logicalType"].(string)
+	s := &schema{kind: kind, logical: logical}
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		name, _ := obj["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("Avro %s is missing a name", kind)
+		}
+		if ns, ok := obj["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		s.name = fullName(name, namespace)
+		if i := strings.LastIndexByte(s.name, '.'); i >= 0 {
+			namespace = s.name[:i]
+		}
+		p.named[s.name] = s
+	}
+	switch kind {
+	case "record", "error":
+		s.kind = "record"
+		fields, _ := obj["fields"].([]any)
+		for _, f := range fields {
+			f, ok := f.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid field in Avro record %s", s.name)
+			}
+			name, _ := f["name"].(string)
+			fs, err := p.parse(f["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.fields = append(s.fields, schemaField{name, fs})
+		}
+	case "enum":
+		symbols, _ := obj["symbols"].([]any)
+		for _, sym := range symbols {
+			sym, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid symbol in Avro enum %s", s.name)
+			}
+			s.symbols = append(s.symbols, sym)
+		}
+	case "array", "map":
+		key := "items"
+		if kind == "map" {
+			key = "values"
+		}
+		items, err := p.parse(obj[key], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = items
+	case "fixed":
+		size, ok := obj["size"].(float64)
+		if !ok || size < 0 {
+			return nil, fmt.Errorf("invalid size for Avro fixed %s", s.name)
+		}
+		s.size = int(size)
+	default:
+		if !slices.Contains(primitives, kind) {
+			return p.parse(kind, namespace)
+		}
+	}
+	if logical == "decimal" {
+		precision, _ := obj["precision"].(float64)
+		scale, _ := obj["scale"].(float64)
+		s.precision, s.scale = int(precision), int(scale)
+	}
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// typeMapper maps Avro schemas to super types.
+type typeMapper struct {
+	sctx    *super.Context
+	types   map[*schema]super.Type
+	visited map[*schema]bool
+	// unionTags maps the branch index of an Avro union to a union tag.
+	unionTags map[*schema][]int
+}
+
+func newTypeMapper(sctx *super.Context) *typeMapper {
+	return &typeMapper{
+		sctx:      sctx,
+		types:     map[*schema]super.Type{},
+		visited:   map[*schema]bool{},
+		unionTags: map[*schema][]int{},
+	}
+}
+
+func (m *typeMapper) lookup(s *schema) (super.Type, error) {
+	if typ, ok := m.types[s]; ok {
+		return typ, nil
+	}
+	if m.visited[s] {
+		return nil, fmt.Errorf("recursive Avro type %s is not supported", s.name)
+	}
+	m.visited[s] = true
+	defer delete(m.visited, s)
+	typ, err := m.newType(s)
+	if err != nil {
+		return nil, err
+	}
+	m.types[s] = typ
+	return typ, nil
+}
+
+func (m *typeMapper) newType(s *schema) (super.Type, error) {
+	switch s.logical {
+	case "date", "timestamp-millis", "timestamp-micros", "timestamp-nanos",
+		"local-timestamp-millis", "local-timestamp-micros", "local-timestamp-nanos":
+		if s.kind == "int" || s.kind == "long" {
+			return super.TypeTime, nil
+		}
+	case "time-millis", "time-micros":
+		if s.kind == "int" || s.kind == "long" {
+			return super.TypeDuration, nil
+		}
+	case "decimal":
+		if s.kind == "bytes" || s.kind == "fixed" {
+			typ, err := m.sctx.LookupTypeDecimal(s.precision, s.scale)
+			if err != nil {
+				return nil, fmt.Errorf("Avro decimal: %w", err)
+			}
+			return typ, nil
+		}
+	case "uuid":
+		if s.kind == "string" || s.kind == "fixed" && s.size == 16 {
+			return super.TypeString, nil
+		}
+	}
+	switch s.kind {
+	case "null":
+		return super.TypeNull, nil
+	case "boolean":
+		return super.TypeBool, nil
+	case "int":
+		return super.TypeInt32, nil
+	case "long":
+		return super.TypeInt64, nil
+	case "float":
+		return super.TypeFloat32, nil
+	case "double":
+		return super.TypeFloat64, nil
+	case "bytes", "fixed":
+		return super.TypeBytes, nil
+	case "string":
+		return super.TypeString, nil
+	case "record":
+		fields := make([]super.Field, 0, len(s.fields))
+		for _, f := range s.fields {
+			typ, err := m.lookup(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, super.NewField(f.name, typ))
+		}
+		return m.sctx.LookupTypeRecord(fields)
+	case "enum":
+		return m.sctx.LookupTypeEnum(s.symbols), nil
+	case "array":
+		typ, err := m.lookup(s.items)
+		if err != nil {
+			return nil, err
+		}
+		return m.sctx.LookupTypeArray(typ), nil
+	case "map":
+		typ, err := m.lookup(s.items)
+		if err != nil {
+			return nil, err
+		}
+		return m.sctx.LookupTypeMap(super.TypeString, typ), nil
+	case "union":
+		var types []super.Type
+		for _, b := range s.branches {
+			typ, err := m.lookup(b)
+			if err != nil {
+				return nil, err
+			}
+			// Distinct Avro types may map to the same super type.
+			if !slices.Contains(types, typ) {
+				types = append(types, typ)
+			}
+		}
+		if len(types) == 1 {
+			m.unionTags[s] = make([]int, len(s.branches))
+			return types[0], nil
+		}
+		union, ok := m.sctx.LookupTypeUnion(slices.Clone(types))
+		if !ok {
+			return nil, errors.New("invalid Avro union")
+		}
+		tags := make([]int, len(s.branches))
+		for i, b := range s.branches {
+			tags[i] = union.TagOf(m.types[b])
+		}
+		m.unionTags[s] = tags
+		return union, nil
+	}
+	return nil, fmt.Errorf("unsupported Avro type %q", s.kind)
+}
@@ -0,0 +1,414 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+)
+
+var (
+	ErrMultipleTypes   = errors.New("avroio: encountered multiple types (consider 'blend')")
+	ErrNotRecord       = errors.New("avroio: not a record")
+	ErrUnsupportedType = errors.New("avroio: unsupported type")
+)
+
+// blockSize is the approximate uncompressed size at which a block is
+// flushed.
+const blockSize = 64 * 1024
+
+var nameRegexp = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// Writer is a sio.Writer for the Avro object container file format.  It
+// requires that all values be records of the same type.  Blocks are written
+// with deflate compression.
+type Writer struct {
+	w     io.WriteCloser
+	typ   *super.TypeRecord
+	sync  [syncSize]byte
+	block []byte
+	count int64
+	buf   bytes.Buffer
+	flate *flate.Writer
+
+	// names holds the Avro name of each record and enum type.
+	names map[super.Type]string
+	// unionBranches maps each union tag to an Avro union branch.
+	unionBranches map[*super.TypeUnion][]int64
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		w:             w,
+		names:         map[super.Type]string{},
+		unionBranches: map[*super.TypeUnion][]int64{},
+	}
+}
+
+func (w *Writer) Push(vec vector.Any) error {
+	return sbuf.WriteVec(w, vec)
+}
+
+func (w *Writer) Write(val super.Value) error {
+	recType, ok := super.TypeUnder(val.Type()).(*super.TypeRecord)
+	if !ok || val.IsNull() {
+		return fmt.Errorf("%w: %s", ErrNotRecord, sup.FormatValue(val))
+	}
+	if w.typ == nil {
+		if err := w.writeHeader(recType); err != nil {
+			return err
+		}
+		w.typ = recType
+	} else if w.typ != recType {
+		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, sup.FormatType(w.typ), sup.FormatType(recType))
+	}
+	var err error
+	if w.block, err = w.encode(w.block, recType, val.Bytes()); err != nil {
+		return err
+	}
+	w.count++
+	if len(w.block) >= blockSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *Writer) Close() error {
+	var err error
+	if w.count > 0 {
+		err = w.flush()
+	}
+	if err2 := w.w.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+func (w *Writer) writeHeader(typ *super.TypeRecord) error {
+	s, err := w.newSchema(typ, map[string]struct{}{})
+	if err != nil {
+		return err
+	}
+	schemaJSON, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if _, err := rand.Read(w.sync[:]); err != nil {
+		return err
+	}
+	b := append([]byte(nil), magic...)
+	b = binary.AppendVarint(b, 2)
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte("deflate"))
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, schemaJSON)
+	b = binary.AppendVarint(b, 0)
+	b = append(b, w.sync[:]...)
+	_, err = w.w.Write(b)
+	return err
+}
+
+func (w *Writer) flush() error {
+	w.buf.Reset()
+	if w.flate == nil {
+		var err error
+		if w.flate, err = flate.NewWriter(&w.buf, flate.DefaultCompression); err != nil {
+			return err
+		}
+	} else {
+		w.flate.Reset(&w.buf)
+	}
+	if _, err := w.flate.Write(w.block); err != nil {
+		return err
+	}
+	if err := w.flate.Close(); err != nil {
+		return err
+	}
+	b := binary.AppendVarint(nil, w.count)
+	b = binary.AppendVarint(b, int64(w.buf.Len()))
+	b = append(append(b, w.buf.Bytes()...), w.sync[:]...)
+	w.block = w.block[:0]
+	w.count = 0
+	_, err := w.w.Write(b)
+	return err
+}
+
+type recordSchema struct {
+	Type   string        `json:"type"`
+	Name   string        `json:"name"`
+	Fields []fieldSchema `json:"fields"`
+}
+
+type fieldSchema struct {
+	Name string `json:"name"`
+	Type any    `json:"type"`
+}
+
+type enumSchema struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Symbols []string `json:"symbols"`
+}
+
+type arraySchema struct {
+	Type  string `json:"type"`
+	Items any    `json:"items"`
+}
+
+type mapSchema struct {
+	Type   string `json:"type"`
+	Values any    `json:"values"`
+}
+
+type logicalSchema struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+	Precision   int    `json:"precision,omitempty"`
+	Scale       int    `json:"scale,omitempty"`
+}
+
+func (w *Writer) newSchema(typ super.Type, seen map[string]struct{}) (any, error) {
+	if named, ok := typ.(*super.TypeNamed); ok {
+		if _, ok := seen[named.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+		}
+		seen[named.Name] = struct{}{}
+		defer delete(seen, named.Name)
+		return w.newSchema(named.Type, seen)
+	}
+	if name, ok := w.names[typ]; ok {
+		// Avro permits only one definition of a named type.
+		return name, nil
+	}
+	switch typ := typ.(type) {
+	case *super.TypeOfNull, *super.TypeOfNone:
+		return "null", nil
+	case *super.TypeOfBool:
+		return "boolean", nil
+	case *super.TypeOfInt8, *super.TypeOfInt16, *super.TypeOfInt32, *super.TypeOfUint8, *super.TypeOfUint16:
+		return "int", nil
+	case *super.TypeOfInt64, *super.TypeOfUint32:
+		return "long", nil
+	case *super.TypeOfUint64:
+		return logicalSchema{Type: "bytes", LogicalType: "decimal", Precision: 20}, nil
+	case *super.TypeOfFloat16, *super.TypeOfFloat32:
+		return "float", nil
+	case *super.TypeOfFloat64:
+		return "double", nil
+	case *super.TypeOfString, *super.TypeOfIP, *super.TypeOfNet, *super.TypeOfType, *super.TypeError:
+		return "string", nil
+	case *super.TypeOfBytes:
+		return "bytes", nil
+	case *super.TypeOfTime:
+		return logicalSchema{Type: "long", LogicalType: "timestamp-nanos"}, nil
+	case *super.TypeDecimal:
+		return logicalSchema{Type: "bytes", LogicalType: "decimal", Precision: typ.Precision, Scale: typ.Scale}, nil
+	case *super.TypeRecord:
+		s := recordSchema{Type: "record", Name: "record" + strconv.Itoa(len(w.names)), Fields: []fieldSchema{}}
+		w.names[typ] = s.Name
+		for _, f := range typ.Fields {
+			if !nameRegexp.MatchString(f.Name) {
+				return nil, fmt.Errorf("%w: invalid Avro field name %q", ErrUnsupportedType, f.Name)
+			}
+			fs, err := w.newSchema(f.Type, seen)
+			if err != nil {
+				return nil, err
+			}
+			s.Fields = append(s.Fields, fieldSchema{f.Name, fs})
+		}
+		return s, nil
+	case *super.TypeEnum:
+		for _, sym := range typ.Symbols {
+			if !nameRegexp.MatchString(sym) {
+				return nil, fmt.Errorf("%w: invalid Avro enum symbol %q", ErrUnsupportedType, sym)
+			}
+		}
+		s := enumSchema{Type: "enum", Name: "enum" + strconv.Itoa(len(w.names)), Symbols: typ.Symbols}
+		w.names[typ] = s.Name
+		return s, nil
+	case *super.TypeArray, *super.TypeSet:
+		items, err := w.newSchema(super.InnerType(typ), seen)
+		if err != nil {
+			return nil, err
+		}
+		return arraySchema{Type: "array", Items: items}, nil
+	case *super.TypeMap:
+		if super.TypeUnder(typ.KeyType) != super.TypeString {
+			return nil, fmt.Errorf("%w: %s (Avro map keys must be strings)", ErrUnsupportedType, sup.FormatType(typ))
+		}
+		values, err := w.newSchema(typ.ValType, seen)
+		if err != nil {
+			return nil, err
+		}
+		return mapSchema{Type: "map", Values: values}, nil
+	case *super.TypeUnion:
+		var branches []any
+		keys := map[string]int64{}
+		tags := make([]int64, len(typ.Types))
+		for tag, t := range typ.Types {
+			s, err := w.newSchema(t, seen)
+			if err != nil {
+				return nil, err
+			}
+			key := branchKey(s)
+			branch, ok := keys[key]
+			if !ok {
+				branch = int64(len(branches))
+				keys[key] = branch
+				branches = append(branches, s)
+			} else if key != "null" {
+				// Avro unions may not contain more than one
+				// schema of the same type except for null.
+				return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+			}
+			tags[tag] = branch
+		}
+		w.unionBranches[typ] = tags
+		return branches, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+}
+
+// branchKey returns the name that identifies s within an Avro union.
+func branchKey(s any) string {
+	switch s := s.(type) {
+	case string:
+		return s
+	case recordSchema:
+		return s.Name
+	case enumSchema:
+		return s.Name
+	case arraySchema:
+		return s.Type
+	case mapSchema:
+		return s.Type
+	case logicalSchema:
+		return s.Type
+	}
+	panic(s)
+}
+
+func (w *Writer) encode(b []byte, typ super.Type, bytes scode.Bytes) ([]byte, error) {
+	typ = super.TypeUnder(typ)
+	if bytes == nil && typ != super.TypeNull && typ != super.TypeNone {
+		return nil, fmt.Errorf("%w: null %s", ErrUnsupportedType, sup.FormatType(typ))
+	}
+	switch typ := typ.(type) {
+	case *super.TypeOfNull, *super.TypeOfNone:
+		return b, nil
+	case *super.TypeOfBool:
+		if super.DecodeBool(bytes) {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case *super.TypeOfInt8, *super.TypeOfInt16, *super.TypeOfInt32, *super.TypeOfInt64:
+		return binary.AppendVarint(b, super.DecodeInt(bytes)), nil
+	case *super.TypeOfUint8, *super.TypeOfUint16, *super.TypeOfUint32:
+		return binary.AppendVarint(b, int64(super.DecodeUint(bytes))), nil
+	case *super.TypeOfUint64:
+		return appendBytes(b, twosComplementBytes(new(big.Int).SetUint64(super.DecodeUint(bytes)))), nil
+	case *super.TypeOfFloat16:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(super.DecodeFloat16(bytes))), nil
+	case *super.TypeOfFloat32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(super.DecodeFloat32(bytes))), nil
+	case *super.TypeOfFloat64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(super.DecodeFloat64(bytes))), nil
+	case *super.TypeOfString, *super.TypeOfBytes:
+		return appendBytes(b, bytes), nil
+	case *super.TypeOfIP:
+		return appendBytes(b, []byte(super.DecodeIP(bytes).String())), nil
+	case *super.TypeOfNet:
+		return appendBytes(b, []byte(super.DecodeNet(bytes).String())), nil
+	case *super.TypeOfType:
+		return appendBytes(b, []byte(sup.FormatTypeValue(bytes))), nil
+	case *super.TypeError:
+		return appendBytes(b, []byte(sup.FormatValue(super.NewValue(typ, bytes)))), nil
+	case *super.TypeOfTime:
+		return binary.AppendVarint(b, int64(super.DecodeTime(bytes))), nil
+	case *super.TypeDecimal:
+		return appendBytes(b, twosComplementBytes(super.DecodeDecimal(bytes))), nil
+	case *super.TypeEnum:
+		return binary.AppendVarint(b, int64(super.DecodeUint(bytes))), nil
+	case *super.TypeRecord:
+		it := bytes.Iter()
+		for _, f := range typ.Fields {
+			var err error
+			if b, err = w.encode(b, f.Type, it.Next()); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case *super.TypeArray, *super.TypeSet:
+		inner := super.InnerType(typ)
+		var n int64
+		var items []byte
+		for it := bytes.Iter(); !it.Done(); n++ {
+			var err error
+			if items, err = w.encode(items, inner, it.Next()); err != nil {
+				return nil, err
+			}
+		}
+		return appendBlock(b, n, items), nil
+	case *super.TypeMap:
+		var n int64
+		var items []byte
+		for it := bytes.Iter(); !it.Done(); n++ {
+			items = appendBytes(items, it.Next())
+			var err error
+			if items, err = w.encode(items, typ.ValType, it.Next()); err != nil {
+				return nil, err
+			}
+		}
+		return appendBlock(b, n, items), nil
+	case *super.TypeUnion:
+		it := bytes.Iter()
+		tag := super.DecodeUint(it.Next())
+		b = binary.AppendVarint(b, w.unionBranches[typ][tag])
+		return w.encode(b, typ.Types[tag], it.Next())
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+}
+
+// appendBlock appends an array or map with n items encoded in items as a
+// single block followed by the terminating empty block.
+func appendBlock(b []byte, n int64, items []byte) []byte {
+	if n > 0 {
+		b = binary.AppendVarint(b, n)
+		b = append(b, items...)
+	}
+	return binary.AppendVarint(b, 0)
+}
+
+func appendBytes(b, v []byte) []byte {
+	b = binary.AppendVarint(b, int64(len(v)))
+	return append(b, v...)
+}
+
+// twosComplementBytes returns the minimal big-endian two's-complement
+// representation of v.
+func twosComplementBytes(v *big.Int) []byte {
+	if v.Sign() >= 0 {
+		b := v.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// Add 2^(8n) for the smallest n that holds v.
+	n := (new(big.Int).Not(v).BitLen() + 8) / 8
+	return new(big.Int).Add(v, new(big.Int).Lsh(big.NewInt(1), uint(n*8))).Bytes()
+}
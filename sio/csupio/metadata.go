@@ -0,0 +1,38 @@
+package csupio
+
+import (
+	"io"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/csup"
+)
+
+// ReadMetadata returns the number of values in the CSUP objects read from r
+// along with the metadata of each object as computed by
+// csup.Object.ProjectMetadata for an empty projection, i.e., records
+// holding the minimum and maximum value of each leaf field.  Only the
+// headers and metadata sections of the objects are read.
+func ReadMetadata(sctx *super.Context, r io.ReaderAt) (uint64, []super.Value, error) {
+	var count uint64
+	var vals []super.Value
+	var off int64
+	for {
+		section, err := csup.ReadSection(io.NewSectionReader(r, off, math.MaxInt64))
+		if err != nil {
+			if err == io.EOF {
+				return count, vals, nil
+			}
+			return 0, nil, err
+		}
+		if section.Type == csup.SectionObject {
+			o, err := csup.NewObjectFromHeader(io.NewSectionReader(r, off, math.MaxInt64), section.Object)
+			if err != nil {
+				return 0, nil, err
+			}
+			count += uint64(o.Context().Lookup(o.Root()).Len(o.Context()))
+			vals = append(vals, o.ProjectMetadata(sctx, nil)...)
+		}
+		off += int64(section.Size())
+	}
+}
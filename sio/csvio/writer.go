@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/scode"
@@ -118,6 +119,9 @@ func formatValue(typ super.Type, bytes scode.Bytes) string {
 	if typ.ID() < super.IDTypeComplex {
 		return sup.FormatPrimitive(super.TypeUnder(typ), bytes)
 	}
+	if typ, ok := typ.(*super.TypeDecimal); ok {
+		return decimal.Format(super.DecodeDecimal(bytes), typ.Scale)
+	}
 	return sup.FormatValue(super.NewValue(typ, bytes))
 }
 
@@ -117,6 +117,10 @@ func formatPoolConfig(b *bytes.Buffer, p *pools.Config) {
 	b.WriteString(p.SortKeys.Primary().Key.String())
 	b.WriteString(" order ")
 	b.WriteString(p.SortKeys.Primary().Order.String())
+	if !p.Retention.IsZero() {
+		b.WriteString(" retain ")
+		b.WriteString(p.Retention.String())
+	}
 	b.WriteByte('\n')
 }
 
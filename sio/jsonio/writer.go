@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/terminal/color"
 	"github.com/brimdata/super/sbuf"
@@ -89,6 +90,9 @@ func (w *Writer) writeAny(tab int, val super.Value) {
 		w.writeEnum(typ, val.Bytes())
 	case *super.TypeError:
 		w.writeError(tab, typ, val.Bytes())
+	case *super.TypeDecimal:
+		b := decimal.Append(nil, super.DecodeDecimal(val.Bytes()), typ.Scale)
+		w.writeColor(b, numberColor)
 	default:
 		panic(fmt.Sprintf("unsupported type: %s", sup.FormatType(typ)))
 	}
@@ -220,6 +224,9 @@ func (w *Writer) writePrimitive(val super.Value) {
 		v = nano.Duration(val.Int()).String()
 	case id == super.IDTime:
 		v = nano.Ts(val.Int()).Time().Format(time.RFC3339Nano)
+	case super.IsBigInt(id):
+		w.writeColor(val.BigInt().Append(nil, 10), numberColor)
+		return
 	case super.IsSigned(id):
 		v, c = val.Int(), numberColor
 	case super.IsUnsigned(id):
@@ -0,0 +1,107 @@
+package orcio
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// zstdDecoder is shared since DecodeAll may be called concurrently.
+var zstdDecoder, _ = zstd.NewReader(nil)
+
+// decompress decodes a compressed ORC stream, which is a sequence of chunks
+// each having a 3-byte header holding the chunk length and a flag
+// indicating whether the chunk is stored uncompressed.
+func decompress(kind compressionKind, blockSize uint64, b []byte) ([]byte, error) {
+	if kind == compressionNone {
+		return b, nil
+	}
+	var out []byte
+	for len(b) > 0 {
+		if len(b) < 3 {
+			return nil, errors.New("truncated compression chunk header")
+		}
+		header := uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+		n := int(header >> 1)
+		b = b[3:]
+		if n > len(b) {
+			return nil, errors.New("truncated compression chunk")
+		}
+		chunk := b[:n]
+		b = b[n:]
+		if header&1 == 1 {
+			out = append(out, chunk...)
+			continue
+		}
+		var err error
+		switch kind {
+		case compressionZlib:
+			var buf []byte
+			buf, err = io.ReadAll(flate.NewReader(bytes.NewReader(chunk)))
+			out = append(out, buf...)
+		case compressionSnappy:
+			var buf []byte
+			buf, err = snappy.Decode(nil, chunk)
+			out = append(out, buf...)
+		case compressionLZ4:
+			buf := make([]byte, max(blockSize, 256*1024))
+			var m int
+			m, err = lz4.UncompressBlock(chunk, buf)
+			out = append(out, buf[:m]...)
+		case compressionZstd:
+			out, err = zstdDecoder.DecodeAll(chunk, out)
+		default:
+			return nil, fmt.Errorf("unsupported compression kind %d", kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// compressor compresses ORC streams with zlib.
+type compressor struct {
+	blockSize int
+	buf       bytes.Buffer
+	fw        *flate.Writer
+}
+
+func newCompressor(blockSize int) *compressor {
+	fw, _ := flate.NewWriter(nil, flate.DefaultCompression)
+	return &compressor{blockSize: blockSize, fw: fw}
+}
+
+// compress appends the compressed form of b to dst.
+func (c *compressor) compress(dst, b []byte) []byte {
+	for len(b) > 0 {
+		chunk := b[:min(len(b), c.blockSize)]
+		b = b[len(chunk):]
+		c.buf.Reset()
+		c.fw.Reset(&c.buf)
+		c.fw.Write(chunk)
+		c.fw.Close()
+		if c.buf.Len() < len(chunk) {
+			dst = appendChunkHeader(dst, c.buf.Len(), false)
+			dst = append(dst, c.buf.Bytes()...)
+		} else {
+			dst = appendChunkHeader(dst, len(chunk), true)
+			dst = append(dst, chunk...)
+		}
+	}
+	return dst
+}
+
+func appendChunkHeader(dst []byte, n int, original bool) []byte {
+	header := uint32(n) << 1
+	if original {
+		header |= 1
+	}
+	return append(dst, byte(header), byte(header>>8), byte(header>>16))
+}
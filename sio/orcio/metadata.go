@@ -0,0 +1,68 @@
+package orcio
+
+import (
+	"slices"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/sup"
+)
+
+func buildMetadataValue(sctx *super.Context, stats []*columnStatistics, cols []*column) super.Value {
+	var paths field.List
+	var vals []super.Value
+	m := sup.NewBSUPMarshaler()
+	for _, c := range cols {
+		if int(c.id) >= len(stats) {
+			continue
+		}
+		min, max, ok := columnStats(stats[c.id], c.orc.kind)
+		if !ok {
+			continue
+		}
+		minVal, err := m.Marshal(min)
+		if err != nil {
+			panic(err)
+		}
+		maxVal, err := m.Marshal(max)
+		if err != nil {
+			panic(err)
+		}
+		paths = append(paths, append(slices.Clone(c.path), "min"), append(slices.Clone(c.path), "max"))
+		vals = append(vals, minVal, maxVal)
+	}
+	b, err := super.NewRecordBuilder(sctx, paths)
+	if err != nil {
+		panic(err)
+	}
+	var types []super.Type
+	for _, val := range vals {
+		types = append(types, val.Type())
+		b.Append(val.Bytes())
+	}
+	bytes, err := b.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return super.NewValue(b.Type(types), bytes)
+}
+
+func columnStats(cs *columnStatistics, kind typeKind) (min, max any, ok bool) {
+	switch {
+	case cs.ints != nil:
+		return cs.ints.min, cs.ints.max, true
+	case cs.doubles != nil:
+		return cs.doubles.min, cs.doubles.max, true
+	case cs.strings != nil && kind != kindChar:
+		// Skip CHAR since its values are padded.
+		return cs.strings.min, cs.strings.max, true
+	case cs.dates != nil:
+		return nano.Ts(cs.dates.min * int64(24*time.Hour)), nano.Ts(cs.dates.max * int64(24*time.Hour)), true
+	case cs.timestamps != nil:
+		// The maximum is truncated to milliseconds so round it up.
+		return nano.Ts(cs.timestamps.min * int64(time.Millisecond)), nano.Ts((cs.timestamps.max+1)*int64(time.Millisecond) - 1), true
+	}
+	return nil, nil, false
+}
@@ -0,0 +1,616 @@
+package orcio
+
+import (
+	"errors"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The ORC file tail and stripe footers are protocol buffers messages defined
+// by orc_proto.proto in the ORC project.  Only the messages and fields used
+// by this package are implemented here.
+
+var errBadProto = errors.New("malformed protocol buffers message")
+
+type compressionKind uint64
+
+const (
+	compressionNone compressionKind = iota
+	compressionZlib
+	compressionSnappy
+	compressionLZO
+	compressionLZ4
+	compressionZstd
+)
+
+type typeKind uint64
+
+const (
+	kindBoolean typeKind = iota
+	kindByte
+	kindShort
+	kindInt
+	kindLong
+	kindFloat
+	kindDouble
+	kindString
+	kindBinary
+	kindTimestamp
+	kindList
+	kindMap
+	kindStruct
+	kindUnion
+	kindDecimal
+	kindDate
+	kindVarchar
+	kindChar
+	kindTimestampInstant
+)
+
+type streamKind uint64
+
+const (
+	streamPresent streamKind = iota
+	streamData
+	streamLength
+	streamDictionaryData
+	streamDictionaryCount
+	streamSecondary
+	streamRowIndex
+	streamBloomFilter
+	streamBloomFilterUTF8
+)
+
+type encodingKind uint64
+
+const (
+	encodingDirect encodingKind = iota
+	encodingDictionary
+	encodingDirectV2
+	encodingDictionaryV2
+)
+
+type postScript struct {
+	footerLength         uint64
+	compression          compressionKind
+	compressionBlockSize uint64
+	version              []uint32
+	metadataLength       uint64
+	writerVersion        uint32
+	magic                string
+}
+
+type footer struct {
+	headerLength   uint64
+	contentLength  uint64
+	stripes        []*stripeInformation
+	types          []*orcType
+	numberOfRows   uint64
+	statistics     []*columnStatistics
+	rowIndexStride uint32
+	writer         uint32
+}
+
+type stripeInformation struct {
+	offset       uint64
+	indexLength  uint64
+	dataLength   uint64
+	footerLength uint64
+	numberOfRows uint64
+}
+
+type orcType struct {
+	kind          typeKind
+	subtypes      []uint32
+	fieldNames    []string
+	maximumLength uint32
+	precision     uint32
+	scale         uint32
+}
+
+type stripeFooter struct {
+	streams        []*stream
+	columns        []*columnEncoding
+	writerTimezone string
+}
+
+type stream struct {
+	kind   streamKind
+	column uint32
+	length uint64
+}
+
+type columnEncoding struct {
+	kind           encodingKind
+	dictionarySize uint32
+}
+
+type metadata struct {
+	stripeStats [][]*columnStatistics
+}
+
+// columnStatistics holds the statistics for a column.  Of the type-specific
+// statistics, at most one is set.
+type columnStatistics struct {
+	numberOfValues uint64
+	hasNull        bool
+	ints           *minMax[int64]
+	doubles        *minMax[float64]
+	strings        *minMax[string]
+	dates          *minMax[int64]
+	timestamps     *minMax[int64]
+}
+
+type minMax[T any] struct {
+	min, max T
+}
+
+type protoField struct {
+	num   protowire.Number
+	typ   protowire.Type
+	u64   uint64
+	bytes []byte
+}
+
+// forEachField calls fn for each field of the message encoded in b.
+func forEachField(b []byte, fn func(*protoField) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errBadProto
+		}
+		b = b[n:]
+		f := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.u64, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.u64 = uint64(v)
+		case protowire.Fixed64Type:
+			f.u64, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return errBadProto
+		}
+		b = b[n:]
+		if err := fn(&f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uint32s returns the values of a repeated uint32 field, which may be packed.
+func (f *protoField) uint32s() ([]uint32, error) {
+	if f.typ != protowire.BytesType {
+		return []uint32{uint32(f.u64)}, nil
+	}
+	var vals []uint32
+	for b := f.bytes; len(b) > 0; {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, errBadProto
+		}
+		vals = append(vals, uint32(v))
+		b = b[n:]
+	}
+	return vals, nil
+}
+
+func (f *protoField) sint64() int64 {
+	return protowire.DecodeZigZag(f.u64)
+}
+
+func (f *protoField) float64() float64 {
+	return math.Float64frombits(f.u64)
+}
+
+func decodePostScript(b []byte) (*postScript, error) {
+	var ps postScript
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			ps.footerLength = f.u64
+		case 2:
+			ps.compression = compressionKind(f.u64)
+		case 3:
+			ps.compressionBlockSize = f.u64
+		case 4:
+			vals, err := f.uint32s()
+			if err != nil {
+				return err
+			}
+			ps.version = append(ps.version, vals...)
+		case 5:
+			ps.metadataLength = f.u64
+		case 6:
+			ps.writerVersion = uint32(f.u64)
+		case 8000:
+			ps.magic = string(f.bytes)
+		}
+		return nil
+	})
+	return &ps, err
+}
+
+func decodeFooter(b []byte) (*footer, error) {
+	var ft footer
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			ft.headerLength = f.u64
+		case 2:
+			ft.contentLength = f.u64
+		case 3:
+			si, err := decodeStripeInformation(f.bytes)
+			if err != nil {
+				return err
+			}
+			ft.stripes = append(ft.stripes, si)
+		case 4:
+			typ, err := decodeType(f.bytes)
+			if err != nil {
+				return err
+			}
+			ft.types = append(ft.types, typ)
+		case 6:
+			ft.numberOfRows = f.u64
+		case 7:
+			stats, err := decodeColumnStatistics(f.bytes)
+			if err != nil {
+				return err
+			}
+			ft.statistics = append(ft.statistics, stats)
+		case 8:
+			ft.rowIndexStride = uint32(f.u64)
+		case 9:
+			ft.writer = uint32(f.u64)
+		}
+		return nil
+	})
+	return &ft, err
+}
+
+func decodeStripeInformation(b []byte) (*stripeInformation, error) {
+	var si stripeInformation
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			si.offset = f.u64
+		case 2:
+			si.indexLength = f.u64
+		case 3:
+			si.dataLength = f.u64
+		case 4:
+			si.footerLength = f.u64
+		case 5:
+			si.numberOfRows = f.u64
+		}
+		return nil
+	})
+	return &si, err
+}
+
+func decodeType(b []byte) (*orcType, error) {
+	var t orcType
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			t.kind = typeKind(f.u64)
+		case 2:
+			vals, err := f.uint32s()
+			if err != nil {
+				return err
+			}
+			t.subtypes = append(t.subtypes, vals...)
+		case 3:
+			t.fieldNames = append(t.fieldNames, string(f.bytes))
+		case 4:
+			t.maximumLength = uint32(f.u64)
+		case 5:
+			t.precision = uint32(f.u64)
+		case 6:
+			t.scale = uint32(f.u64)
+		}
+		return nil
+	})
+	return &t, err
+}
+
+func decodeStripeFooter(b []byte) (*stripeFooter, error) {
+	var sf stripeFooter
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			var s stream
+			err := forEachField(f.bytes, func(f *protoField) error {
+				switch f.num {
+				case 1:
+					s.kind = streamKind(f.u64)
+				case 2:
+					s.column = uint32(f.u64)
+				case 3:
+					s.length = f.u64
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.streams = append(sf.streams, &s)
+		case 2:
+			var e columnEncoding
+			err := forEachField(f.bytes, func(f *protoField) error {
+				switch f.num {
+				case 1:
+					e.kind = encodingKind(f.u64)
+				case 2:
+					e.dictionarySize = uint32(f.u64)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.columns = append(sf.columns, &e)
+		case 3:
+			sf.writerTimezone = string(f.bytes)
+		}
+		return nil
+	})
+	return &sf, err
+}
+
+func decodeMetadata(b []byte) (*metadata, error) {
+	var md metadata
+	err := forEachField(b, func(f *protoField) error {
+		if f.num != 1 {
+			return nil
+		}
+		var stats []*columnStatistics
+		err := forEachField(f.bytes, func(f *protoField) error {
+			if f.num != 1 {
+				return nil
+			}
+			s, err := decodeColumnStatistics(f.bytes)
+			if err != nil {
+				return err
+			}
+			stats = append(stats, s)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		md.stripeStats = append(md.stripeStats, stats)
+		return nil
+	})
+	return &md, err
+}
+
+func decodeColumnStatistics(b []byte) (*columnStatistics, error) {
+	// Writers predating the hasNull field may have written nulls.
+	cs := columnStatistics{hasNull: true}
+	err := forEachField(b, func(f *protoField) error {
+		var err error
+		switch f.num {
+		case 1:
+			cs.numberOfValues = f.u64
+		case 2:
+			cs.ints, err = decodeMinMax(f.bytes, (*protoField).sint64)
+		case 3:
+			cs.doubles, err = decodeMinMax(f.bytes, (*protoField).float64)
+		case 4:
+			cs.strings, err = decodeMinMax(f.bytes, func(f *protoField) string { return string(f.bytes) })
+		case 7:
+			cs.dates, err = decodeMinMax(f.bytes, (*protoField).sint64)
+		case 9:
+			// Use the UTC minimum and maximum (fields 3 and 4) in
+			// milliseconds since they do not depend on a time zone.
+			var mm minMax[int64]
+			var hasMin, hasMax bool
+			err = forEachField(f.bytes, func(f *protoField) error {
+				switch f.num {
+				case 3:
+					mm.min, hasMin = f.sint64(), true
+				case 4:
+					mm.max, hasMax = f.sint64(), true
+				}
+				return nil
+			})
+			if hasMin && hasMax {
+				cs.timestamps = &mm
+			}
+		case 10:
+			cs.hasNull = f.u64 != 0
+		}
+		return err
+	})
+	return &cs, err
+}
+
+// decodeMinMax decodes the minimum and maximum (fields 1 and 2) of a
+// type-specific statistics message.  It returns nil if either is missing.
+func decodeMinMax[T any](b []byte, value func(*protoField) T) (*minMax[T], error) {
+	var mm minMax[T]
+	var hasMin, hasMax bool
+	err := forEachField(b, func(f *protoField) error {
+		switch f.num {
+		case 1:
+			mm.min, hasMin = value(f), true
+		case 2:
+			mm.max, hasMax = value(f), true
+		}
+		return nil
+	})
+	if err != nil || !hasMin || !hasMax {
+		return nil, err
+	}
+	return &mm, nil
+}
+
+// protoBuilder encodes a protocol buffers message.
+type protoBuilder struct {
+	b []byte
+}
+
+func (p *protoBuilder) uint(num protowire.Number, v uint64) {
+	p.b = protowire.AppendTag(p.b, num, protowire.VarintType)
+	p.b = protowire.AppendVarint(p.b, v)
+}
+
+func (p *protoBuilder) sint(num protowire.Number, v int64) {
+	p.uint(num, protowire.EncodeZigZag(v))
+}
+
+func (p *protoBuilder) double(num protowire.Number, v float64) {
+	p.b = protowire.AppendTag(p.b, num, protowire.Fixed64Type)
+	p.b = protowire.AppendFixed64(p.b, math.Float64bits(v))
+}
+
+func (p *protoBuilder) bytes(num protowire.Number, b []byte) {
+	p.b = protowire.AppendTag(p.b, num, protowire.BytesType)
+	p.b = protowire.AppendBytes(p.b, b)
+}
+
+func (p *protoBuilder) packed(num protowire.Number, vals []uint32) {
+	var b []byte
+	for _, v := range vals {
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	p.bytes(num, b)
+}
+
+func (ps *postScript) encode() []byte {
+	var p protoBuilder
+	p.uint(1, ps.footerLength)
+	p.uint(2, uint64(ps.compression))
+	p.uint(3, ps.compressionBlockSize)
+	p.packed(4, ps.version)
+	p.uint(5, ps.metadataLength)
+	p.uint(6, uint64(ps.writerVersion))
+	p.bytes(8000, []byte(ps.magic))
+	return p.b
+}
+
+func (ft *footer) encode() []byte {
+	var p protoBuilder
+	p.uint(1, ft.headerLength)
+	p.uint(2, ft.contentLength)
+	for _, si := range ft.stripes {
+		var s protoBuilder
+		s.uint(1, si.offset)
+		s.uint(2, si.indexLength)
+		s.uint(3, si.dataLength)
+		s.uint(4, si.footerLength)
+		s.uint(5, si.numberOfRows)
+		p.bytes(3, s.b)
+	}
+	for _, t := range ft.types {
+		var s protoBuilder
+		s.uint(1, uint64(t.kind))
+		if len(t.subtypes) > 0 {
+			s.packed(2, t.subtypes)
+		}
+		for _, name := range t.fieldNames {
+			s.bytes(3, []byte(name))
+		}
+		if t.kind == kindDecimal {
+			s.uint(5, uint64(t.precision))
+			s.uint(6, uint64(t.scale))
+		}
+		p.bytes(4, s.b)
+	}
+	p.uint(6, ft.numberOfRows)
+	for _, cs := range ft.statistics {
+		p.bytes(7, cs.encode())
+	}
+	p.uint(8, uint64(ft.rowIndexStride))
+	p.uint(9, uint64(ft.writer))
+	return p.b
+}
+
+func (sf *stripeFooter) encode() []byte {
+	var p protoBuilder
+	for _, s := range sf.streams {
+		var b protoBuilder
+		b.uint(1, uint64(s.kind))
+		b.uint(2, uint64(s.column))
+		b.uint(3, s.length)
+		p.bytes(1, b.b)
+	}
+	for _, e := range sf.columns {
+		var b protoBuilder
+		b.uint(1, uint64(e.kind))
+		if e.kind == encodingDictionary || e.kind == encodingDictionaryV2 {
+			b.uint(2, uint64(e.dictionarySize))
+		}
+		p.bytes(2, b.b)
+	}
+	if sf.writerTimezone != "" {
+		p.bytes(3, []byte(sf.writerTimezone))
+	}
+	return p.b
+}
+
+func (md *metadata) encode() []byte {
+	var p protoBuilder
+	for _, stats := range md.stripeStats {
+		var b protoBuilder
+		for _, cs := range stats {
+			b.bytes(1, cs.encode())
+		}
+		p.bytes(1, b.b)
+	}
+	return p.b
+}
+
+func (cs *columnStatistics) encode() []byte {
+	var p protoBuilder
+	p.uint(1, cs.numberOfValues)
+	if mm := cs.ints; mm != nil {
+		var b protoBuilder
+		b.sint(1, mm.min)
+		b.sint(2, mm.max)
+		p.bytes(2, b.b)
+	}
+	if mm := cs.doubles; mm != nil {
+		var b protoBuilder
+		b.double(1, mm.min)
+		b.double(2, mm.max)
+		p.bytes(3, b.b)
+	}
+	if mm := cs.strings; mm != nil {
+		var b protoBuilder
+		b.bytes(1, []byte(mm.min))
+		b.bytes(2, []byte(mm.max))
+		p.bytes(4, b.b)
+	}
+	if mm := cs.dates; mm != nil {
+		var b protoBuilder
+		b.sint(1, mm.min)
+		b.sint(2, mm.max)
+		p.bytes(7, b.b)
+	}
+	if mm := cs.timestamps; mm != nil {
+		var b protoBuilder
+		b.sint(1, mm.min)
+		b.sint(2, mm.max)
+		b.sint(3, mm.min)
+		b.sint(4, mm.max)
+		p.bytes(9, b.b)
+	}
+	var hasNull uint64
+	if cs.hasNull {
+		hasNull = 1
+	}
+	p.uint(10, hasNull)
+	return p.b
+}
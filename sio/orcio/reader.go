@@ -0,0 +1,803 @@
+package orcio
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/vector"
+)
+
+//lint:ignore ST1005 ORC should be capitalized
+var errNotSeekable = errors.New("ORC format requires seekable input")
+
+const (
+	magic = "ORC"
+	// batchSize is the maximum length of a vector returned by Pull.
+	batchSize = 16384
+	// timestampBase is the epoch of ORC timestamps, 2015-01-01 00:00:00 UTC,
+	// in seconds since the Unix epoch.
+	timestampBase = 1420070400
+)
+
+type Reader struct {
+	ctx  context.Context
+	sctx *super.Context
+	r    io.ReaderAt
+
+	ps   *postScript
+	ft   *footer
+	root *column
+
+	metadata        *metadata
+	metadataColumns []*column
+	metadataFilters []expr.Evaluator
+
+	nextStripe *atomic.Int64
+	batches    []batch
+}
+
+// batch holds the unreturned portion of a decoded stripe.
+type batch struct {
+	vec vector.Any
+	off uint32
+}
+
+// column is a node in the projected column tree of an ORC file.
+type column struct {
+	id       uint32
+	orc      *orcType
+	path     field.Path
+	typ      super.Type // Type of non-null values
+	nullable bool
+	children []*column
+}
+
+func NewReader(ctx context.Context, sctx *super.Context, r io.Reader, p sbuf.Pushdown, concurrentReaders int) (*Reader, error) {
+	if concurrentReaders < 1 {
+		panic(concurrentReaders)
+	}
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		return nil, errNotSeekable
+	}
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		return nil, errNotSeekable
+	}
+	size, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	ps, ft, md, err := readTail(ra, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(ft.types) == 0 || ft.types[0].kind != kindStruct {
+		return nil, errors.New("ORC file schema is not a struct")
+	}
+	reader := &Reader{
+		ctx:        ctx,
+		sctx:       sctx,
+		r:          ra,
+		ps:         ps,
+		ft:         ft,
+		nextStripe: &atomic.Int64{},
+		batches:    make([]batch, concurrentReaders),
+	}
+	var proj field.Projection
+	if p != nil {
+		proj = p.Projection()
+	}
+	if reader.root, err = reader.newColumn(0, nil, proj, false); err != nil {
+		return nil, err
+	}
+	if p != nil {
+		filter, projection, err := p.MetaFilter()
+		if err != nil {
+			return nil, err
+		}
+		if filter != nil && md != nil {
+			for _, path := range projection.Paths() {
+				// Trim trailing "max" or "min".
+				path = path[:len(path)-1]
+				if c := reader.lookupColumn(path); c != nil && !slices.Contains(reader.metadataColumns, c) {
+					reader.metadataColumns = append(reader.metadataColumns, c)
+				}
+			}
+			for range concurrentReaders {
+				filter, _, err := p.MetaFilter()
+				if err != nil {
+					return nil, err
+				}
+				reader.metadataFilters = append(reader.metadataFilters, filter)
+			}
+			reader.metadata = md
+		}
+	}
+	return reader, nil
+}
+
+// readTail reads the postscript, footer, and metadata at the end of an
+// ORC file.
+func readTail(r io.ReaderAt, size int64) (*postScript, *footer, *metadata, error) {
+	n := min(size, 256)
+	buf := make([]byte, n)
+	if _, err := r.ReadAt(buf, size-n); err != nil {
+		return nil, nil, nil, err
+	}
+	psLen := int64(buf[n-1])
+	if psLen+1 > n {
+		return nil, nil, nil, errors.New("ORC postscript truncated")
+	}
+	ps, err := decodePostScript(buf[n-1-psLen : n-1])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if ps.magic != magic {
+		return nil, nil, nil, errors.New("ORC postscript magic not found")
+	}
+	if ps.compression == compressionLZO {
+		return nil, nil, nil, errors.New("ORC LZO compression is not supported")
+	}
+	footerOff := size - 1 - psLen - int64(ps.footerLength)
+	metadataOff := footerOff - int64(ps.metadataLength)
+	if metadataOff < 0 {
+		return nil, nil, nil, errors.New("ORC file tail truncated")
+	}
+	buf = make([]byte, size-1-psLen-metadataOff)
+	if _, err := r.ReadAt(buf, metadataOff); err != nil {
+		return nil, nil, nil, err
+	}
+	b, err := decompress(ps.compression, ps.compressionBlockSize, buf[ps.metadataLength:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ft, err := decodeFooter(b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var md *metadata
+	if ps.metadataLength > 0 {
+		b, err := decompress(ps.compression, ps.compressionBlockSize, buf[:ps.metadataLength])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if md, err = decodeMetadata(b); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return ps, ft, md, nil
+}
+
+// newColumn creates the column tree rooted at the column with the given
+// ID, pruning struct fields not in proj unless proj is empty.
+func (r *Reader) newColumn(id uint32, path field.Path, proj field.Projection, nullable bool) (*column, error) {
+	if int(id) >= len(r.ft.types) {
+		return nil, fmt.Errorf("ORC column %d out of range", id)
+	}
+	c := &column{id: id, orc: r.ft.types[id], path: path, nullable: nullable}
+	var err error
+	switch c.orc.kind {
+	case kindStruct:
+		if len(c.orc.fieldNames) != len(c.orc.subtypes) {
+			return nil, fmt.Errorf("ORC struct column %d has mismatched field names", id)
+		}
+		for i, name := range c.orc.fieldNames {
+			var childProj field.Projection
+			if len(proj) > 0 {
+				k := slices.IndexFunc(proj, func(n field.ProjectionNode) bool { return n.Name == name })
+				if k < 0 {
+					continue
+				}
+				childProj = proj[k].Proj
+			}
+			child, err := r.newChild(c.orc.subtypes[i], append(slices.Clone(path), name), childProj)
+			if err != nil {
+				return nil, err
+			}
+			c.children = append(c.children, child)
+		}
+	case kindList, kindMap, kindUnion:
+		for _, subtype := range c.orc.subtypes {
+			child, err := r.newChild(subtype, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			if c.orc.kind == kindUnion {
+				// Union members may not themselves be unions so the
+				// null of a union is a member of the union itself.
+				child.nullable = false
+			}
+			c.children = append(c.children, child)
+		}
+	}
+	if c.typ, err = r.newType(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *Reader) newChild(id uint32, path field.Path, proj field.Projection) (*column, error) {
+	nullable := true
+	if int(id) < len(r.ft.statistics) {
+		nullable = r.ft.statistics[id].hasNull
+	}
+	return r.newColumn(id, path, proj, nullable)
+}
+
+func (r *Reader) newType(c *column) (super.Type, error) {
+	switch c.orc.kind {
+	case kindBoolean:
+		return super.TypeBool, nil
+	case kindByte:
+		return super.TypeInt8, nil
+	case kindShort:
+		return super.TypeInt16, nil
+	case kindInt:
+		return super.TypeInt32, nil
+	case kindLong:
+		return super.TypeInt64, nil
+	case kindFloat:
+		return super.TypeFloat32, nil
+	case kindDouble:
+		return super.TypeFloat64, nil
+	case kindString, kindVarchar, kindChar:
+		return super.TypeString, nil
+	case kindBinary:
+		return super.TypeBytes, nil
+	case kindTimestamp, kindTimestampInstant, kindDate:
+		return super.TypeTime, nil
+	case kindDecimal:
+		precision, scale := decimalPrecisionAndScale(c.orc)
+		return r.sctx.LookupTypeDecimal(precision, scale)
+	case kindList:
+		if len(c.children) != 1 {
+			return nil, fmt.Errorf("ORC list column %d must have one subtype", c.id)
+		}
+		return r.sctx.LookupTypeArray(c.children[0].valueType(r.sctx)), nil
+	case kindMap:
+		if len(c.children) != 2 {
+			return nil, fmt.Errorf("ORC map column %d must have two subtypes", c.id)
+		}
+		return r.sctx.LookupTypeMap(c.children[0].valueType(r.sctx), c.children[1].valueType(r.sctx)), nil
+	case kindStruct:
+		fields := make([]super.Field, 0, len(c.children))
+		for _, child := range c.children {
+			fields = append(fields, super.NewField(child.path[len(child.path)-1], child.valueType(r.sctx)))
+		}
+		arrowio.UniquifyFieldNames(fields)
+		return r.sctx.LookupTypeRecord(fields)
+	case kindUnion:
+		var types []super.Type
+		for _, child := range c.children {
+			if slices.Contains(types, child.typ) {
+				return nil, fmt.Errorf("ORC union column %d has duplicate types", c.id)
+			}
+			types = append(types, child.typ)
+		}
+		if c.nullable {
+			types = append(types, super.TypeNull)
+		}
+		typ, ok := r.sctx.LookupTypeUnion(types)
+		if !ok {
+			return nil, fmt.Errorf("ORC union column %d has a union member", c.id)
+		}
+		return typ, nil
+	}
+	return nil, fmt.Errorf("unsupported ORC type kind %d", c.orc.kind)
+}
+
+func decimalPrecisionAndScale(t *orcType) (int, int) {
+	if t.precision == 0 {
+		// Hive 0.11 decimals lack a precision and scale.
+		return 38, 18
+	}
+	return int(t.precision), int(t.scale)
+}
+
+// valueType returns the type of the column's values including null.
+func (c *column) valueType(sctx *super.Context) super.Type {
+	if !c.nullable || c.orc.kind == kindUnion {
+		return c.typ
+	}
+	return sctx.MustLookupTypeUnion([]super.Type{c.typ, super.TypeNull})
+}
+
+func (r *Reader) lookupColumn(path field.Path) *column {
+	c := r.root
+	for _, name := range path {
+		i := slices.IndexFunc(c.children, func(child *column) bool {
+			return child.path[len(child.path)-1] == name
+		})
+		if c.orc.kind != kindStruct || i < 0 {
+			return nil
+		}
+		c = c.children[i]
+	}
+	return c
+}
+
+func (r *Reader) Pull(done bool) (vector.Any, error) {
+	return r.ConcurrentPull(done, 0)
+}
+
+func (r *Reader) ConcurrentPull(done bool, id int) (vector.Any, error) {
+	if done {
+		return nil, nil
+	}
+	b := &r.batches[id]
+	for b.vec == nil {
+		if err := r.ctx.Err(); err != nil {
+			return nil, err
+		}
+		n := int(r.nextStripe.Add(1) - 1)
+		if n >= len(r.ft.stripes) {
+			return nil, nil
+		}
+		if len(r.metadataFilters) > 0 && n < len(r.metadata.stripeStats) {
+			val := buildMetadataValue(r.sctx, r.metadata.stripeStats[n], r.metadataColumns)
+			if r.metadataFilters[id].Eval(val).Equal(super.False) {
+				continue
+			}
+		}
+		vec, err := r.readStripe(r.ft.stripes[n])
+		if err != nil {
+			return nil, err
+		}
+		if vec.Len() > 0 {
+			b.vec, b.off = vec, 0
+		}
+	}
+	vec := b.vec
+	if n := vec.Len() - b.off; b.off > 0 || n > batchSize {
+		n = min(n, batchSize)
+		index := make([]uint32, n)
+		for i := range index {
+			index[i] = b.off + uint32(i)
+		}
+		vec = vector.Pick(vec, index)
+		b.off += n
+	} else {
+		b.off += n
+	}
+	if b.off >= b.vec.Len() {
+		b.vec = nil
+	}
+	return vec, nil
+}
+
+func (r *Reader) Type() (super.Type, error) {
+	return r.root.typ, nil
+}
+
+type streamKey struct {
+	column uint32
+	kind   streamKind
+}
+
+// stripe holds the decompressed streams of a stripe needed to decode the
+// projected columns.
+type stripe struct {
+	r         *Reader
+	streams   map[streamKey][]byte
+	encodings []*columnEncoding
+	location  *time.Location
+}
+
+func (r *Reader) readStripe(si *stripeInformation) (vector.Any, error) {
+	footerOff := int64(si.offset + si.indexLength + si.dataLength)
+	buf := make([]byte, si.footerLength)
+	if _, err := r.r.ReadAt(buf, footerOff); err != nil {
+		return nil, err
+	}
+	b, err := decompress(r.ps.compression, r.ps.compressionBlockSize, buf)
+	if err != nil {
+		return nil, err
+	}
+	sf, err := decodeStripeFooter(b)
+	if err != nil {
+		return nil, err
+	}
+	s := &stripe{
+		r:         r,
+		streams:   map[streamKey][]byte{},
+		encodings: sf.columns,
+		location:  time.UTC,
+	}
+	if tz := sf.writerTimezone; tz != "" && tz != "UTC" && tz != "GMT" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			s.location = loc
+		}
+	}
+	projected := map[uint32]bool{}
+	var walk func(*column)
+	walk = func(c *column) {
+		projected[c.id] = true
+		for _, child := range c.children {
+			walk(child)
+		}
+	}
+	walk(r.root)
+	off := si.offset
+	for _, st := range sf.streams {
+		streamOff := off
+		off += st.length
+		if !projected[st.column] || st.kind > streamSecondary || st.length == 0 {
+			continue
+		}
+		buf := make([]byte, st.length)
+		if _, err := r.r.ReadAt(buf, int64(streamOff)); err != nil {
+			return nil, err
+		}
+		b, err := decompress(r.ps.compression, r.ps.compressionBlockSize, buf)
+		if err != nil {
+			return nil, err
+		}
+		s.streams[streamKey{st.column, st.kind}] = b
+	}
+	vec, err := s.decode(r.root, uint32(si.numberOfRows))
+	if err != nil {
+		return nil, fmt.Errorf("ORC column %d: %w", r.root.id, err)
+	}
+	return vec, nil
+}
+
+func (s *stripe) stream(c *column, kind streamKind) []byte {
+	return s.streams[streamKey{c.id, kind}]
+}
+
+func (s *stripe) encoding(c *column) encodingKind {
+	if int(c.id) < len(s.encodings) {
+		return s.encodings[c.id].kind
+	}
+	return encodingDirect
+}
+
+func (s *stripe) isV2(c *column) bool {
+	e := s.encoding(c)
+	return e == encodingDirectV2 || e == encodingDictionaryV2
+}
+
+// decode decodes n values of column c.
+func (s *stripe) decode(c *column, n uint32) (vector.Any, error) {
+	var present []bool
+	m := n
+	if b := s.stream(c, streamPresent); b != nil {
+		var err error
+		if present, err = decodeBoolRLE(b, int(n)); err != nil {
+			return nil, err
+		}
+		m = 0
+		for _, p := range present {
+			if p {
+				m++
+			}
+		}
+	}
+	if m < n && !c.nullable {
+		return nil, fmt.Errorf("ORC column %d has unexpected nulls", c.id)
+	}
+	if c.orc.kind == kindUnion {
+		return s.decodeUnion(c, present, n, m)
+	}
+	vec, err := s.decodeValues(c, m)
+	if err != nil {
+		return nil, fmt.Errorf("ORC column %d: %w", c.id, err)
+	}
+	if !c.nullable {
+		return vec, nil
+	}
+	unionType := c.valueType(s.r.sctx).(*super.TypeUnion)
+	nullTag, vecTag, _ := arrowio.NullableUnionTagsAndType(unionType)
+	tags := make([]uint32, n)
+	for i := range tags {
+		if present == nil || present[i] {
+			tags[i] = uint32(vecTag)
+		} else {
+			tags[i] = uint32(nullTag)
+		}
+	}
+	var vecs [2]vector.Any
+	vecs[nullTag] = vector.NewNull(n - m)
+	vecs[vecTag] = vec
+	return vector.NewUnion(unionType, tags, vecs[:]), nil
+}
+
+// decodeValues decodes m non-null values of column c.
+func (s *stripe) decodeValues(c *column, m uint32) (vector.Any, error) {
+	switch c.orc.kind {
+	case kindBoolean:
+		vals, err := decodeBoolRLE(s.stream(c, streamData), int(m))
+		if err != nil {
+			return nil, err
+		}
+		vec := vector.NewFalse(m)
+		for i, v := range vals {
+			if v {
+				vec.Set(uint32(i))
+			}
+		}
+		return vec, nil
+	case kindByte:
+		bytes, err := decodeByteRLE(s.stream(c, streamData), int(m))
+		if err != nil {
+			return nil, err
+		}
+		vals := make([]int64, m)
+		for i, b := range bytes {
+			vals[i] = int64(int8(b))
+		}
+		return vector.NewInt(c.typ, vals), nil
+	case kindShort, kindInt, kindLong:
+		vals, err := s.ints(c, streamData, m, true)
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewInt(c.typ, vals), nil
+	case kindFloat:
+		b := s.stream(c, streamData)
+		if len(b) < int(m)*4 {
+			return nil, errShortStream
+		}
+		vals := make([]float64, m)
+		for i := range vals {
+			vals[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:])))
+		}
+		return vector.NewFloat(c.typ, vals), nil
+	case kindDouble:
+		b := s.stream(c, streamData)
+		if len(b) < int(m)*8 {
+			return nil, errShortStream
+		}
+		vals := make([]float64, m)
+		for i := range vals {
+			vals[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*8:]))
+		}
+		return vector.NewFloat(c.typ, vals), nil
+	case kindString, kindVarchar, kindChar, kindBinary:
+		table, err := s.bytesTable(c, m)
+		if err != nil {
+			return nil, err
+		}
+		if c.orc.kind == kindBinary {
+			return vector.NewBytes(table), nil
+		}
+		return vector.NewString(table), nil
+	case kindTimestamp, kindTimestampInstant:
+		return s.decodeTimestamps(c, m)
+	case kindDate:
+		vals, err := s.ints(c, streamData, m, true)
+		if err != nil {
+			return nil, err
+		}
+		for i := range vals {
+			vals[i] *= int64(24 * time.Hour)
+		}
+		return vector.NewInt(c.typ, vals), nil
+	case kindDecimal:
+		return s.decodeDecimals(c, m)
+	case kindList:
+		offsets, err := s.offsets(c, m)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := s.decode(c.children[0], offsets[m])
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewArray(c.typ.(*super.TypeArray), offsets, vals), nil
+	case kindMap:
+		offsets, err := s.offsets(c, m)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := s.decode(c.children[0], offsets[m])
+		if err != nil {
+			return nil, err
+		}
+		vals, err := s.decode(c.children[1], offsets[m])
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewMap(c.typ.(*super.TypeMap), offsets, keys, vals), nil
+	case kindStruct:
+		fields := make([]vector.Any, 0, len(c.children))
+		for _, child := range c.children {
+			vec, err := s.decode(child, m)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, vec)
+		}
+		return vector.NewRecord(c.typ.(*super.TypeRecord), fields, m), nil
+	}
+	return nil, fmt.Errorf("unsupported ORC type kind %d", c.orc.kind)
+}
+
+func (s *stripe) ints(c *column, kind streamKind, n uint32, signed bool) ([]int64, error) {
+	return decodeIntRLE(s.stream(c, kind), int(n), signed, s.isV2(c))
+}
+
+// offsets decodes the LENGTH stream of a list or map column into offsets.
+func (s *stripe) offsets(c *column, m uint32) ([]uint32, error) {
+	lengths, err := s.ints(c, streamLength, m, false)
+	if err != nil {
+		return nil, err
+	}
+	offsets := make([]uint32, m+1)
+	for i, n := range lengths {
+		offsets[i+1] = offsets[i] + uint32(n)
+	}
+	return offsets, nil
+}
+
+func (s *stripe) bytesTable(c *column, m uint32) (vector.BytesTable, error) {
+	data := s.stream(c, streamData)
+	switch s.encoding(c) {
+	case encodingDictionary, encodingDictionaryV2:
+		size := s.encodings[c.id].dictionarySize
+		lengths, err := s.ints(c, streamLength, size, false)
+		if err != nil {
+			return vector.BytesTable{}, err
+		}
+		dict := s.stream(c, streamDictionaryData)
+		dictOffsets := make([]uint64, size+1)
+		for i, n := range lengths {
+			dictOffsets[i+1] = dictOffsets[i] + uint64(n)
+		}
+		if dictOffsets[size] > uint64(len(dict)) {
+			return vector.BytesTable{}, errShortStream
+		}
+		indexes, err := s.ints(c, streamData, m, false)
+		if err != nil {
+			return vector.BytesTable{}, err
+		}
+		offsets := make([]uint32, m+1)
+		var bytes []byte
+		for i, k := range indexes {
+			if uint64(k) >= uint64(size) {
+				return vector.BytesTable{}, fmt.Errorf("dictionary index %d out of range", k)
+			}
+			bytes = append(bytes, dict[dictOffsets[k]:dictOffsets[k+1]]...)
+			offsets[i+1] = uint32(len(bytes))
+		}
+		return vector.NewBytesTable(offsets, bytes), nil
+	default:
+		lengths, err := s.ints(c, streamLength, m, false)
+		if err != nil {
+			return vector.BytesTable{}, err
+		}
+		offsets := make([]uint32, m+1)
+		for i, n := range lengths {
+			offsets[i+1] = offsets[i] + uint32(n)
+		}
+		if int(offsets[m]) > len(data) {
+			return vector.BytesTable{}, errShortStream
+		}
+		return vector.NewBytesTable(offsets, data[:offsets[m]]), nil
+	}
+}
+
+func (s *stripe) decodeTimestamps(c *column, m uint32) (vector.Any, error) {
+	secs, err := s.ints(c, streamData, m, true)
+	if err != nil {
+		return nil, err
+	}
+	nanos, err := s.ints(c, streamSecondary, m, false)
+	if err != nil {
+		return nil, err
+	}
+	// Timestamps without a time zone are relative to the writer's
+	// local time and are converted to that local time in UTC.
+	loc := s.location
+	if c.orc.kind == kindTimestampInstant {
+		loc = time.UTC
+	}
+	base := int64(timestampBase)
+	if loc != time.UTC {
+		_, offset := time.Unix(timestampBase, 0).In(loc).Zone()
+		base -= int64(offset)
+	}
+	vals := make([]int64, m)
+	for i := range vals {
+		ns := nanos[i] >> 3
+		if zeros := nanos[i] & 7; zeros != 0 {
+			for range zeros + 1 {
+				ns *= 10
+			}
+		}
+		sec := secs[i] + base
+		// Writers store negative seconds truncated toward zero.
+		if sec < 0 && ns > 999999 {
+			sec--
+		}
+		if loc != time.UTC {
+			_, offset := time.Unix(sec, 0).In(loc).Zone()
+			sec += int64(offset)
+		}
+		vals[i] = sec*int64(time.Second) + ns
+	}
+	return vector.NewInt(c.typ, vals), nil
+}
+
+func (s *stripe) decodeDecimals(c *column, m uint32) (vector.Any, error) {
+	vals, err := decodeDecimals(s.stream(c, streamData), int(m))
+	if err != nil {
+		return nil, err
+	}
+	scales, err := s.ints(c, streamSecondary, m, true)
+	if err != nil {
+		return nil, err
+	}
+	typ := c.typ.(*super.TypeDecimal)
+	vec := vector.NewDecimalEmpty(typ, m)
+	ten := big.NewInt(10)
+	for i, v := range vals {
+		if d := int64(typ.Scale) - scales[i]; d > 0 {
+			v.Mul(v, new(big.Int).Exp(ten, big.NewInt(d), nil))
+		} else if d < 0 {
+			v.Quo(v, new(big.Int).Exp(ten, big.NewInt(-d), nil))
+		}
+		vec.Append(v)
+	}
+	return vec, nil
+}
+
+// decodeUnion decodes n values of union column c, m of which are not null.
+func (s *stripe) decodeUnion(c *column, present []bool, n, m uint32) (vector.Any, error) {
+	orcTags, err := decodeByteRLE(s.stream(c, streamData), int(m))
+	if err != nil {
+		return nil, err
+	}
+	typ := c.typ.(*super.TypeUnion)
+	counts := make([]uint32, len(c.children))
+	for _, t := range orcTags {
+		if int(t) >= len(c.children) {
+			return nil, fmt.Errorf("ORC union column %d: tag %d out of range", c.id, t)
+		}
+		counts[t]++
+	}
+	vecs := make([]vector.Any, len(typ.Types))
+	tagMap := make([]uint32, len(c.children))
+	for i, child := range c.children {
+		vec, err := s.decode(child, counts[i])
+		if err != nil {
+			return nil, err
+		}
+		tag := typ.TagOf(child.typ)
+		tagMap[i] = uint32(tag)
+		vecs[tag] = vec
+	}
+	tags := make([]uint32, n)
+	if c.nullable {
+		nullTag := typ.TagOf(super.TypeNull)
+		vecs[nullTag] = vector.NewNull(n - m)
+		var k int
+		for i := range tags {
+			if present == nil || present[i] {
+				tags[i] = tagMap[orcTags[k]]
+				k++
+			} else {
+				tags[i] = uint32(nullTag)
+			}
+		}
+	} else {
+		for i, t := range orcTags {
+			tags[i] = tagMap[t]
+		}
+	}
+	return vector.NewUnion(typ, tags, vecs), nil
+}
@@ -0,0 +1,440 @@
+package orcio
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// TestReaderJavaLayout reads a file encoded by hand, independently of
+// Writer, with the layout of the Apache ORC Java writer: zlib compression,
+// row index streams ahead of the data streams, DICTIONARY_V2 strings, RLE
+// v2 delta runs, packed subtypes, per-value decimal scales, timestamps
+// relative to 2015 with their nanoseconds' trailing zeros elided, and
+// statistics and metadata for each column.
+func TestReaderJavaLayout(t *testing.T) {
+	const (
+		kindPresent = iota
+		kindData
+		kindLength
+		kindDictionaryData
+		_
+		kindSecondary
+		kindRowIndex
+	)
+	type stream struct {
+		column uint64
+		kind   uint64
+		data   []byte
+	}
+	ts := func(s string) (int64, int64) {
+		// The Java writer truncates milliseconds toward zero to get seconds.
+		tm, err := time.Parse(time.RFC3339Nano, s)
+		require.NoError(t, err)
+		millis := tm.UnixMilli()
+		return millis/1000 - timestampBase, int64(tm.Nanosecond())
+	}
+	timestamps := func(vals ...string) ([]byte, []byte) {
+		var secs, nanos []int64
+		for _, s := range vals {
+			sec, ns := ts(s)
+			secs = append(secs, sec)
+			nanos = append(nanos, javaNanos(ns))
+		}
+		return rleV2Direct(secs, true), rleV2Direct(nanos, false)
+	}
+	days := func(vals ...string) []byte {
+		var out []int64
+		for _, s := range vals {
+			tm, err := time.Parse(time.DateOnly, s)
+			require.NoError(t, err)
+			out = append(out, tm.Unix()/86400)
+		}
+		return rleV2Direct(out, true)
+	}
+	doubles := func(vals ...float64) []byte {
+		var b []byte
+		for _, v := range vals {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+		}
+		return b
+	}
+	decimals := func(vals ...int64) []byte {
+		var b []byte
+		for _, v := range vals {
+			b = binary.AppendVarint(b, v)
+		}
+		return b
+	}
+	u := func(vals ...int64) []byte { return rleV2Direct(vals, false) }
+	s := func(vals ...int64) []byte { return rleV2Direct(vals, true) }
+	ts1, nanos1 := timestamps("2024-03-01T12:30:45.123456Z", "1969-12-31T23:59:58.75Z", "2015-01-01T00:00:00Z")
+	ts2, nanos2 := timestamps("2038-01-19T03:14:08Z", "2015-01-01T00:00:00.000000001Z")
+	stripes := []struct {
+		rows      uint64
+		encodings []uint64
+		dictSizes map[int]uint64
+		streams   []stream
+	}{
+		{
+			rows:      3,
+			encodings: []uint64{0, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2, 0, 2, 2, 0, 2, 2},
+			dictSizes: map[int]uint64{2: 1},
+			streams: []stream{
+				{1, kindData, []byte{0xc0, 0x02, 0x02, 0x02}}, // delta run of 1, 2, 3
+				{2, kindPresent, byteRLELiteral(0xa0)},
+				{2, kindData, u(0, 0)},
+				{2, kindLength, u(5)},
+				{2, kindDictionaryData, []byte("apple")},
+				{3, kindData, decimals(1234, -5, 9999999999)},
+				{3, kindSecondary, s(2, 1, 2)},
+				{4, kindData, ts1},
+				{4, kindSecondary, nanos1},
+				{5, kindData, days("2024-03-01", "1969-12-31", "1970-01-01")},
+				{6, kindPresent, byteRLELiteral(0xc0)},
+				{6, kindLength, u(2, 0)},
+				{7, kindData, []byte("ab")},
+				{7, kindLength, u(1, 1)},
+				{8, kindLength, u(1, 0, 2)},
+				{9, kindData, []byte("xyz")},
+				{9, kindLength, u(1, 1, 1)},
+				{10, kindData, s(1, -2, 3)},
+				{11, kindPresent, byteRLELiteral(0xc0)},
+				{11, kindData, byteRLELiteral(0, 1)},
+				{12, kindData, s(7)},
+				{13, kindData, []byte("seven")},
+				{13, kindLength, u(5)},
+				{14, kindPresent, byteRLELiteral(0xa0)},
+				{15, kindData, doubles(1.5, -0.25)},
+				{16, kindData, byteRLELiteral(0x80)},
+			},
+		},
+		{
+			rows:      2,
+			encodings: []uint64{0, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2, 0, 2, 2, 0, 2, 2},
+			dictSizes: map[int]uint64{2: 2},
+			streams: []stream{
+				{1, kindData, s(4, 5)},
+				{2, kindData, u(0, 1)},
+				{2, kindLength, u(6, 6)},
+				{2, kindDictionaryData, []byte("bananacherry")},
+				{3, kindPresent, byteRLELiteral(0x80)},
+				{3, kindData, decimals(0)},
+				{3, kindSecondary, s(2)},
+				{4, kindData, ts2},
+				{4, kindSecondary, nanos2},
+				{5, kindData, days("2038-01-19", "2038-01-20")},
+				{6, kindLength, u(1, 3)},
+				{7, kindData, []byte("cdef")},
+				{7, kindLength, u(1, 1, 1, 1)},
+				{8, kindLength, u(1, 0)},
+				{9, kindData, []byte("k")},
+				{9, kindLength, u(1)},
+				{10, kindData, s(0)},
+				{11, kindData, byteRLELiteral(0, 0)},
+				{12, kindData, s(-1, 100)},
+				{15, kindData, doubles(0, 2.5)},
+				{16, kindData, byteRLELiteral(0xc0)},
+			},
+		},
+	}
+	// The precision and scale of the decimal column 3 are added below.
+	types := []struct {
+		kind     uint64
+		subtypes []uint64
+		names    []string
+	}{
+		{12, []uint64{1, 2, 3, 4, 5, 6, 8, 11, 14}, []string{"id", "name", "amount", "ts", "day", "tags", "attrs", "u", "nested"}},
+		{4, nil, nil},
+		{7, nil, nil},
+		{14, nil, nil},
+		{9, nil, nil},
+		{15, nil, nil},
+		{10, []uint64{7}, nil},
+		{7, nil, nil},
+		{11, []uint64{9, 10}, nil},
+		{7, nil, nil},
+		{3, nil, nil},
+		{13, []uint64{12, 13}, nil},
+		{3, nil, nil},
+		{7, nil, nil},
+		{12, []uint64{15, 16}, []string{"a", "b"}},
+		{6, nil, nil},
+		{0, nil, nil},
+	}
+	hasNull := map[int]bool{2: true, 3: true, 6: true, 11: true, 14: true}
+
+	const blockSize = 256 * 1024
+	file := []byte(magic)
+	var stripeInfos [][]byte
+	for _, st := range stripes {
+		offset := uint64(len(file))
+		// Row index streams come first, one for each column.
+		var sf []byte
+		var index []byte
+		for col := range types {
+			var entry []byte
+			entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+			entry = protowire.AppendBytes(entry, []byte{0, 0, 0})
+			var rowIndex []byte
+			rowIndex = protowire.AppendTag(rowIndex, 1, protowire.BytesType)
+			rowIndex = protowire.AppendBytes(rowIndex, entry)
+			b := zlibChunks(rowIndex, blockSize)
+			index = append(index, b...)
+			sf = appendStream(sf, uint64(col), kindRowIndex, uint64(len(b)))
+		}
+		var data []byte
+		for _, s := range st.streams {
+			b := zlibChunks(s.data, blockSize)
+			data = append(data, b...)
+			sf = appendStream(sf, s.column, s.kind, uint64(len(b)))
+		}
+		for col, kind := range st.encodings {
+			var enc []byte
+			enc = protowire.AppendTag(enc, 1, protowire.VarintType)
+			enc = protowire.AppendVarint(enc, kind)
+			if size, ok := st.dictSizes[col]; ok {
+				enc = protowire.AppendTag(enc, 2, protowire.VarintType)
+				enc = protowire.AppendVarint(enc, size)
+			}
+			sf = protowire.AppendTag(sf, 2, protowire.BytesType)
+			sf = protowire.AppendBytes(sf, enc)
+		}
+		sf = protowire.AppendTag(sf, 3, protowire.BytesType)
+		sf = protowire.AppendString(sf, "UTC")
+		footer := zlibChunks(sf, blockSize)
+		file = append(file, index...)
+		file = append(file, data...)
+		file = append(file, footer...)
+		var si []byte
+		for i, v := range []uint64{offset, uint64(len(index)), uint64(len(data)), uint64(len(footer)), st.rows} {
+			si = protowire.AppendTag(si, protowire.Number(i+1), protowire.VarintType)
+			si = protowire.AppendVarint(si, v)
+		}
+		stripeInfos = append(stripeInfos, si)
+	}
+	contentLength := uint64(len(file))
+	stats := func(col int, n uint64) []byte {
+		var b []byte
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, n)
+		b = protowire.AppendTag(b, 10, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(hasNull[col]))
+		return b
+	}
+	// The metadata holds statistics for each stripe.
+	var md []byte
+	for _, st := range stripes {
+		var ss []byte
+		for col := range types {
+			ss = protowire.AppendTag(ss, 1, protowire.BytesType)
+			ss = protowire.AppendBytes(ss, stats(col, st.rows))
+		}
+		md = protowire.AppendTag(md, 1, protowire.BytesType)
+		md = protowire.AppendBytes(md, ss)
+	}
+	var ft []byte
+	ft = protowire.AppendTag(ft, 1, protowire.VarintType)
+	ft = protowire.AppendVarint(ft, uint64(len(magic)))
+	ft = protowire.AppendTag(ft, 2, protowire.VarintType)
+	ft = protowire.AppendVarint(ft, contentLength)
+	for _, si := range stripeInfos {
+		ft = protowire.AppendTag(ft, 3, protowire.BytesType)
+		ft = protowire.AppendBytes(ft, si)
+	}
+	for col, typ := range types {
+		var b []byte
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, typ.kind)
+		if len(typ.subtypes) > 0 {
+			var packed []byte
+			for _, st := range typ.subtypes {
+				packed = protowire.AppendVarint(packed, st)
+			}
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendBytes(b, packed)
+		}
+		for _, name := range typ.names {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			b = protowire.AppendString(b, name)
+		}
+		if col == 3 {
+			b = protowire.AppendTag(b, 5, protowire.VarintType)
+			b = protowire.AppendVarint(b, 10)
+			b = protowire.AppendTag(b, 6, protowire.VarintType)
+			b = protowire.AppendVarint(b, 2)
+		}
+		ft = protowire.AppendTag(ft, 4, protowire.BytesType)
+		ft = protowire.AppendBytes(ft, b)
+	}
+	ft = protowire.AppendTag(ft, 6, protowire.VarintType)
+	ft = protowire.AppendVarint(ft, 5)
+	for col := range types {
+		ft = protowire.AppendTag(ft, 7, protowire.BytesType)
+		ft = protowire.AppendBytes(ft, stats(col, 5))
+	}
+	ft = protowire.AppendTag(ft, 8, protowire.VarintType)
+	ft = protowire.AppendVarint(ft, 10000)
+	ft = protowire.AppendTag(ft, 9, protowire.VarintType)
+	ft = protowire.AppendVarint(ft, 0) // ORC_JAVA
+	mdBytes := zlibChunks(md, blockSize)
+	ftBytes := zlibChunks(ft, blockSize)
+	file = append(file, mdBytes...)
+	file = append(file, ftBytes...)
+	var ps []byte
+	for i, v := range []uint64{uint64(len(ftBytes)), uint64(compressionZlib), blockSize} {
+		ps = protowire.AppendTag(ps, protowire.Number(i+1), protowire.VarintType)
+		ps = protowire.AppendVarint(ps, v)
+	}
+	ps = protowire.AppendTag(ps, 4, protowire.BytesType)
+	ps = protowire.AppendBytes(ps, []byte{0, 12})
+	ps = protowire.AppendTag(ps, 5, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, uint64(len(mdBytes)))
+	ps = protowire.AppendTag(ps, 6, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, 9)
+	ps = protowire.AppendTag(ps, 8000, protowire.BytesType)
+	ps = protowire.AppendString(ps, magic)
+	file = append(file, ps...)
+	file = append(file, byte(len(ps)))
+
+	r, err := NewReader(context.Background(), super.NewContext(), bytes.NewReader(file), nil, 1)
+	require.NoError(t, err)
+	typ, err := r.Type()
+	require.NoError(t, err)
+	require.Equal(t, "{id:int64,name:string|null,amount:null|decimal(10,2),ts:time,day:time,tags:null|[string],attrs:map{string:int32},u:int32|string|null,nested:null|{a:float64,b:bool}}", sup.FormatType(typ))
+	var vals []string
+	puller := sbuf.NewMaterializer(r)
+	for {
+		batch, err := puller.Pull(false)
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		for _, val := range batch.Values() {
+			vals = append(vals, sup.FormatValue(val))
+		}
+	}
+	expected := []string{
+		`{id:1,name:"apple"::(string|null),amount:12.34::decimal(10,2)::(null|decimal(10,2)),ts:2024-03-01T12:30:45.123456Z,day:2024-03-01T00:00:00Z,tags:["a","b"]::(null|[string]),attrs:map{"x":1::int32},u:7::int32::(int32|string|null),nested:{a:1.5,b:true}::(null|{a:float64,b:bool})}`,
+		`{id:2,name:null::(string|null),amount:-0.50::decimal(10,2)::(null|decimal(10,2)),ts:1969-12-31T23:59:58.75Z,day:1969-12-31T00:00:00Z,tags:[]::[string]::(null|[string]),attrs:map{}::map{string:int32},u:"seven"::(int32|string|null),nested:null::(null|{a:float64,b:bool})}`,
+		`{id:3,name:"apple"::(string|null),amount:99999999.99::decimal(10,2)::(null|decimal(10,2)),ts:2015-01-01T00:00:00Z,day:1970-01-01T00:00:00Z,tags:null::(null|[string]),attrs:map{"y":-2::int32,"z":3::int32},u:null::(int32|string|null),nested:{a:-0.25,b:false}::(null|{a:float64,b:bool})}`,
+		`{id:4,name:"banana"::(string|null),amount:0.00::decimal(10,2)::(null|decimal(10,2)),ts:2038-01-19T03:14:08Z,day:2038-01-19T00:00:00Z,tags:["c"]::(null|[string]),attrs:map{"k":0::int32},u:-1::int32::(int32|string|null),nested:{a:0.,b:true}::(null|{a:float64,b:bool})}`,
+		`{id:5,name:"cherry"::(string|null),amount:null::(null|decimal(10,2)),ts:2015-01-01T00:00:00.000000001Z,day:2038-01-20T00:00:00Z,tags:["d","e","f"]::(null|[string]),attrs:map{}::map{string:int32},u:100::int32::(int32|string|null),nested:{a:2.5,b:true}::(null|{a:float64,b:bool})}`,
+	}
+	require.Equal(t, expected, vals)
+}
+
+// javaNanos encodes nanoseconds as the Java writer does, with up to eight
+// trailing decimal zeros removed and their count less one in the low three
+// bits.
+func javaNanos(ns int64) int64 {
+	if ns == 0 {
+		return 0
+	}
+	var zeros int64
+	for ns%10 == 0 && zeros < 8 {
+		ns /= 10
+		zeros++
+	}
+	if zeros < 2 {
+		// A single zero is not worth eliding.
+		for range zeros {
+			ns *= 10
+		}
+		return ns << 3
+	}
+	return ns<<3 | (zeros - 1)
+}
+
+// rleV2Direct encodes vals as a single RLE v2 DIRECT run.
+func rleV2Direct(vals []int64, signed bool) []byte {
+	us := make([]uint64, len(vals))
+	var width int
+	for i, v := range vals {
+		if signed {
+			us[i] = uint64(v<<1) ^ uint64(v>>63)
+		} else {
+			us[i] = uint64(v)
+		}
+		width = max(width, bits.Len64(us[i]))
+	}
+	// Round the width up to one that has an encoding.
+	var code int
+	switch {
+	case width <= 1:
+		width, code = 1, 0
+	case width <= 24:
+		code = width - 1
+	case width <= 32:
+		width, code = 32, 27
+	default:
+		width, code = 64, 31
+	}
+	n := len(vals) - 1
+	b := []byte{0x40 | byte(code<<1) | byte(n>>8), byte(n)}
+	var acc uint64
+	var nacc int
+	for _, v := range us {
+		for i := width - 1; i >= 0; i-- {
+			acc = acc<<1 | (v>>i)&1
+			nacc++
+			if nacc == 8 {
+				b = append(b, byte(acc))
+				acc, nacc = 0, 0
+			}
+		}
+	}
+	if nacc > 0 {
+		b = append(b, byte(acc<<(8-nacc)))
+	}
+	return b
+}
+
+// byteRLELiteral encodes vals as a byte RLE literal run.
+func byteRLELiteral(vals ...byte) []byte {
+	return append([]byte{byte(-len(vals))}, vals...)
+}
+
+// zlibChunks compresses b into chunks of at most blockSize bytes each, which
+// are stored uncompressed when compression does not help.
+func zlibChunks(b []byte, blockSize int) []byte {
+	var out []byte
+	for len(b) > 0 {
+		chunk := b[:min(len(b), blockSize)]
+		b = b[len(chunk):]
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.BestCompression)
+		w.Write(chunk)
+		w.Close()
+		header, body := uint32(buf.Len())<<1, buf.Bytes()
+		if buf.Len() >= len(chunk) {
+			header, body = uint32(len(chunk))<<1|1, chunk
+		}
+		out = append(out, byte(header), byte(header>>8), byte(header>>16))
+		out = append(out, body...)
+	}
+	return out
+}
+
+func appendStream(b []byte, column, kind, length uint64) []byte {
+	var st []byte
+	st = protowire.AppendTag(st, 1, protowire.VarintType)
+	st = protowire.AppendVarint(st, kind)
+	st = protowire.AppendTag(st, 2, protowire.VarintType)
+	st = protowire.AppendVarint(st, column)
+	st = protowire.AppendTag(st, 3, protowire.VarintType)
+	st = protowire.AppendVarint(st, length)
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, st)
+}
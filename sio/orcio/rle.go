@@ -0,0 +1,560 @@
+package orcio
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// This file implements the ORC run-length encodings for bytes, booleans,
+// and integers (versions 1 and 2) as well as the unbounded varints used for
+// decimals.  Decoders return exactly the number of values requested.
+
+var errShortStream = errors.New("stream too short")
+
+func decodeByteRLE(b []byte, n int) ([]byte, error) {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		if len(b) == 0 {
+			return nil, errShortStream
+		}
+		h := b[0]
+		b = b[1:]
+		if h < 128 {
+			if len(b) == 0 {
+				return nil, errShortStream
+			}
+			for range min(int(h)+3, n-len(out)) {
+				out = append(out, b[0])
+			}
+			b = b[1:]
+		} else {
+			count := 256 - int(h)
+			if len(b) < count {
+				return nil, errShortStream
+			}
+			out = append(out, b[:min(count, n-len(out))]...)
+			b = b[count:]
+		}
+	}
+	return out, nil
+}
+
+func decodeBoolRLE(b []byte, n int) ([]bool, error) {
+	bytes, err := decodeByteRLE(b, (n+7)/8)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]bool, n)
+	for i := range out {
+		out[i] = bytes[i/8]&(0x80>>(i%8)) != 0
+	}
+	return out, nil
+}
+
+func decodeIntRLE(b []byte, n int, signed, v2 bool) ([]int64, error) {
+	if v2 {
+		return decodeIntRLEv2(b, n, signed)
+	}
+	return decodeIntRLEv1(b, n, signed)
+}
+
+func decodeIntRLEv1(b []byte, n int, signed bool) ([]int64, error) {
+	out := make([]int64, 0, n)
+	for len(out) < n {
+		if len(b) == 0 {
+			return nil, errShortStream
+		}
+		h := b[0]
+		b = b[1:]
+		if h < 128 {
+			if len(b) == 0 {
+				return nil, errShortStream
+			}
+			delta := int64(int8(b[0]))
+			base, m := readVarint(b[1:], signed)
+			if m <= 0 {
+				return nil, errShortStream
+			}
+			b = b[1+m:]
+			for k := range min(int64(h)+3, int64(n-len(out))) {
+				out = append(out, base+k*delta)
+			}
+		} else {
+			for range 256 - int(h) {
+				v, m := readVarint(b, signed)
+				if m <= 0 {
+					return nil, errShortStream
+				}
+				b = b[m:]
+				if len(out) < n {
+					out = append(out, v)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+func readVarint(b []byte, signed bool) (int64, int) {
+	u, n := binary.Uvarint(b)
+	if signed {
+		return unzigzag(u), n
+	}
+	return int64(u), n
+}
+
+func unzigzag(u uint64) int64 {
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+// Integer RLE version 2 sub-encodings.
+const (
+	shortRepeat = 0
+	direct      = 1
+	patchedBase = 2
+	delta       = 3
+)
+
+func decodeIntRLEv2(b []byte, n int, signed bool) ([]int64, error) {
+	out := make([]int64, 0, n)
+	for len(out) < n {
+		if len(b) == 0 {
+			return nil, errShortStream
+		}
+		var vals []int64
+		var m int
+		var err error
+		switch b[0] >> 6 {
+		case shortRepeat:
+			vals, m, err = decodeShortRepeat(b, signed)
+		case direct:
+			vals, m, err = decodeDirect(b, signed)
+		case patchedBase:
+			vals, m, err = decodePatchedBase(b)
+		case delta:
+			vals, m, err = decodeDelta(b, signed)
+		}
+		if err != nil {
+			return nil, err
+		}
+		b = b[m:]
+		out = append(out, vals[:min(len(vals), n-len(out))]...)
+	}
+	return out, nil
+}
+
+func decodeShortRepeat(b []byte, signed bool) ([]int64, int, error) {
+	width := int(b[0]>>3&7) + 1
+	count := int(b[0]&7) + 3
+	if len(b) < 1+width {
+		return nil, 0, errShortStream
+	}
+	var u uint64
+	for _, c := range b[1 : 1+width] {
+		u = u<<8 | uint64(c)
+	}
+	v := int64(u)
+	if signed {
+		v = unzigzag(u)
+	}
+	vals := make([]int64, count)
+	for i := range vals {
+		vals[i] = v
+	}
+	return vals, 1 + width, nil
+}
+
+func decodeDirect(b []byte, signed bool) ([]int64, int, error) {
+	if len(b) < 2 {
+		return nil, 0, errShortStream
+	}
+	width := decodeBitWidth(int(b[0] >> 1 & 0x1f))
+	length := (int(b[0])&1)<<8 | int(b[1]) + 1
+	us, m, err := unpack(b[2:], length, width)
+	if err != nil {
+		return nil, 0, err
+	}
+	vals := make([]int64, length)
+	for i, u := range us {
+		if signed {
+			vals[i] = unzigzag(u)
+		} else {
+			vals[i] = int64(u)
+		}
+	}
+	return vals, 2 + m, nil
+}
+
+func decodePatchedBase(b []byte) ([]int64, int, error) {
+	if len(b) < 4 {
+		return nil, 0, errShortStream
+	}
+	width := decodeBitWidth(int(b[0] >> 1 & 0x1f))
+	length := (int(b[0])&1)<<8 | int(b[1]) + 1
+	baseWidth := int(b[2]>>5) + 1
+	patchWidth := decodeBitWidth(int(b[2] & 0x1f))
+	patchGapWidth := int(b[3]>>5) + 1
+	patchListLength := int(b[3] & 0x1f)
+	pos := 4
+	if len(b) < pos+baseWidth {
+		return nil, 0, errShortStream
+	}
+	var u uint64
+	for _, c := range b[pos : pos+baseWidth] {
+		u = u<<8 | uint64(c)
+	}
+	pos += baseWidth
+	// The base is in sign-magnitude form.
+	signBit := uint64(1) << (baseWidth*8 - 1)
+	base := int64(u &^ signBit)
+	if u&signBit != 0 {
+		base = -base
+	}
+	us, m, err := unpack(b[pos:], length, width)
+	if err != nil {
+		return nil, 0, err
+	}
+	pos += m
+	if patchGapWidth+patchWidth > 64 {
+		return nil, 0, errors.New("invalid patch width")
+	}
+	patches, m, err := unpack(b[pos:], patchListLength, closestFixedBits(patchGapWidth+patchWidth))
+	if err != nil {
+		return nil, 0, err
+	}
+	pos += m
+	patchMask := uint64(1)<<patchWidth - 1
+	var idx int
+	for _, p := range patches {
+		idx += int(p >> patchWidth)
+		if idx >= length {
+			return nil, 0, errors.New("invalid patch gap")
+		}
+		us[idx] |= (p & patchMask) << width
+	}
+	vals := make([]int64, length)
+	for i, u := range us {
+		vals[i] = base + int64(u)
+	}
+	return vals, pos, nil
+}
+
+func decodeDelta(b []byte, signed bool) ([]int64, int, error) {
+	if len(b) < 2 {
+		return nil, 0, errShortStream
+	}
+	var width int
+	if code := int(b[0] >> 1 & 0x1f); code != 0 {
+		width = decodeBitWidth(code)
+	}
+	length := (int(b[0])&1)<<8 | int(b[1]) + 1
+	pos := 2
+	base, m := readVarint(b[pos:], signed)
+	if m <= 0 {
+		return nil, 0, errShortStream
+	}
+	pos += m
+	deltaBase, m := readVarint(b[pos:], true)
+	if m <= 0 {
+		return nil, 0, errShortStream
+	}
+	pos += m
+	vals := make([]int64, length)
+	vals[0] = base
+	if length > 1 {
+		vals[1] = base + deltaBase
+	}
+	if width == 0 {
+		for i := 2; i < length; i++ {
+			vals[i] = vals[i-1] + deltaBase
+		}
+		return vals, pos, nil
+	}
+	if length > 2 {
+		deltas, m, err := unpack(b[pos:], length-2, width)
+		if err != nil {
+			return nil, 0, err
+		}
+		pos += m
+		for i, d := range deltas {
+			if deltaBase < 0 {
+				vals[i+2] = vals[i+1] - int64(d)
+			} else {
+				vals[i+2] = vals[i+1] + int64(d)
+			}
+		}
+	}
+	return vals, pos, nil
+}
+
+// unpack decodes count big-endian, bit-packed values of the given width
+// and returns them along with the number of bytes consumed.
+func unpack(b []byte, count, width int) ([]uint64, int, error) {
+	nbytes := (count*width + 7) / 8
+	if len(b) < nbytes {
+		return nil, 0, errShortStream
+	}
+	vals := make([]uint64, count)
+	var pos int
+	for i := range vals {
+		var v uint64
+		for need := width; need > 0; {
+			avail := 8 - pos&7
+			take := min(avail, need)
+			bits := uint64(b[pos>>3]>>(avail-take)) & (1<<take - 1)
+			v = v<<take | bits
+			need -= take
+			pos += take
+		}
+		vals[i] = v
+	}
+	return vals, nbytes, nil
+}
+
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	}
+	return 64
+}
+
+func encodeBitWidth(width int) int {
+	switch {
+	case width <= 24:
+		return width - 1
+	case width == 26:
+		return 24
+	case width == 28:
+		return 25
+	case width == 30:
+		return 26
+	case width == 32:
+		return 27
+	case width == 40:
+		return 28
+	case width == 48:
+		return 29
+	case width == 56:
+		return 30
+	}
+	return 31
+}
+
+// closestFixedBits rounds n up to a bit width that is encodable by
+// encodeBitWidth.
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	}
+	return 64
+}
+
+// decodeDecimals decodes n unbounded, zigzag-encoded base-128 varints.
+func decodeDecimals(b []byte, n int) ([]*big.Int, error) {
+	out := make([]*big.Int, n)
+	for i := range out {
+		end := 0
+		for end < len(b) && b[end]&0x80 != 0 {
+			end++
+		}
+		if end == len(b) {
+			return nil, errShortStream
+		}
+		end++
+		var v *big.Int
+		if end <= 9 {
+			u, _ := binary.Uvarint(b[:end])
+			v = big.NewInt(int64(u >> 1))
+			if u&1 != 0 {
+				v.Not(v)
+			}
+		} else {
+			v = new(big.Int)
+			for k := end - 1; k >= 0; k-- {
+				v.Lsh(v, 7)
+				v.Or(v, big.NewInt(int64(b[k]&0x7f)))
+			}
+			neg := v.Bit(0) == 1
+			v.Rsh(v, 1)
+			if neg {
+				v.Not(v)
+			}
+		}
+		out[i] = v
+		b = b[end:]
+	}
+	return out, nil
+}
+
+func appendDecimal(dst []byte, v *big.Int) []byte {
+	if v.IsInt64() {
+		return binary.AppendUvarint(dst, zigzag(v.Int64()))
+	}
+	// Zigzag: 2v for v >= 0 and -2v-1 for v < 0.
+	u := new(big.Int).Lsh(v, 1)
+	if v.Sign() < 0 {
+		u.Not(u)
+	}
+	mask := big.NewInt(0x7f)
+	for {
+		c := byte(new(big.Int).And(u, mask).Uint64())
+		u.Rsh(u, 7)
+		if u.Sign() == 0 {
+			return append(dst, c)
+		}
+		dst = append(dst, c|0x80)
+	}
+}
+
+func appendByteRLE(dst []byte, vals []byte) []byte {
+	for i := 0; i < len(vals); {
+		run := 1
+		for i+run < len(vals) && run < 130 && vals[i+run] == vals[i] {
+			run++
+		}
+		if run >= 3 {
+			dst = append(dst, byte(run-3), vals[i])
+			i += run
+			continue
+		}
+		j := i
+		for j < len(vals) && j-i < 128 {
+			if j+2 < len(vals) && vals[j] == vals[j+1] && vals[j] == vals[j+2] {
+				break
+			}
+			j++
+		}
+		dst = append(dst, byte(-(j - i)))
+		dst = append(dst, vals[i:j]...)
+		i = j
+	}
+	return dst
+}
+
+func appendBoolRLE(dst []byte, vals []bool) []byte {
+	bytes := make([]byte, (len(vals)+7)/8)
+	for i, v := range vals {
+		if v {
+			bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return appendByteRLE(dst, bytes)
+}
+
+// appendIntRLEv2 encodes vals using the short repeat, direct, and fixed
+// delta sub-encodings of integer RLE version 2.  If signed is false,
+// the values are treated as uint64.
+func appendIntRLEv2(dst []byte, vals []int64, signed bool) []byte {
+	const maxRun = 512
+	for i := 0; i < len(vals); {
+		run := 1
+		for i+run < len(vals) && run < maxRun && vals[i+run] == vals[i] {
+			run++
+		}
+		if run >= 3 {
+			dst = appendRepeat(dst, vals[i], run, signed)
+			i += run
+			continue
+		}
+		j := i
+		for j < len(vals) && j-i < maxRun {
+			if j+2 < len(vals) && vals[j] == vals[j+1] && vals[j] == vals[j+2] {
+				break
+			}
+			j++
+		}
+		dst = appendDirect(dst, vals[i:j], signed)
+		i = j
+	}
+	return dst
+}
+
+func appendRepeat(dst []byte, v int64, count int, signed bool) []byte {
+	u := uint64(v)
+	if signed {
+		u = zigzag(v)
+	}
+	if count <= 10 {
+		width := max(1, (bits.Len64(u)+7)/8)
+		dst = append(dst, byte(shortRepeat<<6|(width-1)<<3|(count-3)))
+		for k := width - 1; k >= 0; k-- {
+			dst = append(dst, byte(u>>(8*k)))
+		}
+		return dst
+	}
+	// Delta encoding with a zero delta and zero width.
+	dst = append(dst, byte(delta<<6|(count-1)>>8&1), byte(count-1))
+	dst = binary.AppendUvarint(dst, u)
+	return binary.AppendUvarint(dst, 0)
+}
+
+func appendDirect(dst []byte, vals []int64, signed bool) []byte {
+	us := make([]uint64, len(vals))
+	var width int
+	for i, v := range vals {
+		us[i] = uint64(v)
+		if signed {
+			us[i] = zigzag(v)
+		}
+		width = max(width, bits.Len64(us[i]))
+	}
+	width = closestFixedBits(width)
+	n := len(vals) - 1
+	dst = append(dst, byte(direct<<6|encodeBitWidth(width)<<1|n>>8&1), byte(n))
+	var cur byte
+	var nbits int
+	for _, u := range us {
+		for need := width; need > 0; {
+			take := min(8-nbits, need)
+			cur = cur<<take | byte(u>>(need-take)&(1<<take-1))
+			nbits += take
+			need -= take
+			if nbits == 8 {
+				dst = append(dst, cur)
+				cur, nbits = 0, 0
+			}
+		}
+	}
+	if nbits > 0 {
+		dst = append(dst, cur<<(8-nbits))
+	}
+	return dst
+}
@@ -0,0 +1,129 @@
+package orcio
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The test vectors below are from the ORC specification.
+
+func TestDecodeIntRLEv2(t *testing.T) {
+	cases := []struct {
+		name     string
+		encoding []byte
+		expected []int64
+	}{
+		{
+			name:     "short repeat",
+			encoding: []byte{0x0a, 0x27, 0x10},
+			expected: []int64{10000, 10000, 10000, 10000, 10000},
+		},
+		{
+			name:     "direct",
+			encoding: []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			expected: []int64{23713, 43806, 57005, 48879},
+		},
+		{
+			name: "patched base",
+			encoding: []byte{
+				0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70,
+				0x28, 0x32, 0x3c, 0x46, 0x50, 0x5a, 0x64, 0x6e, 0x78, 0x82,
+				0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+			},
+			expected: []int64{
+				2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+				2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+			},
+		},
+		{
+			name:     "delta",
+			encoding: []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			expected: []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vals, err := decodeIntRLEv2(c.encoding, len(c.expected), false)
+			require.NoError(t, err)
+			require.Equal(t, c.expected, vals)
+		})
+	}
+}
+
+func TestDecodeIntRLEv1(t *testing.T) {
+	vals, err := decodeIntRLEv1([]byte{0x61, 0x00, 0x07}, 100, false)
+	require.NoError(t, err)
+	require.Len(t, vals, 100)
+	for _, v := range vals {
+		require.Equal(t, int64(7), v)
+	}
+	vals, err = decodeIntRLEv1([]byte{0x61, 0xff, 0x64}, 100, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), vals[0])
+	require.Equal(t, int64(1), vals[99])
+	vals, err = decodeIntRLEv1([]byte{0xfb, 0x02, 0x03, 0x06, 0x07, 0xb}, 5, false)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 6, 7, 11}, vals)
+}
+
+func TestDecodeByteRLE(t *testing.T) {
+	vals, err := decodeByteRLE([]byte{0x61, 0x00}, 100)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 100), vals)
+	vals, err = decodeByteRLE([]byte{0xfe, 0x44, 0x45}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x44, 0x45}, vals)
+	bools, err := decodeBoolRLE([]byte{0xff, 0x80}, 8)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false, false, false, false, false}, bools)
+}
+
+func TestIntRLEv2RoundTrip(t *testing.T) {
+	var vals []int64
+	for i := range 1000 {
+		vals = append(vals, int64(i*i)-5000)
+	}
+	for range 20 {
+		vals = append(vals, -1)
+	}
+	vals = append(vals, math.MinInt64, math.MaxInt64, 0, 0, 0, 1)
+	for _, signed := range []bool{true, false} {
+		b := appendIntRLEv2(nil, vals, signed)
+		out, err := decodeIntRLEv2(b, len(vals), signed)
+		require.NoError(t, err)
+		require.Equal(t, vals, out)
+	}
+}
+
+func TestByteAndBoolRLERoundTrip(t *testing.T) {
+	var bytes []byte
+	var bools []bool
+	for i := range 1000 {
+		bytes = append(bytes, byte(i/7))
+		bools = append(bools, i%3 == 0 || i > 500)
+	}
+	out, err := decodeByteRLE(appendByteRLE(nil, bytes), len(bytes))
+	require.NoError(t, err)
+	require.Equal(t, bytes, out)
+	outBools, err := decodeBoolRLE(appendBoolRLE(nil, bools), len(bools))
+	require.NoError(t, err)
+	require.Equal(t, bools, outBools)
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	big1, _ := new(big.Int).SetString("-99999999999999999999999999999999999999", 10)
+	big2, _ := new(big.Int).SetString("12345678901234567890123", 10)
+	vals := []*big.Int{big.NewInt(0), big.NewInt(-1), big.NewInt(math.MaxInt64), big.NewInt(math.MinInt64), big1, big2}
+	var b []byte
+	for _, v := range vals {
+		b = appendDecimal(b, v)
+	}
+	out, err := decodeDecimals(b, len(vals))
+	require.NoError(t, err)
+	for i := range vals {
+		require.Zero(t, vals[i].Cmp(out[i]), "expected %s, got %s", vals[i], out[i])
+	}
+}
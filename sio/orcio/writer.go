@@ -0,0 +1,538 @@
+package orcio
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+)
+
+var (
+	ErrMultipleTypes   = errors.New("orcio: encountered multiple types (consider 'blend')")
+	ErrNotRecord       = errors.New("orcio: not a record")
+	ErrUnsupportedType = errors.New("orcio: unsupported type")
+)
+
+const (
+	compressionBlockSize = 256 * 1024
+	// stripeSize is the approximate uncompressed size at which a stripe
+	// is flushed.
+	stripeSize = 64 * 1024 * 1024
+	// writerVersion is ORC-135, which introduced UTC timestamp statistics.
+	writerVersion = 6
+)
+
+// Writer is a sio.Writer for the ORC format.  It requires that all values
+// be records of the same type.  Values are written with ZLIB compression and
+// without row indexes.
+type Writer struct {
+	w        io.WriteCloser
+	typ      *super.TypeRecord
+	root     *columnWriter
+	columns  []*columnWriter
+	types    []*orcType
+	comp     *compressor
+	offset   uint64
+	rows     uint64
+	size     int
+	ft       footer
+	metadata metadata
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		w:    w,
+		comp: newCompressor(compressionBlockSize),
+	}
+}
+
+func (w *Writer) Push(vec vector.Any) error {
+	return sbuf.WriteVec(w, vec)
+}
+
+func (w *Writer) Write(val super.Value) error {
+	recType, ok := super.TypeUnder(val.Type()).(*super.TypeRecord)
+	if !ok || val.IsNull() {
+		return fmt.Errorf("%w: %s", ErrNotRecord, sup.FormatValue(val))
+	}
+	if w.typ == nil {
+		root, err := w.newColumnWriter(recType, map[string]struct{}{})
+		if err != nil {
+			return err
+		}
+		if _, err := w.w.Write([]byte(magic)); err != nil {
+			return err
+		}
+		w.typ, w.root, w.offset = recType, root, uint64(len(magic))
+	} else if w.typ != recType {
+		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, sup.FormatType(w.typ), sup.FormatType(recType))
+	}
+	w.size += w.root.write(recType, val.Bytes())
+	w.rows++
+	if w.size >= stripeSize {
+		return w.flushStripe()
+	}
+	return nil
+}
+
+func (w *Writer) Close() error {
+	var err error
+	if w.typ != nil {
+		err = w.writeTail()
+	}
+	if err2 := w.w.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+func (w *Writer) writeTail() error {
+	if w.rows > 0 {
+		if err := w.flushStripe(); err != nil {
+			return err
+		}
+	}
+	w.ft.headerLength = uint64(len(magic))
+	w.ft.contentLength = w.offset - uint64(len(magic))
+	w.ft.types = w.types
+	w.ft.statistics = make([]*columnStatistics, len(w.columns))
+	for i := range w.columns {
+		stats := &columnStatistics{}
+		for _, stripeStats := range w.metadata.stripeStats {
+			stats.merge(stripeStats[i])
+		}
+		w.ft.statistics[i] = stats
+	}
+	md := w.comp.compress(nil, w.metadata.encode())
+	ft := w.comp.compress(nil, w.ft.encode())
+	ps := (&postScript{
+		footerLength:         uint64(len(ft)),
+		compression:          compressionZlib,
+		compressionBlockSize: compressionBlockSize,
+		version:              []uint32{0, 12},
+		metadataLength:       uint64(len(md)),
+		writerVersion:        writerVersion,
+		magic:                magic,
+	}).encode()
+	if len(ps) > math.MaxUint8 {
+		return errors.New("orcio: postscript too large")
+	}
+	b := append(append(append(md, ft...), ps...), byte(len(ps)))
+	_, err := w.w.Write(b)
+	return err
+}
+
+func (w *Writer) flushStripe() error {
+	var sf stripeFooter
+	var data []byte
+	stats := make([]*columnStatistics, len(w.columns))
+	for i, c := range w.columns {
+		addStream := func(kind streamKind, b []byte) {
+			n := len(data)
+			data = w.comp.compress(data, b)
+			sf.streams = append(sf.streams, &stream{kind: kind, column: c.id, length: uint64(len(data) - n)})
+		}
+		if c.stats.hasNull {
+			addStream(streamPresent, appendBoolRLE(nil, c.present))
+		}
+		encoding := encodingDirectV2
+		switch c.kind {
+		case kindBoolean:
+			addStream(streamData, appendBoolRLE(nil, c.bools))
+			encoding = encodingDirect
+		case kindByte:
+			addStream(streamData, appendByteRLE(nil, c.bytes))
+			encoding = encodingDirect
+		case kindShort, kindInt, kindLong:
+			addStream(streamData, appendIntRLEv2(nil, c.ints, true))
+		case kindFloat, kindDouble:
+			addStream(streamData, c.bytes)
+			encoding = encodingDirect
+		case kindString, kindBinary:
+			addStream(streamData, c.bytes)
+			addStream(streamLength, appendIntRLEv2(nil, c.lengths, false))
+		case kindTimestamp:
+			addStream(streamData, appendIntRLEv2(nil, c.ints, true))
+			addStream(streamSecondary, appendIntRLEv2(nil, c.nanos, false))
+		case kindDecimal:
+			addStream(streamData, c.bytes)
+			addStream(streamSecondary, appendIntRLEv2(nil, c.ints, true))
+		case kindList, kindMap:
+			addStream(streamLength, appendIntRLEv2(nil, c.lengths, false))
+		case kindUnion:
+			addStream(streamData, appendByteRLE(nil, c.bytes))
+			encoding = encodingDirect
+		case kindStruct:
+			encoding = encodingDirect
+		}
+		sf.columns = append(sf.columns, &columnEncoding{kind: encoding})
+		stats[i] = c.stats
+		c.reset()
+	}
+	sf.writerTimezone = "UTC"
+	footer := w.comp.compress(nil, sf.encode())
+	if _, err := w.w.Write(append(data, footer...)); err != nil {
+		return err
+	}
+	w.ft.stripes = append(w.ft.stripes, &stripeInformation{
+		offset:       w.offset,
+		dataLength:   uint64(len(data)),
+		footerLength: uint64(len(footer)),
+		numberOfRows: w.rows,
+	})
+	w.metadata.stripeStats = append(w.metadata.stripeStats, stats)
+	w.offset += uint64(len(data) + len(footer))
+	w.ft.numberOfRows += w.rows
+	w.rows, w.size = 0, 0
+	return nil
+}
+
+// columnWriter accumulates the values of a column for the current stripe.
+type columnWriter struct {
+	id       uint32
+	kind     typeKind
+	scale    int
+	children []*columnWriter
+	// unionTags maps a union tag to the index of the child holding
+	// its values or -1 for null.
+	unionTags []int
+
+	present []bool
+	bools   []bool
+	bytes   []byte
+	ints    []int64
+	nanos   []int64
+	lengths []int64
+	stats   *columnStatistics
+}
+
+func (w *Writer) newColumnWriter(typ super.Type, seen map[string]struct{}) (*columnWriter, error) {
+	if named, ok := typ.(*super.TypeNamed); ok {
+		if _, ok := seen[named.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+		}
+		seen[named.Name] = struct{}{}
+		defer delete(seen, named.Name)
+		return w.newColumnWriter(named.Type, seen)
+	}
+	if u, which := nullableUnion(typ); which >= 0 {
+		typ = u.Types[which]
+		if named, ok := typ.(*super.TypeNamed); ok {
+			return w.newColumnWriter(named, seen)
+		}
+	}
+	c := &columnWriter{id: uint32(len(w.columns)), stats: &columnStatistics{}}
+	t := &orcType{}
+	w.columns = append(w.columns, c)
+	w.types = append(w.types, t)
+	addChild := func(typ super.Type) error {
+		child, err := w.newColumnWriter(typ, seen)
+		if err != nil {
+			return err
+		}
+		c.children = append(c.children, child)
+		t.subtypes = append(t.subtypes, child.id)
+		return nil
+	}
+	switch typ := typ.(type) {
+	case *super.TypeOfBool:
+		c.kind = kindBoolean
+	case *super.TypeOfInt8:
+		c.kind = kindByte
+	case *super.TypeOfInt16, *super.TypeOfUint8:
+		c.kind = kindShort
+	case *super.TypeOfInt32, *super.TypeOfUint16:
+		c.kind = kindInt
+	case *super.TypeOfInt64, *super.TypeOfUint32:
+		c.kind = kindLong
+	case *super.TypeOfUint64:
+		c.kind = kindDecimal
+		t.precision = 20
+	case *super.TypeOfFloat16, *super.TypeOfFloat32:
+		c.kind = kindFloat
+	case *super.TypeOfFloat64:
+		c.kind = kindDouble
+	case *super.TypeOfString, *super.TypeOfIP, *super.TypeOfNet, *super.TypeOfType, *super.TypeEnum, *super.TypeError:
+		c.kind = kindString
+	case *super.TypeOfBytes:
+		c.kind = kindBinary
+	case *super.TypeOfTime:
+		c.kind = kindTimestamp
+	case *super.TypeDecimal:
+		if typ.Precision > 38 {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+		}
+		c.kind = kindDecimal
+		c.scale = typ.Scale
+		t.precision, t.scale = uint32(typ.Precision), uint32(typ.Scale)
+	case *super.TypeRecord:
+		c.kind = kindStruct
+		for _, f := range typ.Fields {
+			t.fieldNames = append(t.fieldNames, f.Name)
+			if err := addChild(f.Type); err != nil {
+				return nil, err
+			}
+		}
+	case *super.TypeArray, *super.TypeSet:
+		c.kind = kindList
+		if err := addChild(super.InnerType(typ)); err != nil {
+			return nil, err
+		}
+	case *super.TypeMap:
+		c.kind = kindMap
+		if err := addChild(typ.KeyType); err != nil {
+			return nil, err
+		}
+		if err := addChild(typ.ValType); err != nil {
+			return nil, err
+		}
+	case *super.TypeUnion:
+		c.kind = kindUnion
+		for _, typ := range typ.Types {
+			if typ == super.TypeNull || typ == super.TypeNone {
+				c.unionTags = append(c.unionTags, -1)
+				continue
+			}
+			c.unionTags = append(c.unionTags, len(c.children))
+			if err := addChild(typ); err != nil {
+				return nil, err
+			}
+		}
+		if len(c.children) > math.MaxUint8+1 {
+			return nil, fmt.Errorf("%w: union with more than %d types", ErrUnsupportedType, math.MaxUint8+1)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+	}
+	t.kind = c.kind
+	return c, nil
+}
+
+// write appends a value to the column and returns its approximate size.
+func (c *columnWriter) write(typ super.Type, bytes scode.Bytes) int {
+	if bytes == nil || super.IsNone(typ, bytes) {
+		c.present = append(c.present, false)
+		c.stats.hasNull = true
+		return 1
+	}
+	if u, which := nullableUnion(super.TypeUnder(typ)); which >= 0 {
+		typ, bytes = u.Untag(bytes)
+		if typ == super.TypeNull || typ == super.TypeNone {
+			c.present = append(c.present, false)
+			c.stats.hasNull = true
+			return 1
+		}
+	}
+	typ = super.TypeUnder(typ)
+	c.present = append(c.present, true)
+	c.stats.numberOfValues++
+	switch c.kind {
+	case kindBoolean:
+		c.bools = append(c.bools, super.DecodeBool(bytes))
+	case kindByte:
+		c.bytes = append(c.bytes, byte(super.DecodeInt(bytes)))
+		updateMinMax(&c.stats.ints, super.DecodeInt(bytes))
+	case kindShort, kindInt, kindLong:
+		var v int64
+		if super.IsSigned(typ.ID()) {
+			v = super.DecodeInt(bytes)
+		} else {
+			v = int64(super.DecodeUint(bytes))
+		}
+		c.ints = append(c.ints, v)
+		updateMinMax(&c.stats.ints, v)
+	case kindFloat:
+		var f float32
+		if typ == super.TypeFloat16 {
+			f = super.DecodeFloat16(bytes)
+		} else {
+			f = super.DecodeFloat32(bytes)
+		}
+		c.bytes = binary.LittleEndian.AppendUint32(c.bytes, math.Float32bits(f))
+		if !math.IsNaN(float64(f)) {
+			updateMinMax(&c.stats.doubles, float64(f))
+		}
+	case kindDouble:
+		f := super.DecodeFloat64(bytes)
+		c.bytes = binary.LittleEndian.AppendUint64(c.bytes, math.Float64bits(f))
+		if !math.IsNaN(f) {
+			updateMinMax(&c.stats.doubles, f)
+		}
+	case kindString:
+		s := formatString(typ, bytes)
+		c.bytes = append(c.bytes, s...)
+		c.lengths = append(c.lengths, int64(len(s)))
+		updateMinMax(&c.stats.strings, s)
+		return len(s)
+	case kindBinary:
+		c.bytes = append(c.bytes, bytes...)
+		c.lengths = append(c.lengths, int64(len(bytes)))
+		return len(bytes)
+	case kindTimestamp:
+		ts := int64(super.DecodeTime(bytes))
+		secs := floorDiv(ts, 1e9)
+		nanos := ts - secs*1e9
+		// Readers decrement negative seconds having more than 999999
+		// nanoseconds.
+		if secs < 0 && nanos > 999999 {
+			secs++
+		}
+		c.ints = append(c.ints, secs-timestampBase)
+		c.nanos = append(c.nanos, encodeNanos(nanos))
+		updateMinMax(&c.stats.timestamps, floorDiv(ts, 1e6))
+	case kindDecimal:
+		var v *big.Int
+		if typ == super.TypeUint64 {
+			v = new(big.Int).SetUint64(super.DecodeUint(bytes))
+		} else {
+			v = super.DecodeDecimal(bytes)
+		}
+		c.bytes = appendDecimal(c.bytes, v)
+		c.ints = append(c.ints, int64(c.scale))
+	case kindStruct:
+		var size int
+		it := bytes.Iter()
+		for i, f := range super.TypeRecordOf(typ).Fields {
+			size += c.children[i].write(f.Type, it.Next())
+		}
+		return size
+	case kindList:
+		inner := super.InnerType(typ)
+		var n, size int
+		for it := bytes.Iter(); !it.Done(); n++ {
+			size += c.children[0].write(inner, it.Next())
+		}
+		c.lengths = append(c.lengths, int64(n))
+		return size
+	case kindMap:
+		mapType := typ.(*super.TypeMap)
+		var n, size int
+		for it := bytes.Iter(); !it.Done(); n++ {
+			size += c.children[0].write(mapType.KeyType, it.Next())
+			size += c.children[1].write(mapType.ValType, it.Next())
+		}
+		c.lengths = append(c.lengths, int64(n))
+		return size
+	case kindUnion:
+		it := bytes.Iter()
+		tag := super.DecodeUint(it.Next())
+		child := c.unionTags[tag]
+		if child < 0 {
+			c.present[len(c.present)-1] = false
+			c.stats.numberOfValues--
+			c.stats.hasNull = true
+			return 1
+		}
+		c.bytes = append(c.bytes, byte(child))
+		return c.children[child].write(typ.(*super.TypeUnion).Types[tag], it.Next())
+	}
+	return 8
+}
+
+func formatString(typ super.Type, bytes scode.Bytes) string {
+	switch typ := typ.(type) {
+	case *super.TypeOfIP:
+		return super.DecodeIP(bytes).String()
+	case *super.TypeOfNet:
+		return super.DecodeNet(bytes).String()
+	case *super.TypeOfType:
+		return sup.FormatTypeValue(bytes)
+	case *super.TypeEnum:
+		s, err := typ.Symbol(int(super.DecodeUint(bytes)))
+		if err != nil {
+			panic(err)
+		}
+		return s
+	case *super.TypeError:
+		return sup.FormatValue(super.NewValue(typ, bytes))
+	}
+	return super.DecodeString(bytes)
+}
+
+func (c *columnWriter) reset() {
+	c.present = c.present[:0]
+	c.bools = c.bools[:0]
+	c.bytes = c.bytes[:0]
+	c.ints = c.ints[:0]
+	c.nanos = c.nanos[:0]
+	c.lengths = c.lengths[:0]
+	c.stats = &columnStatistics{}
+}
+
+// encodeNanos encodes nanoseconds with their trailing decimal zeros
+// removed and the count of zeros minus one in the low three bits.
+func encodeNanos(nanos int64) int64 {
+	if nanos == 0 || nanos%100 != 0 {
+		return nanos << 3
+	}
+	nanos /= 100
+	zeros := int64(1)
+	for nanos%10 == 0 && zeros < 7 {
+		nanos /= 10
+		zeros++
+	}
+	return nanos<<3 | zeros
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+func updateMinMax[T cmp.Ordered](mm **minMax[T], v T) {
+	if *mm == nil {
+		*mm = &minMax[T]{v, v}
+		return
+	}
+	(*mm).min = min((*mm).min, v)
+	(*mm).max = max((*mm).max, v)
+}
+
+func mergeMinMax[T cmp.Ordered](dst **minMax[T], src *minMax[T]) {
+	if src != nil {
+		updateMinMax(dst, src.min)
+		updateMinMax(dst, src.max)
+	}
+}
+
+func (cs *columnStatistics) merge(other *columnStatistics) {
+	cs.numberOfValues += other.numberOfValues
+	cs.hasNull = cs.hasNull || other.hasNull
+	mergeMinMax(&cs.ints, other.ints)
+	mergeMinMax(&cs.doubles, other.doubles)
+	mergeMinMax(&cs.strings, other.strings)
+	mergeMinMax(&cs.dates, other.dates)
+	mergeMinMax(&cs.timestamps, other.timestamps)
+}
+
+// nullableUnion returns whether typ is a union of one type other than null
+// and none along with the index of that type.
+func nullableUnion(typ super.Type) (*super.TypeUnion, int) {
+	u, ok := typ.(*super.TypeUnion)
+	if !ok {
+		return nil, -1
+	}
+	which := -1
+	for k := range u.Types {
+		if u.Types[k] == super.TypeNull || u.Types[k] == super.TypeNone {
+			continue
+		}
+		if which >= 0 {
+			return nil, -1
+		}
+		which = k
+	}
+	return u, which
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
 	"github.com/apache/arrow-go/v18/parquet/metadata"
 	"github.com/apache/arrow-go/v18/parquet/pqarrow"
 	"github.com/brimdata/super"
@@ -14,6 +16,33 @@ import (
 	"github.com/x448/float16"
 )
 
+// ReadMetadata returns the number of rows in the Parquet file read from r
+// along with a metadata value for each row group, i.e., a record holding the
+// minimum and maximum value of each column with statistics.  Only the file
+// footer is read.
+func ReadMetadata(sctx *super.Context, r parquet.ReaderAtSeeker) (int64, []super.Value, error) {
+	pr, err := file.NewParquetReader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	prmd := pr.MetaData()
+	schemaManifest, err := pqarrow.NewSchemaManifest(prmd.Schema, prmd.KeyValueMetadata(), &pqarrow.ArrowReadProperties{})
+	if err != nil {
+		return 0, nil, err
+	}
+	var colIndexes []int
+	for i := range prmd.NumColumns() {
+		if _, ok := schemaManifest.ColIndexToField[i]; ok {
+			colIndexes = append(colIndexes, i)
+		}
+	}
+	var vals []super.Value
+	for i := range prmd.NumRowGroups() {
+		vals = append(vals, buildMetadataValue(sctx, prmd.RowGroup(i), colIndexes, schemaManifest.ColIndexToField))
+	}
+	return pr.NumRows(), vals, nil
+}
+
 func buildMetadataValue(sctx *super.Context, rgmd *metadata.RowGroupMetaData, colIndexes []int, colIndexToField map[int]*pqarrow.SchemaField) super.Value {
 	var paths field.List
 	var vals []super.Value
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"slices"
 	"sync/atomic"
 	"time"
@@ -280,42 +281,39 @@ func (v *vectorBuilder) build(a arrow.Array, nullable bool) (vector.Any, error)
 			}
 		}
 		out = vector.NewInt(super.TypeTime, values)
-	case arrow.DECIMAL128:
+	case arrow.DECIMAL32, arrow.DECIMAL64, arrow.DECIMAL128, arrow.DECIMAL256:
 		typ, ok := v.types[dt]
 		if !ok {
 			d := dt.(arrow.DecimalType)
-			name := fmt.Sprintf("deciaml_%d_%d", d.GetScale(), d.GetPrecision())
 			var err error
-			typ, err = v.sctx.LookupTypeNamed(name, super.TypeFloat64)
+			typ, err = v.sctx.LookupTypeDecimal(int(d.GetPrecision()), int(d.GetScale()))
 			if err != nil {
 				return nil, err
 			}
 			v.types[dt] = typ
 		}
-		scale := dt.(arrow.DecimalType).GetScale()
-		values := make([]float64, length)
-		for i, v := range a.(*array.Decimal128).Values() {
-			values[i] = v.ToFloat64(scale)
-		}
-		out = vector.NewFloat(typ, values)
-	case arrow.DECIMAL256:
-		typ, ok := v.types[dt]
-		if !ok {
-			d := dt.(arrow.DecimalType)
-			name := fmt.Sprintf("deciaml_%d_%d", d.GetScale(), d.GetPrecision())
-			var err error
-			typ, err = v.sctx.LookupTypeNamed(name, super.TypeFloat64)
-			if err != nil {
-				return nil, err
+		vec := vector.NewDecimalEmpty(typ.(*super.TypeDecimal), length)
+		switch a := a.(type) {
+		case *array.Decimal32:
+			for _, v := range a.Values() {
+				vec.Append(big.NewInt(int64(v)))
+			}
+		case *array.Decimal64:
+			for _, v := range a.Values() {
+				vec.Append(big.NewInt(int64(v)))
+			}
+		case *array.Decimal128:
+			for _, v := range a.Values() {
+				vec.Append(v.BigInt())
+			}
+		case *array.Decimal256:
+			// XXX pqarrow mis-sign-extends negative DECIMAL256
+			// values whose width isn't a multiple of eight bytes.
+			for _, v := range a.Values() {
+				vec.Append(v.BigInt())
 			}
-			v.types[dt] = typ
-		}
-		scale := dt.(arrow.DecimalType).GetScale()
-		values := make([]float64, length)
-		for i, v := range a.(*array.Decimal256).Values() {
-			values[i] = v.ToFloat64(scale)
 		}
-		out = vector.NewFloat(typ, values)
+		out = vec
 	case arrow.LIST:
 		arr := a.(*array.List)
 		nullable := dt.(*arrow.ListType).ElemField().Nullable
@@ -11,6 +11,8 @@ import (
 
 func Extension(format string) string {
 	switch format {
+	case "avro":
+		return ".avro"
 	case "bsup":
 		return ".bsup"
 	case "csup":
@@ -19,6 +21,8 @@ func Extension(format string) string {
 		return ".csv"
 	case "json":
 		return ".json"
+	case "orc":
+		return ".orc"
 	case "parquet":
 		return ".parquet"
 	case "sup":
@@ -34,6 +38,8 @@ func Extension(format string) string {
 
 func FormatFromPath(path string) string {
 	switch filepath.Ext(path) {
+	case ".avro":
+		return "avro"
 	case ".bsup":
 		return "bsup"
 	case ".csup":
@@ -42,6 +48,8 @@ func FormatFromPath(path string) string {
 		return "csv"
 	case ".json", ".jsonl", ".ndjson":
 		return "json"
+	case ".orc":
+		return "orc"
 	case ".parquet":
 		return "parquet"
 	case ".sup":
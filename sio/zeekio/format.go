@@ -10,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/sup"
@@ -35,6 +36,8 @@ func formatAny(val super.Value, inContainer bool) string {
 		return base64.StdEncoding.EncodeToString(val.Bytes())
 	case *super.TypeOfDuration, *super.TypeOfTime:
 		return formatTime(nano.Ts(val.Int()))
+	case *super.TypeDecimal:
+		return decimal.Format(super.DecodeDecimal(val.Bytes()), t.Scale)
 	case *super.TypeEnum:
 		return formatAny(super.NewValue(super.TypeUint64, val.Bytes()), false)
 	case *super.TypeOfFloat16, *super.TypeOfFloat32:
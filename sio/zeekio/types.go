@@ -27,7 +27,7 @@ func superTypeToZeek(typ super.Type) (string, error) {
 		return "int", nil
 	case *super.TypeOfUint64:
 		return "count", nil
-	case *super.TypeOfFloat16, *super.TypeOfFloat32, *super.TypeOfFloat64:
+	case *super.TypeOfFloat16, *super.TypeOfFloat32, *super.TypeOfFloat64, *super.TypeDecimal:
 		return "double", nil
 	case *super.TypeOfIP:
 		return "addr", nil
@@ -3,10 +3,12 @@ package sup
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/scode"
 )
 
@@ -519,6 +521,12 @@ func (a *Analyzer) decoratePrimitive(val *Primitive, decorator super.Type) (Valu
 	if enumType, ok := super.TypeUnder(decorator).(*super.TypeEnum); ok {
 		return a.decorateEnum(val, enumType, decorator)
 	}
+	if decType, ok := super.TypeUnder(decorator).(*super.TypeDecimal); ok {
+		return a.decorateDecimal(val, decType, decorator)
+	}
+	if id := super.TypeUnder(decorator).ID(); super.IsBigInt(id) {
+		return a.decorateBigInt(val, id, decorator)
+	}
 	if err := primitiveOk(val.typ, decorator); err != nil {
 		return nil, err
 	}
@@ -548,6 +556,41 @@ func (a *Analyzer) decorateEnum(val *Primitive, enumType *super.TypeEnum, decora
 	return nil, fmt.Errorf("symbol %q not a member of %s", val.text, FormatType(enumType))
 }
 
+func (a *Analyzer) decorateDecimal(val *Primitive, decType *super.TypeDecimal, decorator super.Type) (Value, error) {
+	id := val.typ.ID()
+	if !super.IsInteger(id) && !super.IsFloat(id) && !super.IsDecimal(val.typ) {
+		return nil, fmt.Errorf("type mismatch: %q cannot be used as %q", FormatType(val.typ), FormatType(decorator))
+	}
+	v, scale, err := decimal.Parse(val.text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal value: %q", val.text)
+	}
+	if scale > decType.Scale && decimal.Compare(decimal.Rescale(v, scale, decType.Scale), decType.Scale, v, scale) != 0 {
+		return nil, fmt.Errorf("decimal value %q has more digits than allowed by scale of %s", val.text, FormatType(decType))
+	}
+	if !decimal.Fits(decimal.Rescale(v, scale, decType.Scale), decType.Precision) {
+		return nil, fmt.Errorf("decimal value %q overflows %s", val.text, FormatType(decType))
+	}
+	return &Primitive{typ: decorator, text: val.text}, nil
+}
+
+// decorateBigInt decorates an integer with a 128- or 256-bit integer type.
+// Integer literals too large for uint64 are parsed as float64 so val may
+// also be a float64 whose text is an integer.
+func (a *Analyzer) decorateBigInt(val *Primitive, id int, decorator super.Type) (Value, error) {
+	if !super.IsInteger(val.typ.ID()) && val.typ.ID() != super.IDFloat64 {
+		return nil, fmt.Errorf("type mismatch: %q cannot be used as %q", FormatType(val.typ), FormatType(decorator))
+	}
+	v, ok := new(big.Int).SetString(val.text, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %q", val.text)
+	}
+	if !super.FitsInt(id, v) {
+		return nil, fmt.Errorf("integer value %q overflows %s", val.text, FormatType(decorator))
+	}
+	return &Primitive{typ: decorator, text: val.text}, nil
+}
+
 func (a *Analyzer) decorateRecord(val *Record, decorator super.Type) (Value, error) {
 	typ, ok := super.TypeUnder(decorator).(*super.TypeRecord)
 	if !ok {
@@ -716,6 +759,8 @@ func (a Analyzer) convertType(typ ast.Type) (super.Type, error) {
 		return a.convertTypeUnion(t)
 	case *ast.TypeEnum:
 		return a.convertTypeEnum(t)
+	case *ast.TypeDecimal:
+		return a.sctx.LookupTypeDecimal(t.Precision, t.Scale)
 	case *ast.TypeError:
 		typ, err := a.convertType(t.Type)
 		if err != nil {
@@ -4,12 +4,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/netip"
 	"strconv"
 	"time"
 	"unicode/utf8"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/scode"
 	"golang.org/x/text/unicode/norm"
@@ -63,7 +65,7 @@ func buildValue(b *scode.Builder, val Value) error {
 }
 
 func BuildPrimitive(b *scode.Builder, val Primitive) error {
-	switch super.TypeUnder(val.typ).(type) {
+	switch typ := super.TypeUnder(val.typ).(type) {
 	case *super.TypeOfUint8, *super.TypeOfUint16, *super.TypeOfUint32, *super.TypeOfUint64:
 		v, err := strconv.ParseUint(val.text, 10, 64)
 		if err != nil {
@@ -117,6 +119,23 @@ func BuildPrimitive(b *scode.Builder, val Primitive) error {
 		}
 		b.Append(super.EncodeFloat64(v))
 		return nil
+	case *super.TypeOfInt128, *super.TypeOfInt256, *super.TypeOfUint128, *super.TypeOfUint256:
+		v, ok := new(big.Int).SetString(val.text, 10)
+		if !ok {
+			return fmt.Errorf("invalid integer: %s", val.text)
+		}
+		if !super.FitsInt(typ.ID(), v) {
+			return fmt.Errorf("integer overflow: %s overflows %s", val.text, super.PrimitiveName(typ))
+		}
+		b.Append(super.NewBigInt(typ, v).Bytes())
+		return nil
+	case *super.TypeDecimal:
+		v, scale, err := decimal.Parse(val.text)
+		if err != nil {
+			return fmt.Errorf("invalid decimal: %s", val.text)
+		}
+		b.Append(super.EncodeDecimal(decimal.Rescale(v, scale, typ.Scale)))
+		return nil
 	case *super.TypeOfBool:
 		var v bool
 		if val.text == "true" {
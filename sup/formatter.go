@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/decimal"
 	"github.com/brimdata/super/pkg/terminal/color"
 	"github.com/brimdata/super/scode"
 )
@@ -525,6 +526,12 @@ func formatPrimitive(b *strings.Builder, typ super.Type, bytes scode.Bytes) {
 		} else {
 			b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
 		}
+	case *super.TypeOfInt128, *super.TypeOfInt256:
+		b.WriteString(super.DecodeBigInt(bytes).String())
+	case *super.TypeOfUint128, *super.TypeOfUint256:
+		b.WriteString(super.DecodeBigUint(bytes).String())
+	case *super.TypeDecimal:
+		b.Write(decimal.Append(nil, super.DecodeDecimal(bytes), typ.Scale))
 	case *super.TypeOfBool:
 		if super.DecodeBool(bytes) {
 			b.WriteString("true")
@@ -610,6 +617,8 @@ func (f *formatterT) formatType(indent int, typ super.Type, parens bool) {
 		f.formatTypeUnion(indent, typ, parens)
 	case *super.TypeEnum:
 		f.formatTypeEnum(typ)
+	case *super.TypeDecimal:
+		f.buildf("decimal(%d,%d)", typ.Precision, typ.Scale)
 	case *super.TypeError:
 		f.build("error(")
 		f.formatType(indent, typ.Type, false)
@@ -905,15 +905,28 @@ func (u *UnmarshalBSUPContext) decodeMap(val super.Value, mapVal reflect.Value)
 		mapVal.Set(reflect.Zero(mapVal.Type()))
 		return nil
 	}
-	typ, ok := super.TypeUnder(val.Type()).(*super.TypeMap)
-	if !ok {
-		return errors.New("not a map")
-	}
 	if mapVal.IsNil() {
 		mapVal.Set(reflect.MakeMap(mapVal.Type()))
 	}
 	keyType := mapVal.Type().Key()
 	valType := mapVal.Type().Elem()
+	if recType, ok := super.TypeUnder(val.Type()).(*super.TypeRecord); ok && keyType.Kind() == reflect.String {
+		// A record with string keys, e.g., from a JSON object, decodes
+		// into a map with field names as keys.
+		for i, it := 0, val.Bytes().Iter(); !it.Done(); i++ {
+			field := recType.Fields[i]
+			val := reflect.New(valType).Elem()
+			if err := u.decodeAny(super.NewValue(field.Type, it.Next()), val); err != nil {
+				return err
+			}
+			mapVal.SetMapIndex(reflect.ValueOf(field.Name).Convert(keyType), val)
+		}
+		return nil
+	}
+	typ, ok := super.TypeUnder(val.Type()).(*super.TypeMap)
+	if !ok {
+		return errors.New("not a map")
+	}
 	for it := val.ContainerIter(); !it.Done(); {
 		key := reflect.New(keyType).Elem()
 		if err := u.decodeAny(super.NewValue(typ.KeyType, it.Next()), key); err != nil {
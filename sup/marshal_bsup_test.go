@@ -76,6 +76,15 @@ func TestMarshalMap(t *testing.T) {
 	}
 }
 
+func TestUnmarshalRecordToMap(t *testing.T) {
+	val := sup.MustParseValue(super.NewContext(), `{a:"x",b:"y"}`)
+	var m map[string]string
+	require.NoError(t, sup.UnmarshalBSUP(val, &m))
+	assert.Equal(t, map[string]string{"a": "x", "b": "y"}, m)
+	var ints map[int]string
+	assert.EqualError(t, sup.UnmarshalBSUP(val, &ints), "not a map")
+}
+
 type BSUPThing struct {
 	A string `super:"a"`
 	B int
@@ -2,6 +2,7 @@ package sup
 
 import (
 	"errors"
+	"strconv"
 	"unicode"
 
 	"github.com/brimdata/super"
@@ -84,6 +85,9 @@ func (p *Parser) matchTypeName() (ast.Type, error) {
 	if name == "enum" {
 		return p.matchTypeEnumBody()
 	}
+	if name == "decimal" {
+		return p.matchTypeDecimalBody()
+	}
 	if name == "any" {
 		return &ast.TypeFusion{
 			Kind: "TypeFusion",
@@ -378,6 +382,55 @@ func (p *Parser) matchEnumSymbols() ([]*ast.Text, error) {
 	return symbols, nil
 }
 
+func (p *Parser) matchTypeDecimalBody() (*ast.TypeDecimal, error) {
+	l := p.lexer
+	if ok, err := l.match('('); !ok || err != nil {
+		return nil, errors.New("no opening parenthesis in decimal type")
+	}
+	precision, err := p.matchTypeDecimalParam()
+	if err != nil {
+		return nil, err
+	}
+	scale := 0
+	ok, err := l.match(',')
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if scale, err = p.matchTypeDecimalParam(); err != nil {
+			return nil, err
+		}
+	}
+	ok, err = l.match(')')
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, p.error("mismatched parentheses while parsing decimal type")
+	}
+	return &ast.TypeDecimal{
+		Kind:      "TypeDecimal",
+		Precision: precision,
+		Scale:     scale,
+	}, nil
+}
+
+func (p *Parser) matchTypeDecimalParam() (int, error) {
+	l := p.lexer
+	if err := l.skipSpace(); err != nil {
+		return 0, noEOF(err)
+	}
+	s, err := l.scanTypeName()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, p.error("decimal precision and scale must be integers")
+	}
+	return n, nil
+}
+
 func (p *Parser) matchTypeErrorBody() (*ast.TypeError, error) {
 	typ, err := p.matchTypeBody("error")
 	if err != nil {
@@ -34,6 +34,18 @@ func (p *Parser) lexerError() error {
 	return nil
 }
 
+// MatchEnd returns an error if anything other than space and comments
+// follows the value just parsed.
+func (p *Parser) MatchEnd() error {
+	if err := noEOF(p.lexer.skipSpace()); err != nil {
+		return err
+	}
+	if len(p.lexer.cursor) > 0 {
+		return p.error("unexpected text after value")
+	}
+	return nil
+}
+
 func noEOF(err error) error {
 	if err == io.EOF {
 		err = nil
@@ -42,6 +42,7 @@ const (
 	EnumKind
 	ErrorKind
 	FusionKind
+	DecimalKind
 )
 
 func (k Kind) String() string {
@@ -62,6 +63,10 @@ func (k Kind) String() string {
 		return "enum"
 	case ErrorKind:
 		return "error"
+	case FusionKind:
+		return "fusion"
+	case DecimalKind:
+		return "decimal"
 	default:
 		return fmt.Sprintf("<unknown kind: %d>", k)
 	}
@@ -72,25 +77,28 @@ var (
 	TypeUint16   = &TypeOfUint16{}
 	TypeUint32   = &TypeOfUint32{}
 	TypeUint64   = &TypeOfUint64{}
+	TypeUint128  = &TypeOfUint128{}
+	TypeUint256  = &TypeOfUint256{}
 	TypeInt8     = &TypeOfInt8{}
 	TypeInt16    = &TypeOfInt16{}
 	TypeInt32    = &TypeOfInt32{}
 	TypeInt64    = &TypeOfInt64{}
+	TypeInt128   = &TypeOfInt128{}
+	TypeInt256   = &TypeOfInt256{}
 	TypeDuration = &TypeOfDuration{}
 	TypeTime     = &TypeOfTime{}
 	TypeFloat16  = &TypeOfFloat16{}
 	TypeFloat32  = &TypeOfFloat32{}
 	TypeFloat64  = &TypeOfFloat64{}
-	// XXX add TypeDecimal
-	TypeBool   = &TypeOfBool{}
-	TypeBytes  = &TypeOfBytes{}
-	TypeString = &TypeOfString{}
-	TypeIP     = &TypeOfIP{}
-	TypeNet    = &TypeOfNet{}
-	TypeType   = &TypeOfType{}
-	TypeNull   = &TypeOfNull{}
-	TypeNone   = &TypeOfNone{}
-	TypeAll    = &TypeOfAll{}
+	TypeBool     = &TypeOfBool{}
+	TypeBytes    = &TypeOfBytes{}
+	TypeString   = &TypeOfString{}
+	TypeIP       = &TypeOfIP{}
+	TypeNet      = &TypeOfNet{}
+	TypeType     = &TypeOfType{}
+	TypeNull     = &TypeOfNull{}
+	TypeNone     = &TypeOfNone{}
+	TypeAll      = &TypeOfAll{}
 )
 
 // Primary Type IDs
@@ -158,7 +166,6 @@ func IsNumber(id int) bool {
 }
 
 // True iff the type id is encoded as a float encoding.
-// XXX add IDDecimal here when we implement coercible math with it.
 func IsFloat(id int) bool {
 	return id >= IDFloat16 && id <= IDFloat256
 }
@@ -183,6 +190,10 @@ func LookupPrimitive(name string) Type {
 		return TypeUint32
 	case "uint64":
 		return TypeUint64
+	case "uint128":
+		return TypeUint128
+	case "uint256":
+		return TypeUint256
 	case "int8":
 		return TypeInt8
 	case "int16":
@@ -191,6 +202,10 @@ func LookupPrimitive(name string) Type {
 		return TypeInt32
 	case "int64":
 		return TypeInt64
+	case "int128":
+		return TypeInt128
+	case "int256":
+		return TypeInt256
 	case "duration":
 		return TypeDuration
 	case "time":
@@ -233,6 +248,10 @@ func PrimitiveName(typ Type) string {
 		return "uint32"
 	case *TypeOfUint64:
 		return "uint64"
+	case *TypeOfUint128:
+		return "uint128"
+	case *TypeOfUint256:
+		return "uint256"
 	case *TypeOfInt8:
 		return "int8"
 	case *TypeOfInt16:
@@ -241,6 +260,10 @@ func PrimitiveName(typ Type) string {
 		return "int32"
 	case *TypeOfInt64:
 		return "int64"
+	case *TypeOfInt128:
+		return "int128"
+	case *TypeOfInt256:
+		return "int256"
 	case *TypeOfDuration:
 		return "duration"
 	case *TypeOfTime:
@@ -300,6 +323,14 @@ func LookupPrimitiveByID(id int) (Type, error) {
 		return TypeInt64, nil
 	case IDUint64:
 		return TypeUint64, nil
+	case IDInt128:
+		return TypeInt128, nil
+	case IDUint128:
+		return TypeUint128, nil
+	case IDInt256:
+		return TypeInt256, nil
+	case IDUint256:
+		return TypeUint256, nil
 	case IDFloat16:
 		return TypeFloat16, nil
 	case IDFloat32:
@@ -486,6 +517,12 @@ func CompareTypes(a, b Type) int {
 	case ErrorKind:
 		ea, eb := a.(*TypeError), b.(*TypeError)
 		return CompareTypes(ea.Type, eb.Type)
+	case DecimalKind:
+		da, db := a.(*TypeDecimal), b.(*TypeDecimal)
+		if cmp := cmp.Compare(da.Precision, db.Precision); cmp != 0 {
+			return cmp
+		}
+		return cmp.Compare(da.Scale, db.Scale)
 	}
 	return 0
 }
@@ -572,6 +609,12 @@ func appendTypeValue(b []byte, t Type, typedefs *map[string]Type) []byte {
 	case *TypeFusion:
 		b = append(b, TypeValueFusion)
 		return appendTypeValue(b, t.Type, typedefs)
+	case *TypeDecimal:
+		// Decimals are encoded using the reserved primitive ID
+		// of their storage class followed by precision and scale.
+		b = append(b, byte(t.Width()))
+		b = binary.AppendUvarint(b, uint64(t.Precision))
+		return binary.AppendUvarint(b, uint64(t.Scale))
 	default:
 		// Primitive type
 		return append(b, byte(t.ID()))
@@ -75,9 +75,10 @@ func boolToUint64(b bool) uint64 {
 }
 
 // Uint returns v's underlying value.  It panics if v's underlying type is not
-// TypeUint8, TypeUint16, TypeUint32, or TypeUint64.
+// an unsigned integer type.  Values of TypeUint128 and TypeUint256 are
+// truncated to their low 64 bits so BigInt should be used for them.
 func (v Value) Uint() uint64 {
-	if v.Type().ID() > IDUint64 {
+	if !IsUnsigned(v.Type().ID()) {
 		panic(fmt.Sprintf("super.Value.Uint called on %T", v.Type()))
 	}
 	if x, ok := v.native(); ok {
@@ -87,7 +88,8 @@ func (v Value) Uint() uint64 {
 }
 
 // Int returns v's underlying value.  It panics if v's underlying type is not
-// TypeInt8, TypeInt16, TypeInt32, TypeInt64, TypeDuration, or TypeTime.
+// a signed integer type.  Values of TypeInt128 and TypeInt256 that do not fit
+// in an int64 are not meaningful so BigInt should be used for them.
 func (v Value) Int() int64 {
 	if !IsSigned(v.Type().ID()) {
 		panic(fmt.Sprintf("super.Value.Int called on %T", v.Type()))
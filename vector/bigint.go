@@ -0,0 +1,87 @@
+package vector
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/scode"
+)
+
+// BigInt is a vector of int128, int256, uint128, or uint256 values.  Each
+// element is stored in a BytesTable as its counted varint encoding (see
+// super.EncodeBigInt and super.EncodeBigUint).
+type BigInt struct {
+	Typ   super.Type
+	table BytesTable
+}
+
+var _ Any = (*BigInt)(nil)
+
+func NewBigInt(typ super.Type, table BytesTable) *BigInt {
+	return &BigInt{Typ: typ, table: table}
+}
+
+func NewBigIntEmpty(typ super.Type, cap uint32) *BigInt {
+	return NewBigInt(typ, NewBytesTableEmpty(cap))
+}
+
+func (b *BigInt) Append(v *big.Int) {
+	b.table.Append(super.NewBigInt(b.Typ, v).Bytes())
+}
+
+func (*BigInt) Kind() Kind {
+	return KindBigInt
+}
+
+func (b *BigInt) Type() super.Type {
+	return b.Typ
+}
+
+func (b *BigInt) Len() uint32 {
+	return b.table.Len()
+}
+
+func (b *BigInt) Serialize(builder *scode.Builder, slot uint32) {
+	builder.Append(b.table.Bytes(slot))
+}
+
+func (b *BigInt) Value(slot uint32) *big.Int {
+	return super.NewValue(b.Typ, b.table.Bytes(slot)).BigInt()
+}
+
+func (b *BigInt) Table() BytesTable {
+	return b.table
+}
+
+// BigIntValue returns the value at slot in vec, which must be a BigInt or
+// a Const, Dict, or View of one.
+func BigIntValue(vec Any, slot uint32) *big.Int {
+	switch vec := vec.(type) {
+	case *BigInt:
+		return vec.Value(slot)
+	case *Const:
+		return BigIntValue(vec.Any, 0)
+	case *Dict:
+		return BigIntValue(vec.Any, uint32(vec.Index[slot]))
+	case *View:
+		return BigIntValue(vec.Any, vec.Index[slot])
+	}
+	panic(vec)
+}
+
+type bigIntValueBuilder struct {
+	typ   super.Type
+	table BytesTable
+}
+
+func newBigIntValueBuilder(typ super.Type) ValueBuilder {
+	return &bigIntValueBuilder{typ: typ, table: NewBytesTableEmpty(0)}
+}
+
+func (b *bigIntValueBuilder) Write(bytes scode.Bytes) {
+	b.table.Append(bytes)
+}
+
+func (b *bigIntValueBuilder) Build(*super.Context) Any {
+	return NewBigInt(b.typ, b.table)
+}
@@ -52,6 +52,16 @@ func newBytesTableWithValue(b []byte) BytesTable {
 	return NewBytesTable(offsets, b)
 }
 
+func NewConstDecimal(typ *super.TypeDecimal, v []byte, length uint32) *Const {
+	vec := NewDecimal(typ, newBytesTableWithValue(v))
+	return &Const{vec, length}
+}
+
+func NewConstBigInt(typ super.Type, v []byte, length uint32) *Const {
+	vec := NewBigInt(typ, newBytesTableWithValue(v))
+	return &Const{vec, length}
+}
+
 func NewConstString(v string, length uint32) *Const {
 	vec := NewString(newBytesTableWithValue([]byte(v)))
 	return &Const{vec, length}
@@ -73,6 +83,8 @@ func NewConstType(sctx *super.Context, typ super.Type, length uint32) *Const {
 
 func NewConstFromValue(sctx *super.Context, val super.Value, length uint32) *Const {
 	switch id := val.Type().ID(); {
+	case super.IsBigInt(id):
+		return NewConstBigInt(val.Type(), val.Bytes(), length)
 	case super.IsUnsigned(id):
 		return NewConstUint(val.Type(), val.Uint(), length)
 	case super.IsSigned(id):
@@ -98,6 +110,9 @@ func NewConstFromValue(sctx *super.Context, val super.Value, length uint32) *Con
 	case id == super.IDNone:
 		return &Const{NewNone(length), length}
 	}
+	if typ, ok := super.TypeUnder(val.Type()).(*super.TypeDecimal); ok {
+		return NewConstDecimal(typ, val.Bytes(), length)
+	}
 	panic(fmt.Sprintf("%#v\n", super.TypeUnder(val.Type())))
 }
 
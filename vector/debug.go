@@ -68,6 +68,10 @@ func write(w io.Writer, vec Any, indent, prefix string) {
 		fmt.Fprintf(w, " offs=%v bytes=%v", vec.table.offsets, vec.table.bytes)
 	case *String:
 		fmt.Fprintf(w, " offsets=%v bytes=%s", vec.table.offsets, vec.table.bytes)
+	case *Decimal:
+		fmt.Fprintf(w, " offs=%v bytes=%v", vec.table.offsets, vec.table.bytes)
+	case *BigInt:
+		fmt.Fprintf(w, " offs=%v bytes=%v", vec.table.offsets, vec.table.bytes)
 	case *IP:
 		fmt.Fprintf(w, " values=%v", vec.Values)
 	case *Net:
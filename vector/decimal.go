@@ -0,0 +1,87 @@
+package vector
+
+import (
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/scode"
+)
+
+// Decimal is a vector of decimal values.  Each element is stored in a
+// BytesTable as the encoding of its unscaled value (see super.EncodeDecimal).
+type Decimal struct {
+	Typ   *super.TypeDecimal
+	table BytesTable
+}
+
+var _ Any = (*Decimal)(nil)
+
+func NewDecimal(typ *super.TypeDecimal, table BytesTable) *Decimal {
+	return &Decimal{Typ: typ, table: table}
+}
+
+func NewDecimalEmpty(typ *super.TypeDecimal, cap uint32) *Decimal {
+	return NewDecimal(typ, NewBytesTableEmpty(cap))
+}
+
+func (d *Decimal) Append(v *big.Int) {
+	d.table.Append(super.EncodeDecimal(v))
+}
+
+func (*Decimal) Kind() Kind {
+	return KindDecimal
+}
+
+func (d *Decimal) Type() super.Type {
+	return d.Typ
+}
+
+func (d *Decimal) Len() uint32 {
+	return d.table.Len()
+}
+
+func (d *Decimal) Serialize(builder *scode.Builder, slot uint32) {
+	builder.Append(d.table.Bytes(slot))
+}
+
+// Value returns the unscaled value at slot.
+func (d *Decimal) Value(slot uint32) *big.Int {
+	return super.DecodeDecimal(d.table.Bytes(slot))
+}
+
+func (d *Decimal) Table() BytesTable {
+	return d.table
+}
+
+// DecimalValue returns the unscaled value of the decimal at slot in vec,
+// which must be a Decimal or a Const, Dict, or View of one.
+func DecimalValue(vec Any, slot uint32) *big.Int {
+	switch vec := vec.(type) {
+	case *Decimal:
+		return vec.Value(slot)
+	case *Const:
+		return DecimalValue(vec.Any, 0)
+	case *Dict:
+		return DecimalValue(vec.Any, uint32(vec.Index[slot]))
+	case *View:
+		return DecimalValue(vec.Any, vec.Index[slot])
+	}
+	panic(vec)
+}
+
+type decimalValueBuilder struct {
+	typ   *super.TypeDecimal
+	table BytesTable
+}
+
+func newDecimalValueBuilder(typ *super.TypeDecimal) ValueBuilder {
+	return &decimalValueBuilder{typ: typ, table: NewBytesTableEmpty(0)}
+}
+
+func (d *decimalValueBuilder) Write(bytes scode.Bytes) {
+	d.table.Append(bytes)
+}
+
+func (d *decimalValueBuilder) Build(*super.Context) Any {
+	return NewDecimal(d.typ, d.table)
+}
@@ -58,6 +58,10 @@ func (e *Empty) Kind() Kind {
 			return KindUnion
 		case *super.TypeEnum:
 			return KindEnum
+		case *super.TypeDecimal:
+			return KindDecimal
+		case *super.TypeOfInt128, *super.TypeOfInt256, *super.TypeOfUint128, *super.TypeOfUint256:
+			return KindBigInt
 		case *super.TypeError:
 			return KindError
 		case *super.TypeFusion:
@@ -28,6 +28,8 @@ const (
 	KindEnum    = 17
 	KindFusion  = 19
 	KindNone    = 20
+	KindDecimal = 21
+	KindBigInt  = 22
 )
 
 const (
@@ -61,6 +63,10 @@ func KindFromString(v string) Kind {
 		return KindRecord
 	case "None":
 		return KindNone
+	case "Decimal":
+		return KindDecimal
+	case "BigInt":
+		return KindBigInt
 	default:
 		return KindInvalid
 	}
@@ -97,6 +97,10 @@ func NewValueBuilder(typ super.Type) ValueBuilder {
 		return newFusionValueBuilder(typ)
 	case *super.TypeEnum:
 		return &enumValueBuilder{typ, nil}
+	case *super.TypeDecimal:
+		return newDecimalValueBuilder(typ)
+	case *super.TypeOfInt128, *super.TypeOfInt256, *super.TypeOfUint128, *super.TypeOfUint256:
+		return newBigIntValueBuilder(typ)
 	case *super.TypeError:
 		return &errorValueBuilder{typ: typ, ValueBuilder: NewValueBuilder(typ.Type)}
 	case *super.TypeNamed:
@@ -473,11 +477,10 @@ func (n *noneValueBuilder) Build(*super.Context) Any {
 
 func DefuseAny(vec *Fusion) Any {
 	builder := NewDynamicValueBuilder()
-	bytesVec := vec.Values.(*Bytes)
 	typesVec := vec.Subtypes
-	for slot := range bytesVec.Len() {
+	for slot := range vec.Len() {
 		typ := typesVec.Value(slot)
-		bytes := bytesVec.Value(slot)
+		bytes := BytesValue(vec.Values, slot)
 		builder.Write(super.NewValue(typ, bytes))
 	}
 	return builder.Build(typesVec.sctx)
@@ -48,7 +48,12 @@ func New(typ super.Type) Builder {
 	case *super.TypeOfBool:
 		return &boolBuilder{}
 	case *super.TypeOfString,
-		*super.TypeOfBytes:
+		*super.TypeOfBytes,
+		*super.TypeDecimal,
+		*super.TypeOfInt128,
+		*super.TypeOfInt256,
+		*super.TypeOfUint128,
+		*super.TypeOfUint256:
 		return newBytesBuilder(typ)
 	case *super.TypeOfIP:
 		return &genericBuilder[netip.Addr]{
@@ -162,7 +162,7 @@ func (b *bytesFlatWriter) Write(vec vector.Any) genericWriter {
 		for _, slot := range vec.Index {
 			b.table.Append(table.Bytes(uint32(slot)))
 		}
-	case *vector.String, *vector.Bytes:
+	case *vector.String, *vector.Bytes, *vector.Decimal, *vector.BigInt:
 		table := bytesTableOf(vec)
 		for i := range vec.Len() {
 			b.table.Append(table.Bytes(i))
@@ -183,6 +183,10 @@ func bytesTableOf(vec vector.Any) vector.BytesTable {
 		return vec.Table()
 	case *vector.Bytes:
 		return vec.Table()
+	case *vector.Decimal:
+		return vec.Table()
+	case *vector.BigInt:
+		return vec.Table()
 	case *vector.Empty:
 		return vector.BytesTable{}
 	default:
@@ -191,6 +195,12 @@ func bytesTableOf(vec vector.Any) vector.BytesTable {
 }
 
 func newBytesOrStringVector(typ super.Type, table vector.BytesTable) vector.Any {
+	if typ, ok := typ.(*super.TypeDecimal); ok {
+		return vector.NewDecimal(typ, table)
+	}
+	if super.IsBigInt(typ.ID()) {
+		return vector.NewBigInt(typ, table)
+	}
 	switch typ.ID() {
 	case super.IDString:
 		return vector.NewString(table)